@@ -93,6 +93,8 @@ const (
 	Status_STATUS_DEVICE_UNHEALTHY Status = 2
 	// Corresponds to the Network device in healthy state (i.e., up and running, operating as expected).
 	Status_STATUS_DEVICE_UP Status = 3
+	// Corresponds to the Network device, which is not reachable, because its upstream (parent) network device is down.
+	Status_STATUS_DEVICE_UNREACHABLE Status = 4
 )
 
 // Enum value maps for Status.
@@ -102,12 +104,14 @@ var (
 		1: "STATUS_DEVICE_DOWN",
 		2: "STATUS_DEVICE_UNHEALTHY",
 		3: "STATUS_DEVICE_UP",
+		4: "STATUS_DEVICE_UNREACHABLE",
 	}
 	Status_value = map[string]int32{
-		"STATUS_UNSPECIFIED":      0,
-		"STATUS_DEVICE_DOWN":      1,
-		"STATUS_DEVICE_UNHEALTHY": 2,
-		"STATUS_DEVICE_UP":        3,
+		"STATUS_UNSPECIFIED":        0,
+		"STATUS_DEVICE_DOWN":        1,
+		"STATUS_DEVICE_UNHEALTHY":   2,
+		"STATUS_DEVICE_UP":          3,
+		"STATUS_DEVICE_UNREACHABLE": 4,
 	}
 )
 
@@ -152,6 +156,16 @@ const (
 	Protocol_PROTOCOL_RESTCONF Protocol = 3
 	// Corresponds to the Open vSwitch protocol.
 	Protocol_PROTOCOL_OPEN_V_SWITCH Protocol = 4
+	// Corresponds to the gNMI protocol.
+	Protocol_PROTOCOL_GNMI Protocol = 5
+	// Corresponds to the reachability probe, which opens (and closes) TCP connection. It reports status only.
+	Protocol_PROTOCOL_TCP_CONNECT Protocol = 6
+	// Corresponds to the reachability probe, which sends unprivileged ICMP echo request. It reports status only, port
+	// of the endpoint is ignored.
+	Protocol_PROTOCOL_ICMP Protocol = 7
+	// Corresponds to the protocol, which is not built in the monitoring service. Its connector is registered under
+	// the name carried by the endpoint (protocol_name).
+	Protocol_PROTOCOL_CUSTOM Protocol = 8
 )
 
 // Enum value maps for Protocol.
@@ -162,6 +176,10 @@ var (
 		2: "PROTOCOL_NETCONF",
 		3: "PROTOCOL_RESTCONF",
 		4: "PROTOCOL_OPEN_V_SWITCH",
+		5: "PROTOCOL_GNMI",
+		6: "PROTOCOL_TCP_CONNECT",
+		7: "PROTOCOL_ICMP",
+		8: "PROTOCOL_CUSTOM",
 	}
 	Protocol_value = map[string]int32{
 		"PROTOCOL_UNSPECIFIED":   0,
@@ -169,6 +187,10 @@ var (
 		"PROTOCOL_NETCONF":       2,
 		"PROTOCOL_RESTCONF":      3,
 		"PROTOCOL_OPEN_V_SWITCH": 4,
+		"PROTOCOL_GNMI":          5,
+		"PROTOCOL_TCP_CONNECT":   6,
+		"PROTOCOL_ICMP":          7,
+		"PROTOCOL_CUSTOM":        8,
 	}
 )
 
@@ -199,6 +221,58 @@ func (Protocol) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{2}
 }
 
+// PollingScope specifies, which network devices the default poll interval applies to.
+type PollingScope int32
+
+const (
+	PollingScope_POLLING_SCOPE_UNSPECIFIED PollingScope = 0
+	// Default applies to the network devices within the group.
+	PollingScope_POLLING_SCOPE_GROUP PollingScope = 1
+	// Default applies to the network devices located on the site.
+	PollingScope_POLLING_SCOPE_SITE PollingScope = 2
+)
+
+// Enum value maps for PollingScope.
+var (
+	PollingScope_name = map[int32]string{
+		0: "POLLING_SCOPE_UNSPECIFIED",
+		1: "POLLING_SCOPE_GROUP",
+		2: "POLLING_SCOPE_SITE",
+	}
+	PollingScope_value = map[string]int32{
+		"POLLING_SCOPE_UNSPECIFIED": 0,
+		"POLLING_SCOPE_GROUP":       1,
+		"POLLING_SCOPE_SITE":        2,
+	}
+)
+
+func (x PollingScope) Enum() *PollingScope {
+	p := new(PollingScope)
+	*p = x
+	return p
+}
+
+func (x PollingScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PollingScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_monitoring_proto_enumTypes[3].Descriptor()
+}
+
+func (PollingScope) Type() protoreflect.EnumType {
+	return &file_api_v1_monitoring_proto_enumTypes[3]
+}
+
+func (x PollingScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PollingScope.Descriptor instead.
+func (PollingScope) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{3}
+}
+
 // GetSummaryResponse provides a summary of the network device monitoring statistics,
 type GetSummaryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -209,9 +283,13 @@ type GetSummaryResponse struct {
 	// Total number of unhealthy devices.
 	DevicesUnhealthy int32 `protobuf:"varint,3,opt,name=devices_unhealthy,json=devicesUnhealthy,proto3" json:"devices_unhealthy,omitempty"`
 	// Total number of devices in DOWN state.
-	DownDevices   int32 `protobuf:"varint,4,opt,name=down_devices,json=downDevices,proto3" json:"down_devices,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	DownDevices int32 `protobuf:"varint,4,opt,name=down_devices,json=downDevices,proto3" json:"down_devices,omitempty"`
+	// Total number of devices, which are in maintenance.
+	DevicesInMaintenance int32 `protobuf:"varint,5,opt,name=devices_in_maintenance,json=devicesInMaintenance,proto3" json:"devices_in_maintenance,omitempty"`
+	// Total number of devices, which are unreachable due to the upstream network device being down.
+	DevicesUnreachable int32 `protobuf:"varint,6,opt,name=devices_unreachable,json=devicesUnreachable,proto3" json:"devices_unreachable,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetSummaryResponse) Reset() {
@@ -272,6 +350,20 @@ func (x *GetSummaryResponse) GetDownDevices() int32 {
 	return 0
 }
 
+func (x *GetSummaryResponse) GetDevicesInMaintenance() int32 {
+	if x != nil {
+		return x.DevicesInMaintenance
+	}
+	return 0
+}
+
+func (x *GetSummaryResponse) GetDevicesUnreachable() int32 {
+	if x != nil {
+		return x.DevicesUnreachable
+	}
+	return 0
+}
+
 // AddDeviceRequest message carries network device that is necessary to add to the monitoring.
 type AddDeviceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -880,42 +972,28 @@ func (x *GetDeviceListResponse) GetDevices() []*NetworkDevice {
 	return nil
 }
 
-// NetworkDevice message defines Network device data structure,
-type NetworkDevice struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is a device ID assigned internally by the Monitoring service. it is internal to the system.
-	// Later, by this ID, it is possible to retrieve any information about the device.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Network device vendor.
-	Vendor Vendor `protobuf:"varint,2,opt,name=vendor,proto3,enum=api.v1.Vendor" json:"vendor,omitempty"`
-	// Network device model.
-	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
-	// Network device endpoint. Device may contain several network endpoints (e.g., support of different protocols).
-	Endpoints []*Endpoint `protobuf:"bytes,10,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
-	// HW version (i.e., HW revision, different from model version).
-	HwVersion string `protobuf:"bytes,20,opt,name=hw_version,json=hwVersion,proto3" json:"hw_version,omitempty"` // this is to not require this field to be set, when User creates this resour
-	// SW version (i.e., SW revision).
-	SwVersion *Version `protobuf:"bytes,21,opt,name=sw_version,json=swVersion,proto3" json:"sw_version,omitempty"`
-	// FW version (i.e., FW revision).
-	FwVersion     *Version `protobuf:"bytes,22,opt,name=fw_version,json=fwVersion,proto3" json:"fw_version,omitempty"`
+// CreateCredentialProfileRequest carries credential profile, which should be created.
+type CreateCredentialProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *CredentialProfile     `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkDevice) Reset() {
-	*x = NetworkDevice{}
+func (x *CreateCredentialProfileRequest) Reset() {
+	*x = CreateCredentialProfileRequest{}
 	mi := &file_api_v1_monitoring_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkDevice) String() string {
+func (x *CreateCredentialProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkDevice) ProtoMessage() {}
+func (*CreateCredentialProfileRequest) ProtoMessage() {}
 
-func (x *NetworkDevice) ProtoReflect() protoreflect.Message {
+func (x *CreateCredentialProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_monitoring_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -927,93 +1005,86 @@ func (x *NetworkDevice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkDevice.ProtoReflect.Descriptor instead.
-func (*NetworkDevice) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCredentialProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateCredentialProfileRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *NetworkDevice) GetId() string {
+func (x *CreateCredentialProfileRequest) GetProfile() *CredentialProfile {
 	if x != nil {
-		return x.Id
+		return x.Profile
 	}
-	return ""
+	return nil
 }
 
-func (x *NetworkDevice) GetVendor() Vendor {
-	if x != nil {
-		return x.Vendor
-	}
-	return Vendor_VENDOR_UNSPECIFIED
+// CreateCredentialProfileResponse carries created credential profile (with assigned internal ID and without secrets).
+type CreateCredentialProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *CredentialProfile     `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkDevice) GetModel() string {
-	if x != nil {
-		return x.Model
-	}
-	return ""
+func (x *CreateCredentialProfileResponse) Reset() {
+	*x = CreateCredentialProfileResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkDevice) GetEndpoints() []*Endpoint {
-	if x != nil {
-		return x.Endpoints
-	}
-	return nil
+func (x *CreateCredentialProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NetworkDevice) GetHwVersion() string {
+func (*CreateCredentialProfileResponse) ProtoMessage() {}
+
+func (x *CreateCredentialProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[14]
 	if x != nil {
-		return x.HwVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *NetworkDevice) GetSwVersion() *Version {
-	if x != nil {
-		return x.SwVersion
-	}
-	return nil
+// Deprecated: Use CreateCredentialProfileResponse.ProtoReflect.Descriptor instead.
+func (*CreateCredentialProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *NetworkDevice) GetFwVersion() *Version {
+func (x *CreateCredentialProfileResponse) GetProfile() *CredentialProfile {
 	if x != nil {
-		return x.FwVersion
+		return x.Profile
 	}
 	return nil
 }
 
-// DeviceStatus reports the status opf the network device including the time when it was last seen in the UP or unhealthy state.
-type DeviceStatus struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the device status resource internally assigned by the controller.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Current status of the Network device.
-	Status Status `protobuf:"varint,2,opt,name=status,proto3,enum=api.v1.Status" json:"status,omitempty"`
-	// A timestamp when the device was last seen in the UP or unhealthy state.
-	LastSeen string `protobuf:"bytes,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"` // originally supposed to be 'google.protobuf.Timestamp', but ent generation made problems for that.
-	// This variable specifies a number of consequential failed attempts to establish connectivity.
-	// Once this number reaches the limit (specified within monitoring service main control loop),
-	// network device is considered to be in down state.
-	ConsequentialFailedConnectivityAttempts int32          `protobuf:"varint,4,opt,name=consequential_failed_connectivity_attempts,json=consequentialFailedConnectivityAttempts,proto3" json:"consequential_failed_connectivity_attempts,omitempty"`
-	NetworkDevice                           *NetworkDevice `protobuf:"bytes,10,opt,name=network_device,json=networkDevice,proto3" json:"network_device,omitempty"`
-	unknownFields                           protoimpl.UnknownFields
-	sizeCache                               protoimpl.SizeCache
+// UpdateCredentialProfileRequest carries credential profile, which should be updated. Profile is identified by its ID.
+type UpdateCredentialProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *CredentialProfile     `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeviceStatus) Reset() {
-	*x = DeviceStatus{}
-	mi := &file_api_v1_monitoring_proto_msgTypes[14]
+func (x *UpdateCredentialProfileRequest) Reset() {
+	*x = UpdateCredentialProfileRequest{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeviceStatus) String() string {
+func (x *UpdateCredentialProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeviceStatus) ProtoMessage() {}
+func (*UpdateCredentialProfileRequest) ProtoMessage() {}
 
-func (x *DeviceStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_monitoring_proto_msgTypes[14]
+func (x *UpdateCredentialProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,77 +1095,86 @@ func (x *DeviceStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeviceStatus.ProtoReflect.Descriptor instead.
-func (*DeviceStatus) Descriptor() ([]byte, []int) {
-	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UpdateCredentialProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCredentialProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DeviceStatus) GetId() string {
+func (x *UpdateCredentialProfileRequest) GetProfile() *CredentialProfile {
 	if x != nil {
-		return x.Id
+		return x.Profile
 	}
-	return ""
+	return nil
 }
 
-func (x *DeviceStatus) GetStatus() Status {
-	if x != nil {
-		return x.Status
-	}
-	return Status_STATUS_UNSPECIFIED
+// UpdateCredentialProfileResponse carries updated credential profile (without secrets).
+type UpdateCredentialProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *CredentialProfile     `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeviceStatus) GetLastSeen() string {
-	if x != nil {
-		return x.LastSeen
-	}
-	return ""
+func (x *UpdateCredentialProfileResponse) Reset() {
+	*x = UpdateCredentialProfileResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeviceStatus) GetConsequentialFailedConnectivityAttempts() int32 {
+func (x *UpdateCredentialProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCredentialProfileResponse) ProtoMessage() {}
+
+func (x *UpdateCredentialProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[16]
 	if x != nil {
-		return x.ConsequentialFailedConnectivityAttempts
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DeviceStatus) GetNetworkDevice() *NetworkDevice {
+// Deprecated: Use UpdateCredentialProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCredentialProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateCredentialProfileResponse) GetProfile() *CredentialProfile {
 	if x != nil {
-		return x.NetworkDevice
+		return x.Profile
 	}
 	return nil
 }
 
-// Endpoint defines an endpoint structure.
-type Endpoint struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the device status resource internally assigned by the controller.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Host address in CIDR form of IP or FQDN, if applicable.
-	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	// Port number, where device health point is reachable.
-	Port string `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
-	// Supported by the network device protocol for communicating over this endpoint.
-	Protocol      Protocol       `protobuf:"varint,10,opt,name=protocol,proto3,enum=api.v1.Protocol" json:"protocol,omitempty"`
-	NetworkDevice *NetworkDevice `protobuf:"bytes,50,opt,name=network_device,json=networkDevice,proto3" json:"network_device,omitempty"`
+// ListCredentialProfilesResponse contains full list of the credential profiles (without secrets).
+type ListCredentialProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*CredentialProfile   `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Endpoint) Reset() {
-	*x = Endpoint{}
-	mi := &file_api_v1_monitoring_proto_msgTypes[15]
+func (x *ListCredentialProfilesResponse) Reset() {
+	*x = ListCredentialProfilesResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Endpoint) String() string {
+func (x *ListCredentialProfilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Endpoint) ProtoMessage() {}
+func (*ListCredentialProfilesResponse) ProtoMessage() {}
 
-func (x *Endpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_monitoring_proto_msgTypes[15]
+func (x *ListCredentialProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1105,74 +1185,92 @@ func (x *Endpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Endpoint.ProtoReflect.Descriptor instead.
-func (*Endpoint) Descriptor() ([]byte, []int) {
-	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListCredentialProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListCredentialProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Endpoint) GetId() string {
+func (x *ListCredentialProfilesResponse) GetProfiles() []*CredentialProfile {
 	if x != nil {
-		return x.Id
+		return x.Profiles
 	}
-	return ""
+	return nil
 }
 
-func (x *Endpoint) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
+// DeleteCredentialProfileRequest carries information about the credential profile that should be removed.
+type DeleteCredentialProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Internal (to the system) ID of the credential profile.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Endpoint) GetPort() string {
-	if x != nil {
-		return x.Port
-	}
-	return ""
+func (x *DeleteCredentialProfileRequest) Reset() {
+	*x = DeleteCredentialProfileRequest{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Endpoint) GetProtocol() Protocol {
+func (x *DeleteCredentialProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCredentialProfileRequest) ProtoMessage() {}
+
+func (x *DeleteCredentialProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[18]
 	if x != nil {
-		return x.Protocol
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Protocol_PROTOCOL_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Endpoint) GetNetworkDevice() *NetworkDevice {
+// Deprecated: Use DeleteCredentialProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCredentialProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteCredentialProfileRequest) GetId() string {
 	if x != nil {
-		return x.NetworkDevice
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// Version message is a generic message for reporting a version.
-type Version struct {
+// DeleteCredentialProfileResponse carries information about credential profile that has been removed.
+type DeleteCredentialProfileResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the device status resource internally assigned by the controller.
+	// Internal (to the system) ID of the credential profile.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// SW/FW Version number.
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	// Checksum of the current revision.
-	Checksum      string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// A bool variable that indicates the success/failure of the operation.
+	Deleted bool `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// In case of failure, carries additional data, otherwise, empty.
+	Details       *string `protobuf:"bytes,3,opt,name=details,proto3,oneof" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Version) Reset() {
-	*x = Version{}
-	mi := &file_api_v1_monitoring_proto_msgTypes[16]
+func (x *DeleteCredentialProfileResponse) Reset() {
+	*x = DeleteCredentialProfileResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Version) String() string {
+func (x *DeleteCredentialProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Version) ProtoMessage() {}
+func (*DeleteCredentialProfileResponse) ProtoMessage() {}
 
-func (x *Version) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_monitoring_proto_msgTypes[16]
+func (x *DeleteCredentialProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1183,9 +1281,2084 @@ func (x *Version) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Version.ProtoReflect.Descriptor instead.
+// Deprecated: Use DeleteCredentialProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCredentialProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteCredentialProfileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteCredentialProfileResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *DeleteCredentialProfileResponse) GetDetails() string {
+	if x != nil && x.Details != nil {
+		return *x.Details
+	}
+	return ""
+}
+
+// ListDeviceStatusHistoryRequest carries ID of the network device and a time range of the status transitions.
+type ListDeviceStatusHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Internal (to the system) ID of the device.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Beginning (Unix milliseconds, inclusive) of the time range. The range is not bounded from below, when unset.
+	From *int64 `protobuf:"varint,2,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// End (Unix milliseconds, inclusive) of the time range. The range is not bounded from above, when unset.
+	To            *int64 `protobuf:"varint,3,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeviceStatusHistoryRequest) Reset() {
+	*x = ListDeviceStatusHistoryRequest{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeviceStatusHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeviceStatusHistoryRequest) ProtoMessage() {}
+
+func (x *ListDeviceStatusHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeviceStatusHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListDeviceStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDeviceStatusHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListDeviceStatusHistoryRequest) GetFrom() int64 {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return 0
+}
+
+func (x *ListDeviceStatusHistoryRequest) GetTo() int64 {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return 0
+}
+
+// ListDeviceStatusHistoryResponse carries status transitions of the network device ordered from the oldest one.
+type ListDeviceStatusHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Internal (to the system) ID of the device.
+	Id            string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Events        []*StatusEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeviceStatusHistoryResponse) Reset() {
+	*x = ListDeviceStatusHistoryResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeviceStatusHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeviceStatusHistoryResponse) ProtoMessage() {}
+
+func (x *ListDeviceStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeviceStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListDeviceStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListDeviceStatusHistoryResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListDeviceStatusHistoryResponse) GetEvents() []*StatusEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// GetEndpointHealthRequest carries ID of the endpoint, which health should be retrieved.
+type GetEndpointHealthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Internal (to the system) ID of the endpoint.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEndpointHealthRequest) Reset() {
+	*x = GetEndpointHealthRequest{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEndpointHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEndpointHealthRequest) ProtoMessage() {}
+
+func (x *GetEndpointHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEndpointHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetEndpointHealthRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetEndpointHealthRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetEndpointHealthResponse carries health of the endpoint.
+type GetEndpointHealthResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Internal (to the system) ID of the endpoint.
+	Id            string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Health        *EndpointHealth `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEndpointHealthResponse) Reset() {
+	*x = GetEndpointHealthResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEndpointHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEndpointHealthResponse) ProtoMessage() {}
+
+func (x *GetEndpointHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEndpointHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetEndpointHealthResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetEndpointHealthResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetEndpointHealthResponse) GetHealth() *EndpointHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+// GetLatencyStatsRequest carries ID of the network device and a time range of the latency samples.
+type GetLatencyStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Internal (to the system) ID of the device.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Internal (to the system) ID of the endpoint of the device. Samples of all endpoints are considered, when unset.
+	EndpointId *string `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3,oneof" json:"endpoint_id,omitempty"`
+	// Beginning (Unix milliseconds, inclusive) of the time range. It is 24 hours before the end of the range, when unset.
+	From *int64 `protobuf:"varint,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// End (Unix milliseconds, inclusive) of the time range. It is the current time, when unset.
+	To            *int64 `protobuf:"varint,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLatencyStatsRequest) Reset() {
+	*x = GetLatencyStatsRequest{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatencyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatencyStatsRequest) ProtoMessage() {}
+
+func (x *GetLatencyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatencyStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetLatencyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetLatencyStatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetLatencyStatsRequest) GetEndpointId() string {
+	if x != nil && x.EndpointId != nil {
+		return *x.EndpointId
+	}
+	return ""
+}
+
+func (x *GetLatencyStatsRequest) GetFrom() int64 {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return 0
+}
+
+func (x *GetLatencyStatsRequest) GetTo() int64 {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return 0
+}
+
+// GetLatencyStatsResponse carries latency statistics (in milliseconds) of the network device. Statistics are zero,
+// when there are no samples within the time range.
+type GetLatencyStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Internal (to the system) ID of the device.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Internal (to the system) ID of the endpoint. Empty, when samples of all endpoints are considered.
+	EndpointId string `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	// Number of the latency samples within the time range.
+	Samples int32 `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"`
+	// Minimal latency.
+	Min int64 `protobuf:"varint,4,opt,name=min,proto3" json:"min,omitempty"`
+	// Average latency.
+	Avg float64 `protobuf:"fixed64,5,opt,name=avg,proto3" json:"avg,omitempty"`
+	// 95th percentile of the latency.
+	P95 int64 `protobuf:"varint,6,opt,name=p95,proto3" json:"p95,omitempty"`
+	// Maximal latency.
+	Max           int64 `protobuf:"varint,7,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLatencyStatsResponse) Reset() {
+	*x = GetLatencyStatsResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatencyStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatencyStatsResponse) ProtoMessage() {}
+
+func (x *GetLatencyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatencyStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetLatencyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetLatencyStatsResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetLatencyStatsResponse) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *GetLatencyStatsResponse) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *GetLatencyStatsResponse) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *GetLatencyStatsResponse) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *GetLatencyStatsResponse) GetP95() int64 {
+	if x != nil {
+		return x.P95
+	}
+	return 0
+}
+
+func (x *GetLatencyStatsResponse) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// SetPollingDefaultRequest carries default poll interval of the group or the site.
+type SetPollingDefaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Default       *PollingDefault        `protobuf:"bytes,1,opt,name=default,proto3" json:"default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPollingDefaultRequest) Reset() {
+	*x = SetPollingDefaultRequest{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPollingDefaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPollingDefaultRequest) ProtoMessage() {}
+
+func (x *SetPollingDefaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPollingDefaultRequest.ProtoReflect.Descriptor instead.
+func (*SetPollingDefaultRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetPollingDefaultRequest) GetDefault() *PollingDefault {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+// SetPollingDefaultResponse carries default poll interval with ID assigned internally by the system.
+type SetPollingDefaultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Default       *PollingDefault        `protobuf:"bytes,1,opt,name=default,proto3" json:"default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPollingDefaultResponse) Reset() {
+	*x = SetPollingDefaultResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPollingDefaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPollingDefaultResponse) ProtoMessage() {}
+
+func (x *SetPollingDefaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPollingDefaultResponse.ProtoReflect.Descriptor instead.
+func (*SetPollingDefaultResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetPollingDefaultResponse) GetDefault() *PollingDefault {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+// ListPollingDefaultsResponse carries all default poll intervals.
+type ListPollingDefaultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Defaults      []*PollingDefault      `protobuf:"bytes,1,rep,name=defaults,proto3" json:"defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPollingDefaultsResponse) Reset() {
+	*x = ListPollingDefaultsResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPollingDefaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPollingDefaultsResponse) ProtoMessage() {}
+
+func (x *ListPollingDefaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPollingDefaultsResponse.ProtoReflect.Descriptor instead.
+func (*ListPollingDefaultsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListPollingDefaultsResponse) GetDefaults() []*PollingDefault {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+// DeletePollingDefaultRequest carries ID of the default poll interval, which should be removed.
+type DeletePollingDefaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePollingDefaultRequest) Reset() {
+	*x = DeletePollingDefaultRequest{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePollingDefaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePollingDefaultRequest) ProtoMessage() {}
+
+func (x *DeletePollingDefaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePollingDefaultRequest.ProtoReflect.Descriptor instead.
+func (*DeletePollingDefaultRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeletePollingDefaultRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeletePollingDefaultResponse carries information about the default poll interval that has been removed.
+type DeletePollingDefaultResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Internal (to the system) ID of the default poll interval.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// A bool variable that indicates the success/failure of the operation.
+	Deleted bool `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// In case of failure, carries additional data, otherwise, empty.
+	Details       *string `protobuf:"bytes,3,opt,name=details,proto3,oneof" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePollingDefaultResponse) Reset() {
+	*x = DeletePollingDefaultResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePollingDefaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePollingDefaultResponse) ProtoMessage() {}
+
+func (x *DeletePollingDefaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePollingDefaultResponse.ProtoReflect.Descriptor instead.
+func (*DeletePollingDefaultResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeletePollingDefaultResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeletePollingDefaultResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *DeletePollingDefaultResponse) GetDetails() string {
+	if x != nil && x.Details != nil {
+		return *x.Details
+	}
+	return ""
+}
+
+// SetDeviceParentRequest carries ID of the network device and ID of its new parent.
+type SetDeviceParentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Internal (to the system) ID of the device.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Internal (to the system) ID of the parent device. Parent is cleared, when it is empty.
+	ParentId      string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDeviceParentRequest) Reset() {
+	*x = SetDeviceParentRequest{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDeviceParentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeviceParentRequest) ProtoMessage() {}
+
+func (x *SetDeviceParentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDeviceParentRequest.ProtoReflect.Descriptor instead.
+func (*SetDeviceParentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetDeviceParentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetDeviceParentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+// SetDeviceParentResponse carries the network device with updated parent.
+type SetDeviceParentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *NetworkDevice         `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDeviceParentResponse) Reset() {
+	*x = SetDeviceParentResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDeviceParentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeviceParentResponse) ProtoMessage() {}
+
+func (x *SetDeviceParentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDeviceParentResponse.ProtoReflect.Descriptor instead.
+func (*SetDeviceParentResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetDeviceParentResponse) GetDevice() *NetworkDevice {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+// PollDeviceRequest carries ID of the network device, which should be polled.
+type PollDeviceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Internal (to the system) ID of the device.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollDeviceRequest) Reset() {
+	*x = PollDeviceRequest{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollDeviceRequest) ProtoMessage() {}
+
+func (x *PollDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollDeviceRequest.ProtoReflect.Descriptor instead.
+func (*PollDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PollDeviceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PollDeviceResponse carries the result of the poll of the network device.
+type PollDeviceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Network device with its fresh HW, SW, and FW versions.
+	Device *NetworkDevice `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// Fresh status of the device.
+	Status        *DeviceStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollDeviceResponse) Reset() {
+	*x = PollDeviceResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollDeviceResponse) ProtoMessage() {}
+
+func (x *PollDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollDeviceResponse.ProtoReflect.Descriptor instead.
+func (*PollDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PollDeviceResponse) GetDevice() *NetworkDevice {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *PollDeviceResponse) GetStatus() *DeviceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// CreateMaintenanceWindowRequest carries maintenance window, which should be created.
+type CreateMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *MaintenanceWindow     `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMaintenanceWindowRequest) Reset() {
+	*x = CreateMaintenanceWindowRequest{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *CreateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CreateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateMaintenanceWindowRequest) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// CreateMaintenanceWindowResponse carries created maintenance window with ID assigned internally by the system.
+type CreateMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *MaintenanceWindow     `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMaintenanceWindowResponse) Reset() {
+	*x = CreateMaintenanceWindowResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *CreateMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*CreateMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateMaintenanceWindowResponse) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// UpdateMaintenanceWindowRequest carries maintenance window, which should be updated. Window is identified by its ID.
+// Network devices of the window are replaced, when any of them is set.
+type UpdateMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *MaintenanceWindow     `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMaintenanceWindowRequest) Reset() {
+	*x = UpdateMaintenanceWindowRequest{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *UpdateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateMaintenanceWindowRequest) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// UpdateMaintenanceWindowResponse carries updated maintenance window.
+type UpdateMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *MaintenanceWindow     `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMaintenanceWindowResponse) Reset() {
+	*x = UpdateMaintenanceWindowResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *UpdateMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateMaintenanceWindowResponse) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// ListMaintenanceWindowsResponse carries all maintenance windows.
+type ListMaintenanceWindowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Windows       []*MaintenanceWindow   `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsResponse) Reset() {
+	*x = ListMaintenanceWindowsResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsResponse) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListMaintenanceWindowsResponse) GetWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+// DeleteMaintenanceWindowRequest carries ID of the maintenance window, which should be removed.
+type DeleteMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMaintenanceWindowRequest) Reset() {
+	*x = DeleteMaintenanceWindowRequest{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *DeleteMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteMaintenanceWindowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteMaintenanceWindowResponse carries information about the maintenance window that has been removed.
+type DeleteMaintenanceWindowResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Internal (to the system) ID of the maintenance window.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// A bool variable that indicates the success/failure of the operation.
+	Deleted bool `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// In case of failure, carries additional data, otherwise, empty.
+	Details       *string `protobuf:"bytes,3,opt,name=details,proto3,oneof" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMaintenanceWindowResponse) Reset() {
+	*x = DeleteMaintenanceWindowResponse{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *DeleteMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteMaintenanceWindowResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteMaintenanceWindowResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *DeleteMaintenanceWindowResponse) GetDetails() string {
+	if x != nil && x.Details != nil {
+		return *x.Details
+	}
+	return ""
+}
+
+// NetworkDevice message defines Network device data structure,
+type NetworkDevice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is a device ID assigned internally by the Monitoring service. it is internal to the system.
+	// Later, by this ID, it is possible to retrieve any information about the device.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Network device vendor.
+	Vendor Vendor `protobuf:"varint,2,opt,name=vendor,proto3,enum=api.v1.Vendor" json:"vendor,omitempty"`
+	// Network device model.
+	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	// Network device endpoint. Device may contain several network endpoints (e.g., support of different protocols).
+	Endpoints []*Endpoint `protobuf:"bytes,10,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// HW version (i.e., HW revision, different from model version).
+	HwVersion string `protobuf:"bytes,20,opt,name=hw_version,json=hwVersion,proto3" json:"hw_version,omitempty"` // this is to not require this field to be set, when User creates this resour
+	// SW version (i.e., SW revision).
+	SwVersion *Version `protobuf:"bytes,21,opt,name=sw_version,json=swVersion,proto3" json:"sw_version,omitempty"`
+	// FW version (i.e., FW revision).
+	FwVersion *Version `protobuf:"bytes,22,opt,name=fw_version,json=fwVersion,proto3" json:"fw_version,omitempty"`
+	// Interval (in seconds) between polls of the network device. When unset, default of the device group is used,
+	// then default of the site, and then the control loop period of the monitoring service.
+	PollInterval int32 `protobuf:"varint,30,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// Group (e.g., core routers), which the network device belongs to.
+	Group string `protobuf:"bytes,31,opt,name=group,proto3" json:"group,omitempty"`
+	// Site, where the network device is located.
+	Site string `protobuf:"bytes,32,opt,name=site,proto3" json:"site,omitempty"`
+	// Maintenance windows, which explicitly list the network device. It is managed via maintenance window resource.
+	MaintenanceWindows []*MaintenanceWindow `protobuf:"bytes,40,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows,omitempty"`
+	// Downstream network devices, which depend on the network device (e.g., devices behind the site gateway). Each network
+	// device has at most one parent, children are reported UNREACHABLE instead of DOWN, while their parent is down.
+	// Topology is edited with SetDeviceParent, only IDs of the children are carried in responses.
+	Children      []*NetworkDevice `protobuf:"bytes,41,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkDevice) Reset() {
+	*x = NetworkDevice{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkDevice) ProtoMessage() {}
+
+func (x *NetworkDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkDevice.ProtoReflect.Descriptor instead.
+func (*NetworkDevice) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *NetworkDevice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NetworkDevice) GetVendor() Vendor {
+	if x != nil {
+		return x.Vendor
+	}
+	return Vendor_VENDOR_UNSPECIFIED
+}
+
+func (x *NetworkDevice) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *NetworkDevice) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *NetworkDevice) GetHwVersion() string {
+	if x != nil {
+		return x.HwVersion
+	}
+	return ""
+}
+
+func (x *NetworkDevice) GetSwVersion() *Version {
+	if x != nil {
+		return x.SwVersion
+	}
+	return nil
+}
+
+func (x *NetworkDevice) GetFwVersion() *Version {
+	if x != nil {
+		return x.FwVersion
+	}
+	return nil
+}
+
+func (x *NetworkDevice) GetPollInterval() int32 {
+	if x != nil {
+		return x.PollInterval
+	}
+	return 0
+}
+
+func (x *NetworkDevice) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *NetworkDevice) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *NetworkDevice) GetMaintenanceWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindows
+	}
+	return nil
+}
+
+func (x *NetworkDevice) GetChildren() []*NetworkDevice {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// DeviceStatus reports the status opf the network device including the time when it was last seen in the UP or unhealthy state.
+type DeviceStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the device status resource internally assigned by the controller.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Current status of the Network device.
+	Status Status `protobuf:"varint,2,opt,name=status,proto3,enum=api.v1.Status" json:"status,omitempty"`
+	// A timestamp when the device was last seen in the UP or unhealthy state.
+	LastSeen string `protobuf:"bytes,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"` // originally supposed to be 'google.protobuf.Timestamp', but ent generation made problems for that.
+	// This variable specifies a number of consequential failed attempts to establish connectivity.
+	// Once this number reaches the limit (specified within monitoring service main control loop),
+	// network device is considered to be in down state.
+	ConsequentialFailedConnectivityAttempts int32 `protobuf:"varint,4,opt,name=consequential_failed_connectivity_attempts,json=consequentialFailedConnectivityAttempts,proto3" json:"consequential_failed_connectivity_attempts,omitempty"`
+	// A time (Unix milliseconds) when the device is polled next. Polls of the unreachable device are backed off
+	// exponentially, it is polled at the regular period again, once it recovers.
+	NextPoll int64 `protobuf:"varint,5,opt,name=next_poll,json=nextPoll,proto3" json:"next_poll,omitempty"`
+	// Status, which the device is transitioning to. Transition happens only after a number of consecutive readings
+	// of the same status (hysteresis), unspecified when no transition is pending.
+	PendingStatus Status `protobuf:"varint,6,opt,name=pending_status,json=pendingStatus,proto3,enum=api.v1.Status" json:"pending_status,omitempty"`
+	// Number of consecutive readings of the pending status.
+	PendingReadings int32 `protobuf:"varint,7,opt,name=pending_readings,json=pendingReadings,proto3" json:"pending_readings,omitempty"`
+	// Indicates that the device has changed its status too many times within the flapping window.
+	Flapping bool `protobuf:"varint,8,opt,name=flapping,proto3" json:"flapping,omitempty"`
+	// Indicates that the device was polled within an active maintenance window. Device in maintenance is not reported
+	// DOWN and is not flagged as flapping.
+	InMaintenance bool `protobuf:"varint,9,opt,name=in_maintenance,json=inMaintenance,proto3" json:"in_maintenance,omitempty"`
+	// Internal (to the system) ID of the endpoint, which has answered the last poll. Empty, when none has answered.
+	AnsweredEndpointId string `protobuf:"bytes,11,opt,name=answered_endpoint_id,json=answeredEndpointId,proto3" json:"answered_endpoint_id,omitempty"`
+	// Protocol of the endpoint, which has answered the last poll. Unspecified, when none has answered.
+	AnsweredProtocol Protocol `protobuf:"varint,12,opt,name=answered_protocol,json=answeredProtocol,proto3,enum=api.v1.Protocol" json:"answered_protocol,omitempty"`
+	// Classified error of the last failed poll (e.g., "timeout: ..."), which tells why none of the endpoints has answered.
+	// Empty, when the last poll has succeeded.
+	LastError     string         `protobuf:"bytes,13,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NetworkDevice *NetworkDevice `protobuf:"bytes,10,opt,name=network_device,json=networkDevice,proto3" json:"network_device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceStatus) Reset() {
+	*x = DeviceStatus{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceStatus) ProtoMessage() {}
+
+func (x *DeviceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceStatus.ProtoReflect.Descriptor instead.
+func (*DeviceStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeviceStatus) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeviceStatus) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *DeviceStatus) GetLastSeen() string {
+	if x != nil {
+		return x.LastSeen
+	}
+	return ""
+}
+
+func (x *DeviceStatus) GetConsequentialFailedConnectivityAttempts() int32 {
+	if x != nil {
+		return x.ConsequentialFailedConnectivityAttempts
+	}
+	return 0
+}
+
+func (x *DeviceStatus) GetNextPoll() int64 {
+	if x != nil {
+		return x.NextPoll
+	}
+	return 0
+}
+
+func (x *DeviceStatus) GetPendingStatus() Status {
+	if x != nil {
+		return x.PendingStatus
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *DeviceStatus) GetPendingReadings() int32 {
+	if x != nil {
+		return x.PendingReadings
+	}
+	return 0
+}
+
+func (x *DeviceStatus) GetFlapping() bool {
+	if x != nil {
+		return x.Flapping
+	}
+	return false
+}
+
+func (x *DeviceStatus) GetInMaintenance() bool {
+	if x != nil {
+		return x.InMaintenance
+	}
+	return false
+}
+
+func (x *DeviceStatus) GetAnsweredEndpointId() string {
+	if x != nil {
+		return x.AnsweredEndpointId
+	}
+	return ""
+}
+
+func (x *DeviceStatus) GetAnsweredProtocol() Protocol {
+	if x != nil {
+		return x.AnsweredProtocol
+	}
+	return Protocol_PROTOCOL_UNSPECIFIED
+}
+
+func (x *DeviceStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DeviceStatus) GetNetworkDevice() *NetworkDevice {
+	if x != nil {
+		return x.NetworkDevice
+	}
+	return nil
+}
+
+// StatusEvent records a transition of the network device status. Status events are append-only.
+type StatusEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the status event assigned internally by the Monitoring service.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Status of the network device before the transition.
+	OldStatus Status `protobuf:"varint,2,opt,name=old_status,json=oldStatus,proto3,enum=api.v1.Status" json:"old_status,omitempty"`
+	// Status of the network device after the transition.
+	NewStatus Status `protobuf:"varint,3,opt,name=new_status,json=newStatus,proto3,enum=api.v1.Status" json:"new_status,omitempty"`
+	// A time (Unix milliseconds) of the transition.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Human-readable reason of the transition, e.g., "3 failed attempts".
+	Reason        string         `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	NetworkDevice *NetworkDevice `protobuf:"bytes,10,opt,name=network_device,json=networkDevice,proto3" json:"network_device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusEvent) Reset() {
+	*x = StatusEvent{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusEvent) ProtoMessage() {}
+
+func (x *StatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusEvent.ProtoReflect.Descriptor instead.
+func (*StatusEvent) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *StatusEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetOldStatus() Status {
+	if x != nil {
+		return x.OldStatus
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *StatusEvent) GetNewStatus() Status {
+	if x != nil {
+		return x.NewStatus
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *StatusEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *StatusEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetNetworkDevice() *NetworkDevice {
+	if x != nil {
+		return x.NetworkDevice
+	}
+	return nil
+}
+
+// LatencySample records a duration of the successful exchange with the network device over its endpoint. Latency
+// samples are append-only.
+type LatencySample struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the latency sample assigned internally by the Monitoring service.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// A time (Unix milliseconds) of the exchange.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Duration (in milliseconds) of the exchange.
+	Latency       int64          `protobuf:"varint,3,opt,name=latency,proto3" json:"latency,omitempty"`
+	NetworkDevice *NetworkDevice `protobuf:"bytes,10,opt,name=network_device,json=networkDevice,proto3" json:"network_device,omitempty"`
+	Endpoint      *Endpoint      `protobuf:"bytes,11,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatencySample) Reset() {
+	*x = LatencySample{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatencySample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencySample) ProtoMessage() {}
+
+func (x *LatencySample) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencySample.ProtoReflect.Descriptor instead.
+func (*LatencySample) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LatencySample) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LatencySample) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LatencySample) GetLatency() int64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *LatencySample) GetNetworkDevice() *NetworkDevice {
+	if x != nil {
+		return x.NetworkDevice
+	}
+	return nil
+}
+
+func (x *LatencySample) GetEndpoint() *Endpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+// MaintenanceWindow defines a planned maintenance of the network devices. Window covers network devices, which are
+// listed explicitly, and network devices, which match all set selectors (group and site).
+type MaintenanceWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the maintenance window assigned internally by the Monitoring service.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Human-readable name of the maintenance window.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Selects network devices of the group.
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	// Selects network devices located on the site.
+	Site string `protobuf:"bytes,4,opt,name=site,proto3" json:"site,omitempty"`
+	// A time (Unix milliseconds) when the window starts.
+	StartsAt int64 `protobuf:"varint,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// A time (Unix milliseconds) when the window ends.
+	EndsAt int64 `protobuf:"varint,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Recurrence of the window in cron syntax (e.g., "0 2 * * SUN", UTC unless "CRON_TZ=" is specified). Recurring window
+	// starts at each activation after starts_at and lasts for (ends_at - starts_at).
+	Recurrence string `protobuf:"bytes,7,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Network devices, which are in maintenance. Only their IDs are considered in requests.
+	NetworkDevices []*NetworkDevice `protobuf:"bytes,10,rep,name=network_devices,json=networkDevices,proto3" json:"network_devices,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MaintenanceWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetNetworkDevices() []*NetworkDevice {
+	if x != nil {
+		return x.NetworkDevices
+	}
+	return nil
+}
+
+// EndpointHealth defines health record of the single endpoint, which is updated every time the endpoint is probed.
+type EndpointHealth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the endpoint health resource internally assigned by the controller.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// A time (Unix milliseconds) of the last successful probe of the endpoint.
+	LastSuccess int64 `protobuf:"varint,2,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	// A time (Unix milliseconds) of the last failed probe of the endpoint.
+	LastFailure int64 `protobuf:"varint,3,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	// Error message of the last failed probe of the endpoint.
+	LastError string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Latency (in milliseconds) of the last successful probe of the endpoint.
+	Latency int64 `protobuf:"varint,5,opt,name=latency,proto3" json:"latency,omitempty"`
+	// Number of consecutive failed probes of the endpoint, it is zeroed by the successful one.
+	FailureCount  int32 `protobuf:"varint,6,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndpointHealth) Reset() {
+	*x = EndpointHealth{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndpointHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointHealth) ProtoMessage() {}
+
+func (x *EndpointHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndpointHealth.ProtoReflect.Descriptor instead.
+func (*EndpointHealth) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *EndpointHealth) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EndpointHealth) GetLastSuccess() int64 {
+	if x != nil {
+		return x.LastSuccess
+	}
+	return 0
+}
+
+func (x *EndpointHealth) GetLastFailure() int64 {
+	if x != nil {
+		return x.LastFailure
+	}
+	return 0
+}
+
+func (x *EndpointHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *EndpointHealth) GetLatency() int64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *EndpointHealth) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+// Endpoint defines an endpoint structure.
+type Endpoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the device status resource internally assigned by the controller.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Host address in CIDR form of IP or FQDN, if applicable.
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// Port number, where device health point is reachable.
+	Port string `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	// Supported by the network device protocol for communicating over this endpoint.
+	Protocol Protocol `protobuf:"varint,10,opt,name=protocol,proto3,enum=api.v1.Protocol" json:"protocol,omitempty"`
+	// Credentials used for communicating over this endpoint. It is enough to set an ID of the existing profile,
+	// secrets are never returned.
+	CredentialProfile *CredentialProfile `protobuf:"bytes,11,opt,name=credential_profile,json=credentialProfile,proto3" json:"credential_profile,omitempty"`
+	// TLS settings apply to gNMI, RESTCONF (HTTPS) and OVSDB transports. Client certificate is taken from the credential
+	// profile. Enables TLS over this endpoint.
+	TlsEnabled bool `protobuf:"varint,12,opt,name=tls_enabled,json=tlsEnabled,proto3" json:"tls_enabled,omitempty"`
+	// PEM encoded bundle of CA certificates, which device certificate is verified against. System roots are used,
+	// when unset.
+	TlsCaBundle string `protobuf:"bytes,13,opt,name=tls_ca_bundle,json=tlsCaBundle,proto3" json:"tls_ca_bundle,omitempty"`
+	// Overrides the name, which device certificate is verified against. Host is used by default.
+	TlsServerName string `protobuf:"bytes,14,opt,name=tls_server_name,json=tlsServerName,proto3" json:"tls_server_name,omitempty"`
+	// Disables verification of the device certificate. Meant only for labs.
+	TlsInsecureSkipVerify bool `protobuf:"varint,15,opt,name=tls_insecure_skip_verify,json=tlsInsecureSkipVerify,proto3" json:"tls_insecure_skip_verify,omitempty"`
+	// Preference of the endpoint. Endpoints are probed in ascending order of their preference, endpoints without
+	// preference (i.e., 0) are probed last.
+	Preference int32 `protobuf:"varint,16,opt,name=preference,proto3" json:"preference,omitempty"`
+	// Health of the endpoint. It is maintained by the system, i.e., it is ignored in requests.
+	Health *EndpointHealth `protobuf:"bytes,17,opt,name=health,proto3" json:"health,omitempty"`
+	// Name of the protocol, under which connector is registered. It is required for (and relevant only to)
+	// PROTOCOL_CUSTOM.
+	ProtocolName  string         `protobuf:"bytes,18,opt,name=protocol_name,json=protocolName,proto3" json:"protocol_name,omitempty"`
+	NetworkDevice *NetworkDevice `protobuf:"bytes,50,opt,name=network_device,json=networkDevice,proto3" json:"network_device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Endpoint) Reset() {
+	*x = Endpoint{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Endpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Endpoint) ProtoMessage() {}
+
+func (x *Endpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Endpoint.ProtoReflect.Descriptor instead.
+func (*Endpoint) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Endpoint) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Endpoint) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Endpoint) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *Endpoint) GetProtocol() Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Protocol_PROTOCOL_UNSPECIFIED
+}
+
+func (x *Endpoint) GetCredentialProfile() *CredentialProfile {
+	if x != nil {
+		return x.CredentialProfile
+	}
+	return nil
+}
+
+func (x *Endpoint) GetTlsEnabled() bool {
+	if x != nil {
+		return x.TlsEnabled
+	}
+	return false
+}
+
+func (x *Endpoint) GetTlsCaBundle() string {
+	if x != nil {
+		return x.TlsCaBundle
+	}
+	return ""
+}
+
+func (x *Endpoint) GetTlsServerName() string {
+	if x != nil {
+		return x.TlsServerName
+	}
+	return ""
+}
+
+func (x *Endpoint) GetTlsInsecureSkipVerify() bool {
+	if x != nil {
+		return x.TlsInsecureSkipVerify
+	}
+	return false
+}
+
+func (x *Endpoint) GetPreference() int32 {
+	if x != nil {
+		return x.Preference
+	}
+	return 0
+}
+
+func (x *Endpoint) GetHealth() *EndpointHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *Endpoint) GetProtocolName() string {
+	if x != nil {
+		return x.ProtocolName
+	}
+	return ""
+}
+
+func (x *Endpoint) GetNetworkDevice() *NetworkDevice {
+	if x != nil {
+		return x.NetworkDevice
+	}
+	return nil
+}
+
+// Version message is a generic message for reporting a version.
+type Version struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the device status resource internally assigned by the controller.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// SW/FW Version number.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Checksum of the current revision.
+	Checksum      string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Version) Reset() {
+	*x = Version{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Version) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Version) ProtoMessage() {}
+
+func (x *Version) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Version.ProtoReflect.Descriptor instead.
 func (*Version) Descriptor() ([]byte, []int) {
-	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{16}
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *Version) GetId() string {
@@ -1209,17 +3382,378 @@ func (x *Version) GetChecksum() string {
 	return ""
 }
 
+// CredentialProfile carries credentials for accessing network device endpoints. Secrets are stored encrypted
+// and are accepted only on create and update, i.e., they are never returned by the API.
+type CredentialProfile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the credential profile resource internally assigned by the controller.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Human-readable name of the profile, it is unique within the system.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// User name (SSH, RESTCONF basic authentication, gNMI metadata, or SNMPv3 USM user name).
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// Password (SSH, RESTCONF basic authentication, gNMI metadata), or SNMPv3 authentication passphrase. Secret.
+	Password string `protobuf:"bytes,10,opt,name=password,proto3" json:"password,omitempty"`
+	// SNMPv2c community. Secret.
+	SnmpCommunity string `protobuf:"bytes,11,opt,name=snmp_community,json=snmpCommunity,proto3" json:"snmp_community,omitempty"`
+	// PEM encoded SSH private key. Secret.
+	PrivateKey string `protobuf:"bytes,12,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// RESTCONF bearer token. Secret.
+	Token string `protobuf:"bytes,13,opt,name=token,proto3" json:"token,omitempty"`
+	// SNMPv3 privacy passphrase. Secret.
+	SnmpPrivPassphrase string `protobuf:"bytes,14,opt,name=snmp_priv_passphrase,json=snmpPrivPassphrase,proto3" json:"snmp_priv_passphrase,omitempty"`
+	// PEM encoded private key of the TLS client certificate. Secret.
+	TlsClientKey string `protobuf:"bytes,15,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	// SNMPv3 authentication protocol, e.g., SHA256.
+	SnmpAuthProtocol string `protobuf:"bytes,20,opt,name=snmp_auth_protocol,json=snmpAuthProtocol,proto3" json:"snmp_auth_protocol,omitempty"`
+	// SNMPv3 privacy protocol, e.g., AES.
+	SnmpPrivProtocol string `protobuf:"bytes,21,opt,name=snmp_priv_protocol,json=snmpPrivProtocol,proto3" json:"snmp_priv_protocol,omitempty"`
+	// PEM encoded TLS client certificate presented to the device (mTLS).
+	TlsClientCertificate string `protobuf:"bytes,22,opt,name=tls_client_certificate,json=tlsClientCertificate,proto3" json:"tls_client_certificate,omitempty"`
+	// Trusted SSH host keys of the devices in known_hosts format. Host key of the NETCONF server is verified against
+	// them, it takes precedence over ssh_insecure_ignore_host_key.
+	SshKnownHosts string `protobuf:"bytes,23,opt,name=ssh_known_hosts,json=sshKnownHosts,proto3" json:"ssh_known_hosts,omitempty"`
+	// Disables verification of the SSH host key, when no known hosts are set. Meant only for labs.
+	SshInsecureIgnoreHostKey bool `protobuf:"varint,24,opt,name=ssh_insecure_ignore_host_key,json=sshInsecureIgnoreHostKey,proto3" json:"ssh_insecure_ignore_host_key,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CredentialProfile) Reset() {
+	*x = CredentialProfile{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CredentialProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialProfile) ProtoMessage() {}
+
+func (x *CredentialProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialProfile.ProtoReflect.Descriptor instead.
+func (*CredentialProfile) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CredentialProfile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetSnmpCommunity() string {
+	if x != nil {
+		return x.SnmpCommunity
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetSnmpPrivPassphrase() string {
+	if x != nil {
+		return x.SnmpPrivPassphrase
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetTlsClientKey() string {
+	if x != nil {
+		return x.TlsClientKey
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetSnmpAuthProtocol() string {
+	if x != nil {
+		return x.SnmpAuthProtocol
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetSnmpPrivProtocol() string {
+	if x != nil {
+		return x.SnmpPrivProtocol
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetTlsClientCertificate() string {
+	if x != nil {
+		return x.TlsClientCertificate
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetSshKnownHosts() string {
+	if x != nil {
+		return x.SshKnownHosts
+	}
+	return ""
+}
+
+func (x *CredentialProfile) GetSshInsecureIgnoreHostKey() bool {
+	if x != nil {
+		return x.SshInsecureIgnoreHostKey
+	}
+	return false
+}
+
+// PollingDefault carries default poll interval of all network devices within the group or the site.
+type PollingDefault struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the default assigned internally by the Monitoring service.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Scope of the default.
+	Scope PollingScope `protobuf:"varint,2,opt,name=scope,proto3,enum=api.v1.PollingScope" json:"scope,omitempty"`
+	// Name of the group or the site.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Interval (in seconds) between polls of the network devices.
+	PollInterval  int32 `protobuf:"varint,4,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollingDefault) Reset() {
+	*x = PollingDefault{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollingDefault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollingDefault) ProtoMessage() {}
+
+func (x *PollingDefault) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollingDefault.ProtoReflect.Descriptor instead.
+func (*PollingDefault) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PollingDefault) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PollingDefault) GetScope() PollingScope {
+	if x != nil {
+		return x.Scope
+	}
+	return PollingScope_POLLING_SCOPE_UNSPECIFIED
+}
+
+func (x *PollingDefault) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PollingDefault) GetPollInterval() int32 {
+	if x != nil {
+		return x.PollInterval
+	}
+	return 0
+}
+
+// Lease is an internal resource, which elects a leader among the replicas of the monitoring service. Only the leader
+// runs main control loop. It is not exposed via API.
+type Lease struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the lease.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Identity of the replica, which holds the lease.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// A time (Unix milliseconds) when the lease expires, unless the holder renews it.
+	ExpiresAt     int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lease) Reset() {
+	*x = Lease{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lease) ProtoMessage() {}
+
+func (x *Lease) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
+func (*Lease) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Lease) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Lease) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *Lease) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// ReplicaHeartbeat is an internal resource, which tracks live replicas of the monitoring service. Network devices are
+// sharded among the live replicas. It is not exposed via API.
+type ReplicaHeartbeat struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identity of the replica.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// A time (Unix milliseconds) of the last heartbeat of the replica.
+	LastSeen      int64 `protobuf:"varint,2,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplicaHeartbeat) Reset() {
+	*x = ReplicaHeartbeat{}
+	mi := &file_api_v1_monitoring_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicaHeartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaHeartbeat) ProtoMessage() {}
+
+func (x *ReplicaHeartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_monitoring_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaHeartbeat.ProtoReflect.Descriptor instead.
+func (*ReplicaHeartbeat) Descriptor() ([]byte, []int) {
+	return file_api_v1_monitoring_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReplicaHeartbeat) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReplicaHeartbeat) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
 var File_api_v1_monitoring_proto protoreflect.FileDescriptor
 
 const file_api_v1_monitoring_proto_rawDesc = "" +
 	"\n" +
-	"\x17api/v1/monitoring.proto\x12\x06api.v1\x1a\x15api/v1/ent/opts.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"\xa8\x01\n" +
+	"\x17api/v1/monitoring.proto\x12\x06api.v1\x1a\x15api/v1/ent/opts.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"\x8f\x02\n" +
 	"\x12GetSummaryResponse\x12#\n" +
 	"\rdevices_total\x18\x01 \x01(\x05R\fdevicesTotal\x12\x1d\n" +
 	"\n" +
 	"devices_up\x18\x02 \x01(\x05R\tdevicesUp\x12+\n" +
 	"\x11devices_unhealthy\x18\x03 \x01(\x05R\x10devicesUnhealthy\x12!\n" +
-	"\fdown_devices\x18\x04 \x01(\x05R\vdownDevices\"A\n" +
+	"\fdown_devices\x18\x04 \x01(\x05R\vdownDevices\x124\n" +
+	"\x16devices_in_maintenance\x18\x05 \x01(\x05R\x14devicesInMaintenance\x12/\n" +
+	"\x13devices_unreachable\x18\x06 \x01(\x05R\x12devicesUnreachable\"A\n" +
 	"\x10AddDeviceRequest\x12-\n" +
 	"\x06device\x18\x01 \x01(\v2\x15.api.v1.NetworkDeviceR\x06device\"\x83\x01\n" +
 	"\x11AddDeviceResponse\x12-\n" +
@@ -1254,7 +3788,99 @@ const file_api_v1_monitoring_proto_rawDesc = "" +
 	"\x18UpdateDeviceListResponse\x12/\n" +
 	"\adevices\x18\x01 \x03(\v2\x15.api.v1.NetworkDeviceR\adevices\"H\n" +
 	"\x15GetDeviceListResponse\x12/\n" +
-	"\adevices\x18\x01 \x03(\v2\x15.api.v1.NetworkDeviceR\adevices\"\xb2\x02\n" +
+	"\adevices\x18\x01 \x03(\v2\x15.api.v1.NetworkDeviceR\adevices\"U\n" +
+	"\x1eCreateCredentialProfileRequest\x123\n" +
+	"\aprofile\x18\x01 \x01(\v2\x19.api.v1.CredentialProfileR\aprofile\"V\n" +
+	"\x1fCreateCredentialProfileResponse\x123\n" +
+	"\aprofile\x18\x01 \x01(\v2\x19.api.v1.CredentialProfileR\aprofile\"U\n" +
+	"\x1eUpdateCredentialProfileRequest\x123\n" +
+	"\aprofile\x18\x01 \x01(\v2\x19.api.v1.CredentialProfileR\aprofile\"V\n" +
+	"\x1fUpdateCredentialProfileResponse\x123\n" +
+	"\aprofile\x18\x01 \x01(\v2\x19.api.v1.CredentialProfileR\aprofile\"W\n" +
+	"\x1eListCredentialProfilesResponse\x125\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x19.api.v1.CredentialProfileR\bprofiles\"0\n" +
+	"\x1eDeleteCredentialProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"v\n" +
+	"\x1fDeleteCredentialProfileResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\adeleted\x18\x02 \x01(\bR\adeleted\x12\x1d\n" +
+	"\adetails\x18\x03 \x01(\tH\x00R\adetails\x88\x01\x01B\n" +
+	"\n" +
+	"\b_details\"n\n" +
+	"\x1eListDeviceStatusHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04from\x18\x02 \x01(\x03H\x00R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x03 \x01(\x03H\x01R\x02to\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"^\n" +
+	"\x1fListDeviceStatusHistoryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x06events\x18\x02 \x03(\v2\x13.api.v1.StatusEventR\x06events\"*\n" +
+	"\x18GetEndpointHealthRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"[\n" +
+	"\x19GetEndpointHealthResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x06health\x18\x02 \x01(\v2\x16.api.v1.EndpointHealthR\x06health\"\x9c\x01\n" +
+	"\x16GetLatencyStatsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\vendpoint_id\x18\x02 \x01(\tH\x00R\n" +
+	"endpointId\x88\x01\x01\x12\x17\n" +
+	"\x04from\x18\x03 \x01(\x03H\x01R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x04 \x01(\x03H\x02R\x02to\x88\x01\x01B\x0e\n" +
+	"\f_endpoint_idB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\xac\x01\n" +
+	"\x17GetLatencyStatsResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vendpoint_id\x18\x02 \x01(\tR\n" +
+	"endpointId\x12\x18\n" +
+	"\asamples\x18\x03 \x01(\x05R\asamples\x12\x10\n" +
+	"\x03min\x18\x04 \x01(\x03R\x03min\x12\x10\n" +
+	"\x03avg\x18\x05 \x01(\x01R\x03avg\x12\x10\n" +
+	"\x03p95\x18\x06 \x01(\x03R\x03p95\x12\x10\n" +
+	"\x03max\x18\a \x01(\x03R\x03max\"L\n" +
+	"\x18SetPollingDefaultRequest\x120\n" +
+	"\adefault\x18\x01 \x01(\v2\x16.api.v1.PollingDefaultR\adefault\"M\n" +
+	"\x19SetPollingDefaultResponse\x120\n" +
+	"\adefault\x18\x01 \x01(\v2\x16.api.v1.PollingDefaultR\adefault\"Q\n" +
+	"\x1bListPollingDefaultsResponse\x122\n" +
+	"\bdefaults\x18\x01 \x03(\v2\x16.api.v1.PollingDefaultR\bdefaults\"-\n" +
+	"\x1bDeletePollingDefaultRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"s\n" +
+	"\x1cDeletePollingDefaultResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\adeleted\x18\x02 \x01(\bR\adeleted\x12\x1d\n" +
+	"\adetails\x18\x03 \x01(\tH\x00R\adetails\x88\x01\x01B\n" +
+	"\n" +
+	"\b_details\"E\n" +
+	"\x16SetDeviceParentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"H\n" +
+	"\x17SetDeviceParentResponse\x12-\n" +
+	"\x06device\x18\x01 \x01(\v2\x15.api.v1.NetworkDeviceR\x06device\"#\n" +
+	"\x11PollDeviceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"q\n" +
+	"\x12PollDeviceResponse\x12-\n" +
+	"\x06device\x18\x01 \x01(\v2\x15.api.v1.NetworkDeviceR\x06device\x12,\n" +
+	"\x06status\x18\x02 \x01(\v2\x14.api.v1.DeviceStatusR\x06status\"S\n" +
+	"\x1eCreateMaintenanceWindowRequest\x121\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.api.v1.MaintenanceWindowR\x06window\"T\n" +
+	"\x1fCreateMaintenanceWindowResponse\x121\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.api.v1.MaintenanceWindowR\x06window\"S\n" +
+	"\x1eUpdateMaintenanceWindowRequest\x121\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.api.v1.MaintenanceWindowR\x06window\"T\n" +
+	"\x1fUpdateMaintenanceWindowResponse\x121\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.api.v1.MaintenanceWindowR\x06window\"U\n" +
+	"\x1eListMaintenanceWindowsResponse\x123\n" +
+	"\awindows\x18\x01 \x03(\v2\x19.api.v1.MaintenanceWindowR\awindows\"0\n" +
+	"\x1eDeleteMaintenanceWindowRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"v\n" +
+	"\x1fDeleteMaintenanceWindowResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\adeleted\x18\x02 \x01(\bR\adeleted\x12\x1d\n" +
+	"\adetails\x18\x03 \x01(\tH\x00R\adetails\x88\x01\x01B\n" +
+	"\n" +
+	"\b_details\"\xb5\x04\n" +
 	"\rNetworkDevice\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
 	"\x06vendor\x18\x02 \x01(\x0e2\x0e.api.v1.VendorR\x06vendor\x12\x14\n" +
@@ -1266,51 +3892,169 @@ const file_api_v1_monitoring_proto_rawDesc = "" +
 	"\n" +
 	"sw_version\x18\x15 \x01(\v2\x0f.api.v1.VersionB\x06¦I\x02\b\x01R\tswVersion\x126\n" +
 	"\n" +
-	"fw_version\x18\x16 \x01(\v2\x0f.api.v1.VersionB\x06¦I\x02\b\x01R\tfwVersion:\x06\xba\xa6I\x02\b\x01\"\x96\x02\n" +
+	"fw_version\x18\x16 \x01(\v2\x0f.api.v1.VersionB\x06¦I\x02\b\x01R\tfwVersion\x12+\n" +
+	"\rpoll_interval\x18\x1e \x01(\x05B\x06\xba\xa6I\x02\b\x01R\fpollInterval\x12\x1c\n" +
+	"\x05group\x18\x1f \x01(\tB\x06\xba\xa6I\x02\b\x01R\x05group\x12\x1a\n" +
+	"\x04site\x18  \x01(\tB\x06\xba\xa6I\x02\b\x01R\x04site\x12a\n" +
+	"\x13maintenance_windows\x18( \x03(\v2\x19.api.v1.MaintenanceWindowB\x15¦I\x11\x12\x0fnetwork_devicesR\x12maintenanceWindows\x127\n" +
+	"\bchildren\x18) \x03(\v2\x15.api.v1.NetworkDeviceB\x04¦I\x00R\bchildren:\x06\xba\xa6I\x02\b\x01\"\xa8\x05\n" +
 	"\fDeviceStatus\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x0e.api.v1.StatusR\x06status\x12#\n" +
 	"\tlast_seen\x18\x03 \x01(\tB\x06\xba\xa6I\x02\b\x01R\blastSeen\x12[\n" +
-	"*consequential_failed_connectivity_attempts\x18\x04 \x01(\x05R'consequentialFailedConnectivityAttempts\x12D\n" +
+	"*consequential_failed_connectivity_attempts\x18\x04 \x01(\x05R'consequentialFailedConnectivityAttempts\x12#\n" +
+	"\tnext_poll\x18\x05 \x01(\x03B\x06\xba\xa6I\x02\b\x01R\bnextPoll\x12=\n" +
+	"\x0epending_status\x18\x06 \x01(\x0e2\x0e.api.v1.StatusB\x06\xba\xa6I\x02\b\x01R\rpendingStatus\x121\n" +
+	"\x10pending_readings\x18\a \x01(\x05B\x06\xba\xa6I\x02\b\x01R\x0fpendingReadings\x12\"\n" +
+	"\bflapping\x18\b \x01(\bB\x06\xba\xa6I\x02\b\x01R\bflapping\x12-\n" +
+	"\x0ein_maintenance\x18\t \x01(\bB\x06\xba\xa6I\x02\b\x01R\rinMaintenance\x128\n" +
+	"\x14answered_endpoint_id\x18\v \x01(\tB\x06\xba\xa6I\x02\b\x01R\x12answeredEndpointId\x12E\n" +
+	"\x11answered_protocol\x18\f \x01(\x0e2\x10.api.v1.ProtocolB\x06\xba\xa6I\x02\b\x01R\x10answeredProtocol\x12%\n" +
+	"\n" +
+	"last_error\x18\r \x01(\tB\x06\xba\xa6I\x02\b\x01R\tlastError\x12D\n" +
+	"\x0enetwork_device\x18\n" +
+	" \x01(\v2\x15.api.v1.NetworkDeviceB\x06¦I\x02\b\x01R\rnetworkDevice:\x06\xba\xa6I\x02\b\x01\"\xff\x01\n" +
+	"\vStatusEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\n" +
+	"old_status\x18\x02 \x01(\x0e2\x0e.api.v1.StatusR\toldStatus\x12-\n" +
+	"\n" +
+	"new_status\x18\x03 \x01(\x0e2\x0e.api.v1.StatusR\tnewStatus\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12D\n" +
+	"\x0enetwork_device\x18\n" +
+	" \x01(\v2\x15.api.v1.NetworkDeviceB\x06¦I\x02\b\x01R\rnetworkDevice:\x06\xba\xa6I\x02\b\x01\"\xdb\x01\n" +
+	"\rLatencySample\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12\x18\n" +
+	"\alatency\x18\x03 \x01(\x03R\alatency\x12D\n" +
 	"\x0enetwork_device\x18\n" +
-	" \x01(\v2\x15.api.v1.NetworkDeviceB\x06¦I\x02\b\x01R\rnetworkDevice:\x06\xba\xa6I\x02\b\x01\"\xc9\x01\n" +
+	" \x01(\v2\x15.api.v1.NetworkDeviceB\x06¦I\x02\b\x01R\rnetworkDevice\x124\n" +
+	"\bendpoint\x18\v \x01(\v2\x10.api.v1.EndpointB\x06¦I\x02\b\x01R\bendpoint:\x06\xba\xa6I\x02\b\x01\"\x9d\x02\n" +
+	"\x11MaintenanceWindow\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\x05group\x18\x03 \x01(\tB\x06\xba\xa6I\x02\b\x01R\x05group\x12\x1a\n" +
+	"\x04site\x18\x04 \x01(\tB\x06\xba\xa6I\x02\b\x01R\x04site\x12\x1b\n" +
+	"\tstarts_at\x18\x05 \x01(\x03R\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x06 \x01(\x03R\x06endsAt\x12&\n" +
+	"\n" +
+	"recurrence\x18\a \x01(\tB\x06\xba\xa6I\x02\b\x01R\n" +
+	"recurrence\x12D\n" +
+	"\x0fnetwork_devices\x18\n" +
+	" \x03(\v2\x15.api.v1.NetworkDeviceB\x04¦I\x00R\x0enetworkDevices:\x06\xba\xa6I\x02\b\x01\"\xf4\x01\n" +
+	"\x0eEndpointHealth\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\flast_success\x18\x02 \x01(\x03B\x06\xba\xa6I\x02\b\x01R\vlastSuccess\x12)\n" +
+	"\flast_failure\x18\x03 \x01(\x03B\x06\xba\xa6I\x02\b\x01R\vlastFailure\x12%\n" +
+	"\n" +
+	"last_error\x18\x04 \x01(\tB\x06\xba\xa6I\x02\b\x01R\tlastError\x12 \n" +
+	"\alatency\x18\x05 \x01(\x03B\x06\xba\xa6I\x02\b\x01R\alatency\x12+\n" +
+	"\rfailure_count\x18\x06 \x01(\x05B\x06\xba\xa6I\x02\b\x01R\ffailureCount:\x06\xba\xa6I\x02\b\x01\"\xee\x04\n" +
 	"\bEndpoint\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x03 \x01(\tR\x04port\x12,\n" +
 	"\bprotocol\x18\n" +
-	" \x01(\x0e2\x10.api.v1.ProtocolR\bprotocol\x12O\n" +
+	" \x01(\x0e2\x10.api.v1.ProtocolR\bprotocol\x12P\n" +
+	"\x12credential_profile\x18\v \x01(\v2\x19.api.v1.CredentialProfileB\x06¦I\x02\b\x01R\x11credentialProfile\x12'\n" +
+	"\vtls_enabled\x18\f \x01(\bB\x06\xba\xa6I\x02\b\x01R\n" +
+	"tlsEnabled\x12*\n" +
+	"\rtls_ca_bundle\x18\r \x01(\tB\x06\xba\xa6I\x02\b\x01R\vtlsCaBundle\x12.\n" +
+	"\x0ftls_server_name\x18\x0e \x01(\tB\x06\xba\xa6I\x02\b\x01R\rtlsServerName\x12?\n" +
+	"\x18tls_insecure_skip_verify\x18\x0f \x01(\bB\x06\xba\xa6I\x02\b\x01R\x15tlsInsecureSkipVerify\x12&\n" +
+	"\n" +
+	"preference\x18\x10 \x01(\x05B\x06\xba\xa6I\x02\b\x01R\n" +
+	"preference\x126\n" +
+	"\x06health\x18\x11 \x01(\v2\x16.api.v1.EndpointHealthB\x06¦I\x02\b\x01R\x06health\x12+\n" +
+	"\rprotocol_name\x18\x12 \x01(\tB\x06\xba\xa6I\x02\b\x01R\fprotocolName\x12O\n" +
 	"\x0enetwork_device\x182 \x01(\v2\x15.api.v1.NetworkDeviceB\x11¦I\r\b\x01\x12\tendpointsR\rnetworkDevice:\x06\xba\xa6I\x02\b\x01\"W\n" +
 	"\aVersion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1a\n" +
-	"\bchecksum\x18\x03 \x01(\tR\bchecksum:\x06\xba\xa6I\x02\b\x01*[\n" +
+	"\bchecksum\x18\x03 \x01(\tR\bchecksum:\x06\xba\xa6I\x02\b\x01\"\x9b\x05\n" +
+	"\x11CredentialProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\x04name\x18\x02 \x01(\tB\x06\xba\xa6I\x02\x18\x01R\x04name\x12\"\n" +
+	"\busername\x18\x03 \x01(\tB\x06\xba\xa6I\x02\b\x01R\busername\x12$\n" +
+	"\bpassword\x18\n" +
+	" \x01(\tB\b\xba\xa6I\x04\b\x01 \x01R\bpassword\x12/\n" +
+	"\x0esnmp_community\x18\v \x01(\tB\b\xba\xa6I\x04\b\x01 \x01R\rsnmpCommunity\x12)\n" +
+	"\vprivate_key\x18\f \x01(\tB\b\xba\xa6I\x04\b\x01 \x01R\n" +
+	"privateKey\x12\x1e\n" +
+	"\x05token\x18\r \x01(\tB\b\xba\xa6I\x04\b\x01 \x01R\x05token\x12:\n" +
+	"\x14snmp_priv_passphrase\x18\x0e \x01(\tB\b\xba\xa6I\x04\b\x01 \x01R\x12snmpPrivPassphrase\x12.\n" +
+	"\x0etls_client_key\x18\x0f \x01(\tB\b\xba\xa6I\x04\b\x01 \x01R\ftlsClientKey\x124\n" +
+	"\x12snmp_auth_protocol\x18\x14 \x01(\tB\x06\xba\xa6I\x02\b\x01R\x10snmpAuthProtocol\x124\n" +
+	"\x12snmp_priv_protocol\x18\x15 \x01(\tB\x06\xba\xa6I\x02\b\x01R\x10snmpPrivProtocol\x12<\n" +
+	"\x16tls_client_certificate\x18\x16 \x01(\tB\x06\xba\xa6I\x02\b\x01R\x14tlsClientCertificate\x12.\n" +
+	"\x0fssh_known_hosts\x18\x17 \x01(\tB\x06\xba\xa6I\x02\b\x01R\rsshKnownHosts\x12F\n" +
+	"\x1cssh_insecure_ignore_host_key\x18\x18 \x01(\bB\x06\xba\xa6I\x02\b\x01R\x18sshInsecureIgnoreHostKey:\x06\xba\xa6I\x02\b\x01\"\x8d\x01\n" +
+	"\x0ePollingDefault\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x05scope\x18\x02 \x01(\x0e2\x14.api.v1.PollingScopeR\x05scope\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12#\n" +
+	"\rpoll_interval\x18\x04 \x01(\x05R\fpollInterval:\x06\xba\xa6I\x02\b\x01\"V\n" +
+	"\x05Lease\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06holder\x18\x02 \x01(\tR\x06holder\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt:\x06\xba\xa6I\x02\b\x01\"G\n" +
+	"\x10ReplicaHeartbeat\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tlast_seen\x18\x02 \x01(\x03R\blastSeen:\x06\xba\xa6I\x02\b\x01*[\n" +
 	"\x06Vendor\x12\x16\n" +
 	"\x12VENDOR_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fVENDOR_UBIQUITI\x10\x01\x12\x10\n" +
 	"\fVENDOR_CISCO\x10\x02\x12\x12\n" +
-	"\x0eVENDOR_JUNIPER\x10\x03*k\n" +
+	"\x0eVENDOR_JUNIPER\x10\x03*\x8a\x01\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12STATUS_DEVICE_DOWN\x10\x01\x12\x1b\n" +
 	"\x17STATUS_DEVICE_UNHEALTHY\x10\x02\x12\x14\n" +
-	"\x10STATUS_DEVICE_UP\x10\x03*\x80\x01\n" +
+	"\x10STATUS_DEVICE_UP\x10\x03\x12\x1d\n" +
+	"\x19STATUS_DEVICE_UNREACHABLE\x10\x04*\xd5\x01\n" +
 	"\bProtocol\x12\x18\n" +
 	"\x14PROTOCOL_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rPROTOCOL_SNMP\x10\x01\x12\x14\n" +
 	"\x10PROTOCOL_NETCONF\x10\x02\x12\x15\n" +
 	"\x11PROTOCOL_RESTCONF\x10\x03\x12\x1a\n" +
-	"\x16PROTOCOL_OPEN_V_SWITCH\x10\x042\xa5\a\n" +
+	"\x16PROTOCOL_OPEN_V_SWITCH\x10\x04\x12\x11\n" +
+	"\rPROTOCOL_GNMI\x10\x05\x12\x18\n" +
+	"\x14PROTOCOL_TCP_CONNECT\x10\x06\x12\x11\n" +
+	"\rPROTOCOL_ICMP\x10\a\x12\x13\n" +
+	"\x0fPROTOCOL_CUSTOM\x10\b*^\n" +
+	"\fPollingScope\x12\x1d\n" +
+	"\x19POLLING_SCOPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13POLLING_SCOPE_GROUP\x10\x01\x12\x16\n" +
+	"\x12POLLING_SCOPE_SITE\x10\x022\x8e\x19\n" +
 	"\x17DeviceMonitoringService\x12x\n" +
 	"\x10UpdateDeviceList\x12\x1f.api.v1.UpdateDeviceListRequest\x1a .api.v1.UpdateDeviceListResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/v1/monitoring/devices\x12w\n" +
 	"\x0eSwapDeviceList\x12\x1d.api.v1.SwapDeviceListRequest\x1a\x1e.api.v1.SwapDeviceListResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/monitoring/devices/swap\x12f\n" +
 	"\rGetDeviceList\x12\x16.google.protobuf.Empty\x1a\x1d.api.v1.GetDeviceListResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/monitoring/devices\x12c\n" +
 	"\tAddDevice\x12\x18.api.v1.AddDeviceRequest\x1a\x19.api.v1.AddDeviceResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/monitoring/devices\x12q\n" +
-	"\fDeleteDevice\x12\x1b.api.v1.DeleteDeviceRequest\x1a\x1c.api.v1.DeleteDeviceResponse\"&\x82\xd3\xe4\x93\x02 :\x01**\x1b/v1/monitoring/devices/{id}\x12~\n" +
-	"\x0fGetDeviceStatus\x12\x1e.api.v1.GetDeviceStatusRequest\x1a\x1f.api.v1.GetDeviceStatusResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/monitoring/devices/{id}/status\x12u\n" +
+	"\fDeleteDevice\x12\x1b.api.v1.DeleteDeviceRequest\x1a\x1c.api.v1.DeleteDeviceResponse\"&\x82\xd3\xe4\x93\x02 :\x01**\x1b/v1/monitoring/devices/{id}\x12\x81\x01\n" +
+	"\x0fSetDeviceParent\x12\x1e.api.v1.SetDeviceParentRequest\x1a\x1f.api.v1.SetDeviceParentResponse\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/v1/monitoring/devices/{id}/parent\x12p\n" +
+	"\n" +
+	"PollDevice\x12\x19.api.v1.PollDeviceRequest\x1a\x1a.api.v1.PollDeviceResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/monitoring/devices/{id}:poll\x12~\n" +
+	"\x0fGetDeviceStatus\x12\x1e.api.v1.GetDeviceStatusRequest\x1a\x1f.api.v1.GetDeviceStatusResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/monitoring/devices/{id}/status\x12\x9e\x01\n" +
+	"\x17ListDeviceStatusHistory\x12&.api.v1.ListDeviceStatusHistoryRequest\x1a'.api.v1.ListDeviceStatusHistoryResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v1/monitoring/devices/{id}/status/history\x12\x86\x01\n" +
+	"\x11GetEndpointHealth\x12 .api.v1.GetEndpointHealthRequest\x1a!.api.v1.GetEndpointHealthResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/monitoring/endpoints/{id}/health\x12\x7f\n" +
+	"\x0fGetLatencyStats\x12\x1e.api.v1.GetLatencyStatsRequest\x1a\x1f.api.v1.GetLatencyStatsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/monitoring/devices/{id}/latency\x12u\n" +
 	"\x14GetAllDeviceStatuses\x12\x16.google.protobuf.Empty\x1a$.api.v1.GetAllDeviceStatusesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/monitoring/statuses\x12`\n" +
 	"\n" +
-	"GetSummary\x12\x16.google.protobuf.Empty\x1a\x1a.api.v1.GetSummaryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/monitoring/summaryB<Z:github.com/eroshiva/trade-show-poc/api/v1/monitoring;apiv1b\x06proto3"
+	"GetSummary\x12\x16.google.protobuf.Empty\x1a\x1a.api.v1.GetSummaryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/monitoring/summary\x12\x91\x01\n" +
+	"\x17CreateCredentialProfile\x12&.api.v1.CreateCredentialProfileRequest\x1a'.api.v1.CreateCredentialProfileResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/monitoring/credentials\x12\x9e\x01\n" +
+	"\x17UpdateCredentialProfile\x12&.api.v1.UpdateCredentialProfileRequest\x1a'.api.v1.UpdateCredentialProfileResponse\"2\x82\xd3\xe4\x93\x02,:\x01*2'/v1/monitoring/credentials/{profile.id}\x12|\n" +
+	"\x16ListCredentialProfiles\x12\x16.google.protobuf.Empty\x1a&.api.v1.ListCredentialProfilesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/monitoring/credentials\x12\x93\x01\n" +
+	"\x17DeleteCredentialProfile\x12&.api.v1.DeleteCredentialProfileRequest\x1a'.api.v1.DeleteCredentialProfileResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/v1/monitoring/credentials/{id}\x12\x84\x01\n" +
+	"\x11SetPollingDefault\x12 .api.v1.SetPollingDefaultRequest\x1a!.api.v1.SetPollingDefaultResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/v1/monitoring/polling-defaults\x12{\n" +
+	"\x13ListPollingDefaults\x12\x16.google.protobuf.Empty\x1a#.api.v1.ListPollingDefaultsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/monitoring/polling-defaults\x12\x8f\x01\n" +
+	"\x14DeletePollingDefault\x12#.api.v1.DeletePollingDefaultRequest\x1a$.api.v1.DeletePollingDefaultResponse\",\x82\xd3\xe4\x93\x02&*$/v1/monitoring/polling-defaults/{id}\x12\x99\x01\n" +
+	"\x17CreateMaintenanceWindow\x12&.api.v1.CreateMaintenanceWindowRequest\x1a'.api.v1.CreateMaintenanceWindowResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/monitoring/maintenance-windows\x12\xa5\x01\n" +
+	"\x17UpdateMaintenanceWindow\x12&.api.v1.UpdateMaintenanceWindowRequest\x1a'.api.v1.UpdateMaintenanceWindowResponse\"9\x82\xd3\xe4\x93\x023:\x01*2./v1/monitoring/maintenance-windows/{window.id}\x12\x84\x01\n" +
+	"\x16ListMaintenanceWindows\x12\x16.google.protobuf.Empty\x1a&.api.v1.ListMaintenanceWindowsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/monitoring/maintenance-windows\x12\x9b\x01\n" +
+	"\x17DeleteMaintenanceWindow\x12&.api.v1.DeleteMaintenanceWindowRequest\x1a'.api.v1.DeleteMaintenanceWindowResponse\"/\x82\xd3\xe4\x93\x02)*'/v1/monitoring/maintenance-windows/{id}B<Z:github.com/eroshiva/trade-show-poc/api/v1/monitoring;apiv1b\x06proto3"
 
 var (
 	file_api_v1_monitoring_proto_rawDescOnce sync.Once
@@ -1324,72 +4068,173 @@ func file_api_v1_monitoring_proto_rawDescGZIP() []byte {
 	return file_api_v1_monitoring_proto_rawDescData
 }
 
-var file_api_v1_monitoring_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_api_v1_monitoring_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_api_v1_monitoring_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_v1_monitoring_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_api_v1_monitoring_proto_goTypes = []any{
-	(Vendor)(0),                          // 0: api.v1.Vendor
-	(Status)(0),                          // 1: api.v1.Status
-	(Protocol)(0),                        // 2: api.v1.Protocol
-	(*GetSummaryResponse)(nil),           // 3: api.v1.GetSummaryResponse
-	(*AddDeviceRequest)(nil),             // 4: api.v1.AddDeviceRequest
-	(*AddDeviceResponse)(nil),            // 5: api.v1.AddDeviceResponse
-	(*DeleteDeviceRequest)(nil),          // 6: api.v1.DeleteDeviceRequest
-	(*DeleteDeviceResponse)(nil),         // 7: api.v1.DeleteDeviceResponse
-	(*GetDeviceStatusRequest)(nil),       // 8: api.v1.GetDeviceStatusRequest
-	(*GetDeviceStatusResponse)(nil),      // 9: api.v1.GetDeviceStatusResponse
-	(*GetAllDeviceStatusesResponse)(nil), // 10: api.v1.GetAllDeviceStatusesResponse
-	(*SwapDeviceListRequest)(nil),        // 11: api.v1.SwapDeviceListRequest
-	(*SwapDeviceListResponse)(nil),       // 12: api.v1.SwapDeviceListResponse
-	(*UpdateDeviceListRequest)(nil),      // 13: api.v1.UpdateDeviceListRequest
-	(*UpdateDeviceListResponse)(nil),     // 14: api.v1.UpdateDeviceListResponse
-	(*GetDeviceListResponse)(nil),        // 15: api.v1.GetDeviceListResponse
-	(*NetworkDevice)(nil),                // 16: api.v1.NetworkDevice
-	(*DeviceStatus)(nil),                 // 17: api.v1.DeviceStatus
-	(*Endpoint)(nil),                     // 18: api.v1.Endpoint
-	(*Version)(nil),                      // 19: api.v1.Version
-	(*emptypb.Empty)(nil),                // 20: google.protobuf.Empty
+	(Vendor)(0),                             // 0: api.v1.Vendor
+	(Status)(0),                             // 1: api.v1.Status
+	(Protocol)(0),                           // 2: api.v1.Protocol
+	(PollingScope)(0),                       // 3: api.v1.PollingScope
+	(*GetSummaryResponse)(nil),              // 4: api.v1.GetSummaryResponse
+	(*AddDeviceRequest)(nil),                // 5: api.v1.AddDeviceRequest
+	(*AddDeviceResponse)(nil),               // 6: api.v1.AddDeviceResponse
+	(*DeleteDeviceRequest)(nil),             // 7: api.v1.DeleteDeviceRequest
+	(*DeleteDeviceResponse)(nil),            // 8: api.v1.DeleteDeviceResponse
+	(*GetDeviceStatusRequest)(nil),          // 9: api.v1.GetDeviceStatusRequest
+	(*GetDeviceStatusResponse)(nil),         // 10: api.v1.GetDeviceStatusResponse
+	(*GetAllDeviceStatusesResponse)(nil),    // 11: api.v1.GetAllDeviceStatusesResponse
+	(*SwapDeviceListRequest)(nil),           // 12: api.v1.SwapDeviceListRequest
+	(*SwapDeviceListResponse)(nil),          // 13: api.v1.SwapDeviceListResponse
+	(*UpdateDeviceListRequest)(nil),         // 14: api.v1.UpdateDeviceListRequest
+	(*UpdateDeviceListResponse)(nil),        // 15: api.v1.UpdateDeviceListResponse
+	(*GetDeviceListResponse)(nil),           // 16: api.v1.GetDeviceListResponse
+	(*CreateCredentialProfileRequest)(nil),  // 17: api.v1.CreateCredentialProfileRequest
+	(*CreateCredentialProfileResponse)(nil), // 18: api.v1.CreateCredentialProfileResponse
+	(*UpdateCredentialProfileRequest)(nil),  // 19: api.v1.UpdateCredentialProfileRequest
+	(*UpdateCredentialProfileResponse)(nil), // 20: api.v1.UpdateCredentialProfileResponse
+	(*ListCredentialProfilesResponse)(nil),  // 21: api.v1.ListCredentialProfilesResponse
+	(*DeleteCredentialProfileRequest)(nil),  // 22: api.v1.DeleteCredentialProfileRequest
+	(*DeleteCredentialProfileResponse)(nil), // 23: api.v1.DeleteCredentialProfileResponse
+	(*ListDeviceStatusHistoryRequest)(nil),  // 24: api.v1.ListDeviceStatusHistoryRequest
+	(*ListDeviceStatusHistoryResponse)(nil), // 25: api.v1.ListDeviceStatusHistoryResponse
+	(*GetEndpointHealthRequest)(nil),        // 26: api.v1.GetEndpointHealthRequest
+	(*GetEndpointHealthResponse)(nil),       // 27: api.v1.GetEndpointHealthResponse
+	(*GetLatencyStatsRequest)(nil),          // 28: api.v1.GetLatencyStatsRequest
+	(*GetLatencyStatsResponse)(nil),         // 29: api.v1.GetLatencyStatsResponse
+	(*SetPollingDefaultRequest)(nil),        // 30: api.v1.SetPollingDefaultRequest
+	(*SetPollingDefaultResponse)(nil),       // 31: api.v1.SetPollingDefaultResponse
+	(*ListPollingDefaultsResponse)(nil),     // 32: api.v1.ListPollingDefaultsResponse
+	(*DeletePollingDefaultRequest)(nil),     // 33: api.v1.DeletePollingDefaultRequest
+	(*DeletePollingDefaultResponse)(nil),    // 34: api.v1.DeletePollingDefaultResponse
+	(*SetDeviceParentRequest)(nil),          // 35: api.v1.SetDeviceParentRequest
+	(*SetDeviceParentResponse)(nil),         // 36: api.v1.SetDeviceParentResponse
+	(*PollDeviceRequest)(nil),               // 37: api.v1.PollDeviceRequest
+	(*PollDeviceResponse)(nil),              // 38: api.v1.PollDeviceResponse
+	(*CreateMaintenanceWindowRequest)(nil),  // 39: api.v1.CreateMaintenanceWindowRequest
+	(*CreateMaintenanceWindowResponse)(nil), // 40: api.v1.CreateMaintenanceWindowResponse
+	(*UpdateMaintenanceWindowRequest)(nil),  // 41: api.v1.UpdateMaintenanceWindowRequest
+	(*UpdateMaintenanceWindowResponse)(nil), // 42: api.v1.UpdateMaintenanceWindowResponse
+	(*ListMaintenanceWindowsResponse)(nil),  // 43: api.v1.ListMaintenanceWindowsResponse
+	(*DeleteMaintenanceWindowRequest)(nil),  // 44: api.v1.DeleteMaintenanceWindowRequest
+	(*DeleteMaintenanceWindowResponse)(nil), // 45: api.v1.DeleteMaintenanceWindowResponse
+	(*NetworkDevice)(nil),                   // 46: api.v1.NetworkDevice
+	(*DeviceStatus)(nil),                    // 47: api.v1.DeviceStatus
+	(*StatusEvent)(nil),                     // 48: api.v1.StatusEvent
+	(*LatencySample)(nil),                   // 49: api.v1.LatencySample
+	(*MaintenanceWindow)(nil),               // 50: api.v1.MaintenanceWindow
+	(*EndpointHealth)(nil),                  // 51: api.v1.EndpointHealth
+	(*Endpoint)(nil),                        // 52: api.v1.Endpoint
+	(*Version)(nil),                         // 53: api.v1.Version
+	(*CredentialProfile)(nil),               // 54: api.v1.CredentialProfile
+	(*PollingDefault)(nil),                  // 55: api.v1.PollingDefault
+	(*Lease)(nil),                           // 56: api.v1.Lease
+	(*ReplicaHeartbeat)(nil),                // 57: api.v1.ReplicaHeartbeat
+	(*emptypb.Empty)(nil),                   // 58: google.protobuf.Empty
 }
 var file_api_v1_monitoring_proto_depIdxs = []int32{
-	16, // 0: api.v1.AddDeviceRequest.device:type_name -> api.v1.NetworkDevice
-	16, // 1: api.v1.AddDeviceResponse.device:type_name -> api.v1.NetworkDevice
-	18, // 2: api.v1.GetDeviceStatusRequest.endpoint:type_name -> api.v1.Endpoint
-	18, // 3: api.v1.GetDeviceStatusResponse.endpoint:type_name -> api.v1.Endpoint
-	17, // 4: api.v1.GetDeviceStatusResponse.status:type_name -> api.v1.DeviceStatus
-	17, // 5: api.v1.GetAllDeviceStatusesResponse.statuses:type_name -> api.v1.DeviceStatus
-	16, // 6: api.v1.SwapDeviceListRequest.devices:type_name -> api.v1.NetworkDevice
-	16, // 7: api.v1.SwapDeviceListResponse.devices:type_name -> api.v1.NetworkDevice
-	16, // 8: api.v1.UpdateDeviceListRequest.devices:type_name -> api.v1.NetworkDevice
-	16, // 9: api.v1.UpdateDeviceListResponse.devices:type_name -> api.v1.NetworkDevice
-	16, // 10: api.v1.GetDeviceListResponse.devices:type_name -> api.v1.NetworkDevice
-	0,  // 11: api.v1.NetworkDevice.vendor:type_name -> api.v1.Vendor
-	18, // 12: api.v1.NetworkDevice.endpoints:type_name -> api.v1.Endpoint
-	19, // 13: api.v1.NetworkDevice.sw_version:type_name -> api.v1.Version
-	19, // 14: api.v1.NetworkDevice.fw_version:type_name -> api.v1.Version
-	1,  // 15: api.v1.DeviceStatus.status:type_name -> api.v1.Status
-	16, // 16: api.v1.DeviceStatus.network_device:type_name -> api.v1.NetworkDevice
-	2,  // 17: api.v1.Endpoint.protocol:type_name -> api.v1.Protocol
-	16, // 18: api.v1.Endpoint.network_device:type_name -> api.v1.NetworkDevice
-	13, // 19: api.v1.DeviceMonitoringService.UpdateDeviceList:input_type -> api.v1.UpdateDeviceListRequest
-	11, // 20: api.v1.DeviceMonitoringService.SwapDeviceList:input_type -> api.v1.SwapDeviceListRequest
-	20, // 21: api.v1.DeviceMonitoringService.GetDeviceList:input_type -> google.protobuf.Empty
-	4,  // 22: api.v1.DeviceMonitoringService.AddDevice:input_type -> api.v1.AddDeviceRequest
-	6,  // 23: api.v1.DeviceMonitoringService.DeleteDevice:input_type -> api.v1.DeleteDeviceRequest
-	8,  // 24: api.v1.DeviceMonitoringService.GetDeviceStatus:input_type -> api.v1.GetDeviceStatusRequest
-	20, // 25: api.v1.DeviceMonitoringService.GetAllDeviceStatuses:input_type -> google.protobuf.Empty
-	20, // 26: api.v1.DeviceMonitoringService.GetSummary:input_type -> google.protobuf.Empty
-	14, // 27: api.v1.DeviceMonitoringService.UpdateDeviceList:output_type -> api.v1.UpdateDeviceListResponse
-	12, // 28: api.v1.DeviceMonitoringService.SwapDeviceList:output_type -> api.v1.SwapDeviceListResponse
-	15, // 29: api.v1.DeviceMonitoringService.GetDeviceList:output_type -> api.v1.GetDeviceListResponse
-	5,  // 30: api.v1.DeviceMonitoringService.AddDevice:output_type -> api.v1.AddDeviceResponse
-	7,  // 31: api.v1.DeviceMonitoringService.DeleteDevice:output_type -> api.v1.DeleteDeviceResponse
-	9,  // 32: api.v1.DeviceMonitoringService.GetDeviceStatus:output_type -> api.v1.GetDeviceStatusResponse
-	10, // 33: api.v1.DeviceMonitoringService.GetAllDeviceStatuses:output_type -> api.v1.GetAllDeviceStatusesResponse
-	3,  // 34: api.v1.DeviceMonitoringService.GetSummary:output_type -> api.v1.GetSummaryResponse
-	27, // [27:35] is the sub-list for method output_type
-	19, // [19:27] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	46, // 0: api.v1.AddDeviceRequest.device:type_name -> api.v1.NetworkDevice
+	46, // 1: api.v1.AddDeviceResponse.device:type_name -> api.v1.NetworkDevice
+	52, // 2: api.v1.GetDeviceStatusRequest.endpoint:type_name -> api.v1.Endpoint
+	52, // 3: api.v1.GetDeviceStatusResponse.endpoint:type_name -> api.v1.Endpoint
+	47, // 4: api.v1.GetDeviceStatusResponse.status:type_name -> api.v1.DeviceStatus
+	47, // 5: api.v1.GetAllDeviceStatusesResponse.statuses:type_name -> api.v1.DeviceStatus
+	46, // 6: api.v1.SwapDeviceListRequest.devices:type_name -> api.v1.NetworkDevice
+	46, // 7: api.v1.SwapDeviceListResponse.devices:type_name -> api.v1.NetworkDevice
+	46, // 8: api.v1.UpdateDeviceListRequest.devices:type_name -> api.v1.NetworkDevice
+	46, // 9: api.v1.UpdateDeviceListResponse.devices:type_name -> api.v1.NetworkDevice
+	46, // 10: api.v1.GetDeviceListResponse.devices:type_name -> api.v1.NetworkDevice
+	54, // 11: api.v1.CreateCredentialProfileRequest.profile:type_name -> api.v1.CredentialProfile
+	54, // 12: api.v1.CreateCredentialProfileResponse.profile:type_name -> api.v1.CredentialProfile
+	54, // 13: api.v1.UpdateCredentialProfileRequest.profile:type_name -> api.v1.CredentialProfile
+	54, // 14: api.v1.UpdateCredentialProfileResponse.profile:type_name -> api.v1.CredentialProfile
+	54, // 15: api.v1.ListCredentialProfilesResponse.profiles:type_name -> api.v1.CredentialProfile
+	48, // 16: api.v1.ListDeviceStatusHistoryResponse.events:type_name -> api.v1.StatusEvent
+	51, // 17: api.v1.GetEndpointHealthResponse.health:type_name -> api.v1.EndpointHealth
+	55, // 18: api.v1.SetPollingDefaultRequest.default:type_name -> api.v1.PollingDefault
+	55, // 19: api.v1.SetPollingDefaultResponse.default:type_name -> api.v1.PollingDefault
+	55, // 20: api.v1.ListPollingDefaultsResponse.defaults:type_name -> api.v1.PollingDefault
+	46, // 21: api.v1.SetDeviceParentResponse.device:type_name -> api.v1.NetworkDevice
+	46, // 22: api.v1.PollDeviceResponse.device:type_name -> api.v1.NetworkDevice
+	47, // 23: api.v1.PollDeviceResponse.status:type_name -> api.v1.DeviceStatus
+	50, // 24: api.v1.CreateMaintenanceWindowRequest.window:type_name -> api.v1.MaintenanceWindow
+	50, // 25: api.v1.CreateMaintenanceWindowResponse.window:type_name -> api.v1.MaintenanceWindow
+	50, // 26: api.v1.UpdateMaintenanceWindowRequest.window:type_name -> api.v1.MaintenanceWindow
+	50, // 27: api.v1.UpdateMaintenanceWindowResponse.window:type_name -> api.v1.MaintenanceWindow
+	50, // 28: api.v1.ListMaintenanceWindowsResponse.windows:type_name -> api.v1.MaintenanceWindow
+	0,  // 29: api.v1.NetworkDevice.vendor:type_name -> api.v1.Vendor
+	52, // 30: api.v1.NetworkDevice.endpoints:type_name -> api.v1.Endpoint
+	53, // 31: api.v1.NetworkDevice.sw_version:type_name -> api.v1.Version
+	53, // 32: api.v1.NetworkDevice.fw_version:type_name -> api.v1.Version
+	50, // 33: api.v1.NetworkDevice.maintenance_windows:type_name -> api.v1.MaintenanceWindow
+	46, // 34: api.v1.NetworkDevice.children:type_name -> api.v1.NetworkDevice
+	1,  // 35: api.v1.DeviceStatus.status:type_name -> api.v1.Status
+	1,  // 36: api.v1.DeviceStatus.pending_status:type_name -> api.v1.Status
+	2,  // 37: api.v1.DeviceStatus.answered_protocol:type_name -> api.v1.Protocol
+	46, // 38: api.v1.DeviceStatus.network_device:type_name -> api.v1.NetworkDevice
+	1,  // 39: api.v1.StatusEvent.old_status:type_name -> api.v1.Status
+	1,  // 40: api.v1.StatusEvent.new_status:type_name -> api.v1.Status
+	46, // 41: api.v1.StatusEvent.network_device:type_name -> api.v1.NetworkDevice
+	46, // 42: api.v1.LatencySample.network_device:type_name -> api.v1.NetworkDevice
+	52, // 43: api.v1.LatencySample.endpoint:type_name -> api.v1.Endpoint
+	46, // 44: api.v1.MaintenanceWindow.network_devices:type_name -> api.v1.NetworkDevice
+	2,  // 45: api.v1.Endpoint.protocol:type_name -> api.v1.Protocol
+	54, // 46: api.v1.Endpoint.credential_profile:type_name -> api.v1.CredentialProfile
+	51, // 47: api.v1.Endpoint.health:type_name -> api.v1.EndpointHealth
+	46, // 48: api.v1.Endpoint.network_device:type_name -> api.v1.NetworkDevice
+	3,  // 49: api.v1.PollingDefault.scope:type_name -> api.v1.PollingScope
+	14, // 50: api.v1.DeviceMonitoringService.UpdateDeviceList:input_type -> api.v1.UpdateDeviceListRequest
+	12, // 51: api.v1.DeviceMonitoringService.SwapDeviceList:input_type -> api.v1.SwapDeviceListRequest
+	58, // 52: api.v1.DeviceMonitoringService.GetDeviceList:input_type -> google.protobuf.Empty
+	5,  // 53: api.v1.DeviceMonitoringService.AddDevice:input_type -> api.v1.AddDeviceRequest
+	7,  // 54: api.v1.DeviceMonitoringService.DeleteDevice:input_type -> api.v1.DeleteDeviceRequest
+	35, // 55: api.v1.DeviceMonitoringService.SetDeviceParent:input_type -> api.v1.SetDeviceParentRequest
+	37, // 56: api.v1.DeviceMonitoringService.PollDevice:input_type -> api.v1.PollDeviceRequest
+	9,  // 57: api.v1.DeviceMonitoringService.GetDeviceStatus:input_type -> api.v1.GetDeviceStatusRequest
+	24, // 58: api.v1.DeviceMonitoringService.ListDeviceStatusHistory:input_type -> api.v1.ListDeviceStatusHistoryRequest
+	26, // 59: api.v1.DeviceMonitoringService.GetEndpointHealth:input_type -> api.v1.GetEndpointHealthRequest
+	28, // 60: api.v1.DeviceMonitoringService.GetLatencyStats:input_type -> api.v1.GetLatencyStatsRequest
+	58, // 61: api.v1.DeviceMonitoringService.GetAllDeviceStatuses:input_type -> google.protobuf.Empty
+	58, // 62: api.v1.DeviceMonitoringService.GetSummary:input_type -> google.protobuf.Empty
+	17, // 63: api.v1.DeviceMonitoringService.CreateCredentialProfile:input_type -> api.v1.CreateCredentialProfileRequest
+	19, // 64: api.v1.DeviceMonitoringService.UpdateCredentialProfile:input_type -> api.v1.UpdateCredentialProfileRequest
+	58, // 65: api.v1.DeviceMonitoringService.ListCredentialProfiles:input_type -> google.protobuf.Empty
+	22, // 66: api.v1.DeviceMonitoringService.DeleteCredentialProfile:input_type -> api.v1.DeleteCredentialProfileRequest
+	30, // 67: api.v1.DeviceMonitoringService.SetPollingDefault:input_type -> api.v1.SetPollingDefaultRequest
+	58, // 68: api.v1.DeviceMonitoringService.ListPollingDefaults:input_type -> google.protobuf.Empty
+	33, // 69: api.v1.DeviceMonitoringService.DeletePollingDefault:input_type -> api.v1.DeletePollingDefaultRequest
+	39, // 70: api.v1.DeviceMonitoringService.CreateMaintenanceWindow:input_type -> api.v1.CreateMaintenanceWindowRequest
+	41, // 71: api.v1.DeviceMonitoringService.UpdateMaintenanceWindow:input_type -> api.v1.UpdateMaintenanceWindowRequest
+	58, // 72: api.v1.DeviceMonitoringService.ListMaintenanceWindows:input_type -> google.protobuf.Empty
+	44, // 73: api.v1.DeviceMonitoringService.DeleteMaintenanceWindow:input_type -> api.v1.DeleteMaintenanceWindowRequest
+	15, // 74: api.v1.DeviceMonitoringService.UpdateDeviceList:output_type -> api.v1.UpdateDeviceListResponse
+	13, // 75: api.v1.DeviceMonitoringService.SwapDeviceList:output_type -> api.v1.SwapDeviceListResponse
+	16, // 76: api.v1.DeviceMonitoringService.GetDeviceList:output_type -> api.v1.GetDeviceListResponse
+	6,  // 77: api.v1.DeviceMonitoringService.AddDevice:output_type -> api.v1.AddDeviceResponse
+	8,  // 78: api.v1.DeviceMonitoringService.DeleteDevice:output_type -> api.v1.DeleteDeviceResponse
+	36, // 79: api.v1.DeviceMonitoringService.SetDeviceParent:output_type -> api.v1.SetDeviceParentResponse
+	38, // 80: api.v1.DeviceMonitoringService.PollDevice:output_type -> api.v1.PollDeviceResponse
+	10, // 81: api.v1.DeviceMonitoringService.GetDeviceStatus:output_type -> api.v1.GetDeviceStatusResponse
+	25, // 82: api.v1.DeviceMonitoringService.ListDeviceStatusHistory:output_type -> api.v1.ListDeviceStatusHistoryResponse
+	27, // 83: api.v1.DeviceMonitoringService.GetEndpointHealth:output_type -> api.v1.GetEndpointHealthResponse
+	29, // 84: api.v1.DeviceMonitoringService.GetLatencyStats:output_type -> api.v1.GetLatencyStatsResponse
+	11, // 85: api.v1.DeviceMonitoringService.GetAllDeviceStatuses:output_type -> api.v1.GetAllDeviceStatusesResponse
+	4,  // 86: api.v1.DeviceMonitoringService.GetSummary:output_type -> api.v1.GetSummaryResponse
+	18, // 87: api.v1.DeviceMonitoringService.CreateCredentialProfile:output_type -> api.v1.CreateCredentialProfileResponse
+	20, // 88: api.v1.DeviceMonitoringService.UpdateCredentialProfile:output_type -> api.v1.UpdateCredentialProfileResponse
+	21, // 89: api.v1.DeviceMonitoringService.ListCredentialProfiles:output_type -> api.v1.ListCredentialProfilesResponse
+	23, // 90: api.v1.DeviceMonitoringService.DeleteCredentialProfile:output_type -> api.v1.DeleteCredentialProfileResponse
+	31, // 91: api.v1.DeviceMonitoringService.SetPollingDefault:output_type -> api.v1.SetPollingDefaultResponse
+	32, // 92: api.v1.DeviceMonitoringService.ListPollingDefaults:output_type -> api.v1.ListPollingDefaultsResponse
+	34, // 93: api.v1.DeviceMonitoringService.DeletePollingDefault:output_type -> api.v1.DeletePollingDefaultResponse
+	40, // 94: api.v1.DeviceMonitoringService.CreateMaintenanceWindow:output_type -> api.v1.CreateMaintenanceWindowResponse
+	42, // 95: api.v1.DeviceMonitoringService.UpdateMaintenanceWindow:output_type -> api.v1.UpdateMaintenanceWindowResponse
+	43, // 96: api.v1.DeviceMonitoringService.ListMaintenanceWindows:output_type -> api.v1.ListMaintenanceWindowsResponse
+	45, // 97: api.v1.DeviceMonitoringService.DeleteMaintenanceWindow:output_type -> api.v1.DeleteMaintenanceWindowResponse
+	74, // [74:98] is the sub-list for method output_type
+	50, // [50:74] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_monitoring_proto_init() }
@@ -1399,13 +4244,18 @@ func file_api_v1_monitoring_proto_init() {
 	}
 	file_api_v1_monitoring_proto_msgTypes[2].OneofWrappers = []any{}
 	file_api_v1_monitoring_proto_msgTypes[4].OneofWrappers = []any{}
+	file_api_v1_monitoring_proto_msgTypes[19].OneofWrappers = []any{}
+	file_api_v1_monitoring_proto_msgTypes[20].OneofWrappers = []any{}
+	file_api_v1_monitoring_proto_msgTypes[24].OneofWrappers = []any{}
+	file_api_v1_monitoring_proto_msgTypes[30].OneofWrappers = []any{}
+	file_api_v1_monitoring_proto_msgTypes[41].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_monitoring_proto_rawDesc), len(file_api_v1_monitoring_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   17,
+			NumEnums:      4,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -65,6 +65,10 @@ func (m *GetSummaryResponse) validate(all bool) error {
 
 	// no validation rules for DownDevices
 
+	// no validation rules for DevicesInMaintenance
+
+	// no validation rules for DevicesUnreachable
+
 	if len(errors) > 0 {
 		return GetSummaryResponseMultiError(errors)
 	}
@@ -1737,122 +1741,51 @@ var _ interface {
 	ErrorName() string
 } = GetDeviceListResponseValidationError{}
 
-// Validate checks the field values on NetworkDevice with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *NetworkDevice) Validate() error {
+// Validate checks the field values on CreateCredentialProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCredentialProfileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NetworkDevice with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NetworkDeviceMultiError, or
-// nil if none found.
-func (m *NetworkDevice) ValidateAll() error {
+// ValidateAll checks the field values on CreateCredentialProfileRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateCredentialProfileRequestMultiError, or nil if none found.
+func (m *CreateCredentialProfileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NetworkDevice) validate(all bool) error {
+func (m *CreateCredentialProfileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Vendor
-
-	// no validation rules for Model
-
-	for idx, item := range m.GetEndpoints() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, NetworkDeviceValidationError{
-						field:  fmt.Sprintf("Endpoints[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, NetworkDeviceValidationError{
-						field:  fmt.Sprintf("Endpoints[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return NetworkDeviceValidationError{
-					field:  fmt.Sprintf("Endpoints[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for HwVersion
-
-	if all {
-		switch v := interface{}(m.GetSwVersion()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NetworkDeviceValidationError{
-					field:  "SwVersion",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, NetworkDeviceValidationError{
-					field:  "SwVersion",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSwVersion()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return NetworkDeviceValidationError{
-				field:  "SwVersion",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if all {
-		switch v := interface{}(m.GetFwVersion()).(type) {
+		switch v := interface{}(m.GetProfile()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NetworkDeviceValidationError{
-					field:  "FwVersion",
+				errors = append(errors, CreateCredentialProfileRequestValidationError{
+					field:  "Profile",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, NetworkDeviceValidationError{
-					field:  "FwVersion",
+				errors = append(errors, CreateCredentialProfileRequestValidationError{
+					field:  "Profile",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFwVersion()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return NetworkDeviceValidationError{
-				field:  "FwVersion",
+			return CreateCredentialProfileRequestValidationError{
+				field:  "Profile",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1860,19 +1793,19 @@ func (m *NetworkDevice) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return NetworkDeviceMultiError(errors)
+		return CreateCredentialProfileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// NetworkDeviceMultiError is an error wrapping multiple validation errors
-// returned by NetworkDevice.ValidateAll() if the designated constraints
-// aren't met.
-type NetworkDeviceMultiError []error
+// CreateCredentialProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateCredentialProfileRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateCredentialProfileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NetworkDeviceMultiError) Error() string {
+func (m CreateCredentialProfileRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1881,11 +1814,12 @@ func (m NetworkDeviceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NetworkDeviceMultiError) AllErrors() []error { return m }
+func (m CreateCredentialProfileRequestMultiError) AllErrors() []error { return m }
 
-// NetworkDeviceValidationError is the validation error returned by
-// NetworkDevice.Validate if the designated constraints aren't met.
-type NetworkDeviceValidationError struct {
+// CreateCredentialProfileRequestValidationError is the validation error
+// returned by CreateCredentialProfileRequest.Validate if the designated
+// constraints aren't met.
+type CreateCredentialProfileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1893,22 +1827,24 @@ type NetworkDeviceValidationError struct {
 }
 
 // Field function returns field value.
-func (e NetworkDeviceValidationError) Field() string { return e.field }
+func (e CreateCredentialProfileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NetworkDeviceValidationError) Reason() string { return e.reason }
+func (e CreateCredentialProfileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NetworkDeviceValidationError) Cause() error { return e.cause }
+func (e CreateCredentialProfileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NetworkDeviceValidationError) Key() bool { return e.key }
+func (e CreateCredentialProfileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NetworkDeviceValidationError) ErrorName() string { return "NetworkDeviceValidationError" }
+func (e CreateCredentialProfileRequestValidationError) ErrorName() string {
+	return "CreateCredentialProfileRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NetworkDeviceValidationError) Error() string {
+func (e CreateCredentialProfileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1920,14 +1856,14 @@ func (e NetworkDeviceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNetworkDevice.%s: %s%s",
+		"invalid %sCreateCredentialProfileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NetworkDeviceValidationError{}
+var _ error = CreateCredentialProfileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1935,61 +1871,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NetworkDeviceValidationError{}
+} = CreateCredentialProfileRequestValidationError{}
 
-// Validate checks the field values on DeviceStatus with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DeviceStatus) Validate() error {
+// Validate checks the field values on CreateCredentialProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCredentialProfileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeviceStatus with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeviceStatusMultiError, or
-// nil if none found.
-func (m *DeviceStatus) ValidateAll() error {
+// ValidateAll checks the field values on CreateCredentialProfileResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateCredentialProfileResponseMultiError, or nil if none found.
+func (m *CreateCredentialProfileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeviceStatus) validate(all bool) error {
+func (m *CreateCredentialProfileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Status
-
-	// no validation rules for LastSeen
-
-	// no validation rules for ConsequentialFailedConnectivityAttempts
-
 	if all {
-		switch v := interface{}(m.GetNetworkDevice()).(type) {
+		switch v := interface{}(m.GetProfile()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeviceStatusValidationError{
-					field:  "NetworkDevice",
+				errors = append(errors, CreateCredentialProfileResponseValidationError{
+					field:  "Profile",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeviceStatusValidationError{
-					field:  "NetworkDevice",
+				errors = append(errors, CreateCredentialProfileResponseValidationError{
+					field:  "Profile",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNetworkDevice()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeviceStatusValidationError{
-				field:  "NetworkDevice",
+			return CreateCredentialProfileResponseValidationError{
+				field:  "Profile",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1997,18 +1925,19 @@ func (m *DeviceStatus) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeviceStatusMultiError(errors)
+		return CreateCredentialProfileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeviceStatusMultiError is an error wrapping multiple validation errors
-// returned by DeviceStatus.ValidateAll() if the designated constraints aren't met.
-type DeviceStatusMultiError []error
+// CreateCredentialProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateCredentialProfileResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateCredentialProfileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeviceStatusMultiError) Error() string {
+func (m CreateCredentialProfileResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2017,11 +1946,12 @@ func (m DeviceStatusMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeviceStatusMultiError) AllErrors() []error { return m }
+func (m CreateCredentialProfileResponseMultiError) AllErrors() []error { return m }
 
-// DeviceStatusValidationError is the validation error returned by
-// DeviceStatus.Validate if the designated constraints aren't met.
-type DeviceStatusValidationError struct {
+// CreateCredentialProfileResponseValidationError is the validation error
+// returned by CreateCredentialProfileResponse.Validate if the designated
+// constraints aren't met.
+type CreateCredentialProfileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2029,22 +1959,24 @@ type DeviceStatusValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeviceStatusValidationError) Field() string { return e.field }
+func (e CreateCredentialProfileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeviceStatusValidationError) Reason() string { return e.reason }
+func (e CreateCredentialProfileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeviceStatusValidationError) Cause() error { return e.cause }
+func (e CreateCredentialProfileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeviceStatusValidationError) Key() bool { return e.key }
+func (e CreateCredentialProfileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeviceStatusValidationError) ErrorName() string { return "DeviceStatusValidationError" }
+func (e CreateCredentialProfileResponseValidationError) ErrorName() string {
+	return "CreateCredentialProfileResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeviceStatusValidationError) Error() string {
+func (e CreateCredentialProfileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2056,14 +1988,14 @@ func (e DeviceStatusValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeviceStatus.%s: %s%s",
+		"invalid %sCreateCredentialProfileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeviceStatusValidationError{}
+var _ error = CreateCredentialProfileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2071,61 +2003,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeviceStatusValidationError{}
+} = CreateCredentialProfileResponseValidationError{}
 
-// Validate checks the field values on Endpoint with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Endpoint) Validate() error {
+// Validate checks the field values on UpdateCredentialProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCredentialProfileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Endpoint with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in EndpointMultiError, or nil
-// if none found.
-func (m *Endpoint) ValidateAll() error {
+// ValidateAll checks the field values on UpdateCredentialProfileRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateCredentialProfileRequestMultiError, or nil if none found.
+func (m *UpdateCredentialProfileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Endpoint) validate(all bool) error {
+func (m *UpdateCredentialProfileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Host
-
-	// no validation rules for Port
-
-	// no validation rules for Protocol
-
 	if all {
-		switch v := interface{}(m.GetNetworkDevice()).(type) {
+		switch v := interface{}(m.GetProfile()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, EndpointValidationError{
-					field:  "NetworkDevice",
+				errors = append(errors, UpdateCredentialProfileRequestValidationError{
+					field:  "Profile",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, EndpointValidationError{
-					field:  "NetworkDevice",
+				errors = append(errors, UpdateCredentialProfileRequestValidationError{
+					field:  "Profile",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNetworkDevice()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return EndpointValidationError{
-				field:  "NetworkDevice",
+			return UpdateCredentialProfileRequestValidationError{
+				field:  "Profile",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2133,18 +2057,19 @@ func (m *Endpoint) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return EndpointMultiError(errors)
+		return UpdateCredentialProfileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// EndpointMultiError is an error wrapping multiple validation errors returned
-// by Endpoint.ValidateAll() if the designated constraints aren't met.
-type EndpointMultiError []error
+// UpdateCredentialProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateCredentialProfileRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateCredentialProfileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EndpointMultiError) Error() string {
+func (m UpdateCredentialProfileRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2153,11 +2078,12 @@ func (m EndpointMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EndpointMultiError) AllErrors() []error { return m }
+func (m UpdateCredentialProfileRequestMultiError) AllErrors() []error { return m }
 
-// EndpointValidationError is the validation error returned by
-// Endpoint.Validate if the designated constraints aren't met.
-type EndpointValidationError struct {
+// UpdateCredentialProfileRequestValidationError is the validation error
+// returned by UpdateCredentialProfileRequest.Validate if the designated
+// constraints aren't met.
+type UpdateCredentialProfileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2165,22 +2091,24 @@ type EndpointValidationError struct {
 }
 
 // Field function returns field value.
-func (e EndpointValidationError) Field() string { return e.field }
+func (e UpdateCredentialProfileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EndpointValidationError) Reason() string { return e.reason }
+func (e UpdateCredentialProfileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EndpointValidationError) Cause() error { return e.cause }
+func (e UpdateCredentialProfileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EndpointValidationError) Key() bool { return e.key }
+func (e UpdateCredentialProfileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EndpointValidationError) ErrorName() string { return "EndpointValidationError" }
+func (e UpdateCredentialProfileRequestValidationError) ErrorName() string {
+	return "UpdateCredentialProfileRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e EndpointValidationError) Error() string {
+func (e UpdateCredentialProfileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2192,14 +2120,14 @@ func (e EndpointValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEndpoint.%s: %s%s",
+		"invalid %sUpdateCredentialProfileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EndpointValidationError{}
+var _ error = UpdateCredentialProfileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2207,48 +2135,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EndpointValidationError{}
+} = UpdateCredentialProfileRequestValidationError{}
 
-// Validate checks the field values on Version with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Version) Validate() error {
+// Validate checks the field values on UpdateCredentialProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCredentialProfileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Version with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in VersionMultiError, or nil if none found.
-func (m *Version) ValidateAll() error {
+// ValidateAll checks the field values on UpdateCredentialProfileResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateCredentialProfileResponseMultiError, or nil if none found.
+func (m *UpdateCredentialProfileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Version) validate(all bool) error {
+func (m *UpdateCredentialProfileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Version
-
-	// no validation rules for Checksum
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCredentialProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCredentialProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCredentialProfileResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return VersionMultiError(errors)
+		return UpdateCredentialProfileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// VersionMultiError is an error wrapping multiple validation errors returned
-// by Version.ValidateAll() if the designated constraints aren't met.
-type VersionMultiError []error
+// UpdateCredentialProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateCredentialProfileResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateCredentialProfileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VersionMultiError) Error() string {
+func (m UpdateCredentialProfileResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2257,11 +2210,149 @@ func (m VersionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VersionMultiError) AllErrors() []error { return m }
+func (m UpdateCredentialProfileResponseMultiError) AllErrors() []error { return m }
 
-// VersionValidationError is the validation error returned by Version.Validate
+// UpdateCredentialProfileResponseValidationError is the validation error
+// returned by UpdateCredentialProfileResponse.Validate if the designated
+// constraints aren't met.
+type UpdateCredentialProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCredentialProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCredentialProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCredentialProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCredentialProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCredentialProfileResponseValidationError) ErrorName() string {
+	return "UpdateCredentialProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCredentialProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCredentialProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCredentialProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCredentialProfileResponseValidationError{}
+
+// Validate checks the field values on ListCredentialProfilesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCredentialProfilesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCredentialProfilesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListCredentialProfilesResponseMultiError, or nil if none found.
+func (m *ListCredentialProfilesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCredentialProfilesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProfiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCredentialProfilesResponseValidationError{
+						field:  fmt.Sprintf("Profiles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCredentialProfilesResponseValidationError{
+						field:  fmt.Sprintf("Profiles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCredentialProfilesResponseValidationError{
+					field:  fmt.Sprintf("Profiles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCredentialProfilesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCredentialProfilesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListCredentialProfilesResponse.ValidateAll()
 // if the designated constraints aren't met.
-type VersionValidationError struct {
+type ListCredentialProfilesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCredentialProfilesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCredentialProfilesResponseMultiError) AllErrors() []error { return m }
+
+// ListCredentialProfilesResponseValidationError is the validation error
+// returned by ListCredentialProfilesResponse.Validate if the designated
+// constraints aren't met.
+type ListCredentialProfilesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2269,22 +2360,24 @@ type VersionValidationError struct {
 }
 
 // Field function returns field value.
-func (e VersionValidationError) Field() string { return e.field }
+func (e ListCredentialProfilesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VersionValidationError) Reason() string { return e.reason }
+func (e ListCredentialProfilesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VersionValidationError) Cause() error { return e.cause }
+func (e ListCredentialProfilesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VersionValidationError) Key() bool { return e.key }
+func (e ListCredentialProfilesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VersionValidationError) ErrorName() string { return "VersionValidationError" }
+func (e ListCredentialProfilesResponseValidationError) ErrorName() string {
+	return "ListCredentialProfilesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e VersionValidationError) Error() string {
+func (e ListCredentialProfilesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2296,14 +2389,14 @@ func (e VersionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVersion.%s: %s%s",
+		"invalid %sListCredentialProfilesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VersionValidationError{}
+var _ error = ListCredentialProfilesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2311,4 +2404,4684 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VersionValidationError{}
+} = ListCredentialProfilesResponseValidationError{}
+
+// Validate checks the field values on DeleteCredentialProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCredentialProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCredentialProfileRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteCredentialProfileRequestMultiError, or nil if none found.
+func (m *DeleteCredentialProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCredentialProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteCredentialProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCredentialProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteCredentialProfileRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteCredentialProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCredentialProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCredentialProfileRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCredentialProfileRequestValidationError is the validation error
+// returned by DeleteCredentialProfileRequest.Validate if the designated
+// constraints aren't met.
+type DeleteCredentialProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCredentialProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCredentialProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCredentialProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCredentialProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCredentialProfileRequestValidationError) ErrorName() string {
+	return "DeleteCredentialProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCredentialProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCredentialProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCredentialProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCredentialProfileRequestValidationError{}
+
+// Validate checks the field values on DeleteCredentialProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCredentialProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCredentialProfileResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteCredentialProfileResponseMultiError, or nil if none found.
+func (m *DeleteCredentialProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCredentialProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Deleted
+
+	if m.Details != nil {
+		// no validation rules for Details
+	}
+
+	if len(errors) > 0 {
+		return DeleteCredentialProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCredentialProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteCredentialProfileResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteCredentialProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCredentialProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCredentialProfileResponseMultiError) AllErrors() []error { return m }
+
+// DeleteCredentialProfileResponseValidationError is the validation error
+// returned by DeleteCredentialProfileResponse.Validate if the designated
+// constraints aren't met.
+type DeleteCredentialProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCredentialProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCredentialProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCredentialProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCredentialProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCredentialProfileResponseValidationError) ErrorName() string {
+	return "DeleteCredentialProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCredentialProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCredentialProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCredentialProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCredentialProfileResponseValidationError{}
+
+// Validate checks the field values on ListDeviceStatusHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeviceStatusHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeviceStatusHistoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListDeviceStatusHistoryRequestMultiError, or nil if none found.
+func (m *ListDeviceStatusHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeviceStatusHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.From != nil {
+		// no validation rules for From
+	}
+
+	if m.To != nil {
+		// no validation rules for To
+	}
+
+	if len(errors) > 0 {
+		return ListDeviceStatusHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeviceStatusHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by ListDeviceStatusHistoryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListDeviceStatusHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeviceStatusHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeviceStatusHistoryRequestMultiError) AllErrors() []error { return m }
+
+// ListDeviceStatusHistoryRequestValidationError is the validation error
+// returned by ListDeviceStatusHistoryRequest.Validate if the designated
+// constraints aren't met.
+type ListDeviceStatusHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeviceStatusHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeviceStatusHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeviceStatusHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeviceStatusHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeviceStatusHistoryRequestValidationError) ErrorName() string {
+	return "ListDeviceStatusHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeviceStatusHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeviceStatusHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeviceStatusHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeviceStatusHistoryRequestValidationError{}
+
+// Validate checks the field values on ListDeviceStatusHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeviceStatusHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeviceStatusHistoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListDeviceStatusHistoryResponseMultiError, or nil if none found.
+func (m *ListDeviceStatusHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeviceStatusHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeviceStatusHistoryResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeviceStatusHistoryResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeviceStatusHistoryResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListDeviceStatusHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeviceStatusHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by ListDeviceStatusHistoryResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListDeviceStatusHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeviceStatusHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeviceStatusHistoryResponseMultiError) AllErrors() []error { return m }
+
+// ListDeviceStatusHistoryResponseValidationError is the validation error
+// returned by ListDeviceStatusHistoryResponse.Validate if the designated
+// constraints aren't met.
+type ListDeviceStatusHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeviceStatusHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeviceStatusHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeviceStatusHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeviceStatusHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeviceStatusHistoryResponseValidationError) ErrorName() string {
+	return "ListDeviceStatusHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeviceStatusHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeviceStatusHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeviceStatusHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeviceStatusHistoryResponseValidationError{}
+
+// Validate checks the field values on GetEndpointHealthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEndpointHealthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEndpointHealthRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEndpointHealthRequestMultiError, or nil if none found.
+func (m *GetEndpointHealthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEndpointHealthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetEndpointHealthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEndpointHealthRequestMultiError is an error wrapping multiple validation
+// errors returned by GetEndpointHealthRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetEndpointHealthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEndpointHealthRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEndpointHealthRequestMultiError) AllErrors() []error { return m }
+
+// GetEndpointHealthRequestValidationError is the validation error returned by
+// GetEndpointHealthRequest.Validate if the designated constraints aren't met.
+type GetEndpointHealthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEndpointHealthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEndpointHealthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEndpointHealthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEndpointHealthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEndpointHealthRequestValidationError) ErrorName() string {
+	return "GetEndpointHealthRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEndpointHealthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEndpointHealthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEndpointHealthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEndpointHealthRequestValidationError{}
+
+// Validate checks the field values on GetEndpointHealthResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEndpointHealthResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEndpointHealthResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEndpointHealthResponseMultiError, or nil if none found.
+func (m *GetEndpointHealthResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEndpointHealthResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetHealth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetEndpointHealthResponseValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetEndpointHealthResponseValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetEndpointHealthResponseValidationError{
+				field:  "Health",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetEndpointHealthResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEndpointHealthResponseMultiError is an error wrapping multiple validation
+// errors returned by GetEndpointHealthResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetEndpointHealthResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEndpointHealthResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEndpointHealthResponseMultiError) AllErrors() []error { return m }
+
+// GetEndpointHealthResponseValidationError is the validation error returned by
+// GetEndpointHealthResponse.Validate if the designated constraints aren't met.
+type GetEndpointHealthResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEndpointHealthResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEndpointHealthResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEndpointHealthResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEndpointHealthResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEndpointHealthResponseValidationError) ErrorName() string {
+	return "GetEndpointHealthResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEndpointHealthResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEndpointHealthResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEndpointHealthResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEndpointHealthResponseValidationError{}
+
+// Validate checks the field values on GetLatencyStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLatencyStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLatencyStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLatencyStatsRequestMultiError, or nil if none found.
+func (m *GetLatencyStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLatencyStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.EndpointId != nil {
+		// no validation rules for EndpointId
+	}
+
+	if m.From != nil {
+		// no validation rules for From
+	}
+
+	if m.To != nil {
+		// no validation rules for To
+	}
+
+	if len(errors) > 0 {
+		return GetLatencyStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLatencyStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetLatencyStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetLatencyStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLatencyStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLatencyStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetLatencyStatsRequestValidationError is the validation error returned by
+// GetLatencyStatsRequest.Validate if the designated constraints aren't met.
+type GetLatencyStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLatencyStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLatencyStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLatencyStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLatencyStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLatencyStatsRequestValidationError) ErrorName() string {
+	return "GetLatencyStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLatencyStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLatencyStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLatencyStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLatencyStatsRequestValidationError{}
+
+// Validate checks the field values on GetLatencyStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLatencyStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLatencyStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLatencyStatsResponseMultiError, or nil if none found.
+func (m *GetLatencyStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLatencyStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for EndpointId
+
+	// no validation rules for Samples
+
+	// no validation rules for Min
+
+	// no validation rules for Avg
+
+	// no validation rules for P95
+
+	// no validation rules for Max
+
+	if len(errors) > 0 {
+		return GetLatencyStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLatencyStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetLatencyStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetLatencyStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLatencyStatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLatencyStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetLatencyStatsResponseValidationError is the validation error returned by
+// GetLatencyStatsResponse.Validate if the designated constraints aren't met.
+type GetLatencyStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLatencyStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLatencyStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLatencyStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLatencyStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLatencyStatsResponseValidationError) ErrorName() string {
+	return "GetLatencyStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLatencyStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLatencyStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLatencyStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLatencyStatsResponseValidationError{}
+
+// Validate checks the field values on SetPollingDefaultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetPollingDefaultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetPollingDefaultRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetPollingDefaultRequestMultiError, or nil if none found.
+func (m *SetPollingDefaultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetPollingDefaultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDefault()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetPollingDefaultRequestValidationError{
+					field:  "Default",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetPollingDefaultRequestValidationError{
+					field:  "Default",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDefault()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetPollingDefaultRequestValidationError{
+				field:  "Default",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetPollingDefaultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetPollingDefaultRequestMultiError is an error wrapping multiple validation
+// errors returned by SetPollingDefaultRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetPollingDefaultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetPollingDefaultRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetPollingDefaultRequestMultiError) AllErrors() []error { return m }
+
+// SetPollingDefaultRequestValidationError is the validation error returned by
+// SetPollingDefaultRequest.Validate if the designated constraints aren't met.
+type SetPollingDefaultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetPollingDefaultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetPollingDefaultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetPollingDefaultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetPollingDefaultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetPollingDefaultRequestValidationError) ErrorName() string {
+	return "SetPollingDefaultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetPollingDefaultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetPollingDefaultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetPollingDefaultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetPollingDefaultRequestValidationError{}
+
+// Validate checks the field values on SetPollingDefaultResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetPollingDefaultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetPollingDefaultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetPollingDefaultResponseMultiError, or nil if none found.
+func (m *SetPollingDefaultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetPollingDefaultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDefault()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetPollingDefaultResponseValidationError{
+					field:  "Default",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetPollingDefaultResponseValidationError{
+					field:  "Default",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDefault()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetPollingDefaultResponseValidationError{
+				field:  "Default",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetPollingDefaultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetPollingDefaultResponseMultiError is an error wrapping multiple validation
+// errors returned by SetPollingDefaultResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SetPollingDefaultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetPollingDefaultResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetPollingDefaultResponseMultiError) AllErrors() []error { return m }
+
+// SetPollingDefaultResponseValidationError is the validation error returned by
+// SetPollingDefaultResponse.Validate if the designated constraints aren't met.
+type SetPollingDefaultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetPollingDefaultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetPollingDefaultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetPollingDefaultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetPollingDefaultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetPollingDefaultResponseValidationError) ErrorName() string {
+	return "SetPollingDefaultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetPollingDefaultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetPollingDefaultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetPollingDefaultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetPollingDefaultResponseValidationError{}
+
+// Validate checks the field values on ListPollingDefaultsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPollingDefaultsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPollingDefaultsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPollingDefaultsResponseMultiError, or nil if none found.
+func (m *ListPollingDefaultsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPollingDefaultsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDefaults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPollingDefaultsResponseValidationError{
+						field:  fmt.Sprintf("Defaults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPollingDefaultsResponseValidationError{
+						field:  fmt.Sprintf("Defaults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPollingDefaultsResponseValidationError{
+					field:  fmt.Sprintf("Defaults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPollingDefaultsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPollingDefaultsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPollingDefaultsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPollingDefaultsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPollingDefaultsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPollingDefaultsResponseMultiError) AllErrors() []error { return m }
+
+// ListPollingDefaultsResponseValidationError is the validation error returned
+// by ListPollingDefaultsResponse.Validate if the designated constraints
+// aren't met.
+type ListPollingDefaultsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPollingDefaultsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPollingDefaultsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPollingDefaultsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPollingDefaultsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPollingDefaultsResponseValidationError) ErrorName() string {
+	return "ListPollingDefaultsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPollingDefaultsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPollingDefaultsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPollingDefaultsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPollingDefaultsResponseValidationError{}
+
+// Validate checks the field values on DeletePollingDefaultRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePollingDefaultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePollingDefaultRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePollingDefaultRequestMultiError, or nil if none found.
+func (m *DeletePollingDefaultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePollingDefaultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeletePollingDefaultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePollingDefaultRequestMultiError is an error wrapping multiple
+// validation errors returned by DeletePollingDefaultRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeletePollingDefaultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePollingDefaultRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePollingDefaultRequestMultiError) AllErrors() []error { return m }
+
+// DeletePollingDefaultRequestValidationError is the validation error returned
+// by DeletePollingDefaultRequest.Validate if the designated constraints
+// aren't met.
+type DeletePollingDefaultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePollingDefaultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePollingDefaultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePollingDefaultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePollingDefaultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePollingDefaultRequestValidationError) ErrorName() string {
+	return "DeletePollingDefaultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePollingDefaultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePollingDefaultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePollingDefaultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePollingDefaultRequestValidationError{}
+
+// Validate checks the field values on DeletePollingDefaultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePollingDefaultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePollingDefaultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePollingDefaultResponseMultiError, or nil if none found.
+func (m *DeletePollingDefaultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePollingDefaultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Deleted
+
+	if m.Details != nil {
+		// no validation rules for Details
+	}
+
+	if len(errors) > 0 {
+		return DeletePollingDefaultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePollingDefaultResponseMultiError is an error wrapping multiple
+// validation errors returned by DeletePollingDefaultResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeletePollingDefaultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePollingDefaultResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePollingDefaultResponseMultiError) AllErrors() []error { return m }
+
+// DeletePollingDefaultResponseValidationError is the validation error returned
+// by DeletePollingDefaultResponse.Validate if the designated constraints
+// aren't met.
+type DeletePollingDefaultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePollingDefaultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePollingDefaultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePollingDefaultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePollingDefaultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePollingDefaultResponseValidationError) ErrorName() string {
+	return "DeletePollingDefaultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePollingDefaultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePollingDefaultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePollingDefaultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePollingDefaultResponseValidationError{}
+
+// Validate checks the field values on SetDeviceParentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetDeviceParentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetDeviceParentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetDeviceParentRequestMultiError, or nil if none found.
+func (m *SetDeviceParentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetDeviceParentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ParentId
+
+	if len(errors) > 0 {
+		return SetDeviceParentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetDeviceParentRequestMultiError is an error wrapping multiple validation
+// errors returned by SetDeviceParentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetDeviceParentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetDeviceParentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetDeviceParentRequestMultiError) AllErrors() []error { return m }
+
+// SetDeviceParentRequestValidationError is the validation error returned by
+// SetDeviceParentRequest.Validate if the designated constraints aren't met.
+type SetDeviceParentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetDeviceParentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetDeviceParentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetDeviceParentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetDeviceParentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetDeviceParentRequestValidationError) ErrorName() string {
+	return "SetDeviceParentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetDeviceParentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetDeviceParentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetDeviceParentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetDeviceParentRequestValidationError{}
+
+// Validate checks the field values on SetDeviceParentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetDeviceParentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetDeviceParentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetDeviceParentResponseMultiError, or nil if none found.
+func (m *SetDeviceParentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetDeviceParentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDevice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetDeviceParentResponseValidationError{
+					field:  "Device",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetDeviceParentResponseValidationError{
+					field:  "Device",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDevice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetDeviceParentResponseValidationError{
+				field:  "Device",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetDeviceParentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetDeviceParentResponseMultiError is an error wrapping multiple validation
+// errors returned by SetDeviceParentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetDeviceParentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetDeviceParentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetDeviceParentResponseMultiError) AllErrors() []error { return m }
+
+// SetDeviceParentResponseValidationError is the validation error returned by
+// SetDeviceParentResponse.Validate if the designated constraints aren't met.
+type SetDeviceParentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetDeviceParentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetDeviceParentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetDeviceParentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetDeviceParentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetDeviceParentResponseValidationError) ErrorName() string {
+	return "SetDeviceParentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetDeviceParentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetDeviceParentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetDeviceParentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetDeviceParentResponseValidationError{}
+
+// Validate checks the field values on PollDeviceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PollDeviceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PollDeviceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PollDeviceRequestMultiError, or nil if none found.
+func (m *PollDeviceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PollDeviceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return PollDeviceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PollDeviceRequestMultiError is an error wrapping multiple validation errors
+// returned by PollDeviceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PollDeviceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PollDeviceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PollDeviceRequestMultiError) AllErrors() []error { return m }
+
+// PollDeviceRequestValidationError is the validation error returned by
+// PollDeviceRequest.Validate if the designated constraints aren't met.
+type PollDeviceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PollDeviceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PollDeviceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PollDeviceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PollDeviceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PollDeviceRequestValidationError) ErrorName() string {
+	return "PollDeviceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PollDeviceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPollDeviceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PollDeviceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PollDeviceRequestValidationError{}
+
+// Validate checks the field values on PollDeviceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PollDeviceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PollDeviceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PollDeviceResponseMultiError, or nil if none found.
+func (m *PollDeviceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PollDeviceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDevice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PollDeviceResponseValidationError{
+					field:  "Device",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PollDeviceResponseValidationError{
+					field:  "Device",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDevice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PollDeviceResponseValidationError{
+				field:  "Device",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PollDeviceResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PollDeviceResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PollDeviceResponseValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PollDeviceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PollDeviceResponseMultiError is an error wrapping multiple validation errors
+// returned by PollDeviceResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PollDeviceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PollDeviceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PollDeviceResponseMultiError) AllErrors() []error { return m }
+
+// PollDeviceResponseValidationError is the validation error returned by
+// PollDeviceResponse.Validate if the designated constraints aren't met.
+type PollDeviceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PollDeviceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PollDeviceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PollDeviceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PollDeviceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PollDeviceResponseValidationError) ErrorName() string {
+	return "PollDeviceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PollDeviceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPollDeviceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PollDeviceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PollDeviceResponseValidationError{}
+
+// Validate checks the field values on CreateMaintenanceWindowRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMaintenanceWindowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMaintenanceWindowRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateMaintenanceWindowRequestMultiError, or nil if none found.
+func (m *CreateMaintenanceWindowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMaintenanceWindowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMaintenanceWindowRequestValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMaintenanceWindowRequestValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMaintenanceWindowRequestValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateMaintenanceWindowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMaintenanceWindowRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateMaintenanceWindowRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateMaintenanceWindowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMaintenanceWindowRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMaintenanceWindowRequestMultiError) AllErrors() []error { return m }
+
+// CreateMaintenanceWindowRequestValidationError is the validation error
+// returned by CreateMaintenanceWindowRequest.Validate if the designated
+// constraints aren't met.
+type CreateMaintenanceWindowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMaintenanceWindowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMaintenanceWindowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMaintenanceWindowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMaintenanceWindowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMaintenanceWindowRequestValidationError) ErrorName() string {
+	return "CreateMaintenanceWindowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMaintenanceWindowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMaintenanceWindowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMaintenanceWindowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMaintenanceWindowRequestValidationError{}
+
+// Validate checks the field values on CreateMaintenanceWindowResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMaintenanceWindowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMaintenanceWindowResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateMaintenanceWindowResponseMultiError, or nil if none found.
+func (m *CreateMaintenanceWindowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMaintenanceWindowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMaintenanceWindowResponseValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMaintenanceWindowResponseValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMaintenanceWindowResponseValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateMaintenanceWindowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMaintenanceWindowResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateMaintenanceWindowResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateMaintenanceWindowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMaintenanceWindowResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMaintenanceWindowResponseMultiError) AllErrors() []error { return m }
+
+// CreateMaintenanceWindowResponseValidationError is the validation error
+// returned by CreateMaintenanceWindowResponse.Validate if the designated
+// constraints aren't met.
+type CreateMaintenanceWindowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMaintenanceWindowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMaintenanceWindowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMaintenanceWindowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMaintenanceWindowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMaintenanceWindowResponseValidationError) ErrorName() string {
+	return "CreateMaintenanceWindowResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMaintenanceWindowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMaintenanceWindowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMaintenanceWindowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMaintenanceWindowResponseValidationError{}
+
+// Validate checks the field values on UpdateMaintenanceWindowRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMaintenanceWindowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMaintenanceWindowRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateMaintenanceWindowRequestMultiError, or nil if none found.
+func (m *UpdateMaintenanceWindowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMaintenanceWindowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMaintenanceWindowRequestValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMaintenanceWindowRequestValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMaintenanceWindowRequestValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateMaintenanceWindowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMaintenanceWindowRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateMaintenanceWindowRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateMaintenanceWindowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMaintenanceWindowRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMaintenanceWindowRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMaintenanceWindowRequestValidationError is the validation error
+// returned by UpdateMaintenanceWindowRequest.Validate if the designated
+// constraints aren't met.
+type UpdateMaintenanceWindowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMaintenanceWindowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMaintenanceWindowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMaintenanceWindowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMaintenanceWindowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMaintenanceWindowRequestValidationError) ErrorName() string {
+	return "UpdateMaintenanceWindowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMaintenanceWindowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMaintenanceWindowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMaintenanceWindowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMaintenanceWindowRequestValidationError{}
+
+// Validate checks the field values on UpdateMaintenanceWindowResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMaintenanceWindowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMaintenanceWindowResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateMaintenanceWindowResponseMultiError, or nil if none found.
+func (m *UpdateMaintenanceWindowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMaintenanceWindowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMaintenanceWindowResponseValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMaintenanceWindowResponseValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMaintenanceWindowResponseValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateMaintenanceWindowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMaintenanceWindowResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateMaintenanceWindowResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateMaintenanceWindowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMaintenanceWindowResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMaintenanceWindowResponseMultiError) AllErrors() []error { return m }
+
+// UpdateMaintenanceWindowResponseValidationError is the validation error
+// returned by UpdateMaintenanceWindowResponse.Validate if the designated
+// constraints aren't met.
+type UpdateMaintenanceWindowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMaintenanceWindowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMaintenanceWindowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMaintenanceWindowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMaintenanceWindowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMaintenanceWindowResponseValidationError) ErrorName() string {
+	return "UpdateMaintenanceWindowResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMaintenanceWindowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMaintenanceWindowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMaintenanceWindowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMaintenanceWindowResponseValidationError{}
+
+// Validate checks the field values on ListMaintenanceWindowsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMaintenanceWindowsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMaintenanceWindowsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListMaintenanceWindowsResponseMultiError, or nil if none found.
+func (m *ListMaintenanceWindowsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMaintenanceWindowsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWindows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMaintenanceWindowsResponseValidationError{
+						field:  fmt.Sprintf("Windows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMaintenanceWindowsResponseValidationError{
+						field:  fmt.Sprintf("Windows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMaintenanceWindowsResponseValidationError{
+					field:  fmt.Sprintf("Windows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMaintenanceWindowsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMaintenanceWindowsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMaintenanceWindowsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListMaintenanceWindowsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMaintenanceWindowsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMaintenanceWindowsResponseMultiError) AllErrors() []error { return m }
+
+// ListMaintenanceWindowsResponseValidationError is the validation error
+// returned by ListMaintenanceWindowsResponse.Validate if the designated
+// constraints aren't met.
+type ListMaintenanceWindowsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMaintenanceWindowsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMaintenanceWindowsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMaintenanceWindowsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMaintenanceWindowsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMaintenanceWindowsResponseValidationError) ErrorName() string {
+	return "ListMaintenanceWindowsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMaintenanceWindowsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMaintenanceWindowsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMaintenanceWindowsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMaintenanceWindowsResponseValidationError{}
+
+// Validate checks the field values on DeleteMaintenanceWindowRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMaintenanceWindowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMaintenanceWindowRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteMaintenanceWindowRequestMultiError, or nil if none found.
+func (m *DeleteMaintenanceWindowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMaintenanceWindowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteMaintenanceWindowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMaintenanceWindowRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteMaintenanceWindowRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteMaintenanceWindowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMaintenanceWindowRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMaintenanceWindowRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMaintenanceWindowRequestValidationError is the validation error
+// returned by DeleteMaintenanceWindowRequest.Validate if the designated
+// constraints aren't met.
+type DeleteMaintenanceWindowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMaintenanceWindowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMaintenanceWindowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMaintenanceWindowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMaintenanceWindowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMaintenanceWindowRequestValidationError) ErrorName() string {
+	return "DeleteMaintenanceWindowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMaintenanceWindowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMaintenanceWindowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMaintenanceWindowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMaintenanceWindowRequestValidationError{}
+
+// Validate checks the field values on DeleteMaintenanceWindowResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMaintenanceWindowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMaintenanceWindowResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteMaintenanceWindowResponseMultiError, or nil if none found.
+func (m *DeleteMaintenanceWindowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMaintenanceWindowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Deleted
+
+	if m.Details != nil {
+		// no validation rules for Details
+	}
+
+	if len(errors) > 0 {
+		return DeleteMaintenanceWindowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMaintenanceWindowResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteMaintenanceWindowResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteMaintenanceWindowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMaintenanceWindowResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMaintenanceWindowResponseMultiError) AllErrors() []error { return m }
+
+// DeleteMaintenanceWindowResponseValidationError is the validation error
+// returned by DeleteMaintenanceWindowResponse.Validate if the designated
+// constraints aren't met.
+type DeleteMaintenanceWindowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMaintenanceWindowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMaintenanceWindowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMaintenanceWindowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMaintenanceWindowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMaintenanceWindowResponseValidationError) ErrorName() string {
+	return "DeleteMaintenanceWindowResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMaintenanceWindowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMaintenanceWindowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMaintenanceWindowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMaintenanceWindowResponseValidationError{}
+
+// Validate checks the field values on NetworkDevice with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NetworkDevice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NetworkDevice with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NetworkDeviceMultiError, or
+// nil if none found.
+func (m *NetworkDevice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NetworkDevice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Vendor
+
+	// no validation rules for Model
+
+	for idx, item := range m.GetEndpoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NetworkDeviceValidationError{
+						field:  fmt.Sprintf("Endpoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NetworkDeviceValidationError{
+						field:  fmt.Sprintf("Endpoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NetworkDeviceValidationError{
+					field:  fmt.Sprintf("Endpoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for HwVersion
+
+	if all {
+		switch v := interface{}(m.GetSwVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NetworkDeviceValidationError{
+					field:  "SwVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NetworkDeviceValidationError{
+					field:  "SwVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSwVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NetworkDeviceValidationError{
+				field:  "SwVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFwVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NetworkDeviceValidationError{
+					field:  "FwVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NetworkDeviceValidationError{
+					field:  "FwVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFwVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NetworkDeviceValidationError{
+				field:  "FwVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PollInterval
+
+	// no validation rules for Group
+
+	// no validation rules for Site
+
+	for idx, item := range m.GetMaintenanceWindows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NetworkDeviceValidationError{
+						field:  fmt.Sprintf("MaintenanceWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NetworkDeviceValidationError{
+						field:  fmt.Sprintf("MaintenanceWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NetworkDeviceValidationError{
+					field:  fmt.Sprintf("MaintenanceWindows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NetworkDeviceValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NetworkDeviceValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NetworkDeviceValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return NetworkDeviceMultiError(errors)
+	}
+
+	return nil
+}
+
+// NetworkDeviceMultiError is an error wrapping multiple validation errors
+// returned by NetworkDevice.ValidateAll() if the designated constraints
+// aren't met.
+type NetworkDeviceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NetworkDeviceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NetworkDeviceMultiError) AllErrors() []error { return m }
+
+// NetworkDeviceValidationError is the validation error returned by
+// NetworkDevice.Validate if the designated constraints aren't met.
+type NetworkDeviceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NetworkDeviceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NetworkDeviceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NetworkDeviceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NetworkDeviceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NetworkDeviceValidationError) ErrorName() string { return "NetworkDeviceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NetworkDeviceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNetworkDevice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NetworkDeviceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NetworkDeviceValidationError{}
+
+// Validate checks the field values on DeviceStatus with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeviceStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeviceStatus with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeviceStatusMultiError, or
+// nil if none found.
+func (m *DeviceStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeviceStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for LastSeen
+
+	// no validation rules for ConsequentialFailedConnectivityAttempts
+
+	// no validation rules for NextPoll
+
+	// no validation rules for PendingStatus
+
+	// no validation rules for PendingReadings
+
+	// no validation rules for Flapping
+
+	// no validation rules for InMaintenance
+
+	// no validation rules for AnsweredEndpointId
+
+	// no validation rules for AnsweredProtocol
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetNetworkDevice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeviceStatusValidationError{
+					field:  "NetworkDevice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeviceStatusValidationError{
+					field:  "NetworkDevice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNetworkDevice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeviceStatusValidationError{
+				field:  "NetworkDevice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeviceStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeviceStatusMultiError is an error wrapping multiple validation errors
+// returned by DeviceStatus.ValidateAll() if the designated constraints aren't met.
+type DeviceStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeviceStatusMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeviceStatusMultiError) AllErrors() []error { return m }
+
+// DeviceStatusValidationError is the validation error returned by
+// DeviceStatus.Validate if the designated constraints aren't met.
+type DeviceStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeviceStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeviceStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeviceStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeviceStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeviceStatusValidationError) ErrorName() string { return "DeviceStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeviceStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeviceStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeviceStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeviceStatusValidationError{}
+
+// Validate checks the field values on StatusEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatusEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatusEventMultiError, or
+// nil if none found.
+func (m *StatusEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OldStatus
+
+	// no validation rules for NewStatus
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetNetworkDevice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatusEventValidationError{
+					field:  "NetworkDevice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatusEventValidationError{
+					field:  "NetworkDevice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNetworkDevice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatusEventValidationError{
+				field:  "NetworkDevice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StatusEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatusEventMultiError is an error wrapping multiple validation errors
+// returned by StatusEvent.ValidateAll() if the designated constraints aren't met.
+type StatusEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusEventMultiError) AllErrors() []error { return m }
+
+// StatusEventValidationError is the validation error returned by
+// StatusEvent.Validate if the designated constraints aren't met.
+type StatusEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusEventValidationError) ErrorName() string { return "StatusEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatusEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusEventValidationError{}
+
+// Validate checks the field values on LatencySample with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LatencySample) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LatencySample with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LatencySampleMultiError, or
+// nil if none found.
+func (m *LatencySample) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LatencySample) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Latency
+
+	if all {
+		switch v := interface{}(m.GetNetworkDevice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LatencySampleValidationError{
+					field:  "NetworkDevice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LatencySampleValidationError{
+					field:  "NetworkDevice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNetworkDevice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LatencySampleValidationError{
+				field:  "NetworkDevice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndpoint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LatencySampleValidationError{
+					field:  "Endpoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LatencySampleValidationError{
+					field:  "Endpoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndpoint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LatencySampleValidationError{
+				field:  "Endpoint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LatencySampleMultiError(errors)
+	}
+
+	return nil
+}
+
+// LatencySampleMultiError is an error wrapping multiple validation errors
+// returned by LatencySample.ValidateAll() if the designated constraints
+// aren't met.
+type LatencySampleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LatencySampleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LatencySampleMultiError) AllErrors() []error { return m }
+
+// LatencySampleValidationError is the validation error returned by
+// LatencySample.Validate if the designated constraints aren't met.
+type LatencySampleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LatencySampleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LatencySampleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LatencySampleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LatencySampleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LatencySampleValidationError) ErrorName() string { return "LatencySampleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LatencySampleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLatencySample.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LatencySampleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LatencySampleValidationError{}
+
+// Validate checks the field values on MaintenanceWindow with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MaintenanceWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MaintenanceWindow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MaintenanceWindowMultiError, or nil if none found.
+func (m *MaintenanceWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MaintenanceWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Group
+
+	// no validation rules for Site
+
+	// no validation rules for StartsAt
+
+	// no validation rules for EndsAt
+
+	// no validation rules for Recurrence
+
+	for idx, item := range m.GetNetworkDevices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MaintenanceWindowValidationError{
+						field:  fmt.Sprintf("NetworkDevices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MaintenanceWindowValidationError{
+						field:  fmt.Sprintf("NetworkDevices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MaintenanceWindowValidationError{
+					field:  fmt.Sprintf("NetworkDevices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MaintenanceWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// MaintenanceWindowMultiError is an error wrapping multiple validation errors
+// returned by MaintenanceWindow.ValidateAll() if the designated constraints
+// aren't met.
+type MaintenanceWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MaintenanceWindowMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MaintenanceWindowMultiError) AllErrors() []error { return m }
+
+// MaintenanceWindowValidationError is the validation error returned by
+// MaintenanceWindow.Validate if the designated constraints aren't met.
+type MaintenanceWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MaintenanceWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MaintenanceWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MaintenanceWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MaintenanceWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MaintenanceWindowValidationError) ErrorName() string {
+	return "MaintenanceWindowValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MaintenanceWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMaintenanceWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MaintenanceWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MaintenanceWindowValidationError{}
+
+// Validate checks the field values on EndpointHealth with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EndpointHealth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EndpointHealth with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EndpointHealthMultiError,
+// or nil if none found.
+func (m *EndpointHealth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EndpointHealth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for LastSuccess
+
+	// no validation rules for LastFailure
+
+	// no validation rules for LastError
+
+	// no validation rules for Latency
+
+	// no validation rules for FailureCount
+
+	if len(errors) > 0 {
+		return EndpointHealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// EndpointHealthMultiError is an error wrapping multiple validation errors
+// returned by EndpointHealth.ValidateAll() if the designated constraints
+// aren't met.
+type EndpointHealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EndpointHealthMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EndpointHealthMultiError) AllErrors() []error { return m }
+
+// EndpointHealthValidationError is the validation error returned by
+// EndpointHealth.Validate if the designated constraints aren't met.
+type EndpointHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndpointHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndpointHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndpointHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndpointHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndpointHealthValidationError) ErrorName() string { return "EndpointHealthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EndpointHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndpointHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndpointHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndpointHealthValidationError{}
+
+// Validate checks the field values on Endpoint with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Endpoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Endpoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EndpointMultiError, or nil
+// if none found.
+func (m *Endpoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Endpoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Host
+
+	// no validation rules for Port
+
+	// no validation rules for Protocol
+
+	if all {
+		switch v := interface{}(m.GetCredentialProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EndpointValidationError{
+					field:  "CredentialProfile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EndpointValidationError{
+					field:  "CredentialProfile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCredentialProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EndpointValidationError{
+				field:  "CredentialProfile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TlsEnabled
+
+	// no validation rules for TlsCaBundle
+
+	// no validation rules for TlsServerName
+
+	// no validation rules for TlsInsecureSkipVerify
+
+	// no validation rules for Preference
+
+	if all {
+		switch v := interface{}(m.GetHealth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EndpointValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EndpointValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EndpointValidationError{
+				field:  "Health",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ProtocolName
+
+	if all {
+		switch v := interface{}(m.GetNetworkDevice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EndpointValidationError{
+					field:  "NetworkDevice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EndpointValidationError{
+					field:  "NetworkDevice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNetworkDevice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EndpointValidationError{
+				field:  "NetworkDevice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EndpointMultiError(errors)
+	}
+
+	return nil
+}
+
+// EndpointMultiError is an error wrapping multiple validation errors returned
+// by Endpoint.ValidateAll() if the designated constraints aren't met.
+type EndpointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EndpointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EndpointMultiError) AllErrors() []error { return m }
+
+// EndpointValidationError is the validation error returned by
+// Endpoint.Validate if the designated constraints aren't met.
+type EndpointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndpointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndpointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndpointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndpointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndpointValidationError) ErrorName() string { return "EndpointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EndpointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndpoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndpointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndpointValidationError{}
+
+// Validate checks the field values on Version with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Version) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Version with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in VersionMultiError, or nil if none found.
+func (m *Version) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Version) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Version
+
+	// no validation rules for Checksum
+
+	if len(errors) > 0 {
+		return VersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// VersionMultiError is an error wrapping multiple validation errors returned
+// by Version.ValidateAll() if the designated constraints aren't met.
+type VersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VersionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VersionMultiError) AllErrors() []error { return m }
+
+// VersionValidationError is the validation error returned by Version.Validate
+// if the designated constraints aren't met.
+type VersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VersionValidationError) ErrorName() string { return "VersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VersionValidationError{}
+
+// Validate checks the field values on CredentialProfile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CredentialProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CredentialProfile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CredentialProfileMultiError, or nil if none found.
+func (m *CredentialProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CredentialProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for SnmpCommunity
+
+	// no validation rules for PrivateKey
+
+	// no validation rules for Token
+
+	// no validation rules for SnmpPrivPassphrase
+
+	// no validation rules for TlsClientKey
+
+	// no validation rules for SnmpAuthProtocol
+
+	// no validation rules for SnmpPrivProtocol
+
+	// no validation rules for TlsClientCertificate
+
+	// no validation rules for SshKnownHosts
+
+	// no validation rules for SshInsecureIgnoreHostKey
+
+	if len(errors) > 0 {
+		return CredentialProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// CredentialProfileMultiError is an error wrapping multiple validation errors
+// returned by CredentialProfile.ValidateAll() if the designated constraints
+// aren't met.
+type CredentialProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CredentialProfileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CredentialProfileMultiError) AllErrors() []error { return m }
+
+// CredentialProfileValidationError is the validation error returned by
+// CredentialProfile.Validate if the designated constraints aren't met.
+type CredentialProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CredentialProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CredentialProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CredentialProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CredentialProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CredentialProfileValidationError) ErrorName() string {
+	return "CredentialProfileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CredentialProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCredentialProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CredentialProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CredentialProfileValidationError{}
+
+// Validate checks the field values on PollingDefault with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PollingDefault) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PollingDefault with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PollingDefaultMultiError,
+// or nil if none found.
+func (m *PollingDefault) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PollingDefault) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Scope
+
+	// no validation rules for Name
+
+	// no validation rules for PollInterval
+
+	if len(errors) > 0 {
+		return PollingDefaultMultiError(errors)
+	}
+
+	return nil
+}
+
+// PollingDefaultMultiError is an error wrapping multiple validation errors
+// returned by PollingDefault.ValidateAll() if the designated constraints
+// aren't met.
+type PollingDefaultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PollingDefaultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PollingDefaultMultiError) AllErrors() []error { return m }
+
+// PollingDefaultValidationError is the validation error returned by
+// PollingDefault.Validate if the designated constraints aren't met.
+type PollingDefaultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PollingDefaultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PollingDefaultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PollingDefaultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PollingDefaultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PollingDefaultValidationError) ErrorName() string { return "PollingDefaultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PollingDefaultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPollingDefault.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PollingDefaultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PollingDefaultValidationError{}
+
+// Validate checks the field values on Lease with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Lease) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Lease with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LeaseMultiError, or nil if none found.
+func (m *Lease) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Lease) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Holder
+
+	// no validation rules for ExpiresAt
+
+	if len(errors) > 0 {
+		return LeaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaseMultiError is an error wrapping multiple validation errors returned by
+// Lease.ValidateAll() if the designated constraints aren't met.
+type LeaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaseMultiError) AllErrors() []error { return m }
+
+// LeaseValidationError is the validation error returned by Lease.Validate if
+// the designated constraints aren't met.
+type LeaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaseValidationError) ErrorName() string { return "LeaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LeaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLease.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaseValidationError{}
+
+// Validate checks the field values on ReplicaHeartbeat with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReplicaHeartbeat) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplicaHeartbeat with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplicaHeartbeatMultiError, or nil if none found.
+func (m *ReplicaHeartbeat) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplicaHeartbeat) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for LastSeen
+
+	if len(errors) > 0 {
+		return ReplicaHeartbeatMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplicaHeartbeatMultiError is an error wrapping multiple validation errors
+// returned by ReplicaHeartbeat.ValidateAll() if the designated constraints
+// aren't met.
+type ReplicaHeartbeatMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplicaHeartbeatMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplicaHeartbeatMultiError) AllErrors() []error { return m }
+
+// ReplicaHeartbeatValidationError is the validation error returned by
+// ReplicaHeartbeat.Validate if the designated constraints aren't met.
+type ReplicaHeartbeatValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplicaHeartbeatValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplicaHeartbeatValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplicaHeartbeatValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplicaHeartbeatValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplicaHeartbeatValidationError) ErrorName() string { return "ReplicaHeartbeatValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReplicaHeartbeatValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplicaHeartbeat.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplicaHeartbeatValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplicaHeartbeatValidationError{}
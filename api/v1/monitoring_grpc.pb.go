@@ -20,14 +20,30 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DeviceMonitoringService_UpdateDeviceList_FullMethodName     = "/api.v1.DeviceMonitoringService/UpdateDeviceList"
-	DeviceMonitoringService_SwapDeviceList_FullMethodName       = "/api.v1.DeviceMonitoringService/SwapDeviceList"
-	DeviceMonitoringService_GetDeviceList_FullMethodName        = "/api.v1.DeviceMonitoringService/GetDeviceList"
-	DeviceMonitoringService_AddDevice_FullMethodName            = "/api.v1.DeviceMonitoringService/AddDevice"
-	DeviceMonitoringService_DeleteDevice_FullMethodName         = "/api.v1.DeviceMonitoringService/DeleteDevice"
-	DeviceMonitoringService_GetDeviceStatus_FullMethodName      = "/api.v1.DeviceMonitoringService/GetDeviceStatus"
-	DeviceMonitoringService_GetAllDeviceStatuses_FullMethodName = "/api.v1.DeviceMonitoringService/GetAllDeviceStatuses"
-	DeviceMonitoringService_GetSummary_FullMethodName           = "/api.v1.DeviceMonitoringService/GetSummary"
+	DeviceMonitoringService_UpdateDeviceList_FullMethodName        = "/api.v1.DeviceMonitoringService/UpdateDeviceList"
+	DeviceMonitoringService_SwapDeviceList_FullMethodName          = "/api.v1.DeviceMonitoringService/SwapDeviceList"
+	DeviceMonitoringService_GetDeviceList_FullMethodName           = "/api.v1.DeviceMonitoringService/GetDeviceList"
+	DeviceMonitoringService_AddDevice_FullMethodName               = "/api.v1.DeviceMonitoringService/AddDevice"
+	DeviceMonitoringService_DeleteDevice_FullMethodName            = "/api.v1.DeviceMonitoringService/DeleteDevice"
+	DeviceMonitoringService_SetDeviceParent_FullMethodName         = "/api.v1.DeviceMonitoringService/SetDeviceParent"
+	DeviceMonitoringService_PollDevice_FullMethodName              = "/api.v1.DeviceMonitoringService/PollDevice"
+	DeviceMonitoringService_GetDeviceStatus_FullMethodName         = "/api.v1.DeviceMonitoringService/GetDeviceStatus"
+	DeviceMonitoringService_ListDeviceStatusHistory_FullMethodName = "/api.v1.DeviceMonitoringService/ListDeviceStatusHistory"
+	DeviceMonitoringService_GetEndpointHealth_FullMethodName       = "/api.v1.DeviceMonitoringService/GetEndpointHealth"
+	DeviceMonitoringService_GetLatencyStats_FullMethodName         = "/api.v1.DeviceMonitoringService/GetLatencyStats"
+	DeviceMonitoringService_GetAllDeviceStatuses_FullMethodName    = "/api.v1.DeviceMonitoringService/GetAllDeviceStatuses"
+	DeviceMonitoringService_GetSummary_FullMethodName              = "/api.v1.DeviceMonitoringService/GetSummary"
+	DeviceMonitoringService_CreateCredentialProfile_FullMethodName = "/api.v1.DeviceMonitoringService/CreateCredentialProfile"
+	DeviceMonitoringService_UpdateCredentialProfile_FullMethodName = "/api.v1.DeviceMonitoringService/UpdateCredentialProfile"
+	DeviceMonitoringService_ListCredentialProfiles_FullMethodName  = "/api.v1.DeviceMonitoringService/ListCredentialProfiles"
+	DeviceMonitoringService_DeleteCredentialProfile_FullMethodName = "/api.v1.DeviceMonitoringService/DeleteCredentialProfile"
+	DeviceMonitoringService_SetPollingDefault_FullMethodName       = "/api.v1.DeviceMonitoringService/SetPollingDefault"
+	DeviceMonitoringService_ListPollingDefaults_FullMethodName     = "/api.v1.DeviceMonitoringService/ListPollingDefaults"
+	DeviceMonitoringService_DeletePollingDefault_FullMethodName    = "/api.v1.DeviceMonitoringService/DeletePollingDefault"
+	DeviceMonitoringService_CreateMaintenanceWindow_FullMethodName = "/api.v1.DeviceMonitoringService/CreateMaintenanceWindow"
+	DeviceMonitoringService_UpdateMaintenanceWindow_FullMethodName = "/api.v1.DeviceMonitoringService/UpdateMaintenanceWindow"
+	DeviceMonitoringService_ListMaintenanceWindows_FullMethodName  = "/api.v1.DeviceMonitoringService/ListMaintenanceWindows"
+	DeviceMonitoringService_DeleteMaintenanceWindow_FullMethodName = "/api.v1.DeviceMonitoringService/DeleteMaintenanceWindow"
 )
 
 // DeviceMonitoringServiceClient is the client API for DeviceMonitoringService service.
@@ -50,12 +66,53 @@ type DeviceMonitoringServiceClient interface {
 	// DeleteDevice allows to remove network device from the monitoring service.
 	// In order to do so, you should remember ID assigned internally by the monitoring system.
 	DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*DeleteDeviceResponse, error)
+	// SetDeviceParent allows to edit the topology, i.e., to set (or to clear) the upstream network device, which
+	// the network device depends on (e.g., site gateway).
+	SetDeviceParent(ctx context.Context, in *SetDeviceParentRequest, opts ...grpc.CallOption) (*SetDeviceParentResponse, error)
+	// PollDevice allows to poll the network device right away (i.e., without waiting for the control loop) and to retrieve
+	// its fresh status and versions. Result of the poll is stored in the system.
+	PollDevice(ctx context.Context, in *PollDeviceRequest, opts ...grpc.CallOption) (*PollDeviceResponse, error)
 	// GetDeviceStatus allows to retrieve network device status in real time.
 	GetDeviceStatus(ctx context.Context, in *GetDeviceStatusRequest, opts ...grpc.CallOption) (*GetDeviceStatusResponse, error)
+	// ListDeviceStatusHistory allows to retrieve status transitions of the network device within a time range.
+	ListDeviceStatusHistory(ctx context.Context, in *ListDeviceStatusHistoryRequest, opts ...grpc.CallOption) (*ListDeviceStatusHistoryResponse, error)
+	// GetEndpointHealth allows to retrieve health of the single endpoint of the network device, i.e., whether it answers
+	// regardless of the other endpoints of the device.
+	GetEndpointHealth(ctx context.Context, in *GetEndpointHealthRequest, opts ...grpc.CallOption) (*GetEndpointHealthResponse, error)
+	// GetLatencyStats allows to retrieve latency statistics (min/avg/p95/max) of the exchanges with the network device
+	// within a time range, optionally narrowed down to the single endpoint of the device.
+	GetLatencyStats(ctx context.Context, in *GetLatencyStatsRequest, opts ...grpc.CallOption) (*GetLatencyStatsResponse, error)
 	// GetAllDeviceStatuses allows to retrieve all statuses from all network devices.
 	GetAllDeviceStatuses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAllDeviceStatusesResponse, error)
 	// GetSummary allows to retrieve summary of network device monitoring.
 	GetSummary(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSummaryResponse, error)
+	// CreateCredentialProfile allows to create a credential profile, which can be referenced by network device endpoints.
+	// Response carries the profile with ID assigned internally by the system. Secrets are never returned.
+	CreateCredentialProfile(ctx context.Context, in *CreateCredentialProfileRequest, opts ...grpc.CallOption) (*CreateCredentialProfileResponse, error)
+	// UpdateCredentialProfile allows to update (e.g., rotate secrets of) the credential profile. Only fields, which are
+	//
+	//	set in the request, are updated.
+	UpdateCredentialProfile(ctx context.Context, in *UpdateCredentialProfileRequest, opts ...grpc.CallOption) (*UpdateCredentialProfileResponse, error)
+	// ListCredentialProfiles allows to retrieve all credential profiles. Secrets are never returned.
+	ListCredentialProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCredentialProfilesResponse, error)
+	// DeleteCredentialProfile allows to remove credential profile. Endpoints, which reference it, are left without credentials.
+	DeleteCredentialProfile(ctx context.Context, in *DeleteCredentialProfileRequest, opts ...grpc.CallOption) (*DeleteCredentialProfileResponse, error)
+	// SetPollingDefault allows to set a default poll interval of all network devices within the group or the site.
+	// Default, which already exists for the same group (or site), is overwritten.
+	SetPollingDefault(ctx context.Context, in *SetPollingDefaultRequest, opts ...grpc.CallOption) (*SetPollingDefaultResponse, error)
+	// ListPollingDefaults allows to retrieve all default poll intervals of the groups and the sites.
+	ListPollingDefaults(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPollingDefaultsResponse, error)
+	// DeletePollingDefault allows to remove default poll interval. Network devices fall back to the next default.
+	DeletePollingDefault(ctx context.Context, in *DeletePollingDefaultRequest, opts ...grpc.CallOption) (*DeletePollingDefaultResponse, error)
+	// CreateMaintenanceWindow allows to create a maintenance window. Network devices are still polled during the active
+	// window, but they are not reported DOWN.
+	CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*CreateMaintenanceWindowResponse, error)
+	// UpdateMaintenanceWindow allows to update the maintenance window. Only fields, which are set in the request, are updated.
+	UpdateMaintenanceWindow(ctx context.Context, in *UpdateMaintenanceWindowRequest, opts ...grpc.CallOption) (*UpdateMaintenanceWindowResponse, error)
+	// ListMaintenanceWindows allows to retrieve all maintenance windows.
+	ListMaintenanceWindows(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error)
+	// DeleteMaintenanceWindow allows to remove the maintenance window.
+	DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*DeleteMaintenanceWindowResponse, error)
 }
 
 type deviceMonitoringServiceClient struct {
@@ -111,6 +168,24 @@ func (c *deviceMonitoringServiceClient) DeleteDevice(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *deviceMonitoringServiceClient) SetDeviceParent(ctx context.Context, in *SetDeviceParentRequest, opts ...grpc.CallOption) (*SetDeviceParentResponse, error) {
+	out := new(SetDeviceParentResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_SetDeviceParent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) PollDevice(ctx context.Context, in *PollDeviceRequest, opts ...grpc.CallOption) (*PollDeviceResponse, error) {
+	out := new(PollDeviceResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_PollDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deviceMonitoringServiceClient) GetDeviceStatus(ctx context.Context, in *GetDeviceStatusRequest, opts ...grpc.CallOption) (*GetDeviceStatusResponse, error) {
 	out := new(GetDeviceStatusResponse)
 	err := c.cc.Invoke(ctx, DeviceMonitoringService_GetDeviceStatus_FullMethodName, in, out, opts...)
@@ -120,6 +195,33 @@ func (c *deviceMonitoringServiceClient) GetDeviceStatus(ctx context.Context, in
 	return out, nil
 }
 
+func (c *deviceMonitoringServiceClient) ListDeviceStatusHistory(ctx context.Context, in *ListDeviceStatusHistoryRequest, opts ...grpc.CallOption) (*ListDeviceStatusHistoryResponse, error) {
+	out := new(ListDeviceStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_ListDeviceStatusHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) GetEndpointHealth(ctx context.Context, in *GetEndpointHealthRequest, opts ...grpc.CallOption) (*GetEndpointHealthResponse, error) {
+	out := new(GetEndpointHealthResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_GetEndpointHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) GetLatencyStats(ctx context.Context, in *GetLatencyStatsRequest, opts ...grpc.CallOption) (*GetLatencyStatsResponse, error) {
+	out := new(GetLatencyStatsResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_GetLatencyStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deviceMonitoringServiceClient) GetAllDeviceStatuses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAllDeviceStatusesResponse, error) {
 	out := new(GetAllDeviceStatusesResponse)
 	err := c.cc.Invoke(ctx, DeviceMonitoringService_GetAllDeviceStatuses_FullMethodName, in, out, opts...)
@@ -138,6 +240,105 @@ func (c *deviceMonitoringServiceClient) GetSummary(ctx context.Context, in *empt
 	return out, nil
 }
 
+func (c *deviceMonitoringServiceClient) CreateCredentialProfile(ctx context.Context, in *CreateCredentialProfileRequest, opts ...grpc.CallOption) (*CreateCredentialProfileResponse, error) {
+	out := new(CreateCredentialProfileResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_CreateCredentialProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) UpdateCredentialProfile(ctx context.Context, in *UpdateCredentialProfileRequest, opts ...grpc.CallOption) (*UpdateCredentialProfileResponse, error) {
+	out := new(UpdateCredentialProfileResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_UpdateCredentialProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) ListCredentialProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCredentialProfilesResponse, error) {
+	out := new(ListCredentialProfilesResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_ListCredentialProfiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) DeleteCredentialProfile(ctx context.Context, in *DeleteCredentialProfileRequest, opts ...grpc.CallOption) (*DeleteCredentialProfileResponse, error) {
+	out := new(DeleteCredentialProfileResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_DeleteCredentialProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) SetPollingDefault(ctx context.Context, in *SetPollingDefaultRequest, opts ...grpc.CallOption) (*SetPollingDefaultResponse, error) {
+	out := new(SetPollingDefaultResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_SetPollingDefault_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) ListPollingDefaults(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPollingDefaultsResponse, error) {
+	out := new(ListPollingDefaultsResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_ListPollingDefaults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) DeletePollingDefault(ctx context.Context, in *DeletePollingDefaultRequest, opts ...grpc.CallOption) (*DeletePollingDefaultResponse, error) {
+	out := new(DeletePollingDefaultResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_DeletePollingDefault_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*CreateMaintenanceWindowResponse, error) {
+	out := new(CreateMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_CreateMaintenanceWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) UpdateMaintenanceWindow(ctx context.Context, in *UpdateMaintenanceWindowRequest, opts ...grpc.CallOption) (*UpdateMaintenanceWindowResponse, error) {
+	out := new(UpdateMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_UpdateMaintenanceWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) ListMaintenanceWindows(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error) {
+	out := new(ListMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_ListMaintenanceWindows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMonitoringServiceClient) DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*DeleteMaintenanceWindowResponse, error) {
+	out := new(DeleteMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, DeviceMonitoringService_DeleteMaintenanceWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceMonitoringServiceServer is the server API for DeviceMonitoringService service.
 // All implementations should embed UnimplementedDeviceMonitoringServiceServer
 // for forward compatibility
@@ -158,12 +359,53 @@ type DeviceMonitoringServiceServer interface {
 	// DeleteDevice allows to remove network device from the monitoring service.
 	// In order to do so, you should remember ID assigned internally by the monitoring system.
 	DeleteDevice(context.Context, *DeleteDeviceRequest) (*DeleteDeviceResponse, error)
+	// SetDeviceParent allows to edit the topology, i.e., to set (or to clear) the upstream network device, which
+	// the network device depends on (e.g., site gateway).
+	SetDeviceParent(context.Context, *SetDeviceParentRequest) (*SetDeviceParentResponse, error)
+	// PollDevice allows to poll the network device right away (i.e., without waiting for the control loop) and to retrieve
+	// its fresh status and versions. Result of the poll is stored in the system.
+	PollDevice(context.Context, *PollDeviceRequest) (*PollDeviceResponse, error)
 	// GetDeviceStatus allows to retrieve network device status in real time.
 	GetDeviceStatus(context.Context, *GetDeviceStatusRequest) (*GetDeviceStatusResponse, error)
+	// ListDeviceStatusHistory allows to retrieve status transitions of the network device within a time range.
+	ListDeviceStatusHistory(context.Context, *ListDeviceStatusHistoryRequest) (*ListDeviceStatusHistoryResponse, error)
+	// GetEndpointHealth allows to retrieve health of the single endpoint of the network device, i.e., whether it answers
+	// regardless of the other endpoints of the device.
+	GetEndpointHealth(context.Context, *GetEndpointHealthRequest) (*GetEndpointHealthResponse, error)
+	// GetLatencyStats allows to retrieve latency statistics (min/avg/p95/max) of the exchanges with the network device
+	// within a time range, optionally narrowed down to the single endpoint of the device.
+	GetLatencyStats(context.Context, *GetLatencyStatsRequest) (*GetLatencyStatsResponse, error)
 	// GetAllDeviceStatuses allows to retrieve all statuses from all network devices.
 	GetAllDeviceStatuses(context.Context, *emptypb.Empty) (*GetAllDeviceStatusesResponse, error)
 	// GetSummary allows to retrieve summary of network device monitoring.
 	GetSummary(context.Context, *emptypb.Empty) (*GetSummaryResponse, error)
+	// CreateCredentialProfile allows to create a credential profile, which can be referenced by network device endpoints.
+	// Response carries the profile with ID assigned internally by the system. Secrets are never returned.
+	CreateCredentialProfile(context.Context, *CreateCredentialProfileRequest) (*CreateCredentialProfileResponse, error)
+	// UpdateCredentialProfile allows to update (e.g., rotate secrets of) the credential profile. Only fields, which are
+	//
+	//	set in the request, are updated.
+	UpdateCredentialProfile(context.Context, *UpdateCredentialProfileRequest) (*UpdateCredentialProfileResponse, error)
+	// ListCredentialProfiles allows to retrieve all credential profiles. Secrets are never returned.
+	ListCredentialProfiles(context.Context, *emptypb.Empty) (*ListCredentialProfilesResponse, error)
+	// DeleteCredentialProfile allows to remove credential profile. Endpoints, which reference it, are left without credentials.
+	DeleteCredentialProfile(context.Context, *DeleteCredentialProfileRequest) (*DeleteCredentialProfileResponse, error)
+	// SetPollingDefault allows to set a default poll interval of all network devices within the group or the site.
+	// Default, which already exists for the same group (or site), is overwritten.
+	SetPollingDefault(context.Context, *SetPollingDefaultRequest) (*SetPollingDefaultResponse, error)
+	// ListPollingDefaults allows to retrieve all default poll intervals of the groups and the sites.
+	ListPollingDefaults(context.Context, *emptypb.Empty) (*ListPollingDefaultsResponse, error)
+	// DeletePollingDefault allows to remove default poll interval. Network devices fall back to the next default.
+	DeletePollingDefault(context.Context, *DeletePollingDefaultRequest) (*DeletePollingDefaultResponse, error)
+	// CreateMaintenanceWindow allows to create a maintenance window. Network devices are still polled during the active
+	// window, but they are not reported DOWN.
+	CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*CreateMaintenanceWindowResponse, error)
+	// UpdateMaintenanceWindow allows to update the maintenance window. Only fields, which are set in the request, are updated.
+	UpdateMaintenanceWindow(context.Context, *UpdateMaintenanceWindowRequest) (*UpdateMaintenanceWindowResponse, error)
+	// ListMaintenanceWindows allows to retrieve all maintenance windows.
+	ListMaintenanceWindows(context.Context, *emptypb.Empty) (*ListMaintenanceWindowsResponse, error)
+	// DeleteMaintenanceWindow allows to remove the maintenance window.
+	DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*DeleteMaintenanceWindowResponse, error)
 }
 
 // UnimplementedDeviceMonitoringServiceServer should be embedded to have forward compatible implementations.
@@ -185,15 +427,63 @@ func (UnimplementedDeviceMonitoringServiceServer) AddDevice(context.Context, *Ad
 func (UnimplementedDeviceMonitoringServiceServer) DeleteDevice(context.Context, *DeleteDeviceRequest) (*DeleteDeviceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDevice not implemented")
 }
+func (UnimplementedDeviceMonitoringServiceServer) SetDeviceParent(context.Context, *SetDeviceParentRequest) (*SetDeviceParentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeviceParent not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) PollDevice(context.Context, *PollDeviceRequest) (*PollDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollDevice not implemented")
+}
 func (UnimplementedDeviceMonitoringServiceServer) GetDeviceStatus(context.Context, *GetDeviceStatusRequest) (*GetDeviceStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceStatus not implemented")
 }
+func (UnimplementedDeviceMonitoringServiceServer) ListDeviceStatusHistory(context.Context, *ListDeviceStatusHistoryRequest) (*ListDeviceStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeviceStatusHistory not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) GetEndpointHealth(context.Context, *GetEndpointHealthRequest) (*GetEndpointHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndpointHealth not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) GetLatencyStats(context.Context, *GetLatencyStatsRequest) (*GetLatencyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatencyStats not implemented")
+}
 func (UnimplementedDeviceMonitoringServiceServer) GetAllDeviceStatuses(context.Context, *emptypb.Empty) (*GetAllDeviceStatusesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllDeviceStatuses not implemented")
 }
 func (UnimplementedDeviceMonitoringServiceServer) GetSummary(context.Context, *emptypb.Empty) (*GetSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSummary not implemented")
 }
+func (UnimplementedDeviceMonitoringServiceServer) CreateCredentialProfile(context.Context, *CreateCredentialProfileRequest) (*CreateCredentialProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredentialProfile not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) UpdateCredentialProfile(context.Context, *UpdateCredentialProfileRequest) (*UpdateCredentialProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialProfile not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) ListCredentialProfiles(context.Context, *emptypb.Empty) (*ListCredentialProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialProfiles not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) DeleteCredentialProfile(context.Context, *DeleteCredentialProfileRequest) (*DeleteCredentialProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredentialProfile not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) SetPollingDefault(context.Context, *SetPollingDefaultRequest) (*SetPollingDefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPollingDefault not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) ListPollingDefaults(context.Context, *emptypb.Empty) (*ListPollingDefaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPollingDefaults not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) DeletePollingDefault(context.Context, *DeletePollingDefaultRequest) (*DeletePollingDefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePollingDefault not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*CreateMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMaintenanceWindow not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) UpdateMaintenanceWindow(context.Context, *UpdateMaintenanceWindowRequest) (*UpdateMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaintenanceWindow not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) ListMaintenanceWindows(context.Context, *emptypb.Empty) (*ListMaintenanceWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaintenanceWindows not implemented")
+}
+func (UnimplementedDeviceMonitoringServiceServer) DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*DeleteMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMaintenanceWindow not implemented")
+}
 
 // UnsafeDeviceMonitoringServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DeviceMonitoringServiceServer will
@@ -296,6 +586,42 @@ func _DeviceMonitoringService_DeleteDevice_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceMonitoringService_SetDeviceParent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDeviceParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).SetDeviceParent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_SetDeviceParent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).SetDeviceParent(ctx, req.(*SetDeviceParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_PollDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).PollDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_PollDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).PollDevice(ctx, req.(*PollDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeviceMonitoringService_GetDeviceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDeviceStatusRequest)
 	if err := dec(in); err != nil {
@@ -314,6 +640,60 @@ func _DeviceMonitoringService_GetDeviceStatus_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceMonitoringService_ListDeviceStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).ListDeviceStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_ListDeviceStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).ListDeviceStatusHistory(ctx, req.(*ListDeviceStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_GetEndpointHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEndpointHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).GetEndpointHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_GetEndpointHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).GetEndpointHealth(ctx, req.(*GetEndpointHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_GetLatencyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatencyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).GetLatencyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_GetLatencyStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).GetLatencyStats(ctx, req.(*GetLatencyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeviceMonitoringService_GetAllDeviceStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -350,6 +730,204 @@ func _DeviceMonitoringService_GetSummary_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceMonitoringService_CreateCredentialProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).CreateCredentialProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_CreateCredentialProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).CreateCredentialProfile(ctx, req.(*CreateCredentialProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_UpdateCredentialProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).UpdateCredentialProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_UpdateCredentialProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).UpdateCredentialProfile(ctx, req.(*UpdateCredentialProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_ListCredentialProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).ListCredentialProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_ListCredentialProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).ListCredentialProfiles(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_DeleteCredentialProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).DeleteCredentialProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_DeleteCredentialProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).DeleteCredentialProfile(ctx, req.(*DeleteCredentialProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_SetPollingDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPollingDefaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).SetPollingDefault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_SetPollingDefault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).SetPollingDefault(ctx, req.(*SetPollingDefaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_ListPollingDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).ListPollingDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_ListPollingDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).ListPollingDefaults(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_DeletePollingDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePollingDefaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).DeletePollingDefault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_DeletePollingDefault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).DeletePollingDefault(ctx, req.(*DeletePollingDefaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_CreateMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).CreateMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_CreateMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).CreateMaintenanceWindow(ctx, req.(*CreateMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_UpdateMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).UpdateMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_UpdateMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).UpdateMaintenanceWindow(ctx, req.(*UpdateMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_ListMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).ListMaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_ListMaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).ListMaintenanceWindows(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMonitoringService_DeleteMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMonitoringServiceServer).DeleteMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMonitoringService_DeleteMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMonitoringServiceServer).DeleteMaintenanceWindow(ctx, req.(*DeleteMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceMonitoringService_ServiceDesc is the grpc.ServiceDesc for DeviceMonitoringService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -377,10 +955,30 @@ var DeviceMonitoringService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDevice",
 			Handler:    _DeviceMonitoringService_DeleteDevice_Handler,
 		},
+		{
+			MethodName: "SetDeviceParent",
+			Handler:    _DeviceMonitoringService_SetDeviceParent_Handler,
+		},
+		{
+			MethodName: "PollDevice",
+			Handler:    _DeviceMonitoringService_PollDevice_Handler,
+		},
 		{
 			MethodName: "GetDeviceStatus",
 			Handler:    _DeviceMonitoringService_GetDeviceStatus_Handler,
 		},
+		{
+			MethodName: "ListDeviceStatusHistory",
+			Handler:    _DeviceMonitoringService_ListDeviceStatusHistory_Handler,
+		},
+		{
+			MethodName: "GetEndpointHealth",
+			Handler:    _DeviceMonitoringService_GetEndpointHealth_Handler,
+		},
+		{
+			MethodName: "GetLatencyStats",
+			Handler:    _DeviceMonitoringService_GetLatencyStats_Handler,
+		},
 		{
 			MethodName: "GetAllDeviceStatuses",
 			Handler:    _DeviceMonitoringService_GetAllDeviceStatuses_Handler,
@@ -389,6 +987,50 @@ var DeviceMonitoringService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSummary",
 			Handler:    _DeviceMonitoringService_GetSummary_Handler,
 		},
+		{
+			MethodName: "CreateCredentialProfile",
+			Handler:    _DeviceMonitoringService_CreateCredentialProfile_Handler,
+		},
+		{
+			MethodName: "UpdateCredentialProfile",
+			Handler:    _DeviceMonitoringService_UpdateCredentialProfile_Handler,
+		},
+		{
+			MethodName: "ListCredentialProfiles",
+			Handler:    _DeviceMonitoringService_ListCredentialProfiles_Handler,
+		},
+		{
+			MethodName: "DeleteCredentialProfile",
+			Handler:    _DeviceMonitoringService_DeleteCredentialProfile_Handler,
+		},
+		{
+			MethodName: "SetPollingDefault",
+			Handler:    _DeviceMonitoringService_SetPollingDefault_Handler,
+		},
+		{
+			MethodName: "ListPollingDefaults",
+			Handler:    _DeviceMonitoringService_ListPollingDefaults_Handler,
+		},
+		{
+			MethodName: "DeletePollingDefault",
+			Handler:    _DeviceMonitoringService_DeletePollingDefault_Handler,
+		},
+		{
+			MethodName: "CreateMaintenanceWindow",
+			Handler:    _DeviceMonitoringService_CreateMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "UpdateMaintenanceWindow",
+			Handler:    _DeviceMonitoringService_UpdateMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "ListMaintenanceWindows",
+			Handler:    _DeviceMonitoringService_ListMaintenanceWindows_Handler,
+		},
+		{
+			MethodName: "DeleteMaintenanceWindow",
+			Handler:    _DeviceMonitoringService_DeleteMaintenanceWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/monitoring.proto",
@@ -32,6 +32,9 @@ const (
 	netconf  = "NETCONF"
 	restconf = "RESTCONF"
 	ovs      = "OVS"
+	gnmi     = "GNMI"
+	tcp      = "TCP"
+	icmp     = "ICMP"
 
 	ubiquiti = "UBIQUITI"
 	juniper  = "JUNIPER"
@@ -252,6 +255,9 @@ func convertGolangStructsToProtoStructs(devices []*NetworkDevice) []*apiv1.Netwo
 		for _, ep := range nd.Endpoint {
 			convProto := convertProtocol(ep.Protocol)
 			convEp := server.CreateEndpoint(ep.Host, ep.Port, convProto)
+			if convProto == apiv1.Protocol_PROTOCOL_CUSTOM {
+				convEp.ProtocolName = ep.Protocol
+			}
 			eps = append(eps, convEp)
 		}
 		convND := server.CreateNetworkDevice(convertVendor(nd.Vendor), nd.Model, eps)
@@ -270,8 +276,17 @@ func convertProtocol(protocol string) apiv1.Protocol {
 		return apiv1.Protocol_PROTOCOL_RESTCONF
 	case strings.ToLower(ovs):
 		return apiv1.Protocol_PROTOCOL_OPEN_V_SWITCH
-	default:
+	case strings.ToLower(gnmi):
+		return apiv1.Protocol_PROTOCOL_GNMI
+	case strings.ToLower(tcp):
+		return apiv1.Protocol_PROTOCOL_TCP_CONNECT
+	case strings.ToLower(icmp):
+		return apiv1.Protocol_PROTOCOL_ICMP
+	case "":
 		return apiv1.Protocol_PROTOCOL_UNSPECIFIED
+	default:
+		// protocol is served by the connector registered out of tree
+		return apiv1.Protocol_PROTOCOL_CUSTOM
 	}
 }
 
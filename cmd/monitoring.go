@@ -36,6 +36,15 @@ func main() {
 	reverseProxyTermChan := make(chan bool)
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGINT)
+	// key for encrypting the credentials is reloaded on SIGHUP, e.g., once it was rotated
+	reloadChan := make(chan os.Signal, 1)
+	signal.Notify(reloadChan, syscall.SIGHUP)
+	go func() {
+		for range reloadChan {
+			zlog.Info().Msg("Reloading credentials encryption key")
+			db.ReloadCredentialsKey()
+		}
+	}()
 
 	readyChan := make(chan bool, 1)
 	reverseProxyReadyChan := make(chan bool, 1)
@@ -71,7 +80,7 @@ func main() {
 	wg.Add(1)
 	go func() {
 		wg.Add(1) //nolint:staticcheck
-		server.StartServer(server.GetGRPCServerAddress(), server.GetHTTPServerAddress(), dbClient, wg, termChan, readyChan, reverseProxyReadyChan, reverseProxyTermChan)
+		server.StartServer(server.GetGRPCServerAddress(), server.GetHTTPServerAddress(), dbClient, sbManager, wg, termChan, readyChan, reverseProxyReadyChan, reverseProxyTermChan)
 		wg.Done()
 	}()
 
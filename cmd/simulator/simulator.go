@@ -18,6 +18,21 @@ func main() {
 	ds := simulatorv1.NewDeviceSimulator()
 	ds.StartNetworkDeviceSimulator()
 
+	snmpAgent := simulatorv1.NewSNMPAgent()
+	snmpAgent.StartSNMPAgent()
+
+	netconfServer := simulatorv1.NewNETCONFServer()
+	netconfServer.StartNETCONFServer()
+
+	restconfServer := simulatorv1.NewRESTCONFServer()
+	restconfServer.StartRESTCONFServer()
+
+	ovsdbServer := simulatorv1.NewOVSDBServer()
+	ovsdbServer.StartOVSDBServer()
+
+	gnmiServer := simulatorv1.NewGNMIServer()
+	gnmiServer.StartGNMIServer()
+
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
@@ -26,6 +41,11 @@ func main() {
 
 		// gracefully close network device simulator server
 		ds.StopNetworkDeviceSimulator()
+		snmpAgent.StopSNMPAgent()
+		netconfServer.StopNETCONFServer()
+		restconfServer.StopRESTCONFServer()
+		ovsdbServer.StopOVSDBServer()
+		gnmiServer.StopGNMIServer()
 		wg.Done()
 	}()
 
@@ -15,9 +15,17 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
+	"github.com/eroshiva/trade-show-poc/internal/ent/latencysample"
+	"github.com/eroshiva/trade-show-poc/internal/ent/lease"
+	"github.com/eroshiva/trade-show-poc/internal/ent/maintenancewindow"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
+	"github.com/eroshiva/trade-show-poc/internal/ent/replicaheartbeat"
+	"github.com/eroshiva/trade-show-poc/internal/ent/statusevent"
 	"github.com/eroshiva/trade-show-poc/internal/ent/version"
 
 	stdsql "database/sql"
@@ -28,12 +36,28 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// CredentialProfile is the client for interacting with the CredentialProfile builders.
+	CredentialProfile *CredentialProfileClient
 	// DeviceStatus is the client for interacting with the DeviceStatus builders.
 	DeviceStatus *DeviceStatusClient
 	// Endpoint is the client for interacting with the Endpoint builders.
 	Endpoint *EndpointClient
+	// EndpointHealth is the client for interacting with the EndpointHealth builders.
+	EndpointHealth *EndpointHealthClient
+	// LatencySample is the client for interacting with the LatencySample builders.
+	LatencySample *LatencySampleClient
+	// Lease is the client for interacting with the Lease builders.
+	Lease *LeaseClient
+	// MaintenanceWindow is the client for interacting with the MaintenanceWindow builders.
+	MaintenanceWindow *MaintenanceWindowClient
 	// NetworkDevice is the client for interacting with the NetworkDevice builders.
 	NetworkDevice *NetworkDeviceClient
+	// PollingDefault is the client for interacting with the PollingDefault builders.
+	PollingDefault *PollingDefaultClient
+	// ReplicaHeartbeat is the client for interacting with the ReplicaHeartbeat builders.
+	ReplicaHeartbeat *ReplicaHeartbeatClient
+	// StatusEvent is the client for interacting with the StatusEvent builders.
+	StatusEvent *StatusEventClient
 	// Version is the client for interacting with the Version builders.
 	Version *VersionClient
 }
@@ -47,9 +71,17 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.CredentialProfile = NewCredentialProfileClient(c.config)
 	c.DeviceStatus = NewDeviceStatusClient(c.config)
 	c.Endpoint = NewEndpointClient(c.config)
+	c.EndpointHealth = NewEndpointHealthClient(c.config)
+	c.LatencySample = NewLatencySampleClient(c.config)
+	c.Lease = NewLeaseClient(c.config)
+	c.MaintenanceWindow = NewMaintenanceWindowClient(c.config)
 	c.NetworkDevice = NewNetworkDeviceClient(c.config)
+	c.PollingDefault = NewPollingDefaultClient(c.config)
+	c.ReplicaHeartbeat = NewReplicaHeartbeatClient(c.config)
+	c.StatusEvent = NewStatusEventClient(c.config)
 	c.Version = NewVersionClient(c.config)
 }
 
@@ -141,12 +173,20 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		DeviceStatus:  NewDeviceStatusClient(cfg),
-		Endpoint:      NewEndpointClient(cfg),
-		NetworkDevice: NewNetworkDeviceClient(cfg),
-		Version:       NewVersionClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		CredentialProfile: NewCredentialProfileClient(cfg),
+		DeviceStatus:      NewDeviceStatusClient(cfg),
+		Endpoint:          NewEndpointClient(cfg),
+		EndpointHealth:    NewEndpointHealthClient(cfg),
+		LatencySample:     NewLatencySampleClient(cfg),
+		Lease:             NewLeaseClient(cfg),
+		MaintenanceWindow: NewMaintenanceWindowClient(cfg),
+		NetworkDevice:     NewNetworkDeviceClient(cfg),
+		PollingDefault:    NewPollingDefaultClient(cfg),
+		ReplicaHeartbeat:  NewReplicaHeartbeatClient(cfg),
+		StatusEvent:       NewStatusEventClient(cfg),
+		Version:           NewVersionClient(cfg),
 	}, nil
 }
 
@@ -164,19 +204,27 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		DeviceStatus:  NewDeviceStatusClient(cfg),
-		Endpoint:      NewEndpointClient(cfg),
-		NetworkDevice: NewNetworkDeviceClient(cfg),
-		Version:       NewVersionClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		CredentialProfile: NewCredentialProfileClient(cfg),
+		DeviceStatus:      NewDeviceStatusClient(cfg),
+		Endpoint:          NewEndpointClient(cfg),
+		EndpointHealth:    NewEndpointHealthClient(cfg),
+		LatencySample:     NewLatencySampleClient(cfg),
+		Lease:             NewLeaseClient(cfg),
+		MaintenanceWindow: NewMaintenanceWindowClient(cfg),
+		NetworkDevice:     NewNetworkDeviceClient(cfg),
+		PollingDefault:    NewPollingDefaultClient(cfg),
+		ReplicaHeartbeat:  NewReplicaHeartbeatClient(cfg),
+		StatusEvent:       NewStatusEventClient(cfg),
+		Version:           NewVersionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		DeviceStatus.
+//		CredentialProfile.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -198,30 +246,52 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.DeviceStatus.Use(hooks...)
-	c.Endpoint.Use(hooks...)
-	c.NetworkDevice.Use(hooks...)
-	c.Version.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.CredentialProfile, c.DeviceStatus, c.Endpoint, c.EndpointHealth,
+		c.LatencySample, c.Lease, c.MaintenanceWindow, c.NetworkDevice,
+		c.PollingDefault, c.ReplicaHeartbeat, c.StatusEvent, c.Version,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.DeviceStatus.Intercept(interceptors...)
-	c.Endpoint.Intercept(interceptors...)
-	c.NetworkDevice.Intercept(interceptors...)
-	c.Version.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.CredentialProfile, c.DeviceStatus, c.Endpoint, c.EndpointHealth,
+		c.LatencySample, c.Lease, c.MaintenanceWindow, c.NetworkDevice,
+		c.PollingDefault, c.ReplicaHeartbeat, c.StatusEvent, c.Version,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *CredentialProfileMutation:
+		return c.CredentialProfile.mutate(ctx, m)
 	case *DeviceStatusMutation:
 		return c.DeviceStatus.mutate(ctx, m)
 	case *EndpointMutation:
 		return c.Endpoint.mutate(ctx, m)
+	case *EndpointHealthMutation:
+		return c.EndpointHealth.mutate(ctx, m)
+	case *LatencySampleMutation:
+		return c.LatencySample.mutate(ctx, m)
+	case *LeaseMutation:
+		return c.Lease.mutate(ctx, m)
+	case *MaintenanceWindowMutation:
+		return c.MaintenanceWindow.mutate(ctx, m)
 	case *NetworkDeviceMutation:
 		return c.NetworkDevice.mutate(ctx, m)
+	case *PollingDefaultMutation:
+		return c.PollingDefault.mutate(ctx, m)
+	case *ReplicaHeartbeatMutation:
+		return c.ReplicaHeartbeat.mutate(ctx, m)
+	case *StatusEventMutation:
+		return c.StatusEvent.mutate(ctx, m)
 	case *VersionMutation:
 		return c.Version.mutate(ctx, m)
 	default:
@@ -229,6 +299,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// CredentialProfileClient is a client for the CredentialProfile schema.
+type CredentialProfileClient struct {
+	config
+}
+
+// NewCredentialProfileClient returns a client for the CredentialProfile from the given config.
+func NewCredentialProfileClient(c config) *CredentialProfileClient {
+	return &CredentialProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `credentialprofile.Hooks(f(g(h())))`.
+func (c *CredentialProfileClient) Use(hooks ...Hook) {
+	c.hooks.CredentialProfile = append(c.hooks.CredentialProfile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `credentialprofile.Intercept(f(g(h())))`.
+func (c *CredentialProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CredentialProfile = append(c.inters.CredentialProfile, interceptors...)
+}
+
+// Create returns a builder for creating a CredentialProfile entity.
+func (c *CredentialProfileClient) Create() *CredentialProfileCreate {
+	mutation := newCredentialProfileMutation(c.config, OpCreate)
+	return &CredentialProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CredentialProfile entities.
+func (c *CredentialProfileClient) CreateBulk(builders ...*CredentialProfileCreate) *CredentialProfileCreateBulk {
+	return &CredentialProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CredentialProfileClient) MapCreateBulk(slice any, setFunc func(*CredentialProfileCreate, int)) *CredentialProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CredentialProfileCreateBulk{err: fmt.Errorf("calling to CredentialProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CredentialProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CredentialProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CredentialProfile.
+func (c *CredentialProfileClient) Update() *CredentialProfileUpdate {
+	mutation := newCredentialProfileMutation(c.config, OpUpdate)
+	return &CredentialProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CredentialProfileClient) UpdateOne(cp *CredentialProfile) *CredentialProfileUpdateOne {
+	mutation := newCredentialProfileMutation(c.config, OpUpdateOne, withCredentialProfile(cp))
+	return &CredentialProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CredentialProfileClient) UpdateOneID(id string) *CredentialProfileUpdateOne {
+	mutation := newCredentialProfileMutation(c.config, OpUpdateOne, withCredentialProfileID(id))
+	return &CredentialProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CredentialProfile.
+func (c *CredentialProfileClient) Delete() *CredentialProfileDelete {
+	mutation := newCredentialProfileMutation(c.config, OpDelete)
+	return &CredentialProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CredentialProfileClient) DeleteOne(cp *CredentialProfile) *CredentialProfileDeleteOne {
+	return c.DeleteOneID(cp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CredentialProfileClient) DeleteOneID(id string) *CredentialProfileDeleteOne {
+	builder := c.Delete().Where(credentialprofile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CredentialProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for CredentialProfile.
+func (c *CredentialProfileClient) Query() *CredentialProfileQuery {
+	return &CredentialProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCredentialProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CredentialProfile entity by its id.
+func (c *CredentialProfileClient) Get(ctx context.Context, id string) (*CredentialProfile, error) {
+	return c.Query().Where(credentialprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CredentialProfileClient) GetX(ctx context.Context, id string) *CredentialProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CredentialProfileClient) Hooks() []Hook {
+	return c.hooks.CredentialProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *CredentialProfileClient) Interceptors() []Interceptor {
+	return c.inters.CredentialProfile
+}
+
+func (c *CredentialProfileClient) mutate(ctx context.Context, m *CredentialProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CredentialProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CredentialProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CredentialProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CredentialProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CredentialProfile mutation op: %q", m.Op())
+	}
+}
+
 // DeviceStatusClient is a client for the DeviceStatus schema.
 type DeviceStatusClient struct {
 	config
@@ -486,6 +689,38 @@ func (c *EndpointClient) GetX(ctx context.Context, id string) *Endpoint {
 	return obj
 }
 
+// QueryCredentialProfile queries the credential_profile edge of a Endpoint.
+func (c *EndpointClient) QueryCredentialProfile(e *Endpoint) *CredentialProfileQuery {
+	query := (&CredentialProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(endpoint.Table, endpoint.FieldID, id),
+			sqlgraph.To(credentialprofile.Table, credentialprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, endpoint.CredentialProfileTable, endpoint.CredentialProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHealth queries the health edge of a Endpoint.
+func (c *EndpointClient) QueryHealth(e *Endpoint) *EndpointHealthQuery {
+	query := (&EndpointHealthClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(endpoint.Table, endpoint.FieldID, id),
+			sqlgraph.To(endpointhealth.Table, endpointhealth.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, endpoint.HealthTable, endpoint.HealthColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryNetworkDevice queries the network_device edge of a Endpoint.
 func (c *EndpointClient) QueryNetworkDevice(e *Endpoint) *NetworkDeviceQuery {
 	query := (&NetworkDeviceClient{config: c.config}).Query()
@@ -527,107 +762,107 @@ func (c *EndpointClient) mutate(ctx context.Context, m *EndpointMutation) (Value
 	}
 }
 
-// NetworkDeviceClient is a client for the NetworkDevice schema.
-type NetworkDeviceClient struct {
+// EndpointHealthClient is a client for the EndpointHealth schema.
+type EndpointHealthClient struct {
 	config
 }
 
-// NewNetworkDeviceClient returns a client for the NetworkDevice from the given config.
-func NewNetworkDeviceClient(c config) *NetworkDeviceClient {
-	return &NetworkDeviceClient{config: c}
+// NewEndpointHealthClient returns a client for the EndpointHealth from the given config.
+func NewEndpointHealthClient(c config) *EndpointHealthClient {
+	return &EndpointHealthClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `networkdevice.Hooks(f(g(h())))`.
-func (c *NetworkDeviceClient) Use(hooks ...Hook) {
-	c.hooks.NetworkDevice = append(c.hooks.NetworkDevice, hooks...)
+// A call to `Use(f, g, h)` equals to `endpointhealth.Hooks(f(g(h())))`.
+func (c *EndpointHealthClient) Use(hooks ...Hook) {
+	c.hooks.EndpointHealth = append(c.hooks.EndpointHealth, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `networkdevice.Intercept(f(g(h())))`.
-func (c *NetworkDeviceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NetworkDevice = append(c.inters.NetworkDevice, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `endpointhealth.Intercept(f(g(h())))`.
+func (c *EndpointHealthClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EndpointHealth = append(c.inters.EndpointHealth, interceptors...)
 }
 
-// Create returns a builder for creating a NetworkDevice entity.
-func (c *NetworkDeviceClient) Create() *NetworkDeviceCreate {
-	mutation := newNetworkDeviceMutation(c.config, OpCreate)
-	return &NetworkDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EndpointHealth entity.
+func (c *EndpointHealthClient) Create() *EndpointHealthCreate {
+	mutation := newEndpointHealthMutation(c.config, OpCreate)
+	return &EndpointHealthCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NetworkDevice entities.
-func (c *NetworkDeviceClient) CreateBulk(builders ...*NetworkDeviceCreate) *NetworkDeviceCreateBulk {
-	return &NetworkDeviceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EndpointHealth entities.
+func (c *EndpointHealthClient) CreateBulk(builders ...*EndpointHealthCreate) *EndpointHealthCreateBulk {
+	return &EndpointHealthCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NetworkDeviceClient) MapCreateBulk(slice any, setFunc func(*NetworkDeviceCreate, int)) *NetworkDeviceCreateBulk {
+func (c *EndpointHealthClient) MapCreateBulk(slice any, setFunc func(*EndpointHealthCreate, int)) *EndpointHealthCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NetworkDeviceCreateBulk{err: fmt.Errorf("calling to NetworkDeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EndpointHealthCreateBulk{err: fmt.Errorf("calling to EndpointHealthClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NetworkDeviceCreate, rv.Len())
+	builders := make([]*EndpointHealthCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NetworkDeviceCreateBulk{config: c.config, builders: builders}
+	return &EndpointHealthCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NetworkDevice.
-func (c *NetworkDeviceClient) Update() *NetworkDeviceUpdate {
-	mutation := newNetworkDeviceMutation(c.config, OpUpdate)
-	return &NetworkDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EndpointHealth.
+func (c *EndpointHealthClient) Update() *EndpointHealthUpdate {
+	mutation := newEndpointHealthMutation(c.config, OpUpdate)
+	return &EndpointHealthUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NetworkDeviceClient) UpdateOne(nd *NetworkDevice) *NetworkDeviceUpdateOne {
-	mutation := newNetworkDeviceMutation(c.config, OpUpdateOne, withNetworkDevice(nd))
-	return &NetworkDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EndpointHealthClient) UpdateOne(eh *EndpointHealth) *EndpointHealthUpdateOne {
+	mutation := newEndpointHealthMutation(c.config, OpUpdateOne, withEndpointHealth(eh))
+	return &EndpointHealthUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NetworkDeviceClient) UpdateOneID(id string) *NetworkDeviceUpdateOne {
-	mutation := newNetworkDeviceMutation(c.config, OpUpdateOne, withNetworkDeviceID(id))
-	return &NetworkDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EndpointHealthClient) UpdateOneID(id string) *EndpointHealthUpdateOne {
+	mutation := newEndpointHealthMutation(c.config, OpUpdateOne, withEndpointHealthID(id))
+	return &EndpointHealthUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NetworkDevice.
-func (c *NetworkDeviceClient) Delete() *NetworkDeviceDelete {
-	mutation := newNetworkDeviceMutation(c.config, OpDelete)
-	return &NetworkDeviceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EndpointHealth.
+func (c *EndpointHealthClient) Delete() *EndpointHealthDelete {
+	mutation := newEndpointHealthMutation(c.config, OpDelete)
+	return &EndpointHealthDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NetworkDeviceClient) DeleteOne(nd *NetworkDevice) *NetworkDeviceDeleteOne {
-	return c.DeleteOneID(nd.ID)
+func (c *EndpointHealthClient) DeleteOne(eh *EndpointHealth) *EndpointHealthDeleteOne {
+	return c.DeleteOneID(eh.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NetworkDeviceClient) DeleteOneID(id string) *NetworkDeviceDeleteOne {
-	builder := c.Delete().Where(networkdevice.ID(id))
+func (c *EndpointHealthClient) DeleteOneID(id string) *EndpointHealthDeleteOne {
+	builder := c.Delete().Where(endpointhealth.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NetworkDeviceDeleteOne{builder}
+	return &EndpointHealthDeleteOne{builder}
 }
 
-// Query returns a query builder for NetworkDevice.
-func (c *NetworkDeviceClient) Query() *NetworkDeviceQuery {
-	return &NetworkDeviceQuery{
+// Query returns a query builder for EndpointHealth.
+func (c *EndpointHealthClient) Query() *EndpointHealthQuery {
+	return &EndpointHealthQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNetworkDevice},
+		ctx:    &QueryContext{Type: TypeEndpointHealth},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NetworkDevice entity by its id.
-func (c *NetworkDeviceClient) Get(ctx context.Context, id string) (*NetworkDevice, error) {
-	return c.Query().Where(networkdevice.ID(id)).Only(ctx)
+// Get returns a EndpointHealth entity by its id.
+func (c *EndpointHealthClient) Get(ctx context.Context, id string) (*EndpointHealth, error) {
+	return c.Query().Where(endpointhealth.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NetworkDeviceClient) GetX(ctx context.Context, id string) *NetworkDevice {
+func (c *EndpointHealthClient) GetX(ctx context.Context, id string) *EndpointHealth {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -635,65 +870,693 @@ func (c *NetworkDeviceClient) GetX(ctx context.Context, id string) *NetworkDevic
 	return obj
 }
 
-// QueryEndpoints queries the endpoints edge of a NetworkDevice.
-func (c *NetworkDeviceClient) QueryEndpoints(nd *NetworkDevice) *EndpointQuery {
-	query := (&EndpointClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := nd.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(networkdevice.Table, networkdevice.FieldID, id),
-			sqlgraph.To(endpoint.Table, endpoint.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, networkdevice.EndpointsTable, networkdevice.EndpointsColumn),
-		)
-		fromV = sqlgraph.Neighbors(nd.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *EndpointHealthClient) Hooks() []Hook {
+	return c.hooks.EndpointHealth
+}
+
+// Interceptors returns the client interceptors.
+func (c *EndpointHealthClient) Interceptors() []Interceptor {
+	return c.inters.EndpointHealth
+}
+
+func (c *EndpointHealthClient) mutate(ctx context.Context, m *EndpointHealthMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EndpointHealthCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EndpointHealthUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EndpointHealthUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EndpointHealthDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EndpointHealth mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QuerySwVersion queries the sw_version edge of a NetworkDevice.
-func (c *NetworkDeviceClient) QuerySwVersion(nd *NetworkDevice) *VersionQuery {
-	query := (&VersionClient{config: c.config}).Query()
+// LatencySampleClient is a client for the LatencySample schema.
+type LatencySampleClient struct {
+	config
+}
+
+// NewLatencySampleClient returns a client for the LatencySample from the given config.
+func NewLatencySampleClient(c config) *LatencySampleClient {
+	return &LatencySampleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `latencysample.Hooks(f(g(h())))`.
+func (c *LatencySampleClient) Use(hooks ...Hook) {
+	c.hooks.LatencySample = append(c.hooks.LatencySample, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `latencysample.Intercept(f(g(h())))`.
+func (c *LatencySampleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LatencySample = append(c.inters.LatencySample, interceptors...)
+}
+
+// Create returns a builder for creating a LatencySample entity.
+func (c *LatencySampleClient) Create() *LatencySampleCreate {
+	mutation := newLatencySampleMutation(c.config, OpCreate)
+	return &LatencySampleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LatencySample entities.
+func (c *LatencySampleClient) CreateBulk(builders ...*LatencySampleCreate) *LatencySampleCreateBulk {
+	return &LatencySampleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LatencySampleClient) MapCreateBulk(slice any, setFunc func(*LatencySampleCreate, int)) *LatencySampleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LatencySampleCreateBulk{err: fmt.Errorf("calling to LatencySampleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LatencySampleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LatencySampleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LatencySample.
+func (c *LatencySampleClient) Update() *LatencySampleUpdate {
+	mutation := newLatencySampleMutation(c.config, OpUpdate)
+	return &LatencySampleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LatencySampleClient) UpdateOne(ls *LatencySample) *LatencySampleUpdateOne {
+	mutation := newLatencySampleMutation(c.config, OpUpdateOne, withLatencySample(ls))
+	return &LatencySampleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LatencySampleClient) UpdateOneID(id string) *LatencySampleUpdateOne {
+	mutation := newLatencySampleMutation(c.config, OpUpdateOne, withLatencySampleID(id))
+	return &LatencySampleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LatencySample.
+func (c *LatencySampleClient) Delete() *LatencySampleDelete {
+	mutation := newLatencySampleMutation(c.config, OpDelete)
+	return &LatencySampleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LatencySampleClient) DeleteOne(ls *LatencySample) *LatencySampleDeleteOne {
+	return c.DeleteOneID(ls.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LatencySampleClient) DeleteOneID(id string) *LatencySampleDeleteOne {
+	builder := c.Delete().Where(latencysample.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LatencySampleDeleteOne{builder}
+}
+
+// Query returns a query builder for LatencySample.
+func (c *LatencySampleClient) Query() *LatencySampleQuery {
+	return &LatencySampleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLatencySample},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LatencySample entity by its id.
+func (c *LatencySampleClient) Get(ctx context.Context, id string) (*LatencySample, error) {
+	return c.Query().Where(latencysample.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LatencySampleClient) GetX(ctx context.Context, id string) *LatencySample {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNetworkDevice queries the network_device edge of a LatencySample.
+func (c *LatencySampleClient) QueryNetworkDevice(ls *LatencySample) *NetworkDeviceQuery {
+	query := (&NetworkDeviceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := nd.ID
+		id := ls.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(networkdevice.Table, networkdevice.FieldID, id),
-			sqlgraph.To(version.Table, version.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, networkdevice.SwVersionTable, networkdevice.SwVersionColumn),
+			sqlgraph.From(latencysample.Table, latencysample.FieldID, id),
+			sqlgraph.To(networkdevice.Table, networkdevice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, latencysample.NetworkDeviceTable, latencysample.NetworkDeviceColumn),
 		)
-		fromV = sqlgraph.Neighbors(nd.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ls.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryFwVersion queries the fw_version edge of a NetworkDevice.
-func (c *NetworkDeviceClient) QueryFwVersion(nd *NetworkDevice) *VersionQuery {
-	query := (&VersionClient{config: c.config}).Query()
+// QueryEndpoint queries the endpoint edge of a LatencySample.
+func (c *LatencySampleClient) QueryEndpoint(ls *LatencySample) *EndpointQuery {
+	query := (&EndpointClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := nd.ID
+		id := ls.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(networkdevice.Table, networkdevice.FieldID, id),
-			sqlgraph.To(version.Table, version.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, networkdevice.FwVersionTable, networkdevice.FwVersionColumn),
+			sqlgraph.From(latencysample.Table, latencysample.FieldID, id),
+			sqlgraph.To(endpoint.Table, endpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, latencysample.EndpointTable, latencysample.EndpointColumn),
 		)
-		fromV = sqlgraph.Neighbors(nd.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ls.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NetworkDeviceClient) Hooks() []Hook {
-	return c.hooks.NetworkDevice
+func (c *LatencySampleClient) Hooks() []Hook {
+	return c.hooks.LatencySample
 }
 
 // Interceptors returns the client interceptors.
-func (c *NetworkDeviceClient) Interceptors() []Interceptor {
-	return c.inters.NetworkDevice
+func (c *LatencySampleClient) Interceptors() []Interceptor {
+	return c.inters.LatencySample
 }
 
-func (c *NetworkDeviceClient) mutate(ctx context.Context, m *NetworkDeviceMutation) (Value, error) {
+func (c *LatencySampleClient) mutate(ctx context.Context, m *LatencySampleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LatencySampleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LatencySampleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LatencySampleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LatencySampleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LatencySample mutation op: %q", m.Op())
+	}
+}
+
+// LeaseClient is a client for the Lease schema.
+type LeaseClient struct {
+	config
+}
+
+// NewLeaseClient returns a client for the Lease from the given config.
+func NewLeaseClient(c config) *LeaseClient {
+	return &LeaseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lease.Hooks(f(g(h())))`.
+func (c *LeaseClient) Use(hooks ...Hook) {
+	c.hooks.Lease = append(c.hooks.Lease, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lease.Intercept(f(g(h())))`.
+func (c *LeaseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Lease = append(c.inters.Lease, interceptors...)
+}
+
+// Create returns a builder for creating a Lease entity.
+func (c *LeaseClient) Create() *LeaseCreate {
+	mutation := newLeaseMutation(c.config, OpCreate)
+	return &LeaseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Lease entities.
+func (c *LeaseClient) CreateBulk(builders ...*LeaseCreate) *LeaseCreateBulk {
+	return &LeaseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaseClient) MapCreateBulk(slice any, setFunc func(*LeaseCreate, int)) *LeaseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaseCreateBulk{err: fmt.Errorf("calling to LeaseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Lease.
+func (c *LeaseClient) Update() *LeaseUpdate {
+	mutation := newLeaseMutation(c.config, OpUpdate)
+	return &LeaseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaseClient) UpdateOne(l *Lease) *LeaseUpdateOne {
+	mutation := newLeaseMutation(c.config, OpUpdateOne, withLease(l))
+	return &LeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaseClient) UpdateOneID(id string) *LeaseUpdateOne {
+	mutation := newLeaseMutation(c.config, OpUpdateOne, withLeaseID(id))
+	return &LeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Lease.
+func (c *LeaseClient) Delete() *LeaseDelete {
+	mutation := newLeaseMutation(c.config, OpDelete)
+	return &LeaseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaseClient) DeleteOne(l *Lease) *LeaseDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaseClient) DeleteOneID(id string) *LeaseDeleteOne {
+	builder := c.Delete().Where(lease.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaseDeleteOne{builder}
+}
+
+// Query returns a query builder for Lease.
+func (c *LeaseClient) Query() *LeaseQuery {
+	return &LeaseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLease},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Lease entity by its id.
+func (c *LeaseClient) Get(ctx context.Context, id string) (*Lease, error) {
+	return c.Query().Where(lease.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaseClient) GetX(ctx context.Context, id string) *Lease {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LeaseClient) Hooks() []Hook {
+	return c.hooks.Lease
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaseClient) Interceptors() []Interceptor {
+	return c.inters.Lease
+}
+
+func (c *LeaseClient) mutate(ctx context.Context, m *LeaseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Lease mutation op: %q", m.Op())
+	}
+}
+
+// MaintenanceWindowClient is a client for the MaintenanceWindow schema.
+type MaintenanceWindowClient struct {
+	config
+}
+
+// NewMaintenanceWindowClient returns a client for the MaintenanceWindow from the given config.
+func NewMaintenanceWindowClient(c config) *MaintenanceWindowClient {
+	return &MaintenanceWindowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `maintenancewindow.Hooks(f(g(h())))`.
+func (c *MaintenanceWindowClient) Use(hooks ...Hook) {
+	c.hooks.MaintenanceWindow = append(c.hooks.MaintenanceWindow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `maintenancewindow.Intercept(f(g(h())))`.
+func (c *MaintenanceWindowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MaintenanceWindow = append(c.inters.MaintenanceWindow, interceptors...)
+}
+
+// Create returns a builder for creating a MaintenanceWindow entity.
+func (c *MaintenanceWindowClient) Create() *MaintenanceWindowCreate {
+	mutation := newMaintenanceWindowMutation(c.config, OpCreate)
+	return &MaintenanceWindowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MaintenanceWindow entities.
+func (c *MaintenanceWindowClient) CreateBulk(builders ...*MaintenanceWindowCreate) *MaintenanceWindowCreateBulk {
+	return &MaintenanceWindowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MaintenanceWindowClient) MapCreateBulk(slice any, setFunc func(*MaintenanceWindowCreate, int)) *MaintenanceWindowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MaintenanceWindowCreateBulk{err: fmt.Errorf("calling to MaintenanceWindowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MaintenanceWindowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MaintenanceWindowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Update() *MaintenanceWindowUpdate {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdate)
+	return &MaintenanceWindowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MaintenanceWindowClient) UpdateOne(mw *MaintenanceWindow) *MaintenanceWindowUpdateOne {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdateOne, withMaintenanceWindow(mw))
+	return &MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MaintenanceWindowClient) UpdateOneID(id string) *MaintenanceWindowUpdateOne {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdateOne, withMaintenanceWindowID(id))
+	return &MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Delete() *MaintenanceWindowDelete {
+	mutation := newMaintenanceWindowMutation(c.config, OpDelete)
+	return &MaintenanceWindowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MaintenanceWindowClient) DeleteOne(mw *MaintenanceWindow) *MaintenanceWindowDeleteOne {
+	return c.DeleteOneID(mw.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MaintenanceWindowClient) DeleteOneID(id string) *MaintenanceWindowDeleteOne {
+	builder := c.Delete().Where(maintenancewindow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MaintenanceWindowDeleteOne{builder}
+}
+
+// Query returns a query builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Query() *MaintenanceWindowQuery {
+	return &MaintenanceWindowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMaintenanceWindow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MaintenanceWindow entity by its id.
+func (c *MaintenanceWindowClient) Get(ctx context.Context, id string) (*MaintenanceWindow, error) {
+	return c.Query().Where(maintenancewindow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MaintenanceWindowClient) GetX(ctx context.Context, id string) *MaintenanceWindow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNetworkDevices queries the network_devices edge of a MaintenanceWindow.
+func (c *MaintenanceWindowClient) QueryNetworkDevices(mw *MaintenanceWindow) *NetworkDeviceQuery {
+	query := (&NetworkDeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mw.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(maintenancewindow.Table, maintenancewindow.FieldID, id),
+			sqlgraph.To(networkdevice.Table, networkdevice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, maintenancewindow.NetworkDevicesTable, maintenancewindow.NetworkDevicesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(mw.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MaintenanceWindowClient) Hooks() []Hook {
+	return c.hooks.MaintenanceWindow
+}
+
+// Interceptors returns the client interceptors.
+func (c *MaintenanceWindowClient) Interceptors() []Interceptor {
+	return c.inters.MaintenanceWindow
+}
+
+func (c *MaintenanceWindowClient) mutate(ctx context.Context, m *MaintenanceWindowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MaintenanceWindowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MaintenanceWindowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MaintenanceWindowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MaintenanceWindow mutation op: %q", m.Op())
+	}
+}
+
+// NetworkDeviceClient is a client for the NetworkDevice schema.
+type NetworkDeviceClient struct {
+	config
+}
+
+// NewNetworkDeviceClient returns a client for the NetworkDevice from the given config.
+func NewNetworkDeviceClient(c config) *NetworkDeviceClient {
+	return &NetworkDeviceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `networkdevice.Hooks(f(g(h())))`.
+func (c *NetworkDeviceClient) Use(hooks ...Hook) {
+	c.hooks.NetworkDevice = append(c.hooks.NetworkDevice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `networkdevice.Intercept(f(g(h())))`.
+func (c *NetworkDeviceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NetworkDevice = append(c.inters.NetworkDevice, interceptors...)
+}
+
+// Create returns a builder for creating a NetworkDevice entity.
+func (c *NetworkDeviceClient) Create() *NetworkDeviceCreate {
+	mutation := newNetworkDeviceMutation(c.config, OpCreate)
+	return &NetworkDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NetworkDevice entities.
+func (c *NetworkDeviceClient) CreateBulk(builders ...*NetworkDeviceCreate) *NetworkDeviceCreateBulk {
+	return &NetworkDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NetworkDeviceClient) MapCreateBulk(slice any, setFunc func(*NetworkDeviceCreate, int)) *NetworkDeviceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NetworkDeviceCreateBulk{err: fmt.Errorf("calling to NetworkDeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NetworkDeviceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NetworkDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NetworkDevice.
+func (c *NetworkDeviceClient) Update() *NetworkDeviceUpdate {
+	mutation := newNetworkDeviceMutation(c.config, OpUpdate)
+	return &NetworkDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NetworkDeviceClient) UpdateOne(nd *NetworkDevice) *NetworkDeviceUpdateOne {
+	mutation := newNetworkDeviceMutation(c.config, OpUpdateOne, withNetworkDevice(nd))
+	return &NetworkDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NetworkDeviceClient) UpdateOneID(id string) *NetworkDeviceUpdateOne {
+	mutation := newNetworkDeviceMutation(c.config, OpUpdateOne, withNetworkDeviceID(id))
+	return &NetworkDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NetworkDevice.
+func (c *NetworkDeviceClient) Delete() *NetworkDeviceDelete {
+	mutation := newNetworkDeviceMutation(c.config, OpDelete)
+	return &NetworkDeviceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NetworkDeviceClient) DeleteOne(nd *NetworkDevice) *NetworkDeviceDeleteOne {
+	return c.DeleteOneID(nd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NetworkDeviceClient) DeleteOneID(id string) *NetworkDeviceDeleteOne {
+	builder := c.Delete().Where(networkdevice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NetworkDeviceDeleteOne{builder}
+}
+
+// Query returns a query builder for NetworkDevice.
+func (c *NetworkDeviceClient) Query() *NetworkDeviceQuery {
+	return &NetworkDeviceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNetworkDevice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NetworkDevice entity by its id.
+func (c *NetworkDeviceClient) Get(ctx context.Context, id string) (*NetworkDevice, error) {
+	return c.Query().Where(networkdevice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NetworkDeviceClient) GetX(ctx context.Context, id string) *NetworkDevice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryParent queries the parent edge of a NetworkDevice.
+func (c *NetworkDeviceClient) QueryParent(nd *NetworkDevice) *NetworkDeviceQuery {
+	query := (&NetworkDeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(networkdevice.Table, networkdevice.FieldID, id),
+			sqlgraph.To(networkdevice.Table, networkdevice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, networkdevice.ParentTable, networkdevice.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(nd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEndpoints queries the endpoints edge of a NetworkDevice.
+func (c *NetworkDeviceClient) QueryEndpoints(nd *NetworkDevice) *EndpointQuery {
+	query := (&EndpointClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(networkdevice.Table, networkdevice.FieldID, id),
+			sqlgraph.To(endpoint.Table, endpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, networkdevice.EndpointsTable, networkdevice.EndpointsColumn),
+		)
+		fromV = sqlgraph.Neighbors(nd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySwVersion queries the sw_version edge of a NetworkDevice.
+func (c *NetworkDeviceClient) QuerySwVersion(nd *NetworkDevice) *VersionQuery {
+	query := (&VersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(networkdevice.Table, networkdevice.FieldID, id),
+			sqlgraph.To(version.Table, version.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, networkdevice.SwVersionTable, networkdevice.SwVersionColumn),
+		)
+		fromV = sqlgraph.Neighbors(nd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFwVersion queries the fw_version edge of a NetworkDevice.
+func (c *NetworkDeviceClient) QueryFwVersion(nd *NetworkDevice) *VersionQuery {
+	query := (&VersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(networkdevice.Table, networkdevice.FieldID, id),
+			sqlgraph.To(version.Table, version.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, networkdevice.FwVersionTable, networkdevice.FwVersionColumn),
+		)
+		fromV = sqlgraph.Neighbors(nd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMaintenanceWindows queries the maintenance_windows edge of a NetworkDevice.
+func (c *NetworkDeviceClient) QueryMaintenanceWindows(nd *NetworkDevice) *MaintenanceWindowQuery {
+	query := (&MaintenanceWindowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(networkdevice.Table, networkdevice.FieldID, id),
+			sqlgraph.To(maintenancewindow.Table, maintenancewindow.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, networkdevice.MaintenanceWindowsTable, networkdevice.MaintenanceWindowsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(nd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a NetworkDevice.
+func (c *NetworkDeviceClient) QueryChildren(nd *NetworkDevice) *NetworkDeviceQuery {
+	query := (&NetworkDeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(networkdevice.Table, networkdevice.FieldID, id),
+			sqlgraph.To(networkdevice.Table, networkdevice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, networkdevice.ChildrenTable, networkdevice.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(nd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NetworkDeviceClient) Hooks() []Hook {
+	return c.hooks.NetworkDevice
+}
+
+// Interceptors returns the client interceptors.
+func (c *NetworkDeviceClient) Interceptors() []Interceptor {
+	return c.inters.NetworkDevice
+}
+
+func (c *NetworkDeviceClient) mutate(ctx context.Context, m *NetworkDeviceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
 		return (&NetworkDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
@@ -708,6 +1571,421 @@ func (c *NetworkDeviceClient) mutate(ctx context.Context, m *NetworkDeviceMutati
 	}
 }
 
+// PollingDefaultClient is a client for the PollingDefault schema.
+type PollingDefaultClient struct {
+	config
+}
+
+// NewPollingDefaultClient returns a client for the PollingDefault from the given config.
+func NewPollingDefaultClient(c config) *PollingDefaultClient {
+	return &PollingDefaultClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pollingdefault.Hooks(f(g(h())))`.
+func (c *PollingDefaultClient) Use(hooks ...Hook) {
+	c.hooks.PollingDefault = append(c.hooks.PollingDefault, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pollingdefault.Intercept(f(g(h())))`.
+func (c *PollingDefaultClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PollingDefault = append(c.inters.PollingDefault, interceptors...)
+}
+
+// Create returns a builder for creating a PollingDefault entity.
+func (c *PollingDefaultClient) Create() *PollingDefaultCreate {
+	mutation := newPollingDefaultMutation(c.config, OpCreate)
+	return &PollingDefaultCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PollingDefault entities.
+func (c *PollingDefaultClient) CreateBulk(builders ...*PollingDefaultCreate) *PollingDefaultCreateBulk {
+	return &PollingDefaultCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PollingDefaultClient) MapCreateBulk(slice any, setFunc func(*PollingDefaultCreate, int)) *PollingDefaultCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PollingDefaultCreateBulk{err: fmt.Errorf("calling to PollingDefaultClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PollingDefaultCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PollingDefaultCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PollingDefault.
+func (c *PollingDefaultClient) Update() *PollingDefaultUpdate {
+	mutation := newPollingDefaultMutation(c.config, OpUpdate)
+	return &PollingDefaultUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PollingDefaultClient) UpdateOne(pd *PollingDefault) *PollingDefaultUpdateOne {
+	mutation := newPollingDefaultMutation(c.config, OpUpdateOne, withPollingDefault(pd))
+	return &PollingDefaultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PollingDefaultClient) UpdateOneID(id string) *PollingDefaultUpdateOne {
+	mutation := newPollingDefaultMutation(c.config, OpUpdateOne, withPollingDefaultID(id))
+	return &PollingDefaultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PollingDefault.
+func (c *PollingDefaultClient) Delete() *PollingDefaultDelete {
+	mutation := newPollingDefaultMutation(c.config, OpDelete)
+	return &PollingDefaultDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PollingDefaultClient) DeleteOne(pd *PollingDefault) *PollingDefaultDeleteOne {
+	return c.DeleteOneID(pd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PollingDefaultClient) DeleteOneID(id string) *PollingDefaultDeleteOne {
+	builder := c.Delete().Where(pollingdefault.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PollingDefaultDeleteOne{builder}
+}
+
+// Query returns a query builder for PollingDefault.
+func (c *PollingDefaultClient) Query() *PollingDefaultQuery {
+	return &PollingDefaultQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePollingDefault},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PollingDefault entity by its id.
+func (c *PollingDefaultClient) Get(ctx context.Context, id string) (*PollingDefault, error) {
+	return c.Query().Where(pollingdefault.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PollingDefaultClient) GetX(ctx context.Context, id string) *PollingDefault {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PollingDefaultClient) Hooks() []Hook {
+	return c.hooks.PollingDefault
+}
+
+// Interceptors returns the client interceptors.
+func (c *PollingDefaultClient) Interceptors() []Interceptor {
+	return c.inters.PollingDefault
+}
+
+func (c *PollingDefaultClient) mutate(ctx context.Context, m *PollingDefaultMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PollingDefaultCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PollingDefaultUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PollingDefaultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PollingDefaultDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PollingDefault mutation op: %q", m.Op())
+	}
+}
+
+// ReplicaHeartbeatClient is a client for the ReplicaHeartbeat schema.
+type ReplicaHeartbeatClient struct {
+	config
+}
+
+// NewReplicaHeartbeatClient returns a client for the ReplicaHeartbeat from the given config.
+func NewReplicaHeartbeatClient(c config) *ReplicaHeartbeatClient {
+	return &ReplicaHeartbeatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `replicaheartbeat.Hooks(f(g(h())))`.
+func (c *ReplicaHeartbeatClient) Use(hooks ...Hook) {
+	c.hooks.ReplicaHeartbeat = append(c.hooks.ReplicaHeartbeat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `replicaheartbeat.Intercept(f(g(h())))`.
+func (c *ReplicaHeartbeatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReplicaHeartbeat = append(c.inters.ReplicaHeartbeat, interceptors...)
+}
+
+// Create returns a builder for creating a ReplicaHeartbeat entity.
+func (c *ReplicaHeartbeatClient) Create() *ReplicaHeartbeatCreate {
+	mutation := newReplicaHeartbeatMutation(c.config, OpCreate)
+	return &ReplicaHeartbeatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReplicaHeartbeat entities.
+func (c *ReplicaHeartbeatClient) CreateBulk(builders ...*ReplicaHeartbeatCreate) *ReplicaHeartbeatCreateBulk {
+	return &ReplicaHeartbeatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReplicaHeartbeatClient) MapCreateBulk(slice any, setFunc func(*ReplicaHeartbeatCreate, int)) *ReplicaHeartbeatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReplicaHeartbeatCreateBulk{err: fmt.Errorf("calling to ReplicaHeartbeatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReplicaHeartbeatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReplicaHeartbeatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReplicaHeartbeat.
+func (c *ReplicaHeartbeatClient) Update() *ReplicaHeartbeatUpdate {
+	mutation := newReplicaHeartbeatMutation(c.config, OpUpdate)
+	return &ReplicaHeartbeatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReplicaHeartbeatClient) UpdateOne(rh *ReplicaHeartbeat) *ReplicaHeartbeatUpdateOne {
+	mutation := newReplicaHeartbeatMutation(c.config, OpUpdateOne, withReplicaHeartbeat(rh))
+	return &ReplicaHeartbeatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReplicaHeartbeatClient) UpdateOneID(id string) *ReplicaHeartbeatUpdateOne {
+	mutation := newReplicaHeartbeatMutation(c.config, OpUpdateOne, withReplicaHeartbeatID(id))
+	return &ReplicaHeartbeatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReplicaHeartbeat.
+func (c *ReplicaHeartbeatClient) Delete() *ReplicaHeartbeatDelete {
+	mutation := newReplicaHeartbeatMutation(c.config, OpDelete)
+	return &ReplicaHeartbeatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReplicaHeartbeatClient) DeleteOne(rh *ReplicaHeartbeat) *ReplicaHeartbeatDeleteOne {
+	return c.DeleteOneID(rh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReplicaHeartbeatClient) DeleteOneID(id string) *ReplicaHeartbeatDeleteOne {
+	builder := c.Delete().Where(replicaheartbeat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReplicaHeartbeatDeleteOne{builder}
+}
+
+// Query returns a query builder for ReplicaHeartbeat.
+func (c *ReplicaHeartbeatClient) Query() *ReplicaHeartbeatQuery {
+	return &ReplicaHeartbeatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReplicaHeartbeat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReplicaHeartbeat entity by its id.
+func (c *ReplicaHeartbeatClient) Get(ctx context.Context, id string) (*ReplicaHeartbeat, error) {
+	return c.Query().Where(replicaheartbeat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReplicaHeartbeatClient) GetX(ctx context.Context, id string) *ReplicaHeartbeat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReplicaHeartbeatClient) Hooks() []Hook {
+	return c.hooks.ReplicaHeartbeat
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReplicaHeartbeatClient) Interceptors() []Interceptor {
+	return c.inters.ReplicaHeartbeat
+}
+
+func (c *ReplicaHeartbeatClient) mutate(ctx context.Context, m *ReplicaHeartbeatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReplicaHeartbeatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReplicaHeartbeatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReplicaHeartbeatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReplicaHeartbeatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReplicaHeartbeat mutation op: %q", m.Op())
+	}
+}
+
+// StatusEventClient is a client for the StatusEvent schema.
+type StatusEventClient struct {
+	config
+}
+
+// NewStatusEventClient returns a client for the StatusEvent from the given config.
+func NewStatusEventClient(c config) *StatusEventClient {
+	return &StatusEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `statusevent.Hooks(f(g(h())))`.
+func (c *StatusEventClient) Use(hooks ...Hook) {
+	c.hooks.StatusEvent = append(c.hooks.StatusEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `statusevent.Intercept(f(g(h())))`.
+func (c *StatusEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StatusEvent = append(c.inters.StatusEvent, interceptors...)
+}
+
+// Create returns a builder for creating a StatusEvent entity.
+func (c *StatusEventClient) Create() *StatusEventCreate {
+	mutation := newStatusEventMutation(c.config, OpCreate)
+	return &StatusEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StatusEvent entities.
+func (c *StatusEventClient) CreateBulk(builders ...*StatusEventCreate) *StatusEventCreateBulk {
+	return &StatusEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StatusEventClient) MapCreateBulk(slice any, setFunc func(*StatusEventCreate, int)) *StatusEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StatusEventCreateBulk{err: fmt.Errorf("calling to StatusEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StatusEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StatusEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StatusEvent.
+func (c *StatusEventClient) Update() *StatusEventUpdate {
+	mutation := newStatusEventMutation(c.config, OpUpdate)
+	return &StatusEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StatusEventClient) UpdateOne(se *StatusEvent) *StatusEventUpdateOne {
+	mutation := newStatusEventMutation(c.config, OpUpdateOne, withStatusEvent(se))
+	return &StatusEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StatusEventClient) UpdateOneID(id string) *StatusEventUpdateOne {
+	mutation := newStatusEventMutation(c.config, OpUpdateOne, withStatusEventID(id))
+	return &StatusEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StatusEvent.
+func (c *StatusEventClient) Delete() *StatusEventDelete {
+	mutation := newStatusEventMutation(c.config, OpDelete)
+	return &StatusEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StatusEventClient) DeleteOne(se *StatusEvent) *StatusEventDeleteOne {
+	return c.DeleteOneID(se.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StatusEventClient) DeleteOneID(id string) *StatusEventDeleteOne {
+	builder := c.Delete().Where(statusevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StatusEventDeleteOne{builder}
+}
+
+// Query returns a query builder for StatusEvent.
+func (c *StatusEventClient) Query() *StatusEventQuery {
+	return &StatusEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStatusEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StatusEvent entity by its id.
+func (c *StatusEventClient) Get(ctx context.Context, id string) (*StatusEvent, error) {
+	return c.Query().Where(statusevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StatusEventClient) GetX(ctx context.Context, id string) *StatusEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNetworkDevice queries the network_device edge of a StatusEvent.
+func (c *StatusEventClient) QueryNetworkDevice(se *StatusEvent) *NetworkDeviceQuery {
+	query := (&NetworkDeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := se.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(statusevent.Table, statusevent.FieldID, id),
+			sqlgraph.To(networkdevice.Table, networkdevice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, statusevent.NetworkDeviceTable, statusevent.NetworkDeviceColumn),
+		)
+		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *StatusEventClient) Hooks() []Hook {
+	return c.hooks.StatusEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *StatusEventClient) Interceptors() []Interceptor {
+	return c.inters.StatusEvent
+}
+
+func (c *StatusEventClient) mutate(ctx context.Context, m *StatusEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StatusEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StatusEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StatusEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StatusEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StatusEvent mutation op: %q", m.Op())
+	}
+}
+
 // VersionClient is a client for the Version schema.
 type VersionClient struct {
 	config
@@ -844,10 +2122,14 @@ func (c *VersionClient) mutate(ctx context.Context, m *VersionMutation) (Value,
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		DeviceStatus, Endpoint, NetworkDevice, Version []ent.Hook
+		CredentialProfile, DeviceStatus, Endpoint, EndpointHealth, LatencySample, Lease,
+		MaintenanceWindow, NetworkDevice, PollingDefault, ReplicaHeartbeat,
+		StatusEvent, Version []ent.Hook
 	}
 	inters struct {
-		DeviceStatus, Endpoint, NetworkDevice, Version []ent.Interceptor
+		CredentialProfile, DeviceStatus, Endpoint, EndpointHealth, LatencySample, Lease,
+		MaintenanceWindow, NetworkDevice, PollingDefault, ReplicaHeartbeat,
+		StatusEvent, Version []ent.Interceptor
 	}
 )
 
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
+)
+
+// CredentialProfile is the model entity for the CredentialProfile schema.
+type CredentialProfile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Password holds the value of the "password" field.
+	Password string `json:"-"`
+	// SnmpCommunity holds the value of the "snmp_community" field.
+	SnmpCommunity string `json:"-"`
+	// PrivateKey holds the value of the "private_key" field.
+	PrivateKey string `json:"-"`
+	// Token holds the value of the "token" field.
+	Token string `json:"-"`
+	// SnmpPrivPassphrase holds the value of the "snmp_priv_passphrase" field.
+	SnmpPrivPassphrase string `json:"-"`
+	// TLSClientKey holds the value of the "tls_client_key" field.
+	TLSClientKey string `json:"-"`
+	// SnmpAuthProtocol holds the value of the "snmp_auth_protocol" field.
+	SnmpAuthProtocol string `json:"snmp_auth_protocol,omitempty"`
+	// SnmpPrivProtocol holds the value of the "snmp_priv_protocol" field.
+	SnmpPrivProtocol string `json:"snmp_priv_protocol,omitempty"`
+	// TLSClientCertificate holds the value of the "tls_client_certificate" field.
+	TLSClientCertificate string `json:"tls_client_certificate,omitempty"`
+	// SSHKnownHosts holds the value of the "ssh_known_hosts" field.
+	SSHKnownHosts string `json:"ssh_known_hosts,omitempty"`
+	// SSHInsecureIgnoreHostKey holds the value of the "ssh_insecure_ignore_host_key" field.
+	SSHInsecureIgnoreHostKey bool `json:"ssh_insecure_ignore_host_key,omitempty"`
+	selectValues             sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CredentialProfile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case credentialprofile.FieldSSHInsecureIgnoreHostKey:
+			values[i] = new(sql.NullBool)
+		case credentialprofile.FieldID, credentialprofile.FieldName, credentialprofile.FieldUsername, credentialprofile.FieldPassword, credentialprofile.FieldSnmpCommunity, credentialprofile.FieldPrivateKey, credentialprofile.FieldToken, credentialprofile.FieldSnmpPrivPassphrase, credentialprofile.FieldTLSClientKey, credentialprofile.FieldSnmpAuthProtocol, credentialprofile.FieldSnmpPrivProtocol, credentialprofile.FieldTLSClientCertificate, credentialprofile.FieldSSHKnownHosts:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CredentialProfile fields.
+func (cp *CredentialProfile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case credentialprofile.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				cp.ID = value.String
+			}
+		case credentialprofile.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cp.Name = value.String
+			}
+		case credentialprofile.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				cp.Username = value.String
+			}
+		case credentialprofile.FieldPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password", values[i])
+			} else if value.Valid {
+				cp.Password = value.String
+			}
+		case credentialprofile.FieldSnmpCommunity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snmp_community", values[i])
+			} else if value.Valid {
+				cp.SnmpCommunity = value.String
+			}
+		case credentialprofile.FieldPrivateKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_key", values[i])
+			} else if value.Valid {
+				cp.PrivateKey = value.String
+			}
+		case credentialprofile.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				cp.Token = value.String
+			}
+		case credentialprofile.FieldSnmpPrivPassphrase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snmp_priv_passphrase", values[i])
+			} else if value.Valid {
+				cp.SnmpPrivPassphrase = value.String
+			}
+		case credentialprofile.FieldTLSClientKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_client_key", values[i])
+			} else if value.Valid {
+				cp.TLSClientKey = value.String
+			}
+		case credentialprofile.FieldSnmpAuthProtocol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snmp_auth_protocol", values[i])
+			} else if value.Valid {
+				cp.SnmpAuthProtocol = value.String
+			}
+		case credentialprofile.FieldSnmpPrivProtocol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snmp_priv_protocol", values[i])
+			} else if value.Valid {
+				cp.SnmpPrivProtocol = value.String
+			}
+		case credentialprofile.FieldTLSClientCertificate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_client_certificate", values[i])
+			} else if value.Valid {
+				cp.TLSClientCertificate = value.String
+			}
+		case credentialprofile.FieldSSHKnownHosts:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ssh_known_hosts", values[i])
+			} else if value.Valid {
+				cp.SSHKnownHosts = value.String
+			}
+		case credentialprofile.FieldSSHInsecureIgnoreHostKey:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field ssh_insecure_ignore_host_key", values[i])
+			} else if value.Valid {
+				cp.SSHInsecureIgnoreHostKey = value.Bool
+			}
+		default:
+			cp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CredentialProfile.
+// This includes values selected through modifiers, order, etc.
+func (cp *CredentialProfile) Value(name string) (ent.Value, error) {
+	return cp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CredentialProfile.
+// Note that you need to call CredentialProfile.Unwrap() before calling this method if this CredentialProfile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cp *CredentialProfile) Update() *CredentialProfileUpdateOne {
+	return NewCredentialProfileClient(cp.config).UpdateOne(cp)
+}
+
+// Unwrap unwraps the CredentialProfile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cp *CredentialProfile) Unwrap() *CredentialProfile {
+	_tx, ok := cp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CredentialProfile is not a transactional entity")
+	}
+	cp.config.driver = _tx.drv
+	return cp
+}
+
+// String implements the fmt.Stringer.
+func (cp *CredentialProfile) String() string {
+	var builder strings.Builder
+	builder.WriteString("CredentialProfile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cp.ID))
+	builder.WriteString("name=")
+	builder.WriteString(cp.Name)
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(cp.Username)
+	builder.WriteString(", ")
+	builder.WriteString("password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("snmp_community=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("private_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("snmp_priv_passphrase=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("tls_client_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("snmp_auth_protocol=")
+	builder.WriteString(cp.SnmpAuthProtocol)
+	builder.WriteString(", ")
+	builder.WriteString("snmp_priv_protocol=")
+	builder.WriteString(cp.SnmpPrivProtocol)
+	builder.WriteString(", ")
+	builder.WriteString("tls_client_certificate=")
+	builder.WriteString(cp.TLSClientCertificate)
+	builder.WriteString(", ")
+	builder.WriteString("ssh_known_hosts=")
+	builder.WriteString(cp.SSHKnownHosts)
+	builder.WriteString(", ")
+	builder.WriteString("ssh_insecure_ignore_host_key=")
+	builder.WriteString(fmt.Sprintf("%v", cp.SSHInsecureIgnoreHostKey))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CredentialProfiles is a parsable slice of CredentialProfile.
+type CredentialProfiles []*CredentialProfile
@@ -0,0 +1,143 @@
+// Code generated by ent, DO NOT EDIT.
+
+package credentialprofile
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the credentialprofile type in the database.
+	Label = "credential_profile"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldPassword holds the string denoting the password field in the database.
+	FieldPassword = "password"
+	// FieldSnmpCommunity holds the string denoting the snmp_community field in the database.
+	FieldSnmpCommunity = "snmp_community"
+	// FieldPrivateKey holds the string denoting the private_key field in the database.
+	FieldPrivateKey = "private_key"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldSnmpPrivPassphrase holds the string denoting the snmp_priv_passphrase field in the database.
+	FieldSnmpPrivPassphrase = "snmp_priv_passphrase"
+	// FieldTLSClientKey holds the string denoting the tls_client_key field in the database.
+	FieldTLSClientKey = "tls_client_key"
+	// FieldSnmpAuthProtocol holds the string denoting the snmp_auth_protocol field in the database.
+	FieldSnmpAuthProtocol = "snmp_auth_protocol"
+	// FieldSnmpPrivProtocol holds the string denoting the snmp_priv_protocol field in the database.
+	FieldSnmpPrivProtocol = "snmp_priv_protocol"
+	// FieldTLSClientCertificate holds the string denoting the tls_client_certificate field in the database.
+	FieldTLSClientCertificate = "tls_client_certificate"
+	// FieldSSHKnownHosts holds the string denoting the ssh_known_hosts field in the database.
+	FieldSSHKnownHosts = "ssh_known_hosts"
+	// FieldSSHInsecureIgnoreHostKey holds the string denoting the ssh_insecure_ignore_host_key field in the database.
+	FieldSSHInsecureIgnoreHostKey = "ssh_insecure_ignore_host_key"
+	// Table holds the table name of the credentialprofile in the database.
+	Table = "credential_profiles"
+)
+
+// Columns holds all SQL columns for credentialprofile fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldUsername,
+	FieldPassword,
+	FieldSnmpCommunity,
+	FieldPrivateKey,
+	FieldToken,
+	FieldSnmpPrivPassphrase,
+	FieldTLSClientKey,
+	FieldSnmpAuthProtocol,
+	FieldSnmpPrivProtocol,
+	FieldTLSClientCertificate,
+	FieldSSHKnownHosts,
+	FieldSSHInsecureIgnoreHostKey,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the CredentialProfile queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByPassword orders the results by the password field.
+func ByPassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassword, opts...).ToFunc()
+}
+
+// BySnmpCommunity orders the results by the snmp_community field.
+func BySnmpCommunity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnmpCommunity, opts...).ToFunc()
+}
+
+// ByPrivateKey orders the results by the private_key field.
+func ByPrivateKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrivateKey, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// BySnmpPrivPassphrase orders the results by the snmp_priv_passphrase field.
+func BySnmpPrivPassphrase(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnmpPrivPassphrase, opts...).ToFunc()
+}
+
+// ByTLSClientKey orders the results by the tls_client_key field.
+func ByTLSClientKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSClientKey, opts...).ToFunc()
+}
+
+// BySnmpAuthProtocol orders the results by the snmp_auth_protocol field.
+func BySnmpAuthProtocol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnmpAuthProtocol, opts...).ToFunc()
+}
+
+// BySnmpPrivProtocol orders the results by the snmp_priv_protocol field.
+func BySnmpPrivProtocol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnmpPrivProtocol, opts...).ToFunc()
+}
+
+// ByTLSClientCertificate orders the results by the tls_client_certificate field.
+func ByTLSClientCertificate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSClientCertificate, opts...).ToFunc()
+}
+
+// BySSHKnownHosts orders the results by the ssh_known_hosts field.
+func BySSHKnownHosts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSSHKnownHosts, opts...).ToFunc()
+}
+
+// BySSHInsecureIgnoreHostKey orders the results by the ssh_insecure_ignore_host_key field.
+func BySSHInsecureIgnoreHostKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSSHInsecureIgnoreHostKey, opts...).ToFunc()
+}
@@ -0,0 +1,1053 @@
+// Code generated by ent, DO NOT EDIT.
+
+package credentialprofile
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldName, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldUsername, v))
+}
+
+// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
+func Password(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldPassword, v))
+}
+
+// SnmpCommunity applies equality check predicate on the "snmp_community" field. It's identical to SnmpCommunityEQ.
+func SnmpCommunity(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldSnmpCommunity, v))
+}
+
+// PrivateKey applies equality check predicate on the "private_key" field. It's identical to PrivateKeyEQ.
+func PrivateKey(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldPrivateKey, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldToken, v))
+}
+
+// SnmpPrivPassphrase applies equality check predicate on the "snmp_priv_passphrase" field. It's identical to SnmpPrivPassphraseEQ.
+func SnmpPrivPassphrase(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldSnmpPrivPassphrase, v))
+}
+
+// TLSClientKey applies equality check predicate on the "tls_client_key" field. It's identical to TLSClientKeyEQ.
+func TLSClientKey(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldTLSClientKey, v))
+}
+
+// SnmpAuthProtocol applies equality check predicate on the "snmp_auth_protocol" field. It's identical to SnmpAuthProtocolEQ.
+func SnmpAuthProtocol(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldSnmpAuthProtocol, v))
+}
+
+// SnmpPrivProtocol applies equality check predicate on the "snmp_priv_protocol" field. It's identical to SnmpPrivProtocolEQ.
+func SnmpPrivProtocol(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldSnmpPrivProtocol, v))
+}
+
+// TLSClientCertificate applies equality check predicate on the "tls_client_certificate" field. It's identical to TLSClientCertificateEQ.
+func TLSClientCertificate(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldTLSClientCertificate, v))
+}
+
+// SSHKnownHosts applies equality check predicate on the "ssh_known_hosts" field. It's identical to SSHKnownHostsEQ.
+func SSHKnownHosts(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldSSHKnownHosts, v))
+}
+
+// SSHInsecureIgnoreHostKey applies equality check predicate on the "ssh_insecure_ignore_host_key" field. It's identical to SSHInsecureIgnoreHostKeyEQ.
+func SSHInsecureIgnoreHostKey(v bool) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldSSHInsecureIgnoreHostKey, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldName, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameIsNil applies the IsNil predicate on the "username" field.
+func UsernameIsNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIsNull(FieldUsername))
+}
+
+// UsernameNotNil applies the NotNil predicate on the "username" field.
+func UsernameNotNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotNull(FieldUsername))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// PasswordEQ applies the EQ predicate on the "password" field.
+func PasswordEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldPassword, v))
+}
+
+// PasswordNEQ applies the NEQ predicate on the "password" field.
+func PasswordNEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldPassword, v))
+}
+
+// PasswordIn applies the In predicate on the "password" field.
+func PasswordIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldPassword, vs...))
+}
+
+// PasswordNotIn applies the NotIn predicate on the "password" field.
+func PasswordNotIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldPassword, vs...))
+}
+
+// PasswordGT applies the GT predicate on the "password" field.
+func PasswordGT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldPassword, v))
+}
+
+// PasswordGTE applies the GTE predicate on the "password" field.
+func PasswordGTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldPassword, v))
+}
+
+// PasswordLT applies the LT predicate on the "password" field.
+func PasswordLT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldPassword, v))
+}
+
+// PasswordLTE applies the LTE predicate on the "password" field.
+func PasswordLTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldPassword, v))
+}
+
+// PasswordContains applies the Contains predicate on the "password" field.
+func PasswordContains(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContains(FieldPassword, v))
+}
+
+// PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
+func PasswordHasPrefix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasPrefix(FieldPassword, v))
+}
+
+// PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
+func PasswordHasSuffix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasSuffix(FieldPassword, v))
+}
+
+// PasswordIsNil applies the IsNil predicate on the "password" field.
+func PasswordIsNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIsNull(FieldPassword))
+}
+
+// PasswordNotNil applies the NotNil predicate on the "password" field.
+func PasswordNotNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotNull(FieldPassword))
+}
+
+// PasswordEqualFold applies the EqualFold predicate on the "password" field.
+func PasswordEqualFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldPassword, v))
+}
+
+// PasswordContainsFold applies the ContainsFold predicate on the "password" field.
+func PasswordContainsFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldPassword, v))
+}
+
+// SnmpCommunityEQ applies the EQ predicate on the "snmp_community" field.
+func SnmpCommunityEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldSnmpCommunity, v))
+}
+
+// SnmpCommunityNEQ applies the NEQ predicate on the "snmp_community" field.
+func SnmpCommunityNEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldSnmpCommunity, v))
+}
+
+// SnmpCommunityIn applies the In predicate on the "snmp_community" field.
+func SnmpCommunityIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldSnmpCommunity, vs...))
+}
+
+// SnmpCommunityNotIn applies the NotIn predicate on the "snmp_community" field.
+func SnmpCommunityNotIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldSnmpCommunity, vs...))
+}
+
+// SnmpCommunityGT applies the GT predicate on the "snmp_community" field.
+func SnmpCommunityGT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldSnmpCommunity, v))
+}
+
+// SnmpCommunityGTE applies the GTE predicate on the "snmp_community" field.
+func SnmpCommunityGTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldSnmpCommunity, v))
+}
+
+// SnmpCommunityLT applies the LT predicate on the "snmp_community" field.
+func SnmpCommunityLT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldSnmpCommunity, v))
+}
+
+// SnmpCommunityLTE applies the LTE predicate on the "snmp_community" field.
+func SnmpCommunityLTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldSnmpCommunity, v))
+}
+
+// SnmpCommunityContains applies the Contains predicate on the "snmp_community" field.
+func SnmpCommunityContains(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContains(FieldSnmpCommunity, v))
+}
+
+// SnmpCommunityHasPrefix applies the HasPrefix predicate on the "snmp_community" field.
+func SnmpCommunityHasPrefix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasPrefix(FieldSnmpCommunity, v))
+}
+
+// SnmpCommunityHasSuffix applies the HasSuffix predicate on the "snmp_community" field.
+func SnmpCommunityHasSuffix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasSuffix(FieldSnmpCommunity, v))
+}
+
+// SnmpCommunityIsNil applies the IsNil predicate on the "snmp_community" field.
+func SnmpCommunityIsNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIsNull(FieldSnmpCommunity))
+}
+
+// SnmpCommunityNotNil applies the NotNil predicate on the "snmp_community" field.
+func SnmpCommunityNotNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotNull(FieldSnmpCommunity))
+}
+
+// SnmpCommunityEqualFold applies the EqualFold predicate on the "snmp_community" field.
+func SnmpCommunityEqualFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldSnmpCommunity, v))
+}
+
+// SnmpCommunityContainsFold applies the ContainsFold predicate on the "snmp_community" field.
+func SnmpCommunityContainsFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldSnmpCommunity, v))
+}
+
+// PrivateKeyEQ applies the EQ predicate on the "private_key" field.
+func PrivateKeyEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldPrivateKey, v))
+}
+
+// PrivateKeyNEQ applies the NEQ predicate on the "private_key" field.
+func PrivateKeyNEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldPrivateKey, v))
+}
+
+// PrivateKeyIn applies the In predicate on the "private_key" field.
+func PrivateKeyIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldPrivateKey, vs...))
+}
+
+// PrivateKeyNotIn applies the NotIn predicate on the "private_key" field.
+func PrivateKeyNotIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldPrivateKey, vs...))
+}
+
+// PrivateKeyGT applies the GT predicate on the "private_key" field.
+func PrivateKeyGT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldPrivateKey, v))
+}
+
+// PrivateKeyGTE applies the GTE predicate on the "private_key" field.
+func PrivateKeyGTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldPrivateKey, v))
+}
+
+// PrivateKeyLT applies the LT predicate on the "private_key" field.
+func PrivateKeyLT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldPrivateKey, v))
+}
+
+// PrivateKeyLTE applies the LTE predicate on the "private_key" field.
+func PrivateKeyLTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldPrivateKey, v))
+}
+
+// PrivateKeyContains applies the Contains predicate on the "private_key" field.
+func PrivateKeyContains(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContains(FieldPrivateKey, v))
+}
+
+// PrivateKeyHasPrefix applies the HasPrefix predicate on the "private_key" field.
+func PrivateKeyHasPrefix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasPrefix(FieldPrivateKey, v))
+}
+
+// PrivateKeyHasSuffix applies the HasSuffix predicate on the "private_key" field.
+func PrivateKeyHasSuffix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasSuffix(FieldPrivateKey, v))
+}
+
+// PrivateKeyIsNil applies the IsNil predicate on the "private_key" field.
+func PrivateKeyIsNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIsNull(FieldPrivateKey))
+}
+
+// PrivateKeyNotNil applies the NotNil predicate on the "private_key" field.
+func PrivateKeyNotNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotNull(FieldPrivateKey))
+}
+
+// PrivateKeyEqualFold applies the EqualFold predicate on the "private_key" field.
+func PrivateKeyEqualFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldPrivateKey, v))
+}
+
+// PrivateKeyContainsFold applies the ContainsFold predicate on the "private_key" field.
+func PrivateKeyContainsFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldPrivateKey, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenIsNil applies the IsNil predicate on the "token" field.
+func TokenIsNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIsNull(FieldToken))
+}
+
+// TokenNotNil applies the NotNil predicate on the "token" field.
+func TokenNotNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotNull(FieldToken))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldToken, v))
+}
+
+// SnmpPrivPassphraseEQ applies the EQ predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldSnmpPrivPassphrase, v))
+}
+
+// SnmpPrivPassphraseNEQ applies the NEQ predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseNEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldSnmpPrivPassphrase, v))
+}
+
+// SnmpPrivPassphraseIn applies the In predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldSnmpPrivPassphrase, vs...))
+}
+
+// SnmpPrivPassphraseNotIn applies the NotIn predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseNotIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldSnmpPrivPassphrase, vs...))
+}
+
+// SnmpPrivPassphraseGT applies the GT predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseGT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldSnmpPrivPassphrase, v))
+}
+
+// SnmpPrivPassphraseGTE applies the GTE predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseGTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldSnmpPrivPassphrase, v))
+}
+
+// SnmpPrivPassphraseLT applies the LT predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseLT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldSnmpPrivPassphrase, v))
+}
+
+// SnmpPrivPassphraseLTE applies the LTE predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseLTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldSnmpPrivPassphrase, v))
+}
+
+// SnmpPrivPassphraseContains applies the Contains predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseContains(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContains(FieldSnmpPrivPassphrase, v))
+}
+
+// SnmpPrivPassphraseHasPrefix applies the HasPrefix predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseHasPrefix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasPrefix(FieldSnmpPrivPassphrase, v))
+}
+
+// SnmpPrivPassphraseHasSuffix applies the HasSuffix predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseHasSuffix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasSuffix(FieldSnmpPrivPassphrase, v))
+}
+
+// SnmpPrivPassphraseIsNil applies the IsNil predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseIsNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIsNull(FieldSnmpPrivPassphrase))
+}
+
+// SnmpPrivPassphraseNotNil applies the NotNil predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseNotNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotNull(FieldSnmpPrivPassphrase))
+}
+
+// SnmpPrivPassphraseEqualFold applies the EqualFold predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseEqualFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldSnmpPrivPassphrase, v))
+}
+
+// SnmpPrivPassphraseContainsFold applies the ContainsFold predicate on the "snmp_priv_passphrase" field.
+func SnmpPrivPassphraseContainsFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldSnmpPrivPassphrase, v))
+}
+
+// TLSClientKeyEQ applies the EQ predicate on the "tls_client_key" field.
+func TLSClientKeyEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldTLSClientKey, v))
+}
+
+// TLSClientKeyNEQ applies the NEQ predicate on the "tls_client_key" field.
+func TLSClientKeyNEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldTLSClientKey, v))
+}
+
+// TLSClientKeyIn applies the In predicate on the "tls_client_key" field.
+func TLSClientKeyIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldTLSClientKey, vs...))
+}
+
+// TLSClientKeyNotIn applies the NotIn predicate on the "tls_client_key" field.
+func TLSClientKeyNotIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldTLSClientKey, vs...))
+}
+
+// TLSClientKeyGT applies the GT predicate on the "tls_client_key" field.
+func TLSClientKeyGT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldTLSClientKey, v))
+}
+
+// TLSClientKeyGTE applies the GTE predicate on the "tls_client_key" field.
+func TLSClientKeyGTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldTLSClientKey, v))
+}
+
+// TLSClientKeyLT applies the LT predicate on the "tls_client_key" field.
+func TLSClientKeyLT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldTLSClientKey, v))
+}
+
+// TLSClientKeyLTE applies the LTE predicate on the "tls_client_key" field.
+func TLSClientKeyLTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldTLSClientKey, v))
+}
+
+// TLSClientKeyContains applies the Contains predicate on the "tls_client_key" field.
+func TLSClientKeyContains(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContains(FieldTLSClientKey, v))
+}
+
+// TLSClientKeyHasPrefix applies the HasPrefix predicate on the "tls_client_key" field.
+func TLSClientKeyHasPrefix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasPrefix(FieldTLSClientKey, v))
+}
+
+// TLSClientKeyHasSuffix applies the HasSuffix predicate on the "tls_client_key" field.
+func TLSClientKeyHasSuffix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasSuffix(FieldTLSClientKey, v))
+}
+
+// TLSClientKeyIsNil applies the IsNil predicate on the "tls_client_key" field.
+func TLSClientKeyIsNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIsNull(FieldTLSClientKey))
+}
+
+// TLSClientKeyNotNil applies the NotNil predicate on the "tls_client_key" field.
+func TLSClientKeyNotNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotNull(FieldTLSClientKey))
+}
+
+// TLSClientKeyEqualFold applies the EqualFold predicate on the "tls_client_key" field.
+func TLSClientKeyEqualFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldTLSClientKey, v))
+}
+
+// TLSClientKeyContainsFold applies the ContainsFold predicate on the "tls_client_key" field.
+func TLSClientKeyContainsFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldTLSClientKey, v))
+}
+
+// SnmpAuthProtocolEQ applies the EQ predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldSnmpAuthProtocol, v))
+}
+
+// SnmpAuthProtocolNEQ applies the NEQ predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolNEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldSnmpAuthProtocol, v))
+}
+
+// SnmpAuthProtocolIn applies the In predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldSnmpAuthProtocol, vs...))
+}
+
+// SnmpAuthProtocolNotIn applies the NotIn predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolNotIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldSnmpAuthProtocol, vs...))
+}
+
+// SnmpAuthProtocolGT applies the GT predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolGT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldSnmpAuthProtocol, v))
+}
+
+// SnmpAuthProtocolGTE applies the GTE predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolGTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldSnmpAuthProtocol, v))
+}
+
+// SnmpAuthProtocolLT applies the LT predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolLT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldSnmpAuthProtocol, v))
+}
+
+// SnmpAuthProtocolLTE applies the LTE predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolLTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldSnmpAuthProtocol, v))
+}
+
+// SnmpAuthProtocolContains applies the Contains predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolContains(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContains(FieldSnmpAuthProtocol, v))
+}
+
+// SnmpAuthProtocolHasPrefix applies the HasPrefix predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolHasPrefix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasPrefix(FieldSnmpAuthProtocol, v))
+}
+
+// SnmpAuthProtocolHasSuffix applies the HasSuffix predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolHasSuffix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasSuffix(FieldSnmpAuthProtocol, v))
+}
+
+// SnmpAuthProtocolIsNil applies the IsNil predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolIsNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIsNull(FieldSnmpAuthProtocol))
+}
+
+// SnmpAuthProtocolNotNil applies the NotNil predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolNotNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotNull(FieldSnmpAuthProtocol))
+}
+
+// SnmpAuthProtocolEqualFold applies the EqualFold predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolEqualFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldSnmpAuthProtocol, v))
+}
+
+// SnmpAuthProtocolContainsFold applies the ContainsFold predicate on the "snmp_auth_protocol" field.
+func SnmpAuthProtocolContainsFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldSnmpAuthProtocol, v))
+}
+
+// SnmpPrivProtocolEQ applies the EQ predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldSnmpPrivProtocol, v))
+}
+
+// SnmpPrivProtocolNEQ applies the NEQ predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolNEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldSnmpPrivProtocol, v))
+}
+
+// SnmpPrivProtocolIn applies the In predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldSnmpPrivProtocol, vs...))
+}
+
+// SnmpPrivProtocolNotIn applies the NotIn predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolNotIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldSnmpPrivProtocol, vs...))
+}
+
+// SnmpPrivProtocolGT applies the GT predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolGT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldSnmpPrivProtocol, v))
+}
+
+// SnmpPrivProtocolGTE applies the GTE predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolGTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldSnmpPrivProtocol, v))
+}
+
+// SnmpPrivProtocolLT applies the LT predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolLT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldSnmpPrivProtocol, v))
+}
+
+// SnmpPrivProtocolLTE applies the LTE predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolLTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldSnmpPrivProtocol, v))
+}
+
+// SnmpPrivProtocolContains applies the Contains predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolContains(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContains(FieldSnmpPrivProtocol, v))
+}
+
+// SnmpPrivProtocolHasPrefix applies the HasPrefix predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolHasPrefix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasPrefix(FieldSnmpPrivProtocol, v))
+}
+
+// SnmpPrivProtocolHasSuffix applies the HasSuffix predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolHasSuffix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasSuffix(FieldSnmpPrivProtocol, v))
+}
+
+// SnmpPrivProtocolIsNil applies the IsNil predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolIsNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIsNull(FieldSnmpPrivProtocol))
+}
+
+// SnmpPrivProtocolNotNil applies the NotNil predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolNotNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotNull(FieldSnmpPrivProtocol))
+}
+
+// SnmpPrivProtocolEqualFold applies the EqualFold predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolEqualFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldSnmpPrivProtocol, v))
+}
+
+// SnmpPrivProtocolContainsFold applies the ContainsFold predicate on the "snmp_priv_protocol" field.
+func SnmpPrivProtocolContainsFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldSnmpPrivProtocol, v))
+}
+
+// TLSClientCertificateEQ applies the EQ predicate on the "tls_client_certificate" field.
+func TLSClientCertificateEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldTLSClientCertificate, v))
+}
+
+// TLSClientCertificateNEQ applies the NEQ predicate on the "tls_client_certificate" field.
+func TLSClientCertificateNEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldTLSClientCertificate, v))
+}
+
+// TLSClientCertificateIn applies the In predicate on the "tls_client_certificate" field.
+func TLSClientCertificateIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldTLSClientCertificate, vs...))
+}
+
+// TLSClientCertificateNotIn applies the NotIn predicate on the "tls_client_certificate" field.
+func TLSClientCertificateNotIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldTLSClientCertificate, vs...))
+}
+
+// TLSClientCertificateGT applies the GT predicate on the "tls_client_certificate" field.
+func TLSClientCertificateGT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldTLSClientCertificate, v))
+}
+
+// TLSClientCertificateGTE applies the GTE predicate on the "tls_client_certificate" field.
+func TLSClientCertificateGTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldTLSClientCertificate, v))
+}
+
+// TLSClientCertificateLT applies the LT predicate on the "tls_client_certificate" field.
+func TLSClientCertificateLT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldTLSClientCertificate, v))
+}
+
+// TLSClientCertificateLTE applies the LTE predicate on the "tls_client_certificate" field.
+func TLSClientCertificateLTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldTLSClientCertificate, v))
+}
+
+// TLSClientCertificateContains applies the Contains predicate on the "tls_client_certificate" field.
+func TLSClientCertificateContains(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContains(FieldTLSClientCertificate, v))
+}
+
+// TLSClientCertificateHasPrefix applies the HasPrefix predicate on the "tls_client_certificate" field.
+func TLSClientCertificateHasPrefix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasPrefix(FieldTLSClientCertificate, v))
+}
+
+// TLSClientCertificateHasSuffix applies the HasSuffix predicate on the "tls_client_certificate" field.
+func TLSClientCertificateHasSuffix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasSuffix(FieldTLSClientCertificate, v))
+}
+
+// TLSClientCertificateIsNil applies the IsNil predicate on the "tls_client_certificate" field.
+func TLSClientCertificateIsNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIsNull(FieldTLSClientCertificate))
+}
+
+// TLSClientCertificateNotNil applies the NotNil predicate on the "tls_client_certificate" field.
+func TLSClientCertificateNotNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotNull(FieldTLSClientCertificate))
+}
+
+// TLSClientCertificateEqualFold applies the EqualFold predicate on the "tls_client_certificate" field.
+func TLSClientCertificateEqualFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldTLSClientCertificate, v))
+}
+
+// TLSClientCertificateContainsFold applies the ContainsFold predicate on the "tls_client_certificate" field.
+func TLSClientCertificateContainsFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldTLSClientCertificate, v))
+}
+
+// SSHKnownHostsEQ applies the EQ predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldSSHKnownHosts, v))
+}
+
+// SSHKnownHostsNEQ applies the NEQ predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsNEQ(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldSSHKnownHosts, v))
+}
+
+// SSHKnownHostsIn applies the In predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIn(FieldSSHKnownHosts, vs...))
+}
+
+// SSHKnownHostsNotIn applies the NotIn predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsNotIn(vs ...string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotIn(FieldSSHKnownHosts, vs...))
+}
+
+// SSHKnownHostsGT applies the GT predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsGT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGT(FieldSSHKnownHosts, v))
+}
+
+// SSHKnownHostsGTE applies the GTE predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsGTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldGTE(FieldSSHKnownHosts, v))
+}
+
+// SSHKnownHostsLT applies the LT predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsLT(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLT(FieldSSHKnownHosts, v))
+}
+
+// SSHKnownHostsLTE applies the LTE predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsLTE(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldLTE(FieldSSHKnownHosts, v))
+}
+
+// SSHKnownHostsContains applies the Contains predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsContains(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContains(FieldSSHKnownHosts, v))
+}
+
+// SSHKnownHostsHasPrefix applies the HasPrefix predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsHasPrefix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasPrefix(FieldSSHKnownHosts, v))
+}
+
+// SSHKnownHostsHasSuffix applies the HasSuffix predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsHasSuffix(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldHasSuffix(FieldSSHKnownHosts, v))
+}
+
+// SSHKnownHostsIsNil applies the IsNil predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsIsNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIsNull(FieldSSHKnownHosts))
+}
+
+// SSHKnownHostsNotNil applies the NotNil predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsNotNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotNull(FieldSSHKnownHosts))
+}
+
+// SSHKnownHostsEqualFold applies the EqualFold predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsEqualFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEqualFold(FieldSSHKnownHosts, v))
+}
+
+// SSHKnownHostsContainsFold applies the ContainsFold predicate on the "ssh_known_hosts" field.
+func SSHKnownHostsContainsFold(v string) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldContainsFold(FieldSSHKnownHosts, v))
+}
+
+// SSHInsecureIgnoreHostKeyEQ applies the EQ predicate on the "ssh_insecure_ignore_host_key" field.
+func SSHInsecureIgnoreHostKeyEQ(v bool) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldEQ(FieldSSHInsecureIgnoreHostKey, v))
+}
+
+// SSHInsecureIgnoreHostKeyNEQ applies the NEQ predicate on the "ssh_insecure_ignore_host_key" field.
+func SSHInsecureIgnoreHostKeyNEQ(v bool) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNEQ(FieldSSHInsecureIgnoreHostKey, v))
+}
+
+// SSHInsecureIgnoreHostKeyIsNil applies the IsNil predicate on the "ssh_insecure_ignore_host_key" field.
+func SSHInsecureIgnoreHostKeyIsNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldIsNull(FieldSSHInsecureIgnoreHostKey))
+}
+
+// SSHInsecureIgnoreHostKeyNotNil applies the NotNil predicate on the "ssh_insecure_ignore_host_key" field.
+func SSHInsecureIgnoreHostKeyNotNil() predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.FieldNotNull(FieldSSHInsecureIgnoreHostKey))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CredentialProfile) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CredentialProfile) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CredentialProfile) predicate.CredentialProfile {
+	return predicate.CredentialProfile(sql.NotPredicates(p))
+}
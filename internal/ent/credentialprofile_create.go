@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
+)
+
+// CredentialProfileCreate is the builder for creating a CredentialProfile entity.
+type CredentialProfileCreate struct {
+	config
+	mutation *CredentialProfileMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (cpc *CredentialProfileCreate) SetName(s string) *CredentialProfileCreate {
+	cpc.mutation.SetName(s)
+	return cpc
+}
+
+// SetUsername sets the "username" field.
+func (cpc *CredentialProfileCreate) SetUsername(s string) *CredentialProfileCreate {
+	cpc.mutation.SetUsername(s)
+	return cpc
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (cpc *CredentialProfileCreate) SetNillableUsername(s *string) *CredentialProfileCreate {
+	if s != nil {
+		cpc.SetUsername(*s)
+	}
+	return cpc
+}
+
+// SetPassword sets the "password" field.
+func (cpc *CredentialProfileCreate) SetPassword(s string) *CredentialProfileCreate {
+	cpc.mutation.SetPassword(s)
+	return cpc
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (cpc *CredentialProfileCreate) SetNillablePassword(s *string) *CredentialProfileCreate {
+	if s != nil {
+		cpc.SetPassword(*s)
+	}
+	return cpc
+}
+
+// SetSnmpCommunity sets the "snmp_community" field.
+func (cpc *CredentialProfileCreate) SetSnmpCommunity(s string) *CredentialProfileCreate {
+	cpc.mutation.SetSnmpCommunity(s)
+	return cpc
+}
+
+// SetNillableSnmpCommunity sets the "snmp_community" field if the given value is not nil.
+func (cpc *CredentialProfileCreate) SetNillableSnmpCommunity(s *string) *CredentialProfileCreate {
+	if s != nil {
+		cpc.SetSnmpCommunity(*s)
+	}
+	return cpc
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (cpc *CredentialProfileCreate) SetPrivateKey(s string) *CredentialProfileCreate {
+	cpc.mutation.SetPrivateKey(s)
+	return cpc
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (cpc *CredentialProfileCreate) SetNillablePrivateKey(s *string) *CredentialProfileCreate {
+	if s != nil {
+		cpc.SetPrivateKey(*s)
+	}
+	return cpc
+}
+
+// SetToken sets the "token" field.
+func (cpc *CredentialProfileCreate) SetToken(s string) *CredentialProfileCreate {
+	cpc.mutation.SetToken(s)
+	return cpc
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (cpc *CredentialProfileCreate) SetNillableToken(s *string) *CredentialProfileCreate {
+	if s != nil {
+		cpc.SetToken(*s)
+	}
+	return cpc
+}
+
+// SetSnmpPrivPassphrase sets the "snmp_priv_passphrase" field.
+func (cpc *CredentialProfileCreate) SetSnmpPrivPassphrase(s string) *CredentialProfileCreate {
+	cpc.mutation.SetSnmpPrivPassphrase(s)
+	return cpc
+}
+
+// SetNillableSnmpPrivPassphrase sets the "snmp_priv_passphrase" field if the given value is not nil.
+func (cpc *CredentialProfileCreate) SetNillableSnmpPrivPassphrase(s *string) *CredentialProfileCreate {
+	if s != nil {
+		cpc.SetSnmpPrivPassphrase(*s)
+	}
+	return cpc
+}
+
+// SetTLSClientKey sets the "tls_client_key" field.
+func (cpc *CredentialProfileCreate) SetTLSClientKey(s string) *CredentialProfileCreate {
+	cpc.mutation.SetTLSClientKey(s)
+	return cpc
+}
+
+// SetNillableTLSClientKey sets the "tls_client_key" field if the given value is not nil.
+func (cpc *CredentialProfileCreate) SetNillableTLSClientKey(s *string) *CredentialProfileCreate {
+	if s != nil {
+		cpc.SetTLSClientKey(*s)
+	}
+	return cpc
+}
+
+// SetSnmpAuthProtocol sets the "snmp_auth_protocol" field.
+func (cpc *CredentialProfileCreate) SetSnmpAuthProtocol(s string) *CredentialProfileCreate {
+	cpc.mutation.SetSnmpAuthProtocol(s)
+	return cpc
+}
+
+// SetNillableSnmpAuthProtocol sets the "snmp_auth_protocol" field if the given value is not nil.
+func (cpc *CredentialProfileCreate) SetNillableSnmpAuthProtocol(s *string) *CredentialProfileCreate {
+	if s != nil {
+		cpc.SetSnmpAuthProtocol(*s)
+	}
+	return cpc
+}
+
+// SetSnmpPrivProtocol sets the "snmp_priv_protocol" field.
+func (cpc *CredentialProfileCreate) SetSnmpPrivProtocol(s string) *CredentialProfileCreate {
+	cpc.mutation.SetSnmpPrivProtocol(s)
+	return cpc
+}
+
+// SetNillableSnmpPrivProtocol sets the "snmp_priv_protocol" field if the given value is not nil.
+func (cpc *CredentialProfileCreate) SetNillableSnmpPrivProtocol(s *string) *CredentialProfileCreate {
+	if s != nil {
+		cpc.SetSnmpPrivProtocol(*s)
+	}
+	return cpc
+}
+
+// SetTLSClientCertificate sets the "tls_client_certificate" field.
+func (cpc *CredentialProfileCreate) SetTLSClientCertificate(s string) *CredentialProfileCreate {
+	cpc.mutation.SetTLSClientCertificate(s)
+	return cpc
+}
+
+// SetNillableTLSClientCertificate sets the "tls_client_certificate" field if the given value is not nil.
+func (cpc *CredentialProfileCreate) SetNillableTLSClientCertificate(s *string) *CredentialProfileCreate {
+	if s != nil {
+		cpc.SetTLSClientCertificate(*s)
+	}
+	return cpc
+}
+
+// SetSSHKnownHosts sets the "ssh_known_hosts" field.
+func (cpc *CredentialProfileCreate) SetSSHKnownHosts(s string) *CredentialProfileCreate {
+	cpc.mutation.SetSSHKnownHosts(s)
+	return cpc
+}
+
+// SetNillableSSHKnownHosts sets the "ssh_known_hosts" field if the given value is not nil.
+func (cpc *CredentialProfileCreate) SetNillableSSHKnownHosts(s *string) *CredentialProfileCreate {
+	if s != nil {
+		cpc.SetSSHKnownHosts(*s)
+	}
+	return cpc
+}
+
+// SetSSHInsecureIgnoreHostKey sets the "ssh_insecure_ignore_host_key" field.
+func (cpc *CredentialProfileCreate) SetSSHInsecureIgnoreHostKey(b bool) *CredentialProfileCreate {
+	cpc.mutation.SetSSHInsecureIgnoreHostKey(b)
+	return cpc
+}
+
+// SetNillableSSHInsecureIgnoreHostKey sets the "ssh_insecure_ignore_host_key" field if the given value is not nil.
+func (cpc *CredentialProfileCreate) SetNillableSSHInsecureIgnoreHostKey(b *bool) *CredentialProfileCreate {
+	if b != nil {
+		cpc.SetSSHInsecureIgnoreHostKey(*b)
+	}
+	return cpc
+}
+
+// SetID sets the "id" field.
+func (cpc *CredentialProfileCreate) SetID(s string) *CredentialProfileCreate {
+	cpc.mutation.SetID(s)
+	return cpc
+}
+
+// Mutation returns the CredentialProfileMutation object of the builder.
+func (cpc *CredentialProfileCreate) Mutation() *CredentialProfileMutation {
+	return cpc.mutation
+}
+
+// Save creates the CredentialProfile in the database.
+func (cpc *CredentialProfileCreate) Save(ctx context.Context) (*CredentialProfile, error) {
+	return withHooks(ctx, cpc.sqlSave, cpc.mutation, cpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cpc *CredentialProfileCreate) SaveX(ctx context.Context) *CredentialProfile {
+	v, err := cpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cpc *CredentialProfileCreate) Exec(ctx context.Context) error {
+	_, err := cpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpc *CredentialProfileCreate) ExecX(ctx context.Context) {
+	if err := cpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpc *CredentialProfileCreate) check() error {
+	if _, ok := cpc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CredentialProfile.name"`)}
+	}
+	return nil
+}
+
+func (cpc *CredentialProfileCreate) sqlSave(ctx context.Context) (*CredentialProfile, error) {
+	if err := cpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CredentialProfile.ID type: %T", _spec.ID.Value)
+		}
+	}
+	cpc.mutation.id = &_node.ID
+	cpc.mutation.done = true
+	return _node, nil
+}
+
+func (cpc *CredentialProfileCreate) createSpec() (*CredentialProfile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CredentialProfile{config: cpc.config}
+		_spec = sqlgraph.NewCreateSpec(credentialprofile.Table, sqlgraph.NewFieldSpec(credentialprofile.FieldID, field.TypeString))
+	)
+	if id, ok := cpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cpc.mutation.Name(); ok {
+		_spec.SetField(credentialprofile.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cpc.mutation.Username(); ok {
+		_spec.SetField(credentialprofile.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := cpc.mutation.Password(); ok {
+		_spec.SetField(credentialprofile.FieldPassword, field.TypeString, value)
+		_node.Password = value
+	}
+	if value, ok := cpc.mutation.SnmpCommunity(); ok {
+		_spec.SetField(credentialprofile.FieldSnmpCommunity, field.TypeString, value)
+		_node.SnmpCommunity = value
+	}
+	if value, ok := cpc.mutation.PrivateKey(); ok {
+		_spec.SetField(credentialprofile.FieldPrivateKey, field.TypeString, value)
+		_node.PrivateKey = value
+	}
+	if value, ok := cpc.mutation.Token(); ok {
+		_spec.SetField(credentialprofile.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := cpc.mutation.SnmpPrivPassphrase(); ok {
+		_spec.SetField(credentialprofile.FieldSnmpPrivPassphrase, field.TypeString, value)
+		_node.SnmpPrivPassphrase = value
+	}
+	if value, ok := cpc.mutation.TLSClientKey(); ok {
+		_spec.SetField(credentialprofile.FieldTLSClientKey, field.TypeString, value)
+		_node.TLSClientKey = value
+	}
+	if value, ok := cpc.mutation.SnmpAuthProtocol(); ok {
+		_spec.SetField(credentialprofile.FieldSnmpAuthProtocol, field.TypeString, value)
+		_node.SnmpAuthProtocol = value
+	}
+	if value, ok := cpc.mutation.SnmpPrivProtocol(); ok {
+		_spec.SetField(credentialprofile.FieldSnmpPrivProtocol, field.TypeString, value)
+		_node.SnmpPrivProtocol = value
+	}
+	if value, ok := cpc.mutation.TLSClientCertificate(); ok {
+		_spec.SetField(credentialprofile.FieldTLSClientCertificate, field.TypeString, value)
+		_node.TLSClientCertificate = value
+	}
+	if value, ok := cpc.mutation.SSHKnownHosts(); ok {
+		_spec.SetField(credentialprofile.FieldSSHKnownHosts, field.TypeString, value)
+		_node.SSHKnownHosts = value
+	}
+	if value, ok := cpc.mutation.SSHInsecureIgnoreHostKey(); ok {
+		_spec.SetField(credentialprofile.FieldSSHInsecureIgnoreHostKey, field.TypeBool, value)
+		_node.SSHInsecureIgnoreHostKey = value
+	}
+	return _node, _spec
+}
+
+// CredentialProfileCreateBulk is the builder for creating many CredentialProfile entities in bulk.
+type CredentialProfileCreateBulk struct {
+	config
+	err      error
+	builders []*CredentialProfileCreate
+}
+
+// Save creates the CredentialProfile entities in the database.
+func (cpcb *CredentialProfileCreateBulk) Save(ctx context.Context) ([]*CredentialProfile, error) {
+	if cpcb.err != nil {
+		return nil, cpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cpcb.builders))
+	nodes := make([]*CredentialProfile, len(cpcb.builders))
+	mutators := make([]Mutator, len(cpcb.builders))
+	for i := range cpcb.builders {
+		func(i int, root context.Context) {
+			builder := cpcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CredentialProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpcb *CredentialProfileCreateBulk) SaveX(ctx context.Context) []*CredentialProfile {
+	v, err := cpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cpcb *CredentialProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := cpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpcb *CredentialProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := cpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// CredentialProfileDelete is the builder for deleting a CredentialProfile entity.
+type CredentialProfileDelete struct {
+	config
+	hooks    []Hook
+	mutation *CredentialProfileMutation
+}
+
+// Where appends a list predicates to the CredentialProfileDelete builder.
+func (cpd *CredentialProfileDelete) Where(ps ...predicate.CredentialProfile) *CredentialProfileDelete {
+	cpd.mutation.Where(ps...)
+	return cpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cpd *CredentialProfileDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cpd.sqlExec, cpd.mutation, cpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpd *CredentialProfileDelete) ExecX(ctx context.Context) int {
+	n, err := cpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cpd *CredentialProfileDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(credentialprofile.Table, sqlgraph.NewFieldSpec(credentialprofile.FieldID, field.TypeString))
+	if ps := cpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cpd.mutation.done = true
+	return affected, err
+}
+
+// CredentialProfileDeleteOne is the builder for deleting a single CredentialProfile entity.
+type CredentialProfileDeleteOne struct {
+	cpd *CredentialProfileDelete
+}
+
+// Where appends a list predicates to the CredentialProfileDelete builder.
+func (cpdo *CredentialProfileDeleteOne) Where(ps ...predicate.CredentialProfile) *CredentialProfileDeleteOne {
+	cpdo.cpd.mutation.Where(ps...)
+	return cpdo
+}
+
+// Exec executes the deletion query.
+func (cpdo *CredentialProfileDeleteOne) Exec(ctx context.Context) error {
+	n, err := cpdo.cpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{credentialprofile.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpdo *CredentialProfileDeleteOne) ExecX(ctx context.Context) {
+	if err := cpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,833 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// CredentialProfileUpdate is the builder for updating CredentialProfile entities.
+type CredentialProfileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CredentialProfileMutation
+}
+
+// Where appends a list predicates to the CredentialProfileUpdate builder.
+func (cpu *CredentialProfileUpdate) Where(ps ...predicate.CredentialProfile) *CredentialProfileUpdate {
+	cpu.mutation.Where(ps...)
+	return cpu
+}
+
+// SetName sets the "name" field.
+func (cpu *CredentialProfileUpdate) SetName(s string) *CredentialProfileUpdate {
+	cpu.mutation.SetName(s)
+	return cpu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillableName(s *string) *CredentialProfileUpdate {
+	if s != nil {
+		cpu.SetName(*s)
+	}
+	return cpu
+}
+
+// SetUsername sets the "username" field.
+func (cpu *CredentialProfileUpdate) SetUsername(s string) *CredentialProfileUpdate {
+	cpu.mutation.SetUsername(s)
+	return cpu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillableUsername(s *string) *CredentialProfileUpdate {
+	if s != nil {
+		cpu.SetUsername(*s)
+	}
+	return cpu
+}
+
+// ClearUsername clears the value of the "username" field.
+func (cpu *CredentialProfileUpdate) ClearUsername() *CredentialProfileUpdate {
+	cpu.mutation.ClearUsername()
+	return cpu
+}
+
+// SetPassword sets the "password" field.
+func (cpu *CredentialProfileUpdate) SetPassword(s string) *CredentialProfileUpdate {
+	cpu.mutation.SetPassword(s)
+	return cpu
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillablePassword(s *string) *CredentialProfileUpdate {
+	if s != nil {
+		cpu.SetPassword(*s)
+	}
+	return cpu
+}
+
+// ClearPassword clears the value of the "password" field.
+func (cpu *CredentialProfileUpdate) ClearPassword() *CredentialProfileUpdate {
+	cpu.mutation.ClearPassword()
+	return cpu
+}
+
+// SetSnmpCommunity sets the "snmp_community" field.
+func (cpu *CredentialProfileUpdate) SetSnmpCommunity(s string) *CredentialProfileUpdate {
+	cpu.mutation.SetSnmpCommunity(s)
+	return cpu
+}
+
+// SetNillableSnmpCommunity sets the "snmp_community" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillableSnmpCommunity(s *string) *CredentialProfileUpdate {
+	if s != nil {
+		cpu.SetSnmpCommunity(*s)
+	}
+	return cpu
+}
+
+// ClearSnmpCommunity clears the value of the "snmp_community" field.
+func (cpu *CredentialProfileUpdate) ClearSnmpCommunity() *CredentialProfileUpdate {
+	cpu.mutation.ClearSnmpCommunity()
+	return cpu
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (cpu *CredentialProfileUpdate) SetPrivateKey(s string) *CredentialProfileUpdate {
+	cpu.mutation.SetPrivateKey(s)
+	return cpu
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillablePrivateKey(s *string) *CredentialProfileUpdate {
+	if s != nil {
+		cpu.SetPrivateKey(*s)
+	}
+	return cpu
+}
+
+// ClearPrivateKey clears the value of the "private_key" field.
+func (cpu *CredentialProfileUpdate) ClearPrivateKey() *CredentialProfileUpdate {
+	cpu.mutation.ClearPrivateKey()
+	return cpu
+}
+
+// SetToken sets the "token" field.
+func (cpu *CredentialProfileUpdate) SetToken(s string) *CredentialProfileUpdate {
+	cpu.mutation.SetToken(s)
+	return cpu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillableToken(s *string) *CredentialProfileUpdate {
+	if s != nil {
+		cpu.SetToken(*s)
+	}
+	return cpu
+}
+
+// ClearToken clears the value of the "token" field.
+func (cpu *CredentialProfileUpdate) ClearToken() *CredentialProfileUpdate {
+	cpu.mutation.ClearToken()
+	return cpu
+}
+
+// SetSnmpPrivPassphrase sets the "snmp_priv_passphrase" field.
+func (cpu *CredentialProfileUpdate) SetSnmpPrivPassphrase(s string) *CredentialProfileUpdate {
+	cpu.mutation.SetSnmpPrivPassphrase(s)
+	return cpu
+}
+
+// SetNillableSnmpPrivPassphrase sets the "snmp_priv_passphrase" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillableSnmpPrivPassphrase(s *string) *CredentialProfileUpdate {
+	if s != nil {
+		cpu.SetSnmpPrivPassphrase(*s)
+	}
+	return cpu
+}
+
+// ClearSnmpPrivPassphrase clears the value of the "snmp_priv_passphrase" field.
+func (cpu *CredentialProfileUpdate) ClearSnmpPrivPassphrase() *CredentialProfileUpdate {
+	cpu.mutation.ClearSnmpPrivPassphrase()
+	return cpu
+}
+
+// SetTLSClientKey sets the "tls_client_key" field.
+func (cpu *CredentialProfileUpdate) SetTLSClientKey(s string) *CredentialProfileUpdate {
+	cpu.mutation.SetTLSClientKey(s)
+	return cpu
+}
+
+// SetNillableTLSClientKey sets the "tls_client_key" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillableTLSClientKey(s *string) *CredentialProfileUpdate {
+	if s != nil {
+		cpu.SetTLSClientKey(*s)
+	}
+	return cpu
+}
+
+// ClearTLSClientKey clears the value of the "tls_client_key" field.
+func (cpu *CredentialProfileUpdate) ClearTLSClientKey() *CredentialProfileUpdate {
+	cpu.mutation.ClearTLSClientKey()
+	return cpu
+}
+
+// SetSnmpAuthProtocol sets the "snmp_auth_protocol" field.
+func (cpu *CredentialProfileUpdate) SetSnmpAuthProtocol(s string) *CredentialProfileUpdate {
+	cpu.mutation.SetSnmpAuthProtocol(s)
+	return cpu
+}
+
+// SetNillableSnmpAuthProtocol sets the "snmp_auth_protocol" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillableSnmpAuthProtocol(s *string) *CredentialProfileUpdate {
+	if s != nil {
+		cpu.SetSnmpAuthProtocol(*s)
+	}
+	return cpu
+}
+
+// ClearSnmpAuthProtocol clears the value of the "snmp_auth_protocol" field.
+func (cpu *CredentialProfileUpdate) ClearSnmpAuthProtocol() *CredentialProfileUpdate {
+	cpu.mutation.ClearSnmpAuthProtocol()
+	return cpu
+}
+
+// SetSnmpPrivProtocol sets the "snmp_priv_protocol" field.
+func (cpu *CredentialProfileUpdate) SetSnmpPrivProtocol(s string) *CredentialProfileUpdate {
+	cpu.mutation.SetSnmpPrivProtocol(s)
+	return cpu
+}
+
+// SetNillableSnmpPrivProtocol sets the "snmp_priv_protocol" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillableSnmpPrivProtocol(s *string) *CredentialProfileUpdate {
+	if s != nil {
+		cpu.SetSnmpPrivProtocol(*s)
+	}
+	return cpu
+}
+
+// ClearSnmpPrivProtocol clears the value of the "snmp_priv_protocol" field.
+func (cpu *CredentialProfileUpdate) ClearSnmpPrivProtocol() *CredentialProfileUpdate {
+	cpu.mutation.ClearSnmpPrivProtocol()
+	return cpu
+}
+
+// SetTLSClientCertificate sets the "tls_client_certificate" field.
+func (cpu *CredentialProfileUpdate) SetTLSClientCertificate(s string) *CredentialProfileUpdate {
+	cpu.mutation.SetTLSClientCertificate(s)
+	return cpu
+}
+
+// SetNillableTLSClientCertificate sets the "tls_client_certificate" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillableTLSClientCertificate(s *string) *CredentialProfileUpdate {
+	if s != nil {
+		cpu.SetTLSClientCertificate(*s)
+	}
+	return cpu
+}
+
+// ClearTLSClientCertificate clears the value of the "tls_client_certificate" field.
+func (cpu *CredentialProfileUpdate) ClearTLSClientCertificate() *CredentialProfileUpdate {
+	cpu.mutation.ClearTLSClientCertificate()
+	return cpu
+}
+
+// SetSSHKnownHosts sets the "ssh_known_hosts" field.
+func (cpu *CredentialProfileUpdate) SetSSHKnownHosts(s string) *CredentialProfileUpdate {
+	cpu.mutation.SetSSHKnownHosts(s)
+	return cpu
+}
+
+// SetNillableSSHKnownHosts sets the "ssh_known_hosts" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillableSSHKnownHosts(s *string) *CredentialProfileUpdate {
+	if s != nil {
+		cpu.SetSSHKnownHosts(*s)
+	}
+	return cpu
+}
+
+// ClearSSHKnownHosts clears the value of the "ssh_known_hosts" field.
+func (cpu *CredentialProfileUpdate) ClearSSHKnownHosts() *CredentialProfileUpdate {
+	cpu.mutation.ClearSSHKnownHosts()
+	return cpu
+}
+
+// SetSSHInsecureIgnoreHostKey sets the "ssh_insecure_ignore_host_key" field.
+func (cpu *CredentialProfileUpdate) SetSSHInsecureIgnoreHostKey(b bool) *CredentialProfileUpdate {
+	cpu.mutation.SetSSHInsecureIgnoreHostKey(b)
+	return cpu
+}
+
+// SetNillableSSHInsecureIgnoreHostKey sets the "ssh_insecure_ignore_host_key" field if the given value is not nil.
+func (cpu *CredentialProfileUpdate) SetNillableSSHInsecureIgnoreHostKey(b *bool) *CredentialProfileUpdate {
+	if b != nil {
+		cpu.SetSSHInsecureIgnoreHostKey(*b)
+	}
+	return cpu
+}
+
+// ClearSSHInsecureIgnoreHostKey clears the value of the "ssh_insecure_ignore_host_key" field.
+func (cpu *CredentialProfileUpdate) ClearSSHInsecureIgnoreHostKey() *CredentialProfileUpdate {
+	cpu.mutation.ClearSSHInsecureIgnoreHostKey()
+	return cpu
+}
+
+// Mutation returns the CredentialProfileMutation object of the builder.
+func (cpu *CredentialProfileUpdate) Mutation() *CredentialProfileMutation {
+	return cpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cpu *CredentialProfileUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cpu.sqlSave, cpu.mutation, cpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpu *CredentialProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := cpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cpu *CredentialProfileUpdate) Exec(ctx context.Context) error {
+	_, err := cpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpu *CredentialProfileUpdate) ExecX(ctx context.Context) {
+	if err := cpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cpu *CredentialProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(credentialprofile.Table, credentialprofile.Columns, sqlgraph.NewFieldSpec(credentialprofile.FieldID, field.TypeString))
+	if ps := cpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cpu.mutation.Name(); ok {
+		_spec.SetField(credentialprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := cpu.mutation.Username(); ok {
+		_spec.SetField(credentialprofile.FieldUsername, field.TypeString, value)
+	}
+	if cpu.mutation.UsernameCleared() {
+		_spec.ClearField(credentialprofile.FieldUsername, field.TypeString)
+	}
+	if value, ok := cpu.mutation.Password(); ok {
+		_spec.SetField(credentialprofile.FieldPassword, field.TypeString, value)
+	}
+	if cpu.mutation.PasswordCleared() {
+		_spec.ClearField(credentialprofile.FieldPassword, field.TypeString)
+	}
+	if value, ok := cpu.mutation.SnmpCommunity(); ok {
+		_spec.SetField(credentialprofile.FieldSnmpCommunity, field.TypeString, value)
+	}
+	if cpu.mutation.SnmpCommunityCleared() {
+		_spec.ClearField(credentialprofile.FieldSnmpCommunity, field.TypeString)
+	}
+	if value, ok := cpu.mutation.PrivateKey(); ok {
+		_spec.SetField(credentialprofile.FieldPrivateKey, field.TypeString, value)
+	}
+	if cpu.mutation.PrivateKeyCleared() {
+		_spec.ClearField(credentialprofile.FieldPrivateKey, field.TypeString)
+	}
+	if value, ok := cpu.mutation.Token(); ok {
+		_spec.SetField(credentialprofile.FieldToken, field.TypeString, value)
+	}
+	if cpu.mutation.TokenCleared() {
+		_spec.ClearField(credentialprofile.FieldToken, field.TypeString)
+	}
+	if value, ok := cpu.mutation.SnmpPrivPassphrase(); ok {
+		_spec.SetField(credentialprofile.FieldSnmpPrivPassphrase, field.TypeString, value)
+	}
+	if cpu.mutation.SnmpPrivPassphraseCleared() {
+		_spec.ClearField(credentialprofile.FieldSnmpPrivPassphrase, field.TypeString)
+	}
+	if value, ok := cpu.mutation.TLSClientKey(); ok {
+		_spec.SetField(credentialprofile.FieldTLSClientKey, field.TypeString, value)
+	}
+	if cpu.mutation.TLSClientKeyCleared() {
+		_spec.ClearField(credentialprofile.FieldTLSClientKey, field.TypeString)
+	}
+	if value, ok := cpu.mutation.SnmpAuthProtocol(); ok {
+		_spec.SetField(credentialprofile.FieldSnmpAuthProtocol, field.TypeString, value)
+	}
+	if cpu.mutation.SnmpAuthProtocolCleared() {
+		_spec.ClearField(credentialprofile.FieldSnmpAuthProtocol, field.TypeString)
+	}
+	if value, ok := cpu.mutation.SnmpPrivProtocol(); ok {
+		_spec.SetField(credentialprofile.FieldSnmpPrivProtocol, field.TypeString, value)
+	}
+	if cpu.mutation.SnmpPrivProtocolCleared() {
+		_spec.ClearField(credentialprofile.FieldSnmpPrivProtocol, field.TypeString)
+	}
+	if value, ok := cpu.mutation.TLSClientCertificate(); ok {
+		_spec.SetField(credentialprofile.FieldTLSClientCertificate, field.TypeString, value)
+	}
+	if cpu.mutation.TLSClientCertificateCleared() {
+		_spec.ClearField(credentialprofile.FieldTLSClientCertificate, field.TypeString)
+	}
+	if value, ok := cpu.mutation.SSHKnownHosts(); ok {
+		_spec.SetField(credentialprofile.FieldSSHKnownHosts, field.TypeString, value)
+	}
+	if cpu.mutation.SSHKnownHostsCleared() {
+		_spec.ClearField(credentialprofile.FieldSSHKnownHosts, field.TypeString)
+	}
+	if value, ok := cpu.mutation.SSHInsecureIgnoreHostKey(); ok {
+		_spec.SetField(credentialprofile.FieldSSHInsecureIgnoreHostKey, field.TypeBool, value)
+	}
+	if cpu.mutation.SSHInsecureIgnoreHostKeyCleared() {
+		_spec.ClearField(credentialprofile.FieldSSHInsecureIgnoreHostKey, field.TypeBool)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credentialprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cpu.mutation.done = true
+	return n, nil
+}
+
+// CredentialProfileUpdateOne is the builder for updating a single CredentialProfile entity.
+type CredentialProfileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CredentialProfileMutation
+}
+
+// SetName sets the "name" field.
+func (cpuo *CredentialProfileUpdateOne) SetName(s string) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetName(s)
+	return cpuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillableName(s *string) *CredentialProfileUpdateOne {
+	if s != nil {
+		cpuo.SetName(*s)
+	}
+	return cpuo
+}
+
+// SetUsername sets the "username" field.
+func (cpuo *CredentialProfileUpdateOne) SetUsername(s string) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetUsername(s)
+	return cpuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillableUsername(s *string) *CredentialProfileUpdateOne {
+	if s != nil {
+		cpuo.SetUsername(*s)
+	}
+	return cpuo
+}
+
+// ClearUsername clears the value of the "username" field.
+func (cpuo *CredentialProfileUpdateOne) ClearUsername() *CredentialProfileUpdateOne {
+	cpuo.mutation.ClearUsername()
+	return cpuo
+}
+
+// SetPassword sets the "password" field.
+func (cpuo *CredentialProfileUpdateOne) SetPassword(s string) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetPassword(s)
+	return cpuo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillablePassword(s *string) *CredentialProfileUpdateOne {
+	if s != nil {
+		cpuo.SetPassword(*s)
+	}
+	return cpuo
+}
+
+// ClearPassword clears the value of the "password" field.
+func (cpuo *CredentialProfileUpdateOne) ClearPassword() *CredentialProfileUpdateOne {
+	cpuo.mutation.ClearPassword()
+	return cpuo
+}
+
+// SetSnmpCommunity sets the "snmp_community" field.
+func (cpuo *CredentialProfileUpdateOne) SetSnmpCommunity(s string) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetSnmpCommunity(s)
+	return cpuo
+}
+
+// SetNillableSnmpCommunity sets the "snmp_community" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillableSnmpCommunity(s *string) *CredentialProfileUpdateOne {
+	if s != nil {
+		cpuo.SetSnmpCommunity(*s)
+	}
+	return cpuo
+}
+
+// ClearSnmpCommunity clears the value of the "snmp_community" field.
+func (cpuo *CredentialProfileUpdateOne) ClearSnmpCommunity() *CredentialProfileUpdateOne {
+	cpuo.mutation.ClearSnmpCommunity()
+	return cpuo
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (cpuo *CredentialProfileUpdateOne) SetPrivateKey(s string) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetPrivateKey(s)
+	return cpuo
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillablePrivateKey(s *string) *CredentialProfileUpdateOne {
+	if s != nil {
+		cpuo.SetPrivateKey(*s)
+	}
+	return cpuo
+}
+
+// ClearPrivateKey clears the value of the "private_key" field.
+func (cpuo *CredentialProfileUpdateOne) ClearPrivateKey() *CredentialProfileUpdateOne {
+	cpuo.mutation.ClearPrivateKey()
+	return cpuo
+}
+
+// SetToken sets the "token" field.
+func (cpuo *CredentialProfileUpdateOne) SetToken(s string) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetToken(s)
+	return cpuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillableToken(s *string) *CredentialProfileUpdateOne {
+	if s != nil {
+		cpuo.SetToken(*s)
+	}
+	return cpuo
+}
+
+// ClearToken clears the value of the "token" field.
+func (cpuo *CredentialProfileUpdateOne) ClearToken() *CredentialProfileUpdateOne {
+	cpuo.mutation.ClearToken()
+	return cpuo
+}
+
+// SetSnmpPrivPassphrase sets the "snmp_priv_passphrase" field.
+func (cpuo *CredentialProfileUpdateOne) SetSnmpPrivPassphrase(s string) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetSnmpPrivPassphrase(s)
+	return cpuo
+}
+
+// SetNillableSnmpPrivPassphrase sets the "snmp_priv_passphrase" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillableSnmpPrivPassphrase(s *string) *CredentialProfileUpdateOne {
+	if s != nil {
+		cpuo.SetSnmpPrivPassphrase(*s)
+	}
+	return cpuo
+}
+
+// ClearSnmpPrivPassphrase clears the value of the "snmp_priv_passphrase" field.
+func (cpuo *CredentialProfileUpdateOne) ClearSnmpPrivPassphrase() *CredentialProfileUpdateOne {
+	cpuo.mutation.ClearSnmpPrivPassphrase()
+	return cpuo
+}
+
+// SetTLSClientKey sets the "tls_client_key" field.
+func (cpuo *CredentialProfileUpdateOne) SetTLSClientKey(s string) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetTLSClientKey(s)
+	return cpuo
+}
+
+// SetNillableTLSClientKey sets the "tls_client_key" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillableTLSClientKey(s *string) *CredentialProfileUpdateOne {
+	if s != nil {
+		cpuo.SetTLSClientKey(*s)
+	}
+	return cpuo
+}
+
+// ClearTLSClientKey clears the value of the "tls_client_key" field.
+func (cpuo *CredentialProfileUpdateOne) ClearTLSClientKey() *CredentialProfileUpdateOne {
+	cpuo.mutation.ClearTLSClientKey()
+	return cpuo
+}
+
+// SetSnmpAuthProtocol sets the "snmp_auth_protocol" field.
+func (cpuo *CredentialProfileUpdateOne) SetSnmpAuthProtocol(s string) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetSnmpAuthProtocol(s)
+	return cpuo
+}
+
+// SetNillableSnmpAuthProtocol sets the "snmp_auth_protocol" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillableSnmpAuthProtocol(s *string) *CredentialProfileUpdateOne {
+	if s != nil {
+		cpuo.SetSnmpAuthProtocol(*s)
+	}
+	return cpuo
+}
+
+// ClearSnmpAuthProtocol clears the value of the "snmp_auth_protocol" field.
+func (cpuo *CredentialProfileUpdateOne) ClearSnmpAuthProtocol() *CredentialProfileUpdateOne {
+	cpuo.mutation.ClearSnmpAuthProtocol()
+	return cpuo
+}
+
+// SetSnmpPrivProtocol sets the "snmp_priv_protocol" field.
+func (cpuo *CredentialProfileUpdateOne) SetSnmpPrivProtocol(s string) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetSnmpPrivProtocol(s)
+	return cpuo
+}
+
+// SetNillableSnmpPrivProtocol sets the "snmp_priv_protocol" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillableSnmpPrivProtocol(s *string) *CredentialProfileUpdateOne {
+	if s != nil {
+		cpuo.SetSnmpPrivProtocol(*s)
+	}
+	return cpuo
+}
+
+// ClearSnmpPrivProtocol clears the value of the "snmp_priv_protocol" field.
+func (cpuo *CredentialProfileUpdateOne) ClearSnmpPrivProtocol() *CredentialProfileUpdateOne {
+	cpuo.mutation.ClearSnmpPrivProtocol()
+	return cpuo
+}
+
+// SetTLSClientCertificate sets the "tls_client_certificate" field.
+func (cpuo *CredentialProfileUpdateOne) SetTLSClientCertificate(s string) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetTLSClientCertificate(s)
+	return cpuo
+}
+
+// SetNillableTLSClientCertificate sets the "tls_client_certificate" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillableTLSClientCertificate(s *string) *CredentialProfileUpdateOne {
+	if s != nil {
+		cpuo.SetTLSClientCertificate(*s)
+	}
+	return cpuo
+}
+
+// ClearTLSClientCertificate clears the value of the "tls_client_certificate" field.
+func (cpuo *CredentialProfileUpdateOne) ClearTLSClientCertificate() *CredentialProfileUpdateOne {
+	cpuo.mutation.ClearTLSClientCertificate()
+	return cpuo
+}
+
+// SetSSHKnownHosts sets the "ssh_known_hosts" field.
+func (cpuo *CredentialProfileUpdateOne) SetSSHKnownHosts(s string) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetSSHKnownHosts(s)
+	return cpuo
+}
+
+// SetNillableSSHKnownHosts sets the "ssh_known_hosts" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillableSSHKnownHosts(s *string) *CredentialProfileUpdateOne {
+	if s != nil {
+		cpuo.SetSSHKnownHosts(*s)
+	}
+	return cpuo
+}
+
+// ClearSSHKnownHosts clears the value of the "ssh_known_hosts" field.
+func (cpuo *CredentialProfileUpdateOne) ClearSSHKnownHosts() *CredentialProfileUpdateOne {
+	cpuo.mutation.ClearSSHKnownHosts()
+	return cpuo
+}
+
+// SetSSHInsecureIgnoreHostKey sets the "ssh_insecure_ignore_host_key" field.
+func (cpuo *CredentialProfileUpdateOne) SetSSHInsecureIgnoreHostKey(b bool) *CredentialProfileUpdateOne {
+	cpuo.mutation.SetSSHInsecureIgnoreHostKey(b)
+	return cpuo
+}
+
+// SetNillableSSHInsecureIgnoreHostKey sets the "ssh_insecure_ignore_host_key" field if the given value is not nil.
+func (cpuo *CredentialProfileUpdateOne) SetNillableSSHInsecureIgnoreHostKey(b *bool) *CredentialProfileUpdateOne {
+	if b != nil {
+		cpuo.SetSSHInsecureIgnoreHostKey(*b)
+	}
+	return cpuo
+}
+
+// ClearSSHInsecureIgnoreHostKey clears the value of the "ssh_insecure_ignore_host_key" field.
+func (cpuo *CredentialProfileUpdateOne) ClearSSHInsecureIgnoreHostKey() *CredentialProfileUpdateOne {
+	cpuo.mutation.ClearSSHInsecureIgnoreHostKey()
+	return cpuo
+}
+
+// Mutation returns the CredentialProfileMutation object of the builder.
+func (cpuo *CredentialProfileUpdateOne) Mutation() *CredentialProfileMutation {
+	return cpuo.mutation
+}
+
+// Where appends a list predicates to the CredentialProfileUpdate builder.
+func (cpuo *CredentialProfileUpdateOne) Where(ps ...predicate.CredentialProfile) *CredentialProfileUpdateOne {
+	cpuo.mutation.Where(ps...)
+	return cpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cpuo *CredentialProfileUpdateOne) Select(field string, fields ...string) *CredentialProfileUpdateOne {
+	cpuo.fields = append([]string{field}, fields...)
+	return cpuo
+}
+
+// Save executes the query and returns the updated CredentialProfile entity.
+func (cpuo *CredentialProfileUpdateOne) Save(ctx context.Context) (*CredentialProfile, error) {
+	return withHooks(ctx, cpuo.sqlSave, cpuo.mutation, cpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpuo *CredentialProfileUpdateOne) SaveX(ctx context.Context) *CredentialProfile {
+	node, err := cpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cpuo *CredentialProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := cpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpuo *CredentialProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := cpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cpuo *CredentialProfileUpdateOne) sqlSave(ctx context.Context) (_node *CredentialProfile, err error) {
+	_spec := sqlgraph.NewUpdateSpec(credentialprofile.Table, credentialprofile.Columns, sqlgraph.NewFieldSpec(credentialprofile.FieldID, field.TypeString))
+	id, ok := cpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CredentialProfile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, credentialprofile.FieldID)
+		for _, f := range fields {
+			if !credentialprofile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != credentialprofile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cpuo.mutation.Name(); ok {
+		_spec.SetField(credentialprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := cpuo.mutation.Username(); ok {
+		_spec.SetField(credentialprofile.FieldUsername, field.TypeString, value)
+	}
+	if cpuo.mutation.UsernameCleared() {
+		_spec.ClearField(credentialprofile.FieldUsername, field.TypeString)
+	}
+	if value, ok := cpuo.mutation.Password(); ok {
+		_spec.SetField(credentialprofile.FieldPassword, field.TypeString, value)
+	}
+	if cpuo.mutation.PasswordCleared() {
+		_spec.ClearField(credentialprofile.FieldPassword, field.TypeString)
+	}
+	if value, ok := cpuo.mutation.SnmpCommunity(); ok {
+		_spec.SetField(credentialprofile.FieldSnmpCommunity, field.TypeString, value)
+	}
+	if cpuo.mutation.SnmpCommunityCleared() {
+		_spec.ClearField(credentialprofile.FieldSnmpCommunity, field.TypeString)
+	}
+	if value, ok := cpuo.mutation.PrivateKey(); ok {
+		_spec.SetField(credentialprofile.FieldPrivateKey, field.TypeString, value)
+	}
+	if cpuo.mutation.PrivateKeyCleared() {
+		_spec.ClearField(credentialprofile.FieldPrivateKey, field.TypeString)
+	}
+	if value, ok := cpuo.mutation.Token(); ok {
+		_spec.SetField(credentialprofile.FieldToken, field.TypeString, value)
+	}
+	if cpuo.mutation.TokenCleared() {
+		_spec.ClearField(credentialprofile.FieldToken, field.TypeString)
+	}
+	if value, ok := cpuo.mutation.SnmpPrivPassphrase(); ok {
+		_spec.SetField(credentialprofile.FieldSnmpPrivPassphrase, field.TypeString, value)
+	}
+	if cpuo.mutation.SnmpPrivPassphraseCleared() {
+		_spec.ClearField(credentialprofile.FieldSnmpPrivPassphrase, field.TypeString)
+	}
+	if value, ok := cpuo.mutation.TLSClientKey(); ok {
+		_spec.SetField(credentialprofile.FieldTLSClientKey, field.TypeString, value)
+	}
+	if cpuo.mutation.TLSClientKeyCleared() {
+		_spec.ClearField(credentialprofile.FieldTLSClientKey, field.TypeString)
+	}
+	if value, ok := cpuo.mutation.SnmpAuthProtocol(); ok {
+		_spec.SetField(credentialprofile.FieldSnmpAuthProtocol, field.TypeString, value)
+	}
+	if cpuo.mutation.SnmpAuthProtocolCleared() {
+		_spec.ClearField(credentialprofile.FieldSnmpAuthProtocol, field.TypeString)
+	}
+	if value, ok := cpuo.mutation.SnmpPrivProtocol(); ok {
+		_spec.SetField(credentialprofile.FieldSnmpPrivProtocol, field.TypeString, value)
+	}
+	if cpuo.mutation.SnmpPrivProtocolCleared() {
+		_spec.ClearField(credentialprofile.FieldSnmpPrivProtocol, field.TypeString)
+	}
+	if value, ok := cpuo.mutation.TLSClientCertificate(); ok {
+		_spec.SetField(credentialprofile.FieldTLSClientCertificate, field.TypeString, value)
+	}
+	if cpuo.mutation.TLSClientCertificateCleared() {
+		_spec.ClearField(credentialprofile.FieldTLSClientCertificate, field.TypeString)
+	}
+	if value, ok := cpuo.mutation.SSHKnownHosts(); ok {
+		_spec.SetField(credentialprofile.FieldSSHKnownHosts, field.TypeString, value)
+	}
+	if cpuo.mutation.SSHKnownHostsCleared() {
+		_spec.ClearField(credentialprofile.FieldSSHKnownHosts, field.TypeString)
+	}
+	if value, ok := cpuo.mutation.SSHInsecureIgnoreHostKey(); ok {
+		_spec.SetField(credentialprofile.FieldSSHInsecureIgnoreHostKey, field.TypeBool, value)
+	}
+	if cpuo.mutation.SSHInsecureIgnoreHostKeyCleared() {
+		_spec.ClearField(credentialprofile.FieldSSHInsecureIgnoreHostKey, field.TypeBool)
+	}
+	_node = &CredentialProfile{config: cpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credentialprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cpuo.mutation.done = true
+	return _node, nil
+}
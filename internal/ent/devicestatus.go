@@ -23,6 +23,22 @@ type DeviceStatus struct {
 	LastSeen string `json:"last_seen,omitempty"`
 	// ConsequentialFailedConnectivityAttempts holds the value of the "consequential_failed_connectivity_attempts" field.
 	ConsequentialFailedConnectivityAttempts int32 `json:"consequential_failed_connectivity_attempts,omitempty"`
+	// NextPoll holds the value of the "next_poll" field.
+	NextPoll int64 `json:"next_poll,omitempty"`
+	// PendingStatus holds the value of the "pending_status" field.
+	PendingStatus devicestatus.PendingStatus `json:"pending_status,omitempty"`
+	// PendingReadings holds the value of the "pending_readings" field.
+	PendingReadings int32 `json:"pending_readings,omitempty"`
+	// Flapping holds the value of the "flapping" field.
+	Flapping bool `json:"flapping,omitempty"`
+	// InMaintenance holds the value of the "in_maintenance" field.
+	InMaintenance bool `json:"in_maintenance,omitempty"`
+	// AnsweredEndpointID holds the value of the "answered_endpoint_id" field.
+	AnsweredEndpointID string `json:"answered_endpoint_id,omitempty"`
+	// AnsweredProtocol holds the value of the "answered_protocol" field.
+	AnsweredProtocol devicestatus.AnsweredProtocol `json:"answered_protocol,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DeviceStatusQuery when eager-loading is set.
 	Edges                        DeviceStatusEdges `json:"edges"`
@@ -55,9 +71,11 @@ func (*DeviceStatus) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case devicestatus.FieldConsequentialFailedConnectivityAttempts:
+		case devicestatus.FieldFlapping, devicestatus.FieldInMaintenance:
+			values[i] = new(sql.NullBool)
+		case devicestatus.FieldConsequentialFailedConnectivityAttempts, devicestatus.FieldNextPoll, devicestatus.FieldPendingReadings:
 			values[i] = new(sql.NullInt64)
-		case devicestatus.FieldID, devicestatus.FieldStatus, devicestatus.FieldLastSeen:
+		case devicestatus.FieldID, devicestatus.FieldStatus, devicestatus.FieldLastSeen, devicestatus.FieldPendingStatus, devicestatus.FieldAnsweredEndpointID, devicestatus.FieldAnsweredProtocol, devicestatus.FieldLastError:
 			values[i] = new(sql.NullString)
 		case devicestatus.ForeignKeys[0]: // device_status_network_device
 			values[i] = new(sql.NullString)
@@ -100,6 +118,54 @@ func (ds *DeviceStatus) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ds.ConsequentialFailedConnectivityAttempts = int32(value.Int64)
 			}
+		case devicestatus.FieldNextPoll:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field next_poll", values[i])
+			} else if value.Valid {
+				ds.NextPoll = value.Int64
+			}
+		case devicestatus.FieldPendingStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_status", values[i])
+			} else if value.Valid {
+				ds.PendingStatus = devicestatus.PendingStatus(value.String)
+			}
+		case devicestatus.FieldPendingReadings:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_readings", values[i])
+			} else if value.Valid {
+				ds.PendingReadings = int32(value.Int64)
+			}
+		case devicestatus.FieldFlapping:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field flapping", values[i])
+			} else if value.Valid {
+				ds.Flapping = value.Bool
+			}
+		case devicestatus.FieldInMaintenance:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field in_maintenance", values[i])
+			} else if value.Valid {
+				ds.InMaintenance = value.Bool
+			}
+		case devicestatus.FieldAnsweredEndpointID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answered_endpoint_id", values[i])
+			} else if value.Valid {
+				ds.AnsweredEndpointID = value.String
+			}
+		case devicestatus.FieldAnsweredProtocol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answered_protocol", values[i])
+			} else if value.Valid {
+				ds.AnsweredProtocol = devicestatus.AnsweredProtocol(value.String)
+			}
+		case devicestatus.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				ds.LastError = value.String
+			}
 		case devicestatus.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field device_status_network_device", values[i])
@@ -156,6 +222,30 @@ func (ds *DeviceStatus) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("consequential_failed_connectivity_attempts=")
 	builder.WriteString(fmt.Sprintf("%v", ds.ConsequentialFailedConnectivityAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("next_poll=")
+	builder.WriteString(fmt.Sprintf("%v", ds.NextPoll))
+	builder.WriteString(", ")
+	builder.WriteString("pending_status=")
+	builder.WriteString(fmt.Sprintf("%v", ds.PendingStatus))
+	builder.WriteString(", ")
+	builder.WriteString("pending_readings=")
+	builder.WriteString(fmt.Sprintf("%v", ds.PendingReadings))
+	builder.WriteString(", ")
+	builder.WriteString("flapping=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Flapping))
+	builder.WriteString(", ")
+	builder.WriteString("in_maintenance=")
+	builder.WriteString(fmt.Sprintf("%v", ds.InMaintenance))
+	builder.WriteString(", ")
+	builder.WriteString("answered_endpoint_id=")
+	builder.WriteString(ds.AnsweredEndpointID)
+	builder.WriteString(", ")
+	builder.WriteString("answered_protocol=")
+	builder.WriteString(fmt.Sprintf("%v", ds.AnsweredProtocol))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(ds.LastError)
 	builder.WriteByte(')')
 	return builder.String()
 }
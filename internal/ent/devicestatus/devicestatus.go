@@ -20,6 +20,22 @@ const (
 	FieldLastSeen = "last_seen"
 	// FieldConsequentialFailedConnectivityAttempts holds the string denoting the consequential_failed_connectivity_attempts field in the database.
 	FieldConsequentialFailedConnectivityAttempts = "consequential_failed_connectivity_attempts"
+	// FieldNextPoll holds the string denoting the next_poll field in the database.
+	FieldNextPoll = "next_poll"
+	// FieldPendingStatus holds the string denoting the pending_status field in the database.
+	FieldPendingStatus = "pending_status"
+	// FieldPendingReadings holds the string denoting the pending_readings field in the database.
+	FieldPendingReadings = "pending_readings"
+	// FieldFlapping holds the string denoting the flapping field in the database.
+	FieldFlapping = "flapping"
+	// FieldInMaintenance holds the string denoting the in_maintenance field in the database.
+	FieldInMaintenance = "in_maintenance"
+	// FieldAnsweredEndpointID holds the string denoting the answered_endpoint_id field in the database.
+	FieldAnsweredEndpointID = "answered_endpoint_id"
+	// FieldAnsweredProtocol holds the string denoting the answered_protocol field in the database.
+	FieldAnsweredProtocol = "answered_protocol"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
 	// EdgeNetworkDevice holds the string denoting the network_device edge name in mutations.
 	EdgeNetworkDevice = "network_device"
 	// Table holds the table name of the devicestatus in the database.
@@ -39,6 +55,14 @@ var Columns = []string{
 	FieldStatus,
 	FieldLastSeen,
 	FieldConsequentialFailedConnectivityAttempts,
+	FieldNextPoll,
+	FieldPendingStatus,
+	FieldPendingReadings,
+	FieldFlapping,
+	FieldInMaintenance,
+	FieldAnsweredEndpointID,
+	FieldAnsweredProtocol,
+	FieldLastError,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "device_status"
@@ -67,10 +91,11 @@ type Status string
 
 // Status values.
 const (
-	StatusSTATUS_UNSPECIFIED      Status = "STATUS_UNSPECIFIED"
-	StatusSTATUS_DEVICE_DOWN      Status = "STATUS_DEVICE_DOWN"
-	StatusSTATUS_DEVICE_UNHEALTHY Status = "STATUS_DEVICE_UNHEALTHY"
-	StatusSTATUS_DEVICE_UP        Status = "STATUS_DEVICE_UP"
+	StatusSTATUS_UNSPECIFIED        Status = "STATUS_UNSPECIFIED"
+	StatusSTATUS_DEVICE_DOWN        Status = "STATUS_DEVICE_DOWN"
+	StatusSTATUS_DEVICE_UNHEALTHY   Status = "STATUS_DEVICE_UNHEALTHY"
+	StatusSTATUS_DEVICE_UP          Status = "STATUS_DEVICE_UP"
+	StatusSTATUS_DEVICE_UNREACHABLE Status = "STATUS_DEVICE_UNREACHABLE"
 )
 
 func (s Status) String() string {
@@ -80,13 +105,69 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusSTATUS_UNSPECIFIED, StatusSTATUS_DEVICE_DOWN, StatusSTATUS_DEVICE_UNHEALTHY, StatusSTATUS_DEVICE_UP:
+	case StatusSTATUS_UNSPECIFIED, StatusSTATUS_DEVICE_DOWN, StatusSTATUS_DEVICE_UNHEALTHY, StatusSTATUS_DEVICE_UP, StatusSTATUS_DEVICE_UNREACHABLE:
 		return nil
 	default:
 		return fmt.Errorf("devicestatus: invalid enum value for status field: %q", s)
 	}
 }
 
+// PendingStatus defines the type for the "pending_status" enum field.
+type PendingStatus string
+
+// PendingStatus values.
+const (
+	PendingStatusSTATUS_UNSPECIFIED        PendingStatus = "STATUS_UNSPECIFIED"
+	PendingStatusSTATUS_DEVICE_DOWN        PendingStatus = "STATUS_DEVICE_DOWN"
+	PendingStatusSTATUS_DEVICE_UNHEALTHY   PendingStatus = "STATUS_DEVICE_UNHEALTHY"
+	PendingStatusSTATUS_DEVICE_UP          PendingStatus = "STATUS_DEVICE_UP"
+	PendingStatusSTATUS_DEVICE_UNREACHABLE PendingStatus = "STATUS_DEVICE_UNREACHABLE"
+)
+
+func (ps PendingStatus) String() string {
+	return string(ps)
+}
+
+// PendingStatusValidator is a validator for the "pending_status" field enum values. It is called by the builders before save.
+func PendingStatusValidator(ps PendingStatus) error {
+	switch ps {
+	case PendingStatusSTATUS_UNSPECIFIED, PendingStatusSTATUS_DEVICE_DOWN, PendingStatusSTATUS_DEVICE_UNHEALTHY, PendingStatusSTATUS_DEVICE_UP, PendingStatusSTATUS_DEVICE_UNREACHABLE:
+		return nil
+	default:
+		return fmt.Errorf("devicestatus: invalid enum value for pending_status field: %q", ps)
+	}
+}
+
+// AnsweredProtocol defines the type for the "answered_protocol" enum field.
+type AnsweredProtocol string
+
+// AnsweredProtocol values.
+const (
+	AnsweredProtocolPROTOCOL_UNSPECIFIED   AnsweredProtocol = "PROTOCOL_UNSPECIFIED"
+	AnsweredProtocolPROTOCOL_SNMP          AnsweredProtocol = "PROTOCOL_SNMP"
+	AnsweredProtocolPROTOCOL_NETCONF       AnsweredProtocol = "PROTOCOL_NETCONF"
+	AnsweredProtocolPROTOCOL_RESTCONF      AnsweredProtocol = "PROTOCOL_RESTCONF"
+	AnsweredProtocolPROTOCOL_OPEN_V_SWITCH AnsweredProtocol = "PROTOCOL_OPEN_V_SWITCH"
+	AnsweredProtocolPROTOCOL_GNMI          AnsweredProtocol = "PROTOCOL_GNMI"
+	AnsweredProtocolPROTOCOL_TCP_CONNECT   AnsweredProtocol = "PROTOCOL_TCP_CONNECT"
+	AnsweredProtocolPROTOCOL_ICMP          AnsweredProtocol = "PROTOCOL_ICMP"
+	AnsweredProtocolPROTOCOL_CUSTOM        AnsweredProtocol = "PROTOCOL_CUSTOM"
+)
+
+func (ap AnsweredProtocol) String() string {
+	return string(ap)
+}
+
+// AnsweredProtocolValidator is a validator for the "answered_protocol" field enum values. It is called by the builders before save.
+func AnsweredProtocolValidator(ap AnsweredProtocol) error {
+	switch ap {
+	case AnsweredProtocolPROTOCOL_UNSPECIFIED, AnsweredProtocolPROTOCOL_SNMP, AnsweredProtocolPROTOCOL_NETCONF, AnsweredProtocolPROTOCOL_RESTCONF, AnsweredProtocolPROTOCOL_OPEN_V_SWITCH, AnsweredProtocolPROTOCOL_GNMI, AnsweredProtocolPROTOCOL_TCP_CONNECT, AnsweredProtocolPROTOCOL_ICMP, AnsweredProtocolPROTOCOL_CUSTOM:
+		return nil
+	default:
+		return fmt.Errorf("devicestatus: invalid enum value for answered_protocol field: %q", ap)
+	}
+}
+
 // OrderOption defines the ordering options for the DeviceStatus queries.
 type OrderOption func(*sql.Selector)
 
@@ -110,6 +191,46 @@ func ByConsequentialFailedConnectivityAttempts(opts ...sql.OrderTermOption) Orde
 	return sql.OrderByField(FieldConsequentialFailedConnectivityAttempts, opts...).ToFunc()
 }
 
+// ByNextPoll orders the results by the next_poll field.
+func ByNextPoll(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextPoll, opts...).ToFunc()
+}
+
+// ByPendingStatus orders the results by the pending_status field.
+func ByPendingStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingStatus, opts...).ToFunc()
+}
+
+// ByPendingReadings orders the results by the pending_readings field.
+func ByPendingReadings(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingReadings, opts...).ToFunc()
+}
+
+// ByFlapping orders the results by the flapping field.
+func ByFlapping(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlapping, opts...).ToFunc()
+}
+
+// ByInMaintenance orders the results by the in_maintenance field.
+func ByInMaintenance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInMaintenance, opts...).ToFunc()
+}
+
+// ByAnsweredEndpointID orders the results by the answered_endpoint_id field.
+func ByAnsweredEndpointID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnsweredEndpointID, opts...).ToFunc()
+}
+
+// ByAnsweredProtocol orders the results by the answered_protocol field.
+func ByAnsweredProtocol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnsweredProtocol, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
 // ByNetworkDeviceField orders the results by network_device field.
 func ByNetworkDeviceField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -73,6 +73,36 @@ func ConsequentialFailedConnectivityAttempts(v int32) predicate.DeviceStatus {
 	return predicate.DeviceStatus(sql.FieldEQ(FieldConsequentialFailedConnectivityAttempts, v))
 }
 
+// NextPoll applies equality check predicate on the "next_poll" field. It's identical to NextPollEQ.
+func NextPoll(v int64) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldNextPoll, v))
+}
+
+// PendingReadings applies equality check predicate on the "pending_readings" field. It's identical to PendingReadingsEQ.
+func PendingReadings(v int32) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldPendingReadings, v))
+}
+
+// Flapping applies equality check predicate on the "flapping" field. It's identical to FlappingEQ.
+func Flapping(v bool) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldFlapping, v))
+}
+
+// InMaintenance applies equality check predicate on the "in_maintenance" field. It's identical to InMaintenanceEQ.
+func InMaintenance(v bool) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldInMaintenance, v))
+}
+
+// AnsweredEndpointID applies equality check predicate on the "answered_endpoint_id" field. It's identical to AnsweredEndpointIDEQ.
+func AnsweredEndpointID(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldAnsweredEndpointID, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldLastError, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.DeviceStatus {
 	return predicate.DeviceStatus(sql.FieldEQ(FieldStatus, v))
@@ -208,6 +238,356 @@ func ConsequentialFailedConnectivityAttemptsLTE(v int32) predicate.DeviceStatus
 	return predicate.DeviceStatus(sql.FieldLTE(FieldConsequentialFailedConnectivityAttempts, v))
 }
 
+// NextPollEQ applies the EQ predicate on the "next_poll" field.
+func NextPollEQ(v int64) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldNextPoll, v))
+}
+
+// NextPollNEQ applies the NEQ predicate on the "next_poll" field.
+func NextPollNEQ(v int64) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNEQ(FieldNextPoll, v))
+}
+
+// NextPollIn applies the In predicate on the "next_poll" field.
+func NextPollIn(vs ...int64) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIn(FieldNextPoll, vs...))
+}
+
+// NextPollNotIn applies the NotIn predicate on the "next_poll" field.
+func NextPollNotIn(vs ...int64) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotIn(FieldNextPoll, vs...))
+}
+
+// NextPollGT applies the GT predicate on the "next_poll" field.
+func NextPollGT(v int64) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldGT(FieldNextPoll, v))
+}
+
+// NextPollGTE applies the GTE predicate on the "next_poll" field.
+func NextPollGTE(v int64) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldGTE(FieldNextPoll, v))
+}
+
+// NextPollLT applies the LT predicate on the "next_poll" field.
+func NextPollLT(v int64) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldLT(FieldNextPoll, v))
+}
+
+// NextPollLTE applies the LTE predicate on the "next_poll" field.
+func NextPollLTE(v int64) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldLTE(FieldNextPoll, v))
+}
+
+// NextPollIsNil applies the IsNil predicate on the "next_poll" field.
+func NextPollIsNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIsNull(FieldNextPoll))
+}
+
+// NextPollNotNil applies the NotNil predicate on the "next_poll" field.
+func NextPollNotNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotNull(FieldNextPoll))
+}
+
+// PendingStatusEQ applies the EQ predicate on the "pending_status" field.
+func PendingStatusEQ(v PendingStatus) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldPendingStatus, v))
+}
+
+// PendingStatusNEQ applies the NEQ predicate on the "pending_status" field.
+func PendingStatusNEQ(v PendingStatus) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNEQ(FieldPendingStatus, v))
+}
+
+// PendingStatusIn applies the In predicate on the "pending_status" field.
+func PendingStatusIn(vs ...PendingStatus) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIn(FieldPendingStatus, vs...))
+}
+
+// PendingStatusNotIn applies the NotIn predicate on the "pending_status" field.
+func PendingStatusNotIn(vs ...PendingStatus) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotIn(FieldPendingStatus, vs...))
+}
+
+// PendingStatusIsNil applies the IsNil predicate on the "pending_status" field.
+func PendingStatusIsNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIsNull(FieldPendingStatus))
+}
+
+// PendingStatusNotNil applies the NotNil predicate on the "pending_status" field.
+func PendingStatusNotNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotNull(FieldPendingStatus))
+}
+
+// PendingReadingsEQ applies the EQ predicate on the "pending_readings" field.
+func PendingReadingsEQ(v int32) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldPendingReadings, v))
+}
+
+// PendingReadingsNEQ applies the NEQ predicate on the "pending_readings" field.
+func PendingReadingsNEQ(v int32) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNEQ(FieldPendingReadings, v))
+}
+
+// PendingReadingsIn applies the In predicate on the "pending_readings" field.
+func PendingReadingsIn(vs ...int32) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIn(FieldPendingReadings, vs...))
+}
+
+// PendingReadingsNotIn applies the NotIn predicate on the "pending_readings" field.
+func PendingReadingsNotIn(vs ...int32) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotIn(FieldPendingReadings, vs...))
+}
+
+// PendingReadingsGT applies the GT predicate on the "pending_readings" field.
+func PendingReadingsGT(v int32) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldGT(FieldPendingReadings, v))
+}
+
+// PendingReadingsGTE applies the GTE predicate on the "pending_readings" field.
+func PendingReadingsGTE(v int32) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldGTE(FieldPendingReadings, v))
+}
+
+// PendingReadingsLT applies the LT predicate on the "pending_readings" field.
+func PendingReadingsLT(v int32) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldLT(FieldPendingReadings, v))
+}
+
+// PendingReadingsLTE applies the LTE predicate on the "pending_readings" field.
+func PendingReadingsLTE(v int32) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldLTE(FieldPendingReadings, v))
+}
+
+// PendingReadingsIsNil applies the IsNil predicate on the "pending_readings" field.
+func PendingReadingsIsNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIsNull(FieldPendingReadings))
+}
+
+// PendingReadingsNotNil applies the NotNil predicate on the "pending_readings" field.
+func PendingReadingsNotNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotNull(FieldPendingReadings))
+}
+
+// FlappingEQ applies the EQ predicate on the "flapping" field.
+func FlappingEQ(v bool) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldFlapping, v))
+}
+
+// FlappingNEQ applies the NEQ predicate on the "flapping" field.
+func FlappingNEQ(v bool) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNEQ(FieldFlapping, v))
+}
+
+// FlappingIsNil applies the IsNil predicate on the "flapping" field.
+func FlappingIsNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIsNull(FieldFlapping))
+}
+
+// FlappingNotNil applies the NotNil predicate on the "flapping" field.
+func FlappingNotNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotNull(FieldFlapping))
+}
+
+// InMaintenanceEQ applies the EQ predicate on the "in_maintenance" field.
+func InMaintenanceEQ(v bool) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldInMaintenance, v))
+}
+
+// InMaintenanceNEQ applies the NEQ predicate on the "in_maintenance" field.
+func InMaintenanceNEQ(v bool) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNEQ(FieldInMaintenance, v))
+}
+
+// InMaintenanceIsNil applies the IsNil predicate on the "in_maintenance" field.
+func InMaintenanceIsNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIsNull(FieldInMaintenance))
+}
+
+// InMaintenanceNotNil applies the NotNil predicate on the "in_maintenance" field.
+func InMaintenanceNotNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotNull(FieldInMaintenance))
+}
+
+// AnsweredEndpointIDEQ applies the EQ predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDEQ(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldAnsweredEndpointID, v))
+}
+
+// AnsweredEndpointIDNEQ applies the NEQ predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDNEQ(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNEQ(FieldAnsweredEndpointID, v))
+}
+
+// AnsweredEndpointIDIn applies the In predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDIn(vs ...string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIn(FieldAnsweredEndpointID, vs...))
+}
+
+// AnsweredEndpointIDNotIn applies the NotIn predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDNotIn(vs ...string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotIn(FieldAnsweredEndpointID, vs...))
+}
+
+// AnsweredEndpointIDGT applies the GT predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDGT(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldGT(FieldAnsweredEndpointID, v))
+}
+
+// AnsweredEndpointIDGTE applies the GTE predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDGTE(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldGTE(FieldAnsweredEndpointID, v))
+}
+
+// AnsweredEndpointIDLT applies the LT predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDLT(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldLT(FieldAnsweredEndpointID, v))
+}
+
+// AnsweredEndpointIDLTE applies the LTE predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDLTE(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldLTE(FieldAnsweredEndpointID, v))
+}
+
+// AnsweredEndpointIDContains applies the Contains predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDContains(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldContains(FieldAnsweredEndpointID, v))
+}
+
+// AnsweredEndpointIDHasPrefix applies the HasPrefix predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDHasPrefix(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldHasPrefix(FieldAnsweredEndpointID, v))
+}
+
+// AnsweredEndpointIDHasSuffix applies the HasSuffix predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDHasSuffix(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldHasSuffix(FieldAnsweredEndpointID, v))
+}
+
+// AnsweredEndpointIDIsNil applies the IsNil predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDIsNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIsNull(FieldAnsweredEndpointID))
+}
+
+// AnsweredEndpointIDNotNil applies the NotNil predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDNotNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotNull(FieldAnsweredEndpointID))
+}
+
+// AnsweredEndpointIDEqualFold applies the EqualFold predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDEqualFold(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEqualFold(FieldAnsweredEndpointID, v))
+}
+
+// AnsweredEndpointIDContainsFold applies the ContainsFold predicate on the "answered_endpoint_id" field.
+func AnsweredEndpointIDContainsFold(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldContainsFold(FieldAnsweredEndpointID, v))
+}
+
+// AnsweredProtocolEQ applies the EQ predicate on the "answered_protocol" field.
+func AnsweredProtocolEQ(v AnsweredProtocol) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldAnsweredProtocol, v))
+}
+
+// AnsweredProtocolNEQ applies the NEQ predicate on the "answered_protocol" field.
+func AnsweredProtocolNEQ(v AnsweredProtocol) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNEQ(FieldAnsweredProtocol, v))
+}
+
+// AnsweredProtocolIn applies the In predicate on the "answered_protocol" field.
+func AnsweredProtocolIn(vs ...AnsweredProtocol) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIn(FieldAnsweredProtocol, vs...))
+}
+
+// AnsweredProtocolNotIn applies the NotIn predicate on the "answered_protocol" field.
+func AnsweredProtocolNotIn(vs ...AnsweredProtocol) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotIn(FieldAnsweredProtocol, vs...))
+}
+
+// AnsweredProtocolIsNil applies the IsNil predicate on the "answered_protocol" field.
+func AnsweredProtocolIsNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIsNull(FieldAnsweredProtocol))
+}
+
+// AnsweredProtocolNotNil applies the NotNil predicate on the "answered_protocol" field.
+func AnsweredProtocolNotNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotNull(FieldAnsweredProtocol))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.DeviceStatus {
+	return predicate.DeviceStatus(sql.FieldContainsFold(FieldLastError, v))
+}
+
 // HasNetworkDevice applies the HasEdge predicate on the "network_device" edge.
 func HasNetworkDevice() predicate.DeviceStatus {
 	return predicate.DeviceStatus(func(s *sql.Selector) {
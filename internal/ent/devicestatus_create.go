@@ -46,6 +46,118 @@ func (dsc *DeviceStatusCreate) SetConsequentialFailedConnectivityAttempts(i int3
 	return dsc
 }
 
+// SetNextPoll sets the "next_poll" field.
+func (dsc *DeviceStatusCreate) SetNextPoll(i int64) *DeviceStatusCreate {
+	dsc.mutation.SetNextPoll(i)
+	return dsc
+}
+
+// SetNillableNextPoll sets the "next_poll" field if the given value is not nil.
+func (dsc *DeviceStatusCreate) SetNillableNextPoll(i *int64) *DeviceStatusCreate {
+	if i != nil {
+		dsc.SetNextPoll(*i)
+	}
+	return dsc
+}
+
+// SetPendingStatus sets the "pending_status" field.
+func (dsc *DeviceStatusCreate) SetPendingStatus(ds devicestatus.PendingStatus) *DeviceStatusCreate {
+	dsc.mutation.SetPendingStatus(ds)
+	return dsc
+}
+
+// SetNillablePendingStatus sets the "pending_status" field if the given value is not nil.
+func (dsc *DeviceStatusCreate) SetNillablePendingStatus(ds *devicestatus.PendingStatus) *DeviceStatusCreate {
+	if ds != nil {
+		dsc.SetPendingStatus(*ds)
+	}
+	return dsc
+}
+
+// SetPendingReadings sets the "pending_readings" field.
+func (dsc *DeviceStatusCreate) SetPendingReadings(i int32) *DeviceStatusCreate {
+	dsc.mutation.SetPendingReadings(i)
+	return dsc
+}
+
+// SetNillablePendingReadings sets the "pending_readings" field if the given value is not nil.
+func (dsc *DeviceStatusCreate) SetNillablePendingReadings(i *int32) *DeviceStatusCreate {
+	if i != nil {
+		dsc.SetPendingReadings(*i)
+	}
+	return dsc
+}
+
+// SetFlapping sets the "flapping" field.
+func (dsc *DeviceStatusCreate) SetFlapping(b bool) *DeviceStatusCreate {
+	dsc.mutation.SetFlapping(b)
+	return dsc
+}
+
+// SetNillableFlapping sets the "flapping" field if the given value is not nil.
+func (dsc *DeviceStatusCreate) SetNillableFlapping(b *bool) *DeviceStatusCreate {
+	if b != nil {
+		dsc.SetFlapping(*b)
+	}
+	return dsc
+}
+
+// SetInMaintenance sets the "in_maintenance" field.
+func (dsc *DeviceStatusCreate) SetInMaintenance(b bool) *DeviceStatusCreate {
+	dsc.mutation.SetInMaintenance(b)
+	return dsc
+}
+
+// SetNillableInMaintenance sets the "in_maintenance" field if the given value is not nil.
+func (dsc *DeviceStatusCreate) SetNillableInMaintenance(b *bool) *DeviceStatusCreate {
+	if b != nil {
+		dsc.SetInMaintenance(*b)
+	}
+	return dsc
+}
+
+// SetAnsweredEndpointID sets the "answered_endpoint_id" field.
+func (dsc *DeviceStatusCreate) SetAnsweredEndpointID(s string) *DeviceStatusCreate {
+	dsc.mutation.SetAnsweredEndpointID(s)
+	return dsc
+}
+
+// SetNillableAnsweredEndpointID sets the "answered_endpoint_id" field if the given value is not nil.
+func (dsc *DeviceStatusCreate) SetNillableAnsweredEndpointID(s *string) *DeviceStatusCreate {
+	if s != nil {
+		dsc.SetAnsweredEndpointID(*s)
+	}
+	return dsc
+}
+
+// SetAnsweredProtocol sets the "answered_protocol" field.
+func (dsc *DeviceStatusCreate) SetAnsweredProtocol(dp devicestatus.AnsweredProtocol) *DeviceStatusCreate {
+	dsc.mutation.SetAnsweredProtocol(dp)
+	return dsc
+}
+
+// SetNillableAnsweredProtocol sets the "answered_protocol" field if the given value is not nil.
+func (dsc *DeviceStatusCreate) SetNillableAnsweredProtocol(dp *devicestatus.AnsweredProtocol) *DeviceStatusCreate {
+	if dp != nil {
+		dsc.SetAnsweredProtocol(*dp)
+	}
+	return dsc
+}
+
+// SetLastError sets the "last_error" field.
+func (dsc *DeviceStatusCreate) SetLastError(s string) *DeviceStatusCreate {
+	dsc.mutation.SetLastError(s)
+	return dsc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (dsc *DeviceStatusCreate) SetNillableLastError(s *string) *DeviceStatusCreate {
+	if s != nil {
+		dsc.SetLastError(*s)
+	}
+	return dsc
+}
+
 // SetID sets the "id" field.
 func (dsc *DeviceStatusCreate) SetID(s string) *DeviceStatusCreate {
 	dsc.mutation.SetID(s)
@@ -116,6 +228,16 @@ func (dsc *DeviceStatusCreate) check() error {
 	if _, ok := dsc.mutation.ConsequentialFailedConnectivityAttempts(); !ok {
 		return &ValidationError{Name: "consequential_failed_connectivity_attempts", err: errors.New(`ent: missing required field "DeviceStatus.consequential_failed_connectivity_attempts"`)}
 	}
+	if v, ok := dsc.mutation.PendingStatus(); ok {
+		if err := devicestatus.PendingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "pending_status", err: fmt.Errorf(`ent: validator failed for field "DeviceStatus.pending_status": %w`, err)}
+		}
+	}
+	if v, ok := dsc.mutation.AnsweredProtocol(); ok {
+		if err := devicestatus.AnsweredProtocolValidator(v); err != nil {
+			return &ValidationError{Name: "answered_protocol", err: fmt.Errorf(`ent: validator failed for field "DeviceStatus.answered_protocol": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -163,6 +285,38 @@ func (dsc *DeviceStatusCreate) createSpec() (*DeviceStatus, *sqlgraph.CreateSpec
 		_spec.SetField(devicestatus.FieldConsequentialFailedConnectivityAttempts, field.TypeInt32, value)
 		_node.ConsequentialFailedConnectivityAttempts = value
 	}
+	if value, ok := dsc.mutation.NextPoll(); ok {
+		_spec.SetField(devicestatus.FieldNextPoll, field.TypeInt64, value)
+		_node.NextPoll = value
+	}
+	if value, ok := dsc.mutation.PendingStatus(); ok {
+		_spec.SetField(devicestatus.FieldPendingStatus, field.TypeEnum, value)
+		_node.PendingStatus = value
+	}
+	if value, ok := dsc.mutation.PendingReadings(); ok {
+		_spec.SetField(devicestatus.FieldPendingReadings, field.TypeInt32, value)
+		_node.PendingReadings = value
+	}
+	if value, ok := dsc.mutation.Flapping(); ok {
+		_spec.SetField(devicestatus.FieldFlapping, field.TypeBool, value)
+		_node.Flapping = value
+	}
+	if value, ok := dsc.mutation.InMaintenance(); ok {
+		_spec.SetField(devicestatus.FieldInMaintenance, field.TypeBool, value)
+		_node.InMaintenance = value
+	}
+	if value, ok := dsc.mutation.AnsweredEndpointID(); ok {
+		_spec.SetField(devicestatus.FieldAnsweredEndpointID, field.TypeString, value)
+		_node.AnsweredEndpointID = value
+	}
+	if value, ok := dsc.mutation.AnsweredProtocol(); ok {
+		_spec.SetField(devicestatus.FieldAnsweredProtocol, field.TypeEnum, value)
+		_node.AnsweredProtocol = value
+	}
+	if value, ok := dsc.mutation.LastError(); ok {
+		_spec.SetField(devicestatus.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
 	if nodes := dsc.mutation.NetworkDeviceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -83,6 +83,180 @@ func (dsu *DeviceStatusUpdate) AddConsequentialFailedConnectivityAttempts(i int3
 	return dsu
 }
 
+// SetNextPoll sets the "next_poll" field.
+func (dsu *DeviceStatusUpdate) SetNextPoll(i int64) *DeviceStatusUpdate {
+	dsu.mutation.ResetNextPoll()
+	dsu.mutation.SetNextPoll(i)
+	return dsu
+}
+
+// SetNillableNextPoll sets the "next_poll" field if the given value is not nil.
+func (dsu *DeviceStatusUpdate) SetNillableNextPoll(i *int64) *DeviceStatusUpdate {
+	if i != nil {
+		dsu.SetNextPoll(*i)
+	}
+	return dsu
+}
+
+// AddNextPoll adds i to the "next_poll" field.
+func (dsu *DeviceStatusUpdate) AddNextPoll(i int64) *DeviceStatusUpdate {
+	dsu.mutation.AddNextPoll(i)
+	return dsu
+}
+
+// ClearNextPoll clears the value of the "next_poll" field.
+func (dsu *DeviceStatusUpdate) ClearNextPoll() *DeviceStatusUpdate {
+	dsu.mutation.ClearNextPoll()
+	return dsu
+}
+
+// SetPendingStatus sets the "pending_status" field.
+func (dsu *DeviceStatusUpdate) SetPendingStatus(ds devicestatus.PendingStatus) *DeviceStatusUpdate {
+	dsu.mutation.SetPendingStatus(ds)
+	return dsu
+}
+
+// SetNillablePendingStatus sets the "pending_status" field if the given value is not nil.
+func (dsu *DeviceStatusUpdate) SetNillablePendingStatus(ds *devicestatus.PendingStatus) *DeviceStatusUpdate {
+	if ds != nil {
+		dsu.SetPendingStatus(*ds)
+	}
+	return dsu
+}
+
+// ClearPendingStatus clears the value of the "pending_status" field.
+func (dsu *DeviceStatusUpdate) ClearPendingStatus() *DeviceStatusUpdate {
+	dsu.mutation.ClearPendingStatus()
+	return dsu
+}
+
+// SetPendingReadings sets the "pending_readings" field.
+func (dsu *DeviceStatusUpdate) SetPendingReadings(i int32) *DeviceStatusUpdate {
+	dsu.mutation.ResetPendingReadings()
+	dsu.mutation.SetPendingReadings(i)
+	return dsu
+}
+
+// SetNillablePendingReadings sets the "pending_readings" field if the given value is not nil.
+func (dsu *DeviceStatusUpdate) SetNillablePendingReadings(i *int32) *DeviceStatusUpdate {
+	if i != nil {
+		dsu.SetPendingReadings(*i)
+	}
+	return dsu
+}
+
+// AddPendingReadings adds i to the "pending_readings" field.
+func (dsu *DeviceStatusUpdate) AddPendingReadings(i int32) *DeviceStatusUpdate {
+	dsu.mutation.AddPendingReadings(i)
+	return dsu
+}
+
+// ClearPendingReadings clears the value of the "pending_readings" field.
+func (dsu *DeviceStatusUpdate) ClearPendingReadings() *DeviceStatusUpdate {
+	dsu.mutation.ClearPendingReadings()
+	return dsu
+}
+
+// SetFlapping sets the "flapping" field.
+func (dsu *DeviceStatusUpdate) SetFlapping(b bool) *DeviceStatusUpdate {
+	dsu.mutation.SetFlapping(b)
+	return dsu
+}
+
+// SetNillableFlapping sets the "flapping" field if the given value is not nil.
+func (dsu *DeviceStatusUpdate) SetNillableFlapping(b *bool) *DeviceStatusUpdate {
+	if b != nil {
+		dsu.SetFlapping(*b)
+	}
+	return dsu
+}
+
+// ClearFlapping clears the value of the "flapping" field.
+func (dsu *DeviceStatusUpdate) ClearFlapping() *DeviceStatusUpdate {
+	dsu.mutation.ClearFlapping()
+	return dsu
+}
+
+// SetInMaintenance sets the "in_maintenance" field.
+func (dsu *DeviceStatusUpdate) SetInMaintenance(b bool) *DeviceStatusUpdate {
+	dsu.mutation.SetInMaintenance(b)
+	return dsu
+}
+
+// SetNillableInMaintenance sets the "in_maintenance" field if the given value is not nil.
+func (dsu *DeviceStatusUpdate) SetNillableInMaintenance(b *bool) *DeviceStatusUpdate {
+	if b != nil {
+		dsu.SetInMaintenance(*b)
+	}
+	return dsu
+}
+
+// ClearInMaintenance clears the value of the "in_maintenance" field.
+func (dsu *DeviceStatusUpdate) ClearInMaintenance() *DeviceStatusUpdate {
+	dsu.mutation.ClearInMaintenance()
+	return dsu
+}
+
+// SetAnsweredEndpointID sets the "answered_endpoint_id" field.
+func (dsu *DeviceStatusUpdate) SetAnsweredEndpointID(s string) *DeviceStatusUpdate {
+	dsu.mutation.SetAnsweredEndpointID(s)
+	return dsu
+}
+
+// SetNillableAnsweredEndpointID sets the "answered_endpoint_id" field if the given value is not nil.
+func (dsu *DeviceStatusUpdate) SetNillableAnsweredEndpointID(s *string) *DeviceStatusUpdate {
+	if s != nil {
+		dsu.SetAnsweredEndpointID(*s)
+	}
+	return dsu
+}
+
+// ClearAnsweredEndpointID clears the value of the "answered_endpoint_id" field.
+func (dsu *DeviceStatusUpdate) ClearAnsweredEndpointID() *DeviceStatusUpdate {
+	dsu.mutation.ClearAnsweredEndpointID()
+	return dsu
+}
+
+// SetAnsweredProtocol sets the "answered_protocol" field.
+func (dsu *DeviceStatusUpdate) SetAnsweredProtocol(dp devicestatus.AnsweredProtocol) *DeviceStatusUpdate {
+	dsu.mutation.SetAnsweredProtocol(dp)
+	return dsu
+}
+
+// SetNillableAnsweredProtocol sets the "answered_protocol" field if the given value is not nil.
+func (dsu *DeviceStatusUpdate) SetNillableAnsweredProtocol(dp *devicestatus.AnsweredProtocol) *DeviceStatusUpdate {
+	if dp != nil {
+		dsu.SetAnsweredProtocol(*dp)
+	}
+	return dsu
+}
+
+// ClearAnsweredProtocol clears the value of the "answered_protocol" field.
+func (dsu *DeviceStatusUpdate) ClearAnsweredProtocol() *DeviceStatusUpdate {
+	dsu.mutation.ClearAnsweredProtocol()
+	return dsu
+}
+
+// SetLastError sets the "last_error" field.
+func (dsu *DeviceStatusUpdate) SetLastError(s string) *DeviceStatusUpdate {
+	dsu.mutation.SetLastError(s)
+	return dsu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (dsu *DeviceStatusUpdate) SetNillableLastError(s *string) *DeviceStatusUpdate {
+	if s != nil {
+		dsu.SetLastError(*s)
+	}
+	return dsu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (dsu *DeviceStatusUpdate) ClearLastError() *DeviceStatusUpdate {
+	dsu.mutation.ClearLastError()
+	return dsu
+}
+
 // SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID.
 func (dsu *DeviceStatusUpdate) SetNetworkDeviceID(id string) *DeviceStatusUpdate {
 	dsu.mutation.SetNetworkDeviceID(id)
@@ -147,6 +321,16 @@ func (dsu *DeviceStatusUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeviceStatus.status": %w`, err)}
 		}
 	}
+	if v, ok := dsu.mutation.PendingStatus(); ok {
+		if err := devicestatus.PendingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "pending_status", err: fmt.Errorf(`ent: validator failed for field "DeviceStatus.pending_status": %w`, err)}
+		}
+	}
+	if v, ok := dsu.mutation.AnsweredProtocol(); ok {
+		if err := devicestatus.AnsweredProtocolValidator(v); err != nil {
+			return &ValidationError{Name: "answered_protocol", err: fmt.Errorf(`ent: validator failed for field "DeviceStatus.answered_protocol": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -177,6 +361,60 @@ func (dsu *DeviceStatusUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := dsu.mutation.AddedConsequentialFailedConnectivityAttempts(); ok {
 		_spec.AddField(devicestatus.FieldConsequentialFailedConnectivityAttempts, field.TypeInt32, value)
 	}
+	if value, ok := dsu.mutation.NextPoll(); ok {
+		_spec.SetField(devicestatus.FieldNextPoll, field.TypeInt64, value)
+	}
+	if value, ok := dsu.mutation.AddedNextPoll(); ok {
+		_spec.AddField(devicestatus.FieldNextPoll, field.TypeInt64, value)
+	}
+	if dsu.mutation.NextPollCleared() {
+		_spec.ClearField(devicestatus.FieldNextPoll, field.TypeInt64)
+	}
+	if value, ok := dsu.mutation.PendingStatus(); ok {
+		_spec.SetField(devicestatus.FieldPendingStatus, field.TypeEnum, value)
+	}
+	if dsu.mutation.PendingStatusCleared() {
+		_spec.ClearField(devicestatus.FieldPendingStatus, field.TypeEnum)
+	}
+	if value, ok := dsu.mutation.PendingReadings(); ok {
+		_spec.SetField(devicestatus.FieldPendingReadings, field.TypeInt32, value)
+	}
+	if value, ok := dsu.mutation.AddedPendingReadings(); ok {
+		_spec.AddField(devicestatus.FieldPendingReadings, field.TypeInt32, value)
+	}
+	if dsu.mutation.PendingReadingsCleared() {
+		_spec.ClearField(devicestatus.FieldPendingReadings, field.TypeInt32)
+	}
+	if value, ok := dsu.mutation.Flapping(); ok {
+		_spec.SetField(devicestatus.FieldFlapping, field.TypeBool, value)
+	}
+	if dsu.mutation.FlappingCleared() {
+		_spec.ClearField(devicestatus.FieldFlapping, field.TypeBool)
+	}
+	if value, ok := dsu.mutation.InMaintenance(); ok {
+		_spec.SetField(devicestatus.FieldInMaintenance, field.TypeBool, value)
+	}
+	if dsu.mutation.InMaintenanceCleared() {
+		_spec.ClearField(devicestatus.FieldInMaintenance, field.TypeBool)
+	}
+	if value, ok := dsu.mutation.AnsweredEndpointID(); ok {
+		_spec.SetField(devicestatus.FieldAnsweredEndpointID, field.TypeString, value)
+	}
+	if dsu.mutation.AnsweredEndpointIDCleared() {
+		_spec.ClearField(devicestatus.FieldAnsweredEndpointID, field.TypeString)
+	}
+	if value, ok := dsu.mutation.AnsweredProtocol(); ok {
+		_spec.SetField(devicestatus.FieldAnsweredProtocol, field.TypeEnum, value)
+	}
+	if dsu.mutation.AnsweredProtocolCleared() {
+		_spec.ClearField(devicestatus.FieldAnsweredProtocol, field.TypeEnum)
+	}
+	if value, ok := dsu.mutation.LastError(); ok {
+		_spec.SetField(devicestatus.FieldLastError, field.TypeString, value)
+	}
+	if dsu.mutation.LastErrorCleared() {
+		_spec.ClearField(devicestatus.FieldLastError, field.TypeString)
+	}
 	if dsu.mutation.NetworkDeviceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -281,6 +519,180 @@ func (dsuo *DeviceStatusUpdateOne) AddConsequentialFailedConnectivityAttempts(i
 	return dsuo
 }
 
+// SetNextPoll sets the "next_poll" field.
+func (dsuo *DeviceStatusUpdateOne) SetNextPoll(i int64) *DeviceStatusUpdateOne {
+	dsuo.mutation.ResetNextPoll()
+	dsuo.mutation.SetNextPoll(i)
+	return dsuo
+}
+
+// SetNillableNextPoll sets the "next_poll" field if the given value is not nil.
+func (dsuo *DeviceStatusUpdateOne) SetNillableNextPoll(i *int64) *DeviceStatusUpdateOne {
+	if i != nil {
+		dsuo.SetNextPoll(*i)
+	}
+	return dsuo
+}
+
+// AddNextPoll adds i to the "next_poll" field.
+func (dsuo *DeviceStatusUpdateOne) AddNextPoll(i int64) *DeviceStatusUpdateOne {
+	dsuo.mutation.AddNextPoll(i)
+	return dsuo
+}
+
+// ClearNextPoll clears the value of the "next_poll" field.
+func (dsuo *DeviceStatusUpdateOne) ClearNextPoll() *DeviceStatusUpdateOne {
+	dsuo.mutation.ClearNextPoll()
+	return dsuo
+}
+
+// SetPendingStatus sets the "pending_status" field.
+func (dsuo *DeviceStatusUpdateOne) SetPendingStatus(ds devicestatus.PendingStatus) *DeviceStatusUpdateOne {
+	dsuo.mutation.SetPendingStatus(ds)
+	return dsuo
+}
+
+// SetNillablePendingStatus sets the "pending_status" field if the given value is not nil.
+func (dsuo *DeviceStatusUpdateOne) SetNillablePendingStatus(ds *devicestatus.PendingStatus) *DeviceStatusUpdateOne {
+	if ds != nil {
+		dsuo.SetPendingStatus(*ds)
+	}
+	return dsuo
+}
+
+// ClearPendingStatus clears the value of the "pending_status" field.
+func (dsuo *DeviceStatusUpdateOne) ClearPendingStatus() *DeviceStatusUpdateOne {
+	dsuo.mutation.ClearPendingStatus()
+	return dsuo
+}
+
+// SetPendingReadings sets the "pending_readings" field.
+func (dsuo *DeviceStatusUpdateOne) SetPendingReadings(i int32) *DeviceStatusUpdateOne {
+	dsuo.mutation.ResetPendingReadings()
+	dsuo.mutation.SetPendingReadings(i)
+	return dsuo
+}
+
+// SetNillablePendingReadings sets the "pending_readings" field if the given value is not nil.
+func (dsuo *DeviceStatusUpdateOne) SetNillablePendingReadings(i *int32) *DeviceStatusUpdateOne {
+	if i != nil {
+		dsuo.SetPendingReadings(*i)
+	}
+	return dsuo
+}
+
+// AddPendingReadings adds i to the "pending_readings" field.
+func (dsuo *DeviceStatusUpdateOne) AddPendingReadings(i int32) *DeviceStatusUpdateOne {
+	dsuo.mutation.AddPendingReadings(i)
+	return dsuo
+}
+
+// ClearPendingReadings clears the value of the "pending_readings" field.
+func (dsuo *DeviceStatusUpdateOne) ClearPendingReadings() *DeviceStatusUpdateOne {
+	dsuo.mutation.ClearPendingReadings()
+	return dsuo
+}
+
+// SetFlapping sets the "flapping" field.
+func (dsuo *DeviceStatusUpdateOne) SetFlapping(b bool) *DeviceStatusUpdateOne {
+	dsuo.mutation.SetFlapping(b)
+	return dsuo
+}
+
+// SetNillableFlapping sets the "flapping" field if the given value is not nil.
+func (dsuo *DeviceStatusUpdateOne) SetNillableFlapping(b *bool) *DeviceStatusUpdateOne {
+	if b != nil {
+		dsuo.SetFlapping(*b)
+	}
+	return dsuo
+}
+
+// ClearFlapping clears the value of the "flapping" field.
+func (dsuo *DeviceStatusUpdateOne) ClearFlapping() *DeviceStatusUpdateOne {
+	dsuo.mutation.ClearFlapping()
+	return dsuo
+}
+
+// SetInMaintenance sets the "in_maintenance" field.
+func (dsuo *DeviceStatusUpdateOne) SetInMaintenance(b bool) *DeviceStatusUpdateOne {
+	dsuo.mutation.SetInMaintenance(b)
+	return dsuo
+}
+
+// SetNillableInMaintenance sets the "in_maintenance" field if the given value is not nil.
+func (dsuo *DeviceStatusUpdateOne) SetNillableInMaintenance(b *bool) *DeviceStatusUpdateOne {
+	if b != nil {
+		dsuo.SetInMaintenance(*b)
+	}
+	return dsuo
+}
+
+// ClearInMaintenance clears the value of the "in_maintenance" field.
+func (dsuo *DeviceStatusUpdateOne) ClearInMaintenance() *DeviceStatusUpdateOne {
+	dsuo.mutation.ClearInMaintenance()
+	return dsuo
+}
+
+// SetAnsweredEndpointID sets the "answered_endpoint_id" field.
+func (dsuo *DeviceStatusUpdateOne) SetAnsweredEndpointID(s string) *DeviceStatusUpdateOne {
+	dsuo.mutation.SetAnsweredEndpointID(s)
+	return dsuo
+}
+
+// SetNillableAnsweredEndpointID sets the "answered_endpoint_id" field if the given value is not nil.
+func (dsuo *DeviceStatusUpdateOne) SetNillableAnsweredEndpointID(s *string) *DeviceStatusUpdateOne {
+	if s != nil {
+		dsuo.SetAnsweredEndpointID(*s)
+	}
+	return dsuo
+}
+
+// ClearAnsweredEndpointID clears the value of the "answered_endpoint_id" field.
+func (dsuo *DeviceStatusUpdateOne) ClearAnsweredEndpointID() *DeviceStatusUpdateOne {
+	dsuo.mutation.ClearAnsweredEndpointID()
+	return dsuo
+}
+
+// SetAnsweredProtocol sets the "answered_protocol" field.
+func (dsuo *DeviceStatusUpdateOne) SetAnsweredProtocol(dp devicestatus.AnsweredProtocol) *DeviceStatusUpdateOne {
+	dsuo.mutation.SetAnsweredProtocol(dp)
+	return dsuo
+}
+
+// SetNillableAnsweredProtocol sets the "answered_protocol" field if the given value is not nil.
+func (dsuo *DeviceStatusUpdateOne) SetNillableAnsweredProtocol(dp *devicestatus.AnsweredProtocol) *DeviceStatusUpdateOne {
+	if dp != nil {
+		dsuo.SetAnsweredProtocol(*dp)
+	}
+	return dsuo
+}
+
+// ClearAnsweredProtocol clears the value of the "answered_protocol" field.
+func (dsuo *DeviceStatusUpdateOne) ClearAnsweredProtocol() *DeviceStatusUpdateOne {
+	dsuo.mutation.ClearAnsweredProtocol()
+	return dsuo
+}
+
+// SetLastError sets the "last_error" field.
+func (dsuo *DeviceStatusUpdateOne) SetLastError(s string) *DeviceStatusUpdateOne {
+	dsuo.mutation.SetLastError(s)
+	return dsuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (dsuo *DeviceStatusUpdateOne) SetNillableLastError(s *string) *DeviceStatusUpdateOne {
+	if s != nil {
+		dsuo.SetLastError(*s)
+	}
+	return dsuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (dsuo *DeviceStatusUpdateOne) ClearLastError() *DeviceStatusUpdateOne {
+	dsuo.mutation.ClearLastError()
+	return dsuo
+}
+
 // SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID.
 func (dsuo *DeviceStatusUpdateOne) SetNetworkDeviceID(id string) *DeviceStatusUpdateOne {
 	dsuo.mutation.SetNetworkDeviceID(id)
@@ -358,6 +770,16 @@ func (dsuo *DeviceStatusUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeviceStatus.status": %w`, err)}
 		}
 	}
+	if v, ok := dsuo.mutation.PendingStatus(); ok {
+		if err := devicestatus.PendingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "pending_status", err: fmt.Errorf(`ent: validator failed for field "DeviceStatus.pending_status": %w`, err)}
+		}
+	}
+	if v, ok := dsuo.mutation.AnsweredProtocol(); ok {
+		if err := devicestatus.AnsweredProtocolValidator(v); err != nil {
+			return &ValidationError{Name: "answered_protocol", err: fmt.Errorf(`ent: validator failed for field "DeviceStatus.answered_protocol": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -405,6 +827,60 @@ func (dsuo *DeviceStatusUpdateOne) sqlSave(ctx context.Context) (_node *DeviceSt
 	if value, ok := dsuo.mutation.AddedConsequentialFailedConnectivityAttempts(); ok {
 		_spec.AddField(devicestatus.FieldConsequentialFailedConnectivityAttempts, field.TypeInt32, value)
 	}
+	if value, ok := dsuo.mutation.NextPoll(); ok {
+		_spec.SetField(devicestatus.FieldNextPoll, field.TypeInt64, value)
+	}
+	if value, ok := dsuo.mutation.AddedNextPoll(); ok {
+		_spec.AddField(devicestatus.FieldNextPoll, field.TypeInt64, value)
+	}
+	if dsuo.mutation.NextPollCleared() {
+		_spec.ClearField(devicestatus.FieldNextPoll, field.TypeInt64)
+	}
+	if value, ok := dsuo.mutation.PendingStatus(); ok {
+		_spec.SetField(devicestatus.FieldPendingStatus, field.TypeEnum, value)
+	}
+	if dsuo.mutation.PendingStatusCleared() {
+		_spec.ClearField(devicestatus.FieldPendingStatus, field.TypeEnum)
+	}
+	if value, ok := dsuo.mutation.PendingReadings(); ok {
+		_spec.SetField(devicestatus.FieldPendingReadings, field.TypeInt32, value)
+	}
+	if value, ok := dsuo.mutation.AddedPendingReadings(); ok {
+		_spec.AddField(devicestatus.FieldPendingReadings, field.TypeInt32, value)
+	}
+	if dsuo.mutation.PendingReadingsCleared() {
+		_spec.ClearField(devicestatus.FieldPendingReadings, field.TypeInt32)
+	}
+	if value, ok := dsuo.mutation.Flapping(); ok {
+		_spec.SetField(devicestatus.FieldFlapping, field.TypeBool, value)
+	}
+	if dsuo.mutation.FlappingCleared() {
+		_spec.ClearField(devicestatus.FieldFlapping, field.TypeBool)
+	}
+	if value, ok := dsuo.mutation.InMaintenance(); ok {
+		_spec.SetField(devicestatus.FieldInMaintenance, field.TypeBool, value)
+	}
+	if dsuo.mutation.InMaintenanceCleared() {
+		_spec.ClearField(devicestatus.FieldInMaintenance, field.TypeBool)
+	}
+	if value, ok := dsuo.mutation.AnsweredEndpointID(); ok {
+		_spec.SetField(devicestatus.FieldAnsweredEndpointID, field.TypeString, value)
+	}
+	if dsuo.mutation.AnsweredEndpointIDCleared() {
+		_spec.ClearField(devicestatus.FieldAnsweredEndpointID, field.TypeString)
+	}
+	if value, ok := dsuo.mutation.AnsweredProtocol(); ok {
+		_spec.SetField(devicestatus.FieldAnsweredProtocol, field.TypeEnum, value)
+	}
+	if dsuo.mutation.AnsweredProtocolCleared() {
+		_spec.ClearField(devicestatus.FieldAnsweredProtocol, field.TypeEnum)
+	}
+	if value, ok := dsuo.mutation.LastError(); ok {
+		_spec.SetField(devicestatus.FieldLastError, field.TypeString, value)
+	}
+	if dsuo.mutation.LastErrorCleared() {
+		_spec.ClearField(devicestatus.FieldLastError, field.TypeString)
+	}
 	if dsuo.mutation.NetworkDeviceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
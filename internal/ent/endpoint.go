@@ -8,7 +8,9 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
 )
 
@@ -23,20 +25,60 @@ type Endpoint struct {
 	Port string `json:"port,omitempty"`
 	// Protocol holds the value of the "protocol" field.
 	Protocol endpoint.Protocol `json:"protocol,omitempty"`
+	// TLSEnabled holds the value of the "tls_enabled" field.
+	TLSEnabled bool `json:"tls_enabled,omitempty"`
+	// TLSCaBundle holds the value of the "tls_ca_bundle" field.
+	TLSCaBundle string `json:"tls_ca_bundle,omitempty"`
+	// TLSServerName holds the value of the "tls_server_name" field.
+	TLSServerName string `json:"tls_server_name,omitempty"`
+	// TLSInsecureSkipVerify holds the value of the "tls_insecure_skip_verify" field.
+	TLSInsecureSkipVerify bool `json:"tls_insecure_skip_verify,omitempty"`
+	// Preference holds the value of the "preference" field.
+	Preference int32 `json:"preference,omitempty"`
+	// ProtocolName holds the value of the "protocol_name" field.
+	ProtocolName string `json:"protocol_name,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EndpointQuery when eager-loading is set.
-	Edges                    EndpointEdges `json:"edges"`
-	network_device_endpoints *string
-	selectValues             sql.SelectValues
+	Edges                       EndpointEdges `json:"edges"`
+	endpoint_credential_profile *string
+	endpoint_health             *string
+	network_device_endpoints    *string
+	selectValues                sql.SelectValues
 }
 
 // EndpointEdges holds the relations/edges for other nodes in the graph.
 type EndpointEdges struct {
+	// CredentialProfile holds the value of the credential_profile edge.
+	CredentialProfile *CredentialProfile `json:"credential_profile,omitempty"`
+	// Health holds the value of the health edge.
+	Health *EndpointHealth `json:"health,omitempty"`
 	// NetworkDevice holds the value of the network_device edge.
 	NetworkDevice *NetworkDevice `json:"network_device,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
+}
+
+// CredentialProfileOrErr returns the CredentialProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EndpointEdges) CredentialProfileOrErr() (*CredentialProfile, error) {
+	if e.CredentialProfile != nil {
+		return e.CredentialProfile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: credentialprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "credential_profile"}
+}
+
+// HealthOrErr returns the Health value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EndpointEdges) HealthOrErr() (*EndpointHealth, error) {
+	if e.Health != nil {
+		return e.Health, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: endpointhealth.Label}
+	}
+	return nil, &NotLoadedError{edge: "health"}
 }
 
 // NetworkDeviceOrErr returns the NetworkDevice value or an error if the edge
@@ -44,7 +86,7 @@ type EndpointEdges struct {
 func (e EndpointEdges) NetworkDeviceOrErr() (*NetworkDevice, error) {
 	if e.NetworkDevice != nil {
 		return e.NetworkDevice, nil
-	} else if e.loadedTypes[0] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: networkdevice.Label}
 	}
 	return nil, &NotLoadedError{edge: "network_device"}
@@ -55,9 +97,17 @@ func (*Endpoint) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case endpoint.FieldID, endpoint.FieldHost, endpoint.FieldPort, endpoint.FieldProtocol:
+		case endpoint.FieldTLSEnabled, endpoint.FieldTLSInsecureSkipVerify:
+			values[i] = new(sql.NullBool)
+		case endpoint.FieldPreference:
+			values[i] = new(sql.NullInt64)
+		case endpoint.FieldID, endpoint.FieldHost, endpoint.FieldPort, endpoint.FieldProtocol, endpoint.FieldTLSCaBundle, endpoint.FieldTLSServerName, endpoint.FieldProtocolName:
+			values[i] = new(sql.NullString)
+		case endpoint.ForeignKeys[0]: // endpoint_credential_profile
 			values[i] = new(sql.NullString)
-		case endpoint.ForeignKeys[0]: // network_device_endpoints
+		case endpoint.ForeignKeys[1]: // endpoint_health
+			values[i] = new(sql.NullString)
+		case endpoint.ForeignKeys[2]: // network_device_endpoints
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -98,7 +148,57 @@ func (e *Endpoint) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				e.Protocol = endpoint.Protocol(value.String)
 			}
+		case endpoint.FieldTLSEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_enabled", values[i])
+			} else if value.Valid {
+				e.TLSEnabled = value.Bool
+			}
+		case endpoint.FieldTLSCaBundle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_ca_bundle", values[i])
+			} else if value.Valid {
+				e.TLSCaBundle = value.String
+			}
+		case endpoint.FieldTLSServerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_server_name", values[i])
+			} else if value.Valid {
+				e.TLSServerName = value.String
+			}
+		case endpoint.FieldTLSInsecureSkipVerify:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_insecure_skip_verify", values[i])
+			} else if value.Valid {
+				e.TLSInsecureSkipVerify = value.Bool
+			}
+		case endpoint.FieldPreference:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field preference", values[i])
+			} else if value.Valid {
+				e.Preference = int32(value.Int64)
+			}
+		case endpoint.FieldProtocolName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field protocol_name", values[i])
+			} else if value.Valid {
+				e.ProtocolName = value.String
+			}
 		case endpoint.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint_credential_profile", values[i])
+			} else if value.Valid {
+				e.endpoint_credential_profile = new(string)
+				*e.endpoint_credential_profile = value.String
+			}
+		case endpoint.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint_health", values[i])
+			} else if value.Valid {
+				e.endpoint_health = new(string)
+				*e.endpoint_health = value.String
+			}
+		case endpoint.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field network_device_endpoints", values[i])
 			} else if value.Valid {
@@ -118,6 +218,16 @@ func (e *Endpoint) Value(name string) (ent.Value, error) {
 	return e.selectValues.Get(name)
 }
 
+// QueryCredentialProfile queries the "credential_profile" edge of the Endpoint entity.
+func (e *Endpoint) QueryCredentialProfile() *CredentialProfileQuery {
+	return NewEndpointClient(e.config).QueryCredentialProfile(e)
+}
+
+// QueryHealth queries the "health" edge of the Endpoint entity.
+func (e *Endpoint) QueryHealth() *EndpointHealthQuery {
+	return NewEndpointClient(e.config).QueryHealth(e)
+}
+
 // QueryNetworkDevice queries the "network_device" edge of the Endpoint entity.
 func (e *Endpoint) QueryNetworkDevice() *NetworkDeviceQuery {
 	return NewEndpointClient(e.config).QueryNetworkDevice(e)
@@ -154,6 +264,24 @@ func (e *Endpoint) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("protocol=")
 	builder.WriteString(fmt.Sprintf("%v", e.Protocol))
+	builder.WriteString(", ")
+	builder.WriteString("tls_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", e.TLSEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("tls_ca_bundle=")
+	builder.WriteString(e.TLSCaBundle)
+	builder.WriteString(", ")
+	builder.WriteString("tls_server_name=")
+	builder.WriteString(e.TLSServerName)
+	builder.WriteString(", ")
+	builder.WriteString("tls_insecure_skip_verify=")
+	builder.WriteString(fmt.Sprintf("%v", e.TLSInsecureSkipVerify))
+	builder.WriteString(", ")
+	builder.WriteString("preference=")
+	builder.WriteString(fmt.Sprintf("%v", e.Preference))
+	builder.WriteString(", ")
+	builder.WriteString("protocol_name=")
+	builder.WriteString(e.ProtocolName)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -20,10 +20,40 @@ const (
 	FieldPort = "port"
 	// FieldProtocol holds the string denoting the protocol field in the database.
 	FieldProtocol = "protocol"
+	// FieldTLSEnabled holds the string denoting the tls_enabled field in the database.
+	FieldTLSEnabled = "tls_enabled"
+	// FieldTLSCaBundle holds the string denoting the tls_ca_bundle field in the database.
+	FieldTLSCaBundle = "tls_ca_bundle"
+	// FieldTLSServerName holds the string denoting the tls_server_name field in the database.
+	FieldTLSServerName = "tls_server_name"
+	// FieldTLSInsecureSkipVerify holds the string denoting the tls_insecure_skip_verify field in the database.
+	FieldTLSInsecureSkipVerify = "tls_insecure_skip_verify"
+	// FieldPreference holds the string denoting the preference field in the database.
+	FieldPreference = "preference"
+	// FieldProtocolName holds the string denoting the protocol_name field in the database.
+	FieldProtocolName = "protocol_name"
+	// EdgeCredentialProfile holds the string denoting the credential_profile edge name in mutations.
+	EdgeCredentialProfile = "credential_profile"
+	// EdgeHealth holds the string denoting the health edge name in mutations.
+	EdgeHealth = "health"
 	// EdgeNetworkDevice holds the string denoting the network_device edge name in mutations.
 	EdgeNetworkDevice = "network_device"
 	// Table holds the table name of the endpoint in the database.
 	Table = "endpoints"
+	// CredentialProfileTable is the table that holds the credential_profile relation/edge.
+	CredentialProfileTable = "endpoints"
+	// CredentialProfileInverseTable is the table name for the CredentialProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "credentialprofile" package.
+	CredentialProfileInverseTable = "credential_profiles"
+	// CredentialProfileColumn is the table column denoting the credential_profile relation/edge.
+	CredentialProfileColumn = "endpoint_credential_profile"
+	// HealthTable is the table that holds the health relation/edge.
+	HealthTable = "endpoints"
+	// HealthInverseTable is the table name for the EndpointHealth entity.
+	// It exists in this package in order to avoid circular dependency with the "endpointhealth" package.
+	HealthInverseTable = "endpoint_healths"
+	// HealthColumn is the table column denoting the health relation/edge.
+	HealthColumn = "endpoint_health"
 	// NetworkDeviceTable is the table that holds the network_device relation/edge.
 	NetworkDeviceTable = "endpoints"
 	// NetworkDeviceInverseTable is the table name for the NetworkDevice entity.
@@ -39,11 +69,19 @@ var Columns = []string{
 	FieldHost,
 	FieldPort,
 	FieldProtocol,
+	FieldTLSEnabled,
+	FieldTLSCaBundle,
+	FieldTLSServerName,
+	FieldTLSInsecureSkipVerify,
+	FieldPreference,
+	FieldProtocolName,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "endpoints"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"endpoint_credential_profile",
+	"endpoint_health",
 	"network_device_endpoints",
 }
 
@@ -72,6 +110,10 @@ const (
 	ProtocolPROTOCOL_NETCONF       Protocol = "PROTOCOL_NETCONF"
 	ProtocolPROTOCOL_RESTCONF      Protocol = "PROTOCOL_RESTCONF"
 	ProtocolPROTOCOL_OPEN_V_SWITCH Protocol = "PROTOCOL_OPEN_V_SWITCH"
+	ProtocolPROTOCOL_GNMI          Protocol = "PROTOCOL_GNMI"
+	ProtocolPROTOCOL_TCP_CONNECT   Protocol = "PROTOCOL_TCP_CONNECT"
+	ProtocolPROTOCOL_ICMP          Protocol = "PROTOCOL_ICMP"
+	ProtocolPROTOCOL_CUSTOM        Protocol = "PROTOCOL_CUSTOM"
 )
 
 func (pr Protocol) String() string {
@@ -81,7 +123,7 @@ func (pr Protocol) String() string {
 // ProtocolValidator is a validator for the "protocol" field enum values. It is called by the builders before save.
 func ProtocolValidator(pr Protocol) error {
 	switch pr {
-	case ProtocolPROTOCOL_UNSPECIFIED, ProtocolPROTOCOL_SNMP, ProtocolPROTOCOL_NETCONF, ProtocolPROTOCOL_RESTCONF, ProtocolPROTOCOL_OPEN_V_SWITCH:
+	case ProtocolPROTOCOL_UNSPECIFIED, ProtocolPROTOCOL_SNMP, ProtocolPROTOCOL_NETCONF, ProtocolPROTOCOL_RESTCONF, ProtocolPROTOCOL_OPEN_V_SWITCH, ProtocolPROTOCOL_GNMI, ProtocolPROTOCOL_TCP_CONNECT, ProtocolPROTOCOL_ICMP, ProtocolPROTOCOL_CUSTOM:
 		return nil
 	default:
 		return fmt.Errorf("endpoint: invalid enum value for protocol field: %q", pr)
@@ -111,12 +153,70 @@ func ByProtocol(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProtocol, opts...).ToFunc()
 }
 
+// ByTLSEnabled orders the results by the tls_enabled field.
+func ByTLSEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSEnabled, opts...).ToFunc()
+}
+
+// ByTLSCaBundle orders the results by the tls_ca_bundle field.
+func ByTLSCaBundle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSCaBundle, opts...).ToFunc()
+}
+
+// ByTLSServerName orders the results by the tls_server_name field.
+func ByTLSServerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSServerName, opts...).ToFunc()
+}
+
+// ByTLSInsecureSkipVerify orders the results by the tls_insecure_skip_verify field.
+func ByTLSInsecureSkipVerify(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSInsecureSkipVerify, opts...).ToFunc()
+}
+
+// ByPreference orders the results by the preference field.
+func ByPreference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreference, opts...).ToFunc()
+}
+
+// ByProtocolName orders the results by the protocol_name field.
+func ByProtocolName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProtocolName, opts...).ToFunc()
+}
+
+// ByCredentialProfileField orders the results by credential_profile field.
+func ByCredentialProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCredentialProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByHealthField orders the results by health field.
+func ByHealthField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHealthStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByNetworkDeviceField orders the results by network_device field.
 func ByNetworkDeviceField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newNetworkDeviceStep(), sql.OrderByField(field, opts...))
 	}
 }
+func newCredentialProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CredentialProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CredentialProfileTable, CredentialProfileColumn),
+	)
+}
+func newHealthStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HealthInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, HealthTable, HealthColumn),
+	)
+}
 func newNetworkDeviceStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -73,6 +73,36 @@ func Port(v string) predicate.Endpoint {
 	return predicate.Endpoint(sql.FieldEQ(FieldPort, v))
 }
 
+// TLSEnabled applies equality check predicate on the "tls_enabled" field. It's identical to TLSEnabledEQ.
+func TLSEnabled(v bool) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEQ(FieldTLSEnabled, v))
+}
+
+// TLSCaBundle applies equality check predicate on the "tls_ca_bundle" field. It's identical to TLSCaBundleEQ.
+func TLSCaBundle(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEQ(FieldTLSCaBundle, v))
+}
+
+// TLSServerName applies equality check predicate on the "tls_server_name" field. It's identical to TLSServerNameEQ.
+func TLSServerName(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEQ(FieldTLSServerName, v))
+}
+
+// TLSInsecureSkipVerify applies equality check predicate on the "tls_insecure_skip_verify" field. It's identical to TLSInsecureSkipVerifyEQ.
+func TLSInsecureSkipVerify(v bool) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEQ(FieldTLSInsecureSkipVerify, v))
+}
+
+// Preference applies equality check predicate on the "preference" field. It's identical to PreferenceEQ.
+func Preference(v int32) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEQ(FieldPreference, v))
+}
+
+// ProtocolName applies equality check predicate on the "protocol_name" field. It's identical to ProtocolNameEQ.
+func ProtocolName(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEQ(FieldProtocolName, v))
+}
+
 // HostEQ applies the EQ predicate on the "host" field.
 func HostEQ(v string) predicate.Endpoint {
 	return predicate.Endpoint(sql.FieldEQ(FieldHost, v))
@@ -223,6 +253,367 @@ func ProtocolNotIn(vs ...Protocol) predicate.Endpoint {
 	return predicate.Endpoint(sql.FieldNotIn(FieldProtocol, vs...))
 }
 
+// TLSEnabledEQ applies the EQ predicate on the "tls_enabled" field.
+func TLSEnabledEQ(v bool) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEQ(FieldTLSEnabled, v))
+}
+
+// TLSEnabledNEQ applies the NEQ predicate on the "tls_enabled" field.
+func TLSEnabledNEQ(v bool) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNEQ(FieldTLSEnabled, v))
+}
+
+// TLSEnabledIsNil applies the IsNil predicate on the "tls_enabled" field.
+func TLSEnabledIsNil() predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldIsNull(FieldTLSEnabled))
+}
+
+// TLSEnabledNotNil applies the NotNil predicate on the "tls_enabled" field.
+func TLSEnabledNotNil() predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNotNull(FieldTLSEnabled))
+}
+
+// TLSCaBundleEQ applies the EQ predicate on the "tls_ca_bundle" field.
+func TLSCaBundleEQ(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEQ(FieldTLSCaBundle, v))
+}
+
+// TLSCaBundleNEQ applies the NEQ predicate on the "tls_ca_bundle" field.
+func TLSCaBundleNEQ(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNEQ(FieldTLSCaBundle, v))
+}
+
+// TLSCaBundleIn applies the In predicate on the "tls_ca_bundle" field.
+func TLSCaBundleIn(vs ...string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldIn(FieldTLSCaBundle, vs...))
+}
+
+// TLSCaBundleNotIn applies the NotIn predicate on the "tls_ca_bundle" field.
+func TLSCaBundleNotIn(vs ...string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNotIn(FieldTLSCaBundle, vs...))
+}
+
+// TLSCaBundleGT applies the GT predicate on the "tls_ca_bundle" field.
+func TLSCaBundleGT(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldGT(FieldTLSCaBundle, v))
+}
+
+// TLSCaBundleGTE applies the GTE predicate on the "tls_ca_bundle" field.
+func TLSCaBundleGTE(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldGTE(FieldTLSCaBundle, v))
+}
+
+// TLSCaBundleLT applies the LT predicate on the "tls_ca_bundle" field.
+func TLSCaBundleLT(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldLT(FieldTLSCaBundle, v))
+}
+
+// TLSCaBundleLTE applies the LTE predicate on the "tls_ca_bundle" field.
+func TLSCaBundleLTE(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldLTE(FieldTLSCaBundle, v))
+}
+
+// TLSCaBundleContains applies the Contains predicate on the "tls_ca_bundle" field.
+func TLSCaBundleContains(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldContains(FieldTLSCaBundle, v))
+}
+
+// TLSCaBundleHasPrefix applies the HasPrefix predicate on the "tls_ca_bundle" field.
+func TLSCaBundleHasPrefix(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldHasPrefix(FieldTLSCaBundle, v))
+}
+
+// TLSCaBundleHasSuffix applies the HasSuffix predicate on the "tls_ca_bundle" field.
+func TLSCaBundleHasSuffix(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldHasSuffix(FieldTLSCaBundle, v))
+}
+
+// TLSCaBundleIsNil applies the IsNil predicate on the "tls_ca_bundle" field.
+func TLSCaBundleIsNil() predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldIsNull(FieldTLSCaBundle))
+}
+
+// TLSCaBundleNotNil applies the NotNil predicate on the "tls_ca_bundle" field.
+func TLSCaBundleNotNil() predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNotNull(FieldTLSCaBundle))
+}
+
+// TLSCaBundleEqualFold applies the EqualFold predicate on the "tls_ca_bundle" field.
+func TLSCaBundleEqualFold(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEqualFold(FieldTLSCaBundle, v))
+}
+
+// TLSCaBundleContainsFold applies the ContainsFold predicate on the "tls_ca_bundle" field.
+func TLSCaBundleContainsFold(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldContainsFold(FieldTLSCaBundle, v))
+}
+
+// TLSServerNameEQ applies the EQ predicate on the "tls_server_name" field.
+func TLSServerNameEQ(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEQ(FieldTLSServerName, v))
+}
+
+// TLSServerNameNEQ applies the NEQ predicate on the "tls_server_name" field.
+func TLSServerNameNEQ(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNEQ(FieldTLSServerName, v))
+}
+
+// TLSServerNameIn applies the In predicate on the "tls_server_name" field.
+func TLSServerNameIn(vs ...string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldIn(FieldTLSServerName, vs...))
+}
+
+// TLSServerNameNotIn applies the NotIn predicate on the "tls_server_name" field.
+func TLSServerNameNotIn(vs ...string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNotIn(FieldTLSServerName, vs...))
+}
+
+// TLSServerNameGT applies the GT predicate on the "tls_server_name" field.
+func TLSServerNameGT(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldGT(FieldTLSServerName, v))
+}
+
+// TLSServerNameGTE applies the GTE predicate on the "tls_server_name" field.
+func TLSServerNameGTE(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldGTE(FieldTLSServerName, v))
+}
+
+// TLSServerNameLT applies the LT predicate on the "tls_server_name" field.
+func TLSServerNameLT(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldLT(FieldTLSServerName, v))
+}
+
+// TLSServerNameLTE applies the LTE predicate on the "tls_server_name" field.
+func TLSServerNameLTE(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldLTE(FieldTLSServerName, v))
+}
+
+// TLSServerNameContains applies the Contains predicate on the "tls_server_name" field.
+func TLSServerNameContains(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldContains(FieldTLSServerName, v))
+}
+
+// TLSServerNameHasPrefix applies the HasPrefix predicate on the "tls_server_name" field.
+func TLSServerNameHasPrefix(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldHasPrefix(FieldTLSServerName, v))
+}
+
+// TLSServerNameHasSuffix applies the HasSuffix predicate on the "tls_server_name" field.
+func TLSServerNameHasSuffix(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldHasSuffix(FieldTLSServerName, v))
+}
+
+// TLSServerNameIsNil applies the IsNil predicate on the "tls_server_name" field.
+func TLSServerNameIsNil() predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldIsNull(FieldTLSServerName))
+}
+
+// TLSServerNameNotNil applies the NotNil predicate on the "tls_server_name" field.
+func TLSServerNameNotNil() predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNotNull(FieldTLSServerName))
+}
+
+// TLSServerNameEqualFold applies the EqualFold predicate on the "tls_server_name" field.
+func TLSServerNameEqualFold(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEqualFold(FieldTLSServerName, v))
+}
+
+// TLSServerNameContainsFold applies the ContainsFold predicate on the "tls_server_name" field.
+func TLSServerNameContainsFold(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldContainsFold(FieldTLSServerName, v))
+}
+
+// TLSInsecureSkipVerifyEQ applies the EQ predicate on the "tls_insecure_skip_verify" field.
+func TLSInsecureSkipVerifyEQ(v bool) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEQ(FieldTLSInsecureSkipVerify, v))
+}
+
+// TLSInsecureSkipVerifyNEQ applies the NEQ predicate on the "tls_insecure_skip_verify" field.
+func TLSInsecureSkipVerifyNEQ(v bool) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNEQ(FieldTLSInsecureSkipVerify, v))
+}
+
+// TLSInsecureSkipVerifyIsNil applies the IsNil predicate on the "tls_insecure_skip_verify" field.
+func TLSInsecureSkipVerifyIsNil() predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldIsNull(FieldTLSInsecureSkipVerify))
+}
+
+// TLSInsecureSkipVerifyNotNil applies the NotNil predicate on the "tls_insecure_skip_verify" field.
+func TLSInsecureSkipVerifyNotNil() predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNotNull(FieldTLSInsecureSkipVerify))
+}
+
+// PreferenceEQ applies the EQ predicate on the "preference" field.
+func PreferenceEQ(v int32) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEQ(FieldPreference, v))
+}
+
+// PreferenceNEQ applies the NEQ predicate on the "preference" field.
+func PreferenceNEQ(v int32) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNEQ(FieldPreference, v))
+}
+
+// PreferenceIn applies the In predicate on the "preference" field.
+func PreferenceIn(vs ...int32) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldIn(FieldPreference, vs...))
+}
+
+// PreferenceNotIn applies the NotIn predicate on the "preference" field.
+func PreferenceNotIn(vs ...int32) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNotIn(FieldPreference, vs...))
+}
+
+// PreferenceGT applies the GT predicate on the "preference" field.
+func PreferenceGT(v int32) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldGT(FieldPreference, v))
+}
+
+// PreferenceGTE applies the GTE predicate on the "preference" field.
+func PreferenceGTE(v int32) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldGTE(FieldPreference, v))
+}
+
+// PreferenceLT applies the LT predicate on the "preference" field.
+func PreferenceLT(v int32) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldLT(FieldPreference, v))
+}
+
+// PreferenceLTE applies the LTE predicate on the "preference" field.
+func PreferenceLTE(v int32) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldLTE(FieldPreference, v))
+}
+
+// PreferenceIsNil applies the IsNil predicate on the "preference" field.
+func PreferenceIsNil() predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldIsNull(FieldPreference))
+}
+
+// PreferenceNotNil applies the NotNil predicate on the "preference" field.
+func PreferenceNotNil() predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNotNull(FieldPreference))
+}
+
+// ProtocolNameEQ applies the EQ predicate on the "protocol_name" field.
+func ProtocolNameEQ(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEQ(FieldProtocolName, v))
+}
+
+// ProtocolNameNEQ applies the NEQ predicate on the "protocol_name" field.
+func ProtocolNameNEQ(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNEQ(FieldProtocolName, v))
+}
+
+// ProtocolNameIn applies the In predicate on the "protocol_name" field.
+func ProtocolNameIn(vs ...string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldIn(FieldProtocolName, vs...))
+}
+
+// ProtocolNameNotIn applies the NotIn predicate on the "protocol_name" field.
+func ProtocolNameNotIn(vs ...string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNotIn(FieldProtocolName, vs...))
+}
+
+// ProtocolNameGT applies the GT predicate on the "protocol_name" field.
+func ProtocolNameGT(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldGT(FieldProtocolName, v))
+}
+
+// ProtocolNameGTE applies the GTE predicate on the "protocol_name" field.
+func ProtocolNameGTE(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldGTE(FieldProtocolName, v))
+}
+
+// ProtocolNameLT applies the LT predicate on the "protocol_name" field.
+func ProtocolNameLT(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldLT(FieldProtocolName, v))
+}
+
+// ProtocolNameLTE applies the LTE predicate on the "protocol_name" field.
+func ProtocolNameLTE(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldLTE(FieldProtocolName, v))
+}
+
+// ProtocolNameContains applies the Contains predicate on the "protocol_name" field.
+func ProtocolNameContains(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldContains(FieldProtocolName, v))
+}
+
+// ProtocolNameHasPrefix applies the HasPrefix predicate on the "protocol_name" field.
+func ProtocolNameHasPrefix(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldHasPrefix(FieldProtocolName, v))
+}
+
+// ProtocolNameHasSuffix applies the HasSuffix predicate on the "protocol_name" field.
+func ProtocolNameHasSuffix(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldHasSuffix(FieldProtocolName, v))
+}
+
+// ProtocolNameIsNil applies the IsNil predicate on the "protocol_name" field.
+func ProtocolNameIsNil() predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldIsNull(FieldProtocolName))
+}
+
+// ProtocolNameNotNil applies the NotNil predicate on the "protocol_name" field.
+func ProtocolNameNotNil() predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldNotNull(FieldProtocolName))
+}
+
+// ProtocolNameEqualFold applies the EqualFold predicate on the "protocol_name" field.
+func ProtocolNameEqualFold(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldEqualFold(FieldProtocolName, v))
+}
+
+// ProtocolNameContainsFold applies the ContainsFold predicate on the "protocol_name" field.
+func ProtocolNameContainsFold(v string) predicate.Endpoint {
+	return predicate.Endpoint(sql.FieldContainsFold(FieldProtocolName, v))
+}
+
+// HasCredentialProfile applies the HasEdge predicate on the "credential_profile" edge.
+func HasCredentialProfile() predicate.Endpoint {
+	return predicate.Endpoint(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CredentialProfileTable, CredentialProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCredentialProfileWith applies the HasEdge predicate on the "credential_profile" edge with a given conditions (other predicates).
+func HasCredentialProfileWith(preds ...predicate.CredentialProfile) predicate.Endpoint {
+	return predicate.Endpoint(func(s *sql.Selector) {
+		step := newCredentialProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHealth applies the HasEdge predicate on the "health" edge.
+func HasHealth() predicate.Endpoint {
+	return predicate.Endpoint(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, HealthTable, HealthColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHealthWith applies the HasEdge predicate on the "health" edge with a given conditions (other predicates).
+func HasHealthWith(preds ...predicate.EndpointHealth) predicate.Endpoint {
+	return predicate.Endpoint(func(s *sql.Selector) {
+		step := newHealthStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasNetworkDevice applies the HasEdge predicate on the "network_device" edge.
 func HasNetworkDevice() predicate.Endpoint {
 	return predicate.Endpoint(func(s *sql.Selector) {
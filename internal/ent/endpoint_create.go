@@ -9,7 +9,9 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
 )
 
@@ -38,12 +40,134 @@ func (ec *EndpointCreate) SetProtocol(e endpoint.Protocol) *EndpointCreate {
 	return ec
 }
 
+// SetTLSEnabled sets the "tls_enabled" field.
+func (ec *EndpointCreate) SetTLSEnabled(b bool) *EndpointCreate {
+	ec.mutation.SetTLSEnabled(b)
+	return ec
+}
+
+// SetNillableTLSEnabled sets the "tls_enabled" field if the given value is not nil.
+func (ec *EndpointCreate) SetNillableTLSEnabled(b *bool) *EndpointCreate {
+	if b != nil {
+		ec.SetTLSEnabled(*b)
+	}
+	return ec
+}
+
+// SetTLSCaBundle sets the "tls_ca_bundle" field.
+func (ec *EndpointCreate) SetTLSCaBundle(s string) *EndpointCreate {
+	ec.mutation.SetTLSCaBundle(s)
+	return ec
+}
+
+// SetNillableTLSCaBundle sets the "tls_ca_bundle" field if the given value is not nil.
+func (ec *EndpointCreate) SetNillableTLSCaBundle(s *string) *EndpointCreate {
+	if s != nil {
+		ec.SetTLSCaBundle(*s)
+	}
+	return ec
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (ec *EndpointCreate) SetTLSServerName(s string) *EndpointCreate {
+	ec.mutation.SetTLSServerName(s)
+	return ec
+}
+
+// SetNillableTLSServerName sets the "tls_server_name" field if the given value is not nil.
+func (ec *EndpointCreate) SetNillableTLSServerName(s *string) *EndpointCreate {
+	if s != nil {
+		ec.SetTLSServerName(*s)
+	}
+	return ec
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (ec *EndpointCreate) SetTLSInsecureSkipVerify(b bool) *EndpointCreate {
+	ec.mutation.SetTLSInsecureSkipVerify(b)
+	return ec
+}
+
+// SetNillableTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field if the given value is not nil.
+func (ec *EndpointCreate) SetNillableTLSInsecureSkipVerify(b *bool) *EndpointCreate {
+	if b != nil {
+		ec.SetTLSInsecureSkipVerify(*b)
+	}
+	return ec
+}
+
+// SetPreference sets the "preference" field.
+func (ec *EndpointCreate) SetPreference(i int32) *EndpointCreate {
+	ec.mutation.SetPreference(i)
+	return ec
+}
+
+// SetNillablePreference sets the "preference" field if the given value is not nil.
+func (ec *EndpointCreate) SetNillablePreference(i *int32) *EndpointCreate {
+	if i != nil {
+		ec.SetPreference(*i)
+	}
+	return ec
+}
+
+// SetProtocolName sets the "protocol_name" field.
+func (ec *EndpointCreate) SetProtocolName(s string) *EndpointCreate {
+	ec.mutation.SetProtocolName(s)
+	return ec
+}
+
+// SetNillableProtocolName sets the "protocol_name" field if the given value is not nil.
+func (ec *EndpointCreate) SetNillableProtocolName(s *string) *EndpointCreate {
+	if s != nil {
+		ec.SetProtocolName(*s)
+	}
+	return ec
+}
+
 // SetID sets the "id" field.
 func (ec *EndpointCreate) SetID(s string) *EndpointCreate {
 	ec.mutation.SetID(s)
 	return ec
 }
 
+// SetCredentialProfileID sets the "credential_profile" edge to the CredentialProfile entity by ID.
+func (ec *EndpointCreate) SetCredentialProfileID(id string) *EndpointCreate {
+	ec.mutation.SetCredentialProfileID(id)
+	return ec
+}
+
+// SetNillableCredentialProfileID sets the "credential_profile" edge to the CredentialProfile entity by ID if the given value is not nil.
+func (ec *EndpointCreate) SetNillableCredentialProfileID(id *string) *EndpointCreate {
+	if id != nil {
+		ec = ec.SetCredentialProfileID(*id)
+	}
+	return ec
+}
+
+// SetCredentialProfile sets the "credential_profile" edge to the CredentialProfile entity.
+func (ec *EndpointCreate) SetCredentialProfile(c *CredentialProfile) *EndpointCreate {
+	return ec.SetCredentialProfileID(c.ID)
+}
+
+// SetHealthID sets the "health" edge to the EndpointHealth entity by ID.
+func (ec *EndpointCreate) SetHealthID(id string) *EndpointCreate {
+	ec.mutation.SetHealthID(id)
+	return ec
+}
+
+// SetNillableHealthID sets the "health" edge to the EndpointHealth entity by ID if the given value is not nil.
+func (ec *EndpointCreate) SetNillableHealthID(id *string) *EndpointCreate {
+	if id != nil {
+		ec = ec.SetHealthID(*id)
+	}
+	return ec
+}
+
+// SetHealth sets the "health" edge to the EndpointHealth entity.
+func (ec *EndpointCreate) SetHealth(e *EndpointHealth) *EndpointCreate {
+	return ec.SetHealthID(e.ID)
+}
+
 // SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID.
 func (ec *EndpointCreate) SetNetworkDeviceID(id string) *EndpointCreate {
 	ec.mutation.SetNetworkDeviceID(id)
@@ -158,6 +282,64 @@ func (ec *EndpointCreate) createSpec() (*Endpoint, *sqlgraph.CreateSpec) {
 		_spec.SetField(endpoint.FieldProtocol, field.TypeEnum, value)
 		_node.Protocol = value
 	}
+	if value, ok := ec.mutation.TLSEnabled(); ok {
+		_spec.SetField(endpoint.FieldTLSEnabled, field.TypeBool, value)
+		_node.TLSEnabled = value
+	}
+	if value, ok := ec.mutation.TLSCaBundle(); ok {
+		_spec.SetField(endpoint.FieldTLSCaBundle, field.TypeString, value)
+		_node.TLSCaBundle = value
+	}
+	if value, ok := ec.mutation.TLSServerName(); ok {
+		_spec.SetField(endpoint.FieldTLSServerName, field.TypeString, value)
+		_node.TLSServerName = value
+	}
+	if value, ok := ec.mutation.TLSInsecureSkipVerify(); ok {
+		_spec.SetField(endpoint.FieldTLSInsecureSkipVerify, field.TypeBool, value)
+		_node.TLSInsecureSkipVerify = value
+	}
+	if value, ok := ec.mutation.Preference(); ok {
+		_spec.SetField(endpoint.FieldPreference, field.TypeInt32, value)
+		_node.Preference = value
+	}
+	if value, ok := ec.mutation.ProtocolName(); ok {
+		_spec.SetField(endpoint.FieldProtocolName, field.TypeString, value)
+		_node.ProtocolName = value
+	}
+	if nodes := ec.mutation.CredentialProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   endpoint.CredentialProfileTable,
+			Columns: []string{endpoint.CredentialProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credentialprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.endpoint_credential_profile = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.HealthIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   endpoint.HealthTable,
+			Columns: []string{endpoint.HealthColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(endpointhealth.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.endpoint_health = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := ec.mutation.NetworkDeviceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
 	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
 )
@@ -19,12 +21,14 @@ import (
 // EndpointQuery is the builder for querying Endpoint entities.
 type EndpointQuery struct {
 	config
-	ctx               *QueryContext
-	order             []endpoint.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.Endpoint
-	withNetworkDevice *NetworkDeviceQuery
-	withFKs           bool
+	ctx                   *QueryContext
+	order                 []endpoint.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Endpoint
+	withCredentialProfile *CredentialProfileQuery
+	withHealth            *EndpointHealthQuery
+	withNetworkDevice     *NetworkDeviceQuery
+	withFKs               bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -61,6 +65,50 @@ func (eq *EndpointQuery) Order(o ...endpoint.OrderOption) *EndpointQuery {
 	return eq
 }
 
+// QueryCredentialProfile chains the current query on the "credential_profile" edge.
+func (eq *EndpointQuery) QueryCredentialProfile() *CredentialProfileQuery {
+	query := (&CredentialProfileClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(endpoint.Table, endpoint.FieldID, selector),
+			sqlgraph.To(credentialprofile.Table, credentialprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, endpoint.CredentialProfileTable, endpoint.CredentialProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHealth chains the current query on the "health" edge.
+func (eq *EndpointQuery) QueryHealth() *EndpointHealthQuery {
+	query := (&EndpointHealthClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(endpoint.Table, endpoint.FieldID, selector),
+			sqlgraph.To(endpointhealth.Table, endpointhealth.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, endpoint.HealthTable, endpoint.HealthColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryNetworkDevice chains the current query on the "network_device" edge.
 func (eq *EndpointQuery) QueryNetworkDevice() *NetworkDeviceQuery {
 	query := (&NetworkDeviceClient{config: eq.config}).Query()
@@ -270,18 +318,42 @@ func (eq *EndpointQuery) Clone() *EndpointQuery {
 		return nil
 	}
 	return &EndpointQuery{
-		config:            eq.config,
-		ctx:               eq.ctx.Clone(),
-		order:             append([]endpoint.OrderOption{}, eq.order...),
-		inters:            append([]Interceptor{}, eq.inters...),
-		predicates:        append([]predicate.Endpoint{}, eq.predicates...),
-		withNetworkDevice: eq.withNetworkDevice.Clone(),
+		config:                eq.config,
+		ctx:                   eq.ctx.Clone(),
+		order:                 append([]endpoint.OrderOption{}, eq.order...),
+		inters:                append([]Interceptor{}, eq.inters...),
+		predicates:            append([]predicate.Endpoint{}, eq.predicates...),
+		withCredentialProfile: eq.withCredentialProfile.Clone(),
+		withHealth:            eq.withHealth.Clone(),
+		withNetworkDevice:     eq.withNetworkDevice.Clone(),
 		// clone intermediate query.
 		sql:  eq.sql.Clone(),
 		path: eq.path,
 	}
 }
 
+// WithCredentialProfile tells the query-builder to eager-load the nodes that are connected to
+// the "credential_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EndpointQuery) WithCredentialProfile(opts ...func(*CredentialProfileQuery)) *EndpointQuery {
+	query := (&CredentialProfileClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withCredentialProfile = query
+	return eq
+}
+
+// WithHealth tells the query-builder to eager-load the nodes that are connected to
+// the "health" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EndpointQuery) WithHealth(opts ...func(*EndpointHealthQuery)) *EndpointQuery {
+	query := (&EndpointHealthClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withHealth = query
+	return eq
+}
+
 // WithNetworkDevice tells the query-builder to eager-load the nodes that are connected to
 // the "network_device" edge. The optional arguments are used to configure the query builder of the edge.
 func (eq *EndpointQuery) WithNetworkDevice(opts ...func(*NetworkDeviceQuery)) *EndpointQuery {
@@ -372,11 +444,13 @@ func (eq *EndpointQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*End
 		nodes       = []*Endpoint{}
 		withFKs     = eq.withFKs
 		_spec       = eq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
+			eq.withCredentialProfile != nil,
+			eq.withHealth != nil,
 			eq.withNetworkDevice != nil,
 		}
 	)
-	if eq.withNetworkDevice != nil {
+	if eq.withCredentialProfile != nil || eq.withHealth != nil || eq.withNetworkDevice != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -400,6 +474,18 @@ func (eq *EndpointQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*End
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := eq.withCredentialProfile; query != nil {
+		if err := eq.loadCredentialProfile(ctx, query, nodes, nil,
+			func(n *Endpoint, e *CredentialProfile) { n.Edges.CredentialProfile = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withHealth; query != nil {
+		if err := eq.loadHealth(ctx, query, nodes, nil,
+			func(n *Endpoint, e *EndpointHealth) { n.Edges.Health = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := eq.withNetworkDevice; query != nil {
 		if err := eq.loadNetworkDevice(ctx, query, nodes, nil,
 			func(n *Endpoint, e *NetworkDevice) { n.Edges.NetworkDevice = e }); err != nil {
@@ -409,6 +495,70 @@ func (eq *EndpointQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*End
 	return nodes, nil
 }
 
+func (eq *EndpointQuery) loadCredentialProfile(ctx context.Context, query *CredentialProfileQuery, nodes []*Endpoint, init func(*Endpoint), assign func(*Endpoint, *CredentialProfile)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Endpoint)
+	for i := range nodes {
+		if nodes[i].endpoint_credential_profile == nil {
+			continue
+		}
+		fk := *nodes[i].endpoint_credential_profile
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(credentialprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "endpoint_credential_profile" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (eq *EndpointQuery) loadHealth(ctx context.Context, query *EndpointHealthQuery, nodes []*Endpoint, init func(*Endpoint), assign func(*Endpoint, *EndpointHealth)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Endpoint)
+	for i := range nodes {
+		if nodes[i].endpoint_health == nil {
+			continue
+		}
+		fk := *nodes[i].endpoint_health
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(endpointhealth.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "endpoint_health" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (eq *EndpointQuery) loadNetworkDevice(ctx context.Context, query *NetworkDeviceQuery, nodes []*Endpoint, init func(*Endpoint), assign func(*Endpoint, *NetworkDevice)) error {
 	ids := make([]string, 0, len(nodes))
 	nodeids := make(map[string][]*Endpoint)
@@ -10,7 +10,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
 	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
 )
@@ -70,6 +72,171 @@ func (eu *EndpointUpdate) SetNillableProtocol(e *endpoint.Protocol) *EndpointUpd
 	return eu
 }
 
+// SetTLSEnabled sets the "tls_enabled" field.
+func (eu *EndpointUpdate) SetTLSEnabled(b bool) *EndpointUpdate {
+	eu.mutation.SetTLSEnabled(b)
+	return eu
+}
+
+// SetNillableTLSEnabled sets the "tls_enabled" field if the given value is not nil.
+func (eu *EndpointUpdate) SetNillableTLSEnabled(b *bool) *EndpointUpdate {
+	if b != nil {
+		eu.SetTLSEnabled(*b)
+	}
+	return eu
+}
+
+// ClearTLSEnabled clears the value of the "tls_enabled" field.
+func (eu *EndpointUpdate) ClearTLSEnabled() *EndpointUpdate {
+	eu.mutation.ClearTLSEnabled()
+	return eu
+}
+
+// SetTLSCaBundle sets the "tls_ca_bundle" field.
+func (eu *EndpointUpdate) SetTLSCaBundle(s string) *EndpointUpdate {
+	eu.mutation.SetTLSCaBundle(s)
+	return eu
+}
+
+// SetNillableTLSCaBundle sets the "tls_ca_bundle" field if the given value is not nil.
+func (eu *EndpointUpdate) SetNillableTLSCaBundle(s *string) *EndpointUpdate {
+	if s != nil {
+		eu.SetTLSCaBundle(*s)
+	}
+	return eu
+}
+
+// ClearTLSCaBundle clears the value of the "tls_ca_bundle" field.
+func (eu *EndpointUpdate) ClearTLSCaBundle() *EndpointUpdate {
+	eu.mutation.ClearTLSCaBundle()
+	return eu
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (eu *EndpointUpdate) SetTLSServerName(s string) *EndpointUpdate {
+	eu.mutation.SetTLSServerName(s)
+	return eu
+}
+
+// SetNillableTLSServerName sets the "tls_server_name" field if the given value is not nil.
+func (eu *EndpointUpdate) SetNillableTLSServerName(s *string) *EndpointUpdate {
+	if s != nil {
+		eu.SetTLSServerName(*s)
+	}
+	return eu
+}
+
+// ClearTLSServerName clears the value of the "tls_server_name" field.
+func (eu *EndpointUpdate) ClearTLSServerName() *EndpointUpdate {
+	eu.mutation.ClearTLSServerName()
+	return eu
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (eu *EndpointUpdate) SetTLSInsecureSkipVerify(b bool) *EndpointUpdate {
+	eu.mutation.SetTLSInsecureSkipVerify(b)
+	return eu
+}
+
+// SetNillableTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field if the given value is not nil.
+func (eu *EndpointUpdate) SetNillableTLSInsecureSkipVerify(b *bool) *EndpointUpdate {
+	if b != nil {
+		eu.SetTLSInsecureSkipVerify(*b)
+	}
+	return eu
+}
+
+// ClearTLSInsecureSkipVerify clears the value of the "tls_insecure_skip_verify" field.
+func (eu *EndpointUpdate) ClearTLSInsecureSkipVerify() *EndpointUpdate {
+	eu.mutation.ClearTLSInsecureSkipVerify()
+	return eu
+}
+
+// SetPreference sets the "preference" field.
+func (eu *EndpointUpdate) SetPreference(i int32) *EndpointUpdate {
+	eu.mutation.ResetPreference()
+	eu.mutation.SetPreference(i)
+	return eu
+}
+
+// SetNillablePreference sets the "preference" field if the given value is not nil.
+func (eu *EndpointUpdate) SetNillablePreference(i *int32) *EndpointUpdate {
+	if i != nil {
+		eu.SetPreference(*i)
+	}
+	return eu
+}
+
+// AddPreference adds i to the "preference" field.
+func (eu *EndpointUpdate) AddPreference(i int32) *EndpointUpdate {
+	eu.mutation.AddPreference(i)
+	return eu
+}
+
+// ClearPreference clears the value of the "preference" field.
+func (eu *EndpointUpdate) ClearPreference() *EndpointUpdate {
+	eu.mutation.ClearPreference()
+	return eu
+}
+
+// SetProtocolName sets the "protocol_name" field.
+func (eu *EndpointUpdate) SetProtocolName(s string) *EndpointUpdate {
+	eu.mutation.SetProtocolName(s)
+	return eu
+}
+
+// SetNillableProtocolName sets the "protocol_name" field if the given value is not nil.
+func (eu *EndpointUpdate) SetNillableProtocolName(s *string) *EndpointUpdate {
+	if s != nil {
+		eu.SetProtocolName(*s)
+	}
+	return eu
+}
+
+// ClearProtocolName clears the value of the "protocol_name" field.
+func (eu *EndpointUpdate) ClearProtocolName() *EndpointUpdate {
+	eu.mutation.ClearProtocolName()
+	return eu
+}
+
+// SetCredentialProfileID sets the "credential_profile" edge to the CredentialProfile entity by ID.
+func (eu *EndpointUpdate) SetCredentialProfileID(id string) *EndpointUpdate {
+	eu.mutation.SetCredentialProfileID(id)
+	return eu
+}
+
+// SetNillableCredentialProfileID sets the "credential_profile" edge to the CredentialProfile entity by ID if the given value is not nil.
+func (eu *EndpointUpdate) SetNillableCredentialProfileID(id *string) *EndpointUpdate {
+	if id != nil {
+		eu = eu.SetCredentialProfileID(*id)
+	}
+	return eu
+}
+
+// SetCredentialProfile sets the "credential_profile" edge to the CredentialProfile entity.
+func (eu *EndpointUpdate) SetCredentialProfile(c *CredentialProfile) *EndpointUpdate {
+	return eu.SetCredentialProfileID(c.ID)
+}
+
+// SetHealthID sets the "health" edge to the EndpointHealth entity by ID.
+func (eu *EndpointUpdate) SetHealthID(id string) *EndpointUpdate {
+	eu.mutation.SetHealthID(id)
+	return eu
+}
+
+// SetNillableHealthID sets the "health" edge to the EndpointHealth entity by ID if the given value is not nil.
+func (eu *EndpointUpdate) SetNillableHealthID(id *string) *EndpointUpdate {
+	if id != nil {
+		eu = eu.SetHealthID(*id)
+	}
+	return eu
+}
+
+// SetHealth sets the "health" edge to the EndpointHealth entity.
+func (eu *EndpointUpdate) SetHealth(e *EndpointHealth) *EndpointUpdate {
+	return eu.SetHealthID(e.ID)
+}
+
 // SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID.
 func (eu *EndpointUpdate) SetNetworkDeviceID(id string) *EndpointUpdate {
 	eu.mutation.SetNetworkDeviceID(id)
@@ -94,6 +261,18 @@ func (eu *EndpointUpdate) Mutation() *EndpointMutation {
 	return eu.mutation
 }
 
+// ClearCredentialProfile clears the "credential_profile" edge to the CredentialProfile entity.
+func (eu *EndpointUpdate) ClearCredentialProfile() *EndpointUpdate {
+	eu.mutation.ClearCredentialProfile()
+	return eu
+}
+
+// ClearHealth clears the "health" edge to the EndpointHealth entity.
+func (eu *EndpointUpdate) ClearHealth() *EndpointUpdate {
+	eu.mutation.ClearHealth()
+	return eu
+}
+
 // ClearNetworkDevice clears the "network_device" edge to the NetworkDevice entity.
 func (eu *EndpointUpdate) ClearNetworkDevice() *EndpointUpdate {
 	eu.mutation.ClearNetworkDevice()
@@ -158,6 +337,103 @@ func (eu *EndpointUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := eu.mutation.Protocol(); ok {
 		_spec.SetField(endpoint.FieldProtocol, field.TypeEnum, value)
 	}
+	if value, ok := eu.mutation.TLSEnabled(); ok {
+		_spec.SetField(endpoint.FieldTLSEnabled, field.TypeBool, value)
+	}
+	if eu.mutation.TLSEnabledCleared() {
+		_spec.ClearField(endpoint.FieldTLSEnabled, field.TypeBool)
+	}
+	if value, ok := eu.mutation.TLSCaBundle(); ok {
+		_spec.SetField(endpoint.FieldTLSCaBundle, field.TypeString, value)
+	}
+	if eu.mutation.TLSCaBundleCleared() {
+		_spec.ClearField(endpoint.FieldTLSCaBundle, field.TypeString)
+	}
+	if value, ok := eu.mutation.TLSServerName(); ok {
+		_spec.SetField(endpoint.FieldTLSServerName, field.TypeString, value)
+	}
+	if eu.mutation.TLSServerNameCleared() {
+		_spec.ClearField(endpoint.FieldTLSServerName, field.TypeString)
+	}
+	if value, ok := eu.mutation.TLSInsecureSkipVerify(); ok {
+		_spec.SetField(endpoint.FieldTLSInsecureSkipVerify, field.TypeBool, value)
+	}
+	if eu.mutation.TLSInsecureSkipVerifyCleared() {
+		_spec.ClearField(endpoint.FieldTLSInsecureSkipVerify, field.TypeBool)
+	}
+	if value, ok := eu.mutation.Preference(); ok {
+		_spec.SetField(endpoint.FieldPreference, field.TypeInt32, value)
+	}
+	if value, ok := eu.mutation.AddedPreference(); ok {
+		_spec.AddField(endpoint.FieldPreference, field.TypeInt32, value)
+	}
+	if eu.mutation.PreferenceCleared() {
+		_spec.ClearField(endpoint.FieldPreference, field.TypeInt32)
+	}
+	if value, ok := eu.mutation.ProtocolName(); ok {
+		_spec.SetField(endpoint.FieldProtocolName, field.TypeString, value)
+	}
+	if eu.mutation.ProtocolNameCleared() {
+		_spec.ClearField(endpoint.FieldProtocolName, field.TypeString)
+	}
+	if eu.mutation.CredentialProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   endpoint.CredentialProfileTable,
+			Columns: []string{endpoint.CredentialProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credentialprofile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.CredentialProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   endpoint.CredentialProfileTable,
+			Columns: []string{endpoint.CredentialProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credentialprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.HealthCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   endpoint.HealthTable,
+			Columns: []string{endpoint.HealthColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(endpointhealth.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.HealthIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   endpoint.HealthTable,
+			Columns: []string{endpoint.HealthColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(endpointhealth.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if eu.mutation.NetworkDeviceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -249,6 +525,171 @@ func (euo *EndpointUpdateOne) SetNillableProtocol(e *endpoint.Protocol) *Endpoin
 	return euo
 }
 
+// SetTLSEnabled sets the "tls_enabled" field.
+func (euo *EndpointUpdateOne) SetTLSEnabled(b bool) *EndpointUpdateOne {
+	euo.mutation.SetTLSEnabled(b)
+	return euo
+}
+
+// SetNillableTLSEnabled sets the "tls_enabled" field if the given value is not nil.
+func (euo *EndpointUpdateOne) SetNillableTLSEnabled(b *bool) *EndpointUpdateOne {
+	if b != nil {
+		euo.SetTLSEnabled(*b)
+	}
+	return euo
+}
+
+// ClearTLSEnabled clears the value of the "tls_enabled" field.
+func (euo *EndpointUpdateOne) ClearTLSEnabled() *EndpointUpdateOne {
+	euo.mutation.ClearTLSEnabled()
+	return euo
+}
+
+// SetTLSCaBundle sets the "tls_ca_bundle" field.
+func (euo *EndpointUpdateOne) SetTLSCaBundle(s string) *EndpointUpdateOne {
+	euo.mutation.SetTLSCaBundle(s)
+	return euo
+}
+
+// SetNillableTLSCaBundle sets the "tls_ca_bundle" field if the given value is not nil.
+func (euo *EndpointUpdateOne) SetNillableTLSCaBundle(s *string) *EndpointUpdateOne {
+	if s != nil {
+		euo.SetTLSCaBundle(*s)
+	}
+	return euo
+}
+
+// ClearTLSCaBundle clears the value of the "tls_ca_bundle" field.
+func (euo *EndpointUpdateOne) ClearTLSCaBundle() *EndpointUpdateOne {
+	euo.mutation.ClearTLSCaBundle()
+	return euo
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (euo *EndpointUpdateOne) SetTLSServerName(s string) *EndpointUpdateOne {
+	euo.mutation.SetTLSServerName(s)
+	return euo
+}
+
+// SetNillableTLSServerName sets the "tls_server_name" field if the given value is not nil.
+func (euo *EndpointUpdateOne) SetNillableTLSServerName(s *string) *EndpointUpdateOne {
+	if s != nil {
+		euo.SetTLSServerName(*s)
+	}
+	return euo
+}
+
+// ClearTLSServerName clears the value of the "tls_server_name" field.
+func (euo *EndpointUpdateOne) ClearTLSServerName() *EndpointUpdateOne {
+	euo.mutation.ClearTLSServerName()
+	return euo
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (euo *EndpointUpdateOne) SetTLSInsecureSkipVerify(b bool) *EndpointUpdateOne {
+	euo.mutation.SetTLSInsecureSkipVerify(b)
+	return euo
+}
+
+// SetNillableTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field if the given value is not nil.
+func (euo *EndpointUpdateOne) SetNillableTLSInsecureSkipVerify(b *bool) *EndpointUpdateOne {
+	if b != nil {
+		euo.SetTLSInsecureSkipVerify(*b)
+	}
+	return euo
+}
+
+// ClearTLSInsecureSkipVerify clears the value of the "tls_insecure_skip_verify" field.
+func (euo *EndpointUpdateOne) ClearTLSInsecureSkipVerify() *EndpointUpdateOne {
+	euo.mutation.ClearTLSInsecureSkipVerify()
+	return euo
+}
+
+// SetPreference sets the "preference" field.
+func (euo *EndpointUpdateOne) SetPreference(i int32) *EndpointUpdateOne {
+	euo.mutation.ResetPreference()
+	euo.mutation.SetPreference(i)
+	return euo
+}
+
+// SetNillablePreference sets the "preference" field if the given value is not nil.
+func (euo *EndpointUpdateOne) SetNillablePreference(i *int32) *EndpointUpdateOne {
+	if i != nil {
+		euo.SetPreference(*i)
+	}
+	return euo
+}
+
+// AddPreference adds i to the "preference" field.
+func (euo *EndpointUpdateOne) AddPreference(i int32) *EndpointUpdateOne {
+	euo.mutation.AddPreference(i)
+	return euo
+}
+
+// ClearPreference clears the value of the "preference" field.
+func (euo *EndpointUpdateOne) ClearPreference() *EndpointUpdateOne {
+	euo.mutation.ClearPreference()
+	return euo
+}
+
+// SetProtocolName sets the "protocol_name" field.
+func (euo *EndpointUpdateOne) SetProtocolName(s string) *EndpointUpdateOne {
+	euo.mutation.SetProtocolName(s)
+	return euo
+}
+
+// SetNillableProtocolName sets the "protocol_name" field if the given value is not nil.
+func (euo *EndpointUpdateOne) SetNillableProtocolName(s *string) *EndpointUpdateOne {
+	if s != nil {
+		euo.SetProtocolName(*s)
+	}
+	return euo
+}
+
+// ClearProtocolName clears the value of the "protocol_name" field.
+func (euo *EndpointUpdateOne) ClearProtocolName() *EndpointUpdateOne {
+	euo.mutation.ClearProtocolName()
+	return euo
+}
+
+// SetCredentialProfileID sets the "credential_profile" edge to the CredentialProfile entity by ID.
+func (euo *EndpointUpdateOne) SetCredentialProfileID(id string) *EndpointUpdateOne {
+	euo.mutation.SetCredentialProfileID(id)
+	return euo
+}
+
+// SetNillableCredentialProfileID sets the "credential_profile" edge to the CredentialProfile entity by ID if the given value is not nil.
+func (euo *EndpointUpdateOne) SetNillableCredentialProfileID(id *string) *EndpointUpdateOne {
+	if id != nil {
+		euo = euo.SetCredentialProfileID(*id)
+	}
+	return euo
+}
+
+// SetCredentialProfile sets the "credential_profile" edge to the CredentialProfile entity.
+func (euo *EndpointUpdateOne) SetCredentialProfile(c *CredentialProfile) *EndpointUpdateOne {
+	return euo.SetCredentialProfileID(c.ID)
+}
+
+// SetHealthID sets the "health" edge to the EndpointHealth entity by ID.
+func (euo *EndpointUpdateOne) SetHealthID(id string) *EndpointUpdateOne {
+	euo.mutation.SetHealthID(id)
+	return euo
+}
+
+// SetNillableHealthID sets the "health" edge to the EndpointHealth entity by ID if the given value is not nil.
+func (euo *EndpointUpdateOne) SetNillableHealthID(id *string) *EndpointUpdateOne {
+	if id != nil {
+		euo = euo.SetHealthID(*id)
+	}
+	return euo
+}
+
+// SetHealth sets the "health" edge to the EndpointHealth entity.
+func (euo *EndpointUpdateOne) SetHealth(e *EndpointHealth) *EndpointUpdateOne {
+	return euo.SetHealthID(e.ID)
+}
+
 // SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID.
 func (euo *EndpointUpdateOne) SetNetworkDeviceID(id string) *EndpointUpdateOne {
 	euo.mutation.SetNetworkDeviceID(id)
@@ -273,6 +714,18 @@ func (euo *EndpointUpdateOne) Mutation() *EndpointMutation {
 	return euo.mutation
 }
 
+// ClearCredentialProfile clears the "credential_profile" edge to the CredentialProfile entity.
+func (euo *EndpointUpdateOne) ClearCredentialProfile() *EndpointUpdateOne {
+	euo.mutation.ClearCredentialProfile()
+	return euo
+}
+
+// ClearHealth clears the "health" edge to the EndpointHealth entity.
+func (euo *EndpointUpdateOne) ClearHealth() *EndpointUpdateOne {
+	euo.mutation.ClearHealth()
+	return euo
+}
+
 // ClearNetworkDevice clears the "network_device" edge to the NetworkDevice entity.
 func (euo *EndpointUpdateOne) ClearNetworkDevice() *EndpointUpdateOne {
 	euo.mutation.ClearNetworkDevice()
@@ -367,6 +820,103 @@ func (euo *EndpointUpdateOne) sqlSave(ctx context.Context) (_node *Endpoint, err
 	if value, ok := euo.mutation.Protocol(); ok {
 		_spec.SetField(endpoint.FieldProtocol, field.TypeEnum, value)
 	}
+	if value, ok := euo.mutation.TLSEnabled(); ok {
+		_spec.SetField(endpoint.FieldTLSEnabled, field.TypeBool, value)
+	}
+	if euo.mutation.TLSEnabledCleared() {
+		_spec.ClearField(endpoint.FieldTLSEnabled, field.TypeBool)
+	}
+	if value, ok := euo.mutation.TLSCaBundle(); ok {
+		_spec.SetField(endpoint.FieldTLSCaBundle, field.TypeString, value)
+	}
+	if euo.mutation.TLSCaBundleCleared() {
+		_spec.ClearField(endpoint.FieldTLSCaBundle, field.TypeString)
+	}
+	if value, ok := euo.mutation.TLSServerName(); ok {
+		_spec.SetField(endpoint.FieldTLSServerName, field.TypeString, value)
+	}
+	if euo.mutation.TLSServerNameCleared() {
+		_spec.ClearField(endpoint.FieldTLSServerName, field.TypeString)
+	}
+	if value, ok := euo.mutation.TLSInsecureSkipVerify(); ok {
+		_spec.SetField(endpoint.FieldTLSInsecureSkipVerify, field.TypeBool, value)
+	}
+	if euo.mutation.TLSInsecureSkipVerifyCleared() {
+		_spec.ClearField(endpoint.FieldTLSInsecureSkipVerify, field.TypeBool)
+	}
+	if value, ok := euo.mutation.Preference(); ok {
+		_spec.SetField(endpoint.FieldPreference, field.TypeInt32, value)
+	}
+	if value, ok := euo.mutation.AddedPreference(); ok {
+		_spec.AddField(endpoint.FieldPreference, field.TypeInt32, value)
+	}
+	if euo.mutation.PreferenceCleared() {
+		_spec.ClearField(endpoint.FieldPreference, field.TypeInt32)
+	}
+	if value, ok := euo.mutation.ProtocolName(); ok {
+		_spec.SetField(endpoint.FieldProtocolName, field.TypeString, value)
+	}
+	if euo.mutation.ProtocolNameCleared() {
+		_spec.ClearField(endpoint.FieldProtocolName, field.TypeString)
+	}
+	if euo.mutation.CredentialProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   endpoint.CredentialProfileTable,
+			Columns: []string{endpoint.CredentialProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credentialprofile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.CredentialProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   endpoint.CredentialProfileTable,
+			Columns: []string{endpoint.CredentialProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credentialprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.HealthCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   endpoint.HealthTable,
+			Columns: []string{endpoint.HealthColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(endpointhealth.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.HealthIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   endpoint.HealthTable,
+			Columns: []string{endpoint.HealthColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(endpointhealth.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if euo.mutation.NetworkDeviceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
+)
+
+// EndpointHealth is the model entity for the EndpointHealth schema.
+type EndpointHealth struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// LastSuccess holds the value of the "last_success" field.
+	LastSuccess int64 `json:"last_success,omitempty"`
+	// LastFailure holds the value of the "last_failure" field.
+	LastFailure int64 `json:"last_failure,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// Latency holds the value of the "latency" field.
+	Latency int64 `json:"latency,omitempty"`
+	// FailureCount holds the value of the "failure_count" field.
+	FailureCount int32 `json:"failure_count,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EndpointHealth) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case endpointhealth.FieldLastSuccess, endpointhealth.FieldLastFailure, endpointhealth.FieldLatency, endpointhealth.FieldFailureCount:
+			values[i] = new(sql.NullInt64)
+		case endpointhealth.FieldID, endpointhealth.FieldLastError:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EndpointHealth fields.
+func (eh *EndpointHealth) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case endpointhealth.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				eh.ID = value.String
+			}
+		case endpointhealth.FieldLastSuccess:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_success", values[i])
+			} else if value.Valid {
+				eh.LastSuccess = value.Int64
+			}
+		case endpointhealth.FieldLastFailure:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failure", values[i])
+			} else if value.Valid {
+				eh.LastFailure = value.Int64
+			}
+		case endpointhealth.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				eh.LastError = value.String
+			}
+		case endpointhealth.FieldLatency:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency", values[i])
+			} else if value.Valid {
+				eh.Latency = value.Int64
+			}
+		case endpointhealth.FieldFailureCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_count", values[i])
+			} else if value.Valid {
+				eh.FailureCount = int32(value.Int64)
+			}
+		default:
+			eh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EndpointHealth.
+// This includes values selected through modifiers, order, etc.
+func (eh *EndpointHealth) Value(name string) (ent.Value, error) {
+	return eh.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EndpointHealth.
+// Note that you need to call EndpointHealth.Unwrap() before calling this method if this EndpointHealth
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (eh *EndpointHealth) Update() *EndpointHealthUpdateOne {
+	return NewEndpointHealthClient(eh.config).UpdateOne(eh)
+}
+
+// Unwrap unwraps the EndpointHealth entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (eh *EndpointHealth) Unwrap() *EndpointHealth {
+	_tx, ok := eh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EndpointHealth is not a transactional entity")
+	}
+	eh.config.driver = _tx.drv
+	return eh
+}
+
+// String implements the fmt.Stringer.
+func (eh *EndpointHealth) String() string {
+	var builder strings.Builder
+	builder.WriteString("EndpointHealth(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", eh.ID))
+	builder.WriteString("last_success=")
+	builder.WriteString(fmt.Sprintf("%v", eh.LastSuccess))
+	builder.WriteString(", ")
+	builder.WriteString("last_failure=")
+	builder.WriteString(fmt.Sprintf("%v", eh.LastFailure))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(eh.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("latency=")
+	builder.WriteString(fmt.Sprintf("%v", eh.Latency))
+	builder.WriteString(", ")
+	builder.WriteString("failure_count=")
+	builder.WriteString(fmt.Sprintf("%v", eh.FailureCount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EndpointHealths is a parsable slice of EndpointHealth.
+type EndpointHealths []*EndpointHealth
@@ -0,0 +1,79 @@
+// Code generated by ent, DO NOT EDIT.
+
+package endpointhealth
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the endpointhealth type in the database.
+	Label = "endpoint_health"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLastSuccess holds the string denoting the last_success field in the database.
+	FieldLastSuccess = "last_success"
+	// FieldLastFailure holds the string denoting the last_failure field in the database.
+	FieldLastFailure = "last_failure"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldLatency holds the string denoting the latency field in the database.
+	FieldLatency = "latency"
+	// FieldFailureCount holds the string denoting the failure_count field in the database.
+	FieldFailureCount = "failure_count"
+	// Table holds the table name of the endpointhealth in the database.
+	Table = "endpoint_healths"
+)
+
+// Columns holds all SQL columns for endpointhealth fields.
+var Columns = []string{
+	FieldID,
+	FieldLastSuccess,
+	FieldLastFailure,
+	FieldLastError,
+	FieldLatency,
+	FieldFailureCount,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the EndpointHealth queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLastSuccess orders the results by the last_success field.
+func ByLastSuccess(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSuccess, opts...).ToFunc()
+}
+
+// ByLastFailure orders the results by the last_failure field.
+func ByLastFailure(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastFailure, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByLatency orders the results by the latency field.
+func ByLatency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatency, opts...).ToFunc()
+}
+
+// ByFailureCount orders the results by the failure_count field.
+func ByFailureCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureCount, opts...).ToFunc()
+}
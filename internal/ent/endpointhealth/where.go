@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package endpointhealth
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldContainsFold(FieldID, id))
+}
+
+// LastSuccess applies equality check predicate on the "last_success" field. It's identical to LastSuccessEQ.
+func LastSuccess(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEQ(FieldLastSuccess, v))
+}
+
+// LastFailure applies equality check predicate on the "last_failure" field. It's identical to LastFailureEQ.
+func LastFailure(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEQ(FieldLastFailure, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEQ(FieldLastError, v))
+}
+
+// Latency applies equality check predicate on the "latency" field. It's identical to LatencyEQ.
+func Latency(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEQ(FieldLatency, v))
+}
+
+// FailureCount applies equality check predicate on the "failure_count" field. It's identical to FailureCountEQ.
+func FailureCount(v int32) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEQ(FieldFailureCount, v))
+}
+
+// LastSuccessEQ applies the EQ predicate on the "last_success" field.
+func LastSuccessEQ(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEQ(FieldLastSuccess, v))
+}
+
+// LastSuccessNEQ applies the NEQ predicate on the "last_success" field.
+func LastSuccessNEQ(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNEQ(FieldLastSuccess, v))
+}
+
+// LastSuccessIn applies the In predicate on the "last_success" field.
+func LastSuccessIn(vs ...int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldIn(FieldLastSuccess, vs...))
+}
+
+// LastSuccessNotIn applies the NotIn predicate on the "last_success" field.
+func LastSuccessNotIn(vs ...int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNotIn(FieldLastSuccess, vs...))
+}
+
+// LastSuccessGT applies the GT predicate on the "last_success" field.
+func LastSuccessGT(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldGT(FieldLastSuccess, v))
+}
+
+// LastSuccessGTE applies the GTE predicate on the "last_success" field.
+func LastSuccessGTE(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldGTE(FieldLastSuccess, v))
+}
+
+// LastSuccessLT applies the LT predicate on the "last_success" field.
+func LastSuccessLT(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldLT(FieldLastSuccess, v))
+}
+
+// LastSuccessLTE applies the LTE predicate on the "last_success" field.
+func LastSuccessLTE(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldLTE(FieldLastSuccess, v))
+}
+
+// LastSuccessIsNil applies the IsNil predicate on the "last_success" field.
+func LastSuccessIsNil() predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldIsNull(FieldLastSuccess))
+}
+
+// LastSuccessNotNil applies the NotNil predicate on the "last_success" field.
+func LastSuccessNotNil() predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNotNull(FieldLastSuccess))
+}
+
+// LastFailureEQ applies the EQ predicate on the "last_failure" field.
+func LastFailureEQ(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEQ(FieldLastFailure, v))
+}
+
+// LastFailureNEQ applies the NEQ predicate on the "last_failure" field.
+func LastFailureNEQ(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNEQ(FieldLastFailure, v))
+}
+
+// LastFailureIn applies the In predicate on the "last_failure" field.
+func LastFailureIn(vs ...int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldIn(FieldLastFailure, vs...))
+}
+
+// LastFailureNotIn applies the NotIn predicate on the "last_failure" field.
+func LastFailureNotIn(vs ...int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNotIn(FieldLastFailure, vs...))
+}
+
+// LastFailureGT applies the GT predicate on the "last_failure" field.
+func LastFailureGT(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldGT(FieldLastFailure, v))
+}
+
+// LastFailureGTE applies the GTE predicate on the "last_failure" field.
+func LastFailureGTE(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldGTE(FieldLastFailure, v))
+}
+
+// LastFailureLT applies the LT predicate on the "last_failure" field.
+func LastFailureLT(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldLT(FieldLastFailure, v))
+}
+
+// LastFailureLTE applies the LTE predicate on the "last_failure" field.
+func LastFailureLTE(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldLTE(FieldLastFailure, v))
+}
+
+// LastFailureIsNil applies the IsNil predicate on the "last_failure" field.
+func LastFailureIsNil() predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldIsNull(FieldLastFailure))
+}
+
+// LastFailureNotNil applies the NotNil predicate on the "last_failure" field.
+func LastFailureNotNil() predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNotNull(FieldLastFailure))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// LatencyEQ applies the EQ predicate on the "latency" field.
+func LatencyEQ(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEQ(FieldLatency, v))
+}
+
+// LatencyNEQ applies the NEQ predicate on the "latency" field.
+func LatencyNEQ(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNEQ(FieldLatency, v))
+}
+
+// LatencyIn applies the In predicate on the "latency" field.
+func LatencyIn(vs ...int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldIn(FieldLatency, vs...))
+}
+
+// LatencyNotIn applies the NotIn predicate on the "latency" field.
+func LatencyNotIn(vs ...int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNotIn(FieldLatency, vs...))
+}
+
+// LatencyGT applies the GT predicate on the "latency" field.
+func LatencyGT(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldGT(FieldLatency, v))
+}
+
+// LatencyGTE applies the GTE predicate on the "latency" field.
+func LatencyGTE(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldGTE(FieldLatency, v))
+}
+
+// LatencyLT applies the LT predicate on the "latency" field.
+func LatencyLT(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldLT(FieldLatency, v))
+}
+
+// LatencyLTE applies the LTE predicate on the "latency" field.
+func LatencyLTE(v int64) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldLTE(FieldLatency, v))
+}
+
+// LatencyIsNil applies the IsNil predicate on the "latency" field.
+func LatencyIsNil() predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldIsNull(FieldLatency))
+}
+
+// LatencyNotNil applies the NotNil predicate on the "latency" field.
+func LatencyNotNil() predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNotNull(FieldLatency))
+}
+
+// FailureCountEQ applies the EQ predicate on the "failure_count" field.
+func FailureCountEQ(v int32) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldEQ(FieldFailureCount, v))
+}
+
+// FailureCountNEQ applies the NEQ predicate on the "failure_count" field.
+func FailureCountNEQ(v int32) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNEQ(FieldFailureCount, v))
+}
+
+// FailureCountIn applies the In predicate on the "failure_count" field.
+func FailureCountIn(vs ...int32) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldIn(FieldFailureCount, vs...))
+}
+
+// FailureCountNotIn applies the NotIn predicate on the "failure_count" field.
+func FailureCountNotIn(vs ...int32) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNotIn(FieldFailureCount, vs...))
+}
+
+// FailureCountGT applies the GT predicate on the "failure_count" field.
+func FailureCountGT(v int32) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldGT(FieldFailureCount, v))
+}
+
+// FailureCountGTE applies the GTE predicate on the "failure_count" field.
+func FailureCountGTE(v int32) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldGTE(FieldFailureCount, v))
+}
+
+// FailureCountLT applies the LT predicate on the "failure_count" field.
+func FailureCountLT(v int32) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldLT(FieldFailureCount, v))
+}
+
+// FailureCountLTE applies the LTE predicate on the "failure_count" field.
+func FailureCountLTE(v int32) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldLTE(FieldFailureCount, v))
+}
+
+// FailureCountIsNil applies the IsNil predicate on the "failure_count" field.
+func FailureCountIsNil() predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldIsNull(FieldFailureCount))
+}
+
+// FailureCountNotNil applies the NotNil predicate on the "failure_count" field.
+func FailureCountNotNil() predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.FieldNotNull(FieldFailureCount))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EndpointHealth) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EndpointHealth) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EndpointHealth) predicate.EndpointHealth {
+	return predicate.EndpointHealth(sql.NotPredicates(p))
+}
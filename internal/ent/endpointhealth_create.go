@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
+)
+
+// EndpointHealthCreate is the builder for creating a EndpointHealth entity.
+type EndpointHealthCreate struct {
+	config
+	mutation *EndpointHealthMutation
+	hooks    []Hook
+}
+
+// SetLastSuccess sets the "last_success" field.
+func (ehc *EndpointHealthCreate) SetLastSuccess(i int64) *EndpointHealthCreate {
+	ehc.mutation.SetLastSuccess(i)
+	return ehc
+}
+
+// SetNillableLastSuccess sets the "last_success" field if the given value is not nil.
+func (ehc *EndpointHealthCreate) SetNillableLastSuccess(i *int64) *EndpointHealthCreate {
+	if i != nil {
+		ehc.SetLastSuccess(*i)
+	}
+	return ehc
+}
+
+// SetLastFailure sets the "last_failure" field.
+func (ehc *EndpointHealthCreate) SetLastFailure(i int64) *EndpointHealthCreate {
+	ehc.mutation.SetLastFailure(i)
+	return ehc
+}
+
+// SetNillableLastFailure sets the "last_failure" field if the given value is not nil.
+func (ehc *EndpointHealthCreate) SetNillableLastFailure(i *int64) *EndpointHealthCreate {
+	if i != nil {
+		ehc.SetLastFailure(*i)
+	}
+	return ehc
+}
+
+// SetLastError sets the "last_error" field.
+func (ehc *EndpointHealthCreate) SetLastError(s string) *EndpointHealthCreate {
+	ehc.mutation.SetLastError(s)
+	return ehc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ehc *EndpointHealthCreate) SetNillableLastError(s *string) *EndpointHealthCreate {
+	if s != nil {
+		ehc.SetLastError(*s)
+	}
+	return ehc
+}
+
+// SetLatency sets the "latency" field.
+func (ehc *EndpointHealthCreate) SetLatency(i int64) *EndpointHealthCreate {
+	ehc.mutation.SetLatency(i)
+	return ehc
+}
+
+// SetNillableLatency sets the "latency" field if the given value is not nil.
+func (ehc *EndpointHealthCreate) SetNillableLatency(i *int64) *EndpointHealthCreate {
+	if i != nil {
+		ehc.SetLatency(*i)
+	}
+	return ehc
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (ehc *EndpointHealthCreate) SetFailureCount(i int32) *EndpointHealthCreate {
+	ehc.mutation.SetFailureCount(i)
+	return ehc
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (ehc *EndpointHealthCreate) SetNillableFailureCount(i *int32) *EndpointHealthCreate {
+	if i != nil {
+		ehc.SetFailureCount(*i)
+	}
+	return ehc
+}
+
+// SetID sets the "id" field.
+func (ehc *EndpointHealthCreate) SetID(s string) *EndpointHealthCreate {
+	ehc.mutation.SetID(s)
+	return ehc
+}
+
+// Mutation returns the EndpointHealthMutation object of the builder.
+func (ehc *EndpointHealthCreate) Mutation() *EndpointHealthMutation {
+	return ehc.mutation
+}
+
+// Save creates the EndpointHealth in the database.
+func (ehc *EndpointHealthCreate) Save(ctx context.Context) (*EndpointHealth, error) {
+	return withHooks(ctx, ehc.sqlSave, ehc.mutation, ehc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ehc *EndpointHealthCreate) SaveX(ctx context.Context) *EndpointHealth {
+	v, err := ehc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ehc *EndpointHealthCreate) Exec(ctx context.Context) error {
+	_, err := ehc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehc *EndpointHealthCreate) ExecX(ctx context.Context) {
+	if err := ehc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ehc *EndpointHealthCreate) check() error {
+	return nil
+}
+
+func (ehc *EndpointHealthCreate) sqlSave(ctx context.Context) (*EndpointHealth, error) {
+	if err := ehc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ehc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ehc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EndpointHealth.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ehc.mutation.id = &_node.ID
+	ehc.mutation.done = true
+	return _node, nil
+}
+
+func (ehc *EndpointHealthCreate) createSpec() (*EndpointHealth, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EndpointHealth{config: ehc.config}
+		_spec = sqlgraph.NewCreateSpec(endpointhealth.Table, sqlgraph.NewFieldSpec(endpointhealth.FieldID, field.TypeString))
+	)
+	if id, ok := ehc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ehc.mutation.LastSuccess(); ok {
+		_spec.SetField(endpointhealth.FieldLastSuccess, field.TypeInt64, value)
+		_node.LastSuccess = value
+	}
+	if value, ok := ehc.mutation.LastFailure(); ok {
+		_spec.SetField(endpointhealth.FieldLastFailure, field.TypeInt64, value)
+		_node.LastFailure = value
+	}
+	if value, ok := ehc.mutation.LastError(); ok {
+		_spec.SetField(endpointhealth.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := ehc.mutation.Latency(); ok {
+		_spec.SetField(endpointhealth.FieldLatency, field.TypeInt64, value)
+		_node.Latency = value
+	}
+	if value, ok := ehc.mutation.FailureCount(); ok {
+		_spec.SetField(endpointhealth.FieldFailureCount, field.TypeInt32, value)
+		_node.FailureCount = value
+	}
+	return _node, _spec
+}
+
+// EndpointHealthCreateBulk is the builder for creating many EndpointHealth entities in bulk.
+type EndpointHealthCreateBulk struct {
+	config
+	err      error
+	builders []*EndpointHealthCreate
+}
+
+// Save creates the EndpointHealth entities in the database.
+func (ehcb *EndpointHealthCreateBulk) Save(ctx context.Context) ([]*EndpointHealth, error) {
+	if ehcb.err != nil {
+		return nil, ehcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ehcb.builders))
+	nodes := make([]*EndpointHealth, len(ehcb.builders))
+	mutators := make([]Mutator, len(ehcb.builders))
+	for i := range ehcb.builders {
+		func(i int, root context.Context) {
+			builder := ehcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EndpointHealthMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ehcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ehcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ehcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ehcb *EndpointHealthCreateBulk) SaveX(ctx context.Context) []*EndpointHealth {
+	v, err := ehcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ehcb *EndpointHealthCreateBulk) Exec(ctx context.Context) error {
+	_, err := ehcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehcb *EndpointHealthCreateBulk) ExecX(ctx context.Context) {
+	if err := ehcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
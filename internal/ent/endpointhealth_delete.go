@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// EndpointHealthDelete is the builder for deleting a EndpointHealth entity.
+type EndpointHealthDelete struct {
+	config
+	hooks    []Hook
+	mutation *EndpointHealthMutation
+}
+
+// Where appends a list predicates to the EndpointHealthDelete builder.
+func (ehd *EndpointHealthDelete) Where(ps ...predicate.EndpointHealth) *EndpointHealthDelete {
+	ehd.mutation.Where(ps...)
+	return ehd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ehd *EndpointHealthDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ehd.sqlExec, ehd.mutation, ehd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehd *EndpointHealthDelete) ExecX(ctx context.Context) int {
+	n, err := ehd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ehd *EndpointHealthDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(endpointhealth.Table, sqlgraph.NewFieldSpec(endpointhealth.FieldID, field.TypeString))
+	if ps := ehd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ehd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ehd.mutation.done = true
+	return affected, err
+}
+
+// EndpointHealthDeleteOne is the builder for deleting a single EndpointHealth entity.
+type EndpointHealthDeleteOne struct {
+	ehd *EndpointHealthDelete
+}
+
+// Where appends a list predicates to the EndpointHealthDelete builder.
+func (ehdo *EndpointHealthDeleteOne) Where(ps ...predicate.EndpointHealth) *EndpointHealthDeleteOne {
+	ehdo.ehd.mutation.Where(ps...)
+	return ehdo
+}
+
+// Exec executes the deletion query.
+func (ehdo *EndpointHealthDeleteOne) Exec(ctx context.Context) error {
+	n, err := ehdo.ehd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{endpointhealth.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehdo *EndpointHealthDeleteOne) ExecX(ctx context.Context) {
+	if err := ehdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
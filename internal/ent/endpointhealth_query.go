@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// EndpointHealthQuery is the builder for querying EndpointHealth entities.
+type EndpointHealthQuery struct {
+	config
+	ctx        *QueryContext
+	order      []endpointhealth.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EndpointHealth
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EndpointHealthQuery builder.
+func (ehq *EndpointHealthQuery) Where(ps ...predicate.EndpointHealth) *EndpointHealthQuery {
+	ehq.predicates = append(ehq.predicates, ps...)
+	return ehq
+}
+
+// Limit the number of records to be returned by this query.
+func (ehq *EndpointHealthQuery) Limit(limit int) *EndpointHealthQuery {
+	ehq.ctx.Limit = &limit
+	return ehq
+}
+
+// Offset to start from.
+func (ehq *EndpointHealthQuery) Offset(offset int) *EndpointHealthQuery {
+	ehq.ctx.Offset = &offset
+	return ehq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ehq *EndpointHealthQuery) Unique(unique bool) *EndpointHealthQuery {
+	ehq.ctx.Unique = &unique
+	return ehq
+}
+
+// Order specifies how the records should be ordered.
+func (ehq *EndpointHealthQuery) Order(o ...endpointhealth.OrderOption) *EndpointHealthQuery {
+	ehq.order = append(ehq.order, o...)
+	return ehq
+}
+
+// First returns the first EndpointHealth entity from the query.
+// Returns a *NotFoundError when no EndpointHealth was found.
+func (ehq *EndpointHealthQuery) First(ctx context.Context) (*EndpointHealth, error) {
+	nodes, err := ehq.Limit(1).All(setContextOp(ctx, ehq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{endpointhealth.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ehq *EndpointHealthQuery) FirstX(ctx context.Context) *EndpointHealth {
+	node, err := ehq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EndpointHealth ID from the query.
+// Returns a *NotFoundError when no EndpointHealth ID was found.
+func (ehq *EndpointHealthQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ehq.Limit(1).IDs(setContextOp(ctx, ehq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{endpointhealth.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ehq *EndpointHealthQuery) FirstIDX(ctx context.Context) string {
+	id, err := ehq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EndpointHealth entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EndpointHealth entity is found.
+// Returns a *NotFoundError when no EndpointHealth entities are found.
+func (ehq *EndpointHealthQuery) Only(ctx context.Context) (*EndpointHealth, error) {
+	nodes, err := ehq.Limit(2).All(setContextOp(ctx, ehq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{endpointhealth.Label}
+	default:
+		return nil, &NotSingularError{endpointhealth.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ehq *EndpointHealthQuery) OnlyX(ctx context.Context) *EndpointHealth {
+	node, err := ehq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EndpointHealth ID in the query.
+// Returns a *NotSingularError when more than one EndpointHealth ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ehq *EndpointHealthQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ehq.Limit(2).IDs(setContextOp(ctx, ehq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{endpointhealth.Label}
+	default:
+		err = &NotSingularError{endpointhealth.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ehq *EndpointHealthQuery) OnlyIDX(ctx context.Context) string {
+	id, err := ehq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EndpointHealths.
+func (ehq *EndpointHealthQuery) All(ctx context.Context) ([]*EndpointHealth, error) {
+	ctx = setContextOp(ctx, ehq.ctx, ent.OpQueryAll)
+	if err := ehq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EndpointHealth, *EndpointHealthQuery]()
+	return withInterceptors[[]*EndpointHealth](ctx, ehq, qr, ehq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ehq *EndpointHealthQuery) AllX(ctx context.Context) []*EndpointHealth {
+	nodes, err := ehq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EndpointHealth IDs.
+func (ehq *EndpointHealthQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if ehq.ctx.Unique == nil && ehq.path != nil {
+		ehq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ehq.ctx, ent.OpQueryIDs)
+	if err = ehq.Select(endpointhealth.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ehq *EndpointHealthQuery) IDsX(ctx context.Context) []string {
+	ids, err := ehq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ehq *EndpointHealthQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ehq.ctx, ent.OpQueryCount)
+	if err := ehq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ehq, querierCount[*EndpointHealthQuery](), ehq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ehq *EndpointHealthQuery) CountX(ctx context.Context) int {
+	count, err := ehq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ehq *EndpointHealthQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ehq.ctx, ent.OpQueryExist)
+	switch _, err := ehq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ehq *EndpointHealthQuery) ExistX(ctx context.Context) bool {
+	exist, err := ehq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EndpointHealthQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ehq *EndpointHealthQuery) Clone() *EndpointHealthQuery {
+	if ehq == nil {
+		return nil
+	}
+	return &EndpointHealthQuery{
+		config:     ehq.config,
+		ctx:        ehq.ctx.Clone(),
+		order:      append([]endpointhealth.OrderOption{}, ehq.order...),
+		inters:     append([]Interceptor{}, ehq.inters...),
+		predicates: append([]predicate.EndpointHealth{}, ehq.predicates...),
+		// clone intermediate query.
+		sql:  ehq.sql.Clone(),
+		path: ehq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LastSuccess int64 `json:"last_success,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EndpointHealth.Query().
+//		GroupBy(endpointhealth.FieldLastSuccess).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ehq *EndpointHealthQuery) GroupBy(field string, fields ...string) *EndpointHealthGroupBy {
+	ehq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EndpointHealthGroupBy{build: ehq}
+	grbuild.flds = &ehq.ctx.Fields
+	grbuild.label = endpointhealth.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		LastSuccess int64 `json:"last_success,omitempty"`
+//	}
+//
+//	client.EndpointHealth.Query().
+//		Select(endpointhealth.FieldLastSuccess).
+//		Scan(ctx, &v)
+func (ehq *EndpointHealthQuery) Select(fields ...string) *EndpointHealthSelect {
+	ehq.ctx.Fields = append(ehq.ctx.Fields, fields...)
+	sbuild := &EndpointHealthSelect{EndpointHealthQuery: ehq}
+	sbuild.label = endpointhealth.Label
+	sbuild.flds, sbuild.scan = &ehq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EndpointHealthSelect configured with the given aggregations.
+func (ehq *EndpointHealthQuery) Aggregate(fns ...AggregateFunc) *EndpointHealthSelect {
+	return ehq.Select().Aggregate(fns...)
+}
+
+func (ehq *EndpointHealthQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ehq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ehq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ehq.ctx.Fields {
+		if !endpointhealth.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ehq.path != nil {
+		prev, err := ehq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ehq.sql = prev
+	}
+	return nil
+}
+
+func (ehq *EndpointHealthQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EndpointHealth, error) {
+	var (
+		nodes = []*EndpointHealth{}
+		_spec = ehq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EndpointHealth).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EndpointHealth{config: ehq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ehq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ehq *EndpointHealthQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ehq.querySpec()
+	_spec.Node.Columns = ehq.ctx.Fields
+	if len(ehq.ctx.Fields) > 0 {
+		_spec.Unique = ehq.ctx.Unique != nil && *ehq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ehq.driver, _spec)
+}
+
+func (ehq *EndpointHealthQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(endpointhealth.Table, endpointhealth.Columns, sqlgraph.NewFieldSpec(endpointhealth.FieldID, field.TypeString))
+	_spec.From = ehq.sql
+	if unique := ehq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ehq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ehq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, endpointhealth.FieldID)
+		for i := range fields {
+			if fields[i] != endpointhealth.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ehq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ehq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ehq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ehq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ehq *EndpointHealthQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ehq.driver.Dialect())
+	t1 := builder.Table(endpointhealth.Table)
+	columns := ehq.ctx.Fields
+	if len(columns) == 0 {
+		columns = endpointhealth.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ehq.sql != nil {
+		selector = ehq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ehq.ctx.Unique != nil && *ehq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ehq.predicates {
+		p(selector)
+	}
+	for _, p := range ehq.order {
+		p(selector)
+	}
+	if offset := ehq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ehq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EndpointHealthGroupBy is the group-by builder for EndpointHealth entities.
+type EndpointHealthGroupBy struct {
+	selector
+	build *EndpointHealthQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ehgb *EndpointHealthGroupBy) Aggregate(fns ...AggregateFunc) *EndpointHealthGroupBy {
+	ehgb.fns = append(ehgb.fns, fns...)
+	return ehgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ehgb *EndpointHealthGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ehgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ehgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EndpointHealthQuery, *EndpointHealthGroupBy](ctx, ehgb.build, ehgb, ehgb.build.inters, v)
+}
+
+func (ehgb *EndpointHealthGroupBy) sqlScan(ctx context.Context, root *EndpointHealthQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ehgb.fns))
+	for _, fn := range ehgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ehgb.flds)+len(ehgb.fns))
+		for _, f := range *ehgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ehgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ehgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EndpointHealthSelect is the builder for selecting fields of EndpointHealth entities.
+type EndpointHealthSelect struct {
+	*EndpointHealthQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ehs *EndpointHealthSelect) Aggregate(fns ...AggregateFunc) *EndpointHealthSelect {
+	ehs.fns = append(ehs.fns, fns...)
+	return ehs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ehs *EndpointHealthSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ehs.ctx, ent.OpQuerySelect)
+	if err := ehs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EndpointHealthQuery, *EndpointHealthSelect](ctx, ehs.EndpointHealthQuery, ehs, ehs.inters, v)
+}
+
+func (ehs *EndpointHealthSelect) sqlScan(ctx context.Context, root *EndpointHealthQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ehs.fns))
+	for _, fn := range ehs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ehs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ehs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// EndpointHealthUpdate is the builder for updating EndpointHealth entities.
+type EndpointHealthUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EndpointHealthMutation
+}
+
+// Where appends a list predicates to the EndpointHealthUpdate builder.
+func (ehu *EndpointHealthUpdate) Where(ps ...predicate.EndpointHealth) *EndpointHealthUpdate {
+	ehu.mutation.Where(ps...)
+	return ehu
+}
+
+// SetLastSuccess sets the "last_success" field.
+func (ehu *EndpointHealthUpdate) SetLastSuccess(i int64) *EndpointHealthUpdate {
+	ehu.mutation.ResetLastSuccess()
+	ehu.mutation.SetLastSuccess(i)
+	return ehu
+}
+
+// SetNillableLastSuccess sets the "last_success" field if the given value is not nil.
+func (ehu *EndpointHealthUpdate) SetNillableLastSuccess(i *int64) *EndpointHealthUpdate {
+	if i != nil {
+		ehu.SetLastSuccess(*i)
+	}
+	return ehu
+}
+
+// AddLastSuccess adds i to the "last_success" field.
+func (ehu *EndpointHealthUpdate) AddLastSuccess(i int64) *EndpointHealthUpdate {
+	ehu.mutation.AddLastSuccess(i)
+	return ehu
+}
+
+// ClearLastSuccess clears the value of the "last_success" field.
+func (ehu *EndpointHealthUpdate) ClearLastSuccess() *EndpointHealthUpdate {
+	ehu.mutation.ClearLastSuccess()
+	return ehu
+}
+
+// SetLastFailure sets the "last_failure" field.
+func (ehu *EndpointHealthUpdate) SetLastFailure(i int64) *EndpointHealthUpdate {
+	ehu.mutation.ResetLastFailure()
+	ehu.mutation.SetLastFailure(i)
+	return ehu
+}
+
+// SetNillableLastFailure sets the "last_failure" field if the given value is not nil.
+func (ehu *EndpointHealthUpdate) SetNillableLastFailure(i *int64) *EndpointHealthUpdate {
+	if i != nil {
+		ehu.SetLastFailure(*i)
+	}
+	return ehu
+}
+
+// AddLastFailure adds i to the "last_failure" field.
+func (ehu *EndpointHealthUpdate) AddLastFailure(i int64) *EndpointHealthUpdate {
+	ehu.mutation.AddLastFailure(i)
+	return ehu
+}
+
+// ClearLastFailure clears the value of the "last_failure" field.
+func (ehu *EndpointHealthUpdate) ClearLastFailure() *EndpointHealthUpdate {
+	ehu.mutation.ClearLastFailure()
+	return ehu
+}
+
+// SetLastError sets the "last_error" field.
+func (ehu *EndpointHealthUpdate) SetLastError(s string) *EndpointHealthUpdate {
+	ehu.mutation.SetLastError(s)
+	return ehu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ehu *EndpointHealthUpdate) SetNillableLastError(s *string) *EndpointHealthUpdate {
+	if s != nil {
+		ehu.SetLastError(*s)
+	}
+	return ehu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (ehu *EndpointHealthUpdate) ClearLastError() *EndpointHealthUpdate {
+	ehu.mutation.ClearLastError()
+	return ehu
+}
+
+// SetLatency sets the "latency" field.
+func (ehu *EndpointHealthUpdate) SetLatency(i int64) *EndpointHealthUpdate {
+	ehu.mutation.ResetLatency()
+	ehu.mutation.SetLatency(i)
+	return ehu
+}
+
+// SetNillableLatency sets the "latency" field if the given value is not nil.
+func (ehu *EndpointHealthUpdate) SetNillableLatency(i *int64) *EndpointHealthUpdate {
+	if i != nil {
+		ehu.SetLatency(*i)
+	}
+	return ehu
+}
+
+// AddLatency adds i to the "latency" field.
+func (ehu *EndpointHealthUpdate) AddLatency(i int64) *EndpointHealthUpdate {
+	ehu.mutation.AddLatency(i)
+	return ehu
+}
+
+// ClearLatency clears the value of the "latency" field.
+func (ehu *EndpointHealthUpdate) ClearLatency() *EndpointHealthUpdate {
+	ehu.mutation.ClearLatency()
+	return ehu
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (ehu *EndpointHealthUpdate) SetFailureCount(i int32) *EndpointHealthUpdate {
+	ehu.mutation.ResetFailureCount()
+	ehu.mutation.SetFailureCount(i)
+	return ehu
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (ehu *EndpointHealthUpdate) SetNillableFailureCount(i *int32) *EndpointHealthUpdate {
+	if i != nil {
+		ehu.SetFailureCount(*i)
+	}
+	return ehu
+}
+
+// AddFailureCount adds i to the "failure_count" field.
+func (ehu *EndpointHealthUpdate) AddFailureCount(i int32) *EndpointHealthUpdate {
+	ehu.mutation.AddFailureCount(i)
+	return ehu
+}
+
+// ClearFailureCount clears the value of the "failure_count" field.
+func (ehu *EndpointHealthUpdate) ClearFailureCount() *EndpointHealthUpdate {
+	ehu.mutation.ClearFailureCount()
+	return ehu
+}
+
+// Mutation returns the EndpointHealthMutation object of the builder.
+func (ehu *EndpointHealthUpdate) Mutation() *EndpointHealthMutation {
+	return ehu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ehu *EndpointHealthUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ehu.sqlSave, ehu.mutation, ehu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ehu *EndpointHealthUpdate) SaveX(ctx context.Context) int {
+	affected, err := ehu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ehu *EndpointHealthUpdate) Exec(ctx context.Context) error {
+	_, err := ehu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehu *EndpointHealthUpdate) ExecX(ctx context.Context) {
+	if err := ehu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ehu *EndpointHealthUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(endpointhealth.Table, endpointhealth.Columns, sqlgraph.NewFieldSpec(endpointhealth.FieldID, field.TypeString))
+	if ps := ehu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ehu.mutation.LastSuccess(); ok {
+		_spec.SetField(endpointhealth.FieldLastSuccess, field.TypeInt64, value)
+	}
+	if value, ok := ehu.mutation.AddedLastSuccess(); ok {
+		_spec.AddField(endpointhealth.FieldLastSuccess, field.TypeInt64, value)
+	}
+	if ehu.mutation.LastSuccessCleared() {
+		_spec.ClearField(endpointhealth.FieldLastSuccess, field.TypeInt64)
+	}
+	if value, ok := ehu.mutation.LastFailure(); ok {
+		_spec.SetField(endpointhealth.FieldLastFailure, field.TypeInt64, value)
+	}
+	if value, ok := ehu.mutation.AddedLastFailure(); ok {
+		_spec.AddField(endpointhealth.FieldLastFailure, field.TypeInt64, value)
+	}
+	if ehu.mutation.LastFailureCleared() {
+		_spec.ClearField(endpointhealth.FieldLastFailure, field.TypeInt64)
+	}
+	if value, ok := ehu.mutation.LastError(); ok {
+		_spec.SetField(endpointhealth.FieldLastError, field.TypeString, value)
+	}
+	if ehu.mutation.LastErrorCleared() {
+		_spec.ClearField(endpointhealth.FieldLastError, field.TypeString)
+	}
+	if value, ok := ehu.mutation.Latency(); ok {
+		_spec.SetField(endpointhealth.FieldLatency, field.TypeInt64, value)
+	}
+	if value, ok := ehu.mutation.AddedLatency(); ok {
+		_spec.AddField(endpointhealth.FieldLatency, field.TypeInt64, value)
+	}
+	if ehu.mutation.LatencyCleared() {
+		_spec.ClearField(endpointhealth.FieldLatency, field.TypeInt64)
+	}
+	if value, ok := ehu.mutation.FailureCount(); ok {
+		_spec.SetField(endpointhealth.FieldFailureCount, field.TypeInt32, value)
+	}
+	if value, ok := ehu.mutation.AddedFailureCount(); ok {
+		_spec.AddField(endpointhealth.FieldFailureCount, field.TypeInt32, value)
+	}
+	if ehu.mutation.FailureCountCleared() {
+		_spec.ClearField(endpointhealth.FieldFailureCount, field.TypeInt32)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ehu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{endpointhealth.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ehu.mutation.done = true
+	return n, nil
+}
+
+// EndpointHealthUpdateOne is the builder for updating a single EndpointHealth entity.
+type EndpointHealthUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EndpointHealthMutation
+}
+
+// SetLastSuccess sets the "last_success" field.
+func (ehuo *EndpointHealthUpdateOne) SetLastSuccess(i int64) *EndpointHealthUpdateOne {
+	ehuo.mutation.ResetLastSuccess()
+	ehuo.mutation.SetLastSuccess(i)
+	return ehuo
+}
+
+// SetNillableLastSuccess sets the "last_success" field if the given value is not nil.
+func (ehuo *EndpointHealthUpdateOne) SetNillableLastSuccess(i *int64) *EndpointHealthUpdateOne {
+	if i != nil {
+		ehuo.SetLastSuccess(*i)
+	}
+	return ehuo
+}
+
+// AddLastSuccess adds i to the "last_success" field.
+func (ehuo *EndpointHealthUpdateOne) AddLastSuccess(i int64) *EndpointHealthUpdateOne {
+	ehuo.mutation.AddLastSuccess(i)
+	return ehuo
+}
+
+// ClearLastSuccess clears the value of the "last_success" field.
+func (ehuo *EndpointHealthUpdateOne) ClearLastSuccess() *EndpointHealthUpdateOne {
+	ehuo.mutation.ClearLastSuccess()
+	return ehuo
+}
+
+// SetLastFailure sets the "last_failure" field.
+func (ehuo *EndpointHealthUpdateOne) SetLastFailure(i int64) *EndpointHealthUpdateOne {
+	ehuo.mutation.ResetLastFailure()
+	ehuo.mutation.SetLastFailure(i)
+	return ehuo
+}
+
+// SetNillableLastFailure sets the "last_failure" field if the given value is not nil.
+func (ehuo *EndpointHealthUpdateOne) SetNillableLastFailure(i *int64) *EndpointHealthUpdateOne {
+	if i != nil {
+		ehuo.SetLastFailure(*i)
+	}
+	return ehuo
+}
+
+// AddLastFailure adds i to the "last_failure" field.
+func (ehuo *EndpointHealthUpdateOne) AddLastFailure(i int64) *EndpointHealthUpdateOne {
+	ehuo.mutation.AddLastFailure(i)
+	return ehuo
+}
+
+// ClearLastFailure clears the value of the "last_failure" field.
+func (ehuo *EndpointHealthUpdateOne) ClearLastFailure() *EndpointHealthUpdateOne {
+	ehuo.mutation.ClearLastFailure()
+	return ehuo
+}
+
+// SetLastError sets the "last_error" field.
+func (ehuo *EndpointHealthUpdateOne) SetLastError(s string) *EndpointHealthUpdateOne {
+	ehuo.mutation.SetLastError(s)
+	return ehuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ehuo *EndpointHealthUpdateOne) SetNillableLastError(s *string) *EndpointHealthUpdateOne {
+	if s != nil {
+		ehuo.SetLastError(*s)
+	}
+	return ehuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (ehuo *EndpointHealthUpdateOne) ClearLastError() *EndpointHealthUpdateOne {
+	ehuo.mutation.ClearLastError()
+	return ehuo
+}
+
+// SetLatency sets the "latency" field.
+func (ehuo *EndpointHealthUpdateOne) SetLatency(i int64) *EndpointHealthUpdateOne {
+	ehuo.mutation.ResetLatency()
+	ehuo.mutation.SetLatency(i)
+	return ehuo
+}
+
+// SetNillableLatency sets the "latency" field if the given value is not nil.
+func (ehuo *EndpointHealthUpdateOne) SetNillableLatency(i *int64) *EndpointHealthUpdateOne {
+	if i != nil {
+		ehuo.SetLatency(*i)
+	}
+	return ehuo
+}
+
+// AddLatency adds i to the "latency" field.
+func (ehuo *EndpointHealthUpdateOne) AddLatency(i int64) *EndpointHealthUpdateOne {
+	ehuo.mutation.AddLatency(i)
+	return ehuo
+}
+
+// ClearLatency clears the value of the "latency" field.
+func (ehuo *EndpointHealthUpdateOne) ClearLatency() *EndpointHealthUpdateOne {
+	ehuo.mutation.ClearLatency()
+	return ehuo
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (ehuo *EndpointHealthUpdateOne) SetFailureCount(i int32) *EndpointHealthUpdateOne {
+	ehuo.mutation.ResetFailureCount()
+	ehuo.mutation.SetFailureCount(i)
+	return ehuo
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (ehuo *EndpointHealthUpdateOne) SetNillableFailureCount(i *int32) *EndpointHealthUpdateOne {
+	if i != nil {
+		ehuo.SetFailureCount(*i)
+	}
+	return ehuo
+}
+
+// AddFailureCount adds i to the "failure_count" field.
+func (ehuo *EndpointHealthUpdateOne) AddFailureCount(i int32) *EndpointHealthUpdateOne {
+	ehuo.mutation.AddFailureCount(i)
+	return ehuo
+}
+
+// ClearFailureCount clears the value of the "failure_count" field.
+func (ehuo *EndpointHealthUpdateOne) ClearFailureCount() *EndpointHealthUpdateOne {
+	ehuo.mutation.ClearFailureCount()
+	return ehuo
+}
+
+// Mutation returns the EndpointHealthMutation object of the builder.
+func (ehuo *EndpointHealthUpdateOne) Mutation() *EndpointHealthMutation {
+	return ehuo.mutation
+}
+
+// Where appends a list predicates to the EndpointHealthUpdate builder.
+func (ehuo *EndpointHealthUpdateOne) Where(ps ...predicate.EndpointHealth) *EndpointHealthUpdateOne {
+	ehuo.mutation.Where(ps...)
+	return ehuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ehuo *EndpointHealthUpdateOne) Select(field string, fields ...string) *EndpointHealthUpdateOne {
+	ehuo.fields = append([]string{field}, fields...)
+	return ehuo
+}
+
+// Save executes the query and returns the updated EndpointHealth entity.
+func (ehuo *EndpointHealthUpdateOne) Save(ctx context.Context) (*EndpointHealth, error) {
+	return withHooks(ctx, ehuo.sqlSave, ehuo.mutation, ehuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ehuo *EndpointHealthUpdateOne) SaveX(ctx context.Context) *EndpointHealth {
+	node, err := ehuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ehuo *EndpointHealthUpdateOne) Exec(ctx context.Context) error {
+	_, err := ehuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehuo *EndpointHealthUpdateOne) ExecX(ctx context.Context) {
+	if err := ehuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ehuo *EndpointHealthUpdateOne) sqlSave(ctx context.Context) (_node *EndpointHealth, err error) {
+	_spec := sqlgraph.NewUpdateSpec(endpointhealth.Table, endpointhealth.Columns, sqlgraph.NewFieldSpec(endpointhealth.FieldID, field.TypeString))
+	id, ok := ehuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EndpointHealth.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ehuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, endpointhealth.FieldID)
+		for _, f := range fields {
+			if !endpointhealth.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != endpointhealth.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ehuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ehuo.mutation.LastSuccess(); ok {
+		_spec.SetField(endpointhealth.FieldLastSuccess, field.TypeInt64, value)
+	}
+	if value, ok := ehuo.mutation.AddedLastSuccess(); ok {
+		_spec.AddField(endpointhealth.FieldLastSuccess, field.TypeInt64, value)
+	}
+	if ehuo.mutation.LastSuccessCleared() {
+		_spec.ClearField(endpointhealth.FieldLastSuccess, field.TypeInt64)
+	}
+	if value, ok := ehuo.mutation.LastFailure(); ok {
+		_spec.SetField(endpointhealth.FieldLastFailure, field.TypeInt64, value)
+	}
+	if value, ok := ehuo.mutation.AddedLastFailure(); ok {
+		_spec.AddField(endpointhealth.FieldLastFailure, field.TypeInt64, value)
+	}
+	if ehuo.mutation.LastFailureCleared() {
+		_spec.ClearField(endpointhealth.FieldLastFailure, field.TypeInt64)
+	}
+	if value, ok := ehuo.mutation.LastError(); ok {
+		_spec.SetField(endpointhealth.FieldLastError, field.TypeString, value)
+	}
+	if ehuo.mutation.LastErrorCleared() {
+		_spec.ClearField(endpointhealth.FieldLastError, field.TypeString)
+	}
+	if value, ok := ehuo.mutation.Latency(); ok {
+		_spec.SetField(endpointhealth.FieldLatency, field.TypeInt64, value)
+	}
+	if value, ok := ehuo.mutation.AddedLatency(); ok {
+		_spec.AddField(endpointhealth.FieldLatency, field.TypeInt64, value)
+	}
+	if ehuo.mutation.LatencyCleared() {
+		_spec.ClearField(endpointhealth.FieldLatency, field.TypeInt64)
+	}
+	if value, ok := ehuo.mutation.FailureCount(); ok {
+		_spec.SetField(endpointhealth.FieldFailureCount, field.TypeInt32, value)
+	}
+	if value, ok := ehuo.mutation.AddedFailureCount(); ok {
+		_spec.AddField(endpointhealth.FieldFailureCount, field.TypeInt32, value)
+	}
+	if ehuo.mutation.FailureCountCleared() {
+		_spec.ClearField(endpointhealth.FieldFailureCount, field.TypeInt32)
+	}
+	_node = &EndpointHealth{config: ehuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ehuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{endpointhealth.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ehuo.mutation.done = true
+	return _node, nil
+}
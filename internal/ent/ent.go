@@ -12,9 +12,17 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
+	"github.com/eroshiva/trade-show-poc/internal/ent/latencysample"
+	"github.com/eroshiva/trade-show-poc/internal/ent/lease"
+	"github.com/eroshiva/trade-show-poc/internal/ent/maintenancewindow"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
+	"github.com/eroshiva/trade-show-poc/internal/ent/replicaheartbeat"
+	"github.com/eroshiva/trade-show-poc/internal/ent/statusevent"
 	"github.com/eroshiva/trade-show-poc/internal/ent/version"
 )
 
@@ -76,10 +84,18 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			devicestatus.Table:  devicestatus.ValidColumn,
-			endpoint.Table:      endpoint.ValidColumn,
-			networkdevice.Table: networkdevice.ValidColumn,
-			version.Table:       version.ValidColumn,
+			credentialprofile.Table: credentialprofile.ValidColumn,
+			devicestatus.Table:      devicestatus.ValidColumn,
+			endpoint.Table:          endpoint.ValidColumn,
+			endpointhealth.Table:    endpointhealth.ValidColumn,
+			latencysample.Table:     latencysample.ValidColumn,
+			lease.Table:             lease.ValidColumn,
+			maintenancewindow.Table: maintenancewindow.ValidColumn,
+			networkdevice.Table:     networkdevice.ValidColumn,
+			pollingdefault.Table:    pollingdefault.ValidColumn,
+			replicaheartbeat.Table:  replicaheartbeat.ValidColumn,
+			statusevent.Table:       statusevent.ValidColumn,
+			version.Table:           version.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,48 @@
+//go:build ignore
+
+package main
+
+import (
+	"log"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+)
+
+// cascadeEdges lists edges (per schema), which reference the network device (or its endpoint), and which are removed
+// together with it. Schema is generated out of Protobuf, which can't carry the referential action, hence it is set here.
+var cascadeEdges = map[string][]string{
+	"StatusEvent":   {"network_device"},
+	"LatencySample": {"network_device", "endpoint"},
+}
+
+func main() {
+	err := entc.Generate("./schema", &gen.Config{
+		Features: []gen.Feature{gen.FeatureVersionedMigration, gen.FeatureExecQuery, gen.FeatureIntercept},
+		Hooks:    []gen.Hook{cascadeOnDelete},
+	})
+	if err != nil {
+		log.Fatalf("running ent codegen: %v", err)
+	}
+}
+
+// cascadeOnDelete sets ON DELETE CASCADE on the foreign keys of the edges listed in cascadeEdges.
+func cascadeOnDelete(next gen.Generator) gen.Generator {
+	return gen.GenerateFunc(func(g *gen.Graph) error {
+		for _, n := range g.Nodes {
+			for _, name := range cascadeEdges[n.Name] {
+				for _, e := range n.Edges {
+					if e.Name != name {
+						continue
+					}
+					if e.Annotations == nil {
+						e.Annotations = gen.Annotations{}
+					}
+					e.Annotations[entsql.Annotation{}.Name()] = entsql.Annotation{OnDelete: entsql.Cascade}
+				}
+			}
+		}
+		return next.Generate(g)
+	})
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/eroshiva/trade-show-poc/internal/ent"
 )
 
+// The CredentialProfileFunc type is an adapter to allow the use of ordinary
+// function as CredentialProfile mutator.
+type CredentialProfileFunc func(context.Context, *ent.CredentialProfileMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CredentialProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CredentialProfileMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CredentialProfileMutation", m)
+}
+
 // The DeviceStatusFunc type is an adapter to allow the use of ordinary
 // function as DeviceStatus mutator.
 type DeviceStatusFunc func(context.Context, *ent.DeviceStatusMutation) (ent.Value, error)
@@ -33,6 +45,54 @@ func (f EndpointFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EndpointMutation", m)
 }
 
+// The EndpointHealthFunc type is an adapter to allow the use of ordinary
+// function as EndpointHealth mutator.
+type EndpointHealthFunc func(context.Context, *ent.EndpointHealthMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EndpointHealthFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EndpointHealthMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EndpointHealthMutation", m)
+}
+
+// The LatencySampleFunc type is an adapter to allow the use of ordinary
+// function as LatencySample mutator.
+type LatencySampleFunc func(context.Context, *ent.LatencySampleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LatencySampleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LatencySampleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LatencySampleMutation", m)
+}
+
+// The LeaseFunc type is an adapter to allow the use of ordinary
+// function as Lease mutator.
+type LeaseFunc func(context.Context, *ent.LeaseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeaseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaseMutation", m)
+}
+
+// The MaintenanceWindowFunc type is an adapter to allow the use of ordinary
+// function as MaintenanceWindow mutator.
+type MaintenanceWindowFunc func(context.Context, *ent.MaintenanceWindowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MaintenanceWindowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MaintenanceWindowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MaintenanceWindowMutation", m)
+}
+
 // The NetworkDeviceFunc type is an adapter to allow the use of ordinary
 // function as NetworkDevice mutator.
 type NetworkDeviceFunc func(context.Context, *ent.NetworkDeviceMutation) (ent.Value, error)
@@ -45,6 +105,42 @@ func (f NetworkDeviceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NetworkDeviceMutation", m)
 }
 
+// The PollingDefaultFunc type is an adapter to allow the use of ordinary
+// function as PollingDefault mutator.
+type PollingDefaultFunc func(context.Context, *ent.PollingDefaultMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PollingDefaultFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PollingDefaultMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PollingDefaultMutation", m)
+}
+
+// The ReplicaHeartbeatFunc type is an adapter to allow the use of ordinary
+// function as ReplicaHeartbeat mutator.
+type ReplicaHeartbeatFunc func(context.Context, *ent.ReplicaHeartbeatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReplicaHeartbeatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReplicaHeartbeatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReplicaHeartbeatMutation", m)
+}
+
+// The StatusEventFunc type is an adapter to allow the use of ordinary
+// function as StatusEvent mutator.
+type StatusEventFunc func(context.Context, *ent.StatusEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StatusEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StatusEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StatusEventMutation", m)
+}
+
 // The VersionFunc type is an adapter to allow the use of ordinary
 // function as Version mutator.
 type VersionFunc func(context.Context, *ent.VersionMutation) (ent.Value, error)
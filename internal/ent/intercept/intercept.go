@@ -8,10 +8,18 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
+	"github.com/eroshiva/trade-show-poc/internal/ent/latencysample"
+	"github.com/eroshiva/trade-show-poc/internal/ent/lease"
+	"github.com/eroshiva/trade-show-poc/internal/ent/maintenancewindow"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
 	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+	"github.com/eroshiva/trade-show-poc/internal/ent/replicaheartbeat"
+	"github.com/eroshiva/trade-show-poc/internal/ent/statusevent"
 	"github.com/eroshiva/trade-show-poc/internal/ent/version"
 )
 
@@ -71,6 +79,33 @@ func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
 	return f(ctx, query)
 }
 
+// The CredentialProfileFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CredentialProfileFunc func(context.Context, *ent.CredentialProfileQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CredentialProfileFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CredentialProfileQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CredentialProfileQuery", q)
+}
+
+// The TraverseCredentialProfile type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCredentialProfile func(context.Context, *ent.CredentialProfileQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCredentialProfile) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCredentialProfile) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CredentialProfileQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CredentialProfileQuery", q)
+}
+
 // The DeviceStatusFunc type is an adapter to allow the use of ordinary function as a Querier.
 type DeviceStatusFunc func(context.Context, *ent.DeviceStatusQuery) (ent.Value, error)
 
@@ -125,6 +160,114 @@ func (f TraverseEndpoint) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.EndpointQuery", q)
 }
 
+// The EndpointHealthFunc type is an adapter to allow the use of ordinary function as a Querier.
+type EndpointHealthFunc func(context.Context, *ent.EndpointHealthQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f EndpointHealthFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.EndpointHealthQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.EndpointHealthQuery", q)
+}
+
+// The TraverseEndpointHealth type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseEndpointHealth func(context.Context, *ent.EndpointHealthQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseEndpointHealth) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseEndpointHealth) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.EndpointHealthQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.EndpointHealthQuery", q)
+}
+
+// The LatencySampleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LatencySampleFunc func(context.Context, *ent.LatencySampleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LatencySampleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LatencySampleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LatencySampleQuery", q)
+}
+
+// The TraverseLatencySample type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLatencySample func(context.Context, *ent.LatencySampleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLatencySample) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLatencySample) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LatencySampleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LatencySampleQuery", q)
+}
+
+// The LeaseFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LeaseFunc func(context.Context, *ent.LeaseQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LeaseFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LeaseQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LeaseQuery", q)
+}
+
+// The TraverseLease type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLease func(context.Context, *ent.LeaseQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLease) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLease) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LeaseQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LeaseQuery", q)
+}
+
+// The MaintenanceWindowFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MaintenanceWindowFunc func(context.Context, *ent.MaintenanceWindowQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MaintenanceWindowFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MaintenanceWindowQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MaintenanceWindowQuery", q)
+}
+
+// The TraverseMaintenanceWindow type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMaintenanceWindow func(context.Context, *ent.MaintenanceWindowQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMaintenanceWindow) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMaintenanceWindow) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MaintenanceWindowQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MaintenanceWindowQuery", q)
+}
+
 // The NetworkDeviceFunc type is an adapter to allow the use of ordinary function as a Querier.
 type NetworkDeviceFunc func(context.Context, *ent.NetworkDeviceQuery) (ent.Value, error)
 
@@ -152,6 +295,87 @@ func (f TraverseNetworkDevice) Traverse(ctx context.Context, q ent.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *ent.NetworkDeviceQuery", q)
 }
 
+// The PollingDefaultFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PollingDefaultFunc func(context.Context, *ent.PollingDefaultQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PollingDefaultFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PollingDefaultQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PollingDefaultQuery", q)
+}
+
+// The TraversePollingDefault type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePollingDefault func(context.Context, *ent.PollingDefaultQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePollingDefault) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePollingDefault) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PollingDefaultQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PollingDefaultQuery", q)
+}
+
+// The ReplicaHeartbeatFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ReplicaHeartbeatFunc func(context.Context, *ent.ReplicaHeartbeatQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ReplicaHeartbeatFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ReplicaHeartbeatQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ReplicaHeartbeatQuery", q)
+}
+
+// The TraverseReplicaHeartbeat type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseReplicaHeartbeat func(context.Context, *ent.ReplicaHeartbeatQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseReplicaHeartbeat) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseReplicaHeartbeat) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReplicaHeartbeatQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ReplicaHeartbeatQuery", q)
+}
+
+// The StatusEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type StatusEventFunc func(context.Context, *ent.StatusEventQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f StatusEventFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.StatusEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.StatusEventQuery", q)
+}
+
+// The TraverseStatusEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseStatusEvent func(context.Context, *ent.StatusEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseStatusEvent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseStatusEvent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.StatusEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.StatusEventQuery", q)
+}
+
 // The VersionFunc type is an adapter to allow the use of ordinary function as a Querier.
 type VersionFunc func(context.Context, *ent.VersionQuery) (ent.Value, error)
 
@@ -182,12 +406,28 @@ func (f TraverseVersion) Traverse(ctx context.Context, q ent.Query) error {
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q ent.Query) (Query, error) {
 	switch q := q.(type) {
+	case *ent.CredentialProfileQuery:
+		return &query[*ent.CredentialProfileQuery, predicate.CredentialProfile, credentialprofile.OrderOption]{typ: ent.TypeCredentialProfile, tq: q}, nil
 	case *ent.DeviceStatusQuery:
 		return &query[*ent.DeviceStatusQuery, predicate.DeviceStatus, devicestatus.OrderOption]{typ: ent.TypeDeviceStatus, tq: q}, nil
 	case *ent.EndpointQuery:
 		return &query[*ent.EndpointQuery, predicate.Endpoint, endpoint.OrderOption]{typ: ent.TypeEndpoint, tq: q}, nil
+	case *ent.EndpointHealthQuery:
+		return &query[*ent.EndpointHealthQuery, predicate.EndpointHealth, endpointhealth.OrderOption]{typ: ent.TypeEndpointHealth, tq: q}, nil
+	case *ent.LatencySampleQuery:
+		return &query[*ent.LatencySampleQuery, predicate.LatencySample, latencysample.OrderOption]{typ: ent.TypeLatencySample, tq: q}, nil
+	case *ent.LeaseQuery:
+		return &query[*ent.LeaseQuery, predicate.Lease, lease.OrderOption]{typ: ent.TypeLease, tq: q}, nil
+	case *ent.MaintenanceWindowQuery:
+		return &query[*ent.MaintenanceWindowQuery, predicate.MaintenanceWindow, maintenancewindow.OrderOption]{typ: ent.TypeMaintenanceWindow, tq: q}, nil
 	case *ent.NetworkDeviceQuery:
 		return &query[*ent.NetworkDeviceQuery, predicate.NetworkDevice, networkdevice.OrderOption]{typ: ent.TypeNetworkDevice, tq: q}, nil
+	case *ent.PollingDefaultQuery:
+		return &query[*ent.PollingDefaultQuery, predicate.PollingDefault, pollingdefault.OrderOption]{typ: ent.TypePollingDefault, tq: q}, nil
+	case *ent.ReplicaHeartbeatQuery:
+		return &query[*ent.ReplicaHeartbeatQuery, predicate.ReplicaHeartbeat, replicaheartbeat.OrderOption]{typ: ent.TypeReplicaHeartbeat, tq: q}, nil
+	case *ent.StatusEventQuery:
+		return &query[*ent.StatusEventQuery, predicate.StatusEvent, statusevent.OrderOption]{typ: ent.TypeStatusEvent, tq: q}, nil
 	case *ent.VersionQuery:
 		return &query[*ent.VersionQuery, predicate.Version, version.OrderOption]{typ: ent.TypeVersion, tq: q}, nil
 	default:
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/latencysample"
+	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+)
+
+// LatencySample is the model entity for the LatencySample schema.
+type LatencySample struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp int64 `json:"timestamp,omitempty"`
+	// Latency holds the value of the "latency" field.
+	Latency int64 `json:"latency,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LatencySampleQuery when eager-loading is set.
+	Edges                         LatencySampleEdges `json:"edges"`
+	latency_sample_network_device *string
+	latency_sample_endpoint       *string
+	selectValues                  sql.SelectValues
+}
+
+// LatencySampleEdges holds the relations/edges for other nodes in the graph.
+type LatencySampleEdges struct {
+	// NetworkDevice holds the value of the network_device edge.
+	NetworkDevice *NetworkDevice `json:"network_device,omitempty"`
+	// Endpoint holds the value of the endpoint edge.
+	Endpoint *Endpoint `json:"endpoint,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// NetworkDeviceOrErr returns the NetworkDevice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LatencySampleEdges) NetworkDeviceOrErr() (*NetworkDevice, error) {
+	if e.NetworkDevice != nil {
+		return e.NetworkDevice, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: networkdevice.Label}
+	}
+	return nil, &NotLoadedError{edge: "network_device"}
+}
+
+// EndpointOrErr returns the Endpoint value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LatencySampleEdges) EndpointOrErr() (*Endpoint, error) {
+	if e.Endpoint != nil {
+		return e.Endpoint, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: endpoint.Label}
+	}
+	return nil, &NotLoadedError{edge: "endpoint"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LatencySample) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case latencysample.FieldTimestamp, latencysample.FieldLatency:
+			values[i] = new(sql.NullInt64)
+		case latencysample.FieldID:
+			values[i] = new(sql.NullString)
+		case latencysample.ForeignKeys[0]: // latency_sample_network_device
+			values[i] = new(sql.NullString)
+		case latencysample.ForeignKeys[1]: // latency_sample_endpoint
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LatencySample fields.
+func (ls *LatencySample) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case latencysample.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ls.ID = value.String
+			}
+		case latencysample.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				ls.Timestamp = value.Int64
+			}
+		case latencysample.FieldLatency:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency", values[i])
+			} else if value.Valid {
+				ls.Latency = value.Int64
+			}
+		case latencysample.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_sample_network_device", values[i])
+			} else if value.Valid {
+				ls.latency_sample_network_device = new(string)
+				*ls.latency_sample_network_device = value.String
+			}
+		case latencysample.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_sample_endpoint", values[i])
+			} else if value.Valid {
+				ls.latency_sample_endpoint = new(string)
+				*ls.latency_sample_endpoint = value.String
+			}
+		default:
+			ls.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LatencySample.
+// This includes values selected through modifiers, order, etc.
+func (ls *LatencySample) Value(name string) (ent.Value, error) {
+	return ls.selectValues.Get(name)
+}
+
+// QueryNetworkDevice queries the "network_device" edge of the LatencySample entity.
+func (ls *LatencySample) QueryNetworkDevice() *NetworkDeviceQuery {
+	return NewLatencySampleClient(ls.config).QueryNetworkDevice(ls)
+}
+
+// QueryEndpoint queries the "endpoint" edge of the LatencySample entity.
+func (ls *LatencySample) QueryEndpoint() *EndpointQuery {
+	return NewLatencySampleClient(ls.config).QueryEndpoint(ls)
+}
+
+// Update returns a builder for updating this LatencySample.
+// Note that you need to call LatencySample.Unwrap() before calling this method if this LatencySample
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ls *LatencySample) Update() *LatencySampleUpdateOne {
+	return NewLatencySampleClient(ls.config).UpdateOne(ls)
+}
+
+// Unwrap unwraps the LatencySample entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ls *LatencySample) Unwrap() *LatencySample {
+	_tx, ok := ls.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LatencySample is not a transactional entity")
+	}
+	ls.config.driver = _tx.drv
+	return ls
+}
+
+// String implements the fmt.Stringer.
+func (ls *LatencySample) String() string {
+	var builder strings.Builder
+	builder.WriteString("LatencySample(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ls.ID))
+	builder.WriteString("timestamp=")
+	builder.WriteString(fmt.Sprintf("%v", ls.Timestamp))
+	builder.WriteString(", ")
+	builder.WriteString("latency=")
+	builder.WriteString(fmt.Sprintf("%v", ls.Latency))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LatencySamples is a parsable slice of LatencySample.
+type LatencySamples []*LatencySample
@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package latencysample
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the latencysample type in the database.
+	Label = "latency_sample"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// FieldLatency holds the string denoting the latency field in the database.
+	FieldLatency = "latency"
+	// EdgeNetworkDevice holds the string denoting the network_device edge name in mutations.
+	EdgeNetworkDevice = "network_device"
+	// EdgeEndpoint holds the string denoting the endpoint edge name in mutations.
+	EdgeEndpoint = "endpoint"
+	// Table holds the table name of the latencysample in the database.
+	Table = "latency_samples"
+	// NetworkDeviceTable is the table that holds the network_device relation/edge.
+	NetworkDeviceTable = "latency_samples"
+	// NetworkDeviceInverseTable is the table name for the NetworkDevice entity.
+	// It exists in this package in order to avoid circular dependency with the "networkdevice" package.
+	NetworkDeviceInverseTable = "network_devices"
+	// NetworkDeviceColumn is the table column denoting the network_device relation/edge.
+	NetworkDeviceColumn = "latency_sample_network_device"
+	// EndpointTable is the table that holds the endpoint relation/edge.
+	EndpointTable = "latency_samples"
+	// EndpointInverseTable is the table name for the Endpoint entity.
+	// It exists in this package in order to avoid circular dependency with the "endpoint" package.
+	EndpointInverseTable = "endpoints"
+	// EndpointColumn is the table column denoting the endpoint relation/edge.
+	EndpointColumn = "latency_sample_endpoint"
+)
+
+// Columns holds all SQL columns for latencysample fields.
+var Columns = []string{
+	FieldID,
+	FieldTimestamp,
+	FieldLatency,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "latency_samples"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"latency_sample_network_device",
+	"latency_sample_endpoint",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the LatencySample queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// ByLatency orders the results by the latency field.
+func ByLatency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatency, opts...).ToFunc()
+}
+
+// ByNetworkDeviceField orders the results by network_device field.
+func ByNetworkDeviceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNetworkDeviceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEndpointField orders the results by endpoint field.
+func ByEndpointField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEndpointStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newNetworkDeviceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NetworkDeviceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, NetworkDeviceTable, NetworkDeviceColumn),
+	)
+}
+func newEndpointStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EndpointInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, EndpointTable, EndpointColumn),
+	)
+}
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package latencysample
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldContainsFold(FieldID, id))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// Latency applies equality check predicate on the "latency" field. It's identical to LatencyEQ.
+func Latency(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldEQ(FieldLatency, v))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// LatencyEQ applies the EQ predicate on the "latency" field.
+func LatencyEQ(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldEQ(FieldLatency, v))
+}
+
+// LatencyNEQ applies the NEQ predicate on the "latency" field.
+func LatencyNEQ(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldNEQ(FieldLatency, v))
+}
+
+// LatencyIn applies the In predicate on the "latency" field.
+func LatencyIn(vs ...int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldIn(FieldLatency, vs...))
+}
+
+// LatencyNotIn applies the NotIn predicate on the "latency" field.
+func LatencyNotIn(vs ...int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldNotIn(FieldLatency, vs...))
+}
+
+// LatencyGT applies the GT predicate on the "latency" field.
+func LatencyGT(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldGT(FieldLatency, v))
+}
+
+// LatencyGTE applies the GTE predicate on the "latency" field.
+func LatencyGTE(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldGTE(FieldLatency, v))
+}
+
+// LatencyLT applies the LT predicate on the "latency" field.
+func LatencyLT(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldLT(FieldLatency, v))
+}
+
+// LatencyLTE applies the LTE predicate on the "latency" field.
+func LatencyLTE(v int64) predicate.LatencySample {
+	return predicate.LatencySample(sql.FieldLTE(FieldLatency, v))
+}
+
+// HasNetworkDevice applies the HasEdge predicate on the "network_device" edge.
+func HasNetworkDevice() predicate.LatencySample {
+	return predicate.LatencySample(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, NetworkDeviceTable, NetworkDeviceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNetworkDeviceWith applies the HasEdge predicate on the "network_device" edge with a given conditions (other predicates).
+func HasNetworkDeviceWith(preds ...predicate.NetworkDevice) predicate.LatencySample {
+	return predicate.LatencySample(func(s *sql.Selector) {
+		step := newNetworkDeviceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEndpoint applies the HasEdge predicate on the "endpoint" edge.
+func HasEndpoint() predicate.LatencySample {
+	return predicate.LatencySample(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, EndpointTable, EndpointColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEndpointWith applies the HasEdge predicate on the "endpoint" edge with a given conditions (other predicates).
+func HasEndpointWith(preds ...predicate.Endpoint) predicate.LatencySample {
+	return predicate.LatencySample(func(s *sql.Selector) {
+		step := newEndpointStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LatencySample) predicate.LatencySample {
+	return predicate.LatencySample(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LatencySample) predicate.LatencySample {
+	return predicate.LatencySample(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LatencySample) predicate.LatencySample {
+	return predicate.LatencySample(sql.NotPredicates(p))
+}
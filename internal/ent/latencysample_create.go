@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/latencysample"
+	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+)
+
+// LatencySampleCreate is the builder for creating a LatencySample entity.
+type LatencySampleCreate struct {
+	config
+	mutation *LatencySampleMutation
+	hooks    []Hook
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (lsc *LatencySampleCreate) SetTimestamp(i int64) *LatencySampleCreate {
+	lsc.mutation.SetTimestamp(i)
+	return lsc
+}
+
+// SetLatency sets the "latency" field.
+func (lsc *LatencySampleCreate) SetLatency(i int64) *LatencySampleCreate {
+	lsc.mutation.SetLatency(i)
+	return lsc
+}
+
+// SetID sets the "id" field.
+func (lsc *LatencySampleCreate) SetID(s string) *LatencySampleCreate {
+	lsc.mutation.SetID(s)
+	return lsc
+}
+
+// SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID.
+func (lsc *LatencySampleCreate) SetNetworkDeviceID(id string) *LatencySampleCreate {
+	lsc.mutation.SetNetworkDeviceID(id)
+	return lsc
+}
+
+// SetNillableNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID if the given value is not nil.
+func (lsc *LatencySampleCreate) SetNillableNetworkDeviceID(id *string) *LatencySampleCreate {
+	if id != nil {
+		lsc = lsc.SetNetworkDeviceID(*id)
+	}
+	return lsc
+}
+
+// SetNetworkDevice sets the "network_device" edge to the NetworkDevice entity.
+func (lsc *LatencySampleCreate) SetNetworkDevice(n *NetworkDevice) *LatencySampleCreate {
+	return lsc.SetNetworkDeviceID(n.ID)
+}
+
+// SetEndpointID sets the "endpoint" edge to the Endpoint entity by ID.
+func (lsc *LatencySampleCreate) SetEndpointID(id string) *LatencySampleCreate {
+	lsc.mutation.SetEndpointID(id)
+	return lsc
+}
+
+// SetNillableEndpointID sets the "endpoint" edge to the Endpoint entity by ID if the given value is not nil.
+func (lsc *LatencySampleCreate) SetNillableEndpointID(id *string) *LatencySampleCreate {
+	if id != nil {
+		lsc = lsc.SetEndpointID(*id)
+	}
+	return lsc
+}
+
+// SetEndpoint sets the "endpoint" edge to the Endpoint entity.
+func (lsc *LatencySampleCreate) SetEndpoint(e *Endpoint) *LatencySampleCreate {
+	return lsc.SetEndpointID(e.ID)
+}
+
+// Mutation returns the LatencySampleMutation object of the builder.
+func (lsc *LatencySampleCreate) Mutation() *LatencySampleMutation {
+	return lsc.mutation
+}
+
+// Save creates the LatencySample in the database.
+func (lsc *LatencySampleCreate) Save(ctx context.Context) (*LatencySample, error) {
+	return withHooks(ctx, lsc.sqlSave, lsc.mutation, lsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lsc *LatencySampleCreate) SaveX(ctx context.Context) *LatencySample {
+	v, err := lsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lsc *LatencySampleCreate) Exec(ctx context.Context) error {
+	_, err := lsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsc *LatencySampleCreate) ExecX(ctx context.Context) {
+	if err := lsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lsc *LatencySampleCreate) check() error {
+	if _, ok := lsc.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "LatencySample.timestamp"`)}
+	}
+	if _, ok := lsc.mutation.Latency(); !ok {
+		return &ValidationError{Name: "latency", err: errors.New(`ent: missing required field "LatencySample.latency"`)}
+	}
+	return nil
+}
+
+func (lsc *LatencySampleCreate) sqlSave(ctx context.Context) (*LatencySample, error) {
+	if err := lsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LatencySample.ID type: %T", _spec.ID.Value)
+		}
+	}
+	lsc.mutation.id = &_node.ID
+	lsc.mutation.done = true
+	return _node, nil
+}
+
+func (lsc *LatencySampleCreate) createSpec() (*LatencySample, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LatencySample{config: lsc.config}
+		_spec = sqlgraph.NewCreateSpec(latencysample.Table, sqlgraph.NewFieldSpec(latencysample.FieldID, field.TypeString))
+	)
+	if id, ok := lsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lsc.mutation.Timestamp(); ok {
+		_spec.SetField(latencysample.FieldTimestamp, field.TypeInt64, value)
+		_node.Timestamp = value
+	}
+	if value, ok := lsc.mutation.Latency(); ok {
+		_spec.SetField(latencysample.FieldLatency, field.TypeInt64, value)
+		_node.Latency = value
+	}
+	if nodes := lsc.mutation.NetworkDeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   latencysample.NetworkDeviceTable,
+			Columns: []string{latencysample.NetworkDeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.latency_sample_network_device = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lsc.mutation.EndpointIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   latencysample.EndpointTable,
+			Columns: []string{latencysample.EndpointColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(endpoint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.latency_sample_endpoint = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LatencySampleCreateBulk is the builder for creating many LatencySample entities in bulk.
+type LatencySampleCreateBulk struct {
+	config
+	err      error
+	builders []*LatencySampleCreate
+}
+
+// Save creates the LatencySample entities in the database.
+func (lscb *LatencySampleCreateBulk) Save(ctx context.Context) ([]*LatencySample, error) {
+	if lscb.err != nil {
+		return nil, lscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lscb.builders))
+	nodes := make([]*LatencySample, len(lscb.builders))
+	mutators := make([]Mutator, len(lscb.builders))
+	for i := range lscb.builders {
+		func(i int, root context.Context) {
+			builder := lscb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LatencySampleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lscb *LatencySampleCreateBulk) SaveX(ctx context.Context) []*LatencySample {
+	v, err := lscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lscb *LatencySampleCreateBulk) Exec(ctx context.Context) error {
+	_, err := lscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lscb *LatencySampleCreateBulk) ExecX(ctx context.Context) {
+	if err := lscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/latencysample"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// LatencySampleDelete is the builder for deleting a LatencySample entity.
+type LatencySampleDelete struct {
+	config
+	hooks    []Hook
+	mutation *LatencySampleMutation
+}
+
+// Where appends a list predicates to the LatencySampleDelete builder.
+func (lsd *LatencySampleDelete) Where(ps ...predicate.LatencySample) *LatencySampleDelete {
+	lsd.mutation.Where(ps...)
+	return lsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lsd *LatencySampleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lsd.sqlExec, lsd.mutation, lsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsd *LatencySampleDelete) ExecX(ctx context.Context) int {
+	n, err := lsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lsd *LatencySampleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(latencysample.Table, sqlgraph.NewFieldSpec(latencysample.FieldID, field.TypeString))
+	if ps := lsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lsd.mutation.done = true
+	return affected, err
+}
+
+// LatencySampleDeleteOne is the builder for deleting a single LatencySample entity.
+type LatencySampleDeleteOne struct {
+	lsd *LatencySampleDelete
+}
+
+// Where appends a list predicates to the LatencySampleDelete builder.
+func (lsdo *LatencySampleDeleteOne) Where(ps ...predicate.LatencySample) *LatencySampleDeleteOne {
+	lsdo.lsd.mutation.Where(ps...)
+	return lsdo
+}
+
+// Exec executes the deletion query.
+func (lsdo *LatencySampleDeleteOne) Exec(ctx context.Context) error {
+	n, err := lsdo.lsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{latencysample.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsdo *LatencySampleDeleteOne) ExecX(ctx context.Context) {
+	if err := lsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
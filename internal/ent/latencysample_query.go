@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/latencysample"
+	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// LatencySampleQuery is the builder for querying LatencySample entities.
+type LatencySampleQuery struct {
+	config
+	ctx               *QueryContext
+	order             []latencysample.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.LatencySample
+	withNetworkDevice *NetworkDeviceQuery
+	withEndpoint      *EndpointQuery
+	withFKs           bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LatencySampleQuery builder.
+func (lsq *LatencySampleQuery) Where(ps ...predicate.LatencySample) *LatencySampleQuery {
+	lsq.predicates = append(lsq.predicates, ps...)
+	return lsq
+}
+
+// Limit the number of records to be returned by this query.
+func (lsq *LatencySampleQuery) Limit(limit int) *LatencySampleQuery {
+	lsq.ctx.Limit = &limit
+	return lsq
+}
+
+// Offset to start from.
+func (lsq *LatencySampleQuery) Offset(offset int) *LatencySampleQuery {
+	lsq.ctx.Offset = &offset
+	return lsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lsq *LatencySampleQuery) Unique(unique bool) *LatencySampleQuery {
+	lsq.ctx.Unique = &unique
+	return lsq
+}
+
+// Order specifies how the records should be ordered.
+func (lsq *LatencySampleQuery) Order(o ...latencysample.OrderOption) *LatencySampleQuery {
+	lsq.order = append(lsq.order, o...)
+	return lsq
+}
+
+// QueryNetworkDevice chains the current query on the "network_device" edge.
+func (lsq *LatencySampleQuery) QueryNetworkDevice() *NetworkDeviceQuery {
+	query := (&NetworkDeviceClient{config: lsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(latencysample.Table, latencysample.FieldID, selector),
+			sqlgraph.To(networkdevice.Table, networkdevice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, latencysample.NetworkDeviceTable, latencysample.NetworkDeviceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEndpoint chains the current query on the "endpoint" edge.
+func (lsq *LatencySampleQuery) QueryEndpoint() *EndpointQuery {
+	query := (&EndpointClient{config: lsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(latencysample.Table, latencysample.FieldID, selector),
+			sqlgraph.To(endpoint.Table, endpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, latencysample.EndpointTable, latencysample.EndpointColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LatencySample entity from the query.
+// Returns a *NotFoundError when no LatencySample was found.
+func (lsq *LatencySampleQuery) First(ctx context.Context) (*LatencySample, error) {
+	nodes, err := lsq.Limit(1).All(setContextOp(ctx, lsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{latencysample.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lsq *LatencySampleQuery) FirstX(ctx context.Context) *LatencySample {
+	node, err := lsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LatencySample ID from the query.
+// Returns a *NotFoundError when no LatencySample ID was found.
+func (lsq *LatencySampleQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = lsq.Limit(1).IDs(setContextOp(ctx, lsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{latencysample.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lsq *LatencySampleQuery) FirstIDX(ctx context.Context) string {
+	id, err := lsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LatencySample entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LatencySample entity is found.
+// Returns a *NotFoundError when no LatencySample entities are found.
+func (lsq *LatencySampleQuery) Only(ctx context.Context) (*LatencySample, error) {
+	nodes, err := lsq.Limit(2).All(setContextOp(ctx, lsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{latencysample.Label}
+	default:
+		return nil, &NotSingularError{latencysample.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lsq *LatencySampleQuery) OnlyX(ctx context.Context) *LatencySample {
+	node, err := lsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LatencySample ID in the query.
+// Returns a *NotSingularError when more than one LatencySample ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lsq *LatencySampleQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = lsq.Limit(2).IDs(setContextOp(ctx, lsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{latencysample.Label}
+	default:
+		err = &NotSingularError{latencysample.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lsq *LatencySampleQuery) OnlyIDX(ctx context.Context) string {
+	id, err := lsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LatencySamples.
+func (lsq *LatencySampleQuery) All(ctx context.Context) ([]*LatencySample, error) {
+	ctx = setContextOp(ctx, lsq.ctx, ent.OpQueryAll)
+	if err := lsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LatencySample, *LatencySampleQuery]()
+	return withInterceptors[[]*LatencySample](ctx, lsq, qr, lsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lsq *LatencySampleQuery) AllX(ctx context.Context) []*LatencySample {
+	nodes, err := lsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LatencySample IDs.
+func (lsq *LatencySampleQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if lsq.ctx.Unique == nil && lsq.path != nil {
+		lsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lsq.ctx, ent.OpQueryIDs)
+	if err = lsq.Select(latencysample.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lsq *LatencySampleQuery) IDsX(ctx context.Context) []string {
+	ids, err := lsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lsq *LatencySampleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lsq.ctx, ent.OpQueryCount)
+	if err := lsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lsq, querierCount[*LatencySampleQuery](), lsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lsq *LatencySampleQuery) CountX(ctx context.Context) int {
+	count, err := lsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lsq *LatencySampleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lsq.ctx, ent.OpQueryExist)
+	switch _, err := lsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lsq *LatencySampleQuery) ExistX(ctx context.Context) bool {
+	exist, err := lsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LatencySampleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lsq *LatencySampleQuery) Clone() *LatencySampleQuery {
+	if lsq == nil {
+		return nil
+	}
+	return &LatencySampleQuery{
+		config:            lsq.config,
+		ctx:               lsq.ctx.Clone(),
+		order:             append([]latencysample.OrderOption{}, lsq.order...),
+		inters:            append([]Interceptor{}, lsq.inters...),
+		predicates:        append([]predicate.LatencySample{}, lsq.predicates...),
+		withNetworkDevice: lsq.withNetworkDevice.Clone(),
+		withEndpoint:      lsq.withEndpoint.Clone(),
+		// clone intermediate query.
+		sql:  lsq.sql.Clone(),
+		path: lsq.path,
+	}
+}
+
+// WithNetworkDevice tells the query-builder to eager-load the nodes that are connected to
+// the "network_device" edge. The optional arguments are used to configure the query builder of the edge.
+func (lsq *LatencySampleQuery) WithNetworkDevice(opts ...func(*NetworkDeviceQuery)) *LatencySampleQuery {
+	query := (&NetworkDeviceClient{config: lsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lsq.withNetworkDevice = query
+	return lsq
+}
+
+// WithEndpoint tells the query-builder to eager-load the nodes that are connected to
+// the "endpoint" edge. The optional arguments are used to configure the query builder of the edge.
+func (lsq *LatencySampleQuery) WithEndpoint(opts ...func(*EndpointQuery)) *LatencySampleQuery {
+	query := (&EndpointClient{config: lsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lsq.withEndpoint = query
+	return lsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Timestamp int64 `json:"timestamp,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LatencySample.Query().
+//		GroupBy(latencysample.FieldTimestamp).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lsq *LatencySampleQuery) GroupBy(field string, fields ...string) *LatencySampleGroupBy {
+	lsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LatencySampleGroupBy{build: lsq}
+	grbuild.flds = &lsq.ctx.Fields
+	grbuild.label = latencysample.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Timestamp int64 `json:"timestamp,omitempty"`
+//	}
+//
+//	client.LatencySample.Query().
+//		Select(latencysample.FieldTimestamp).
+//		Scan(ctx, &v)
+func (lsq *LatencySampleQuery) Select(fields ...string) *LatencySampleSelect {
+	lsq.ctx.Fields = append(lsq.ctx.Fields, fields...)
+	sbuild := &LatencySampleSelect{LatencySampleQuery: lsq}
+	sbuild.label = latencysample.Label
+	sbuild.flds, sbuild.scan = &lsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LatencySampleSelect configured with the given aggregations.
+func (lsq *LatencySampleQuery) Aggregate(fns ...AggregateFunc) *LatencySampleSelect {
+	return lsq.Select().Aggregate(fns...)
+}
+
+func (lsq *LatencySampleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lsq.ctx.Fields {
+		if !latencysample.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lsq.path != nil {
+		prev, err := lsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lsq.sql = prev
+	}
+	return nil
+}
+
+func (lsq *LatencySampleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LatencySample, error) {
+	var (
+		nodes       = []*LatencySample{}
+		withFKs     = lsq.withFKs
+		_spec       = lsq.querySpec()
+		loadedTypes = [2]bool{
+			lsq.withNetworkDevice != nil,
+			lsq.withEndpoint != nil,
+		}
+	)
+	if lsq.withNetworkDevice != nil || lsq.withEndpoint != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, latencysample.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LatencySample).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LatencySample{config: lsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := lsq.withNetworkDevice; query != nil {
+		if err := lsq.loadNetworkDevice(ctx, query, nodes, nil,
+			func(n *LatencySample, e *NetworkDevice) { n.Edges.NetworkDevice = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lsq.withEndpoint; query != nil {
+		if err := lsq.loadEndpoint(ctx, query, nodes, nil,
+			func(n *LatencySample, e *Endpoint) { n.Edges.Endpoint = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (lsq *LatencySampleQuery) loadNetworkDevice(ctx context.Context, query *NetworkDeviceQuery, nodes []*LatencySample, init func(*LatencySample), assign func(*LatencySample, *NetworkDevice)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*LatencySample)
+	for i := range nodes {
+		if nodes[i].latency_sample_network_device == nil {
+			continue
+		}
+		fk := *nodes[i].latency_sample_network_device
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(networkdevice.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "latency_sample_network_device" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (lsq *LatencySampleQuery) loadEndpoint(ctx context.Context, query *EndpointQuery, nodes []*LatencySample, init func(*LatencySample), assign func(*LatencySample, *Endpoint)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*LatencySample)
+	for i := range nodes {
+		if nodes[i].latency_sample_endpoint == nil {
+			continue
+		}
+		fk := *nodes[i].latency_sample_endpoint
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(endpoint.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "latency_sample_endpoint" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (lsq *LatencySampleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lsq.querySpec()
+	_spec.Node.Columns = lsq.ctx.Fields
+	if len(lsq.ctx.Fields) > 0 {
+		_spec.Unique = lsq.ctx.Unique != nil && *lsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lsq.driver, _spec)
+}
+
+func (lsq *LatencySampleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(latencysample.Table, latencysample.Columns, sqlgraph.NewFieldSpec(latencysample.FieldID, field.TypeString))
+	_spec.From = lsq.sql
+	if unique := lsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, latencysample.FieldID)
+		for i := range fields {
+			if fields[i] != latencysample.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lsq *LatencySampleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lsq.driver.Dialect())
+	t1 := builder.Table(latencysample.Table)
+	columns := lsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = latencysample.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lsq.sql != nil {
+		selector = lsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lsq.ctx.Unique != nil && *lsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range lsq.predicates {
+		p(selector)
+	}
+	for _, p := range lsq.order {
+		p(selector)
+	}
+	if offset := lsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LatencySampleGroupBy is the group-by builder for LatencySample entities.
+type LatencySampleGroupBy struct {
+	selector
+	build *LatencySampleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lsgb *LatencySampleGroupBy) Aggregate(fns ...AggregateFunc) *LatencySampleGroupBy {
+	lsgb.fns = append(lsgb.fns, fns...)
+	return lsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lsgb *LatencySampleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := lsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LatencySampleQuery, *LatencySampleGroupBy](ctx, lsgb.build, lsgb, lsgb.build.inters, v)
+}
+
+func (lsgb *LatencySampleGroupBy) sqlScan(ctx context.Context, root *LatencySampleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lsgb.fns))
+	for _, fn := range lsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lsgb.flds)+len(lsgb.fns))
+		for _, f := range *lsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LatencySampleSelect is the builder for selecting fields of LatencySample entities.
+type LatencySampleSelect struct {
+	*LatencySampleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lss *LatencySampleSelect) Aggregate(fns ...AggregateFunc) *LatencySampleSelect {
+	lss.fns = append(lss.fns, fns...)
+	return lss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lss *LatencySampleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lss.ctx, ent.OpQuerySelect)
+	if err := lss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LatencySampleQuery, *LatencySampleSelect](ctx, lss.LatencySampleQuery, lss, lss.inters, v)
+}
+
+func (lss *LatencySampleSelect) sqlScan(ctx context.Context, root *LatencySampleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lss.fns))
+	for _, fn := range lss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,501 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/latencysample"
+	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// LatencySampleUpdate is the builder for updating LatencySample entities.
+type LatencySampleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LatencySampleMutation
+}
+
+// Where appends a list predicates to the LatencySampleUpdate builder.
+func (lsu *LatencySampleUpdate) Where(ps ...predicate.LatencySample) *LatencySampleUpdate {
+	lsu.mutation.Where(ps...)
+	return lsu
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (lsu *LatencySampleUpdate) SetTimestamp(i int64) *LatencySampleUpdate {
+	lsu.mutation.ResetTimestamp()
+	lsu.mutation.SetTimestamp(i)
+	return lsu
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (lsu *LatencySampleUpdate) SetNillableTimestamp(i *int64) *LatencySampleUpdate {
+	if i != nil {
+		lsu.SetTimestamp(*i)
+	}
+	return lsu
+}
+
+// AddTimestamp adds i to the "timestamp" field.
+func (lsu *LatencySampleUpdate) AddTimestamp(i int64) *LatencySampleUpdate {
+	lsu.mutation.AddTimestamp(i)
+	return lsu
+}
+
+// SetLatency sets the "latency" field.
+func (lsu *LatencySampleUpdate) SetLatency(i int64) *LatencySampleUpdate {
+	lsu.mutation.ResetLatency()
+	lsu.mutation.SetLatency(i)
+	return lsu
+}
+
+// SetNillableLatency sets the "latency" field if the given value is not nil.
+func (lsu *LatencySampleUpdate) SetNillableLatency(i *int64) *LatencySampleUpdate {
+	if i != nil {
+		lsu.SetLatency(*i)
+	}
+	return lsu
+}
+
+// AddLatency adds i to the "latency" field.
+func (lsu *LatencySampleUpdate) AddLatency(i int64) *LatencySampleUpdate {
+	lsu.mutation.AddLatency(i)
+	return lsu
+}
+
+// SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID.
+func (lsu *LatencySampleUpdate) SetNetworkDeviceID(id string) *LatencySampleUpdate {
+	lsu.mutation.SetNetworkDeviceID(id)
+	return lsu
+}
+
+// SetNillableNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID if the given value is not nil.
+func (lsu *LatencySampleUpdate) SetNillableNetworkDeviceID(id *string) *LatencySampleUpdate {
+	if id != nil {
+		lsu = lsu.SetNetworkDeviceID(*id)
+	}
+	return lsu
+}
+
+// SetNetworkDevice sets the "network_device" edge to the NetworkDevice entity.
+func (lsu *LatencySampleUpdate) SetNetworkDevice(n *NetworkDevice) *LatencySampleUpdate {
+	return lsu.SetNetworkDeviceID(n.ID)
+}
+
+// SetEndpointID sets the "endpoint" edge to the Endpoint entity by ID.
+func (lsu *LatencySampleUpdate) SetEndpointID(id string) *LatencySampleUpdate {
+	lsu.mutation.SetEndpointID(id)
+	return lsu
+}
+
+// SetNillableEndpointID sets the "endpoint" edge to the Endpoint entity by ID if the given value is not nil.
+func (lsu *LatencySampleUpdate) SetNillableEndpointID(id *string) *LatencySampleUpdate {
+	if id != nil {
+		lsu = lsu.SetEndpointID(*id)
+	}
+	return lsu
+}
+
+// SetEndpoint sets the "endpoint" edge to the Endpoint entity.
+func (lsu *LatencySampleUpdate) SetEndpoint(e *Endpoint) *LatencySampleUpdate {
+	return lsu.SetEndpointID(e.ID)
+}
+
+// Mutation returns the LatencySampleMutation object of the builder.
+func (lsu *LatencySampleUpdate) Mutation() *LatencySampleMutation {
+	return lsu.mutation
+}
+
+// ClearNetworkDevice clears the "network_device" edge to the NetworkDevice entity.
+func (lsu *LatencySampleUpdate) ClearNetworkDevice() *LatencySampleUpdate {
+	lsu.mutation.ClearNetworkDevice()
+	return lsu
+}
+
+// ClearEndpoint clears the "endpoint" edge to the Endpoint entity.
+func (lsu *LatencySampleUpdate) ClearEndpoint() *LatencySampleUpdate {
+	lsu.mutation.ClearEndpoint()
+	return lsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lsu *LatencySampleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lsu.sqlSave, lsu.mutation, lsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lsu *LatencySampleUpdate) SaveX(ctx context.Context) int {
+	affected, err := lsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lsu *LatencySampleUpdate) Exec(ctx context.Context) error {
+	_, err := lsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsu *LatencySampleUpdate) ExecX(ctx context.Context) {
+	if err := lsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lsu *LatencySampleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(latencysample.Table, latencysample.Columns, sqlgraph.NewFieldSpec(latencysample.FieldID, field.TypeString))
+	if ps := lsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lsu.mutation.Timestamp(); ok {
+		_spec.SetField(latencysample.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := lsu.mutation.AddedTimestamp(); ok {
+		_spec.AddField(latencysample.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := lsu.mutation.Latency(); ok {
+		_spec.SetField(latencysample.FieldLatency, field.TypeInt64, value)
+	}
+	if value, ok := lsu.mutation.AddedLatency(); ok {
+		_spec.AddField(latencysample.FieldLatency, field.TypeInt64, value)
+	}
+	if lsu.mutation.NetworkDeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   latencysample.NetworkDeviceTable,
+			Columns: []string{latencysample.NetworkDeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lsu.mutation.NetworkDeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   latencysample.NetworkDeviceTable,
+			Columns: []string{latencysample.NetworkDeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lsu.mutation.EndpointCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   latencysample.EndpointTable,
+			Columns: []string{latencysample.EndpointColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(endpoint.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lsu.mutation.EndpointIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   latencysample.EndpointTable,
+			Columns: []string{latencysample.EndpointColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(endpoint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{latencysample.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lsu.mutation.done = true
+	return n, nil
+}
+
+// LatencySampleUpdateOne is the builder for updating a single LatencySample entity.
+type LatencySampleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LatencySampleMutation
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (lsuo *LatencySampleUpdateOne) SetTimestamp(i int64) *LatencySampleUpdateOne {
+	lsuo.mutation.ResetTimestamp()
+	lsuo.mutation.SetTimestamp(i)
+	return lsuo
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (lsuo *LatencySampleUpdateOne) SetNillableTimestamp(i *int64) *LatencySampleUpdateOne {
+	if i != nil {
+		lsuo.SetTimestamp(*i)
+	}
+	return lsuo
+}
+
+// AddTimestamp adds i to the "timestamp" field.
+func (lsuo *LatencySampleUpdateOne) AddTimestamp(i int64) *LatencySampleUpdateOne {
+	lsuo.mutation.AddTimestamp(i)
+	return lsuo
+}
+
+// SetLatency sets the "latency" field.
+func (lsuo *LatencySampleUpdateOne) SetLatency(i int64) *LatencySampleUpdateOne {
+	lsuo.mutation.ResetLatency()
+	lsuo.mutation.SetLatency(i)
+	return lsuo
+}
+
+// SetNillableLatency sets the "latency" field if the given value is not nil.
+func (lsuo *LatencySampleUpdateOne) SetNillableLatency(i *int64) *LatencySampleUpdateOne {
+	if i != nil {
+		lsuo.SetLatency(*i)
+	}
+	return lsuo
+}
+
+// AddLatency adds i to the "latency" field.
+func (lsuo *LatencySampleUpdateOne) AddLatency(i int64) *LatencySampleUpdateOne {
+	lsuo.mutation.AddLatency(i)
+	return lsuo
+}
+
+// SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID.
+func (lsuo *LatencySampleUpdateOne) SetNetworkDeviceID(id string) *LatencySampleUpdateOne {
+	lsuo.mutation.SetNetworkDeviceID(id)
+	return lsuo
+}
+
+// SetNillableNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID if the given value is not nil.
+func (lsuo *LatencySampleUpdateOne) SetNillableNetworkDeviceID(id *string) *LatencySampleUpdateOne {
+	if id != nil {
+		lsuo = lsuo.SetNetworkDeviceID(*id)
+	}
+	return lsuo
+}
+
+// SetNetworkDevice sets the "network_device" edge to the NetworkDevice entity.
+func (lsuo *LatencySampleUpdateOne) SetNetworkDevice(n *NetworkDevice) *LatencySampleUpdateOne {
+	return lsuo.SetNetworkDeviceID(n.ID)
+}
+
+// SetEndpointID sets the "endpoint" edge to the Endpoint entity by ID.
+func (lsuo *LatencySampleUpdateOne) SetEndpointID(id string) *LatencySampleUpdateOne {
+	lsuo.mutation.SetEndpointID(id)
+	return lsuo
+}
+
+// SetNillableEndpointID sets the "endpoint" edge to the Endpoint entity by ID if the given value is not nil.
+func (lsuo *LatencySampleUpdateOne) SetNillableEndpointID(id *string) *LatencySampleUpdateOne {
+	if id != nil {
+		lsuo = lsuo.SetEndpointID(*id)
+	}
+	return lsuo
+}
+
+// SetEndpoint sets the "endpoint" edge to the Endpoint entity.
+func (lsuo *LatencySampleUpdateOne) SetEndpoint(e *Endpoint) *LatencySampleUpdateOne {
+	return lsuo.SetEndpointID(e.ID)
+}
+
+// Mutation returns the LatencySampleMutation object of the builder.
+func (lsuo *LatencySampleUpdateOne) Mutation() *LatencySampleMutation {
+	return lsuo.mutation
+}
+
+// ClearNetworkDevice clears the "network_device" edge to the NetworkDevice entity.
+func (lsuo *LatencySampleUpdateOne) ClearNetworkDevice() *LatencySampleUpdateOne {
+	lsuo.mutation.ClearNetworkDevice()
+	return lsuo
+}
+
+// ClearEndpoint clears the "endpoint" edge to the Endpoint entity.
+func (lsuo *LatencySampleUpdateOne) ClearEndpoint() *LatencySampleUpdateOne {
+	lsuo.mutation.ClearEndpoint()
+	return lsuo
+}
+
+// Where appends a list predicates to the LatencySampleUpdate builder.
+func (lsuo *LatencySampleUpdateOne) Where(ps ...predicate.LatencySample) *LatencySampleUpdateOne {
+	lsuo.mutation.Where(ps...)
+	return lsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lsuo *LatencySampleUpdateOne) Select(field string, fields ...string) *LatencySampleUpdateOne {
+	lsuo.fields = append([]string{field}, fields...)
+	return lsuo
+}
+
+// Save executes the query and returns the updated LatencySample entity.
+func (lsuo *LatencySampleUpdateOne) Save(ctx context.Context) (*LatencySample, error) {
+	return withHooks(ctx, lsuo.sqlSave, lsuo.mutation, lsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lsuo *LatencySampleUpdateOne) SaveX(ctx context.Context) *LatencySample {
+	node, err := lsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lsuo *LatencySampleUpdateOne) Exec(ctx context.Context) error {
+	_, err := lsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsuo *LatencySampleUpdateOne) ExecX(ctx context.Context) {
+	if err := lsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lsuo *LatencySampleUpdateOne) sqlSave(ctx context.Context) (_node *LatencySample, err error) {
+	_spec := sqlgraph.NewUpdateSpec(latencysample.Table, latencysample.Columns, sqlgraph.NewFieldSpec(latencysample.FieldID, field.TypeString))
+	id, ok := lsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LatencySample.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, latencysample.FieldID)
+		for _, f := range fields {
+			if !latencysample.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != latencysample.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lsuo.mutation.Timestamp(); ok {
+		_spec.SetField(latencysample.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := lsuo.mutation.AddedTimestamp(); ok {
+		_spec.AddField(latencysample.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := lsuo.mutation.Latency(); ok {
+		_spec.SetField(latencysample.FieldLatency, field.TypeInt64, value)
+	}
+	if value, ok := lsuo.mutation.AddedLatency(); ok {
+		_spec.AddField(latencysample.FieldLatency, field.TypeInt64, value)
+	}
+	if lsuo.mutation.NetworkDeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   latencysample.NetworkDeviceTable,
+			Columns: []string{latencysample.NetworkDeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lsuo.mutation.NetworkDeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   latencysample.NetworkDeviceTable,
+			Columns: []string{latencysample.NetworkDeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lsuo.mutation.EndpointCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   latencysample.EndpointTable,
+			Columns: []string{latencysample.EndpointColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(endpoint.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lsuo.mutation.EndpointIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   latencysample.EndpointTable,
+			Columns: []string{latencysample.EndpointColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(endpoint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LatencySample{config: lsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{latencysample.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/maintenancewindow"
+)
+
+// MaintenanceWindow is the model entity for the MaintenanceWindow schema.
+type MaintenanceWindow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Group holds the value of the "group" field.
+	Group string `json:"group,omitempty"`
+	// Site holds the value of the "site" field.
+	Site string `json:"site,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt int64 `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt int64 `json:"ends_at,omitempty"`
+	// Recurrence holds the value of the "recurrence" field.
+	Recurrence string `json:"recurrence,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MaintenanceWindowQuery when eager-loading is set.
+	Edges        MaintenanceWindowEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MaintenanceWindowEdges holds the relations/edges for other nodes in the graph.
+type MaintenanceWindowEdges struct {
+	// NetworkDevices holds the value of the network_devices edge.
+	NetworkDevices []*NetworkDevice `json:"network_devices,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// NetworkDevicesOrErr returns the NetworkDevices value or an error if the edge
+// was not loaded in eager-loading.
+func (e MaintenanceWindowEdges) NetworkDevicesOrErr() ([]*NetworkDevice, error) {
+	if e.loadedTypes[0] {
+		return e.NetworkDevices, nil
+	}
+	return nil, &NotLoadedError{edge: "network_devices"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MaintenanceWindow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case maintenancewindow.FieldStartsAt, maintenancewindow.FieldEndsAt:
+			values[i] = new(sql.NullInt64)
+		case maintenancewindow.FieldID, maintenancewindow.FieldName, maintenancewindow.FieldGroup, maintenancewindow.FieldSite, maintenancewindow.FieldRecurrence:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MaintenanceWindow fields.
+func (mw *MaintenanceWindow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case maintenancewindow.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				mw.ID = value.String
+			}
+		case maintenancewindow.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				mw.Name = value.String
+			}
+		case maintenancewindow.FieldGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group", values[i])
+			} else if value.Valid {
+				mw.Group = value.String
+			}
+		case maintenancewindow.FieldSite:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site", values[i])
+			} else if value.Valid {
+				mw.Site = value.String
+			}
+		case maintenancewindow.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				mw.StartsAt = value.Int64
+			}
+		case maintenancewindow.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				mw.EndsAt = value.Int64
+			}
+		case maintenancewindow.FieldRecurrence:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence", values[i])
+			} else if value.Valid {
+				mw.Recurrence = value.String
+			}
+		default:
+			mw.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MaintenanceWindow.
+// This includes values selected through modifiers, order, etc.
+func (mw *MaintenanceWindow) Value(name string) (ent.Value, error) {
+	return mw.selectValues.Get(name)
+}
+
+// QueryNetworkDevices queries the "network_devices" edge of the MaintenanceWindow entity.
+func (mw *MaintenanceWindow) QueryNetworkDevices() *NetworkDeviceQuery {
+	return NewMaintenanceWindowClient(mw.config).QueryNetworkDevices(mw)
+}
+
+// Update returns a builder for updating this MaintenanceWindow.
+// Note that you need to call MaintenanceWindow.Unwrap() before calling this method if this MaintenanceWindow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mw *MaintenanceWindow) Update() *MaintenanceWindowUpdateOne {
+	return NewMaintenanceWindowClient(mw.config).UpdateOne(mw)
+}
+
+// Unwrap unwraps the MaintenanceWindow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mw *MaintenanceWindow) Unwrap() *MaintenanceWindow {
+	_tx, ok := mw.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MaintenanceWindow is not a transactional entity")
+	}
+	mw.config.driver = _tx.drv
+	return mw
+}
+
+// String implements the fmt.Stringer.
+func (mw *MaintenanceWindow) String() string {
+	var builder strings.Builder
+	builder.WriteString("MaintenanceWindow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mw.ID))
+	builder.WriteString("name=")
+	builder.WriteString(mw.Name)
+	builder.WriteString(", ")
+	builder.WriteString("group=")
+	builder.WriteString(mw.Group)
+	builder.WriteString(", ")
+	builder.WriteString("site=")
+	builder.WriteString(mw.Site)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(fmt.Sprintf("%v", mw.StartsAt))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(fmt.Sprintf("%v", mw.EndsAt))
+	builder.WriteString(", ")
+	builder.WriteString("recurrence=")
+	builder.WriteString(mw.Recurrence)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MaintenanceWindows is a parsable slice of MaintenanceWindow.
+type MaintenanceWindows []*MaintenanceWindow
@@ -0,0 +1,122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maintenancewindow
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the maintenancewindow type in the database.
+	Label = "maintenance_window"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldGroup holds the string denoting the group field in the database.
+	FieldGroup = "group"
+	// FieldSite holds the string denoting the site field in the database.
+	FieldSite = "site"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldRecurrence holds the string denoting the recurrence field in the database.
+	FieldRecurrence = "recurrence"
+	// EdgeNetworkDevices holds the string denoting the network_devices edge name in mutations.
+	EdgeNetworkDevices = "network_devices"
+	// Table holds the table name of the maintenancewindow in the database.
+	Table = "maintenance_windows"
+	// NetworkDevicesTable is the table that holds the network_devices relation/edge. The primary key declared below.
+	NetworkDevicesTable = "maintenance_window_network_devices"
+	// NetworkDevicesInverseTable is the table name for the NetworkDevice entity.
+	// It exists in this package in order to avoid circular dependency with the "networkdevice" package.
+	NetworkDevicesInverseTable = "network_devices"
+)
+
+// Columns holds all SQL columns for maintenancewindow fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldGroup,
+	FieldSite,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldRecurrence,
+}
+
+var (
+	// NetworkDevicesPrimaryKey and NetworkDevicesColumn2 are the table columns denoting the
+	// primary key for the network_devices relation (M2M).
+	NetworkDevicesPrimaryKey = []string{"maintenance_window_id", "network_device_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the MaintenanceWindow queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByGroup orders the results by the group field.
+func ByGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroup, opts...).ToFunc()
+}
+
+// BySite orders the results by the site field.
+func BySite(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSite, opts...).ToFunc()
+}
+
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByEndsAt orders the results by the ends_at field.
+func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
+}
+
+// ByRecurrence orders the results by the recurrence field.
+func ByRecurrence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecurrence, opts...).ToFunc()
+}
+
+// ByNetworkDevicesCount orders the results by network_devices count.
+func ByNetworkDevicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNetworkDevicesStep(), opts...)
+	}
+}
+
+// ByNetworkDevices orders the results by network_devices terms.
+func ByNetworkDevices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNetworkDevicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newNetworkDevicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NetworkDevicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, NetworkDevicesTable, NetworkDevicesPrimaryKey...),
+	)
+}
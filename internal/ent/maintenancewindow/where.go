@@ -0,0 +1,502 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maintenancewindow
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldName, v))
+}
+
+// Group applies equality check predicate on the "group" field. It's identical to GroupEQ.
+func Group(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldGroup, v))
+}
+
+// Site applies equality check predicate on the "site" field. It's identical to SiteEQ.
+func Site(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldSite, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// Recurrence applies equality check predicate on the "recurrence" field. It's identical to RecurrenceEQ.
+func Recurrence(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldRecurrence, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContainsFold(FieldName, v))
+}
+
+// GroupEQ applies the EQ predicate on the "group" field.
+func GroupEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldGroup, v))
+}
+
+// GroupNEQ applies the NEQ predicate on the "group" field.
+func GroupNEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldGroup, v))
+}
+
+// GroupIn applies the In predicate on the "group" field.
+func GroupIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldGroup, vs...))
+}
+
+// GroupNotIn applies the NotIn predicate on the "group" field.
+func GroupNotIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldGroup, vs...))
+}
+
+// GroupGT applies the GT predicate on the "group" field.
+func GroupGT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldGroup, v))
+}
+
+// GroupGTE applies the GTE predicate on the "group" field.
+func GroupGTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldGroup, v))
+}
+
+// GroupLT applies the LT predicate on the "group" field.
+func GroupLT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldGroup, v))
+}
+
+// GroupLTE applies the LTE predicate on the "group" field.
+func GroupLTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldGroup, v))
+}
+
+// GroupContains applies the Contains predicate on the "group" field.
+func GroupContains(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContains(FieldGroup, v))
+}
+
+// GroupHasPrefix applies the HasPrefix predicate on the "group" field.
+func GroupHasPrefix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasPrefix(FieldGroup, v))
+}
+
+// GroupHasSuffix applies the HasSuffix predicate on the "group" field.
+func GroupHasSuffix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasSuffix(FieldGroup, v))
+}
+
+// GroupIsNil applies the IsNil predicate on the "group" field.
+func GroupIsNil() predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIsNull(FieldGroup))
+}
+
+// GroupNotNil applies the NotNil predicate on the "group" field.
+func GroupNotNil() predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotNull(FieldGroup))
+}
+
+// GroupEqualFold applies the EqualFold predicate on the "group" field.
+func GroupEqualFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEqualFold(FieldGroup, v))
+}
+
+// GroupContainsFold applies the ContainsFold predicate on the "group" field.
+func GroupContainsFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContainsFold(FieldGroup, v))
+}
+
+// SiteEQ applies the EQ predicate on the "site" field.
+func SiteEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldSite, v))
+}
+
+// SiteNEQ applies the NEQ predicate on the "site" field.
+func SiteNEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldSite, v))
+}
+
+// SiteIn applies the In predicate on the "site" field.
+func SiteIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldSite, vs...))
+}
+
+// SiteNotIn applies the NotIn predicate on the "site" field.
+func SiteNotIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldSite, vs...))
+}
+
+// SiteGT applies the GT predicate on the "site" field.
+func SiteGT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldSite, v))
+}
+
+// SiteGTE applies the GTE predicate on the "site" field.
+func SiteGTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldSite, v))
+}
+
+// SiteLT applies the LT predicate on the "site" field.
+func SiteLT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldSite, v))
+}
+
+// SiteLTE applies the LTE predicate on the "site" field.
+func SiteLTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldSite, v))
+}
+
+// SiteContains applies the Contains predicate on the "site" field.
+func SiteContains(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContains(FieldSite, v))
+}
+
+// SiteHasPrefix applies the HasPrefix predicate on the "site" field.
+func SiteHasPrefix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasPrefix(FieldSite, v))
+}
+
+// SiteHasSuffix applies the HasSuffix predicate on the "site" field.
+func SiteHasSuffix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasSuffix(FieldSite, v))
+}
+
+// SiteIsNil applies the IsNil predicate on the "site" field.
+func SiteIsNil() predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIsNull(FieldSite))
+}
+
+// SiteNotNil applies the NotNil predicate on the "site" field.
+func SiteNotNil() predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotNull(FieldSite))
+}
+
+// SiteEqualFold applies the EqualFold predicate on the "site" field.
+func SiteEqualFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEqualFold(FieldSite, v))
+}
+
+// SiteContainsFold applies the ContainsFold predicate on the "site" field.
+func SiteContainsFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContainsFold(FieldSite, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v int64) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// RecurrenceEQ applies the EQ predicate on the "recurrence" field.
+func RecurrenceEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldRecurrence, v))
+}
+
+// RecurrenceNEQ applies the NEQ predicate on the "recurrence" field.
+func RecurrenceNEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldRecurrence, v))
+}
+
+// RecurrenceIn applies the In predicate on the "recurrence" field.
+func RecurrenceIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldRecurrence, vs...))
+}
+
+// RecurrenceNotIn applies the NotIn predicate on the "recurrence" field.
+func RecurrenceNotIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldRecurrence, vs...))
+}
+
+// RecurrenceGT applies the GT predicate on the "recurrence" field.
+func RecurrenceGT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldRecurrence, v))
+}
+
+// RecurrenceGTE applies the GTE predicate on the "recurrence" field.
+func RecurrenceGTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldRecurrence, v))
+}
+
+// RecurrenceLT applies the LT predicate on the "recurrence" field.
+func RecurrenceLT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldRecurrence, v))
+}
+
+// RecurrenceLTE applies the LTE predicate on the "recurrence" field.
+func RecurrenceLTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldRecurrence, v))
+}
+
+// RecurrenceContains applies the Contains predicate on the "recurrence" field.
+func RecurrenceContains(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContains(FieldRecurrence, v))
+}
+
+// RecurrenceHasPrefix applies the HasPrefix predicate on the "recurrence" field.
+func RecurrenceHasPrefix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasPrefix(FieldRecurrence, v))
+}
+
+// RecurrenceHasSuffix applies the HasSuffix predicate on the "recurrence" field.
+func RecurrenceHasSuffix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasSuffix(FieldRecurrence, v))
+}
+
+// RecurrenceIsNil applies the IsNil predicate on the "recurrence" field.
+func RecurrenceIsNil() predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIsNull(FieldRecurrence))
+}
+
+// RecurrenceNotNil applies the NotNil predicate on the "recurrence" field.
+func RecurrenceNotNil() predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotNull(FieldRecurrence))
+}
+
+// RecurrenceEqualFold applies the EqualFold predicate on the "recurrence" field.
+func RecurrenceEqualFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEqualFold(FieldRecurrence, v))
+}
+
+// RecurrenceContainsFold applies the ContainsFold predicate on the "recurrence" field.
+func RecurrenceContainsFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContainsFold(FieldRecurrence, v))
+}
+
+// HasNetworkDevices applies the HasEdge predicate on the "network_devices" edge.
+func HasNetworkDevices() predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, NetworkDevicesTable, NetworkDevicesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNetworkDevicesWith applies the HasEdge predicate on the "network_devices" edge with a given conditions (other predicates).
+func HasNetworkDevicesWith(preds ...predicate.NetworkDevice) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(func(s *sql.Selector) {
+		step := newNetworkDevicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MaintenanceWindow) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MaintenanceWindow) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MaintenanceWindow) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.NotPredicates(p))
+}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/maintenancewindow"
+	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+)
+
+// MaintenanceWindowCreate is the builder for creating a MaintenanceWindow entity.
+type MaintenanceWindowCreate struct {
+	config
+	mutation *MaintenanceWindowMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (mwc *MaintenanceWindowCreate) SetName(s string) *MaintenanceWindowCreate {
+	mwc.mutation.SetName(s)
+	return mwc
+}
+
+// SetGroup sets the "group" field.
+func (mwc *MaintenanceWindowCreate) SetGroup(s string) *MaintenanceWindowCreate {
+	mwc.mutation.SetGroup(s)
+	return mwc
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (mwc *MaintenanceWindowCreate) SetNillableGroup(s *string) *MaintenanceWindowCreate {
+	if s != nil {
+		mwc.SetGroup(*s)
+	}
+	return mwc
+}
+
+// SetSite sets the "site" field.
+func (mwc *MaintenanceWindowCreate) SetSite(s string) *MaintenanceWindowCreate {
+	mwc.mutation.SetSite(s)
+	return mwc
+}
+
+// SetNillableSite sets the "site" field if the given value is not nil.
+func (mwc *MaintenanceWindowCreate) SetNillableSite(s *string) *MaintenanceWindowCreate {
+	if s != nil {
+		mwc.SetSite(*s)
+	}
+	return mwc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (mwc *MaintenanceWindowCreate) SetStartsAt(i int64) *MaintenanceWindowCreate {
+	mwc.mutation.SetStartsAt(i)
+	return mwc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (mwc *MaintenanceWindowCreate) SetEndsAt(i int64) *MaintenanceWindowCreate {
+	mwc.mutation.SetEndsAt(i)
+	return mwc
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (mwc *MaintenanceWindowCreate) SetRecurrence(s string) *MaintenanceWindowCreate {
+	mwc.mutation.SetRecurrence(s)
+	return mwc
+}
+
+// SetNillableRecurrence sets the "recurrence" field if the given value is not nil.
+func (mwc *MaintenanceWindowCreate) SetNillableRecurrence(s *string) *MaintenanceWindowCreate {
+	if s != nil {
+		mwc.SetRecurrence(*s)
+	}
+	return mwc
+}
+
+// SetID sets the "id" field.
+func (mwc *MaintenanceWindowCreate) SetID(s string) *MaintenanceWindowCreate {
+	mwc.mutation.SetID(s)
+	return mwc
+}
+
+// AddNetworkDeviceIDs adds the "network_devices" edge to the NetworkDevice entity by IDs.
+func (mwc *MaintenanceWindowCreate) AddNetworkDeviceIDs(ids ...string) *MaintenanceWindowCreate {
+	mwc.mutation.AddNetworkDeviceIDs(ids...)
+	return mwc
+}
+
+// AddNetworkDevices adds the "network_devices" edges to the NetworkDevice entity.
+func (mwc *MaintenanceWindowCreate) AddNetworkDevices(n ...*NetworkDevice) *MaintenanceWindowCreate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mwc.AddNetworkDeviceIDs(ids...)
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (mwc *MaintenanceWindowCreate) Mutation() *MaintenanceWindowMutation {
+	return mwc.mutation
+}
+
+// Save creates the MaintenanceWindow in the database.
+func (mwc *MaintenanceWindowCreate) Save(ctx context.Context) (*MaintenanceWindow, error) {
+	return withHooks(ctx, mwc.sqlSave, mwc.mutation, mwc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mwc *MaintenanceWindowCreate) SaveX(ctx context.Context) *MaintenanceWindow {
+	v, err := mwc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwc *MaintenanceWindowCreate) Exec(ctx context.Context) error {
+	_, err := mwc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwc *MaintenanceWindowCreate) ExecX(ctx context.Context) {
+	if err := mwc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mwc *MaintenanceWindowCreate) check() error {
+	if _, ok := mwc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "MaintenanceWindow.name"`)}
+	}
+	if _, ok := mwc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "MaintenanceWindow.starts_at"`)}
+	}
+	if _, ok := mwc.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`ent: missing required field "MaintenanceWindow.ends_at"`)}
+	}
+	return nil
+}
+
+func (mwc *MaintenanceWindowCreate) sqlSave(ctx context.Context) (*MaintenanceWindow, error) {
+	if err := mwc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mwc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mwc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected MaintenanceWindow.ID type: %T", _spec.ID.Value)
+		}
+	}
+	mwc.mutation.id = &_node.ID
+	mwc.mutation.done = true
+	return _node, nil
+}
+
+func (mwc *MaintenanceWindowCreate) createSpec() (*MaintenanceWindow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MaintenanceWindow{config: mwc.config}
+		_spec = sqlgraph.NewCreateSpec(maintenancewindow.Table, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString))
+	)
+	if id, ok := mwc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mwc.mutation.Name(); ok {
+		_spec.SetField(maintenancewindow.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := mwc.mutation.Group(); ok {
+		_spec.SetField(maintenancewindow.FieldGroup, field.TypeString, value)
+		_node.Group = value
+	}
+	if value, ok := mwc.mutation.Site(); ok {
+		_spec.SetField(maintenancewindow.FieldSite, field.TypeString, value)
+		_node.Site = value
+	}
+	if value, ok := mwc.mutation.StartsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartsAt, field.TypeInt64, value)
+		_node.StartsAt = value
+	}
+	if value, ok := mwc.mutation.EndsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndsAt, field.TypeInt64, value)
+		_node.EndsAt = value
+	}
+	if value, ok := mwc.mutation.Recurrence(); ok {
+		_spec.SetField(maintenancewindow.FieldRecurrence, field.TypeString, value)
+		_node.Recurrence = value
+	}
+	if nodes := mwc.mutation.NetworkDevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   maintenancewindow.NetworkDevicesTable,
+			Columns: maintenancewindow.NetworkDevicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MaintenanceWindowCreateBulk is the builder for creating many MaintenanceWindow entities in bulk.
+type MaintenanceWindowCreateBulk struct {
+	config
+	err      error
+	builders []*MaintenanceWindowCreate
+}
+
+// Save creates the MaintenanceWindow entities in the database.
+func (mwcb *MaintenanceWindowCreateBulk) Save(ctx context.Context) ([]*MaintenanceWindow, error) {
+	if mwcb.err != nil {
+		return nil, mwcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mwcb.builders))
+	nodes := make([]*MaintenanceWindow, len(mwcb.builders))
+	mutators := make([]Mutator, len(mwcb.builders))
+	for i := range mwcb.builders {
+		func(i int, root context.Context) {
+			builder := mwcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MaintenanceWindowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mwcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mwcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mwcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwcb *MaintenanceWindowCreateBulk) SaveX(ctx context.Context) []*MaintenanceWindow {
+	v, err := mwcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwcb *MaintenanceWindowCreateBulk) Exec(ctx context.Context) error {
+	_, err := mwcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwcb *MaintenanceWindowCreateBulk) ExecX(ctx context.Context) {
+	if err := mwcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
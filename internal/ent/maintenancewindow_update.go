@@ -0,0 +1,636 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/maintenancewindow"
+	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// MaintenanceWindowUpdate is the builder for updating MaintenanceWindow entities.
+type MaintenanceWindowUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MaintenanceWindowMutation
+}
+
+// Where appends a list predicates to the MaintenanceWindowUpdate builder.
+func (mwu *MaintenanceWindowUpdate) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowUpdate {
+	mwu.mutation.Where(ps...)
+	return mwu
+}
+
+// SetName sets the "name" field.
+func (mwu *MaintenanceWindowUpdate) SetName(s string) *MaintenanceWindowUpdate {
+	mwu.mutation.SetName(s)
+	return mwu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableName(s *string) *MaintenanceWindowUpdate {
+	if s != nil {
+		mwu.SetName(*s)
+	}
+	return mwu
+}
+
+// SetGroup sets the "group" field.
+func (mwu *MaintenanceWindowUpdate) SetGroup(s string) *MaintenanceWindowUpdate {
+	mwu.mutation.SetGroup(s)
+	return mwu
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableGroup(s *string) *MaintenanceWindowUpdate {
+	if s != nil {
+		mwu.SetGroup(*s)
+	}
+	return mwu
+}
+
+// ClearGroup clears the value of the "group" field.
+func (mwu *MaintenanceWindowUpdate) ClearGroup() *MaintenanceWindowUpdate {
+	mwu.mutation.ClearGroup()
+	return mwu
+}
+
+// SetSite sets the "site" field.
+func (mwu *MaintenanceWindowUpdate) SetSite(s string) *MaintenanceWindowUpdate {
+	mwu.mutation.SetSite(s)
+	return mwu
+}
+
+// SetNillableSite sets the "site" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableSite(s *string) *MaintenanceWindowUpdate {
+	if s != nil {
+		mwu.SetSite(*s)
+	}
+	return mwu
+}
+
+// ClearSite clears the value of the "site" field.
+func (mwu *MaintenanceWindowUpdate) ClearSite() *MaintenanceWindowUpdate {
+	mwu.mutation.ClearSite()
+	return mwu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (mwu *MaintenanceWindowUpdate) SetStartsAt(i int64) *MaintenanceWindowUpdate {
+	mwu.mutation.ResetStartsAt()
+	mwu.mutation.SetStartsAt(i)
+	return mwu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableStartsAt(i *int64) *MaintenanceWindowUpdate {
+	if i != nil {
+		mwu.SetStartsAt(*i)
+	}
+	return mwu
+}
+
+// AddStartsAt adds i to the "starts_at" field.
+func (mwu *MaintenanceWindowUpdate) AddStartsAt(i int64) *MaintenanceWindowUpdate {
+	mwu.mutation.AddStartsAt(i)
+	return mwu
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (mwu *MaintenanceWindowUpdate) SetEndsAt(i int64) *MaintenanceWindowUpdate {
+	mwu.mutation.ResetEndsAt()
+	mwu.mutation.SetEndsAt(i)
+	return mwu
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableEndsAt(i *int64) *MaintenanceWindowUpdate {
+	if i != nil {
+		mwu.SetEndsAt(*i)
+	}
+	return mwu
+}
+
+// AddEndsAt adds i to the "ends_at" field.
+func (mwu *MaintenanceWindowUpdate) AddEndsAt(i int64) *MaintenanceWindowUpdate {
+	mwu.mutation.AddEndsAt(i)
+	return mwu
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (mwu *MaintenanceWindowUpdate) SetRecurrence(s string) *MaintenanceWindowUpdate {
+	mwu.mutation.SetRecurrence(s)
+	return mwu
+}
+
+// SetNillableRecurrence sets the "recurrence" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableRecurrence(s *string) *MaintenanceWindowUpdate {
+	if s != nil {
+		mwu.SetRecurrence(*s)
+	}
+	return mwu
+}
+
+// ClearRecurrence clears the value of the "recurrence" field.
+func (mwu *MaintenanceWindowUpdate) ClearRecurrence() *MaintenanceWindowUpdate {
+	mwu.mutation.ClearRecurrence()
+	return mwu
+}
+
+// AddNetworkDeviceIDs adds the "network_devices" edge to the NetworkDevice entity by IDs.
+func (mwu *MaintenanceWindowUpdate) AddNetworkDeviceIDs(ids ...string) *MaintenanceWindowUpdate {
+	mwu.mutation.AddNetworkDeviceIDs(ids...)
+	return mwu
+}
+
+// AddNetworkDevices adds the "network_devices" edges to the NetworkDevice entity.
+func (mwu *MaintenanceWindowUpdate) AddNetworkDevices(n ...*NetworkDevice) *MaintenanceWindowUpdate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mwu.AddNetworkDeviceIDs(ids...)
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (mwu *MaintenanceWindowUpdate) Mutation() *MaintenanceWindowMutation {
+	return mwu.mutation
+}
+
+// ClearNetworkDevices clears all "network_devices" edges to the NetworkDevice entity.
+func (mwu *MaintenanceWindowUpdate) ClearNetworkDevices() *MaintenanceWindowUpdate {
+	mwu.mutation.ClearNetworkDevices()
+	return mwu
+}
+
+// RemoveNetworkDeviceIDs removes the "network_devices" edge to NetworkDevice entities by IDs.
+func (mwu *MaintenanceWindowUpdate) RemoveNetworkDeviceIDs(ids ...string) *MaintenanceWindowUpdate {
+	mwu.mutation.RemoveNetworkDeviceIDs(ids...)
+	return mwu
+}
+
+// RemoveNetworkDevices removes "network_devices" edges to NetworkDevice entities.
+func (mwu *MaintenanceWindowUpdate) RemoveNetworkDevices(n ...*NetworkDevice) *MaintenanceWindowUpdate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mwu.RemoveNetworkDeviceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mwu *MaintenanceWindowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mwu.sqlSave, mwu.mutation, mwu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwu *MaintenanceWindowUpdate) SaveX(ctx context.Context) int {
+	affected, err := mwu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mwu *MaintenanceWindowUpdate) Exec(ctx context.Context) error {
+	_, err := mwu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwu *MaintenanceWindowUpdate) ExecX(ctx context.Context) {
+	if err := mwu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mwu *MaintenanceWindowUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString))
+	if ps := mwu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwu.mutation.Name(); ok {
+		_spec.SetField(maintenancewindow.FieldName, field.TypeString, value)
+	}
+	if value, ok := mwu.mutation.Group(); ok {
+		_spec.SetField(maintenancewindow.FieldGroup, field.TypeString, value)
+	}
+	if mwu.mutation.GroupCleared() {
+		_spec.ClearField(maintenancewindow.FieldGroup, field.TypeString)
+	}
+	if value, ok := mwu.mutation.Site(); ok {
+		_spec.SetField(maintenancewindow.FieldSite, field.TypeString, value)
+	}
+	if mwu.mutation.SiteCleared() {
+		_spec.ClearField(maintenancewindow.FieldSite, field.TypeString)
+	}
+	if value, ok := mwu.mutation.StartsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := mwu.mutation.AddedStartsAt(); ok {
+		_spec.AddField(maintenancewindow.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := mwu.mutation.EndsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndsAt, field.TypeInt64, value)
+	}
+	if value, ok := mwu.mutation.AddedEndsAt(); ok {
+		_spec.AddField(maintenancewindow.FieldEndsAt, field.TypeInt64, value)
+	}
+	if value, ok := mwu.mutation.Recurrence(); ok {
+		_spec.SetField(maintenancewindow.FieldRecurrence, field.TypeString, value)
+	}
+	if mwu.mutation.RecurrenceCleared() {
+		_spec.ClearField(maintenancewindow.FieldRecurrence, field.TypeString)
+	}
+	if mwu.mutation.NetworkDevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   maintenancewindow.NetworkDevicesTable,
+			Columns: maintenancewindow.NetworkDevicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mwu.mutation.RemovedNetworkDevicesIDs(); len(nodes) > 0 && !mwu.mutation.NetworkDevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   maintenancewindow.NetworkDevicesTable,
+			Columns: maintenancewindow.NetworkDevicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mwu.mutation.NetworkDevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   maintenancewindow.NetworkDevicesTable,
+			Columns: maintenancewindow.NetworkDevicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mwu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancewindow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mwu.mutation.done = true
+	return n, nil
+}
+
+// MaintenanceWindowUpdateOne is the builder for updating a single MaintenanceWindow entity.
+type MaintenanceWindowUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MaintenanceWindowMutation
+}
+
+// SetName sets the "name" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetName(s string) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetName(s)
+	return mwuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableName(s *string) *MaintenanceWindowUpdateOne {
+	if s != nil {
+		mwuo.SetName(*s)
+	}
+	return mwuo
+}
+
+// SetGroup sets the "group" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetGroup(s string) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetGroup(s)
+	return mwuo
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableGroup(s *string) *MaintenanceWindowUpdateOne {
+	if s != nil {
+		mwuo.SetGroup(*s)
+	}
+	return mwuo
+}
+
+// ClearGroup clears the value of the "group" field.
+func (mwuo *MaintenanceWindowUpdateOne) ClearGroup() *MaintenanceWindowUpdateOne {
+	mwuo.mutation.ClearGroup()
+	return mwuo
+}
+
+// SetSite sets the "site" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetSite(s string) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetSite(s)
+	return mwuo
+}
+
+// SetNillableSite sets the "site" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableSite(s *string) *MaintenanceWindowUpdateOne {
+	if s != nil {
+		mwuo.SetSite(*s)
+	}
+	return mwuo
+}
+
+// ClearSite clears the value of the "site" field.
+func (mwuo *MaintenanceWindowUpdateOne) ClearSite() *MaintenanceWindowUpdateOne {
+	mwuo.mutation.ClearSite()
+	return mwuo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetStartsAt(i int64) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.ResetStartsAt()
+	mwuo.mutation.SetStartsAt(i)
+	return mwuo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableStartsAt(i *int64) *MaintenanceWindowUpdateOne {
+	if i != nil {
+		mwuo.SetStartsAt(*i)
+	}
+	return mwuo
+}
+
+// AddStartsAt adds i to the "starts_at" field.
+func (mwuo *MaintenanceWindowUpdateOne) AddStartsAt(i int64) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.AddStartsAt(i)
+	return mwuo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetEndsAt(i int64) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.ResetEndsAt()
+	mwuo.mutation.SetEndsAt(i)
+	return mwuo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableEndsAt(i *int64) *MaintenanceWindowUpdateOne {
+	if i != nil {
+		mwuo.SetEndsAt(*i)
+	}
+	return mwuo
+}
+
+// AddEndsAt adds i to the "ends_at" field.
+func (mwuo *MaintenanceWindowUpdateOne) AddEndsAt(i int64) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.AddEndsAt(i)
+	return mwuo
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetRecurrence(s string) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetRecurrence(s)
+	return mwuo
+}
+
+// SetNillableRecurrence sets the "recurrence" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableRecurrence(s *string) *MaintenanceWindowUpdateOne {
+	if s != nil {
+		mwuo.SetRecurrence(*s)
+	}
+	return mwuo
+}
+
+// ClearRecurrence clears the value of the "recurrence" field.
+func (mwuo *MaintenanceWindowUpdateOne) ClearRecurrence() *MaintenanceWindowUpdateOne {
+	mwuo.mutation.ClearRecurrence()
+	return mwuo
+}
+
+// AddNetworkDeviceIDs adds the "network_devices" edge to the NetworkDevice entity by IDs.
+func (mwuo *MaintenanceWindowUpdateOne) AddNetworkDeviceIDs(ids ...string) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.AddNetworkDeviceIDs(ids...)
+	return mwuo
+}
+
+// AddNetworkDevices adds the "network_devices" edges to the NetworkDevice entity.
+func (mwuo *MaintenanceWindowUpdateOne) AddNetworkDevices(n ...*NetworkDevice) *MaintenanceWindowUpdateOne {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mwuo.AddNetworkDeviceIDs(ids...)
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (mwuo *MaintenanceWindowUpdateOne) Mutation() *MaintenanceWindowMutation {
+	return mwuo.mutation
+}
+
+// ClearNetworkDevices clears all "network_devices" edges to the NetworkDevice entity.
+func (mwuo *MaintenanceWindowUpdateOne) ClearNetworkDevices() *MaintenanceWindowUpdateOne {
+	mwuo.mutation.ClearNetworkDevices()
+	return mwuo
+}
+
+// RemoveNetworkDeviceIDs removes the "network_devices" edge to NetworkDevice entities by IDs.
+func (mwuo *MaintenanceWindowUpdateOne) RemoveNetworkDeviceIDs(ids ...string) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.RemoveNetworkDeviceIDs(ids...)
+	return mwuo
+}
+
+// RemoveNetworkDevices removes "network_devices" edges to NetworkDevice entities.
+func (mwuo *MaintenanceWindowUpdateOne) RemoveNetworkDevices(n ...*NetworkDevice) *MaintenanceWindowUpdateOne {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mwuo.RemoveNetworkDeviceIDs(ids...)
+}
+
+// Where appends a list predicates to the MaintenanceWindowUpdate builder.
+func (mwuo *MaintenanceWindowUpdateOne) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.Where(ps...)
+	return mwuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mwuo *MaintenanceWindowUpdateOne) Select(field string, fields ...string) *MaintenanceWindowUpdateOne {
+	mwuo.fields = append([]string{field}, fields...)
+	return mwuo
+}
+
+// Save executes the query and returns the updated MaintenanceWindow entity.
+func (mwuo *MaintenanceWindowUpdateOne) Save(ctx context.Context) (*MaintenanceWindow, error) {
+	return withHooks(ctx, mwuo.sqlSave, mwuo.mutation, mwuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwuo *MaintenanceWindowUpdateOne) SaveX(ctx context.Context) *MaintenanceWindow {
+	node, err := mwuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mwuo *MaintenanceWindowUpdateOne) Exec(ctx context.Context) error {
+	_, err := mwuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwuo *MaintenanceWindowUpdateOne) ExecX(ctx context.Context) {
+	if err := mwuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mwuo *MaintenanceWindowUpdateOne) sqlSave(ctx context.Context) (_node *MaintenanceWindow, err error) {
+	_spec := sqlgraph.NewUpdateSpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString))
+	id, ok := mwuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MaintenanceWindow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mwuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, maintenancewindow.FieldID)
+		for _, f := range fields {
+			if !maintenancewindow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != maintenancewindow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mwuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwuo.mutation.Name(); ok {
+		_spec.SetField(maintenancewindow.FieldName, field.TypeString, value)
+	}
+	if value, ok := mwuo.mutation.Group(); ok {
+		_spec.SetField(maintenancewindow.FieldGroup, field.TypeString, value)
+	}
+	if mwuo.mutation.GroupCleared() {
+		_spec.ClearField(maintenancewindow.FieldGroup, field.TypeString)
+	}
+	if value, ok := mwuo.mutation.Site(); ok {
+		_spec.SetField(maintenancewindow.FieldSite, field.TypeString, value)
+	}
+	if mwuo.mutation.SiteCleared() {
+		_spec.ClearField(maintenancewindow.FieldSite, field.TypeString)
+	}
+	if value, ok := mwuo.mutation.StartsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := mwuo.mutation.AddedStartsAt(); ok {
+		_spec.AddField(maintenancewindow.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := mwuo.mutation.EndsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndsAt, field.TypeInt64, value)
+	}
+	if value, ok := mwuo.mutation.AddedEndsAt(); ok {
+		_spec.AddField(maintenancewindow.FieldEndsAt, field.TypeInt64, value)
+	}
+	if value, ok := mwuo.mutation.Recurrence(); ok {
+		_spec.SetField(maintenancewindow.FieldRecurrence, field.TypeString, value)
+	}
+	if mwuo.mutation.RecurrenceCleared() {
+		_spec.ClearField(maintenancewindow.FieldRecurrence, field.TypeString)
+	}
+	if mwuo.mutation.NetworkDevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   maintenancewindow.NetworkDevicesTable,
+			Columns: maintenancewindow.NetworkDevicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mwuo.mutation.RemovedNetworkDevicesIDs(); len(nodes) > 0 && !mwuo.mutation.NetworkDevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   maintenancewindow.NetworkDevicesTable,
+			Columns: maintenancewindow.NetworkDevicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mwuo.mutation.NetworkDevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   maintenancewindow.NetworkDevicesTable,
+			Columns: maintenancewindow.NetworkDevicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MaintenanceWindow{config: mwuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mwuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancewindow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mwuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,18 @@
+-- Create "credential_profiles" table
+CREATE TABLE "credential_profiles" (
+  "id" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "username" character varying NULL,
+  "password" character varying NULL,
+  "snmp_community" character varying NULL,
+  "private_key" character varying NULL,
+  "token" character varying NULL,
+  "snmp_priv_passphrase" character varying NULL,
+  "snmp_auth_protocol" character varying NULL,
+  "snmp_priv_protocol" character varying NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "credential_profiles_name_key" to table: "credential_profiles"
+CREATE UNIQUE INDEX "credential_profiles_name_key" ON "credential_profiles" ("name");
+-- Modify "endpoints" table
+ALTER TABLE "endpoints" ADD COLUMN "endpoint_credential_profile" character varying NULL, ADD CONSTRAINT "endpoints_credential_profiles_credential_profile" FOREIGN KEY ("endpoint_credential_profile") REFERENCES "credential_profiles" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
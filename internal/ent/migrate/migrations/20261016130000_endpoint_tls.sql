@@ -0,0 +1,4 @@
+-- Modify "credential_profiles" table
+ALTER TABLE "credential_profiles" ADD COLUMN "tls_client_key" character varying NULL, ADD COLUMN "tls_client_certificate" character varying NULL;
+-- Modify "endpoints" table
+ALTER TABLE "endpoints" ADD COLUMN "tls_enabled" boolean NULL, ADD COLUMN "tls_ca_bundle" character varying NULL, ADD COLUMN "tls_server_name" character varying NULL, ADD COLUMN "tls_insecure_skip_verify" boolean NULL;
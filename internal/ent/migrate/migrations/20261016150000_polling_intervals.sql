@@ -0,0 +1,10 @@
+-- Modify "network_devices" table
+ALTER TABLE "network_devices" ADD COLUMN "poll_interval" integer NULL, ADD COLUMN "group" character varying NULL, ADD COLUMN "site" character varying NULL;
+-- Create "polling_defaults" table
+CREATE TABLE "polling_defaults" (
+  "id" character varying NOT NULL,
+  "scope" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "poll_interval" integer NOT NULL,
+  PRIMARY KEY ("id")
+);
@@ -0,0 +1,7 @@
+-- Create "leases" table
+CREATE TABLE "leases" (
+  "id" character varying NOT NULL,
+  "holder" character varying NOT NULL,
+  "expires_at" bigint NOT NULL,
+  PRIMARY KEY ("id")
+);
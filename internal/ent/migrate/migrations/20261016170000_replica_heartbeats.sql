@@ -0,0 +1,6 @@
+-- Create "replica_heartbeats" table
+CREATE TABLE "replica_heartbeats" (
+  "id" character varying NOT NULL,
+  "last_seen" bigint NOT NULL,
+  PRIMARY KEY ("id")
+);
@@ -0,0 +1,11 @@
+-- Create "status_events" table
+CREATE TABLE "status_events" (
+  "id" character varying NOT NULL,
+  "old_status" character varying NOT NULL,
+  "new_status" character varying NOT NULL,
+  "timestamp" bigint NOT NULL,
+  "reason" character varying NOT NULL,
+  "status_event_network_device" character varying NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "status_events_network_devices_network_device" FOREIGN KEY ("status_event_network_device") REFERENCES "network_devices" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
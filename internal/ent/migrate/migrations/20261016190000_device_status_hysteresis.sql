@@ -0,0 +1,2 @@
+-- Modify "device_status" table
+ALTER TABLE "device_status" ADD COLUMN "pending_status" character varying NULL, ADD COLUMN "pending_readings" integer NULL, ADD COLUMN "flapping" boolean NULL;
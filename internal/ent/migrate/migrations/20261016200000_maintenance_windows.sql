@@ -0,0 +1,21 @@
+-- Modify "device_status" table
+ALTER TABLE "device_status" ADD COLUMN "in_maintenance" boolean NULL;
+-- Create "maintenance_windows" table
+CREATE TABLE "maintenance_windows" (
+  "id" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "group" character varying NULL,
+  "site" character varying NULL,
+  "starts_at" bigint NOT NULL,
+  "ends_at" bigint NOT NULL,
+  "recurrence" character varying NULL,
+  PRIMARY KEY ("id")
+);
+-- Create "maintenance_window_network_devices" table
+CREATE TABLE "maintenance_window_network_devices" (
+  "maintenance_window_id" character varying NOT NULL,
+  "network_device_id" character varying NOT NULL,
+  PRIMARY KEY ("maintenance_window_id", "network_device_id"),
+  CONSTRAINT "maintenance_window_network_devices_maintenance_window_id" FOREIGN KEY ("maintenance_window_id") REFERENCES "maintenance_windows" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "maintenance_window_network_devices_network_device_id" FOREIGN KEY ("network_device_id") REFERENCES "network_devices" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
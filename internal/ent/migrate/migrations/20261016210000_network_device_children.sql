@@ -0,0 +1,8 @@
+-- Create "network_device_children" table
+CREATE TABLE "network_device_children" (
+  "network_device_id" character varying NOT NULL,
+  "child_id" character varying NOT NULL,
+  PRIMARY KEY ("network_device_id", "child_id"),
+  CONSTRAINT "network_device_children_child_id" FOREIGN KEY ("child_id") REFERENCES "network_devices" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "network_device_children_network_device_id" FOREIGN KEY ("network_device_id") REFERENCES "network_devices" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+-- Modify "device_status" table
+ALTER TABLE "device_status" ADD COLUMN "answered_endpoint_id" character varying NULL, ADD COLUMN "answered_protocol" character varying NULL;
+-- Modify "endpoints" table
+ALTER TABLE "endpoints" ADD COLUMN "preference" integer NULL;
@@ -0,0 +1,12 @@
+-- Create "endpoint_healths" table
+CREATE TABLE "endpoint_healths" (
+  "id" character varying NOT NULL,
+  "last_success" bigint NULL,
+  "last_failure" bigint NULL,
+  "last_error" character varying NULL,
+  "latency" bigint NULL,
+  "failure_count" integer NULL,
+  PRIMARY KEY ("id")
+);
+-- Modify "endpoints" table
+ALTER TABLE "endpoints" ADD COLUMN "endpoint_health" character varying NULL, ADD CONSTRAINT "endpoints_endpoint_healths_health" FOREIGN KEY ("endpoint_health") REFERENCES "endpoint_healths" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
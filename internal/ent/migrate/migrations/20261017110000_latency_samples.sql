@@ -0,0 +1,11 @@
+-- Create "latency_samples" table
+CREATE TABLE "latency_samples" (
+  "id" character varying NOT NULL,
+  "timestamp" bigint NOT NULL,
+  "latency" bigint NOT NULL,
+  "latency_sample_network_device" character varying NULL,
+  "latency_sample_endpoint" character varying NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "latency_samples_endpoints_endpoint" FOREIGN KEY ("latency_sample_endpoint") REFERENCES "endpoints" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "latency_samples_network_devices_network_device" FOREIGN KEY ("latency_sample_network_device") REFERENCES "network_devices" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
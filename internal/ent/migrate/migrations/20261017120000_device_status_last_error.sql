@@ -0,0 +1,2 @@
+-- Modify "device_status" table
+ALTER TABLE "device_status" ADD COLUMN "last_error" character varying NULL;
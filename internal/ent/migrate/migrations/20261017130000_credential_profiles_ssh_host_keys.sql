@@ -0,0 +1,2 @@
+-- Modify "credential_profiles" table
+ALTER TABLE "credential_profiles" ADD COLUMN "ssh_known_hosts" character varying NULL, ADD COLUMN "ssh_insecure_ignore_host_key" boolean NULL;
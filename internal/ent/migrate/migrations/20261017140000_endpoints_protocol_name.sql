@@ -0,0 +1,2 @@
+-- Modify "endpoints" table
+ALTER TABLE "endpoints" ADD COLUMN "protocol_name" character varying NULL;
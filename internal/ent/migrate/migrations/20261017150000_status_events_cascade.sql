@@ -0,0 +1,2 @@
+-- Modify "status_events" table
+ALTER TABLE "status_events" DROP CONSTRAINT "status_events_network_devices_network_device", ADD CONSTRAINT "status_events_network_devices_network_device" FOREIGN KEY ("status_event_network_device") REFERENCES "network_devices" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
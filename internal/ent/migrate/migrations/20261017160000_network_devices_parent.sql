@@ -0,0 +1,6 @@
+-- Modify "network_devices" table
+ALTER TABLE "network_devices" ADD COLUMN "network_device_children" character varying NULL, ADD CONSTRAINT "network_devices_network_devices_children" FOREIGN KEY ("network_device_children") REFERENCES "network_devices" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Move parents of the network devices from "network_device_children" table
+UPDATE "network_devices" SET "network_device_children" = "c"."network_device_id" FROM (SELECT DISTINCT ON ("child_id") "child_id", "network_device_id" FROM "network_device_children" ORDER BY "child_id", "network_device_id") AS "c" WHERE "network_devices"."id" = "c"."child_id";
+-- Drop "network_device_children" table
+DROP TABLE "network_device_children";
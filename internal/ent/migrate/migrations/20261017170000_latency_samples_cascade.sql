@@ -0,0 +1,2 @@
+-- Modify "latency_samples" table
+ALTER TABLE "latency_samples" DROP CONSTRAINT "latency_samples_endpoints_endpoint", DROP CONSTRAINT "latency_samples_network_devices_network_device", ADD CONSTRAINT "latency_samples_endpoints_endpoint" FOREIGN KEY ("latency_sample_endpoint") REFERENCES "endpoints" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "latency_samples_network_devices_network_device" FOREIGN KEY ("latency_sample_network_device") REFERENCES "network_devices" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
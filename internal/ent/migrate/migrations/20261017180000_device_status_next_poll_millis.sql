@@ -0,0 +1,2 @@
+-- Modify "device_status" table, devices are polled right away, their next poll is scheduled again
+ALTER TABLE "device_status" ALTER COLUMN "next_poll" TYPE bigint USING NULL;
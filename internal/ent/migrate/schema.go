@@ -8,12 +8,43 @@ import (
 )
 
 var (
+	// CredentialProfilesColumns holds the columns for the "credential_profiles" table.
+	CredentialProfilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "username", Type: field.TypeString, Nullable: true},
+		{Name: "password", Type: field.TypeString, Nullable: true},
+		{Name: "snmp_community", Type: field.TypeString, Nullable: true},
+		{Name: "private_key", Type: field.TypeString, Nullable: true},
+		{Name: "token", Type: field.TypeString, Nullable: true},
+		{Name: "snmp_priv_passphrase", Type: field.TypeString, Nullable: true},
+		{Name: "tls_client_key", Type: field.TypeString, Nullable: true},
+		{Name: "snmp_auth_protocol", Type: field.TypeString, Nullable: true},
+		{Name: "snmp_priv_protocol", Type: field.TypeString, Nullable: true},
+		{Name: "tls_client_certificate", Type: field.TypeString, Nullable: true},
+		{Name: "ssh_known_hosts", Type: field.TypeString, Nullable: true},
+		{Name: "ssh_insecure_ignore_host_key", Type: field.TypeBool, Nullable: true},
+	}
+	// CredentialProfilesTable holds the schema information for the "credential_profiles" table.
+	CredentialProfilesTable = &schema.Table{
+		Name:       "credential_profiles",
+		Columns:    CredentialProfilesColumns,
+		PrimaryKey: []*schema.Column{CredentialProfilesColumns[0]},
+	}
 	// DeviceStatusColumns holds the columns for the "device_status" table.
 	DeviceStatusColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"STATUS_UNSPECIFIED", "STATUS_DEVICE_DOWN", "STATUS_DEVICE_UNHEALTHY", "STATUS_DEVICE_UP"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"STATUS_UNSPECIFIED", "STATUS_DEVICE_DOWN", "STATUS_DEVICE_UNHEALTHY", "STATUS_DEVICE_UP", "STATUS_DEVICE_UNREACHABLE"}},
 		{Name: "last_seen", Type: field.TypeString, Nullable: true},
 		{Name: "consequential_failed_connectivity_attempts", Type: field.TypeInt32},
+		{Name: "next_poll", Type: field.TypeInt64, Nullable: true},
+		{Name: "pending_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"STATUS_UNSPECIFIED", "STATUS_DEVICE_DOWN", "STATUS_DEVICE_UNHEALTHY", "STATUS_DEVICE_UP", "STATUS_DEVICE_UNREACHABLE"}},
+		{Name: "pending_readings", Type: field.TypeInt32, Nullable: true},
+		{Name: "flapping", Type: field.TypeBool, Nullable: true},
+		{Name: "in_maintenance", Type: field.TypeBool, Nullable: true},
+		{Name: "answered_endpoint_id", Type: field.TypeString, Nullable: true},
+		{Name: "answered_protocol", Type: field.TypeEnum, Nullable: true, Enums: []string{"PROTOCOL_UNSPECIFIED", "PROTOCOL_SNMP", "PROTOCOL_NETCONF", "PROTOCOL_RESTCONF", "PROTOCOL_OPEN_V_SWITCH", "PROTOCOL_GNMI", "PROTOCOL_TCP_CONNECT", "PROTOCOL_ICMP", "PROTOCOL_CUSTOM"}},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
 		{Name: "device_status_network_device", Type: field.TypeString, Nullable: true},
 	}
 	// DeviceStatusTable holds the schema information for the "device_status" table.
@@ -24,7 +55,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "device_status_network_devices_network_device",
-				Columns:    []*schema.Column{DeviceStatusColumns[4]},
+				Columns:    []*schema.Column{DeviceStatusColumns[12]},
 				RefColumns: []*schema.Column{NetworkDevicesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -35,7 +66,15 @@ var (
 		{Name: "id", Type: field.TypeString},
 		{Name: "host", Type: field.TypeString},
 		{Name: "port", Type: field.TypeString},
-		{Name: "protocol", Type: field.TypeEnum, Enums: []string{"PROTOCOL_UNSPECIFIED", "PROTOCOL_SNMP", "PROTOCOL_NETCONF", "PROTOCOL_RESTCONF", "PROTOCOL_OPEN_V_SWITCH"}},
+		{Name: "protocol", Type: field.TypeEnum, Enums: []string{"PROTOCOL_UNSPECIFIED", "PROTOCOL_SNMP", "PROTOCOL_NETCONF", "PROTOCOL_RESTCONF", "PROTOCOL_OPEN_V_SWITCH", "PROTOCOL_GNMI", "PROTOCOL_TCP_CONNECT", "PROTOCOL_ICMP", "PROTOCOL_CUSTOM"}},
+		{Name: "tls_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "tls_ca_bundle", Type: field.TypeString, Nullable: true},
+		{Name: "tls_server_name", Type: field.TypeString, Nullable: true},
+		{Name: "tls_insecure_skip_verify", Type: field.TypeBool, Nullable: true},
+		{Name: "preference", Type: field.TypeInt32, Nullable: true},
+		{Name: "protocol_name", Type: field.TypeString, Nullable: true},
+		{Name: "endpoint_credential_profile", Type: field.TypeString, Nullable: true},
+		{Name: "endpoint_health", Type: field.TypeString, Nullable: true},
 		{Name: "network_device_endpoints", Type: field.TypeString, Nullable: true},
 	}
 	// EndpointsTable holds the schema information for the "endpoints" table.
@@ -44,22 +83,109 @@ var (
 		Columns:    EndpointsColumns,
 		PrimaryKey: []*schema.Column{EndpointsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "endpoints_credential_profiles_credential_profile",
+				Columns:    []*schema.Column{EndpointsColumns[10]},
+				RefColumns: []*schema.Column{CredentialProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "endpoints_endpoint_healths_health",
+				Columns:    []*schema.Column{EndpointsColumns[11]},
+				RefColumns: []*schema.Column{EndpointHealthsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "endpoints_network_devices_endpoints",
-				Columns:    []*schema.Column{EndpointsColumns[4]},
+				Columns:    []*schema.Column{EndpointsColumns[12]},
 				RefColumns: []*schema.Column{NetworkDevicesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// EndpointHealthsColumns holds the columns for the "endpoint_healths" table.
+	EndpointHealthsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "last_success", Type: field.TypeInt64, Nullable: true},
+		{Name: "last_failure", Type: field.TypeInt64, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "latency", Type: field.TypeInt64, Nullable: true},
+		{Name: "failure_count", Type: field.TypeInt32, Nullable: true},
+	}
+	// EndpointHealthsTable holds the schema information for the "endpoint_healths" table.
+	EndpointHealthsTable = &schema.Table{
+		Name:       "endpoint_healths",
+		Columns:    EndpointHealthsColumns,
+		PrimaryKey: []*schema.Column{EndpointHealthsColumns[0]},
+	}
+	// LatencySamplesColumns holds the columns for the "latency_samples" table.
+	LatencySamplesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "timestamp", Type: field.TypeInt64},
+		{Name: "latency", Type: field.TypeInt64},
+		{Name: "latency_sample_network_device", Type: field.TypeString, Nullable: true},
+		{Name: "latency_sample_endpoint", Type: field.TypeString, Nullable: true},
+	}
+	// LatencySamplesTable holds the schema information for the "latency_samples" table.
+	LatencySamplesTable = &schema.Table{
+		Name:       "latency_samples",
+		Columns:    LatencySamplesColumns,
+		PrimaryKey: []*schema.Column{LatencySamplesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "latency_samples_network_devices_network_device",
+				Columns:    []*schema.Column{LatencySamplesColumns[3]},
+				RefColumns: []*schema.Column{NetworkDevicesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "latency_samples_endpoints_endpoint",
+				Columns:    []*schema.Column{LatencySamplesColumns[4]},
+				RefColumns: []*schema.Column{EndpointsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// LeasesColumns holds the columns for the "leases" table.
+	LeasesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "holder", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeInt64},
+	}
+	// LeasesTable holds the schema information for the "leases" table.
+	LeasesTable = &schema.Table{
+		Name:       "leases",
+		Columns:    LeasesColumns,
+		PrimaryKey: []*schema.Column{LeasesColumns[0]},
+	}
+	// MaintenanceWindowsColumns holds the columns for the "maintenance_windows" table.
+	MaintenanceWindowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "group", Type: field.TypeString, Nullable: true},
+		{Name: "site", Type: field.TypeString, Nullable: true},
+		{Name: "starts_at", Type: field.TypeInt64},
+		{Name: "ends_at", Type: field.TypeInt64},
+		{Name: "recurrence", Type: field.TypeString, Nullable: true},
+	}
+	// MaintenanceWindowsTable holds the schema information for the "maintenance_windows" table.
+	MaintenanceWindowsTable = &schema.Table{
+		Name:       "maintenance_windows",
+		Columns:    MaintenanceWindowsColumns,
+		PrimaryKey: []*schema.Column{MaintenanceWindowsColumns[0]},
+	}
 	// NetworkDevicesColumns holds the columns for the "network_devices" table.
 	NetworkDevicesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
 		{Name: "vendor", Type: field.TypeEnum, Enums: []string{"VENDOR_UNSPECIFIED", "VENDOR_UBIQUITI", "VENDOR_CISCO", "VENDOR_JUNIPER"}},
 		{Name: "model", Type: field.TypeString},
 		{Name: "hw_version", Type: field.TypeString, Nullable: true},
+		{Name: "poll_interval", Type: field.TypeInt32, Nullable: true},
+		{Name: "group", Type: field.TypeString, Nullable: true},
+		{Name: "site", Type: field.TypeString, Nullable: true},
 		{Name: "network_device_sw_version", Type: field.TypeString, Nullable: true},
 		{Name: "network_device_fw_version", Type: field.TypeString, Nullable: true},
+		{Name: "network_device_children", Type: field.TypeString, Nullable: true},
 	}
 	// NetworkDevicesTable holds the schema information for the "network_devices" table.
 	NetworkDevicesTable = &schema.Table{
@@ -69,16 +195,69 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "network_devices_versions_sw_version",
-				Columns:    []*schema.Column{NetworkDevicesColumns[4]},
+				Columns:    []*schema.Column{NetworkDevicesColumns[7]},
 				RefColumns: []*schema.Column{VersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "network_devices_versions_fw_version",
-				Columns:    []*schema.Column{NetworkDevicesColumns[5]},
+				Columns:    []*schema.Column{NetworkDevicesColumns[8]},
 				RefColumns: []*schema.Column{VersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "network_devices_network_devices_children",
+				Columns:    []*schema.Column{NetworkDevicesColumns[9]},
+				RefColumns: []*schema.Column{NetworkDevicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PollingDefaultsColumns holds the columns for the "polling_defaults" table.
+	PollingDefaultsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"POLLING_SCOPE_UNSPECIFIED", "POLLING_SCOPE_GROUP", "POLLING_SCOPE_SITE"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "poll_interval", Type: field.TypeInt32},
+	}
+	// PollingDefaultsTable holds the schema information for the "polling_defaults" table.
+	PollingDefaultsTable = &schema.Table{
+		Name:       "polling_defaults",
+		Columns:    PollingDefaultsColumns,
+		PrimaryKey: []*schema.Column{PollingDefaultsColumns[0]},
+	}
+	// ReplicaHeartbeatsColumns holds the columns for the "replica_heartbeats" table.
+	ReplicaHeartbeatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "last_seen", Type: field.TypeInt64},
+	}
+	// ReplicaHeartbeatsTable holds the schema information for the "replica_heartbeats" table.
+	ReplicaHeartbeatsTable = &schema.Table{
+		Name:       "replica_heartbeats",
+		Columns:    ReplicaHeartbeatsColumns,
+		PrimaryKey: []*schema.Column{ReplicaHeartbeatsColumns[0]},
+	}
+	// StatusEventsColumns holds the columns for the "status_events" table.
+	StatusEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "old_status", Type: field.TypeEnum, Enums: []string{"STATUS_UNSPECIFIED", "STATUS_DEVICE_DOWN", "STATUS_DEVICE_UNHEALTHY", "STATUS_DEVICE_UP", "STATUS_DEVICE_UNREACHABLE"}},
+		{Name: "new_status", Type: field.TypeEnum, Enums: []string{"STATUS_UNSPECIFIED", "STATUS_DEVICE_DOWN", "STATUS_DEVICE_UNHEALTHY", "STATUS_DEVICE_UP", "STATUS_DEVICE_UNREACHABLE"}},
+		{Name: "timestamp", Type: field.TypeInt64},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "status_event_network_device", Type: field.TypeString, Nullable: true},
+	}
+	// StatusEventsTable holds the schema information for the "status_events" table.
+	StatusEventsTable = &schema.Table{
+		Name:       "status_events",
+		Columns:    StatusEventsColumns,
+		PrimaryKey: []*schema.Column{StatusEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "status_events_network_devices_network_device",
+				Columns:    []*schema.Column{StatusEventsColumns[5]},
+				RefColumns: []*schema.Column{NetworkDevicesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 	}
 	// VersionsColumns holds the columns for the "versions" table.
@@ -93,18 +272,60 @@ var (
 		Columns:    VersionsColumns,
 		PrimaryKey: []*schema.Column{VersionsColumns[0]},
 	}
+	// MaintenanceWindowNetworkDevicesColumns holds the columns for the "maintenance_window_network_devices" table.
+	MaintenanceWindowNetworkDevicesColumns = []*schema.Column{
+		{Name: "maintenance_window_id", Type: field.TypeString},
+		{Name: "network_device_id", Type: field.TypeString},
+	}
+	// MaintenanceWindowNetworkDevicesTable holds the schema information for the "maintenance_window_network_devices" table.
+	MaintenanceWindowNetworkDevicesTable = &schema.Table{
+		Name:       "maintenance_window_network_devices",
+		Columns:    MaintenanceWindowNetworkDevicesColumns,
+		PrimaryKey: []*schema.Column{MaintenanceWindowNetworkDevicesColumns[0], MaintenanceWindowNetworkDevicesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "maintenance_window_network_devices_maintenance_window_id",
+				Columns:    []*schema.Column{MaintenanceWindowNetworkDevicesColumns[0]},
+				RefColumns: []*schema.Column{MaintenanceWindowsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "maintenance_window_network_devices_network_device_id",
+				Columns:    []*schema.Column{MaintenanceWindowNetworkDevicesColumns[1]},
+				RefColumns: []*schema.Column{NetworkDevicesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		CredentialProfilesTable,
 		DeviceStatusTable,
 		EndpointsTable,
+		EndpointHealthsTable,
+		LatencySamplesTable,
+		LeasesTable,
+		MaintenanceWindowsTable,
 		NetworkDevicesTable,
+		PollingDefaultsTable,
+		ReplicaHeartbeatsTable,
+		StatusEventsTable,
 		VersionsTable,
+		MaintenanceWindowNetworkDevicesTable,
 	}
 )
 
 func init() {
 	DeviceStatusTable.ForeignKeys[0].RefTable = NetworkDevicesTable
-	EndpointsTable.ForeignKeys[0].RefTable = NetworkDevicesTable
+	EndpointsTable.ForeignKeys[0].RefTable = CredentialProfilesTable
+	EndpointsTable.ForeignKeys[1].RefTable = EndpointHealthsTable
+	EndpointsTable.ForeignKeys[2].RefTable = NetworkDevicesTable
+	LatencySamplesTable.ForeignKeys[0].RefTable = NetworkDevicesTable
+	LatencySamplesTable.ForeignKeys[1].RefTable = EndpointsTable
 	NetworkDevicesTable.ForeignKeys[0].RefTable = VersionsTable
 	NetworkDevicesTable.ForeignKeys[1].RefTable = VersionsTable
+	NetworkDevicesTable.ForeignKeys[2].RefTable = NetworkDevicesTable
+	StatusEventsTable.ForeignKeys[0].RefTable = NetworkDevicesTable
+	MaintenanceWindowNetworkDevicesTable.ForeignKeys[0].RefTable = MaintenanceWindowsTable
+	MaintenanceWindowNetworkDevicesTable.ForeignKeys[1].RefTable = NetworkDevicesTable
 }
@@ -10,10 +10,18 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
+	"github.com/eroshiva/trade-show-poc/internal/ent/latencysample"
+	"github.com/eroshiva/trade-show-poc/internal/ent/lease"
+	"github.com/eroshiva/trade-show-poc/internal/ent/maintenancewindow"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
 	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+	"github.com/eroshiva/trade-show-poc/internal/ent/replicaheartbeat"
+	"github.com/eroshiva/trade-show-poc/internal/ent/statusevent"
 	"github.com/eroshiva/trade-show-poc/internal/ent/version"
 )
 
@@ -26,41 +34,7344 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeDeviceStatus  = "DeviceStatus"
-	TypeEndpoint      = "Endpoint"
-	TypeNetworkDevice = "NetworkDevice"
-	TypeVersion       = "Version"
+	TypeCredentialProfile = "CredentialProfile"
+	TypeDeviceStatus      = "DeviceStatus"
+	TypeEndpoint          = "Endpoint"
+	TypeEndpointHealth    = "EndpointHealth"
+	TypeLatencySample     = "LatencySample"
+	TypeLease             = "Lease"
+	TypeMaintenanceWindow = "MaintenanceWindow"
+	TypeNetworkDevice     = "NetworkDevice"
+	TypePollingDefault    = "PollingDefault"
+	TypeReplicaHeartbeat  = "ReplicaHeartbeat"
+	TypeStatusEvent       = "StatusEvent"
+	TypeVersion           = "Version"
 )
 
-// DeviceStatusMutation represents an operation that mutates the DeviceStatus nodes in the graph.
-type DeviceStatusMutation struct {
+// CredentialProfileMutation represents an operation that mutates the CredentialProfile nodes in the graph.
+type CredentialProfileMutation struct {
 	config
-	op                                            Op
-	typ                                           string
-	id                                            *string
-	status                                        *devicestatus.Status
-	last_seen                                     *string
-	consequential_failed_connectivity_attempts    *int32
-	addconsequential_failed_connectivity_attempts *int32
-	clearedFields                                 map[string]struct{}
-	network_device                                *string
-	clearednetwork_device                         bool
-	done                                          bool
-	oldValue                                      func(context.Context) (*DeviceStatus, error)
-	predicates                                    []predicate.DeviceStatus
+	op                           Op
+	typ                          string
+	id                           *string
+	name                         *string
+	username                     *string
+	password                     *string
+	snmp_community               *string
+	private_key                  *string
+	token                        *string
+	snmp_priv_passphrase         *string
+	tls_client_key               *string
+	snmp_auth_protocol           *string
+	snmp_priv_protocol           *string
+	tls_client_certificate       *string
+	ssh_known_hosts              *string
+	ssh_insecure_ignore_host_key *bool
+	clearedFields                map[string]struct{}
+	done                         bool
+	oldValue                     func(context.Context) (*CredentialProfile, error)
+	predicates                   []predicate.CredentialProfile
+}
+
+var _ ent.Mutation = (*CredentialProfileMutation)(nil)
+
+// credentialprofileOption allows management of the mutation configuration using functional options.
+type credentialprofileOption func(*CredentialProfileMutation)
+
+// newCredentialProfileMutation creates new mutation for the CredentialProfile entity.
+func newCredentialProfileMutation(c config, op Op, opts ...credentialprofileOption) *CredentialProfileMutation {
+	m := &CredentialProfileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCredentialProfile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCredentialProfileID sets the ID field of the mutation.
+func withCredentialProfileID(id string) credentialprofileOption {
+	return func(m *CredentialProfileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CredentialProfile
+		)
+		m.oldValue = func(ctx context.Context) (*CredentialProfile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CredentialProfile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCredentialProfile sets the old CredentialProfile of the mutation.
+func withCredentialProfile(node *CredentialProfile) credentialprofileOption {
+	return func(m *CredentialProfileMutation) {
+		m.oldValue = func(context.Context) (*CredentialProfile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CredentialProfileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CredentialProfileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CredentialProfile entities.
+func (m *CredentialProfileMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CredentialProfileMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CredentialProfileMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CredentialProfile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *CredentialProfileMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CredentialProfileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CredentialProfileMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *CredentialProfileMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *CredentialProfileMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ClearUsername clears the value of the "username" field.
+func (m *CredentialProfileMutation) ClearUsername() {
+	m.username = nil
+	m.clearedFields[credentialprofile.FieldUsername] = struct{}{}
+}
+
+// UsernameCleared returns if the "username" field was cleared in this mutation.
+func (m *CredentialProfileMutation) UsernameCleared() bool {
+	_, ok := m.clearedFields[credentialprofile.FieldUsername]
+	return ok
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *CredentialProfileMutation) ResetUsername() {
+	m.username = nil
+	delete(m.clearedFields, credentialprofile.FieldUsername)
+}
+
+// SetPassword sets the "password" field.
+func (m *CredentialProfileMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *CredentialProfileMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ClearPassword clears the value of the "password" field.
+func (m *CredentialProfileMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[credentialprofile.FieldPassword] = struct{}{}
+}
+
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *CredentialProfileMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[credentialprofile.FieldPassword]
+	return ok
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *CredentialProfileMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, credentialprofile.FieldPassword)
+}
+
+// SetSnmpCommunity sets the "snmp_community" field.
+func (m *CredentialProfileMutation) SetSnmpCommunity(s string) {
+	m.snmp_community = &s
+}
+
+// SnmpCommunity returns the value of the "snmp_community" field in the mutation.
+func (m *CredentialProfileMutation) SnmpCommunity() (r string, exists bool) {
+	v := m.snmp_community
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnmpCommunity returns the old "snmp_community" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldSnmpCommunity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnmpCommunity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnmpCommunity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnmpCommunity: %w", err)
+	}
+	return oldValue.SnmpCommunity, nil
+}
+
+// ClearSnmpCommunity clears the value of the "snmp_community" field.
+func (m *CredentialProfileMutation) ClearSnmpCommunity() {
+	m.snmp_community = nil
+	m.clearedFields[credentialprofile.FieldSnmpCommunity] = struct{}{}
+}
+
+// SnmpCommunityCleared returns if the "snmp_community" field was cleared in this mutation.
+func (m *CredentialProfileMutation) SnmpCommunityCleared() bool {
+	_, ok := m.clearedFields[credentialprofile.FieldSnmpCommunity]
+	return ok
+}
+
+// ResetSnmpCommunity resets all changes to the "snmp_community" field.
+func (m *CredentialProfileMutation) ResetSnmpCommunity() {
+	m.snmp_community = nil
+	delete(m.clearedFields, credentialprofile.FieldSnmpCommunity)
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (m *CredentialProfileMutation) SetPrivateKey(s string) {
+	m.private_key = &s
+}
+
+// PrivateKey returns the value of the "private_key" field in the mutation.
+func (m *CredentialProfileMutation) PrivateKey() (r string, exists bool) {
+	v := m.private_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivateKey returns the old "private_key" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldPrivateKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+	}
+	return oldValue.PrivateKey, nil
+}
+
+// ClearPrivateKey clears the value of the "private_key" field.
+func (m *CredentialProfileMutation) ClearPrivateKey() {
+	m.private_key = nil
+	m.clearedFields[credentialprofile.FieldPrivateKey] = struct{}{}
+}
+
+// PrivateKeyCleared returns if the "private_key" field was cleared in this mutation.
+func (m *CredentialProfileMutation) PrivateKeyCleared() bool {
+	_, ok := m.clearedFields[credentialprofile.FieldPrivateKey]
+	return ok
+}
+
+// ResetPrivateKey resets all changes to the "private_key" field.
+func (m *CredentialProfileMutation) ResetPrivateKey() {
+	m.private_key = nil
+	delete(m.clearedFields, credentialprofile.FieldPrivateKey)
+}
+
+// SetToken sets the "token" field.
+func (m *CredentialProfileMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *CredentialProfileMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ClearToken clears the value of the "token" field.
+func (m *CredentialProfileMutation) ClearToken() {
+	m.token = nil
+	m.clearedFields[credentialprofile.FieldToken] = struct{}{}
+}
+
+// TokenCleared returns if the "token" field was cleared in this mutation.
+func (m *CredentialProfileMutation) TokenCleared() bool {
+	_, ok := m.clearedFields[credentialprofile.FieldToken]
+	return ok
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *CredentialProfileMutation) ResetToken() {
+	m.token = nil
+	delete(m.clearedFields, credentialprofile.FieldToken)
+}
+
+// SetSnmpPrivPassphrase sets the "snmp_priv_passphrase" field.
+func (m *CredentialProfileMutation) SetSnmpPrivPassphrase(s string) {
+	m.snmp_priv_passphrase = &s
+}
+
+// SnmpPrivPassphrase returns the value of the "snmp_priv_passphrase" field in the mutation.
+func (m *CredentialProfileMutation) SnmpPrivPassphrase() (r string, exists bool) {
+	v := m.snmp_priv_passphrase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnmpPrivPassphrase returns the old "snmp_priv_passphrase" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldSnmpPrivPassphrase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnmpPrivPassphrase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnmpPrivPassphrase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnmpPrivPassphrase: %w", err)
+	}
+	return oldValue.SnmpPrivPassphrase, nil
+}
+
+// ClearSnmpPrivPassphrase clears the value of the "snmp_priv_passphrase" field.
+func (m *CredentialProfileMutation) ClearSnmpPrivPassphrase() {
+	m.snmp_priv_passphrase = nil
+	m.clearedFields[credentialprofile.FieldSnmpPrivPassphrase] = struct{}{}
+}
+
+// SnmpPrivPassphraseCleared returns if the "snmp_priv_passphrase" field was cleared in this mutation.
+func (m *CredentialProfileMutation) SnmpPrivPassphraseCleared() bool {
+	_, ok := m.clearedFields[credentialprofile.FieldSnmpPrivPassphrase]
+	return ok
+}
+
+// ResetSnmpPrivPassphrase resets all changes to the "snmp_priv_passphrase" field.
+func (m *CredentialProfileMutation) ResetSnmpPrivPassphrase() {
+	m.snmp_priv_passphrase = nil
+	delete(m.clearedFields, credentialprofile.FieldSnmpPrivPassphrase)
+}
+
+// SetTLSClientKey sets the "tls_client_key" field.
+func (m *CredentialProfileMutation) SetTLSClientKey(s string) {
+	m.tls_client_key = &s
+}
+
+// TLSClientKey returns the value of the "tls_client_key" field in the mutation.
+func (m *CredentialProfileMutation) TLSClientKey() (r string, exists bool) {
+	v := m.tls_client_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSClientKey returns the old "tls_client_key" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldTLSClientKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSClientKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSClientKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSClientKey: %w", err)
+	}
+	return oldValue.TLSClientKey, nil
+}
+
+// ClearTLSClientKey clears the value of the "tls_client_key" field.
+func (m *CredentialProfileMutation) ClearTLSClientKey() {
+	m.tls_client_key = nil
+	m.clearedFields[credentialprofile.FieldTLSClientKey] = struct{}{}
+}
+
+// TLSClientKeyCleared returns if the "tls_client_key" field was cleared in this mutation.
+func (m *CredentialProfileMutation) TLSClientKeyCleared() bool {
+	_, ok := m.clearedFields[credentialprofile.FieldTLSClientKey]
+	return ok
+}
+
+// ResetTLSClientKey resets all changes to the "tls_client_key" field.
+func (m *CredentialProfileMutation) ResetTLSClientKey() {
+	m.tls_client_key = nil
+	delete(m.clearedFields, credentialprofile.FieldTLSClientKey)
+}
+
+// SetSnmpAuthProtocol sets the "snmp_auth_protocol" field.
+func (m *CredentialProfileMutation) SetSnmpAuthProtocol(s string) {
+	m.snmp_auth_protocol = &s
+}
+
+// SnmpAuthProtocol returns the value of the "snmp_auth_protocol" field in the mutation.
+func (m *CredentialProfileMutation) SnmpAuthProtocol() (r string, exists bool) {
+	v := m.snmp_auth_protocol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnmpAuthProtocol returns the old "snmp_auth_protocol" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldSnmpAuthProtocol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnmpAuthProtocol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnmpAuthProtocol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnmpAuthProtocol: %w", err)
+	}
+	return oldValue.SnmpAuthProtocol, nil
+}
+
+// ClearSnmpAuthProtocol clears the value of the "snmp_auth_protocol" field.
+func (m *CredentialProfileMutation) ClearSnmpAuthProtocol() {
+	m.snmp_auth_protocol = nil
+	m.clearedFields[credentialprofile.FieldSnmpAuthProtocol] = struct{}{}
+}
+
+// SnmpAuthProtocolCleared returns if the "snmp_auth_protocol" field was cleared in this mutation.
+func (m *CredentialProfileMutation) SnmpAuthProtocolCleared() bool {
+	_, ok := m.clearedFields[credentialprofile.FieldSnmpAuthProtocol]
+	return ok
+}
+
+// ResetSnmpAuthProtocol resets all changes to the "snmp_auth_protocol" field.
+func (m *CredentialProfileMutation) ResetSnmpAuthProtocol() {
+	m.snmp_auth_protocol = nil
+	delete(m.clearedFields, credentialprofile.FieldSnmpAuthProtocol)
+}
+
+// SetSnmpPrivProtocol sets the "snmp_priv_protocol" field.
+func (m *CredentialProfileMutation) SetSnmpPrivProtocol(s string) {
+	m.snmp_priv_protocol = &s
+}
+
+// SnmpPrivProtocol returns the value of the "snmp_priv_protocol" field in the mutation.
+func (m *CredentialProfileMutation) SnmpPrivProtocol() (r string, exists bool) {
+	v := m.snmp_priv_protocol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnmpPrivProtocol returns the old "snmp_priv_protocol" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldSnmpPrivProtocol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnmpPrivProtocol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnmpPrivProtocol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnmpPrivProtocol: %w", err)
+	}
+	return oldValue.SnmpPrivProtocol, nil
+}
+
+// ClearSnmpPrivProtocol clears the value of the "snmp_priv_protocol" field.
+func (m *CredentialProfileMutation) ClearSnmpPrivProtocol() {
+	m.snmp_priv_protocol = nil
+	m.clearedFields[credentialprofile.FieldSnmpPrivProtocol] = struct{}{}
+}
+
+// SnmpPrivProtocolCleared returns if the "snmp_priv_protocol" field was cleared in this mutation.
+func (m *CredentialProfileMutation) SnmpPrivProtocolCleared() bool {
+	_, ok := m.clearedFields[credentialprofile.FieldSnmpPrivProtocol]
+	return ok
+}
+
+// ResetSnmpPrivProtocol resets all changes to the "snmp_priv_protocol" field.
+func (m *CredentialProfileMutation) ResetSnmpPrivProtocol() {
+	m.snmp_priv_protocol = nil
+	delete(m.clearedFields, credentialprofile.FieldSnmpPrivProtocol)
+}
+
+// SetTLSClientCertificate sets the "tls_client_certificate" field.
+func (m *CredentialProfileMutation) SetTLSClientCertificate(s string) {
+	m.tls_client_certificate = &s
+}
+
+// TLSClientCertificate returns the value of the "tls_client_certificate" field in the mutation.
+func (m *CredentialProfileMutation) TLSClientCertificate() (r string, exists bool) {
+	v := m.tls_client_certificate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSClientCertificate returns the old "tls_client_certificate" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldTLSClientCertificate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSClientCertificate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSClientCertificate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSClientCertificate: %w", err)
+	}
+	return oldValue.TLSClientCertificate, nil
+}
+
+// ClearTLSClientCertificate clears the value of the "tls_client_certificate" field.
+func (m *CredentialProfileMutation) ClearTLSClientCertificate() {
+	m.tls_client_certificate = nil
+	m.clearedFields[credentialprofile.FieldTLSClientCertificate] = struct{}{}
+}
+
+// TLSClientCertificateCleared returns if the "tls_client_certificate" field was cleared in this mutation.
+func (m *CredentialProfileMutation) TLSClientCertificateCleared() bool {
+	_, ok := m.clearedFields[credentialprofile.FieldTLSClientCertificate]
+	return ok
+}
+
+// ResetTLSClientCertificate resets all changes to the "tls_client_certificate" field.
+func (m *CredentialProfileMutation) ResetTLSClientCertificate() {
+	m.tls_client_certificate = nil
+	delete(m.clearedFields, credentialprofile.FieldTLSClientCertificate)
+}
+
+// SetSSHKnownHosts sets the "ssh_known_hosts" field.
+func (m *CredentialProfileMutation) SetSSHKnownHosts(s string) {
+	m.ssh_known_hosts = &s
+}
+
+// SSHKnownHosts returns the value of the "ssh_known_hosts" field in the mutation.
+func (m *CredentialProfileMutation) SSHKnownHosts() (r string, exists bool) {
+	v := m.ssh_known_hosts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSSHKnownHosts returns the old "ssh_known_hosts" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldSSHKnownHosts(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSSHKnownHosts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSSHKnownHosts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSSHKnownHosts: %w", err)
+	}
+	return oldValue.SSHKnownHosts, nil
+}
+
+// ClearSSHKnownHosts clears the value of the "ssh_known_hosts" field.
+func (m *CredentialProfileMutation) ClearSSHKnownHosts() {
+	m.ssh_known_hosts = nil
+	m.clearedFields[credentialprofile.FieldSSHKnownHosts] = struct{}{}
+}
+
+// SSHKnownHostsCleared returns if the "ssh_known_hosts" field was cleared in this mutation.
+func (m *CredentialProfileMutation) SSHKnownHostsCleared() bool {
+	_, ok := m.clearedFields[credentialprofile.FieldSSHKnownHosts]
+	return ok
+}
+
+// ResetSSHKnownHosts resets all changes to the "ssh_known_hosts" field.
+func (m *CredentialProfileMutation) ResetSSHKnownHosts() {
+	m.ssh_known_hosts = nil
+	delete(m.clearedFields, credentialprofile.FieldSSHKnownHosts)
+}
+
+// SetSSHInsecureIgnoreHostKey sets the "ssh_insecure_ignore_host_key" field.
+func (m *CredentialProfileMutation) SetSSHInsecureIgnoreHostKey(b bool) {
+	m.ssh_insecure_ignore_host_key = &b
+}
+
+// SSHInsecureIgnoreHostKey returns the value of the "ssh_insecure_ignore_host_key" field in the mutation.
+func (m *CredentialProfileMutation) SSHInsecureIgnoreHostKey() (r bool, exists bool) {
+	v := m.ssh_insecure_ignore_host_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSSHInsecureIgnoreHostKey returns the old "ssh_insecure_ignore_host_key" field's value of the CredentialProfile entity.
+// If the CredentialProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialProfileMutation) OldSSHInsecureIgnoreHostKey(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSSHInsecureIgnoreHostKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSSHInsecureIgnoreHostKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSSHInsecureIgnoreHostKey: %w", err)
+	}
+	return oldValue.SSHInsecureIgnoreHostKey, nil
+}
+
+// ClearSSHInsecureIgnoreHostKey clears the value of the "ssh_insecure_ignore_host_key" field.
+func (m *CredentialProfileMutation) ClearSSHInsecureIgnoreHostKey() {
+	m.ssh_insecure_ignore_host_key = nil
+	m.clearedFields[credentialprofile.FieldSSHInsecureIgnoreHostKey] = struct{}{}
+}
+
+// SSHInsecureIgnoreHostKeyCleared returns if the "ssh_insecure_ignore_host_key" field was cleared in this mutation.
+func (m *CredentialProfileMutation) SSHInsecureIgnoreHostKeyCleared() bool {
+	_, ok := m.clearedFields[credentialprofile.FieldSSHInsecureIgnoreHostKey]
+	return ok
+}
+
+// ResetSSHInsecureIgnoreHostKey resets all changes to the "ssh_insecure_ignore_host_key" field.
+func (m *CredentialProfileMutation) ResetSSHInsecureIgnoreHostKey() {
+	m.ssh_insecure_ignore_host_key = nil
+	delete(m.clearedFields, credentialprofile.FieldSSHInsecureIgnoreHostKey)
+}
+
+// Where appends a list predicates to the CredentialProfileMutation builder.
+func (m *CredentialProfileMutation) Where(ps ...predicate.CredentialProfile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CredentialProfileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CredentialProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CredentialProfile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CredentialProfileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CredentialProfileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CredentialProfile).
+func (m *CredentialProfileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CredentialProfileMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.name != nil {
+		fields = append(fields, credentialprofile.FieldName)
+	}
+	if m.username != nil {
+		fields = append(fields, credentialprofile.FieldUsername)
+	}
+	if m.password != nil {
+		fields = append(fields, credentialprofile.FieldPassword)
+	}
+	if m.snmp_community != nil {
+		fields = append(fields, credentialprofile.FieldSnmpCommunity)
+	}
+	if m.private_key != nil {
+		fields = append(fields, credentialprofile.FieldPrivateKey)
+	}
+	if m.token != nil {
+		fields = append(fields, credentialprofile.FieldToken)
+	}
+	if m.snmp_priv_passphrase != nil {
+		fields = append(fields, credentialprofile.FieldSnmpPrivPassphrase)
+	}
+	if m.tls_client_key != nil {
+		fields = append(fields, credentialprofile.FieldTLSClientKey)
+	}
+	if m.snmp_auth_protocol != nil {
+		fields = append(fields, credentialprofile.FieldSnmpAuthProtocol)
+	}
+	if m.snmp_priv_protocol != nil {
+		fields = append(fields, credentialprofile.FieldSnmpPrivProtocol)
+	}
+	if m.tls_client_certificate != nil {
+		fields = append(fields, credentialprofile.FieldTLSClientCertificate)
+	}
+	if m.ssh_known_hosts != nil {
+		fields = append(fields, credentialprofile.FieldSSHKnownHosts)
+	}
+	if m.ssh_insecure_ignore_host_key != nil {
+		fields = append(fields, credentialprofile.FieldSSHInsecureIgnoreHostKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CredentialProfileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case credentialprofile.FieldName:
+		return m.Name()
+	case credentialprofile.FieldUsername:
+		return m.Username()
+	case credentialprofile.FieldPassword:
+		return m.Password()
+	case credentialprofile.FieldSnmpCommunity:
+		return m.SnmpCommunity()
+	case credentialprofile.FieldPrivateKey:
+		return m.PrivateKey()
+	case credentialprofile.FieldToken:
+		return m.Token()
+	case credentialprofile.FieldSnmpPrivPassphrase:
+		return m.SnmpPrivPassphrase()
+	case credentialprofile.FieldTLSClientKey:
+		return m.TLSClientKey()
+	case credentialprofile.FieldSnmpAuthProtocol:
+		return m.SnmpAuthProtocol()
+	case credentialprofile.FieldSnmpPrivProtocol:
+		return m.SnmpPrivProtocol()
+	case credentialprofile.FieldTLSClientCertificate:
+		return m.TLSClientCertificate()
+	case credentialprofile.FieldSSHKnownHosts:
+		return m.SSHKnownHosts()
+	case credentialprofile.FieldSSHInsecureIgnoreHostKey:
+		return m.SSHInsecureIgnoreHostKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CredentialProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case credentialprofile.FieldName:
+		return m.OldName(ctx)
+	case credentialprofile.FieldUsername:
+		return m.OldUsername(ctx)
+	case credentialprofile.FieldPassword:
+		return m.OldPassword(ctx)
+	case credentialprofile.FieldSnmpCommunity:
+		return m.OldSnmpCommunity(ctx)
+	case credentialprofile.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
+	case credentialprofile.FieldToken:
+		return m.OldToken(ctx)
+	case credentialprofile.FieldSnmpPrivPassphrase:
+		return m.OldSnmpPrivPassphrase(ctx)
+	case credentialprofile.FieldTLSClientKey:
+		return m.OldTLSClientKey(ctx)
+	case credentialprofile.FieldSnmpAuthProtocol:
+		return m.OldSnmpAuthProtocol(ctx)
+	case credentialprofile.FieldSnmpPrivProtocol:
+		return m.OldSnmpPrivProtocol(ctx)
+	case credentialprofile.FieldTLSClientCertificate:
+		return m.OldTLSClientCertificate(ctx)
+	case credentialprofile.FieldSSHKnownHosts:
+		return m.OldSSHKnownHosts(ctx)
+	case credentialprofile.FieldSSHInsecureIgnoreHostKey:
+		return m.OldSSHInsecureIgnoreHostKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown CredentialProfile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CredentialProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case credentialprofile.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case credentialprofile.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case credentialprofile.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case credentialprofile.FieldSnmpCommunity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnmpCommunity(v)
+		return nil
+	case credentialprofile.FieldPrivateKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrivateKey(v)
+		return nil
+	case credentialprofile.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case credentialprofile.FieldSnmpPrivPassphrase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnmpPrivPassphrase(v)
+		return nil
+	case credentialprofile.FieldTLSClientKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSClientKey(v)
+		return nil
+	case credentialprofile.FieldSnmpAuthProtocol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnmpAuthProtocol(v)
+		return nil
+	case credentialprofile.FieldSnmpPrivProtocol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnmpPrivProtocol(v)
+		return nil
+	case credentialprofile.FieldTLSClientCertificate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSClientCertificate(v)
+		return nil
+	case credentialprofile.FieldSSHKnownHosts:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSSHKnownHosts(v)
+		return nil
+	case credentialprofile.FieldSSHInsecureIgnoreHostKey:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSSHInsecureIgnoreHostKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CredentialProfile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CredentialProfileMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CredentialProfileMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CredentialProfileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CredentialProfile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CredentialProfileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(credentialprofile.FieldUsername) {
+		fields = append(fields, credentialprofile.FieldUsername)
+	}
+	if m.FieldCleared(credentialprofile.FieldPassword) {
+		fields = append(fields, credentialprofile.FieldPassword)
+	}
+	if m.FieldCleared(credentialprofile.FieldSnmpCommunity) {
+		fields = append(fields, credentialprofile.FieldSnmpCommunity)
+	}
+	if m.FieldCleared(credentialprofile.FieldPrivateKey) {
+		fields = append(fields, credentialprofile.FieldPrivateKey)
+	}
+	if m.FieldCleared(credentialprofile.FieldToken) {
+		fields = append(fields, credentialprofile.FieldToken)
+	}
+	if m.FieldCleared(credentialprofile.FieldSnmpPrivPassphrase) {
+		fields = append(fields, credentialprofile.FieldSnmpPrivPassphrase)
+	}
+	if m.FieldCleared(credentialprofile.FieldTLSClientKey) {
+		fields = append(fields, credentialprofile.FieldTLSClientKey)
+	}
+	if m.FieldCleared(credentialprofile.FieldSnmpAuthProtocol) {
+		fields = append(fields, credentialprofile.FieldSnmpAuthProtocol)
+	}
+	if m.FieldCleared(credentialprofile.FieldSnmpPrivProtocol) {
+		fields = append(fields, credentialprofile.FieldSnmpPrivProtocol)
+	}
+	if m.FieldCleared(credentialprofile.FieldTLSClientCertificate) {
+		fields = append(fields, credentialprofile.FieldTLSClientCertificate)
+	}
+	if m.FieldCleared(credentialprofile.FieldSSHKnownHosts) {
+		fields = append(fields, credentialprofile.FieldSSHKnownHosts)
+	}
+	if m.FieldCleared(credentialprofile.FieldSSHInsecureIgnoreHostKey) {
+		fields = append(fields, credentialprofile.FieldSSHInsecureIgnoreHostKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CredentialProfileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CredentialProfileMutation) ClearField(name string) error {
+	switch name {
+	case credentialprofile.FieldUsername:
+		m.ClearUsername()
+		return nil
+	case credentialprofile.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case credentialprofile.FieldSnmpCommunity:
+		m.ClearSnmpCommunity()
+		return nil
+	case credentialprofile.FieldPrivateKey:
+		m.ClearPrivateKey()
+		return nil
+	case credentialprofile.FieldToken:
+		m.ClearToken()
+		return nil
+	case credentialprofile.FieldSnmpPrivPassphrase:
+		m.ClearSnmpPrivPassphrase()
+		return nil
+	case credentialprofile.FieldTLSClientKey:
+		m.ClearTLSClientKey()
+		return nil
+	case credentialprofile.FieldSnmpAuthProtocol:
+		m.ClearSnmpAuthProtocol()
+		return nil
+	case credentialprofile.FieldSnmpPrivProtocol:
+		m.ClearSnmpPrivProtocol()
+		return nil
+	case credentialprofile.FieldTLSClientCertificate:
+		m.ClearTLSClientCertificate()
+		return nil
+	case credentialprofile.FieldSSHKnownHosts:
+		m.ClearSSHKnownHosts()
+		return nil
+	case credentialprofile.FieldSSHInsecureIgnoreHostKey:
+		m.ClearSSHInsecureIgnoreHostKey()
+		return nil
+	}
+	return fmt.Errorf("unknown CredentialProfile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CredentialProfileMutation) ResetField(name string) error {
+	switch name {
+	case credentialprofile.FieldName:
+		m.ResetName()
+		return nil
+	case credentialprofile.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case credentialprofile.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case credentialprofile.FieldSnmpCommunity:
+		m.ResetSnmpCommunity()
+		return nil
+	case credentialprofile.FieldPrivateKey:
+		m.ResetPrivateKey()
+		return nil
+	case credentialprofile.FieldToken:
+		m.ResetToken()
+		return nil
+	case credentialprofile.FieldSnmpPrivPassphrase:
+		m.ResetSnmpPrivPassphrase()
+		return nil
+	case credentialprofile.FieldTLSClientKey:
+		m.ResetTLSClientKey()
+		return nil
+	case credentialprofile.FieldSnmpAuthProtocol:
+		m.ResetSnmpAuthProtocol()
+		return nil
+	case credentialprofile.FieldSnmpPrivProtocol:
+		m.ResetSnmpPrivProtocol()
+		return nil
+	case credentialprofile.FieldTLSClientCertificate:
+		m.ResetTLSClientCertificate()
+		return nil
+	case credentialprofile.FieldSSHKnownHosts:
+		m.ResetSSHKnownHosts()
+		return nil
+	case credentialprofile.FieldSSHInsecureIgnoreHostKey:
+		m.ResetSSHInsecureIgnoreHostKey()
+		return nil
+	}
+	return fmt.Errorf("unknown CredentialProfile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CredentialProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CredentialProfileMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CredentialProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CredentialProfileMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CredentialProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CredentialProfileMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CredentialProfileMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CredentialProfile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CredentialProfileMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CredentialProfile edge %s", name)
+}
+
+// DeviceStatusMutation represents an operation that mutates the DeviceStatus nodes in the graph.
+type DeviceStatusMutation struct {
+	config
+	op                                            Op
+	typ                                           string
+	id                                            *string
+	status                                        *devicestatus.Status
+	last_seen                                     *string
+	consequential_failed_connectivity_attempts    *int32
+	addconsequential_failed_connectivity_attempts *int32
+	next_poll                                     *int64
+	addnext_poll                                  *int64
+	pending_status                                *devicestatus.PendingStatus
+	pending_readings                              *int32
+	addpending_readings                           *int32
+	flapping                                      *bool
+	in_maintenance                                *bool
+	answered_endpoint_id                          *string
+	answered_protocol                             *devicestatus.AnsweredProtocol
+	last_error                                    *string
+	clearedFields                                 map[string]struct{}
+	network_device                                *string
+	clearednetwork_device                         bool
+	done                                          bool
+	oldValue                                      func(context.Context) (*DeviceStatus, error)
+	predicates                                    []predicate.DeviceStatus
+}
+
+var _ ent.Mutation = (*DeviceStatusMutation)(nil)
+
+// devicestatusOption allows management of the mutation configuration using functional options.
+type devicestatusOption func(*DeviceStatusMutation)
+
+// newDeviceStatusMutation creates new mutation for the DeviceStatus entity.
+func newDeviceStatusMutation(c config, op Op, opts ...devicestatusOption) *DeviceStatusMutation {
+	m := &DeviceStatusMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeviceStatus,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeviceStatusID sets the ID field of the mutation.
+func withDeviceStatusID(id string) devicestatusOption {
+	return func(m *DeviceStatusMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeviceStatus
+		)
+		m.oldValue = func(ctx context.Context) (*DeviceStatus, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeviceStatus.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeviceStatus sets the old DeviceStatus of the mutation.
+func withDeviceStatus(node *DeviceStatus) devicestatusOption {
+	return func(m *DeviceStatusMutation) {
+		m.oldValue = func(context.Context) (*DeviceStatus, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeviceStatusMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeviceStatusMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeviceStatus entities.
+func (m *DeviceStatusMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeviceStatusMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeviceStatusMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeviceStatus.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *DeviceStatusMutation) SetStatus(d devicestatus.Status) {
+	m.status = &d
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DeviceStatusMutation) Status() (r devicestatus.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the DeviceStatus entity.
+// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceStatusMutation) OldStatus(ctx context.Context) (v devicestatus.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *DeviceStatusMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (m *DeviceStatusMutation) SetLastSeen(s string) {
+	m.last_seen = &s
+}
+
+// LastSeen returns the value of the "last_seen" field in the mutation.
+func (m *DeviceStatusMutation) LastSeen() (r string, exists bool) {
+	v := m.last_seen
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeen returns the old "last_seen" field's value of the DeviceStatus entity.
+// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceStatusMutation) OldLastSeen(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeen: %w", err)
+	}
+	return oldValue.LastSeen, nil
+}
+
+// ClearLastSeen clears the value of the "last_seen" field.
+func (m *DeviceStatusMutation) ClearLastSeen() {
+	m.last_seen = nil
+	m.clearedFields[devicestatus.FieldLastSeen] = struct{}{}
+}
+
+// LastSeenCleared returns if the "last_seen" field was cleared in this mutation.
+func (m *DeviceStatusMutation) LastSeenCleared() bool {
+	_, ok := m.clearedFields[devicestatus.FieldLastSeen]
+	return ok
+}
+
+// ResetLastSeen resets all changes to the "last_seen" field.
+func (m *DeviceStatusMutation) ResetLastSeen() {
+	m.last_seen = nil
+	delete(m.clearedFields, devicestatus.FieldLastSeen)
+}
+
+// SetConsequentialFailedConnectivityAttempts sets the "consequential_failed_connectivity_attempts" field.
+func (m *DeviceStatusMutation) SetConsequentialFailedConnectivityAttempts(i int32) {
+	m.consequential_failed_connectivity_attempts = &i
+	m.addconsequential_failed_connectivity_attempts = nil
+}
+
+// ConsequentialFailedConnectivityAttempts returns the value of the "consequential_failed_connectivity_attempts" field in the mutation.
+func (m *DeviceStatusMutation) ConsequentialFailedConnectivityAttempts() (r int32, exists bool) {
+	v := m.consequential_failed_connectivity_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsequentialFailedConnectivityAttempts returns the old "consequential_failed_connectivity_attempts" field's value of the DeviceStatus entity.
+// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceStatusMutation) OldConsequentialFailedConnectivityAttempts(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsequentialFailedConnectivityAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsequentialFailedConnectivityAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsequentialFailedConnectivityAttempts: %w", err)
+	}
+	return oldValue.ConsequentialFailedConnectivityAttempts, nil
+}
+
+// AddConsequentialFailedConnectivityAttempts adds i to the "consequential_failed_connectivity_attempts" field.
+func (m *DeviceStatusMutation) AddConsequentialFailedConnectivityAttempts(i int32) {
+	if m.addconsequential_failed_connectivity_attempts != nil {
+		*m.addconsequential_failed_connectivity_attempts += i
+	} else {
+		m.addconsequential_failed_connectivity_attempts = &i
+	}
+}
+
+// AddedConsequentialFailedConnectivityAttempts returns the value that was added to the "consequential_failed_connectivity_attempts" field in this mutation.
+func (m *DeviceStatusMutation) AddedConsequentialFailedConnectivityAttempts() (r int32, exists bool) {
+	v := m.addconsequential_failed_connectivity_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsequentialFailedConnectivityAttempts resets all changes to the "consequential_failed_connectivity_attempts" field.
+func (m *DeviceStatusMutation) ResetConsequentialFailedConnectivityAttempts() {
+	m.consequential_failed_connectivity_attempts = nil
+	m.addconsequential_failed_connectivity_attempts = nil
+}
+
+// SetNextPoll sets the "next_poll" field.
+func (m *DeviceStatusMutation) SetNextPoll(i int64) {
+	m.next_poll = &i
+	m.addnext_poll = nil
+}
+
+// NextPoll returns the value of the "next_poll" field in the mutation.
+func (m *DeviceStatusMutation) NextPoll() (r int64, exists bool) {
+	v := m.next_poll
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextPoll returns the old "next_poll" field's value of the DeviceStatus entity.
+// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceStatusMutation) OldNextPoll(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextPoll is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextPoll requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextPoll: %w", err)
+	}
+	return oldValue.NextPoll, nil
+}
+
+// AddNextPoll adds i to the "next_poll" field.
+func (m *DeviceStatusMutation) AddNextPoll(i int64) {
+	if m.addnext_poll != nil {
+		*m.addnext_poll += i
+	} else {
+		m.addnext_poll = &i
+	}
+}
+
+// AddedNextPoll returns the value that was added to the "next_poll" field in this mutation.
+func (m *DeviceStatusMutation) AddedNextPoll() (r int64, exists bool) {
+	v := m.addnext_poll
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNextPoll clears the value of the "next_poll" field.
+func (m *DeviceStatusMutation) ClearNextPoll() {
+	m.next_poll = nil
+	m.addnext_poll = nil
+	m.clearedFields[devicestatus.FieldNextPoll] = struct{}{}
+}
+
+// NextPollCleared returns if the "next_poll" field was cleared in this mutation.
+func (m *DeviceStatusMutation) NextPollCleared() bool {
+	_, ok := m.clearedFields[devicestatus.FieldNextPoll]
+	return ok
+}
+
+// ResetNextPoll resets all changes to the "next_poll" field.
+func (m *DeviceStatusMutation) ResetNextPoll() {
+	m.next_poll = nil
+	m.addnext_poll = nil
+	delete(m.clearedFields, devicestatus.FieldNextPoll)
+}
+
+// SetPendingStatus sets the "pending_status" field.
+func (m *DeviceStatusMutation) SetPendingStatus(ds devicestatus.PendingStatus) {
+	m.pending_status = &ds
+}
+
+// PendingStatus returns the value of the "pending_status" field in the mutation.
+func (m *DeviceStatusMutation) PendingStatus() (r devicestatus.PendingStatus, exists bool) {
+	v := m.pending_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPendingStatus returns the old "pending_status" field's value of the DeviceStatus entity.
+// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceStatusMutation) OldPendingStatus(ctx context.Context) (v devicestatus.PendingStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingStatus: %w", err)
+	}
+	return oldValue.PendingStatus, nil
+}
+
+// ClearPendingStatus clears the value of the "pending_status" field.
+func (m *DeviceStatusMutation) ClearPendingStatus() {
+	m.pending_status = nil
+	m.clearedFields[devicestatus.FieldPendingStatus] = struct{}{}
+}
+
+// PendingStatusCleared returns if the "pending_status" field was cleared in this mutation.
+func (m *DeviceStatusMutation) PendingStatusCleared() bool {
+	_, ok := m.clearedFields[devicestatus.FieldPendingStatus]
+	return ok
+}
+
+// ResetPendingStatus resets all changes to the "pending_status" field.
+func (m *DeviceStatusMutation) ResetPendingStatus() {
+	m.pending_status = nil
+	delete(m.clearedFields, devicestatus.FieldPendingStatus)
+}
+
+// SetPendingReadings sets the "pending_readings" field.
+func (m *DeviceStatusMutation) SetPendingReadings(i int32) {
+	m.pending_readings = &i
+	m.addpending_readings = nil
+}
+
+// PendingReadings returns the value of the "pending_readings" field in the mutation.
+func (m *DeviceStatusMutation) PendingReadings() (r int32, exists bool) {
+	v := m.pending_readings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPendingReadings returns the old "pending_readings" field's value of the DeviceStatus entity.
+// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceStatusMutation) OldPendingReadings(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingReadings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingReadings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingReadings: %w", err)
+	}
+	return oldValue.PendingReadings, nil
+}
+
+// AddPendingReadings adds i to the "pending_readings" field.
+func (m *DeviceStatusMutation) AddPendingReadings(i int32) {
+	if m.addpending_readings != nil {
+		*m.addpending_readings += i
+	} else {
+		m.addpending_readings = &i
+	}
+}
+
+// AddedPendingReadings returns the value that was added to the "pending_readings" field in this mutation.
+func (m *DeviceStatusMutation) AddedPendingReadings() (r int32, exists bool) {
+	v := m.addpending_readings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPendingReadings clears the value of the "pending_readings" field.
+func (m *DeviceStatusMutation) ClearPendingReadings() {
+	m.pending_readings = nil
+	m.addpending_readings = nil
+	m.clearedFields[devicestatus.FieldPendingReadings] = struct{}{}
+}
+
+// PendingReadingsCleared returns if the "pending_readings" field was cleared in this mutation.
+func (m *DeviceStatusMutation) PendingReadingsCleared() bool {
+	_, ok := m.clearedFields[devicestatus.FieldPendingReadings]
+	return ok
+}
+
+// ResetPendingReadings resets all changes to the "pending_readings" field.
+func (m *DeviceStatusMutation) ResetPendingReadings() {
+	m.pending_readings = nil
+	m.addpending_readings = nil
+	delete(m.clearedFields, devicestatus.FieldPendingReadings)
+}
+
+// SetFlapping sets the "flapping" field.
+func (m *DeviceStatusMutation) SetFlapping(b bool) {
+	m.flapping = &b
+}
+
+// Flapping returns the value of the "flapping" field in the mutation.
+func (m *DeviceStatusMutation) Flapping() (r bool, exists bool) {
+	v := m.flapping
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlapping returns the old "flapping" field's value of the DeviceStatus entity.
+// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceStatusMutation) OldFlapping(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlapping is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlapping requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlapping: %w", err)
+	}
+	return oldValue.Flapping, nil
+}
+
+// ClearFlapping clears the value of the "flapping" field.
+func (m *DeviceStatusMutation) ClearFlapping() {
+	m.flapping = nil
+	m.clearedFields[devicestatus.FieldFlapping] = struct{}{}
+}
+
+// FlappingCleared returns if the "flapping" field was cleared in this mutation.
+func (m *DeviceStatusMutation) FlappingCleared() bool {
+	_, ok := m.clearedFields[devicestatus.FieldFlapping]
+	return ok
+}
+
+// ResetFlapping resets all changes to the "flapping" field.
+func (m *DeviceStatusMutation) ResetFlapping() {
+	m.flapping = nil
+	delete(m.clearedFields, devicestatus.FieldFlapping)
+}
+
+// SetInMaintenance sets the "in_maintenance" field.
+func (m *DeviceStatusMutation) SetInMaintenance(b bool) {
+	m.in_maintenance = &b
+}
+
+// InMaintenance returns the value of the "in_maintenance" field in the mutation.
+func (m *DeviceStatusMutation) InMaintenance() (r bool, exists bool) {
+	v := m.in_maintenance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInMaintenance returns the old "in_maintenance" field's value of the DeviceStatus entity.
+// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceStatusMutation) OldInMaintenance(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInMaintenance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInMaintenance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInMaintenance: %w", err)
+	}
+	return oldValue.InMaintenance, nil
+}
+
+// ClearInMaintenance clears the value of the "in_maintenance" field.
+func (m *DeviceStatusMutation) ClearInMaintenance() {
+	m.in_maintenance = nil
+	m.clearedFields[devicestatus.FieldInMaintenance] = struct{}{}
+}
+
+// InMaintenanceCleared returns if the "in_maintenance" field was cleared in this mutation.
+func (m *DeviceStatusMutation) InMaintenanceCleared() bool {
+	_, ok := m.clearedFields[devicestatus.FieldInMaintenance]
+	return ok
+}
+
+// ResetInMaintenance resets all changes to the "in_maintenance" field.
+func (m *DeviceStatusMutation) ResetInMaintenance() {
+	m.in_maintenance = nil
+	delete(m.clearedFields, devicestatus.FieldInMaintenance)
+}
+
+// SetAnsweredEndpointID sets the "answered_endpoint_id" field.
+func (m *DeviceStatusMutation) SetAnsweredEndpointID(s string) {
+	m.answered_endpoint_id = &s
+}
+
+// AnsweredEndpointID returns the value of the "answered_endpoint_id" field in the mutation.
+func (m *DeviceStatusMutation) AnsweredEndpointID() (r string, exists bool) {
+	v := m.answered_endpoint_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnsweredEndpointID returns the old "answered_endpoint_id" field's value of the DeviceStatus entity.
+// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceStatusMutation) OldAnsweredEndpointID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnsweredEndpointID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnsweredEndpointID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnsweredEndpointID: %w", err)
+	}
+	return oldValue.AnsweredEndpointID, nil
+}
+
+// ClearAnsweredEndpointID clears the value of the "answered_endpoint_id" field.
+func (m *DeviceStatusMutation) ClearAnsweredEndpointID() {
+	m.answered_endpoint_id = nil
+	m.clearedFields[devicestatus.FieldAnsweredEndpointID] = struct{}{}
+}
+
+// AnsweredEndpointIDCleared returns if the "answered_endpoint_id" field was cleared in this mutation.
+func (m *DeviceStatusMutation) AnsweredEndpointIDCleared() bool {
+	_, ok := m.clearedFields[devicestatus.FieldAnsweredEndpointID]
+	return ok
+}
+
+// ResetAnsweredEndpointID resets all changes to the "answered_endpoint_id" field.
+func (m *DeviceStatusMutation) ResetAnsweredEndpointID() {
+	m.answered_endpoint_id = nil
+	delete(m.clearedFields, devicestatus.FieldAnsweredEndpointID)
+}
+
+// SetAnsweredProtocol sets the "answered_protocol" field.
+func (m *DeviceStatusMutation) SetAnsweredProtocol(dp devicestatus.AnsweredProtocol) {
+	m.answered_protocol = &dp
+}
+
+// AnsweredProtocol returns the value of the "answered_protocol" field in the mutation.
+func (m *DeviceStatusMutation) AnsweredProtocol() (r devicestatus.AnsweredProtocol, exists bool) {
+	v := m.answered_protocol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnsweredProtocol returns the old "answered_protocol" field's value of the DeviceStatus entity.
+// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceStatusMutation) OldAnsweredProtocol(ctx context.Context) (v devicestatus.AnsweredProtocol, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnsweredProtocol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnsweredProtocol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnsweredProtocol: %w", err)
+	}
+	return oldValue.AnsweredProtocol, nil
+}
+
+// ClearAnsweredProtocol clears the value of the "answered_protocol" field.
+func (m *DeviceStatusMutation) ClearAnsweredProtocol() {
+	m.answered_protocol = nil
+	m.clearedFields[devicestatus.FieldAnsweredProtocol] = struct{}{}
+}
+
+// AnsweredProtocolCleared returns if the "answered_protocol" field was cleared in this mutation.
+func (m *DeviceStatusMutation) AnsweredProtocolCleared() bool {
+	_, ok := m.clearedFields[devicestatus.FieldAnsweredProtocol]
+	return ok
+}
+
+// ResetAnsweredProtocol resets all changes to the "answered_protocol" field.
+func (m *DeviceStatusMutation) ResetAnsweredProtocol() {
+	m.answered_protocol = nil
+	delete(m.clearedFields, devicestatus.FieldAnsweredProtocol)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *DeviceStatusMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *DeviceStatusMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the DeviceStatus entity.
+// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceStatusMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *DeviceStatusMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[devicestatus.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *DeviceStatusMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[devicestatus.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *DeviceStatusMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, devicestatus.FieldLastError)
+}
+
+// SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by id.
+func (m *DeviceStatusMutation) SetNetworkDeviceID(id string) {
+	m.network_device = &id
+}
+
+// ClearNetworkDevice clears the "network_device" edge to the NetworkDevice entity.
+func (m *DeviceStatusMutation) ClearNetworkDevice() {
+	m.clearednetwork_device = true
+}
+
+// NetworkDeviceCleared reports if the "network_device" edge to the NetworkDevice entity was cleared.
+func (m *DeviceStatusMutation) NetworkDeviceCleared() bool {
+	return m.clearednetwork_device
+}
+
+// NetworkDeviceID returns the "network_device" edge ID in the mutation.
+func (m *DeviceStatusMutation) NetworkDeviceID() (id string, exists bool) {
+	if m.network_device != nil {
+		return *m.network_device, true
+	}
+	return
+}
+
+// NetworkDeviceIDs returns the "network_device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NetworkDeviceID instead. It exists only for internal usage by the builders.
+func (m *DeviceStatusMutation) NetworkDeviceIDs() (ids []string) {
+	if id := m.network_device; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNetworkDevice resets all changes to the "network_device" edge.
+func (m *DeviceStatusMutation) ResetNetworkDevice() {
+	m.network_device = nil
+	m.clearednetwork_device = false
+}
+
+// Where appends a list predicates to the DeviceStatusMutation builder.
+func (m *DeviceStatusMutation) Where(ps ...predicate.DeviceStatus) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeviceStatusMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceStatusMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeviceStatus, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeviceStatusMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeviceStatusMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeviceStatus).
+func (m *DeviceStatusMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeviceStatusMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.status != nil {
+		fields = append(fields, devicestatus.FieldStatus)
+	}
+	if m.last_seen != nil {
+		fields = append(fields, devicestatus.FieldLastSeen)
+	}
+	if m.consequential_failed_connectivity_attempts != nil {
+		fields = append(fields, devicestatus.FieldConsequentialFailedConnectivityAttempts)
+	}
+	if m.next_poll != nil {
+		fields = append(fields, devicestatus.FieldNextPoll)
+	}
+	if m.pending_status != nil {
+		fields = append(fields, devicestatus.FieldPendingStatus)
+	}
+	if m.pending_readings != nil {
+		fields = append(fields, devicestatus.FieldPendingReadings)
+	}
+	if m.flapping != nil {
+		fields = append(fields, devicestatus.FieldFlapping)
+	}
+	if m.in_maintenance != nil {
+		fields = append(fields, devicestatus.FieldInMaintenance)
+	}
+	if m.answered_endpoint_id != nil {
+		fields = append(fields, devicestatus.FieldAnsweredEndpointID)
+	}
+	if m.answered_protocol != nil {
+		fields = append(fields, devicestatus.FieldAnsweredProtocol)
+	}
+	if m.last_error != nil {
+		fields = append(fields, devicestatus.FieldLastError)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeviceStatusMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case devicestatus.FieldStatus:
+		return m.Status()
+	case devicestatus.FieldLastSeen:
+		return m.LastSeen()
+	case devicestatus.FieldConsequentialFailedConnectivityAttempts:
+		return m.ConsequentialFailedConnectivityAttempts()
+	case devicestatus.FieldNextPoll:
+		return m.NextPoll()
+	case devicestatus.FieldPendingStatus:
+		return m.PendingStatus()
+	case devicestatus.FieldPendingReadings:
+		return m.PendingReadings()
+	case devicestatus.FieldFlapping:
+		return m.Flapping()
+	case devicestatus.FieldInMaintenance:
+		return m.InMaintenance()
+	case devicestatus.FieldAnsweredEndpointID:
+		return m.AnsweredEndpointID()
+	case devicestatus.FieldAnsweredProtocol:
+		return m.AnsweredProtocol()
+	case devicestatus.FieldLastError:
+		return m.LastError()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeviceStatusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case devicestatus.FieldStatus:
+		return m.OldStatus(ctx)
+	case devicestatus.FieldLastSeen:
+		return m.OldLastSeen(ctx)
+	case devicestatus.FieldConsequentialFailedConnectivityAttempts:
+		return m.OldConsequentialFailedConnectivityAttempts(ctx)
+	case devicestatus.FieldNextPoll:
+		return m.OldNextPoll(ctx)
+	case devicestatus.FieldPendingStatus:
+		return m.OldPendingStatus(ctx)
+	case devicestatus.FieldPendingReadings:
+		return m.OldPendingReadings(ctx)
+	case devicestatus.FieldFlapping:
+		return m.OldFlapping(ctx)
+	case devicestatus.FieldInMaintenance:
+		return m.OldInMaintenance(ctx)
+	case devicestatus.FieldAnsweredEndpointID:
+		return m.OldAnsweredEndpointID(ctx)
+	case devicestatus.FieldAnsweredProtocol:
+		return m.OldAnsweredProtocol(ctx)
+	case devicestatus.FieldLastError:
+		return m.OldLastError(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeviceStatus field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceStatusMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case devicestatus.FieldStatus:
+		v, ok := value.(devicestatus.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case devicestatus.FieldLastSeen:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeen(v)
+		return nil
+	case devicestatus.FieldConsequentialFailedConnectivityAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsequentialFailedConnectivityAttempts(v)
+		return nil
+	case devicestatus.FieldNextPoll:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextPoll(v)
+		return nil
+	case devicestatus.FieldPendingStatus:
+		v, ok := value.(devicestatus.PendingStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPendingStatus(v)
+		return nil
+	case devicestatus.FieldPendingReadings:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPendingReadings(v)
+		return nil
+	case devicestatus.FieldFlapping:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlapping(v)
+		return nil
+	case devicestatus.FieldInMaintenance:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInMaintenance(v)
+		return nil
+	case devicestatus.FieldAnsweredEndpointID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnsweredEndpointID(v)
+		return nil
+	case devicestatus.FieldAnsweredProtocol:
+		v, ok := value.(devicestatus.AnsweredProtocol)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnsweredProtocol(v)
+		return nil
+	case devicestatus.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceStatus field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeviceStatusMutation) AddedFields() []string {
+	var fields []string
+	if m.addconsequential_failed_connectivity_attempts != nil {
+		fields = append(fields, devicestatus.FieldConsequentialFailedConnectivityAttempts)
+	}
+	if m.addnext_poll != nil {
+		fields = append(fields, devicestatus.FieldNextPoll)
+	}
+	if m.addpending_readings != nil {
+		fields = append(fields, devicestatus.FieldPendingReadings)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeviceStatusMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case devicestatus.FieldConsequentialFailedConnectivityAttempts:
+		return m.AddedConsequentialFailedConnectivityAttempts()
+	case devicestatus.FieldNextPoll:
+		return m.AddedNextPoll()
+	case devicestatus.FieldPendingReadings:
+		return m.AddedPendingReadings()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceStatusMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case devicestatus.FieldConsequentialFailedConnectivityAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsequentialFailedConnectivityAttempts(v)
+		return nil
+	case devicestatus.FieldNextPoll:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNextPoll(v)
+		return nil
+	case devicestatus.FieldPendingReadings:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPendingReadings(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceStatus numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeviceStatusMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(devicestatus.FieldLastSeen) {
+		fields = append(fields, devicestatus.FieldLastSeen)
+	}
+	if m.FieldCleared(devicestatus.FieldNextPoll) {
+		fields = append(fields, devicestatus.FieldNextPoll)
+	}
+	if m.FieldCleared(devicestatus.FieldPendingStatus) {
+		fields = append(fields, devicestatus.FieldPendingStatus)
+	}
+	if m.FieldCleared(devicestatus.FieldPendingReadings) {
+		fields = append(fields, devicestatus.FieldPendingReadings)
+	}
+	if m.FieldCleared(devicestatus.FieldFlapping) {
+		fields = append(fields, devicestatus.FieldFlapping)
+	}
+	if m.FieldCleared(devicestatus.FieldInMaintenance) {
+		fields = append(fields, devicestatus.FieldInMaintenance)
+	}
+	if m.FieldCleared(devicestatus.FieldAnsweredEndpointID) {
+		fields = append(fields, devicestatus.FieldAnsweredEndpointID)
+	}
+	if m.FieldCleared(devicestatus.FieldAnsweredProtocol) {
+		fields = append(fields, devicestatus.FieldAnsweredProtocol)
+	}
+	if m.FieldCleared(devicestatus.FieldLastError) {
+		fields = append(fields, devicestatus.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeviceStatusMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeviceStatusMutation) ClearField(name string) error {
+	switch name {
+	case devicestatus.FieldLastSeen:
+		m.ClearLastSeen()
+		return nil
+	case devicestatus.FieldNextPoll:
+		m.ClearNextPoll()
+		return nil
+	case devicestatus.FieldPendingStatus:
+		m.ClearPendingStatus()
+		return nil
+	case devicestatus.FieldPendingReadings:
+		m.ClearPendingReadings()
+		return nil
+	case devicestatus.FieldFlapping:
+		m.ClearFlapping()
+		return nil
+	case devicestatus.FieldInMaintenance:
+		m.ClearInMaintenance()
+		return nil
+	case devicestatus.FieldAnsweredEndpointID:
+		m.ClearAnsweredEndpointID()
+		return nil
+	case devicestatus.FieldAnsweredProtocol:
+		m.ClearAnsweredProtocol()
+		return nil
+	case devicestatus.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceStatus nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeviceStatusMutation) ResetField(name string) error {
+	switch name {
+	case devicestatus.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case devicestatus.FieldLastSeen:
+		m.ResetLastSeen()
+		return nil
+	case devicestatus.FieldConsequentialFailedConnectivityAttempts:
+		m.ResetConsequentialFailedConnectivityAttempts()
+		return nil
+	case devicestatus.FieldNextPoll:
+		m.ResetNextPoll()
+		return nil
+	case devicestatus.FieldPendingStatus:
+		m.ResetPendingStatus()
+		return nil
+	case devicestatus.FieldPendingReadings:
+		m.ResetPendingReadings()
+		return nil
+	case devicestatus.FieldFlapping:
+		m.ResetFlapping()
+		return nil
+	case devicestatus.FieldInMaintenance:
+		m.ResetInMaintenance()
+		return nil
+	case devicestatus.FieldAnsweredEndpointID:
+		m.ResetAnsweredEndpointID()
+		return nil
+	case devicestatus.FieldAnsweredProtocol:
+		m.ResetAnsweredProtocol()
+		return nil
+	case devicestatus.FieldLastError:
+		m.ResetLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceStatus field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeviceStatusMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.network_device != nil {
+		edges = append(edges, devicestatus.EdgeNetworkDevice)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeviceStatusMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case devicestatus.EdgeNetworkDevice:
+		if id := m.network_device; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeviceStatusMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeviceStatusMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeviceStatusMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearednetwork_device {
+		edges = append(edges, devicestatus.EdgeNetworkDevice)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeviceStatusMutation) EdgeCleared(name string) bool {
+	switch name {
+	case devicestatus.EdgeNetworkDevice:
+		return m.clearednetwork_device
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeviceStatusMutation) ClearEdge(name string) error {
+	switch name {
+	case devicestatus.EdgeNetworkDevice:
+		m.ClearNetworkDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceStatus unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeviceStatusMutation) ResetEdge(name string) error {
+	switch name {
+	case devicestatus.EdgeNetworkDevice:
+		m.ResetNetworkDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceStatus edge %s", name)
+}
+
+// EndpointMutation represents an operation that mutates the Endpoint nodes in the graph.
+type EndpointMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *string
+	host                      *string
+	port                      *string
+	protocol                  *endpoint.Protocol
+	tls_enabled               *bool
+	tls_ca_bundle             *string
+	tls_server_name           *string
+	tls_insecure_skip_verify  *bool
+	preference                *int32
+	addpreference             *int32
+	protocol_name             *string
+	clearedFields             map[string]struct{}
+	credential_profile        *string
+	clearedcredential_profile bool
+	health                    *string
+	clearedhealth             bool
+	network_device            *string
+	clearednetwork_device     bool
+	done                      bool
+	oldValue                  func(context.Context) (*Endpoint, error)
+	predicates                []predicate.Endpoint
+}
+
+var _ ent.Mutation = (*EndpointMutation)(nil)
+
+// endpointOption allows management of the mutation configuration using functional options.
+type endpointOption func(*EndpointMutation)
+
+// newEndpointMutation creates new mutation for the Endpoint entity.
+func newEndpointMutation(c config, op Op, opts ...endpointOption) *EndpointMutation {
+	m := &EndpointMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEndpoint,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEndpointID sets the ID field of the mutation.
+func withEndpointID(id string) endpointOption {
+	return func(m *EndpointMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Endpoint
+		)
+		m.oldValue = func(ctx context.Context) (*Endpoint, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Endpoint.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEndpoint sets the old Endpoint of the mutation.
+func withEndpoint(node *Endpoint) endpointOption {
+	return func(m *EndpointMutation) {
+		m.oldValue = func(context.Context) (*Endpoint, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EndpointMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EndpointMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Endpoint entities.
+func (m *EndpointMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EndpointMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EndpointMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Endpoint.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHost sets the "host" field.
+func (m *EndpointMutation) SetHost(s string) {
+	m.host = &s
+}
+
+// Host returns the value of the "host" field in the mutation.
+func (m *EndpointMutation) Host() (r string, exists bool) {
+	v := m.host
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHost returns the old "host" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldHost(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHost: %w", err)
+	}
+	return oldValue.Host, nil
+}
+
+// ResetHost resets all changes to the "host" field.
+func (m *EndpointMutation) ResetHost() {
+	m.host = nil
+}
+
+// SetPort sets the "port" field.
+func (m *EndpointMutation) SetPort(s string) {
+	m.port = &s
+}
+
+// Port returns the value of the "port" field in the mutation.
+func (m *EndpointMutation) Port() (r string, exists bool) {
+	v := m.port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPort returns the old "port" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldPort(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPort: %w", err)
+	}
+	return oldValue.Port, nil
+}
+
+// ResetPort resets all changes to the "port" field.
+func (m *EndpointMutation) ResetPort() {
+	m.port = nil
+}
+
+// SetProtocol sets the "protocol" field.
+func (m *EndpointMutation) SetProtocol(e endpoint.Protocol) {
+	m.protocol = &e
+}
+
+// Protocol returns the value of the "protocol" field in the mutation.
+func (m *EndpointMutation) Protocol() (r endpoint.Protocol, exists bool) {
+	v := m.protocol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProtocol returns the old "protocol" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldProtocol(ctx context.Context) (v endpoint.Protocol, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtocol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtocol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtocol: %w", err)
+	}
+	return oldValue.Protocol, nil
+}
+
+// ResetProtocol resets all changes to the "protocol" field.
+func (m *EndpointMutation) ResetProtocol() {
+	m.protocol = nil
+}
+
+// SetTLSEnabled sets the "tls_enabled" field.
+func (m *EndpointMutation) SetTLSEnabled(b bool) {
+	m.tls_enabled = &b
+}
+
+// TLSEnabled returns the value of the "tls_enabled" field in the mutation.
+func (m *EndpointMutation) TLSEnabled() (r bool, exists bool) {
+	v := m.tls_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSEnabled returns the old "tls_enabled" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldTLSEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSEnabled: %w", err)
+	}
+	return oldValue.TLSEnabled, nil
+}
+
+// ClearTLSEnabled clears the value of the "tls_enabled" field.
+func (m *EndpointMutation) ClearTLSEnabled() {
+	m.tls_enabled = nil
+	m.clearedFields[endpoint.FieldTLSEnabled] = struct{}{}
+}
+
+// TLSEnabledCleared returns if the "tls_enabled" field was cleared in this mutation.
+func (m *EndpointMutation) TLSEnabledCleared() bool {
+	_, ok := m.clearedFields[endpoint.FieldTLSEnabled]
+	return ok
+}
+
+// ResetTLSEnabled resets all changes to the "tls_enabled" field.
+func (m *EndpointMutation) ResetTLSEnabled() {
+	m.tls_enabled = nil
+	delete(m.clearedFields, endpoint.FieldTLSEnabled)
+}
+
+// SetTLSCaBundle sets the "tls_ca_bundle" field.
+func (m *EndpointMutation) SetTLSCaBundle(s string) {
+	m.tls_ca_bundle = &s
+}
+
+// TLSCaBundle returns the value of the "tls_ca_bundle" field in the mutation.
+func (m *EndpointMutation) TLSCaBundle() (r string, exists bool) {
+	v := m.tls_ca_bundle
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSCaBundle returns the old "tls_ca_bundle" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldTLSCaBundle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSCaBundle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSCaBundle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSCaBundle: %w", err)
+	}
+	return oldValue.TLSCaBundle, nil
+}
+
+// ClearTLSCaBundle clears the value of the "tls_ca_bundle" field.
+func (m *EndpointMutation) ClearTLSCaBundle() {
+	m.tls_ca_bundle = nil
+	m.clearedFields[endpoint.FieldTLSCaBundle] = struct{}{}
+}
+
+// TLSCaBundleCleared returns if the "tls_ca_bundle" field was cleared in this mutation.
+func (m *EndpointMutation) TLSCaBundleCleared() bool {
+	_, ok := m.clearedFields[endpoint.FieldTLSCaBundle]
+	return ok
+}
+
+// ResetTLSCaBundle resets all changes to the "tls_ca_bundle" field.
+func (m *EndpointMutation) ResetTLSCaBundle() {
+	m.tls_ca_bundle = nil
+	delete(m.clearedFields, endpoint.FieldTLSCaBundle)
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (m *EndpointMutation) SetTLSServerName(s string) {
+	m.tls_server_name = &s
+}
+
+// TLSServerName returns the value of the "tls_server_name" field in the mutation.
+func (m *EndpointMutation) TLSServerName() (r string, exists bool) {
+	v := m.tls_server_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSServerName returns the old "tls_server_name" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldTLSServerName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSServerName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSServerName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSServerName: %w", err)
+	}
+	return oldValue.TLSServerName, nil
+}
+
+// ClearTLSServerName clears the value of the "tls_server_name" field.
+func (m *EndpointMutation) ClearTLSServerName() {
+	m.tls_server_name = nil
+	m.clearedFields[endpoint.FieldTLSServerName] = struct{}{}
+}
+
+// TLSServerNameCleared returns if the "tls_server_name" field was cleared in this mutation.
+func (m *EndpointMutation) TLSServerNameCleared() bool {
+	_, ok := m.clearedFields[endpoint.FieldTLSServerName]
+	return ok
+}
+
+// ResetTLSServerName resets all changes to the "tls_server_name" field.
+func (m *EndpointMutation) ResetTLSServerName() {
+	m.tls_server_name = nil
+	delete(m.clearedFields, endpoint.FieldTLSServerName)
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (m *EndpointMutation) SetTLSInsecureSkipVerify(b bool) {
+	m.tls_insecure_skip_verify = &b
+}
+
+// TLSInsecureSkipVerify returns the value of the "tls_insecure_skip_verify" field in the mutation.
+func (m *EndpointMutation) TLSInsecureSkipVerify() (r bool, exists bool) {
+	v := m.tls_insecure_skip_verify
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSInsecureSkipVerify returns the old "tls_insecure_skip_verify" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldTLSInsecureSkipVerify(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSInsecureSkipVerify is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSInsecureSkipVerify requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSInsecureSkipVerify: %w", err)
+	}
+	return oldValue.TLSInsecureSkipVerify, nil
+}
+
+// ClearTLSInsecureSkipVerify clears the value of the "tls_insecure_skip_verify" field.
+func (m *EndpointMutation) ClearTLSInsecureSkipVerify() {
+	m.tls_insecure_skip_verify = nil
+	m.clearedFields[endpoint.FieldTLSInsecureSkipVerify] = struct{}{}
+}
+
+// TLSInsecureSkipVerifyCleared returns if the "tls_insecure_skip_verify" field was cleared in this mutation.
+func (m *EndpointMutation) TLSInsecureSkipVerifyCleared() bool {
+	_, ok := m.clearedFields[endpoint.FieldTLSInsecureSkipVerify]
+	return ok
+}
+
+// ResetTLSInsecureSkipVerify resets all changes to the "tls_insecure_skip_verify" field.
+func (m *EndpointMutation) ResetTLSInsecureSkipVerify() {
+	m.tls_insecure_skip_verify = nil
+	delete(m.clearedFields, endpoint.FieldTLSInsecureSkipVerify)
+}
+
+// SetPreference sets the "preference" field.
+func (m *EndpointMutation) SetPreference(i int32) {
+	m.preference = &i
+	m.addpreference = nil
+}
+
+// Preference returns the value of the "preference" field in the mutation.
+func (m *EndpointMutation) Preference() (r int32, exists bool) {
+	v := m.preference
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreference returns the old "preference" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldPreference(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreference is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreference requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreference: %w", err)
+	}
+	return oldValue.Preference, nil
+}
+
+// AddPreference adds i to the "preference" field.
+func (m *EndpointMutation) AddPreference(i int32) {
+	if m.addpreference != nil {
+		*m.addpreference += i
+	} else {
+		m.addpreference = &i
+	}
+}
+
+// AddedPreference returns the value that was added to the "preference" field in this mutation.
+func (m *EndpointMutation) AddedPreference() (r int32, exists bool) {
+	v := m.addpreference
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPreference clears the value of the "preference" field.
+func (m *EndpointMutation) ClearPreference() {
+	m.preference = nil
+	m.addpreference = nil
+	m.clearedFields[endpoint.FieldPreference] = struct{}{}
+}
+
+// PreferenceCleared returns if the "preference" field was cleared in this mutation.
+func (m *EndpointMutation) PreferenceCleared() bool {
+	_, ok := m.clearedFields[endpoint.FieldPreference]
+	return ok
+}
+
+// ResetPreference resets all changes to the "preference" field.
+func (m *EndpointMutation) ResetPreference() {
+	m.preference = nil
+	m.addpreference = nil
+	delete(m.clearedFields, endpoint.FieldPreference)
+}
+
+// SetProtocolName sets the "protocol_name" field.
+func (m *EndpointMutation) SetProtocolName(s string) {
+	m.protocol_name = &s
+}
+
+// ProtocolName returns the value of the "protocol_name" field in the mutation.
+func (m *EndpointMutation) ProtocolName() (r string, exists bool) {
+	v := m.protocol_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProtocolName returns the old "protocol_name" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldProtocolName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtocolName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtocolName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtocolName: %w", err)
+	}
+	return oldValue.ProtocolName, nil
+}
+
+// ClearProtocolName clears the value of the "protocol_name" field.
+func (m *EndpointMutation) ClearProtocolName() {
+	m.protocol_name = nil
+	m.clearedFields[endpoint.FieldProtocolName] = struct{}{}
+}
+
+// ProtocolNameCleared returns if the "protocol_name" field was cleared in this mutation.
+func (m *EndpointMutation) ProtocolNameCleared() bool {
+	_, ok := m.clearedFields[endpoint.FieldProtocolName]
+	return ok
+}
+
+// ResetProtocolName resets all changes to the "protocol_name" field.
+func (m *EndpointMutation) ResetProtocolName() {
+	m.protocol_name = nil
+	delete(m.clearedFields, endpoint.FieldProtocolName)
+}
+
+// SetCredentialProfileID sets the "credential_profile" edge to the CredentialProfile entity by id.
+func (m *EndpointMutation) SetCredentialProfileID(id string) {
+	m.credential_profile = &id
+}
+
+// ClearCredentialProfile clears the "credential_profile" edge to the CredentialProfile entity.
+func (m *EndpointMutation) ClearCredentialProfile() {
+	m.clearedcredential_profile = true
+}
+
+// CredentialProfileCleared reports if the "credential_profile" edge to the CredentialProfile entity was cleared.
+func (m *EndpointMutation) CredentialProfileCleared() bool {
+	return m.clearedcredential_profile
+}
+
+// CredentialProfileID returns the "credential_profile" edge ID in the mutation.
+func (m *EndpointMutation) CredentialProfileID() (id string, exists bool) {
+	if m.credential_profile != nil {
+		return *m.credential_profile, true
+	}
+	return
+}
+
+// CredentialProfileIDs returns the "credential_profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CredentialProfileID instead. It exists only for internal usage by the builders.
+func (m *EndpointMutation) CredentialProfileIDs() (ids []string) {
+	if id := m.credential_profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCredentialProfile resets all changes to the "credential_profile" edge.
+func (m *EndpointMutation) ResetCredentialProfile() {
+	m.credential_profile = nil
+	m.clearedcredential_profile = false
+}
+
+// SetHealthID sets the "health" edge to the EndpointHealth entity by id.
+func (m *EndpointMutation) SetHealthID(id string) {
+	m.health = &id
+}
+
+// ClearHealth clears the "health" edge to the EndpointHealth entity.
+func (m *EndpointMutation) ClearHealth() {
+	m.clearedhealth = true
+}
+
+// HealthCleared reports if the "health" edge to the EndpointHealth entity was cleared.
+func (m *EndpointMutation) HealthCleared() bool {
+	return m.clearedhealth
+}
+
+// HealthID returns the "health" edge ID in the mutation.
+func (m *EndpointMutation) HealthID() (id string, exists bool) {
+	if m.health != nil {
+		return *m.health, true
+	}
+	return
+}
+
+// HealthIDs returns the "health" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HealthID instead. It exists only for internal usage by the builders.
+func (m *EndpointMutation) HealthIDs() (ids []string) {
+	if id := m.health; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHealth resets all changes to the "health" edge.
+func (m *EndpointMutation) ResetHealth() {
+	m.health = nil
+	m.clearedhealth = false
+}
+
+// SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by id.
+func (m *EndpointMutation) SetNetworkDeviceID(id string) {
+	m.network_device = &id
+}
+
+// ClearNetworkDevice clears the "network_device" edge to the NetworkDevice entity.
+func (m *EndpointMutation) ClearNetworkDevice() {
+	m.clearednetwork_device = true
+}
+
+// NetworkDeviceCleared reports if the "network_device" edge to the NetworkDevice entity was cleared.
+func (m *EndpointMutation) NetworkDeviceCleared() bool {
+	return m.clearednetwork_device
+}
+
+// NetworkDeviceID returns the "network_device" edge ID in the mutation.
+func (m *EndpointMutation) NetworkDeviceID() (id string, exists bool) {
+	if m.network_device != nil {
+		return *m.network_device, true
+	}
+	return
+}
+
+// NetworkDeviceIDs returns the "network_device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NetworkDeviceID instead. It exists only for internal usage by the builders.
+func (m *EndpointMutation) NetworkDeviceIDs() (ids []string) {
+	if id := m.network_device; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNetworkDevice resets all changes to the "network_device" edge.
+func (m *EndpointMutation) ResetNetworkDevice() {
+	m.network_device = nil
+	m.clearednetwork_device = false
+}
+
+// Where appends a list predicates to the EndpointMutation builder.
+func (m *EndpointMutation) Where(ps ...predicate.Endpoint) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EndpointMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EndpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Endpoint, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EndpointMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EndpointMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Endpoint).
+func (m *EndpointMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EndpointMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.host != nil {
+		fields = append(fields, endpoint.FieldHost)
+	}
+	if m.port != nil {
+		fields = append(fields, endpoint.FieldPort)
+	}
+	if m.protocol != nil {
+		fields = append(fields, endpoint.FieldProtocol)
+	}
+	if m.tls_enabled != nil {
+		fields = append(fields, endpoint.FieldTLSEnabled)
+	}
+	if m.tls_ca_bundle != nil {
+		fields = append(fields, endpoint.FieldTLSCaBundle)
+	}
+	if m.tls_server_name != nil {
+		fields = append(fields, endpoint.FieldTLSServerName)
+	}
+	if m.tls_insecure_skip_verify != nil {
+		fields = append(fields, endpoint.FieldTLSInsecureSkipVerify)
+	}
+	if m.preference != nil {
+		fields = append(fields, endpoint.FieldPreference)
+	}
+	if m.protocol_name != nil {
+		fields = append(fields, endpoint.FieldProtocolName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EndpointMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case endpoint.FieldHost:
+		return m.Host()
+	case endpoint.FieldPort:
+		return m.Port()
+	case endpoint.FieldProtocol:
+		return m.Protocol()
+	case endpoint.FieldTLSEnabled:
+		return m.TLSEnabled()
+	case endpoint.FieldTLSCaBundle:
+		return m.TLSCaBundle()
+	case endpoint.FieldTLSServerName:
+		return m.TLSServerName()
+	case endpoint.FieldTLSInsecureSkipVerify:
+		return m.TLSInsecureSkipVerify()
+	case endpoint.FieldPreference:
+		return m.Preference()
+	case endpoint.FieldProtocolName:
+		return m.ProtocolName()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EndpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case endpoint.FieldHost:
+		return m.OldHost(ctx)
+	case endpoint.FieldPort:
+		return m.OldPort(ctx)
+	case endpoint.FieldProtocol:
+		return m.OldProtocol(ctx)
+	case endpoint.FieldTLSEnabled:
+		return m.OldTLSEnabled(ctx)
+	case endpoint.FieldTLSCaBundle:
+		return m.OldTLSCaBundle(ctx)
+	case endpoint.FieldTLSServerName:
+		return m.OldTLSServerName(ctx)
+	case endpoint.FieldTLSInsecureSkipVerify:
+		return m.OldTLSInsecureSkipVerify(ctx)
+	case endpoint.FieldPreference:
+		return m.OldPreference(ctx)
+	case endpoint.FieldProtocolName:
+		return m.OldProtocolName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Endpoint field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EndpointMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case endpoint.FieldHost:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHost(v)
+		return nil
+	case endpoint.FieldPort:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPort(v)
+		return nil
+	case endpoint.FieldProtocol:
+		v, ok := value.(endpoint.Protocol)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtocol(v)
+		return nil
+	case endpoint.FieldTLSEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSEnabled(v)
+		return nil
+	case endpoint.FieldTLSCaBundle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSCaBundle(v)
+		return nil
+	case endpoint.FieldTLSServerName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSServerName(v)
+		return nil
+	case endpoint.FieldTLSInsecureSkipVerify:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSInsecureSkipVerify(v)
+		return nil
+	case endpoint.FieldPreference:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreference(v)
+		return nil
+	case endpoint.FieldProtocolName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtocolName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Endpoint field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EndpointMutation) AddedFields() []string {
+	var fields []string
+	if m.addpreference != nil {
+		fields = append(fields, endpoint.FieldPreference)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EndpointMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case endpoint.FieldPreference:
+		return m.AddedPreference()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EndpointMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case endpoint.FieldPreference:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPreference(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Endpoint numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EndpointMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(endpoint.FieldTLSEnabled) {
+		fields = append(fields, endpoint.FieldTLSEnabled)
+	}
+	if m.FieldCleared(endpoint.FieldTLSCaBundle) {
+		fields = append(fields, endpoint.FieldTLSCaBundle)
+	}
+	if m.FieldCleared(endpoint.FieldTLSServerName) {
+		fields = append(fields, endpoint.FieldTLSServerName)
+	}
+	if m.FieldCleared(endpoint.FieldTLSInsecureSkipVerify) {
+		fields = append(fields, endpoint.FieldTLSInsecureSkipVerify)
+	}
+	if m.FieldCleared(endpoint.FieldPreference) {
+		fields = append(fields, endpoint.FieldPreference)
+	}
+	if m.FieldCleared(endpoint.FieldProtocolName) {
+		fields = append(fields, endpoint.FieldProtocolName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EndpointMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EndpointMutation) ClearField(name string) error {
+	switch name {
+	case endpoint.FieldTLSEnabled:
+		m.ClearTLSEnabled()
+		return nil
+	case endpoint.FieldTLSCaBundle:
+		m.ClearTLSCaBundle()
+		return nil
+	case endpoint.FieldTLSServerName:
+		m.ClearTLSServerName()
+		return nil
+	case endpoint.FieldTLSInsecureSkipVerify:
+		m.ClearTLSInsecureSkipVerify()
+		return nil
+	case endpoint.FieldPreference:
+		m.ClearPreference()
+		return nil
+	case endpoint.FieldProtocolName:
+		m.ClearProtocolName()
+		return nil
+	}
+	return fmt.Errorf("unknown Endpoint nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EndpointMutation) ResetField(name string) error {
+	switch name {
+	case endpoint.FieldHost:
+		m.ResetHost()
+		return nil
+	case endpoint.FieldPort:
+		m.ResetPort()
+		return nil
+	case endpoint.FieldProtocol:
+		m.ResetProtocol()
+		return nil
+	case endpoint.FieldTLSEnabled:
+		m.ResetTLSEnabled()
+		return nil
+	case endpoint.FieldTLSCaBundle:
+		m.ResetTLSCaBundle()
+		return nil
+	case endpoint.FieldTLSServerName:
+		m.ResetTLSServerName()
+		return nil
+	case endpoint.FieldTLSInsecureSkipVerify:
+		m.ResetTLSInsecureSkipVerify()
+		return nil
+	case endpoint.FieldPreference:
+		m.ResetPreference()
+		return nil
+	case endpoint.FieldProtocolName:
+		m.ResetProtocolName()
+		return nil
+	}
+	return fmt.Errorf("unknown Endpoint field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EndpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.credential_profile != nil {
+		edges = append(edges, endpoint.EdgeCredentialProfile)
+	}
+	if m.health != nil {
+		edges = append(edges, endpoint.EdgeHealth)
+	}
+	if m.network_device != nil {
+		edges = append(edges, endpoint.EdgeNetworkDevice)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EndpointMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case endpoint.EdgeCredentialProfile:
+		if id := m.credential_profile; id != nil {
+			return []ent.Value{*id}
+		}
+	case endpoint.EdgeHealth:
+		if id := m.health; id != nil {
+			return []ent.Value{*id}
+		}
+	case endpoint.EdgeNetworkDevice:
+		if id := m.network_device; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EndpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EndpointMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EndpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedcredential_profile {
+		edges = append(edges, endpoint.EdgeCredentialProfile)
+	}
+	if m.clearedhealth {
+		edges = append(edges, endpoint.EdgeHealth)
+	}
+	if m.clearednetwork_device {
+		edges = append(edges, endpoint.EdgeNetworkDevice)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EndpointMutation) EdgeCleared(name string) bool {
+	switch name {
+	case endpoint.EdgeCredentialProfile:
+		return m.clearedcredential_profile
+	case endpoint.EdgeHealth:
+		return m.clearedhealth
+	case endpoint.EdgeNetworkDevice:
+		return m.clearednetwork_device
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EndpointMutation) ClearEdge(name string) error {
+	switch name {
+	case endpoint.EdgeCredentialProfile:
+		m.ClearCredentialProfile()
+		return nil
+	case endpoint.EdgeHealth:
+		m.ClearHealth()
+		return nil
+	case endpoint.EdgeNetworkDevice:
+		m.ClearNetworkDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown Endpoint unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EndpointMutation) ResetEdge(name string) error {
+	switch name {
+	case endpoint.EdgeCredentialProfile:
+		m.ResetCredentialProfile()
+		return nil
+	case endpoint.EdgeHealth:
+		m.ResetHealth()
+		return nil
+	case endpoint.EdgeNetworkDevice:
+		m.ResetNetworkDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown Endpoint edge %s", name)
+}
+
+// EndpointHealthMutation represents an operation that mutates the EndpointHealth nodes in the graph.
+type EndpointHealthMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *string
+	last_success     *int64
+	addlast_success  *int64
+	last_failure     *int64
+	addlast_failure  *int64
+	last_error       *string
+	latency          *int64
+	addlatency       *int64
+	failure_count    *int32
+	addfailure_count *int32
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*EndpointHealth, error)
+	predicates       []predicate.EndpointHealth
+}
+
+var _ ent.Mutation = (*EndpointHealthMutation)(nil)
+
+// endpointhealthOption allows management of the mutation configuration using functional options.
+type endpointhealthOption func(*EndpointHealthMutation)
+
+// newEndpointHealthMutation creates new mutation for the EndpointHealth entity.
+func newEndpointHealthMutation(c config, op Op, opts ...endpointhealthOption) *EndpointHealthMutation {
+	m := &EndpointHealthMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEndpointHealth,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEndpointHealthID sets the ID field of the mutation.
+func withEndpointHealthID(id string) endpointhealthOption {
+	return func(m *EndpointHealthMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EndpointHealth
+		)
+		m.oldValue = func(ctx context.Context) (*EndpointHealth, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EndpointHealth.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEndpointHealth sets the old EndpointHealth of the mutation.
+func withEndpointHealth(node *EndpointHealth) endpointhealthOption {
+	return func(m *EndpointHealthMutation) {
+		m.oldValue = func(context.Context) (*EndpointHealth, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EndpointHealthMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EndpointHealthMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EndpointHealth entities.
+func (m *EndpointHealthMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EndpointHealthMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EndpointHealthMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EndpointHealth.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLastSuccess sets the "last_success" field.
+func (m *EndpointHealthMutation) SetLastSuccess(i int64) {
+	m.last_success = &i
+	m.addlast_success = nil
+}
+
+// LastSuccess returns the value of the "last_success" field in the mutation.
+func (m *EndpointHealthMutation) LastSuccess() (r int64, exists bool) {
+	v := m.last_success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSuccess returns the old "last_success" field's value of the EndpointHealth entity.
+// If the EndpointHealth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointHealthMutation) OldLastSuccess(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSuccess: %w", err)
+	}
+	return oldValue.LastSuccess, nil
+}
+
+// AddLastSuccess adds i to the "last_success" field.
+func (m *EndpointHealthMutation) AddLastSuccess(i int64) {
+	if m.addlast_success != nil {
+		*m.addlast_success += i
+	} else {
+		m.addlast_success = &i
+	}
+}
+
+// AddedLastSuccess returns the value that was added to the "last_success" field in this mutation.
+func (m *EndpointHealthMutation) AddedLastSuccess() (r int64, exists bool) {
+	v := m.addlast_success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLastSuccess clears the value of the "last_success" field.
+func (m *EndpointHealthMutation) ClearLastSuccess() {
+	m.last_success = nil
+	m.addlast_success = nil
+	m.clearedFields[endpointhealth.FieldLastSuccess] = struct{}{}
+}
+
+// LastSuccessCleared returns if the "last_success" field was cleared in this mutation.
+func (m *EndpointHealthMutation) LastSuccessCleared() bool {
+	_, ok := m.clearedFields[endpointhealth.FieldLastSuccess]
+	return ok
+}
+
+// ResetLastSuccess resets all changes to the "last_success" field.
+func (m *EndpointHealthMutation) ResetLastSuccess() {
+	m.last_success = nil
+	m.addlast_success = nil
+	delete(m.clearedFields, endpointhealth.FieldLastSuccess)
+}
+
+// SetLastFailure sets the "last_failure" field.
+func (m *EndpointHealthMutation) SetLastFailure(i int64) {
+	m.last_failure = &i
+	m.addlast_failure = nil
+}
+
+// LastFailure returns the value of the "last_failure" field in the mutation.
+func (m *EndpointHealthMutation) LastFailure() (r int64, exists bool) {
+	v := m.last_failure
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastFailure returns the old "last_failure" field's value of the EndpointHealth entity.
+// If the EndpointHealth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointHealthMutation) OldLastFailure(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastFailure is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastFailure requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastFailure: %w", err)
+	}
+	return oldValue.LastFailure, nil
+}
+
+// AddLastFailure adds i to the "last_failure" field.
+func (m *EndpointHealthMutation) AddLastFailure(i int64) {
+	if m.addlast_failure != nil {
+		*m.addlast_failure += i
+	} else {
+		m.addlast_failure = &i
+	}
+}
+
+// AddedLastFailure returns the value that was added to the "last_failure" field in this mutation.
+func (m *EndpointHealthMutation) AddedLastFailure() (r int64, exists bool) {
+	v := m.addlast_failure
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLastFailure clears the value of the "last_failure" field.
+func (m *EndpointHealthMutation) ClearLastFailure() {
+	m.last_failure = nil
+	m.addlast_failure = nil
+	m.clearedFields[endpointhealth.FieldLastFailure] = struct{}{}
+}
+
+// LastFailureCleared returns if the "last_failure" field was cleared in this mutation.
+func (m *EndpointHealthMutation) LastFailureCleared() bool {
+	_, ok := m.clearedFields[endpointhealth.FieldLastFailure]
+	return ok
+}
+
+// ResetLastFailure resets all changes to the "last_failure" field.
+func (m *EndpointHealthMutation) ResetLastFailure() {
+	m.last_failure = nil
+	m.addlast_failure = nil
+	delete(m.clearedFields, endpointhealth.FieldLastFailure)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *EndpointHealthMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *EndpointHealthMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the EndpointHealth entity.
+// If the EndpointHealth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointHealthMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *EndpointHealthMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[endpointhealth.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *EndpointHealthMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[endpointhealth.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *EndpointHealthMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, endpointhealth.FieldLastError)
+}
+
+// SetLatency sets the "latency" field.
+func (m *EndpointHealthMutation) SetLatency(i int64) {
+	m.latency = &i
+	m.addlatency = nil
+}
+
+// Latency returns the value of the "latency" field in the mutation.
+func (m *EndpointHealthMutation) Latency() (r int64, exists bool) {
+	v := m.latency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatency returns the old "latency" field's value of the EndpointHealth entity.
+// If the EndpointHealth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointHealthMutation) OldLatency(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatency: %w", err)
+	}
+	return oldValue.Latency, nil
+}
+
+// AddLatency adds i to the "latency" field.
+func (m *EndpointHealthMutation) AddLatency(i int64) {
+	if m.addlatency != nil {
+		*m.addlatency += i
+	} else {
+		m.addlatency = &i
+	}
+}
+
+// AddedLatency returns the value that was added to the "latency" field in this mutation.
+func (m *EndpointHealthMutation) AddedLatency() (r int64, exists bool) {
+	v := m.addlatency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLatency clears the value of the "latency" field.
+func (m *EndpointHealthMutation) ClearLatency() {
+	m.latency = nil
+	m.addlatency = nil
+	m.clearedFields[endpointhealth.FieldLatency] = struct{}{}
+}
+
+// LatencyCleared returns if the "latency" field was cleared in this mutation.
+func (m *EndpointHealthMutation) LatencyCleared() bool {
+	_, ok := m.clearedFields[endpointhealth.FieldLatency]
+	return ok
+}
+
+// ResetLatency resets all changes to the "latency" field.
+func (m *EndpointHealthMutation) ResetLatency() {
+	m.latency = nil
+	m.addlatency = nil
+	delete(m.clearedFields, endpointhealth.FieldLatency)
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (m *EndpointHealthMutation) SetFailureCount(i int32) {
+	m.failure_count = &i
+	m.addfailure_count = nil
+}
+
+// FailureCount returns the value of the "failure_count" field in the mutation.
+func (m *EndpointHealthMutation) FailureCount() (r int32, exists bool) {
+	v := m.failure_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailureCount returns the old "failure_count" field's value of the EndpointHealth entity.
+// If the EndpointHealth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointHealthMutation) OldFailureCount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailureCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailureCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailureCount: %w", err)
+	}
+	return oldValue.FailureCount, nil
+}
+
+// AddFailureCount adds i to the "failure_count" field.
+func (m *EndpointHealthMutation) AddFailureCount(i int32) {
+	if m.addfailure_count != nil {
+		*m.addfailure_count += i
+	} else {
+		m.addfailure_count = &i
+	}
+}
+
+// AddedFailureCount returns the value that was added to the "failure_count" field in this mutation.
+func (m *EndpointHealthMutation) AddedFailureCount() (r int32, exists bool) {
+	v := m.addfailure_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFailureCount clears the value of the "failure_count" field.
+func (m *EndpointHealthMutation) ClearFailureCount() {
+	m.failure_count = nil
+	m.addfailure_count = nil
+	m.clearedFields[endpointhealth.FieldFailureCount] = struct{}{}
+}
+
+// FailureCountCleared returns if the "failure_count" field was cleared in this mutation.
+func (m *EndpointHealthMutation) FailureCountCleared() bool {
+	_, ok := m.clearedFields[endpointhealth.FieldFailureCount]
+	return ok
+}
+
+// ResetFailureCount resets all changes to the "failure_count" field.
+func (m *EndpointHealthMutation) ResetFailureCount() {
+	m.failure_count = nil
+	m.addfailure_count = nil
+	delete(m.clearedFields, endpointhealth.FieldFailureCount)
+}
+
+// Where appends a list predicates to the EndpointHealthMutation builder.
+func (m *EndpointHealthMutation) Where(ps ...predicate.EndpointHealth) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EndpointHealthMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EndpointHealthMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EndpointHealth, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EndpointHealthMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EndpointHealthMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EndpointHealth).
+func (m *EndpointHealthMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EndpointHealthMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.last_success != nil {
+		fields = append(fields, endpointhealth.FieldLastSuccess)
+	}
+	if m.last_failure != nil {
+		fields = append(fields, endpointhealth.FieldLastFailure)
+	}
+	if m.last_error != nil {
+		fields = append(fields, endpointhealth.FieldLastError)
+	}
+	if m.latency != nil {
+		fields = append(fields, endpointhealth.FieldLatency)
+	}
+	if m.failure_count != nil {
+		fields = append(fields, endpointhealth.FieldFailureCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EndpointHealthMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case endpointhealth.FieldLastSuccess:
+		return m.LastSuccess()
+	case endpointhealth.FieldLastFailure:
+		return m.LastFailure()
+	case endpointhealth.FieldLastError:
+		return m.LastError()
+	case endpointhealth.FieldLatency:
+		return m.Latency()
+	case endpointhealth.FieldFailureCount:
+		return m.FailureCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EndpointHealthMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case endpointhealth.FieldLastSuccess:
+		return m.OldLastSuccess(ctx)
+	case endpointhealth.FieldLastFailure:
+		return m.OldLastFailure(ctx)
+	case endpointhealth.FieldLastError:
+		return m.OldLastError(ctx)
+	case endpointhealth.FieldLatency:
+		return m.OldLatency(ctx)
+	case endpointhealth.FieldFailureCount:
+		return m.OldFailureCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown EndpointHealth field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EndpointHealthMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case endpointhealth.FieldLastSuccess:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSuccess(v)
+		return nil
+	case endpointhealth.FieldLastFailure:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastFailure(v)
+		return nil
+	case endpointhealth.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case endpointhealth.FieldLatency:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatency(v)
+		return nil
+	case endpointhealth.FieldFailureCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailureCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EndpointHealth field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EndpointHealthMutation) AddedFields() []string {
+	var fields []string
+	if m.addlast_success != nil {
+		fields = append(fields, endpointhealth.FieldLastSuccess)
+	}
+	if m.addlast_failure != nil {
+		fields = append(fields, endpointhealth.FieldLastFailure)
+	}
+	if m.addlatency != nil {
+		fields = append(fields, endpointhealth.FieldLatency)
+	}
+	if m.addfailure_count != nil {
+		fields = append(fields, endpointhealth.FieldFailureCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EndpointHealthMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case endpointhealth.FieldLastSuccess:
+		return m.AddedLastSuccess()
+	case endpointhealth.FieldLastFailure:
+		return m.AddedLastFailure()
+	case endpointhealth.FieldLatency:
+		return m.AddedLatency()
+	case endpointhealth.FieldFailureCount:
+		return m.AddedFailureCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EndpointHealthMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case endpointhealth.FieldLastSuccess:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastSuccess(v)
+		return nil
+	case endpointhealth.FieldLastFailure:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastFailure(v)
+		return nil
+	case endpointhealth.FieldLatency:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatency(v)
+		return nil
+	case endpointhealth.FieldFailureCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailureCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EndpointHealth numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EndpointHealthMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(endpointhealth.FieldLastSuccess) {
+		fields = append(fields, endpointhealth.FieldLastSuccess)
+	}
+	if m.FieldCleared(endpointhealth.FieldLastFailure) {
+		fields = append(fields, endpointhealth.FieldLastFailure)
+	}
+	if m.FieldCleared(endpointhealth.FieldLastError) {
+		fields = append(fields, endpointhealth.FieldLastError)
+	}
+	if m.FieldCleared(endpointhealth.FieldLatency) {
+		fields = append(fields, endpointhealth.FieldLatency)
+	}
+	if m.FieldCleared(endpointhealth.FieldFailureCount) {
+		fields = append(fields, endpointhealth.FieldFailureCount)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EndpointHealthMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EndpointHealthMutation) ClearField(name string) error {
+	switch name {
+	case endpointhealth.FieldLastSuccess:
+		m.ClearLastSuccess()
+		return nil
+	case endpointhealth.FieldLastFailure:
+		m.ClearLastFailure()
+		return nil
+	case endpointhealth.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case endpointhealth.FieldLatency:
+		m.ClearLatency()
+		return nil
+	case endpointhealth.FieldFailureCount:
+		m.ClearFailureCount()
+		return nil
+	}
+	return fmt.Errorf("unknown EndpointHealth nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EndpointHealthMutation) ResetField(name string) error {
+	switch name {
+	case endpointhealth.FieldLastSuccess:
+		m.ResetLastSuccess()
+		return nil
+	case endpointhealth.FieldLastFailure:
+		m.ResetLastFailure()
+		return nil
+	case endpointhealth.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case endpointhealth.FieldLatency:
+		m.ResetLatency()
+		return nil
+	case endpointhealth.FieldFailureCount:
+		m.ResetFailureCount()
+		return nil
+	}
+	return fmt.Errorf("unknown EndpointHealth field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EndpointHealthMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EndpointHealthMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EndpointHealthMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EndpointHealthMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EndpointHealthMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EndpointHealthMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EndpointHealthMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EndpointHealth unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EndpointHealthMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EndpointHealth edge %s", name)
+}
+
+// LatencySampleMutation represents an operation that mutates the LatencySample nodes in the graph.
+type LatencySampleMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	timestamp             *int64
+	addtimestamp          *int64
+	latency               *int64
+	addlatency            *int64
+	clearedFields         map[string]struct{}
+	network_device        *string
+	clearednetwork_device bool
+	endpoint              *string
+	clearedendpoint       bool
+	done                  bool
+	oldValue              func(context.Context) (*LatencySample, error)
+	predicates            []predicate.LatencySample
+}
+
+var _ ent.Mutation = (*LatencySampleMutation)(nil)
+
+// latencysampleOption allows management of the mutation configuration using functional options.
+type latencysampleOption func(*LatencySampleMutation)
+
+// newLatencySampleMutation creates new mutation for the LatencySample entity.
+func newLatencySampleMutation(c config, op Op, opts ...latencysampleOption) *LatencySampleMutation {
+	m := &LatencySampleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLatencySample,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLatencySampleID sets the ID field of the mutation.
+func withLatencySampleID(id string) latencysampleOption {
+	return func(m *LatencySampleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LatencySample
+		)
+		m.oldValue = func(ctx context.Context) (*LatencySample, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LatencySample.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLatencySample sets the old LatencySample of the mutation.
+func withLatencySample(node *LatencySample) latencysampleOption {
+	return func(m *LatencySampleMutation) {
+		m.oldValue = func(context.Context) (*LatencySample, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LatencySampleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LatencySampleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LatencySample entities.
+func (m *LatencySampleMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LatencySampleMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LatencySampleMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LatencySample.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *LatencySampleMutation) SetTimestamp(i int64) {
+	m.timestamp = &i
+	m.addtimestamp = nil
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *LatencySampleMutation) Timestamp() (r int64, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the LatencySample entity.
+// If the LatencySample object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatencySampleMutation) OldTimestamp(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// AddTimestamp adds i to the "timestamp" field.
+func (m *LatencySampleMutation) AddTimestamp(i int64) {
+	if m.addtimestamp != nil {
+		*m.addtimestamp += i
+	} else {
+		m.addtimestamp = &i
+	}
+}
+
+// AddedTimestamp returns the value that was added to the "timestamp" field in this mutation.
+func (m *LatencySampleMutation) AddedTimestamp() (r int64, exists bool) {
+	v := m.addtimestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *LatencySampleMutation) ResetTimestamp() {
+	m.timestamp = nil
+	m.addtimestamp = nil
+}
+
+// SetLatency sets the "latency" field.
+func (m *LatencySampleMutation) SetLatency(i int64) {
+	m.latency = &i
+	m.addlatency = nil
+}
+
+// Latency returns the value of the "latency" field in the mutation.
+func (m *LatencySampleMutation) Latency() (r int64, exists bool) {
+	v := m.latency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatency returns the old "latency" field's value of the LatencySample entity.
+// If the LatencySample object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatencySampleMutation) OldLatency(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatency: %w", err)
+	}
+	return oldValue.Latency, nil
+}
+
+// AddLatency adds i to the "latency" field.
+func (m *LatencySampleMutation) AddLatency(i int64) {
+	if m.addlatency != nil {
+		*m.addlatency += i
+	} else {
+		m.addlatency = &i
+	}
+}
+
+// AddedLatency returns the value that was added to the "latency" field in this mutation.
+func (m *LatencySampleMutation) AddedLatency() (r int64, exists bool) {
+	v := m.addlatency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatency resets all changes to the "latency" field.
+func (m *LatencySampleMutation) ResetLatency() {
+	m.latency = nil
+	m.addlatency = nil
+}
+
+// SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by id.
+func (m *LatencySampleMutation) SetNetworkDeviceID(id string) {
+	m.network_device = &id
+}
+
+// ClearNetworkDevice clears the "network_device" edge to the NetworkDevice entity.
+func (m *LatencySampleMutation) ClearNetworkDevice() {
+	m.clearednetwork_device = true
+}
+
+// NetworkDeviceCleared reports if the "network_device" edge to the NetworkDevice entity was cleared.
+func (m *LatencySampleMutation) NetworkDeviceCleared() bool {
+	return m.clearednetwork_device
+}
+
+// NetworkDeviceID returns the "network_device" edge ID in the mutation.
+func (m *LatencySampleMutation) NetworkDeviceID() (id string, exists bool) {
+	if m.network_device != nil {
+		return *m.network_device, true
+	}
+	return
+}
+
+// NetworkDeviceIDs returns the "network_device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NetworkDeviceID instead. It exists only for internal usage by the builders.
+func (m *LatencySampleMutation) NetworkDeviceIDs() (ids []string) {
+	if id := m.network_device; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNetworkDevice resets all changes to the "network_device" edge.
+func (m *LatencySampleMutation) ResetNetworkDevice() {
+	m.network_device = nil
+	m.clearednetwork_device = false
+}
+
+// SetEndpointID sets the "endpoint" edge to the Endpoint entity by id.
+func (m *LatencySampleMutation) SetEndpointID(id string) {
+	m.endpoint = &id
+}
+
+// ClearEndpoint clears the "endpoint" edge to the Endpoint entity.
+func (m *LatencySampleMutation) ClearEndpoint() {
+	m.clearedendpoint = true
+}
+
+// EndpointCleared reports if the "endpoint" edge to the Endpoint entity was cleared.
+func (m *LatencySampleMutation) EndpointCleared() bool {
+	return m.clearedendpoint
+}
+
+// EndpointID returns the "endpoint" edge ID in the mutation.
+func (m *LatencySampleMutation) EndpointID() (id string, exists bool) {
+	if m.endpoint != nil {
+		return *m.endpoint, true
+	}
+	return
+}
+
+// EndpointIDs returns the "endpoint" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EndpointID instead. It exists only for internal usage by the builders.
+func (m *LatencySampleMutation) EndpointIDs() (ids []string) {
+	if id := m.endpoint; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEndpoint resets all changes to the "endpoint" edge.
+func (m *LatencySampleMutation) ResetEndpoint() {
+	m.endpoint = nil
+	m.clearedendpoint = false
+}
+
+// Where appends a list predicates to the LatencySampleMutation builder.
+func (m *LatencySampleMutation) Where(ps ...predicate.LatencySample) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LatencySampleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LatencySampleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LatencySample, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LatencySampleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LatencySampleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LatencySample).
+func (m *LatencySampleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LatencySampleMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.timestamp != nil {
+		fields = append(fields, latencysample.FieldTimestamp)
+	}
+	if m.latency != nil {
+		fields = append(fields, latencysample.FieldLatency)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LatencySampleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case latencysample.FieldTimestamp:
+		return m.Timestamp()
+	case latencysample.FieldLatency:
+		return m.Latency()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LatencySampleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case latencysample.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case latencysample.FieldLatency:
+		return m.OldLatency(ctx)
+	}
+	return nil, fmt.Errorf("unknown LatencySample field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LatencySampleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case latencysample.FieldTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case latencysample.FieldLatency:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatency(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LatencySample field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LatencySampleMutation) AddedFields() []string {
+	var fields []string
+	if m.addtimestamp != nil {
+		fields = append(fields, latencysample.FieldTimestamp)
+	}
+	if m.addlatency != nil {
+		fields = append(fields, latencysample.FieldLatency)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LatencySampleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case latencysample.FieldTimestamp:
+		return m.AddedTimestamp()
+	case latencysample.FieldLatency:
+		return m.AddedLatency()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LatencySampleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case latencysample.FieldTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimestamp(v)
+		return nil
+	case latencysample.FieldLatency:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatency(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LatencySample numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LatencySampleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LatencySampleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LatencySampleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LatencySample nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LatencySampleMutation) ResetField(name string) error {
+	switch name {
+	case latencysample.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case latencysample.FieldLatency:
+		m.ResetLatency()
+		return nil
+	}
+	return fmt.Errorf("unknown LatencySample field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LatencySampleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.network_device != nil {
+		edges = append(edges, latencysample.EdgeNetworkDevice)
+	}
+	if m.endpoint != nil {
+		edges = append(edges, latencysample.EdgeEndpoint)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LatencySampleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case latencysample.EdgeNetworkDevice:
+		if id := m.network_device; id != nil {
+			return []ent.Value{*id}
+		}
+	case latencysample.EdgeEndpoint:
+		if id := m.endpoint; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LatencySampleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LatencySampleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LatencySampleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearednetwork_device {
+		edges = append(edges, latencysample.EdgeNetworkDevice)
+	}
+	if m.clearedendpoint {
+		edges = append(edges, latencysample.EdgeEndpoint)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LatencySampleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case latencysample.EdgeNetworkDevice:
+		return m.clearednetwork_device
+	case latencysample.EdgeEndpoint:
+		return m.clearedendpoint
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LatencySampleMutation) ClearEdge(name string) error {
+	switch name {
+	case latencysample.EdgeNetworkDevice:
+		m.ClearNetworkDevice()
+		return nil
+	case latencysample.EdgeEndpoint:
+		m.ClearEndpoint()
+		return nil
+	}
+	return fmt.Errorf("unknown LatencySample unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LatencySampleMutation) ResetEdge(name string) error {
+	switch name {
+	case latencysample.EdgeNetworkDevice:
+		m.ResetNetworkDevice()
+		return nil
+	case latencysample.EdgeEndpoint:
+		m.ResetEndpoint()
+		return nil
+	}
+	return fmt.Errorf("unknown LatencySample edge %s", name)
+}
+
+// LeaseMutation represents an operation that mutates the Lease nodes in the graph.
+type LeaseMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	holder        *string
+	expires_at    *int64
+	addexpires_at *int64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Lease, error)
+	predicates    []predicate.Lease
+}
+
+var _ ent.Mutation = (*LeaseMutation)(nil)
+
+// leaseOption allows management of the mutation configuration using functional options.
+type leaseOption func(*LeaseMutation)
+
+// newLeaseMutation creates new mutation for the Lease entity.
+func newLeaseMutation(c config, op Op, opts ...leaseOption) *LeaseMutation {
+	m := &LeaseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLease,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLeaseID sets the ID field of the mutation.
+func withLeaseID(id string) leaseOption {
+	return func(m *LeaseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Lease
+		)
+		m.oldValue = func(ctx context.Context) (*Lease, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Lease.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLease sets the old Lease of the mutation.
+func withLease(node *Lease) leaseOption {
+	return func(m *LeaseMutation) {
+		m.oldValue = func(context.Context) (*Lease, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LeaseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LeaseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Lease entities.
+func (m *LeaseMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LeaseMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LeaseMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Lease.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHolder sets the "holder" field.
+func (m *LeaseMutation) SetHolder(s string) {
+	m.holder = &s
+}
+
+// Holder returns the value of the "holder" field in the mutation.
+func (m *LeaseMutation) Holder() (r string, exists bool) {
+	v := m.holder
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHolder returns the old "holder" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldHolder(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHolder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHolder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHolder: %w", err)
+	}
+	return oldValue.Holder, nil
+}
+
+// ResetHolder resets all changes to the "holder" field.
+func (m *LeaseMutation) ResetHolder() {
+	m.holder = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *LeaseMutation) SetExpiresAt(i int64) {
+	m.expires_at = &i
+	m.addexpires_at = nil
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *LeaseMutation) ExpiresAt() (r int64, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldExpiresAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// AddExpiresAt adds i to the "expires_at" field.
+func (m *LeaseMutation) AddExpiresAt(i int64) {
+	if m.addexpires_at != nil {
+		*m.addexpires_at += i
+	} else {
+		m.addexpires_at = &i
+	}
+}
+
+// AddedExpiresAt returns the value that was added to the "expires_at" field in this mutation.
+func (m *LeaseMutation) AddedExpiresAt() (r int64, exists bool) {
+	v := m.addexpires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *LeaseMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	m.addexpires_at = nil
+}
+
+// Where appends a list predicates to the LeaseMutation builder.
+func (m *LeaseMutation) Where(ps ...predicate.Lease) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LeaseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LeaseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Lease, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LeaseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LeaseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Lease).
+func (m *LeaseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LeaseMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.holder != nil {
+		fields = append(fields, lease.FieldHolder)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, lease.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LeaseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case lease.FieldHolder:
+		return m.Holder()
+	case lease.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LeaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case lease.FieldHolder:
+		return m.OldHolder(ctx)
+	case lease.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Lease field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case lease.FieldHolder:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHolder(v)
+		return nil
+	case lease.FieldExpiresAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Lease field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LeaseMutation) AddedFields() []string {
+	var fields []string
+	if m.addexpires_at != nil {
+		fields = append(fields, lease.FieldExpiresAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LeaseMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case lease.FieldExpiresAt:
+		return m.AddedExpiresAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case lease.FieldExpiresAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Lease numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LeaseMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LeaseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LeaseMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Lease nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LeaseMutation) ResetField(name string) error {
+	switch name {
+	case lease.FieldHolder:
+		m.ResetHolder()
+		return nil
+	case lease.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Lease field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LeaseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LeaseMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LeaseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LeaseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LeaseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LeaseMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LeaseMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Lease unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LeaseMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Lease edge %s", name)
+}
+
+// MaintenanceWindowMutation represents an operation that mutates the MaintenanceWindow nodes in the graph.
+type MaintenanceWindowMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *string
+	name                   *string
+	group                  *string
+	site                   *string
+	starts_at              *int64
+	addstarts_at           *int64
+	ends_at                *int64
+	addends_at             *int64
+	recurrence             *string
+	clearedFields          map[string]struct{}
+	network_devices        map[string]struct{}
+	removednetwork_devices map[string]struct{}
+	clearednetwork_devices bool
+	done                   bool
+	oldValue               func(context.Context) (*MaintenanceWindow, error)
+	predicates             []predicate.MaintenanceWindow
+}
+
+var _ ent.Mutation = (*MaintenanceWindowMutation)(nil)
+
+// maintenancewindowOption allows management of the mutation configuration using functional options.
+type maintenancewindowOption func(*MaintenanceWindowMutation)
+
+// newMaintenanceWindowMutation creates new mutation for the MaintenanceWindow entity.
+func newMaintenanceWindowMutation(c config, op Op, opts ...maintenancewindowOption) *MaintenanceWindowMutation {
+	m := &MaintenanceWindowMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMaintenanceWindow,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMaintenanceWindowID sets the ID field of the mutation.
+func withMaintenanceWindowID(id string) maintenancewindowOption {
+	return func(m *MaintenanceWindowMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MaintenanceWindow
+		)
+		m.oldValue = func(ctx context.Context) (*MaintenanceWindow, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MaintenanceWindow.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMaintenanceWindow sets the old MaintenanceWindow of the mutation.
+func withMaintenanceWindow(node *MaintenanceWindow) maintenancewindowOption {
+	return func(m *MaintenanceWindowMutation) {
+		m.oldValue = func(context.Context) (*MaintenanceWindow, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MaintenanceWindowMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MaintenanceWindowMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MaintenanceWindow entities.
+func (m *MaintenanceWindowMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MaintenanceWindowMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MaintenanceWindowMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MaintenanceWindow.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *MaintenanceWindowMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MaintenanceWindowMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MaintenanceWindowMutation) ResetName() {
+	m.name = nil
+}
+
+// SetGroup sets the "group" field.
+func (m *MaintenanceWindowMutation) SetGroup(s string) {
+	m.group = &s
+}
+
+// Group returns the value of the "group" field in the mutation.
+func (m *MaintenanceWindowMutation) Group() (r string, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroup returns the old "group" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldGroup(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroup: %w", err)
+	}
+	return oldValue.Group, nil
+}
+
+// ClearGroup clears the value of the "group" field.
+func (m *MaintenanceWindowMutation) ClearGroup() {
+	m.group = nil
+	m.clearedFields[maintenancewindow.FieldGroup] = struct{}{}
+}
+
+// GroupCleared returns if the "group" field was cleared in this mutation.
+func (m *MaintenanceWindowMutation) GroupCleared() bool {
+	_, ok := m.clearedFields[maintenancewindow.FieldGroup]
+	return ok
+}
+
+// ResetGroup resets all changes to the "group" field.
+func (m *MaintenanceWindowMutation) ResetGroup() {
+	m.group = nil
+	delete(m.clearedFields, maintenancewindow.FieldGroup)
+}
+
+// SetSite sets the "site" field.
+func (m *MaintenanceWindowMutation) SetSite(s string) {
+	m.site = &s
+}
+
+// Site returns the value of the "site" field in the mutation.
+func (m *MaintenanceWindowMutation) Site() (r string, exists bool) {
+	v := m.site
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSite returns the old "site" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldSite(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSite: %w", err)
+	}
+	return oldValue.Site, nil
+}
+
+// ClearSite clears the value of the "site" field.
+func (m *MaintenanceWindowMutation) ClearSite() {
+	m.site = nil
+	m.clearedFields[maintenancewindow.FieldSite] = struct{}{}
+}
+
+// SiteCleared returns if the "site" field was cleared in this mutation.
+func (m *MaintenanceWindowMutation) SiteCleared() bool {
+	_, ok := m.clearedFields[maintenancewindow.FieldSite]
+	return ok
+}
+
+// ResetSite resets all changes to the "site" field.
+func (m *MaintenanceWindowMutation) ResetSite() {
+	m.site = nil
+	delete(m.clearedFields, maintenancewindow.FieldSite)
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *MaintenanceWindowMutation) SetStartsAt(i int64) {
+	m.starts_at = &i
+	m.addstarts_at = nil
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *MaintenanceWindowMutation) StartsAt() (r int64, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldStartsAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// AddStartsAt adds i to the "starts_at" field.
+func (m *MaintenanceWindowMutation) AddStartsAt(i int64) {
+	if m.addstarts_at != nil {
+		*m.addstarts_at += i
+	} else {
+		m.addstarts_at = &i
+	}
+}
+
+// AddedStartsAt returns the value that was added to the "starts_at" field in this mutation.
+func (m *MaintenanceWindowMutation) AddedStartsAt() (r int64, exists bool) {
+	v := m.addstarts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *MaintenanceWindowMutation) ResetStartsAt() {
+	m.starts_at = nil
+	m.addstarts_at = nil
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *MaintenanceWindowMutation) SetEndsAt(i int64) {
+	m.ends_at = &i
+	m.addends_at = nil
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *MaintenanceWindowMutation) EndsAt() (r int64, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldEndsAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// AddEndsAt adds i to the "ends_at" field.
+func (m *MaintenanceWindowMutation) AddEndsAt(i int64) {
+	if m.addends_at != nil {
+		*m.addends_at += i
+	} else {
+		m.addends_at = &i
+	}
+}
+
+// AddedEndsAt returns the value that was added to the "ends_at" field in this mutation.
+func (m *MaintenanceWindowMutation) AddedEndsAt() (r int64, exists bool) {
+	v := m.addends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *MaintenanceWindowMutation) ResetEndsAt() {
+	m.ends_at = nil
+	m.addends_at = nil
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (m *MaintenanceWindowMutation) SetRecurrence(s string) {
+	m.recurrence = &s
+}
+
+// Recurrence returns the value of the "recurrence" field in the mutation.
+func (m *MaintenanceWindowMutation) Recurrence() (r string, exists bool) {
+	v := m.recurrence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecurrence returns the old "recurrence" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldRecurrence(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecurrence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecurrence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecurrence: %w", err)
+	}
+	return oldValue.Recurrence, nil
+}
+
+// ClearRecurrence clears the value of the "recurrence" field.
+func (m *MaintenanceWindowMutation) ClearRecurrence() {
+	m.recurrence = nil
+	m.clearedFields[maintenancewindow.FieldRecurrence] = struct{}{}
+}
+
+// RecurrenceCleared returns if the "recurrence" field was cleared in this mutation.
+func (m *MaintenanceWindowMutation) RecurrenceCleared() bool {
+	_, ok := m.clearedFields[maintenancewindow.FieldRecurrence]
+	return ok
+}
+
+// ResetRecurrence resets all changes to the "recurrence" field.
+func (m *MaintenanceWindowMutation) ResetRecurrence() {
+	m.recurrence = nil
+	delete(m.clearedFields, maintenancewindow.FieldRecurrence)
+}
+
+// AddNetworkDeviceIDs adds the "network_devices" edge to the NetworkDevice entity by ids.
+func (m *MaintenanceWindowMutation) AddNetworkDeviceIDs(ids ...string) {
+	if m.network_devices == nil {
+		m.network_devices = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.network_devices[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNetworkDevices clears the "network_devices" edge to the NetworkDevice entity.
+func (m *MaintenanceWindowMutation) ClearNetworkDevices() {
+	m.clearednetwork_devices = true
+}
+
+// NetworkDevicesCleared reports if the "network_devices" edge to the NetworkDevice entity was cleared.
+func (m *MaintenanceWindowMutation) NetworkDevicesCleared() bool {
+	return m.clearednetwork_devices
+}
+
+// RemoveNetworkDeviceIDs removes the "network_devices" edge to the NetworkDevice entity by IDs.
+func (m *MaintenanceWindowMutation) RemoveNetworkDeviceIDs(ids ...string) {
+	if m.removednetwork_devices == nil {
+		m.removednetwork_devices = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.network_devices, ids[i])
+		m.removednetwork_devices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNetworkDevices returns the removed IDs of the "network_devices" edge to the NetworkDevice entity.
+func (m *MaintenanceWindowMutation) RemovedNetworkDevicesIDs() (ids []string) {
+	for id := range m.removednetwork_devices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NetworkDevicesIDs returns the "network_devices" edge IDs in the mutation.
+func (m *MaintenanceWindowMutation) NetworkDevicesIDs() (ids []string) {
+	for id := range m.network_devices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNetworkDevices resets all changes to the "network_devices" edge.
+func (m *MaintenanceWindowMutation) ResetNetworkDevices() {
+	m.network_devices = nil
+	m.clearednetwork_devices = false
+	m.removednetwork_devices = nil
+}
+
+// Where appends a list predicates to the MaintenanceWindowMutation builder.
+func (m *MaintenanceWindowMutation) Where(ps ...predicate.MaintenanceWindow) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MaintenanceWindowMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MaintenanceWindowMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MaintenanceWindow, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MaintenanceWindowMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MaintenanceWindowMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MaintenanceWindow).
+func (m *MaintenanceWindowMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MaintenanceWindowMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, maintenancewindow.FieldName)
+	}
+	if m.group != nil {
+		fields = append(fields, maintenancewindow.FieldGroup)
+	}
+	if m.site != nil {
+		fields = append(fields, maintenancewindow.FieldSite)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, maintenancewindow.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, maintenancewindow.FieldEndsAt)
+	}
+	if m.recurrence != nil {
+		fields = append(fields, maintenancewindow.FieldRecurrence)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MaintenanceWindowMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case maintenancewindow.FieldName:
+		return m.Name()
+	case maintenancewindow.FieldGroup:
+		return m.Group()
+	case maintenancewindow.FieldSite:
+		return m.Site()
+	case maintenancewindow.FieldStartsAt:
+		return m.StartsAt()
+	case maintenancewindow.FieldEndsAt:
+		return m.EndsAt()
+	case maintenancewindow.FieldRecurrence:
+		return m.Recurrence()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MaintenanceWindowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case maintenancewindow.FieldName:
+		return m.OldName(ctx)
+	case maintenancewindow.FieldGroup:
+		return m.OldGroup(ctx)
+	case maintenancewindow.FieldSite:
+		return m.OldSite(ctx)
+	case maintenancewindow.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case maintenancewindow.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case maintenancewindow.FieldRecurrence:
+		return m.OldRecurrence(ctx)
+	}
+	return nil, fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceWindowMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case maintenancewindow.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case maintenancewindow.FieldGroup:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroup(v)
+		return nil
+	case maintenancewindow.FieldSite:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSite(v)
+		return nil
+	case maintenancewindow.FieldStartsAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case maintenancewindow.FieldEndsAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
+		return nil
+	case maintenancewindow.FieldRecurrence:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecurrence(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MaintenanceWindowMutation) AddedFields() []string {
+	var fields []string
+	if m.addstarts_at != nil {
+		fields = append(fields, maintenancewindow.FieldStartsAt)
+	}
+	if m.addends_at != nil {
+		fields = append(fields, maintenancewindow.FieldEndsAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MaintenanceWindowMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case maintenancewindow.FieldStartsAt:
+		return m.AddedStartsAt()
+	case maintenancewindow.FieldEndsAt:
+		return m.AddedEndsAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceWindowMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case maintenancewindow.FieldStartsAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartsAt(v)
+		return nil
+	case maintenancewindow.FieldEndsAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndsAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MaintenanceWindowMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(maintenancewindow.FieldGroup) {
+		fields = append(fields, maintenancewindow.FieldGroup)
+	}
+	if m.FieldCleared(maintenancewindow.FieldSite) {
+		fields = append(fields, maintenancewindow.FieldSite)
+	}
+	if m.FieldCleared(maintenancewindow.FieldRecurrence) {
+		fields = append(fields, maintenancewindow.FieldRecurrence)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MaintenanceWindowMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MaintenanceWindowMutation) ClearField(name string) error {
+	switch name {
+	case maintenancewindow.FieldGroup:
+		m.ClearGroup()
+		return nil
+	case maintenancewindow.FieldSite:
+		m.ClearSite()
+		return nil
+	case maintenancewindow.FieldRecurrence:
+		m.ClearRecurrence()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MaintenanceWindowMutation) ResetField(name string) error {
+	switch name {
+	case maintenancewindow.FieldName:
+		m.ResetName()
+		return nil
+	case maintenancewindow.FieldGroup:
+		m.ResetGroup()
+		return nil
+	case maintenancewindow.FieldSite:
+		m.ResetSite()
+		return nil
+	case maintenancewindow.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case maintenancewindow.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case maintenancewindow.FieldRecurrence:
+		m.ResetRecurrence()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MaintenanceWindowMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.network_devices != nil {
+		edges = append(edges, maintenancewindow.EdgeNetworkDevices)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MaintenanceWindowMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case maintenancewindow.EdgeNetworkDevices:
+		ids := make([]ent.Value, 0, len(m.network_devices))
+		for id := range m.network_devices {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MaintenanceWindowMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removednetwork_devices != nil {
+		edges = append(edges, maintenancewindow.EdgeNetworkDevices)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MaintenanceWindowMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case maintenancewindow.EdgeNetworkDevices:
+		ids := make([]ent.Value, 0, len(m.removednetwork_devices))
+		for id := range m.removednetwork_devices {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MaintenanceWindowMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearednetwork_devices {
+		edges = append(edges, maintenancewindow.EdgeNetworkDevices)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MaintenanceWindowMutation) EdgeCleared(name string) bool {
+	switch name {
+	case maintenancewindow.EdgeNetworkDevices:
+		return m.clearednetwork_devices
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MaintenanceWindowMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MaintenanceWindow unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MaintenanceWindowMutation) ResetEdge(name string) error {
+	switch name {
+	case maintenancewindow.EdgeNetworkDevices:
+		m.ResetNetworkDevices()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow edge %s", name)
+}
+
+// NetworkDeviceMutation represents an operation that mutates the NetworkDevice nodes in the graph.
+type NetworkDeviceMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *string
+	vendor                     *networkdevice.Vendor
+	model                      *string
+	hw_version                 *string
+	poll_interval              *int32
+	addpoll_interval           *int32
+	group                      *string
+	site                       *string
+	clearedFields              map[string]struct{}
+	parent                     *string
+	clearedparent              bool
+	endpoints                  map[string]struct{}
+	removedendpoints           map[string]struct{}
+	clearedendpoints           bool
+	sw_version                 *string
+	clearedsw_version          bool
+	fw_version                 *string
+	clearedfw_version          bool
+	maintenance_windows        map[string]struct{}
+	removedmaintenance_windows map[string]struct{}
+	clearedmaintenance_windows bool
+	children                   map[string]struct{}
+	removedchildren            map[string]struct{}
+	clearedchildren            bool
+	done                       bool
+	oldValue                   func(context.Context) (*NetworkDevice, error)
+	predicates                 []predicate.NetworkDevice
+}
+
+var _ ent.Mutation = (*NetworkDeviceMutation)(nil)
+
+// networkdeviceOption allows management of the mutation configuration using functional options.
+type networkdeviceOption func(*NetworkDeviceMutation)
+
+// newNetworkDeviceMutation creates new mutation for the NetworkDevice entity.
+func newNetworkDeviceMutation(c config, op Op, opts ...networkdeviceOption) *NetworkDeviceMutation {
+	m := &NetworkDeviceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNetworkDevice,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNetworkDeviceID sets the ID field of the mutation.
+func withNetworkDeviceID(id string) networkdeviceOption {
+	return func(m *NetworkDeviceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NetworkDevice
+		)
+		m.oldValue = func(ctx context.Context) (*NetworkDevice, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NetworkDevice.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNetworkDevice sets the old NetworkDevice of the mutation.
+func withNetworkDevice(node *NetworkDevice) networkdeviceOption {
+	return func(m *NetworkDeviceMutation) {
+		m.oldValue = func(context.Context) (*NetworkDevice, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NetworkDeviceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NetworkDeviceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NetworkDevice entities.
+func (m *NetworkDeviceMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NetworkDeviceMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NetworkDeviceMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NetworkDevice.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVendor sets the "vendor" field.
+func (m *NetworkDeviceMutation) SetVendor(n networkdevice.Vendor) {
+	m.vendor = &n
+}
+
+// Vendor returns the value of the "vendor" field in the mutation.
+func (m *NetworkDeviceMutation) Vendor() (r networkdevice.Vendor, exists bool) {
+	v := m.vendor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVendor returns the old "vendor" field's value of the NetworkDevice entity.
+// If the NetworkDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkDeviceMutation) OldVendor(ctx context.Context) (v networkdevice.Vendor, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVendor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVendor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVendor: %w", err)
+	}
+	return oldValue.Vendor, nil
+}
+
+// ResetVendor resets all changes to the "vendor" field.
+func (m *NetworkDeviceMutation) ResetVendor() {
+	m.vendor = nil
+}
+
+// SetModel sets the "model" field.
+func (m *NetworkDeviceMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *NetworkDeviceMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the NetworkDevice entity.
+// If the NetworkDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkDeviceMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *NetworkDeviceMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetHwVersion sets the "hw_version" field.
+func (m *NetworkDeviceMutation) SetHwVersion(s string) {
+	m.hw_version = &s
+}
+
+// HwVersion returns the value of the "hw_version" field in the mutation.
+func (m *NetworkDeviceMutation) HwVersion() (r string, exists bool) {
+	v := m.hw_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHwVersion returns the old "hw_version" field's value of the NetworkDevice entity.
+// If the NetworkDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkDeviceMutation) OldHwVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHwVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHwVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHwVersion: %w", err)
+	}
+	return oldValue.HwVersion, nil
+}
+
+// ClearHwVersion clears the value of the "hw_version" field.
+func (m *NetworkDeviceMutation) ClearHwVersion() {
+	m.hw_version = nil
+	m.clearedFields[networkdevice.FieldHwVersion] = struct{}{}
+}
+
+// HwVersionCleared returns if the "hw_version" field was cleared in this mutation.
+func (m *NetworkDeviceMutation) HwVersionCleared() bool {
+	_, ok := m.clearedFields[networkdevice.FieldHwVersion]
+	return ok
+}
+
+// ResetHwVersion resets all changes to the "hw_version" field.
+func (m *NetworkDeviceMutation) ResetHwVersion() {
+	m.hw_version = nil
+	delete(m.clearedFields, networkdevice.FieldHwVersion)
+}
+
+// SetPollInterval sets the "poll_interval" field.
+func (m *NetworkDeviceMutation) SetPollInterval(i int32) {
+	m.poll_interval = &i
+	m.addpoll_interval = nil
+}
+
+// PollInterval returns the value of the "poll_interval" field in the mutation.
+func (m *NetworkDeviceMutation) PollInterval() (r int32, exists bool) {
+	v := m.poll_interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPollInterval returns the old "poll_interval" field's value of the NetworkDevice entity.
+// If the NetworkDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkDeviceMutation) OldPollInterval(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPollInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPollInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPollInterval: %w", err)
+	}
+	return oldValue.PollInterval, nil
+}
+
+// AddPollInterval adds i to the "poll_interval" field.
+func (m *NetworkDeviceMutation) AddPollInterval(i int32) {
+	if m.addpoll_interval != nil {
+		*m.addpoll_interval += i
+	} else {
+		m.addpoll_interval = &i
+	}
+}
+
+// AddedPollInterval returns the value that was added to the "poll_interval" field in this mutation.
+func (m *NetworkDeviceMutation) AddedPollInterval() (r int32, exists bool) {
+	v := m.addpoll_interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPollInterval clears the value of the "poll_interval" field.
+func (m *NetworkDeviceMutation) ClearPollInterval() {
+	m.poll_interval = nil
+	m.addpoll_interval = nil
+	m.clearedFields[networkdevice.FieldPollInterval] = struct{}{}
+}
+
+// PollIntervalCleared returns if the "poll_interval" field was cleared in this mutation.
+func (m *NetworkDeviceMutation) PollIntervalCleared() bool {
+	_, ok := m.clearedFields[networkdevice.FieldPollInterval]
+	return ok
+}
+
+// ResetPollInterval resets all changes to the "poll_interval" field.
+func (m *NetworkDeviceMutation) ResetPollInterval() {
+	m.poll_interval = nil
+	m.addpoll_interval = nil
+	delete(m.clearedFields, networkdevice.FieldPollInterval)
+}
+
+// SetGroup sets the "group" field.
+func (m *NetworkDeviceMutation) SetGroup(s string) {
+	m.group = &s
+}
+
+// Group returns the value of the "group" field in the mutation.
+func (m *NetworkDeviceMutation) Group() (r string, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroup returns the old "group" field's value of the NetworkDevice entity.
+// If the NetworkDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkDeviceMutation) OldGroup(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroup: %w", err)
+	}
+	return oldValue.Group, nil
+}
+
+// ClearGroup clears the value of the "group" field.
+func (m *NetworkDeviceMutation) ClearGroup() {
+	m.group = nil
+	m.clearedFields[networkdevice.FieldGroup] = struct{}{}
+}
+
+// GroupCleared returns if the "group" field was cleared in this mutation.
+func (m *NetworkDeviceMutation) GroupCleared() bool {
+	_, ok := m.clearedFields[networkdevice.FieldGroup]
+	return ok
+}
+
+// ResetGroup resets all changes to the "group" field.
+func (m *NetworkDeviceMutation) ResetGroup() {
+	m.group = nil
+	delete(m.clearedFields, networkdevice.FieldGroup)
+}
+
+// SetSite sets the "site" field.
+func (m *NetworkDeviceMutation) SetSite(s string) {
+	m.site = &s
+}
+
+// Site returns the value of the "site" field in the mutation.
+func (m *NetworkDeviceMutation) Site() (r string, exists bool) {
+	v := m.site
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSite returns the old "site" field's value of the NetworkDevice entity.
+// If the NetworkDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkDeviceMutation) OldSite(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSite: %w", err)
+	}
+	return oldValue.Site, nil
+}
+
+// ClearSite clears the value of the "site" field.
+func (m *NetworkDeviceMutation) ClearSite() {
+	m.site = nil
+	m.clearedFields[networkdevice.FieldSite] = struct{}{}
+}
+
+// SiteCleared returns if the "site" field was cleared in this mutation.
+func (m *NetworkDeviceMutation) SiteCleared() bool {
+	_, ok := m.clearedFields[networkdevice.FieldSite]
+	return ok
+}
+
+// ResetSite resets all changes to the "site" field.
+func (m *NetworkDeviceMutation) ResetSite() {
+	m.site = nil
+	delete(m.clearedFields, networkdevice.FieldSite)
+}
+
+// SetParentID sets the "parent" edge to the NetworkDevice entity by id.
+func (m *NetworkDeviceMutation) SetParentID(id string) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the NetworkDevice entity.
+func (m *NetworkDeviceMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the NetworkDevice entity was cleared.
+func (m *NetworkDeviceMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *NetworkDeviceMutation) ParentID() (id string, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *NetworkDeviceMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *NetworkDeviceMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddEndpointIDs adds the "endpoints" edge to the Endpoint entity by ids.
+func (m *NetworkDeviceMutation) AddEndpointIDs(ids ...string) {
+	if m.endpoints == nil {
+		m.endpoints = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.endpoints[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEndpoints clears the "endpoints" edge to the Endpoint entity.
+func (m *NetworkDeviceMutation) ClearEndpoints() {
+	m.clearedendpoints = true
+}
+
+// EndpointsCleared reports if the "endpoints" edge to the Endpoint entity was cleared.
+func (m *NetworkDeviceMutation) EndpointsCleared() bool {
+	return m.clearedendpoints
+}
+
+// RemoveEndpointIDs removes the "endpoints" edge to the Endpoint entity by IDs.
+func (m *NetworkDeviceMutation) RemoveEndpointIDs(ids ...string) {
+	if m.removedendpoints == nil {
+		m.removedendpoints = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.endpoints, ids[i])
+		m.removedendpoints[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEndpoints returns the removed IDs of the "endpoints" edge to the Endpoint entity.
+func (m *NetworkDeviceMutation) RemovedEndpointsIDs() (ids []string) {
+	for id := range m.removedendpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EndpointsIDs returns the "endpoints" edge IDs in the mutation.
+func (m *NetworkDeviceMutation) EndpointsIDs() (ids []string) {
+	for id := range m.endpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEndpoints resets all changes to the "endpoints" edge.
+func (m *NetworkDeviceMutation) ResetEndpoints() {
+	m.endpoints = nil
+	m.clearedendpoints = false
+	m.removedendpoints = nil
+}
+
+// SetSwVersionID sets the "sw_version" edge to the Version entity by id.
+func (m *NetworkDeviceMutation) SetSwVersionID(id string) {
+	m.sw_version = &id
+}
+
+// ClearSwVersion clears the "sw_version" edge to the Version entity.
+func (m *NetworkDeviceMutation) ClearSwVersion() {
+	m.clearedsw_version = true
+}
+
+// SwVersionCleared reports if the "sw_version" edge to the Version entity was cleared.
+func (m *NetworkDeviceMutation) SwVersionCleared() bool {
+	return m.clearedsw_version
+}
+
+// SwVersionID returns the "sw_version" edge ID in the mutation.
+func (m *NetworkDeviceMutation) SwVersionID() (id string, exists bool) {
+	if m.sw_version != nil {
+		return *m.sw_version, true
+	}
+	return
+}
+
+// SwVersionIDs returns the "sw_version" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SwVersionID instead. It exists only for internal usage by the builders.
+func (m *NetworkDeviceMutation) SwVersionIDs() (ids []string) {
+	if id := m.sw_version; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSwVersion resets all changes to the "sw_version" edge.
+func (m *NetworkDeviceMutation) ResetSwVersion() {
+	m.sw_version = nil
+	m.clearedsw_version = false
+}
+
+// SetFwVersionID sets the "fw_version" edge to the Version entity by id.
+func (m *NetworkDeviceMutation) SetFwVersionID(id string) {
+	m.fw_version = &id
+}
+
+// ClearFwVersion clears the "fw_version" edge to the Version entity.
+func (m *NetworkDeviceMutation) ClearFwVersion() {
+	m.clearedfw_version = true
+}
+
+// FwVersionCleared reports if the "fw_version" edge to the Version entity was cleared.
+func (m *NetworkDeviceMutation) FwVersionCleared() bool {
+	return m.clearedfw_version
+}
+
+// FwVersionID returns the "fw_version" edge ID in the mutation.
+func (m *NetworkDeviceMutation) FwVersionID() (id string, exists bool) {
+	if m.fw_version != nil {
+		return *m.fw_version, true
+	}
+	return
+}
+
+// FwVersionIDs returns the "fw_version" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FwVersionID instead. It exists only for internal usage by the builders.
+func (m *NetworkDeviceMutation) FwVersionIDs() (ids []string) {
+	if id := m.fw_version; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFwVersion resets all changes to the "fw_version" edge.
+func (m *NetworkDeviceMutation) ResetFwVersion() {
+	m.fw_version = nil
+	m.clearedfw_version = false
+}
+
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by ids.
+func (m *NetworkDeviceMutation) AddMaintenanceWindowIDs(ids ...string) {
+	if m.maintenance_windows == nil {
+		m.maintenance_windows = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.maintenance_windows[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMaintenanceWindows clears the "maintenance_windows" edge to the MaintenanceWindow entity.
+func (m *NetworkDeviceMutation) ClearMaintenanceWindows() {
+	m.clearedmaintenance_windows = true
+}
+
+// MaintenanceWindowsCleared reports if the "maintenance_windows" edge to the MaintenanceWindow entity was cleared.
+func (m *NetworkDeviceMutation) MaintenanceWindowsCleared() bool {
+	return m.clearedmaintenance_windows
+}
+
+// RemoveMaintenanceWindowIDs removes the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (m *NetworkDeviceMutation) RemoveMaintenanceWindowIDs(ids ...string) {
+	if m.removedmaintenance_windows == nil {
+		m.removedmaintenance_windows = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.maintenance_windows, ids[i])
+		m.removedmaintenance_windows[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMaintenanceWindows returns the removed IDs of the "maintenance_windows" edge to the MaintenanceWindow entity.
+func (m *NetworkDeviceMutation) RemovedMaintenanceWindowsIDs() (ids []string) {
+	for id := range m.removedmaintenance_windows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MaintenanceWindowsIDs returns the "maintenance_windows" edge IDs in the mutation.
+func (m *NetworkDeviceMutation) MaintenanceWindowsIDs() (ids []string) {
+	for id := range m.maintenance_windows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMaintenanceWindows resets all changes to the "maintenance_windows" edge.
+func (m *NetworkDeviceMutation) ResetMaintenanceWindows() {
+	m.maintenance_windows = nil
+	m.clearedmaintenance_windows = false
+	m.removedmaintenance_windows = nil
+}
+
+// AddChildIDs adds the "children" edge to the NetworkDevice entity by ids.
+func (m *NetworkDeviceMutation) AddChildIDs(ids ...string) {
+	if m.children == nil {
+		m.children = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the NetworkDevice entity.
+func (m *NetworkDeviceMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the NetworkDevice entity was cleared.
+func (m *NetworkDeviceMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the NetworkDevice entity by IDs.
+func (m *NetworkDeviceMutation) RemoveChildIDs(ids ...string) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the NetworkDevice entity.
+func (m *NetworkDeviceMutation) RemovedChildrenIDs() (ids []string) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *NetworkDeviceMutation) ChildrenIDs() (ids []string) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *NetworkDeviceMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the NetworkDeviceMutation builder.
+func (m *NetworkDeviceMutation) Where(ps ...predicate.NetworkDevice) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NetworkDeviceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NetworkDeviceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NetworkDevice, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NetworkDeviceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NetworkDeviceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NetworkDevice).
+func (m *NetworkDeviceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NetworkDeviceMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.vendor != nil {
+		fields = append(fields, networkdevice.FieldVendor)
+	}
+	if m.model != nil {
+		fields = append(fields, networkdevice.FieldModel)
+	}
+	if m.hw_version != nil {
+		fields = append(fields, networkdevice.FieldHwVersion)
+	}
+	if m.poll_interval != nil {
+		fields = append(fields, networkdevice.FieldPollInterval)
+	}
+	if m.group != nil {
+		fields = append(fields, networkdevice.FieldGroup)
+	}
+	if m.site != nil {
+		fields = append(fields, networkdevice.FieldSite)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NetworkDeviceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case networkdevice.FieldVendor:
+		return m.Vendor()
+	case networkdevice.FieldModel:
+		return m.Model()
+	case networkdevice.FieldHwVersion:
+		return m.HwVersion()
+	case networkdevice.FieldPollInterval:
+		return m.PollInterval()
+	case networkdevice.FieldGroup:
+		return m.Group()
+	case networkdevice.FieldSite:
+		return m.Site()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NetworkDeviceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case networkdevice.FieldVendor:
+		return m.OldVendor(ctx)
+	case networkdevice.FieldModel:
+		return m.OldModel(ctx)
+	case networkdevice.FieldHwVersion:
+		return m.OldHwVersion(ctx)
+	case networkdevice.FieldPollInterval:
+		return m.OldPollInterval(ctx)
+	case networkdevice.FieldGroup:
+		return m.OldGroup(ctx)
+	case networkdevice.FieldSite:
+		return m.OldSite(ctx)
+	}
+	return nil, fmt.Errorf("unknown NetworkDevice field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NetworkDeviceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case networkdevice.FieldVendor:
+		v, ok := value.(networkdevice.Vendor)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVendor(v)
+		return nil
+	case networkdevice.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case networkdevice.FieldHwVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHwVersion(v)
+		return nil
+	case networkdevice.FieldPollInterval:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPollInterval(v)
+		return nil
+	case networkdevice.FieldGroup:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroup(v)
+		return nil
+	case networkdevice.FieldSite:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSite(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NetworkDevice field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NetworkDeviceMutation) AddedFields() []string {
+	var fields []string
+	if m.addpoll_interval != nil {
+		fields = append(fields, networkdevice.FieldPollInterval)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NetworkDeviceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case networkdevice.FieldPollInterval:
+		return m.AddedPollInterval()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NetworkDeviceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case networkdevice.FieldPollInterval:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPollInterval(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NetworkDevice numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NetworkDeviceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(networkdevice.FieldHwVersion) {
+		fields = append(fields, networkdevice.FieldHwVersion)
+	}
+	if m.FieldCleared(networkdevice.FieldPollInterval) {
+		fields = append(fields, networkdevice.FieldPollInterval)
+	}
+	if m.FieldCleared(networkdevice.FieldGroup) {
+		fields = append(fields, networkdevice.FieldGroup)
+	}
+	if m.FieldCleared(networkdevice.FieldSite) {
+		fields = append(fields, networkdevice.FieldSite)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NetworkDeviceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NetworkDeviceMutation) ClearField(name string) error {
+	switch name {
+	case networkdevice.FieldHwVersion:
+		m.ClearHwVersion()
+		return nil
+	case networkdevice.FieldPollInterval:
+		m.ClearPollInterval()
+		return nil
+	case networkdevice.FieldGroup:
+		m.ClearGroup()
+		return nil
+	case networkdevice.FieldSite:
+		m.ClearSite()
+		return nil
+	}
+	return fmt.Errorf("unknown NetworkDevice nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NetworkDeviceMutation) ResetField(name string) error {
+	switch name {
+	case networkdevice.FieldVendor:
+		m.ResetVendor()
+		return nil
+	case networkdevice.FieldModel:
+		m.ResetModel()
+		return nil
+	case networkdevice.FieldHwVersion:
+		m.ResetHwVersion()
+		return nil
+	case networkdevice.FieldPollInterval:
+		m.ResetPollInterval()
+		return nil
+	case networkdevice.FieldGroup:
+		m.ResetGroup()
+		return nil
+	case networkdevice.FieldSite:
+		m.ResetSite()
+		return nil
+	}
+	return fmt.Errorf("unknown NetworkDevice field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NetworkDeviceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.parent != nil {
+		edges = append(edges, networkdevice.EdgeParent)
+	}
+	if m.endpoints != nil {
+		edges = append(edges, networkdevice.EdgeEndpoints)
+	}
+	if m.sw_version != nil {
+		edges = append(edges, networkdevice.EdgeSwVersion)
+	}
+	if m.fw_version != nil {
+		edges = append(edges, networkdevice.EdgeFwVersion)
+	}
+	if m.maintenance_windows != nil {
+		edges = append(edges, networkdevice.EdgeMaintenanceWindows)
+	}
+	if m.children != nil {
+		edges = append(edges, networkdevice.EdgeChildren)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NetworkDeviceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case networkdevice.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case networkdevice.EdgeEndpoints:
+		ids := make([]ent.Value, 0, len(m.endpoints))
+		for id := range m.endpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	case networkdevice.EdgeSwVersion:
+		if id := m.sw_version; id != nil {
+			return []ent.Value{*id}
+		}
+	case networkdevice.EdgeFwVersion:
+		if id := m.fw_version; id != nil {
+			return []ent.Value{*id}
+		}
+	case networkdevice.EdgeMaintenanceWindows:
+		ids := make([]ent.Value, 0, len(m.maintenance_windows))
+		for id := range m.maintenance_windows {
+			ids = append(ids, id)
+		}
+		return ids
+	case networkdevice.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NetworkDeviceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedendpoints != nil {
+		edges = append(edges, networkdevice.EdgeEndpoints)
+	}
+	if m.removedmaintenance_windows != nil {
+		edges = append(edges, networkdevice.EdgeMaintenanceWindows)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, networkdevice.EdgeChildren)
+	}
+	return edges
 }
 
-var _ ent.Mutation = (*DeviceStatusMutation)(nil)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NetworkDeviceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case networkdevice.EdgeEndpoints:
+		ids := make([]ent.Value, 0, len(m.removedendpoints))
+		for id := range m.removedendpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	case networkdevice.EdgeMaintenanceWindows:
+		ids := make([]ent.Value, 0, len(m.removedmaintenance_windows))
+		for id := range m.removedmaintenance_windows {
+			ids = append(ids, id)
+		}
+		return ids
+	case networkdevice.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
 
-// devicestatusOption allows management of the mutation configuration using functional options.
-type devicestatusOption func(*DeviceStatusMutation)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NetworkDeviceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedparent {
+		edges = append(edges, networkdevice.EdgeParent)
+	}
+	if m.clearedendpoints {
+		edges = append(edges, networkdevice.EdgeEndpoints)
+	}
+	if m.clearedsw_version {
+		edges = append(edges, networkdevice.EdgeSwVersion)
+	}
+	if m.clearedfw_version {
+		edges = append(edges, networkdevice.EdgeFwVersion)
+	}
+	if m.clearedmaintenance_windows {
+		edges = append(edges, networkdevice.EdgeMaintenanceWindows)
+	}
+	if m.clearedchildren {
+		edges = append(edges, networkdevice.EdgeChildren)
+	}
+	return edges
+}
 
-// newDeviceStatusMutation creates new mutation for the DeviceStatus entity.
-func newDeviceStatusMutation(c config, op Op, opts ...devicestatusOption) *DeviceStatusMutation {
-	m := &DeviceStatusMutation{
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NetworkDeviceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case networkdevice.EdgeParent:
+		return m.clearedparent
+	case networkdevice.EdgeEndpoints:
+		return m.clearedendpoints
+	case networkdevice.EdgeSwVersion:
+		return m.clearedsw_version
+	case networkdevice.EdgeFwVersion:
+		return m.clearedfw_version
+	case networkdevice.EdgeMaintenanceWindows:
+		return m.clearedmaintenance_windows
+	case networkdevice.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NetworkDeviceMutation) ClearEdge(name string) error {
+	switch name {
+	case networkdevice.EdgeParent:
+		m.ClearParent()
+		return nil
+	case networkdevice.EdgeSwVersion:
+		m.ClearSwVersion()
+		return nil
+	case networkdevice.EdgeFwVersion:
+		m.ClearFwVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown NetworkDevice unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NetworkDeviceMutation) ResetEdge(name string) error {
+	switch name {
+	case networkdevice.EdgeParent:
+		m.ResetParent()
+		return nil
+	case networkdevice.EdgeEndpoints:
+		m.ResetEndpoints()
+		return nil
+	case networkdevice.EdgeSwVersion:
+		m.ResetSwVersion()
+		return nil
+	case networkdevice.EdgeFwVersion:
+		m.ResetFwVersion()
+		return nil
+	case networkdevice.EdgeMaintenanceWindows:
+		m.ResetMaintenanceWindows()
+		return nil
+	case networkdevice.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown NetworkDevice edge %s", name)
+}
+
+// PollingDefaultMutation represents an operation that mutates the PollingDefault nodes in the graph.
+type PollingDefaultMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *string
+	scope            *pollingdefault.Scope
+	name             *string
+	poll_interval    *int32
+	addpoll_interval *int32
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*PollingDefault, error)
+	predicates       []predicate.PollingDefault
+}
+
+var _ ent.Mutation = (*PollingDefaultMutation)(nil)
+
+// pollingdefaultOption allows management of the mutation configuration using functional options.
+type pollingdefaultOption func(*PollingDefaultMutation)
+
+// newPollingDefaultMutation creates new mutation for the PollingDefault entity.
+func newPollingDefaultMutation(c config, op Op, opts ...pollingdefaultOption) *PollingDefaultMutation {
+	m := &PollingDefaultMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeviceStatus,
+		typ:           TypePollingDefault,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -69,20 +7380,20 @@ func newDeviceStatusMutation(c config, op Op, opts ...devicestatusOption) *Devic
 	return m
 }
 
-// withDeviceStatusID sets the ID field of the mutation.
-func withDeviceStatusID(id string) devicestatusOption {
-	return func(m *DeviceStatusMutation) {
+// withPollingDefaultID sets the ID field of the mutation.
+func withPollingDefaultID(id string) pollingdefaultOption {
+	return func(m *PollingDefaultMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeviceStatus
+			value *PollingDefault
 		)
-		m.oldValue = func(ctx context.Context) (*DeviceStatus, error) {
+		m.oldValue = func(ctx context.Context) (*PollingDefault, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeviceStatus.Get(ctx, id)
+					value, err = m.Client().PollingDefault.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -91,10 +7402,10 @@ func withDeviceStatusID(id string) devicestatusOption {
 	}
 }
 
-// withDeviceStatus sets the old DeviceStatus of the mutation.
-func withDeviceStatus(node *DeviceStatus) devicestatusOption {
-	return func(m *DeviceStatusMutation) {
-		m.oldValue = func(context.Context) (*DeviceStatus, error) {
+// withPollingDefault sets the old PollingDefault of the mutation.
+func withPollingDefault(node *PollingDefault) pollingdefaultOption {
+	return func(m *PollingDefaultMutation) {
+		m.oldValue = func(context.Context) (*PollingDefault, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -103,7 +7414,7 @@ func withDeviceStatus(node *DeviceStatus) devicestatusOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeviceStatusMutation) Client() *Client {
+func (m PollingDefaultMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -111,7 +7422,7 @@ func (m DeviceStatusMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeviceStatusMutation) Tx() (*Tx, error) {
+func (m PollingDefaultMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -121,14 +7432,14 @@ func (m DeviceStatusMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DeviceStatus entities.
-func (m *DeviceStatusMutation) SetID(id string) {
+// operation is only accepted on creation of PollingDefault entities.
+func (m *PollingDefaultMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeviceStatusMutation) ID() (id string, exists bool) {
+func (m *PollingDefaultMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -139,7 +7450,7 @@ func (m *DeviceStatusMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeviceStatusMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *PollingDefaultMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -148,201 +7459,149 @@ func (m *DeviceStatusMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeviceStatus.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PollingDefault.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStatus sets the "status" field.
-func (m *DeviceStatusMutation) SetStatus(d devicestatus.Status) {
-	m.status = &d
+// SetScope sets the "scope" field.
+func (m *PollingDefaultMutation) SetScope(po pollingdefault.Scope) {
+	m.scope = &po
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *DeviceStatusMutation) Status() (r devicestatus.Status, exists bool) {
-	v := m.status
+// Scope returns the value of the "scope" field in the mutation.
+func (m *PollingDefaultMutation) Scope() (r pollingdefault.Scope, exists bool) {
+	v := m.scope
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the DeviceStatus entity.
-// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldScope returns the old "scope" field's value of the PollingDefault entity.
+// If the PollingDefault object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceStatusMutation) OldStatus(ctx context.Context) (v devicestatus.Status, err error) {
+func (m *PollingDefaultMutation) OldScope(ctx context.Context) (v pollingdefault.Scope, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldScope requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Scope, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *DeviceStatusMutation) ResetStatus() {
-	m.status = nil
+// ResetScope resets all changes to the "scope" field.
+func (m *PollingDefaultMutation) ResetScope() {
+	m.scope = nil
 }
 
-// SetLastSeen sets the "last_seen" field.
-func (m *DeviceStatusMutation) SetLastSeen(s string) {
-	m.last_seen = &s
+// SetName sets the "name" field.
+func (m *PollingDefaultMutation) SetName(s string) {
+	m.name = &s
 }
 
-// LastSeen returns the value of the "last_seen" field in the mutation.
-func (m *DeviceStatusMutation) LastSeen() (r string, exists bool) {
-	v := m.last_seen
+// Name returns the value of the "name" field in the mutation.
+func (m *PollingDefaultMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastSeen returns the old "last_seen" field's value of the DeviceStatus entity.
-// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the PollingDefault entity.
+// If the PollingDefault object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceStatusMutation) OldLastSeen(ctx context.Context) (v string, err error) {
+func (m *PollingDefaultMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSeen is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSeen requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSeen: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.LastSeen, nil
-}
-
-// ClearLastSeen clears the value of the "last_seen" field.
-func (m *DeviceStatusMutation) ClearLastSeen() {
-	m.last_seen = nil
-	m.clearedFields[devicestatus.FieldLastSeen] = struct{}{}
-}
-
-// LastSeenCleared returns if the "last_seen" field was cleared in this mutation.
-func (m *DeviceStatusMutation) LastSeenCleared() bool {
-	_, ok := m.clearedFields[devicestatus.FieldLastSeen]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetLastSeen resets all changes to the "last_seen" field.
-func (m *DeviceStatusMutation) ResetLastSeen() {
-	m.last_seen = nil
-	delete(m.clearedFields, devicestatus.FieldLastSeen)
+// ResetName resets all changes to the "name" field.
+func (m *PollingDefaultMutation) ResetName() {
+	m.name = nil
 }
 
-// SetConsequentialFailedConnectivityAttempts sets the "consequential_failed_connectivity_attempts" field.
-func (m *DeviceStatusMutation) SetConsequentialFailedConnectivityAttempts(i int32) {
-	m.consequential_failed_connectivity_attempts = &i
-	m.addconsequential_failed_connectivity_attempts = nil
+// SetPollInterval sets the "poll_interval" field.
+func (m *PollingDefaultMutation) SetPollInterval(i int32) {
+	m.poll_interval = &i
+	m.addpoll_interval = nil
 }
 
-// ConsequentialFailedConnectivityAttempts returns the value of the "consequential_failed_connectivity_attempts" field in the mutation.
-func (m *DeviceStatusMutation) ConsequentialFailedConnectivityAttempts() (r int32, exists bool) {
-	v := m.consequential_failed_connectivity_attempts
+// PollInterval returns the value of the "poll_interval" field in the mutation.
+func (m *PollingDefaultMutation) PollInterval() (r int32, exists bool) {
+	v := m.poll_interval
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConsequentialFailedConnectivityAttempts returns the old "consequential_failed_connectivity_attempts" field's value of the DeviceStatus entity.
-// If the DeviceStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldPollInterval returns the old "poll_interval" field's value of the PollingDefault entity.
+// If the PollingDefault object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceStatusMutation) OldConsequentialFailedConnectivityAttempts(ctx context.Context) (v int32, err error) {
+func (m *PollingDefaultMutation) OldPollInterval(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConsequentialFailedConnectivityAttempts is only allowed on UpdateOne operations")
+		return v, errors.New("OldPollInterval is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConsequentialFailedConnectivityAttempts requires an ID field in the mutation")
+		return v, errors.New("OldPollInterval requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConsequentialFailedConnectivityAttempts: %w", err)
+		return v, fmt.Errorf("querying old value for OldPollInterval: %w", err)
 	}
-	return oldValue.ConsequentialFailedConnectivityAttempts, nil
+	return oldValue.PollInterval, nil
 }
 
-// AddConsequentialFailedConnectivityAttempts adds i to the "consequential_failed_connectivity_attempts" field.
-func (m *DeviceStatusMutation) AddConsequentialFailedConnectivityAttempts(i int32) {
-	if m.addconsequential_failed_connectivity_attempts != nil {
-		*m.addconsequential_failed_connectivity_attempts += i
+// AddPollInterval adds i to the "poll_interval" field.
+func (m *PollingDefaultMutation) AddPollInterval(i int32) {
+	if m.addpoll_interval != nil {
+		*m.addpoll_interval += i
 	} else {
-		m.addconsequential_failed_connectivity_attempts = &i
+		m.addpoll_interval = &i
 	}
 }
 
-// AddedConsequentialFailedConnectivityAttempts returns the value that was added to the "consequential_failed_connectivity_attempts" field in this mutation.
-func (m *DeviceStatusMutation) AddedConsequentialFailedConnectivityAttempts() (r int32, exists bool) {
-	v := m.addconsequential_failed_connectivity_attempts
+// AddedPollInterval returns the value that was added to the "poll_interval" field in this mutation.
+func (m *PollingDefaultMutation) AddedPollInterval() (r int32, exists bool) {
+	v := m.addpoll_interval
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetConsequentialFailedConnectivityAttempts resets all changes to the "consequential_failed_connectivity_attempts" field.
-func (m *DeviceStatusMutation) ResetConsequentialFailedConnectivityAttempts() {
-	m.consequential_failed_connectivity_attempts = nil
-	m.addconsequential_failed_connectivity_attempts = nil
-}
-
-// SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by id.
-func (m *DeviceStatusMutation) SetNetworkDeviceID(id string) {
-	m.network_device = &id
-}
-
-// ClearNetworkDevice clears the "network_device" edge to the NetworkDevice entity.
-func (m *DeviceStatusMutation) ClearNetworkDevice() {
-	m.clearednetwork_device = true
-}
-
-// NetworkDeviceCleared reports if the "network_device" edge to the NetworkDevice entity was cleared.
-func (m *DeviceStatusMutation) NetworkDeviceCleared() bool {
-	return m.clearednetwork_device
-}
-
-// NetworkDeviceID returns the "network_device" edge ID in the mutation.
-func (m *DeviceStatusMutation) NetworkDeviceID() (id string, exists bool) {
-	if m.network_device != nil {
-		return *m.network_device, true
-	}
-	return
-}
-
-// NetworkDeviceIDs returns the "network_device" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NetworkDeviceID instead. It exists only for internal usage by the builders.
-func (m *DeviceStatusMutation) NetworkDeviceIDs() (ids []string) {
-	if id := m.network_device; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetNetworkDevice resets all changes to the "network_device" edge.
-func (m *DeviceStatusMutation) ResetNetworkDevice() {
-	m.network_device = nil
-	m.clearednetwork_device = false
+// ResetPollInterval resets all changes to the "poll_interval" field.
+func (m *PollingDefaultMutation) ResetPollInterval() {
+	m.poll_interval = nil
+	m.addpoll_interval = nil
 }
 
-// Where appends a list predicates to the DeviceStatusMutation builder.
-func (m *DeviceStatusMutation) Where(ps ...predicate.DeviceStatus) {
+// Where appends a list predicates to the PollingDefaultMutation builder.
+func (m *PollingDefaultMutation) Where(ps ...predicate.PollingDefault) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeviceStatusMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PollingDefaultMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeviceStatusMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeviceStatus, len(ps))
+func (m *PollingDefaultMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PollingDefault, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -350,33 +7609,33 @@ func (m *DeviceStatusMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeviceStatusMutation) Op() Op {
+func (m *PollingDefaultMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeviceStatusMutation) SetOp(op Op) {
+func (m *PollingDefaultMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeviceStatus).
-func (m *DeviceStatusMutation) Type() string {
+// Type returns the node type of this mutation (PollingDefault).
+func (m *PollingDefaultMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeviceStatusMutation) Fields() []string {
+func (m *PollingDefaultMutation) Fields() []string {
 	fields := make([]string, 0, 3)
-	if m.status != nil {
-		fields = append(fields, devicestatus.FieldStatus)
+	if m.scope != nil {
+		fields = append(fields, pollingdefault.FieldScope)
 	}
-	if m.last_seen != nil {
-		fields = append(fields, devicestatus.FieldLastSeen)
+	if m.name != nil {
+		fields = append(fields, pollingdefault.FieldName)
 	}
-	if m.consequential_failed_connectivity_attempts != nil {
-		fields = append(fields, devicestatus.FieldConsequentialFailedConnectivityAttempts)
+	if m.poll_interval != nil {
+		fields = append(fields, pollingdefault.FieldPollInterval)
 	}
 	return fields
 }
@@ -384,14 +7643,14 @@ func (m *DeviceStatusMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeviceStatusMutation) Field(name string) (ent.Value, bool) {
+func (m *PollingDefaultMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case devicestatus.FieldStatus:
-		return m.Status()
-	case devicestatus.FieldLastSeen:
-		return m.LastSeen()
-	case devicestatus.FieldConsequentialFailedConnectivityAttempts:
-		return m.ConsequentialFailedConnectivityAttempts()
+	case pollingdefault.FieldScope:
+		return m.Scope()
+	case pollingdefault.FieldName:
+		return m.Name()
+	case pollingdefault.FieldPollInterval:
+		return m.PollInterval()
 	}
 	return nil, false
 }
@@ -399,54 +7658,54 @@ func (m *DeviceStatusMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeviceStatusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PollingDefaultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case devicestatus.FieldStatus:
-		return m.OldStatus(ctx)
-	case devicestatus.FieldLastSeen:
-		return m.OldLastSeen(ctx)
-	case devicestatus.FieldConsequentialFailedConnectivityAttempts:
-		return m.OldConsequentialFailedConnectivityAttempts(ctx)
+	case pollingdefault.FieldScope:
+		return m.OldScope(ctx)
+	case pollingdefault.FieldName:
+		return m.OldName(ctx)
+	case pollingdefault.FieldPollInterval:
+		return m.OldPollInterval(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeviceStatus field %s", name)
+	return nil, fmt.Errorf("unknown PollingDefault field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceStatusMutation) SetField(name string, value ent.Value) error {
+func (m *PollingDefaultMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case devicestatus.FieldStatus:
-		v, ok := value.(devicestatus.Status)
+	case pollingdefault.FieldScope:
+		v, ok := value.(pollingdefault.Scope)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetScope(v)
 		return nil
-	case devicestatus.FieldLastSeen:
+	case pollingdefault.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastSeen(v)
+		m.SetName(v)
 		return nil
-	case devicestatus.FieldConsequentialFailedConnectivityAttempts:
+	case pollingdefault.FieldPollInterval:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConsequentialFailedConnectivityAttempts(v)
+		m.SetPollInterval(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceStatus field %s", name)
+	return fmt.Errorf("unknown PollingDefault field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeviceStatusMutation) AddedFields() []string {
+func (m *PollingDefaultMutation) AddedFields() []string {
 	var fields []string
-	if m.addconsequential_failed_connectivity_attempts != nil {
-		fields = append(fields, devicestatus.FieldConsequentialFailedConnectivityAttempts)
+	if m.addpoll_interval != nil {
+		fields = append(fields, pollingdefault.FieldPollInterval)
 	}
 	return fields
 }
@@ -454,10 +7713,10 @@ func (m *DeviceStatusMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeviceStatusMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PollingDefaultMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case devicestatus.FieldConsequentialFailedConnectivityAttempts:
-		return m.AddedConsequentialFailedConnectivityAttempts()
+	case pollingdefault.FieldPollInterval:
+		return m.AddedPollInterval()
 	}
 	return nil, false
 }
@@ -465,166 +7724,128 @@ func (m *DeviceStatusMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceStatusMutation) AddField(name string, value ent.Value) error {
+func (m *PollingDefaultMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case devicestatus.FieldConsequentialFailedConnectivityAttempts:
+	case pollingdefault.FieldPollInterval:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddConsequentialFailedConnectivityAttempts(v)
+		m.AddPollInterval(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceStatus numeric field %s", name)
+	return fmt.Errorf("unknown PollingDefault numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeviceStatusMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(devicestatus.FieldLastSeen) {
-		fields = append(fields, devicestatus.FieldLastSeen)
-	}
-	return fields
+func (m *PollingDefaultMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeviceStatusMutation) FieldCleared(name string) bool {
+func (m *PollingDefaultMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeviceStatusMutation) ClearField(name string) error {
-	switch name {
-	case devicestatus.FieldLastSeen:
-		m.ClearLastSeen()
-		return nil
-	}
-	return fmt.Errorf("unknown DeviceStatus nullable field %s", name)
+func (m *PollingDefaultMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PollingDefault nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeviceStatusMutation) ResetField(name string) error {
+func (m *PollingDefaultMutation) ResetField(name string) error {
 	switch name {
-	case devicestatus.FieldStatus:
-		m.ResetStatus()
+	case pollingdefault.FieldScope:
+		m.ResetScope()
 		return nil
-	case devicestatus.FieldLastSeen:
-		m.ResetLastSeen()
+	case pollingdefault.FieldName:
+		m.ResetName()
 		return nil
-	case devicestatus.FieldConsequentialFailedConnectivityAttempts:
-		m.ResetConsequentialFailedConnectivityAttempts()
+	case pollingdefault.FieldPollInterval:
+		m.ResetPollInterval()
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceStatus field %s", name)
+	return fmt.Errorf("unknown PollingDefault field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeviceStatusMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.network_device != nil {
-		edges = append(edges, devicestatus.EdgeNetworkDevice)
-	}
+func (m *PollingDefaultMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeviceStatusMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case devicestatus.EdgeNetworkDevice:
-		if id := m.network_device; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *PollingDefaultMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeviceStatusMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *PollingDefaultMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeviceStatusMutation) RemovedIDs(name string) []ent.Value {
+func (m *PollingDefaultMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeviceStatusMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearednetwork_device {
-		edges = append(edges, devicestatus.EdgeNetworkDevice)
-	}
+func (m *PollingDefaultMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeviceStatusMutation) EdgeCleared(name string) bool {
-	switch name {
-	case devicestatus.EdgeNetworkDevice:
-		return m.clearednetwork_device
-	}
+func (m *PollingDefaultMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeviceStatusMutation) ClearEdge(name string) error {
-	switch name {
-	case devicestatus.EdgeNetworkDevice:
-		m.ClearNetworkDevice()
-		return nil
-	}
-	return fmt.Errorf("unknown DeviceStatus unique edge %s", name)
+func (m *PollingDefaultMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PollingDefault unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeviceStatusMutation) ResetEdge(name string) error {
-	switch name {
-	case devicestatus.EdgeNetworkDevice:
-		m.ResetNetworkDevice()
-		return nil
-	}
-	return fmt.Errorf("unknown DeviceStatus edge %s", name)
+func (m *PollingDefaultMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PollingDefault edge %s", name)
 }
 
-// EndpointMutation represents an operation that mutates the Endpoint nodes in the graph.
-type EndpointMutation struct {
+// ReplicaHeartbeatMutation represents an operation that mutates the ReplicaHeartbeat nodes in the graph.
+type ReplicaHeartbeatMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *string
-	host                  *string
-	port                  *string
-	protocol              *endpoint.Protocol
-	clearedFields         map[string]struct{}
-	network_device        *string
-	clearednetwork_device bool
-	done                  bool
-	oldValue              func(context.Context) (*Endpoint, error)
-	predicates            []predicate.Endpoint
+	op            Op
+	typ           string
+	id            *string
+	last_seen     *int64
+	addlast_seen  *int64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ReplicaHeartbeat, error)
+	predicates    []predicate.ReplicaHeartbeat
 }
 
-var _ ent.Mutation = (*EndpointMutation)(nil)
+var _ ent.Mutation = (*ReplicaHeartbeatMutation)(nil)
 
-// endpointOption allows management of the mutation configuration using functional options.
-type endpointOption func(*EndpointMutation)
+// replicaheartbeatOption allows management of the mutation configuration using functional options.
+type replicaheartbeatOption func(*ReplicaHeartbeatMutation)
 
-// newEndpointMutation creates new mutation for the Endpoint entity.
-func newEndpointMutation(c config, op Op, opts ...endpointOption) *EndpointMutation {
-	m := &EndpointMutation{
+// newReplicaHeartbeatMutation creates new mutation for the ReplicaHeartbeat entity.
+func newReplicaHeartbeatMutation(c config, op Op, opts ...replicaheartbeatOption) *ReplicaHeartbeatMutation {
+	m := &ReplicaHeartbeatMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEndpoint,
+		typ:           TypeReplicaHeartbeat,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -633,20 +7854,20 @@ func newEndpointMutation(c config, op Op, opts ...endpointOption) *EndpointMutat
 	return m
 }
 
-// withEndpointID sets the ID field of the mutation.
-func withEndpointID(id string) endpointOption {
-	return func(m *EndpointMutation) {
+// withReplicaHeartbeatID sets the ID field of the mutation.
+func withReplicaHeartbeatID(id string) replicaheartbeatOption {
+	return func(m *ReplicaHeartbeatMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Endpoint
+			value *ReplicaHeartbeat
 		)
-		m.oldValue = func(ctx context.Context) (*Endpoint, error) {
+		m.oldValue = func(ctx context.Context) (*ReplicaHeartbeat, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Endpoint.Get(ctx, id)
+					value, err = m.Client().ReplicaHeartbeat.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -655,10 +7876,10 @@ func withEndpointID(id string) endpointOption {
 	}
 }
 
-// withEndpoint sets the old Endpoint of the mutation.
-func withEndpoint(node *Endpoint) endpointOption {
-	return func(m *EndpointMutation) {
-		m.oldValue = func(context.Context) (*Endpoint, error) {
+// withReplicaHeartbeat sets the old ReplicaHeartbeat of the mutation.
+func withReplicaHeartbeat(node *ReplicaHeartbeat) replicaheartbeatOption {
+	return func(m *ReplicaHeartbeatMutation) {
+		m.oldValue = func(context.Context) (*ReplicaHeartbeat, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -667,7 +7888,7 @@ func withEndpoint(node *Endpoint) endpointOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EndpointMutation) Client() *Client {
+func (m ReplicaHeartbeatMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -675,7 +7896,7 @@ func (m EndpointMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EndpointMutation) Tx() (*Tx, error) {
+func (m ReplicaHeartbeatMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -685,14 +7906,14 @@ func (m EndpointMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Endpoint entities.
-func (m *EndpointMutation) SetID(id string) {
+// operation is only accepted on creation of ReplicaHeartbeat entities.
+func (m *ReplicaHeartbeatMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EndpointMutation) ID() (id string, exists bool) {
+func (m *ReplicaHeartbeatMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -703,7 +7924,7 @@ func (m *EndpointMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EndpointMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ReplicaHeartbeatMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -712,168 +7933,77 @@ func (m *EndpointMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Endpoint.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ReplicaHeartbeat.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetHost sets the "host" field.
-func (m *EndpointMutation) SetHost(s string) {
-	m.host = &s
+// SetLastSeen sets the "last_seen" field.
+func (m *ReplicaHeartbeatMutation) SetLastSeen(i int64) {
+	m.last_seen = &i
+	m.addlast_seen = nil
 }
 
-// Host returns the value of the "host" field in the mutation.
-func (m *EndpointMutation) Host() (r string, exists bool) {
-	v := m.host
+// LastSeen returns the value of the "last_seen" field in the mutation.
+func (m *ReplicaHeartbeatMutation) LastSeen() (r int64, exists bool) {
+	v := m.last_seen
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHost returns the old "host" field's value of the Endpoint entity.
-// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// OldLastSeen returns the old "last_seen" field's value of the ReplicaHeartbeat entity.
+// If the ReplicaHeartbeat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EndpointMutation) OldHost(ctx context.Context) (v string, err error) {
+func (m *ReplicaHeartbeatMutation) OldLastSeen(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHost is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastSeen is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHost requires an ID field in the mutation")
+		return v, errors.New("OldLastSeen requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHost: %w", err)
-	}
-	return oldValue.Host, nil
-}
-
-// ResetHost resets all changes to the "host" field.
-func (m *EndpointMutation) ResetHost() {
-	m.host = nil
-}
-
-// SetPort sets the "port" field.
-func (m *EndpointMutation) SetPort(s string) {
-	m.port = &s
-}
-
-// Port returns the value of the "port" field in the mutation.
-func (m *EndpointMutation) Port() (r string, exists bool) {
-	v := m.port
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldLastSeen: %w", err)
 	}
-	return *v, true
+	return oldValue.LastSeen, nil
 }
 
-// OldPort returns the old "port" field's value of the Endpoint entity.
-// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EndpointMutation) OldPort(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPort is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPort requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPort: %w", err)
+// AddLastSeen adds i to the "last_seen" field.
+func (m *ReplicaHeartbeatMutation) AddLastSeen(i int64) {
+	if m.addlast_seen != nil {
+		*m.addlast_seen += i
+	} else {
+		m.addlast_seen = &i
 	}
-	return oldValue.Port, nil
-}
-
-// ResetPort resets all changes to the "port" field.
-func (m *EndpointMutation) ResetPort() {
-	m.port = nil
-}
-
-// SetProtocol sets the "protocol" field.
-func (m *EndpointMutation) SetProtocol(e endpoint.Protocol) {
-	m.protocol = &e
 }
 
-// Protocol returns the value of the "protocol" field in the mutation.
-func (m *EndpointMutation) Protocol() (r endpoint.Protocol, exists bool) {
-	v := m.protocol
+// AddedLastSeen returns the value that was added to the "last_seen" field in this mutation.
+func (m *ReplicaHeartbeatMutation) AddedLastSeen() (r int64, exists bool) {
+	v := m.addlast_seen
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProtocol returns the old "protocol" field's value of the Endpoint entity.
-// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EndpointMutation) OldProtocol(ctx context.Context) (v endpoint.Protocol, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProtocol is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProtocol requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProtocol: %w", err)
-	}
-	return oldValue.Protocol, nil
-}
-
-// ResetProtocol resets all changes to the "protocol" field.
-func (m *EndpointMutation) ResetProtocol() {
-	m.protocol = nil
-}
-
-// SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by id.
-func (m *EndpointMutation) SetNetworkDeviceID(id string) {
-	m.network_device = &id
-}
-
-// ClearNetworkDevice clears the "network_device" edge to the NetworkDevice entity.
-func (m *EndpointMutation) ClearNetworkDevice() {
-	m.clearednetwork_device = true
-}
-
-// NetworkDeviceCleared reports if the "network_device" edge to the NetworkDevice entity was cleared.
-func (m *EndpointMutation) NetworkDeviceCleared() bool {
-	return m.clearednetwork_device
-}
-
-// NetworkDeviceID returns the "network_device" edge ID in the mutation.
-func (m *EndpointMutation) NetworkDeviceID() (id string, exists bool) {
-	if m.network_device != nil {
-		return *m.network_device, true
-	}
-	return
-}
-
-// NetworkDeviceIDs returns the "network_device" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NetworkDeviceID instead. It exists only for internal usage by the builders.
-func (m *EndpointMutation) NetworkDeviceIDs() (ids []string) {
-	if id := m.network_device; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetNetworkDevice resets all changes to the "network_device" edge.
-func (m *EndpointMutation) ResetNetworkDevice() {
-	m.network_device = nil
-	m.clearednetwork_device = false
+// ResetLastSeen resets all changes to the "last_seen" field.
+func (m *ReplicaHeartbeatMutation) ResetLastSeen() {
+	m.last_seen = nil
+	m.addlast_seen = nil
 }
 
-// Where appends a list predicates to the EndpointMutation builder.
-func (m *EndpointMutation) Where(ps ...predicate.Endpoint) {
+// Where appends a list predicates to the ReplicaHeartbeatMutation builder.
+func (m *ReplicaHeartbeatMutation) Where(ps ...predicate.ReplicaHeartbeat) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EndpointMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReplicaHeartbeatMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EndpointMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Endpoint, len(ps))
+func (m *ReplicaHeartbeatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReplicaHeartbeat, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -881,33 +8011,27 @@ func (m *EndpointMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EndpointMutation) Op() Op {
+func (m *ReplicaHeartbeatMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EndpointMutation) SetOp(op Op) {
+func (m *ReplicaHeartbeatMutation) SetOp(op Op) {
 	m.op = op
 }
-
-// Type returns the node type of this mutation (Endpoint).
-func (m *EndpointMutation) Type() string {
+
+// Type returns the node type of this mutation (ReplicaHeartbeat).
+func (m *ReplicaHeartbeatMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EndpointMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.host != nil {
-		fields = append(fields, endpoint.FieldHost)
-	}
-	if m.port != nil {
-		fields = append(fields, endpoint.FieldPort)
-	}
-	if m.protocol != nil {
-		fields = append(fields, endpoint.FieldProtocol)
+func (m *ReplicaHeartbeatMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.last_seen != nil {
+		fields = append(fields, replicaheartbeat.FieldLastSeen)
 	}
 	return fields
 }
@@ -915,14 +8039,10 @@ func (m *EndpointMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EndpointMutation) Field(name string) (ent.Value, bool) {
+func (m *ReplicaHeartbeatMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case endpoint.FieldHost:
-		return m.Host()
-	case endpoint.FieldPort:
-		return m.Port()
-	case endpoint.FieldProtocol:
-		return m.Protocol()
+	case replicaheartbeat.FieldLastSeen:
+		return m.LastSeen()
 	}
 	return nil, false
 }
@@ -930,213 +8050,175 @@ func (m *EndpointMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EndpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReplicaHeartbeatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case endpoint.FieldHost:
-		return m.OldHost(ctx)
-	case endpoint.FieldPort:
-		return m.OldPort(ctx)
-	case endpoint.FieldProtocol:
-		return m.OldProtocol(ctx)
+	case replicaheartbeat.FieldLastSeen:
+		return m.OldLastSeen(ctx)
 	}
-	return nil, fmt.Errorf("unknown Endpoint field %s", name)
+	return nil, fmt.Errorf("unknown ReplicaHeartbeat field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EndpointMutation) SetField(name string, value ent.Value) error {
+func (m *ReplicaHeartbeatMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case endpoint.FieldHost:
-		v, ok := value.(string)
+	case replicaheartbeat.FieldLastSeen:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHost(v)
-		return nil
-	case endpoint.FieldPort:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPort(v)
-		return nil
-	case endpoint.FieldProtocol:
-		v, ok := value.(endpoint.Protocol)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProtocol(v)
+		m.SetLastSeen(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Endpoint field %s", name)
+	return fmt.Errorf("unknown ReplicaHeartbeat field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EndpointMutation) AddedFields() []string {
-	return nil
+func (m *ReplicaHeartbeatMutation) AddedFields() []string {
+	var fields []string
+	if m.addlast_seen != nil {
+		fields = append(fields, replicaheartbeat.FieldLastSeen)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EndpointMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReplicaHeartbeatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case replicaheartbeat.FieldLastSeen:
+		return m.AddedLastSeen()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EndpointMutation) AddField(name string, value ent.Value) error {
+func (m *ReplicaHeartbeatMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case replicaheartbeat.FieldLastSeen:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastSeen(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Endpoint numeric field %s", name)
+	return fmt.Errorf("unknown ReplicaHeartbeat numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EndpointMutation) ClearedFields() []string {
+func (m *ReplicaHeartbeatMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EndpointMutation) FieldCleared(name string) bool {
+func (m *ReplicaHeartbeatMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EndpointMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Endpoint nullable field %s", name)
+func (m *ReplicaHeartbeatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ReplicaHeartbeat nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EndpointMutation) ResetField(name string) error {
+func (m *ReplicaHeartbeatMutation) ResetField(name string) error {
 	switch name {
-	case endpoint.FieldHost:
-		m.ResetHost()
-		return nil
-	case endpoint.FieldPort:
-		m.ResetPort()
-		return nil
-	case endpoint.FieldProtocol:
-		m.ResetProtocol()
+	case replicaheartbeat.FieldLastSeen:
+		m.ResetLastSeen()
 		return nil
 	}
-	return fmt.Errorf("unknown Endpoint field %s", name)
+	return fmt.Errorf("unknown ReplicaHeartbeat field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EndpointMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.network_device != nil {
-		edges = append(edges, endpoint.EdgeNetworkDevice)
-	}
+func (m *ReplicaHeartbeatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EndpointMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case endpoint.EdgeNetworkDevice:
-		if id := m.network_device; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *ReplicaHeartbeatMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EndpointMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ReplicaHeartbeatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EndpointMutation) RemovedIDs(name string) []ent.Value {
+func (m *ReplicaHeartbeatMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EndpointMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearednetwork_device {
-		edges = append(edges, endpoint.EdgeNetworkDevice)
-	}
+func (m *ReplicaHeartbeatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EndpointMutation) EdgeCleared(name string) bool {
-	switch name {
-	case endpoint.EdgeNetworkDevice:
-		return m.clearednetwork_device
-	}
+func (m *ReplicaHeartbeatMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EndpointMutation) ClearEdge(name string) error {
-	switch name {
-	case endpoint.EdgeNetworkDevice:
-		m.ClearNetworkDevice()
-		return nil
-	}
-	return fmt.Errorf("unknown Endpoint unique edge %s", name)
+func (m *ReplicaHeartbeatMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ReplicaHeartbeat unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EndpointMutation) ResetEdge(name string) error {
-	switch name {
-	case endpoint.EdgeNetworkDevice:
-		m.ResetNetworkDevice()
-		return nil
-	}
-	return fmt.Errorf("unknown Endpoint edge %s", name)
+func (m *ReplicaHeartbeatMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ReplicaHeartbeat edge %s", name)
 }
 
-// NetworkDeviceMutation represents an operation that mutates the NetworkDevice nodes in the graph.
-type NetworkDeviceMutation struct {
+// StatusEventMutation represents an operation that mutates the StatusEvent nodes in the graph.
+type StatusEventMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *string
-	vendor            *networkdevice.Vendor
-	model             *string
-	hw_version        *string
-	clearedFields     map[string]struct{}
-	endpoints         map[string]struct{}
-	removedendpoints  map[string]struct{}
-	clearedendpoints  bool
-	sw_version        *string
-	clearedsw_version bool
-	fw_version        *string
-	clearedfw_version bool
-	done              bool
-	oldValue          func(context.Context) (*NetworkDevice, error)
-	predicates        []predicate.NetworkDevice
+	op                    Op
+	typ                   string
+	id                    *string
+	old_status            *statusevent.OldStatus
+	new_status            *statusevent.NewStatus
+	timestamp             *int64
+	addtimestamp          *int64
+	reason                *string
+	clearedFields         map[string]struct{}
+	network_device        *string
+	clearednetwork_device bool
+	done                  bool
+	oldValue              func(context.Context) (*StatusEvent, error)
+	predicates            []predicate.StatusEvent
 }
 
-var _ ent.Mutation = (*NetworkDeviceMutation)(nil)
+var _ ent.Mutation = (*StatusEventMutation)(nil)
 
-// networkdeviceOption allows management of the mutation configuration using functional options.
-type networkdeviceOption func(*NetworkDeviceMutation)
+// statuseventOption allows management of the mutation configuration using functional options.
+type statuseventOption func(*StatusEventMutation)
 
-// newNetworkDeviceMutation creates new mutation for the NetworkDevice entity.
-func newNetworkDeviceMutation(c config, op Op, opts ...networkdeviceOption) *NetworkDeviceMutation {
-	m := &NetworkDeviceMutation{
+// newStatusEventMutation creates new mutation for the StatusEvent entity.
+func newStatusEventMutation(c config, op Op, opts ...statuseventOption) *StatusEventMutation {
+	m := &StatusEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNetworkDevice,
+		typ:           TypeStatusEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1145,20 +8227,20 @@ func newNetworkDeviceMutation(c config, op Op, opts ...networkdeviceOption) *Net
 	return m
 }
 
-// withNetworkDeviceID sets the ID field of the mutation.
-func withNetworkDeviceID(id string) networkdeviceOption {
-	return func(m *NetworkDeviceMutation) {
+// withStatusEventID sets the ID field of the mutation.
+func withStatusEventID(id string) statuseventOption {
+	return func(m *StatusEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NetworkDevice
+			value *StatusEvent
 		)
-		m.oldValue = func(ctx context.Context) (*NetworkDevice, error) {
+		m.oldValue = func(ctx context.Context) (*StatusEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NetworkDevice.Get(ctx, id)
+					value, err = m.Client().StatusEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1167,10 +8249,10 @@ func withNetworkDeviceID(id string) networkdeviceOption {
 	}
 }
 
-// withNetworkDevice sets the old NetworkDevice of the mutation.
-func withNetworkDevice(node *NetworkDevice) networkdeviceOption {
-	return func(m *NetworkDeviceMutation) {
-		m.oldValue = func(context.Context) (*NetworkDevice, error) {
+// withStatusEvent sets the old StatusEvent of the mutation.
+func withStatusEvent(node *StatusEvent) statuseventOption {
+	return func(m *StatusEventMutation) {
+		m.oldValue = func(context.Context) (*StatusEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1179,7 +8261,7 @@ func withNetworkDevice(node *NetworkDevice) networkdeviceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NetworkDeviceMutation) Client() *Client {
+func (m StatusEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1187,7 +8269,7 @@ func (m NetworkDeviceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NetworkDeviceMutation) Tx() (*Tx, error) {
+func (m StatusEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1197,14 +8279,14 @@ func (m NetworkDeviceMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of NetworkDevice entities.
-func (m *NetworkDeviceMutation) SetID(id string) {
+// operation is only accepted on creation of StatusEvent entities.
+func (m *StatusEventMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NetworkDeviceMutation) ID() (id string, exists bool) {
+func (m *StatusEventMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1215,7 +8297,7 @@ func (m *NetworkDeviceMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NetworkDeviceMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *StatusEventMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1224,274 +8306,224 @@ func (m *NetworkDeviceMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NetworkDevice.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().StatusEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetVendor sets the "vendor" field.
-func (m *NetworkDeviceMutation) SetVendor(n networkdevice.Vendor) {
-	m.vendor = &n
+// SetOldStatus sets the "old_status" field.
+func (m *StatusEventMutation) SetOldStatus(ss statusevent.OldStatus) {
+	m.old_status = &ss
 }
 
-// Vendor returns the value of the "vendor" field in the mutation.
-func (m *NetworkDeviceMutation) Vendor() (r networkdevice.Vendor, exists bool) {
-	v := m.vendor
+// OldStatus returns the value of the "old_status" field in the mutation.
+func (m *StatusEventMutation) OldStatus() (r statusevent.OldStatus, exists bool) {
+	v := m.old_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVendor returns the old "vendor" field's value of the NetworkDevice entity.
-// If the NetworkDevice object wasn't provided to the builder, the object is fetched from the database.
+// OldOldStatus returns the old "old_status" field's value of the StatusEvent entity.
+// If the StatusEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NetworkDeviceMutation) OldVendor(ctx context.Context) (v networkdevice.Vendor, err error) {
+func (m *StatusEventMutation) OldOldStatus(ctx context.Context) (v statusevent.OldStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVendor is only allowed on UpdateOne operations")
+		return v, errors.New("OldOldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVendor requires an ID field in the mutation")
+		return v, errors.New("OldOldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVendor: %w", err)
+		return v, fmt.Errorf("querying old value for OldOldStatus: %w", err)
 	}
-	return oldValue.Vendor, nil
+	return oldValue.OldStatus, nil
 }
 
-// ResetVendor resets all changes to the "vendor" field.
-func (m *NetworkDeviceMutation) ResetVendor() {
-	m.vendor = nil
+// ResetOldStatus resets all changes to the "old_status" field.
+func (m *StatusEventMutation) ResetOldStatus() {
+	m.old_status = nil
 }
 
-// SetModel sets the "model" field.
-func (m *NetworkDeviceMutation) SetModel(s string) {
-	m.model = &s
+// SetNewStatus sets the "new_status" field.
+func (m *StatusEventMutation) SetNewStatus(ss statusevent.NewStatus) {
+	m.new_status = &ss
 }
 
-// Model returns the value of the "model" field in the mutation.
-func (m *NetworkDeviceMutation) Model() (r string, exists bool) {
-	v := m.model
+// NewStatus returns the value of the "new_status" field in the mutation.
+func (m *StatusEventMutation) NewStatus() (r statusevent.NewStatus, exists bool) {
+	v := m.new_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModel returns the old "model" field's value of the NetworkDevice entity.
-// If the NetworkDevice object wasn't provided to the builder, the object is fetched from the database.
+// OldNewStatus returns the old "new_status" field's value of the StatusEvent entity.
+// If the StatusEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NetworkDeviceMutation) OldModel(ctx context.Context) (v string, err error) {
+func (m *StatusEventMutation) OldNewStatus(ctx context.Context) (v statusevent.NewStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldNewStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModel requires an ID field in the mutation")
+		return v, errors.New("OldNewStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldNewStatus: %w", err)
 	}
-	return oldValue.Model, nil
+	return oldValue.NewStatus, nil
 }
 
-// ResetModel resets all changes to the "model" field.
-func (m *NetworkDeviceMutation) ResetModel() {
-	m.model = nil
+// ResetNewStatus resets all changes to the "new_status" field.
+func (m *StatusEventMutation) ResetNewStatus() {
+	m.new_status = nil
 }
 
-// SetHwVersion sets the "hw_version" field.
-func (m *NetworkDeviceMutation) SetHwVersion(s string) {
-	m.hw_version = &s
+// SetTimestamp sets the "timestamp" field.
+func (m *StatusEventMutation) SetTimestamp(i int64) {
+	m.timestamp = &i
+	m.addtimestamp = nil
 }
 
-// HwVersion returns the value of the "hw_version" field in the mutation.
-func (m *NetworkDeviceMutation) HwVersion() (r string, exists bool) {
-	v := m.hw_version
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *StatusEventMutation) Timestamp() (r int64, exists bool) {
+	v := m.timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHwVersion returns the old "hw_version" field's value of the NetworkDevice entity.
-// If the NetworkDevice object wasn't provided to the builder, the object is fetched from the database.
+// OldTimestamp returns the old "timestamp" field's value of the StatusEvent entity.
+// If the StatusEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NetworkDeviceMutation) OldHwVersion(ctx context.Context) (v string, err error) {
+func (m *StatusEventMutation) OldTimestamp(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHwVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHwVersion requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHwVersion: %w", err)
-	}
-	return oldValue.HwVersion, nil
-}
-
-// ClearHwVersion clears the value of the "hw_version" field.
-func (m *NetworkDeviceMutation) ClearHwVersion() {
-	m.hw_version = nil
-	m.clearedFields[networkdevice.FieldHwVersion] = struct{}{}
-}
-
-// HwVersionCleared returns if the "hw_version" field was cleared in this mutation.
-func (m *NetworkDeviceMutation) HwVersionCleared() bool {
-	_, ok := m.clearedFields[networkdevice.FieldHwVersion]
-	return ok
-}
-
-// ResetHwVersion resets all changes to the "hw_version" field.
-func (m *NetworkDeviceMutation) ResetHwVersion() {
-	m.hw_version = nil
-	delete(m.clearedFields, networkdevice.FieldHwVersion)
-}
-
-// AddEndpointIDs adds the "endpoints" edge to the Endpoint entity by ids.
-func (m *NetworkDeviceMutation) AddEndpointIDs(ids ...string) {
-	if m.endpoints == nil {
-		m.endpoints = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.endpoints[ids[i]] = struct{}{}
-	}
-}
-
-// ClearEndpoints clears the "endpoints" edge to the Endpoint entity.
-func (m *NetworkDeviceMutation) ClearEndpoints() {
-	m.clearedendpoints = true
-}
-
-// EndpointsCleared reports if the "endpoints" edge to the Endpoint entity was cleared.
-func (m *NetworkDeviceMutation) EndpointsCleared() bool {
-	return m.clearedendpoints
-}
-
-// RemoveEndpointIDs removes the "endpoints" edge to the Endpoint entity by IDs.
-func (m *NetworkDeviceMutation) RemoveEndpointIDs(ids ...string) {
-	if m.removedendpoints == nil {
-		m.removedendpoints = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.endpoints, ids[i])
-		m.removedendpoints[ids[i]] = struct{}{}
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
 	}
-}
-
-// RemovedEndpoints returns the removed IDs of the "endpoints" edge to the Endpoint entity.
-func (m *NetworkDeviceMutation) RemovedEndpointsIDs() (ids []string) {
-	for id := range m.removedendpoints {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
 	}
-	return
+	return oldValue.Timestamp, nil
 }
 
-// EndpointsIDs returns the "endpoints" edge IDs in the mutation.
-func (m *NetworkDeviceMutation) EndpointsIDs() (ids []string) {
-	for id := range m.endpoints {
-		ids = append(ids, id)
+// AddTimestamp adds i to the "timestamp" field.
+func (m *StatusEventMutation) AddTimestamp(i int64) {
+	if m.addtimestamp != nil {
+		*m.addtimestamp += i
+	} else {
+		m.addtimestamp = &i
 	}
-	return
-}
-
-// ResetEndpoints resets all changes to the "endpoints" edge.
-func (m *NetworkDeviceMutation) ResetEndpoints() {
-	m.endpoints = nil
-	m.clearedendpoints = false
-	m.removedendpoints = nil
 }
 
-// SetSwVersionID sets the "sw_version" edge to the Version entity by id.
-func (m *NetworkDeviceMutation) SetSwVersionID(id string) {
-	m.sw_version = &id
+// AddedTimestamp returns the value that was added to the "timestamp" field in this mutation.
+func (m *StatusEventMutation) AddedTimestamp() (r int64, exists bool) {
+	v := m.addtimestamp
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearSwVersion clears the "sw_version" edge to the Version entity.
-func (m *NetworkDeviceMutation) ClearSwVersion() {
-	m.clearedsw_version = true
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *StatusEventMutation) ResetTimestamp() {
+	m.timestamp = nil
+	m.addtimestamp = nil
 }
 
-// SwVersionCleared reports if the "sw_version" edge to the Version entity was cleared.
-func (m *NetworkDeviceMutation) SwVersionCleared() bool {
-	return m.clearedsw_version
+// SetReason sets the "reason" field.
+func (m *StatusEventMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// SwVersionID returns the "sw_version" edge ID in the mutation.
-func (m *NetworkDeviceMutation) SwVersionID() (id string, exists bool) {
-	if m.sw_version != nil {
-		return *m.sw_version, true
+// Reason returns the value of the "reason" field in the mutation.
+func (m *StatusEventMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// SwVersionIDs returns the "sw_version" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SwVersionID instead. It exists only for internal usage by the builders.
-func (m *NetworkDeviceMutation) SwVersionIDs() (ids []string) {
-	if id := m.sw_version; id != nil {
-		ids = append(ids, *id)
+// OldReason returns the old "reason" field's value of the StatusEvent entity.
+// If the StatusEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusEventMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
 }
 
-// ResetSwVersion resets all changes to the "sw_version" edge.
-func (m *NetworkDeviceMutation) ResetSwVersion() {
-	m.sw_version = nil
-	m.clearedsw_version = false
+// ResetReason resets all changes to the "reason" field.
+func (m *StatusEventMutation) ResetReason() {
+	m.reason = nil
 }
 
-// SetFwVersionID sets the "fw_version" edge to the Version entity by id.
-func (m *NetworkDeviceMutation) SetFwVersionID(id string) {
-	m.fw_version = &id
+// SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by id.
+func (m *StatusEventMutation) SetNetworkDeviceID(id string) {
+	m.network_device = &id
 }
 
-// ClearFwVersion clears the "fw_version" edge to the Version entity.
-func (m *NetworkDeviceMutation) ClearFwVersion() {
-	m.clearedfw_version = true
+// ClearNetworkDevice clears the "network_device" edge to the NetworkDevice entity.
+func (m *StatusEventMutation) ClearNetworkDevice() {
+	m.clearednetwork_device = true
 }
 
-// FwVersionCleared reports if the "fw_version" edge to the Version entity was cleared.
-func (m *NetworkDeviceMutation) FwVersionCleared() bool {
-	return m.clearedfw_version
+// NetworkDeviceCleared reports if the "network_device" edge to the NetworkDevice entity was cleared.
+func (m *StatusEventMutation) NetworkDeviceCleared() bool {
+	return m.clearednetwork_device
 }
 
-// FwVersionID returns the "fw_version" edge ID in the mutation.
-func (m *NetworkDeviceMutation) FwVersionID() (id string, exists bool) {
-	if m.fw_version != nil {
-		return *m.fw_version, true
+// NetworkDeviceID returns the "network_device" edge ID in the mutation.
+func (m *StatusEventMutation) NetworkDeviceID() (id string, exists bool) {
+	if m.network_device != nil {
+		return *m.network_device, true
 	}
 	return
 }
 
-// FwVersionIDs returns the "fw_version" edge IDs in the mutation.
+// NetworkDeviceIDs returns the "network_device" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FwVersionID instead. It exists only for internal usage by the builders.
-func (m *NetworkDeviceMutation) FwVersionIDs() (ids []string) {
-	if id := m.fw_version; id != nil {
+// NetworkDeviceID instead. It exists only for internal usage by the builders.
+func (m *StatusEventMutation) NetworkDeviceIDs() (ids []string) {
+	if id := m.network_device; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFwVersion resets all changes to the "fw_version" edge.
-func (m *NetworkDeviceMutation) ResetFwVersion() {
-	m.fw_version = nil
-	m.clearedfw_version = false
+// ResetNetworkDevice resets all changes to the "network_device" edge.
+func (m *StatusEventMutation) ResetNetworkDevice() {
+	m.network_device = nil
+	m.clearednetwork_device = false
 }
 
-// Where appends a list predicates to the NetworkDeviceMutation builder.
-func (m *NetworkDeviceMutation) Where(ps ...predicate.NetworkDevice) {
+// Where appends a list predicates to the StatusEventMutation builder.
+func (m *StatusEventMutation) Where(ps ...predicate.StatusEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NetworkDeviceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StatusEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NetworkDeviceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NetworkDevice, len(ps))
+func (m *StatusEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StatusEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1499,33 +8531,36 @@ func (m *NetworkDeviceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NetworkDeviceMutation) Op() Op {
+func (m *StatusEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NetworkDeviceMutation) SetOp(op Op) {
+func (m *StatusEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (NetworkDevice).
-func (m *NetworkDeviceMutation) Type() string {
+// Type returns the node type of this mutation (StatusEvent).
+func (m *StatusEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NetworkDeviceMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.vendor != nil {
-		fields = append(fields, networkdevice.FieldVendor)
+func (m *StatusEventMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.old_status != nil {
+		fields = append(fields, statusevent.FieldOldStatus)
 	}
-	if m.model != nil {
-		fields = append(fields, networkdevice.FieldModel)
+	if m.new_status != nil {
+		fields = append(fields, statusevent.FieldNewStatus)
 	}
-	if m.hw_version != nil {
-		fields = append(fields, networkdevice.FieldHwVersion)
+	if m.timestamp != nil {
+		fields = append(fields, statusevent.FieldTimestamp)
+	}
+	if m.reason != nil {
+		fields = append(fields, statusevent.FieldReason)
 	}
 	return fields
 }
@@ -1533,14 +8568,16 @@ func (m *NetworkDeviceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NetworkDeviceMutation) Field(name string) (ent.Value, bool) {
+func (m *StatusEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case networkdevice.FieldVendor:
-		return m.Vendor()
-	case networkdevice.FieldModel:
-		return m.Model()
-	case networkdevice.FieldHwVersion:
-		return m.HwVersion()
+	case statusevent.FieldOldStatus:
+		return m.OldStatus()
+	case statusevent.FieldNewStatus:
+		return m.NewStatus()
+	case statusevent.FieldTimestamp:
+		return m.Timestamp()
+	case statusevent.FieldReason:
+		return m.Reason()
 	}
 	return nil, false
 }
@@ -1548,146 +8585,148 @@ func (m *NetworkDeviceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NetworkDeviceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StatusEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case networkdevice.FieldVendor:
-		return m.OldVendor(ctx)
-	case networkdevice.FieldModel:
-		return m.OldModel(ctx)
-	case networkdevice.FieldHwVersion:
-		return m.OldHwVersion(ctx)
+	case statusevent.FieldOldStatus:
+		return m.OldOldStatus(ctx)
+	case statusevent.FieldNewStatus:
+		return m.OldNewStatus(ctx)
+	case statusevent.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case statusevent.FieldReason:
+		return m.OldReason(ctx)
 	}
-	return nil, fmt.Errorf("unknown NetworkDevice field %s", name)
+	return nil, fmt.Errorf("unknown StatusEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NetworkDeviceMutation) SetField(name string, value ent.Value) error {
+func (m *StatusEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case networkdevice.FieldVendor:
-		v, ok := value.(networkdevice.Vendor)
+	case statusevent.FieldOldStatus:
+		v, ok := value.(statusevent.OldStatus)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVendor(v)
+		m.SetOldStatus(v)
 		return nil
-	case networkdevice.FieldModel:
-		v, ok := value.(string)
+	case statusevent.FieldNewStatus:
+		v, ok := value.(statusevent.NewStatus)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModel(v)
+		m.SetNewStatus(v)
 		return nil
-	case networkdevice.FieldHwVersion:
+	case statusevent.FieldTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case statusevent.FieldReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHwVersion(v)
+		m.SetReason(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NetworkDevice field %s", name)
+	return fmt.Errorf("unknown StatusEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NetworkDeviceMutation) AddedFields() []string {
-	return nil
+func (m *StatusEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addtimestamp != nil {
+		fields = append(fields, statusevent.FieldTimestamp)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NetworkDeviceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *StatusEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case statusevent.FieldTimestamp:
+		return m.AddedTimestamp()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NetworkDeviceMutation) AddField(name string, value ent.Value) error {
+func (m *StatusEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case statusevent.FieldTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimestamp(v)
+		return nil
 	}
-	return fmt.Errorf("unknown NetworkDevice numeric field %s", name)
+	return fmt.Errorf("unknown StatusEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NetworkDeviceMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(networkdevice.FieldHwVersion) {
-		fields = append(fields, networkdevice.FieldHwVersion)
-	}
-	return fields
+func (m *StatusEventMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NetworkDeviceMutation) FieldCleared(name string) bool {
+func (m *StatusEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NetworkDeviceMutation) ClearField(name string) error {
-	switch name {
-	case networkdevice.FieldHwVersion:
-		m.ClearHwVersion()
-		return nil
-	}
-	return fmt.Errorf("unknown NetworkDevice nullable field %s", name)
+func (m *StatusEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown StatusEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NetworkDeviceMutation) ResetField(name string) error {
+func (m *StatusEventMutation) ResetField(name string) error {
 	switch name {
-	case networkdevice.FieldVendor:
-		m.ResetVendor()
+	case statusevent.FieldOldStatus:
+		m.ResetOldStatus()
 		return nil
-	case networkdevice.FieldModel:
-		m.ResetModel()
+	case statusevent.FieldNewStatus:
+		m.ResetNewStatus()
 		return nil
-	case networkdevice.FieldHwVersion:
-		m.ResetHwVersion()
+	case statusevent.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case statusevent.FieldReason:
+		m.ResetReason()
 		return nil
 	}
-	return fmt.Errorf("unknown NetworkDevice field %s", name)
+	return fmt.Errorf("unknown StatusEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NetworkDeviceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.endpoints != nil {
-		edges = append(edges, networkdevice.EdgeEndpoints)
-	}
-	if m.sw_version != nil {
-		edges = append(edges, networkdevice.EdgeSwVersion)
-	}
-	if m.fw_version != nil {
-		edges = append(edges, networkdevice.EdgeFwVersion)
+func (m *StatusEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.network_device != nil {
+		edges = append(edges, statusevent.EdgeNetworkDevice)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NetworkDeviceMutation) AddedIDs(name string) []ent.Value {
+func (m *StatusEventMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case networkdevice.EdgeEndpoints:
-		ids := make([]ent.Value, 0, len(m.endpoints))
-		for id := range m.endpoints {
-			ids = append(ids, id)
-		}
-		return ids
-	case networkdevice.EdgeSwVersion:
-		if id := m.sw_version; id != nil {
-			return []ent.Value{*id}
-		}
-	case networkdevice.EdgeFwVersion:
-		if id := m.fw_version; id != nil {
+	case statusevent.EdgeNetworkDevice:
+		if id := m.network_device; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1695,86 +8734,56 @@ func (m *NetworkDeviceMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NetworkDeviceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedendpoints != nil {
-		edges = append(edges, networkdevice.EdgeEndpoints)
-	}
+func (m *StatusEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NetworkDeviceMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case networkdevice.EdgeEndpoints:
-		ids := make([]ent.Value, 0, len(m.removedendpoints))
-		for id := range m.removedendpoints {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *StatusEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NetworkDeviceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedendpoints {
-		edges = append(edges, networkdevice.EdgeEndpoints)
-	}
-	if m.clearedsw_version {
-		edges = append(edges, networkdevice.EdgeSwVersion)
-	}
-	if m.clearedfw_version {
-		edges = append(edges, networkdevice.EdgeFwVersion)
+func (m *StatusEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearednetwork_device {
+		edges = append(edges, statusevent.EdgeNetworkDevice)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NetworkDeviceMutation) EdgeCleared(name string) bool {
+func (m *StatusEventMutation) EdgeCleared(name string) bool {
 	switch name {
-	case networkdevice.EdgeEndpoints:
-		return m.clearedendpoints
-	case networkdevice.EdgeSwVersion:
-		return m.clearedsw_version
-	case networkdevice.EdgeFwVersion:
-		return m.clearedfw_version
+	case statusevent.EdgeNetworkDevice:
+		return m.clearednetwork_device
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NetworkDeviceMutation) ClearEdge(name string) error {
+func (m *StatusEventMutation) ClearEdge(name string) error {
 	switch name {
-	case networkdevice.EdgeSwVersion:
-		m.ClearSwVersion()
-		return nil
-	case networkdevice.EdgeFwVersion:
-		m.ClearFwVersion()
+	case statusevent.EdgeNetworkDevice:
+		m.ClearNetworkDevice()
 		return nil
 	}
-	return fmt.Errorf("unknown NetworkDevice unique edge %s", name)
+	return fmt.Errorf("unknown StatusEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NetworkDeviceMutation) ResetEdge(name string) error {
+func (m *StatusEventMutation) ResetEdge(name string) error {
 	switch name {
-	case networkdevice.EdgeEndpoints:
-		m.ResetEndpoints()
-		return nil
-	case networkdevice.EdgeSwVersion:
-		m.ResetSwVersion()
-		return nil
-	case networkdevice.EdgeFwVersion:
-		m.ResetFwVersion()
+	case statusevent.EdgeNetworkDevice:
+		m.ResetNetworkDevice()
 		return nil
 	}
-	return fmt.Errorf("unknown NetworkDevice edge %s", name)
+	return fmt.Errorf("unknown StatusEvent edge %s", name)
 }
 
 // VersionMutation represents an operation that mutates the Version nodes in the graph.
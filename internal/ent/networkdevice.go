@@ -23,31 +23,55 @@ type NetworkDevice struct {
 	Model string `json:"model,omitempty"`
 	// HwVersion holds the value of the "hw_version" field.
 	HwVersion string `json:"hw_version,omitempty"`
+	// PollInterval holds the value of the "poll_interval" field.
+	PollInterval int32 `json:"poll_interval,omitempty"`
+	// Group holds the value of the "group" field.
+	Group string `json:"group,omitempty"`
+	// Site holds the value of the "site" field.
+	Site string `json:"site,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the NetworkDeviceQuery when eager-loading is set.
 	Edges                     NetworkDeviceEdges `json:"edges"`
 	network_device_sw_version *string
 	network_device_fw_version *string
+	network_device_children   *string
 	selectValues              sql.SelectValues
 }
 
 // NetworkDeviceEdges holds the relations/edges for other nodes in the graph.
 type NetworkDeviceEdges struct {
+	// Parent holds the value of the parent edge.
+	Parent *NetworkDevice `json:"parent,omitempty"`
 	// Endpoints holds the value of the endpoints edge.
 	Endpoints []*Endpoint `json:"endpoints,omitempty"`
 	// SwVersion holds the value of the sw_version edge.
 	SwVersion *Version `json:"sw_version,omitempty"`
 	// FwVersion holds the value of the fw_version edge.
 	FwVersion *Version `json:"fw_version,omitempty"`
+	// MaintenanceWindows holds the value of the maintenance_windows edge.
+	MaintenanceWindows []*MaintenanceWindow `json:"maintenance_windows,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*NetworkDevice `json:"children,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [6]bool
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NetworkDeviceEdges) ParentOrErr() (*NetworkDevice, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: networkdevice.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
 }
 
 // EndpointsOrErr returns the Endpoints value or an error if the edge
 // was not loaded in eager-loading.
 func (e NetworkDeviceEdges) EndpointsOrErr() ([]*Endpoint, error) {
-	if e.loadedTypes[0] {
+	if e.loadedTypes[1] {
 		return e.Endpoints, nil
 	}
 	return nil, &NotLoadedError{edge: "endpoints"}
@@ -58,7 +82,7 @@ func (e NetworkDeviceEdges) EndpointsOrErr() ([]*Endpoint, error) {
 func (e NetworkDeviceEdges) SwVersionOrErr() (*Version, error) {
 	if e.SwVersion != nil {
 		return e.SwVersion, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: version.Label}
 	}
 	return nil, &NotLoadedError{edge: "sw_version"}
@@ -69,23 +93,45 @@ func (e NetworkDeviceEdges) SwVersionOrErr() (*Version, error) {
 func (e NetworkDeviceEdges) FwVersionOrErr() (*Version, error) {
 	if e.FwVersion != nil {
 		return e.FwVersion, nil
-	} else if e.loadedTypes[2] {
+	} else if e.loadedTypes[3] {
 		return nil, &NotFoundError{label: version.Label}
 	}
 	return nil, &NotLoadedError{edge: "fw_version"}
 }
 
+// MaintenanceWindowsOrErr returns the MaintenanceWindows value or an error if the edge
+// was not loaded in eager-loading.
+func (e NetworkDeviceEdges) MaintenanceWindowsOrErr() ([]*MaintenanceWindow, error) {
+	if e.loadedTypes[4] {
+		return e.MaintenanceWindows, nil
+	}
+	return nil, &NotLoadedError{edge: "maintenance_windows"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e NetworkDeviceEdges) ChildrenOrErr() ([]*NetworkDevice, error) {
+	if e.loadedTypes[5] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*NetworkDevice) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case networkdevice.FieldID, networkdevice.FieldVendor, networkdevice.FieldModel, networkdevice.FieldHwVersion:
+		case networkdevice.FieldPollInterval:
+			values[i] = new(sql.NullInt64)
+		case networkdevice.FieldID, networkdevice.FieldVendor, networkdevice.FieldModel, networkdevice.FieldHwVersion, networkdevice.FieldGroup, networkdevice.FieldSite:
 			values[i] = new(sql.NullString)
 		case networkdevice.ForeignKeys[0]: // network_device_sw_version
 			values[i] = new(sql.NullString)
 		case networkdevice.ForeignKeys[1]: // network_device_fw_version
 			values[i] = new(sql.NullString)
+		case networkdevice.ForeignKeys[2]: // network_device_children
+			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -125,6 +171,24 @@ func (nd *NetworkDevice) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				nd.HwVersion = value.String
 			}
+		case networkdevice.FieldPollInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_interval", values[i])
+			} else if value.Valid {
+				nd.PollInterval = int32(value.Int64)
+			}
+		case networkdevice.FieldGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group", values[i])
+			} else if value.Valid {
+				nd.Group = value.String
+			}
+		case networkdevice.FieldSite:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site", values[i])
+			} else if value.Valid {
+				nd.Site = value.String
+			}
 		case networkdevice.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field network_device_sw_version", values[i])
@@ -139,6 +203,13 @@ func (nd *NetworkDevice) assignValues(columns []string, values []any) error {
 				nd.network_device_fw_version = new(string)
 				*nd.network_device_fw_version = value.String
 			}
+		case networkdevice.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network_device_children", values[i])
+			} else if value.Valid {
+				nd.network_device_children = new(string)
+				*nd.network_device_children = value.String
+			}
 		default:
 			nd.selectValues.Set(columns[i], values[i])
 		}
@@ -152,6 +223,11 @@ func (nd *NetworkDevice) Value(name string) (ent.Value, error) {
 	return nd.selectValues.Get(name)
 }
 
+// QueryParent queries the "parent" edge of the NetworkDevice entity.
+func (nd *NetworkDevice) QueryParent() *NetworkDeviceQuery {
+	return NewNetworkDeviceClient(nd.config).QueryParent(nd)
+}
+
 // QueryEndpoints queries the "endpoints" edge of the NetworkDevice entity.
 func (nd *NetworkDevice) QueryEndpoints() *EndpointQuery {
 	return NewNetworkDeviceClient(nd.config).QueryEndpoints(nd)
@@ -167,6 +243,16 @@ func (nd *NetworkDevice) QueryFwVersion() *VersionQuery {
 	return NewNetworkDeviceClient(nd.config).QueryFwVersion(nd)
 }
 
+// QueryMaintenanceWindows queries the "maintenance_windows" edge of the NetworkDevice entity.
+func (nd *NetworkDevice) QueryMaintenanceWindows() *MaintenanceWindowQuery {
+	return NewNetworkDeviceClient(nd.config).QueryMaintenanceWindows(nd)
+}
+
+// QueryChildren queries the "children" edge of the NetworkDevice entity.
+func (nd *NetworkDevice) QueryChildren() *NetworkDeviceQuery {
+	return NewNetworkDeviceClient(nd.config).QueryChildren(nd)
+}
+
 // Update returns a builder for updating this NetworkDevice.
 // Note that you need to call NetworkDevice.Unwrap() before calling this method if this NetworkDevice
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -198,6 +284,15 @@ func (nd *NetworkDevice) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("hw_version=")
 	builder.WriteString(nd.HwVersion)
+	builder.WriteString(", ")
+	builder.WriteString("poll_interval=")
+	builder.WriteString(fmt.Sprintf("%v", nd.PollInterval))
+	builder.WriteString(", ")
+	builder.WriteString("group=")
+	builder.WriteString(nd.Group)
+	builder.WriteString(", ")
+	builder.WriteString("site=")
+	builder.WriteString(nd.Site)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -20,14 +20,30 @@ const (
 	FieldModel = "model"
 	// FieldHwVersion holds the string denoting the hw_version field in the database.
 	FieldHwVersion = "hw_version"
+	// FieldPollInterval holds the string denoting the poll_interval field in the database.
+	FieldPollInterval = "poll_interval"
+	// FieldGroup holds the string denoting the group field in the database.
+	FieldGroup = "group"
+	// FieldSite holds the string denoting the site field in the database.
+	FieldSite = "site"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
 	// EdgeEndpoints holds the string denoting the endpoints edge name in mutations.
 	EdgeEndpoints = "endpoints"
 	// EdgeSwVersion holds the string denoting the sw_version edge name in mutations.
 	EdgeSwVersion = "sw_version"
 	// EdgeFwVersion holds the string denoting the fw_version edge name in mutations.
 	EdgeFwVersion = "fw_version"
+	// EdgeMaintenanceWindows holds the string denoting the maintenance_windows edge name in mutations.
+	EdgeMaintenanceWindows = "maintenance_windows"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
 	// Table holds the table name of the networkdevice in the database.
 	Table = "network_devices"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "network_devices"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "network_device_children"
 	// EndpointsTable is the table that holds the endpoints relation/edge.
 	EndpointsTable = "endpoints"
 	// EndpointsInverseTable is the table name for the Endpoint entity.
@@ -49,6 +65,15 @@ const (
 	FwVersionInverseTable = "versions"
 	// FwVersionColumn is the table column denoting the fw_version relation/edge.
 	FwVersionColumn = "network_device_fw_version"
+	// MaintenanceWindowsTable is the table that holds the maintenance_windows relation/edge. The primary key declared below.
+	MaintenanceWindowsTable = "maintenance_window_network_devices"
+	// MaintenanceWindowsInverseTable is the table name for the MaintenanceWindow entity.
+	// It exists in this package in order to avoid circular dependency with the "maintenancewindow" package.
+	MaintenanceWindowsInverseTable = "maintenance_windows"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "network_devices"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "network_device_children"
 )
 
 // Columns holds all SQL columns for networkdevice fields.
@@ -57,6 +82,9 @@ var Columns = []string{
 	FieldVendor,
 	FieldModel,
 	FieldHwVersion,
+	FieldPollInterval,
+	FieldGroup,
+	FieldSite,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "network_devices"
@@ -64,8 +92,15 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"network_device_sw_version",
 	"network_device_fw_version",
+	"network_device_children",
 }
 
+var (
+	// MaintenanceWindowsPrimaryKey and MaintenanceWindowsColumn2 are the table columns denoting the
+	// primary key for the maintenance_windows relation (M2M).
+	MaintenanceWindowsPrimaryKey = []string{"maintenance_window_id", "network_device_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -129,6 +164,28 @@ func ByHwVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHwVersion, opts...).ToFunc()
 }
 
+// ByPollInterval orders the results by the poll_interval field.
+func ByPollInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPollInterval, opts...).ToFunc()
+}
+
+// ByGroup orders the results by the group field.
+func ByGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroup, opts...).ToFunc()
+}
+
+// BySite orders the results by the site field.
+func BySite(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSite, opts...).ToFunc()
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByEndpointsCount orders the results by endpoints count.
 func ByEndpointsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -156,6 +213,41 @@ func ByFwVersionField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFwVersionStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByMaintenanceWindowsCount orders the results by maintenance_windows count.
+func ByMaintenanceWindowsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMaintenanceWindowsStep(), opts...)
+	}
+}
+
+// ByMaintenanceWindows orders the results by maintenance_windows terms.
+func ByMaintenanceWindows(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMaintenanceWindowsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
 func newEndpointsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -177,3 +269,17 @@ func newFwVersionStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, FwVersionTable, FwVersionColumn),
 	)
 }
+func newMaintenanceWindowsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MaintenanceWindowsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, MaintenanceWindowsTable, MaintenanceWindowsPrimaryKey...),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
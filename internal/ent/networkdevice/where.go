@@ -73,6 +73,21 @@ func HwVersion(v string) predicate.NetworkDevice {
 	return predicate.NetworkDevice(sql.FieldEQ(FieldHwVersion, v))
 }
 
+// PollInterval applies equality check predicate on the "poll_interval" field. It's identical to PollIntervalEQ.
+func PollInterval(v int32) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldEQ(FieldPollInterval, v))
+}
+
+// Group applies equality check predicate on the "group" field. It's identical to GroupEQ.
+func Group(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldEQ(FieldGroup, v))
+}
+
+// Site applies equality check predicate on the "site" field. It's identical to SiteEQ.
+func Site(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldEQ(FieldSite, v))
+}
+
 // VendorEQ applies the EQ predicate on the "vendor" field.
 func VendorEQ(v Vendor) predicate.NetworkDevice {
 	return predicate.NetworkDevice(sql.FieldEQ(FieldVendor, v))
@@ -233,6 +248,229 @@ func HwVersionContainsFold(v string) predicate.NetworkDevice {
 	return predicate.NetworkDevice(sql.FieldContainsFold(FieldHwVersion, v))
 }
 
+// PollIntervalEQ applies the EQ predicate on the "poll_interval" field.
+func PollIntervalEQ(v int32) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldEQ(FieldPollInterval, v))
+}
+
+// PollIntervalNEQ applies the NEQ predicate on the "poll_interval" field.
+func PollIntervalNEQ(v int32) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldNEQ(FieldPollInterval, v))
+}
+
+// PollIntervalIn applies the In predicate on the "poll_interval" field.
+func PollIntervalIn(vs ...int32) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldIn(FieldPollInterval, vs...))
+}
+
+// PollIntervalNotIn applies the NotIn predicate on the "poll_interval" field.
+func PollIntervalNotIn(vs ...int32) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldNotIn(FieldPollInterval, vs...))
+}
+
+// PollIntervalGT applies the GT predicate on the "poll_interval" field.
+func PollIntervalGT(v int32) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldGT(FieldPollInterval, v))
+}
+
+// PollIntervalGTE applies the GTE predicate on the "poll_interval" field.
+func PollIntervalGTE(v int32) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldGTE(FieldPollInterval, v))
+}
+
+// PollIntervalLT applies the LT predicate on the "poll_interval" field.
+func PollIntervalLT(v int32) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldLT(FieldPollInterval, v))
+}
+
+// PollIntervalLTE applies the LTE predicate on the "poll_interval" field.
+func PollIntervalLTE(v int32) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldLTE(FieldPollInterval, v))
+}
+
+// PollIntervalIsNil applies the IsNil predicate on the "poll_interval" field.
+func PollIntervalIsNil() predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldIsNull(FieldPollInterval))
+}
+
+// PollIntervalNotNil applies the NotNil predicate on the "poll_interval" field.
+func PollIntervalNotNil() predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldNotNull(FieldPollInterval))
+}
+
+// GroupEQ applies the EQ predicate on the "group" field.
+func GroupEQ(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldEQ(FieldGroup, v))
+}
+
+// GroupNEQ applies the NEQ predicate on the "group" field.
+func GroupNEQ(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldNEQ(FieldGroup, v))
+}
+
+// GroupIn applies the In predicate on the "group" field.
+func GroupIn(vs ...string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldIn(FieldGroup, vs...))
+}
+
+// GroupNotIn applies the NotIn predicate on the "group" field.
+func GroupNotIn(vs ...string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldNotIn(FieldGroup, vs...))
+}
+
+// GroupGT applies the GT predicate on the "group" field.
+func GroupGT(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldGT(FieldGroup, v))
+}
+
+// GroupGTE applies the GTE predicate on the "group" field.
+func GroupGTE(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldGTE(FieldGroup, v))
+}
+
+// GroupLT applies the LT predicate on the "group" field.
+func GroupLT(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldLT(FieldGroup, v))
+}
+
+// GroupLTE applies the LTE predicate on the "group" field.
+func GroupLTE(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldLTE(FieldGroup, v))
+}
+
+// GroupContains applies the Contains predicate on the "group" field.
+func GroupContains(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldContains(FieldGroup, v))
+}
+
+// GroupHasPrefix applies the HasPrefix predicate on the "group" field.
+func GroupHasPrefix(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldHasPrefix(FieldGroup, v))
+}
+
+// GroupHasSuffix applies the HasSuffix predicate on the "group" field.
+func GroupHasSuffix(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldHasSuffix(FieldGroup, v))
+}
+
+// GroupIsNil applies the IsNil predicate on the "group" field.
+func GroupIsNil() predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldIsNull(FieldGroup))
+}
+
+// GroupNotNil applies the NotNil predicate on the "group" field.
+func GroupNotNil() predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldNotNull(FieldGroup))
+}
+
+// GroupEqualFold applies the EqualFold predicate on the "group" field.
+func GroupEqualFold(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldEqualFold(FieldGroup, v))
+}
+
+// GroupContainsFold applies the ContainsFold predicate on the "group" field.
+func GroupContainsFold(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldContainsFold(FieldGroup, v))
+}
+
+// SiteEQ applies the EQ predicate on the "site" field.
+func SiteEQ(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldEQ(FieldSite, v))
+}
+
+// SiteNEQ applies the NEQ predicate on the "site" field.
+func SiteNEQ(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldNEQ(FieldSite, v))
+}
+
+// SiteIn applies the In predicate on the "site" field.
+func SiteIn(vs ...string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldIn(FieldSite, vs...))
+}
+
+// SiteNotIn applies the NotIn predicate on the "site" field.
+func SiteNotIn(vs ...string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldNotIn(FieldSite, vs...))
+}
+
+// SiteGT applies the GT predicate on the "site" field.
+func SiteGT(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldGT(FieldSite, v))
+}
+
+// SiteGTE applies the GTE predicate on the "site" field.
+func SiteGTE(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldGTE(FieldSite, v))
+}
+
+// SiteLT applies the LT predicate on the "site" field.
+func SiteLT(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldLT(FieldSite, v))
+}
+
+// SiteLTE applies the LTE predicate on the "site" field.
+func SiteLTE(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldLTE(FieldSite, v))
+}
+
+// SiteContains applies the Contains predicate on the "site" field.
+func SiteContains(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldContains(FieldSite, v))
+}
+
+// SiteHasPrefix applies the HasPrefix predicate on the "site" field.
+func SiteHasPrefix(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldHasPrefix(FieldSite, v))
+}
+
+// SiteHasSuffix applies the HasSuffix predicate on the "site" field.
+func SiteHasSuffix(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldHasSuffix(FieldSite, v))
+}
+
+// SiteIsNil applies the IsNil predicate on the "site" field.
+func SiteIsNil() predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldIsNull(FieldSite))
+}
+
+// SiteNotNil applies the NotNil predicate on the "site" field.
+func SiteNotNil() predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldNotNull(FieldSite))
+}
+
+// SiteEqualFold applies the EqualFold predicate on the "site" field.
+func SiteEqualFold(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldEqualFold(FieldSite, v))
+}
+
+// SiteContainsFold applies the ContainsFold predicate on the "site" field.
+func SiteContainsFold(v string) predicate.NetworkDevice {
+	return predicate.NetworkDevice(sql.FieldContainsFold(FieldSite, v))
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.NetworkDevice {
+	return predicate.NetworkDevice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.NetworkDevice) predicate.NetworkDevice {
+	return predicate.NetworkDevice(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasEndpoints applies the HasEdge predicate on the "endpoints" edge.
 func HasEndpoints() predicate.NetworkDevice {
 	return predicate.NetworkDevice(func(s *sql.Selector) {
@@ -302,6 +540,52 @@ func HasFwVersionWith(preds ...predicate.Version) predicate.NetworkDevice {
 	})
 }
 
+// HasMaintenanceWindows applies the HasEdge predicate on the "maintenance_windows" edge.
+func HasMaintenanceWindows() predicate.NetworkDevice {
+	return predicate.NetworkDevice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, MaintenanceWindowsTable, MaintenanceWindowsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMaintenanceWindowsWith applies the HasEdge predicate on the "maintenance_windows" edge with a given conditions (other predicates).
+func HasMaintenanceWindowsWith(preds ...predicate.MaintenanceWindow) predicate.NetworkDevice {
+	return predicate.NetworkDevice(func(s *sql.Selector) {
+		step := newMaintenanceWindowsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.NetworkDevice {
+	return predicate.NetworkDevice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.NetworkDevice) predicate.NetworkDevice {
+	return predicate.NetworkDevice(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.NetworkDevice) predicate.NetworkDevice {
 	return predicate.NetworkDevice(sql.AndPredicates(predicates...))
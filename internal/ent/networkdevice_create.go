@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/maintenancewindow"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
 	"github.com/eroshiva/trade-show-poc/internal/ent/version"
 )
@@ -47,12 +48,73 @@ func (ndc *NetworkDeviceCreate) SetNillableHwVersion(s *string) *NetworkDeviceCr
 	return ndc
 }
 
+// SetPollInterval sets the "poll_interval" field.
+func (ndc *NetworkDeviceCreate) SetPollInterval(i int32) *NetworkDeviceCreate {
+	ndc.mutation.SetPollInterval(i)
+	return ndc
+}
+
+// SetNillablePollInterval sets the "poll_interval" field if the given value is not nil.
+func (ndc *NetworkDeviceCreate) SetNillablePollInterval(i *int32) *NetworkDeviceCreate {
+	if i != nil {
+		ndc.SetPollInterval(*i)
+	}
+	return ndc
+}
+
+// SetGroup sets the "group" field.
+func (ndc *NetworkDeviceCreate) SetGroup(s string) *NetworkDeviceCreate {
+	ndc.mutation.SetGroup(s)
+	return ndc
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (ndc *NetworkDeviceCreate) SetNillableGroup(s *string) *NetworkDeviceCreate {
+	if s != nil {
+		ndc.SetGroup(*s)
+	}
+	return ndc
+}
+
+// SetSite sets the "site" field.
+func (ndc *NetworkDeviceCreate) SetSite(s string) *NetworkDeviceCreate {
+	ndc.mutation.SetSite(s)
+	return ndc
+}
+
+// SetNillableSite sets the "site" field if the given value is not nil.
+func (ndc *NetworkDeviceCreate) SetNillableSite(s *string) *NetworkDeviceCreate {
+	if s != nil {
+		ndc.SetSite(*s)
+	}
+	return ndc
+}
+
 // SetID sets the "id" field.
 func (ndc *NetworkDeviceCreate) SetID(s string) *NetworkDeviceCreate {
 	ndc.mutation.SetID(s)
 	return ndc
 }
 
+// SetParentID sets the "parent" edge to the NetworkDevice entity by ID.
+func (ndc *NetworkDeviceCreate) SetParentID(id string) *NetworkDeviceCreate {
+	ndc.mutation.SetParentID(id)
+	return ndc
+}
+
+// SetNillableParentID sets the "parent" edge to the NetworkDevice entity by ID if the given value is not nil.
+func (ndc *NetworkDeviceCreate) SetNillableParentID(id *string) *NetworkDeviceCreate {
+	if id != nil {
+		ndc = ndc.SetParentID(*id)
+	}
+	return ndc
+}
+
+// SetParent sets the "parent" edge to the NetworkDevice entity.
+func (ndc *NetworkDeviceCreate) SetParent(n *NetworkDevice) *NetworkDeviceCreate {
+	return ndc.SetParentID(n.ID)
+}
+
 // AddEndpointIDs adds the "endpoints" edge to the Endpoint entity by IDs.
 func (ndc *NetworkDeviceCreate) AddEndpointIDs(ids ...string) *NetworkDeviceCreate {
 	ndc.mutation.AddEndpointIDs(ids...)
@@ -106,6 +168,36 @@ func (ndc *NetworkDeviceCreate) SetFwVersion(v *Version) *NetworkDeviceCreate {
 	return ndc.SetFwVersionID(v.ID)
 }
 
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (ndc *NetworkDeviceCreate) AddMaintenanceWindowIDs(ids ...string) *NetworkDeviceCreate {
+	ndc.mutation.AddMaintenanceWindowIDs(ids...)
+	return ndc
+}
+
+// AddMaintenanceWindows adds the "maintenance_windows" edges to the MaintenanceWindow entity.
+func (ndc *NetworkDeviceCreate) AddMaintenanceWindows(m ...*MaintenanceWindow) *NetworkDeviceCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ndc.AddMaintenanceWindowIDs(ids...)
+}
+
+// AddChildIDs adds the "children" edge to the NetworkDevice entity by IDs.
+func (ndc *NetworkDeviceCreate) AddChildIDs(ids ...string) *NetworkDeviceCreate {
+	ndc.mutation.AddChildIDs(ids...)
+	return ndc
+}
+
+// AddChildren adds the "children" edges to the NetworkDevice entity.
+func (ndc *NetworkDeviceCreate) AddChildren(n ...*NetworkDevice) *NetworkDeviceCreate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return ndc.AddChildIDs(ids...)
+}
+
 // Mutation returns the NetworkDeviceMutation object of the builder.
 func (ndc *NetworkDeviceCreate) Mutation() *NetworkDeviceMutation {
 	return ndc.mutation
@@ -198,6 +290,35 @@ func (ndc *NetworkDeviceCreate) createSpec() (*NetworkDevice, *sqlgraph.CreateSp
 		_spec.SetField(networkdevice.FieldHwVersion, field.TypeString, value)
 		_node.HwVersion = value
 	}
+	if value, ok := ndc.mutation.PollInterval(); ok {
+		_spec.SetField(networkdevice.FieldPollInterval, field.TypeInt32, value)
+		_node.PollInterval = value
+	}
+	if value, ok := ndc.mutation.Group(); ok {
+		_spec.SetField(networkdevice.FieldGroup, field.TypeString, value)
+		_node.Group = value
+	}
+	if value, ok := ndc.mutation.Site(); ok {
+		_spec.SetField(networkdevice.FieldSite, field.TypeString, value)
+		_node.Site = value
+	}
+	if nodes := ndc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   networkdevice.ParentTable,
+			Columns: []string{networkdevice.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.network_device_children = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := ndc.mutation.EndpointsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -248,6 +369,38 @@ func (ndc *NetworkDeviceCreate) createSpec() (*NetworkDevice, *sqlgraph.CreateSp
 		_node.network_device_fw_version = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ndc.mutation.MaintenanceWindowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   networkdevice.MaintenanceWindowsTable,
+			Columns: networkdevice.MaintenanceWindowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ndc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   networkdevice.ChildrenTable,
+			Columns: []string{networkdevice.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
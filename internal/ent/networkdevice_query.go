@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/maintenancewindow"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
 	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
 	"github.com/eroshiva/trade-show-poc/internal/ent/version"
@@ -21,14 +22,17 @@ import (
 // NetworkDeviceQuery is the builder for querying NetworkDevice entities.
 type NetworkDeviceQuery struct {
 	config
-	ctx           *QueryContext
-	order         []networkdevice.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.NetworkDevice
-	withEndpoints *EndpointQuery
-	withSwVersion *VersionQuery
-	withFwVersion *VersionQuery
-	withFKs       bool
+	ctx                    *QueryContext
+	order                  []networkdevice.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.NetworkDevice
+	withParent             *NetworkDeviceQuery
+	withEndpoints          *EndpointQuery
+	withSwVersion          *VersionQuery
+	withFwVersion          *VersionQuery
+	withMaintenanceWindows *MaintenanceWindowQuery
+	withChildren           *NetworkDeviceQuery
+	withFKs                bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -65,6 +69,28 @@ func (ndq *NetworkDeviceQuery) Order(o ...networkdevice.OrderOption) *NetworkDev
 	return ndq
 }
 
+// QueryParent chains the current query on the "parent" edge.
+func (ndq *NetworkDeviceQuery) QueryParent() *NetworkDeviceQuery {
+	query := (&NetworkDeviceClient{config: ndq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ndq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ndq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(networkdevice.Table, networkdevice.FieldID, selector),
+			sqlgraph.To(networkdevice.Table, networkdevice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, networkdevice.ParentTable, networkdevice.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ndq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryEndpoints chains the current query on the "endpoints" edge.
 func (ndq *NetworkDeviceQuery) QueryEndpoints() *EndpointQuery {
 	query := (&EndpointClient{config: ndq.config}).Query()
@@ -131,6 +157,50 @@ func (ndq *NetworkDeviceQuery) QueryFwVersion() *VersionQuery {
 	return query
 }
 
+// QueryMaintenanceWindows chains the current query on the "maintenance_windows" edge.
+func (ndq *NetworkDeviceQuery) QueryMaintenanceWindows() *MaintenanceWindowQuery {
+	query := (&MaintenanceWindowClient{config: ndq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ndq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ndq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(networkdevice.Table, networkdevice.FieldID, selector),
+			sqlgraph.To(maintenancewindow.Table, maintenancewindow.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, networkdevice.MaintenanceWindowsTable, networkdevice.MaintenanceWindowsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(ndq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (ndq *NetworkDeviceQuery) QueryChildren() *NetworkDeviceQuery {
+	query := (&NetworkDeviceClient{config: ndq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ndq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ndq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(networkdevice.Table, networkdevice.FieldID, selector),
+			sqlgraph.To(networkdevice.Table, networkdevice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, networkdevice.ChildrenTable, networkdevice.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ndq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first NetworkDevice entity from the query.
 // Returns a *NotFoundError when no NetworkDevice was found.
 func (ndq *NetworkDeviceQuery) First(ctx context.Context) (*NetworkDevice, error) {
@@ -318,20 +388,34 @@ func (ndq *NetworkDeviceQuery) Clone() *NetworkDeviceQuery {
 		return nil
 	}
 	return &NetworkDeviceQuery{
-		config:        ndq.config,
-		ctx:           ndq.ctx.Clone(),
-		order:         append([]networkdevice.OrderOption{}, ndq.order...),
-		inters:        append([]Interceptor{}, ndq.inters...),
-		predicates:    append([]predicate.NetworkDevice{}, ndq.predicates...),
-		withEndpoints: ndq.withEndpoints.Clone(),
-		withSwVersion: ndq.withSwVersion.Clone(),
-		withFwVersion: ndq.withFwVersion.Clone(),
+		config:                 ndq.config,
+		ctx:                    ndq.ctx.Clone(),
+		order:                  append([]networkdevice.OrderOption{}, ndq.order...),
+		inters:                 append([]Interceptor{}, ndq.inters...),
+		predicates:             append([]predicate.NetworkDevice{}, ndq.predicates...),
+		withParent:             ndq.withParent.Clone(),
+		withEndpoints:          ndq.withEndpoints.Clone(),
+		withSwVersion:          ndq.withSwVersion.Clone(),
+		withFwVersion:          ndq.withFwVersion.Clone(),
+		withMaintenanceWindows: ndq.withMaintenanceWindows.Clone(),
+		withChildren:           ndq.withChildren.Clone(),
 		// clone intermediate query.
 		sql:  ndq.sql.Clone(),
 		path: ndq.path,
 	}
 }
 
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (ndq *NetworkDeviceQuery) WithParent(opts ...func(*NetworkDeviceQuery)) *NetworkDeviceQuery {
+	query := (&NetworkDeviceClient{config: ndq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ndq.withParent = query
+	return ndq
+}
+
 // WithEndpoints tells the query-builder to eager-load the nodes that are connected to
 // the "endpoints" edge. The optional arguments are used to configure the query builder of the edge.
 func (ndq *NetworkDeviceQuery) WithEndpoints(opts ...func(*EndpointQuery)) *NetworkDeviceQuery {
@@ -365,6 +449,28 @@ func (ndq *NetworkDeviceQuery) WithFwVersion(opts ...func(*VersionQuery)) *Netwo
 	return ndq
 }
 
+// WithMaintenanceWindows tells the query-builder to eager-load the nodes that are connected to
+// the "maintenance_windows" edge. The optional arguments are used to configure the query builder of the edge.
+func (ndq *NetworkDeviceQuery) WithMaintenanceWindows(opts ...func(*MaintenanceWindowQuery)) *NetworkDeviceQuery {
+	query := (&MaintenanceWindowClient{config: ndq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ndq.withMaintenanceWindows = query
+	return ndq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (ndq *NetworkDeviceQuery) WithChildren(opts ...func(*NetworkDeviceQuery)) *NetworkDeviceQuery {
+	query := (&NetworkDeviceClient{config: ndq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ndq.withChildren = query
+	return ndq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,13 +550,16 @@ func (ndq *NetworkDeviceQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		nodes       = []*NetworkDevice{}
 		withFKs     = ndq.withFKs
 		_spec       = ndq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [6]bool{
+			ndq.withParent != nil,
 			ndq.withEndpoints != nil,
 			ndq.withSwVersion != nil,
 			ndq.withFwVersion != nil,
+			ndq.withMaintenanceWindows != nil,
+			ndq.withChildren != nil,
 		}
 	)
-	if ndq.withSwVersion != nil || ndq.withFwVersion != nil {
+	if ndq.withParent != nil || ndq.withSwVersion != nil || ndq.withFwVersion != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -474,6 +583,12 @@ func (ndq *NetworkDeviceQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := ndq.withParent; query != nil {
+		if err := ndq.loadParent(ctx, query, nodes, nil,
+			func(n *NetworkDevice, e *NetworkDevice) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := ndq.withEndpoints; query != nil {
 		if err := ndq.loadEndpoints(ctx, query, nodes,
 			func(n *NetworkDevice) { n.Edges.Endpoints = []*Endpoint{} },
@@ -493,9 +608,57 @@ func (ndq *NetworkDeviceQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := ndq.withMaintenanceWindows; query != nil {
+		if err := ndq.loadMaintenanceWindows(ctx, query, nodes,
+			func(n *NetworkDevice) { n.Edges.MaintenanceWindows = []*MaintenanceWindow{} },
+			func(n *NetworkDevice, e *MaintenanceWindow) {
+				n.Edges.MaintenanceWindows = append(n.Edges.MaintenanceWindows, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := ndq.withChildren; query != nil {
+		if err := ndq.loadChildren(ctx, query, nodes,
+			func(n *NetworkDevice) { n.Edges.Children = []*NetworkDevice{} },
+			func(n *NetworkDevice, e *NetworkDevice) { n.Edges.Children = append(n.Edges.Children, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (ndq *NetworkDeviceQuery) loadParent(ctx context.Context, query *NetworkDeviceQuery, nodes []*NetworkDevice, init func(*NetworkDevice), assign func(*NetworkDevice, *NetworkDevice)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*NetworkDevice)
+	for i := range nodes {
+		if nodes[i].network_device_children == nil {
+			continue
+		}
+		fk := *nodes[i].network_device_children
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(networkdevice.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "network_device_children" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (ndq *NetworkDeviceQuery) loadEndpoints(ctx context.Context, query *EndpointQuery, nodes []*NetworkDevice, init func(*NetworkDevice), assign func(*NetworkDevice, *Endpoint)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*NetworkDevice)
@@ -591,6 +754,98 @@ func (ndq *NetworkDeviceQuery) loadFwVersion(ctx context.Context, query *Version
 	}
 	return nil
 }
+func (ndq *NetworkDeviceQuery) loadMaintenanceWindows(ctx context.Context, query *MaintenanceWindowQuery, nodes []*NetworkDevice, init func(*NetworkDevice), assign func(*NetworkDevice, *MaintenanceWindow)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*NetworkDevice)
+	nids := make(map[string]map[*NetworkDevice]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(networkdevice.MaintenanceWindowsTable)
+		s.Join(joinT).On(s.C(maintenancewindow.FieldID), joinT.C(networkdevice.MaintenanceWindowsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(networkdevice.MaintenanceWindowsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(networkdevice.MaintenanceWindowsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*NetworkDevice]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*MaintenanceWindow](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "maintenance_windows" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (ndq *NetworkDeviceQuery) loadChildren(ctx context.Context, query *NetworkDeviceQuery, nodes []*NetworkDevice, init func(*NetworkDevice), assign func(*NetworkDevice, *NetworkDevice)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*NetworkDevice)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.NetworkDevice(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(networkdevice.ChildrenColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.network_device_children
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "network_device_children" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "network_device_children" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (ndq *NetworkDeviceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ndq.querySpec()
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/maintenancewindow"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
 	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
 	"github.com/eroshiva/trade-show-poc/internal/ent/version"
@@ -77,6 +78,92 @@ func (ndu *NetworkDeviceUpdate) ClearHwVersion() *NetworkDeviceUpdate {
 	return ndu
 }
 
+// SetPollInterval sets the "poll_interval" field.
+func (ndu *NetworkDeviceUpdate) SetPollInterval(i int32) *NetworkDeviceUpdate {
+	ndu.mutation.ResetPollInterval()
+	ndu.mutation.SetPollInterval(i)
+	return ndu
+}
+
+// SetNillablePollInterval sets the "poll_interval" field if the given value is not nil.
+func (ndu *NetworkDeviceUpdate) SetNillablePollInterval(i *int32) *NetworkDeviceUpdate {
+	if i != nil {
+		ndu.SetPollInterval(*i)
+	}
+	return ndu
+}
+
+// AddPollInterval adds i to the "poll_interval" field.
+func (ndu *NetworkDeviceUpdate) AddPollInterval(i int32) *NetworkDeviceUpdate {
+	ndu.mutation.AddPollInterval(i)
+	return ndu
+}
+
+// ClearPollInterval clears the value of the "poll_interval" field.
+func (ndu *NetworkDeviceUpdate) ClearPollInterval() *NetworkDeviceUpdate {
+	ndu.mutation.ClearPollInterval()
+	return ndu
+}
+
+// SetGroup sets the "group" field.
+func (ndu *NetworkDeviceUpdate) SetGroup(s string) *NetworkDeviceUpdate {
+	ndu.mutation.SetGroup(s)
+	return ndu
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (ndu *NetworkDeviceUpdate) SetNillableGroup(s *string) *NetworkDeviceUpdate {
+	if s != nil {
+		ndu.SetGroup(*s)
+	}
+	return ndu
+}
+
+// ClearGroup clears the value of the "group" field.
+func (ndu *NetworkDeviceUpdate) ClearGroup() *NetworkDeviceUpdate {
+	ndu.mutation.ClearGroup()
+	return ndu
+}
+
+// SetSite sets the "site" field.
+func (ndu *NetworkDeviceUpdate) SetSite(s string) *NetworkDeviceUpdate {
+	ndu.mutation.SetSite(s)
+	return ndu
+}
+
+// SetNillableSite sets the "site" field if the given value is not nil.
+func (ndu *NetworkDeviceUpdate) SetNillableSite(s *string) *NetworkDeviceUpdate {
+	if s != nil {
+		ndu.SetSite(*s)
+	}
+	return ndu
+}
+
+// ClearSite clears the value of the "site" field.
+func (ndu *NetworkDeviceUpdate) ClearSite() *NetworkDeviceUpdate {
+	ndu.mutation.ClearSite()
+	return ndu
+}
+
+// SetParentID sets the "parent" edge to the NetworkDevice entity by ID.
+func (ndu *NetworkDeviceUpdate) SetParentID(id string) *NetworkDeviceUpdate {
+	ndu.mutation.SetParentID(id)
+	return ndu
+}
+
+// SetNillableParentID sets the "parent" edge to the NetworkDevice entity by ID if the given value is not nil.
+func (ndu *NetworkDeviceUpdate) SetNillableParentID(id *string) *NetworkDeviceUpdate {
+	if id != nil {
+		ndu = ndu.SetParentID(*id)
+	}
+	return ndu
+}
+
+// SetParent sets the "parent" edge to the NetworkDevice entity.
+func (ndu *NetworkDeviceUpdate) SetParent(n *NetworkDevice) *NetworkDeviceUpdate {
+	return ndu.SetParentID(n.ID)
+}
+
 // AddEndpointIDs adds the "endpoints" edge to the Endpoint entity by IDs.
 func (ndu *NetworkDeviceUpdate) AddEndpointIDs(ids ...string) *NetworkDeviceUpdate {
 	ndu.mutation.AddEndpointIDs(ids...)
@@ -130,11 +217,47 @@ func (ndu *NetworkDeviceUpdate) SetFwVersion(v *Version) *NetworkDeviceUpdate {
 	return ndu.SetFwVersionID(v.ID)
 }
 
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (ndu *NetworkDeviceUpdate) AddMaintenanceWindowIDs(ids ...string) *NetworkDeviceUpdate {
+	ndu.mutation.AddMaintenanceWindowIDs(ids...)
+	return ndu
+}
+
+// AddMaintenanceWindows adds the "maintenance_windows" edges to the MaintenanceWindow entity.
+func (ndu *NetworkDeviceUpdate) AddMaintenanceWindows(m ...*MaintenanceWindow) *NetworkDeviceUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ndu.AddMaintenanceWindowIDs(ids...)
+}
+
+// AddChildIDs adds the "children" edge to the NetworkDevice entity by IDs.
+func (ndu *NetworkDeviceUpdate) AddChildIDs(ids ...string) *NetworkDeviceUpdate {
+	ndu.mutation.AddChildIDs(ids...)
+	return ndu
+}
+
+// AddChildren adds the "children" edges to the NetworkDevice entity.
+func (ndu *NetworkDeviceUpdate) AddChildren(n ...*NetworkDevice) *NetworkDeviceUpdate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return ndu.AddChildIDs(ids...)
+}
+
 // Mutation returns the NetworkDeviceMutation object of the builder.
 func (ndu *NetworkDeviceUpdate) Mutation() *NetworkDeviceMutation {
 	return ndu.mutation
 }
 
+// ClearParent clears the "parent" edge to the NetworkDevice entity.
+func (ndu *NetworkDeviceUpdate) ClearParent() *NetworkDeviceUpdate {
+	ndu.mutation.ClearParent()
+	return ndu
+}
+
 // ClearEndpoints clears all "endpoints" edges to the Endpoint entity.
 func (ndu *NetworkDeviceUpdate) ClearEndpoints() *NetworkDeviceUpdate {
 	ndu.mutation.ClearEndpoints()
@@ -168,6 +291,48 @@ func (ndu *NetworkDeviceUpdate) ClearFwVersion() *NetworkDeviceUpdate {
 	return ndu
 }
 
+// ClearMaintenanceWindows clears all "maintenance_windows" edges to the MaintenanceWindow entity.
+func (ndu *NetworkDeviceUpdate) ClearMaintenanceWindows() *NetworkDeviceUpdate {
+	ndu.mutation.ClearMaintenanceWindows()
+	return ndu
+}
+
+// RemoveMaintenanceWindowIDs removes the "maintenance_windows" edge to MaintenanceWindow entities by IDs.
+func (ndu *NetworkDeviceUpdate) RemoveMaintenanceWindowIDs(ids ...string) *NetworkDeviceUpdate {
+	ndu.mutation.RemoveMaintenanceWindowIDs(ids...)
+	return ndu
+}
+
+// RemoveMaintenanceWindows removes "maintenance_windows" edges to MaintenanceWindow entities.
+func (ndu *NetworkDeviceUpdate) RemoveMaintenanceWindows(m ...*MaintenanceWindow) *NetworkDeviceUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ndu.RemoveMaintenanceWindowIDs(ids...)
+}
+
+// ClearChildren clears all "children" edges to the NetworkDevice entity.
+func (ndu *NetworkDeviceUpdate) ClearChildren() *NetworkDeviceUpdate {
+	ndu.mutation.ClearChildren()
+	return ndu
+}
+
+// RemoveChildIDs removes the "children" edge to NetworkDevice entities by IDs.
+func (ndu *NetworkDeviceUpdate) RemoveChildIDs(ids ...string) *NetworkDeviceUpdate {
+	ndu.mutation.RemoveChildIDs(ids...)
+	return ndu
+}
+
+// RemoveChildren removes "children" edges to NetworkDevice entities.
+func (ndu *NetworkDeviceUpdate) RemoveChildren(n ...*NetworkDevice) *NetworkDeviceUpdate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return ndu.RemoveChildIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ndu *NetworkDeviceUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ndu.sqlSave, ndu.mutation, ndu.hooks)
@@ -229,6 +394,56 @@ func (ndu *NetworkDeviceUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if ndu.mutation.HwVersionCleared() {
 		_spec.ClearField(networkdevice.FieldHwVersion, field.TypeString)
 	}
+	if value, ok := ndu.mutation.PollInterval(); ok {
+		_spec.SetField(networkdevice.FieldPollInterval, field.TypeInt32, value)
+	}
+	if value, ok := ndu.mutation.AddedPollInterval(); ok {
+		_spec.AddField(networkdevice.FieldPollInterval, field.TypeInt32, value)
+	}
+	if ndu.mutation.PollIntervalCleared() {
+		_spec.ClearField(networkdevice.FieldPollInterval, field.TypeInt32)
+	}
+	if value, ok := ndu.mutation.Group(); ok {
+		_spec.SetField(networkdevice.FieldGroup, field.TypeString, value)
+	}
+	if ndu.mutation.GroupCleared() {
+		_spec.ClearField(networkdevice.FieldGroup, field.TypeString)
+	}
+	if value, ok := ndu.mutation.Site(); ok {
+		_spec.SetField(networkdevice.FieldSite, field.TypeString, value)
+	}
+	if ndu.mutation.SiteCleared() {
+		_spec.ClearField(networkdevice.FieldSite, field.TypeString)
+	}
+	if ndu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   networkdevice.ParentTable,
+			Columns: []string{networkdevice.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ndu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   networkdevice.ParentTable,
+			Columns: []string{networkdevice.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ndu.mutation.EndpointsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -332,6 +547,96 @@ func (ndu *NetworkDeviceUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ndu.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   networkdevice.MaintenanceWindowsTable,
+			Columns: networkdevice.MaintenanceWindowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ndu.mutation.RemovedMaintenanceWindowsIDs(); len(nodes) > 0 && !ndu.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   networkdevice.MaintenanceWindowsTable,
+			Columns: networkdevice.MaintenanceWindowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ndu.mutation.MaintenanceWindowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   networkdevice.MaintenanceWindowsTable,
+			Columns: networkdevice.MaintenanceWindowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ndu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   networkdevice.ChildrenTable,
+			Columns: []string{networkdevice.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ndu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !ndu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   networkdevice.ChildrenTable,
+			Columns: []string{networkdevice.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ndu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   networkdevice.ChildrenTable,
+			Columns: []string{networkdevice.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ndu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{networkdevice.Label}
@@ -400,6 +705,92 @@ func (nduo *NetworkDeviceUpdateOne) ClearHwVersion() *NetworkDeviceUpdateOne {
 	return nduo
 }
 
+// SetPollInterval sets the "poll_interval" field.
+func (nduo *NetworkDeviceUpdateOne) SetPollInterval(i int32) *NetworkDeviceUpdateOne {
+	nduo.mutation.ResetPollInterval()
+	nduo.mutation.SetPollInterval(i)
+	return nduo
+}
+
+// SetNillablePollInterval sets the "poll_interval" field if the given value is not nil.
+func (nduo *NetworkDeviceUpdateOne) SetNillablePollInterval(i *int32) *NetworkDeviceUpdateOne {
+	if i != nil {
+		nduo.SetPollInterval(*i)
+	}
+	return nduo
+}
+
+// AddPollInterval adds i to the "poll_interval" field.
+func (nduo *NetworkDeviceUpdateOne) AddPollInterval(i int32) *NetworkDeviceUpdateOne {
+	nduo.mutation.AddPollInterval(i)
+	return nduo
+}
+
+// ClearPollInterval clears the value of the "poll_interval" field.
+func (nduo *NetworkDeviceUpdateOne) ClearPollInterval() *NetworkDeviceUpdateOne {
+	nduo.mutation.ClearPollInterval()
+	return nduo
+}
+
+// SetGroup sets the "group" field.
+func (nduo *NetworkDeviceUpdateOne) SetGroup(s string) *NetworkDeviceUpdateOne {
+	nduo.mutation.SetGroup(s)
+	return nduo
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (nduo *NetworkDeviceUpdateOne) SetNillableGroup(s *string) *NetworkDeviceUpdateOne {
+	if s != nil {
+		nduo.SetGroup(*s)
+	}
+	return nduo
+}
+
+// ClearGroup clears the value of the "group" field.
+func (nduo *NetworkDeviceUpdateOne) ClearGroup() *NetworkDeviceUpdateOne {
+	nduo.mutation.ClearGroup()
+	return nduo
+}
+
+// SetSite sets the "site" field.
+func (nduo *NetworkDeviceUpdateOne) SetSite(s string) *NetworkDeviceUpdateOne {
+	nduo.mutation.SetSite(s)
+	return nduo
+}
+
+// SetNillableSite sets the "site" field if the given value is not nil.
+func (nduo *NetworkDeviceUpdateOne) SetNillableSite(s *string) *NetworkDeviceUpdateOne {
+	if s != nil {
+		nduo.SetSite(*s)
+	}
+	return nduo
+}
+
+// ClearSite clears the value of the "site" field.
+func (nduo *NetworkDeviceUpdateOne) ClearSite() *NetworkDeviceUpdateOne {
+	nduo.mutation.ClearSite()
+	return nduo
+}
+
+// SetParentID sets the "parent" edge to the NetworkDevice entity by ID.
+func (nduo *NetworkDeviceUpdateOne) SetParentID(id string) *NetworkDeviceUpdateOne {
+	nduo.mutation.SetParentID(id)
+	return nduo
+}
+
+// SetNillableParentID sets the "parent" edge to the NetworkDevice entity by ID if the given value is not nil.
+func (nduo *NetworkDeviceUpdateOne) SetNillableParentID(id *string) *NetworkDeviceUpdateOne {
+	if id != nil {
+		nduo = nduo.SetParentID(*id)
+	}
+	return nduo
+}
+
+// SetParent sets the "parent" edge to the NetworkDevice entity.
+func (nduo *NetworkDeviceUpdateOne) SetParent(n *NetworkDevice) *NetworkDeviceUpdateOne {
+	return nduo.SetParentID(n.ID)
+}
+
 // AddEndpointIDs adds the "endpoints" edge to the Endpoint entity by IDs.
 func (nduo *NetworkDeviceUpdateOne) AddEndpointIDs(ids ...string) *NetworkDeviceUpdateOne {
 	nduo.mutation.AddEndpointIDs(ids...)
@@ -453,11 +844,47 @@ func (nduo *NetworkDeviceUpdateOne) SetFwVersion(v *Version) *NetworkDeviceUpdat
 	return nduo.SetFwVersionID(v.ID)
 }
 
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (nduo *NetworkDeviceUpdateOne) AddMaintenanceWindowIDs(ids ...string) *NetworkDeviceUpdateOne {
+	nduo.mutation.AddMaintenanceWindowIDs(ids...)
+	return nduo
+}
+
+// AddMaintenanceWindows adds the "maintenance_windows" edges to the MaintenanceWindow entity.
+func (nduo *NetworkDeviceUpdateOne) AddMaintenanceWindows(m ...*MaintenanceWindow) *NetworkDeviceUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return nduo.AddMaintenanceWindowIDs(ids...)
+}
+
+// AddChildIDs adds the "children" edge to the NetworkDevice entity by IDs.
+func (nduo *NetworkDeviceUpdateOne) AddChildIDs(ids ...string) *NetworkDeviceUpdateOne {
+	nduo.mutation.AddChildIDs(ids...)
+	return nduo
+}
+
+// AddChildren adds the "children" edges to the NetworkDevice entity.
+func (nduo *NetworkDeviceUpdateOne) AddChildren(n ...*NetworkDevice) *NetworkDeviceUpdateOne {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nduo.AddChildIDs(ids...)
+}
+
 // Mutation returns the NetworkDeviceMutation object of the builder.
 func (nduo *NetworkDeviceUpdateOne) Mutation() *NetworkDeviceMutation {
 	return nduo.mutation
 }
 
+// ClearParent clears the "parent" edge to the NetworkDevice entity.
+func (nduo *NetworkDeviceUpdateOne) ClearParent() *NetworkDeviceUpdateOne {
+	nduo.mutation.ClearParent()
+	return nduo
+}
+
 // ClearEndpoints clears all "endpoints" edges to the Endpoint entity.
 func (nduo *NetworkDeviceUpdateOne) ClearEndpoints() *NetworkDeviceUpdateOne {
 	nduo.mutation.ClearEndpoints()
@@ -491,6 +918,48 @@ func (nduo *NetworkDeviceUpdateOne) ClearFwVersion() *NetworkDeviceUpdateOne {
 	return nduo
 }
 
+// ClearMaintenanceWindows clears all "maintenance_windows" edges to the MaintenanceWindow entity.
+func (nduo *NetworkDeviceUpdateOne) ClearMaintenanceWindows() *NetworkDeviceUpdateOne {
+	nduo.mutation.ClearMaintenanceWindows()
+	return nduo
+}
+
+// RemoveMaintenanceWindowIDs removes the "maintenance_windows" edge to MaintenanceWindow entities by IDs.
+func (nduo *NetworkDeviceUpdateOne) RemoveMaintenanceWindowIDs(ids ...string) *NetworkDeviceUpdateOne {
+	nduo.mutation.RemoveMaintenanceWindowIDs(ids...)
+	return nduo
+}
+
+// RemoveMaintenanceWindows removes "maintenance_windows" edges to MaintenanceWindow entities.
+func (nduo *NetworkDeviceUpdateOne) RemoveMaintenanceWindows(m ...*MaintenanceWindow) *NetworkDeviceUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return nduo.RemoveMaintenanceWindowIDs(ids...)
+}
+
+// ClearChildren clears all "children" edges to the NetworkDevice entity.
+func (nduo *NetworkDeviceUpdateOne) ClearChildren() *NetworkDeviceUpdateOne {
+	nduo.mutation.ClearChildren()
+	return nduo
+}
+
+// RemoveChildIDs removes the "children" edge to NetworkDevice entities by IDs.
+func (nduo *NetworkDeviceUpdateOne) RemoveChildIDs(ids ...string) *NetworkDeviceUpdateOne {
+	nduo.mutation.RemoveChildIDs(ids...)
+	return nduo
+}
+
+// RemoveChildren removes "children" edges to NetworkDevice entities.
+func (nduo *NetworkDeviceUpdateOne) RemoveChildren(n ...*NetworkDevice) *NetworkDeviceUpdateOne {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nduo.RemoveChildIDs(ids...)
+}
+
 // Where appends a list predicates to the NetworkDeviceUpdate builder.
 func (nduo *NetworkDeviceUpdateOne) Where(ps ...predicate.NetworkDevice) *NetworkDeviceUpdateOne {
 	nduo.mutation.Where(ps...)
@@ -582,6 +1051,56 @@ func (nduo *NetworkDeviceUpdateOne) sqlSave(ctx context.Context) (_node *Network
 	if nduo.mutation.HwVersionCleared() {
 		_spec.ClearField(networkdevice.FieldHwVersion, field.TypeString)
 	}
+	if value, ok := nduo.mutation.PollInterval(); ok {
+		_spec.SetField(networkdevice.FieldPollInterval, field.TypeInt32, value)
+	}
+	if value, ok := nduo.mutation.AddedPollInterval(); ok {
+		_spec.AddField(networkdevice.FieldPollInterval, field.TypeInt32, value)
+	}
+	if nduo.mutation.PollIntervalCleared() {
+		_spec.ClearField(networkdevice.FieldPollInterval, field.TypeInt32)
+	}
+	if value, ok := nduo.mutation.Group(); ok {
+		_spec.SetField(networkdevice.FieldGroup, field.TypeString, value)
+	}
+	if nduo.mutation.GroupCleared() {
+		_spec.ClearField(networkdevice.FieldGroup, field.TypeString)
+	}
+	if value, ok := nduo.mutation.Site(); ok {
+		_spec.SetField(networkdevice.FieldSite, field.TypeString, value)
+	}
+	if nduo.mutation.SiteCleared() {
+		_spec.ClearField(networkdevice.FieldSite, field.TypeString)
+	}
+	if nduo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   networkdevice.ParentTable,
+			Columns: []string{networkdevice.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nduo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   networkdevice.ParentTable,
+			Columns: []string{networkdevice.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if nduo.mutation.EndpointsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -685,6 +1204,96 @@ func (nduo *NetworkDeviceUpdateOne) sqlSave(ctx context.Context) (_node *Network
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nduo.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   networkdevice.MaintenanceWindowsTable,
+			Columns: networkdevice.MaintenanceWindowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nduo.mutation.RemovedMaintenanceWindowsIDs(); len(nodes) > 0 && !nduo.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   networkdevice.MaintenanceWindowsTable,
+			Columns: networkdevice.MaintenanceWindowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nduo.mutation.MaintenanceWindowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   networkdevice.MaintenanceWindowsTable,
+			Columns: networkdevice.MaintenanceWindowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nduo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   networkdevice.ChildrenTable,
+			Columns: []string{networkdevice.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nduo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !nduo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   networkdevice.ChildrenTable,
+			Columns: []string{networkdevice.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nduo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   networkdevice.ChildrenTable,
+			Columns: []string{networkdevice.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &NetworkDevice{config: nduo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
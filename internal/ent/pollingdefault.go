@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
+)
+
+// PollingDefault is the model entity for the PollingDefault schema.
+type PollingDefault struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope pollingdefault.Scope `json:"scope,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// PollInterval holds the value of the "poll_interval" field.
+	PollInterval int32 `json:"poll_interval,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PollingDefault) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pollingdefault.FieldPollInterval:
+			values[i] = new(sql.NullInt64)
+		case pollingdefault.FieldID, pollingdefault.FieldScope, pollingdefault.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PollingDefault fields.
+func (pd *PollingDefault) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pollingdefault.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pd.ID = value.String
+			}
+		case pollingdefault.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				pd.Scope = pollingdefault.Scope(value.String)
+			}
+		case pollingdefault.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pd.Name = value.String
+			}
+		case pollingdefault.FieldPollInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_interval", values[i])
+			} else if value.Valid {
+				pd.PollInterval = int32(value.Int64)
+			}
+		default:
+			pd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PollingDefault.
+// This includes values selected through modifiers, order, etc.
+func (pd *PollingDefault) Value(name string) (ent.Value, error) {
+	return pd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PollingDefault.
+// Note that you need to call PollingDefault.Unwrap() before calling this method if this PollingDefault
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pd *PollingDefault) Update() *PollingDefaultUpdateOne {
+	return NewPollingDefaultClient(pd.config).UpdateOne(pd)
+}
+
+// Unwrap unwraps the PollingDefault entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pd *PollingDefault) Unwrap() *PollingDefault {
+	_tx, ok := pd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PollingDefault is not a transactional entity")
+	}
+	pd.config.driver = _tx.drv
+	return pd
+}
+
+// String implements the fmt.Stringer.
+func (pd *PollingDefault) String() string {
+	var builder strings.Builder
+	builder.WriteString("PollingDefault(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pd.ID))
+	builder.WriteString("scope=")
+	builder.WriteString(fmt.Sprintf("%v", pd.Scope))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pd.Name)
+	builder.WriteString(", ")
+	builder.WriteString("poll_interval=")
+	builder.WriteString(fmt.Sprintf("%v", pd.PollInterval))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PollingDefaults is a parsable slice of PollingDefault.
+type PollingDefaults []*PollingDefault
@@ -0,0 +1,89 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pollingdefault
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the pollingdefault type in the database.
+	Label = "polling_default"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPollInterval holds the string denoting the poll_interval field in the database.
+	FieldPollInterval = "poll_interval"
+	// Table holds the table name of the pollingdefault in the database.
+	Table = "polling_defaults"
+)
+
+// Columns holds all SQL columns for pollingdefault fields.
+var Columns = []string{
+	FieldID,
+	FieldScope,
+	FieldName,
+	FieldPollInterval,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope defines the type for the "scope" enum field.
+type Scope string
+
+// Scope values.
+const (
+	ScopePOLLING_SCOPE_UNSPECIFIED Scope = "POLLING_SCOPE_UNSPECIFIED"
+	ScopePOLLING_SCOPE_GROUP       Scope = "POLLING_SCOPE_GROUP"
+	ScopePOLLING_SCOPE_SITE        Scope = "POLLING_SCOPE_SITE"
+)
+
+func (s Scope) String() string {
+	return string(s)
+}
+
+// ScopeValidator is a validator for the "scope" field enum values. It is called by the builders before save.
+func ScopeValidator(s Scope) error {
+	switch s {
+	case ScopePOLLING_SCOPE_UNSPECIFIED, ScopePOLLING_SCOPE_GROUP, ScopePOLLING_SCOPE_SITE:
+		return nil
+	default:
+		return fmt.Errorf("pollingdefault: invalid enum value for scope field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the PollingDefault queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByScope orders the results by the scope field.
+func ByScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScope, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPollInterval orders the results by the poll_interval field.
+func ByPollInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPollInterval, opts...).ToFunc()
+}
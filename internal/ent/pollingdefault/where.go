@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pollingdefault
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldEQ(FieldName, v))
+}
+
+// PollInterval applies equality check predicate on the "poll_interval" field. It's identical to PollIntervalEQ.
+func PollInterval(v int32) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldEQ(FieldPollInterval, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v Scope) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v Scope) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...Scope) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...Scope) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldContainsFold(FieldName, v))
+}
+
+// PollIntervalEQ applies the EQ predicate on the "poll_interval" field.
+func PollIntervalEQ(v int32) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldEQ(FieldPollInterval, v))
+}
+
+// PollIntervalNEQ applies the NEQ predicate on the "poll_interval" field.
+func PollIntervalNEQ(v int32) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldNEQ(FieldPollInterval, v))
+}
+
+// PollIntervalIn applies the In predicate on the "poll_interval" field.
+func PollIntervalIn(vs ...int32) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldIn(FieldPollInterval, vs...))
+}
+
+// PollIntervalNotIn applies the NotIn predicate on the "poll_interval" field.
+func PollIntervalNotIn(vs ...int32) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldNotIn(FieldPollInterval, vs...))
+}
+
+// PollIntervalGT applies the GT predicate on the "poll_interval" field.
+func PollIntervalGT(v int32) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldGT(FieldPollInterval, v))
+}
+
+// PollIntervalGTE applies the GTE predicate on the "poll_interval" field.
+func PollIntervalGTE(v int32) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldGTE(FieldPollInterval, v))
+}
+
+// PollIntervalLT applies the LT predicate on the "poll_interval" field.
+func PollIntervalLT(v int32) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldLT(FieldPollInterval, v))
+}
+
+// PollIntervalLTE applies the LTE predicate on the "poll_interval" field.
+func PollIntervalLTE(v int32) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.FieldLTE(FieldPollInterval, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PollingDefault) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PollingDefault) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PollingDefault) predicate.PollingDefault {
+	return predicate.PollingDefault(sql.NotPredicates(p))
+}
@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
+)
+
+// PollingDefaultCreate is the builder for creating a PollingDefault entity.
+type PollingDefaultCreate struct {
+	config
+	mutation *PollingDefaultMutation
+	hooks    []Hook
+}
+
+// SetScope sets the "scope" field.
+func (pdc *PollingDefaultCreate) SetScope(po pollingdefault.Scope) *PollingDefaultCreate {
+	pdc.mutation.SetScope(po)
+	return pdc
+}
+
+// SetName sets the "name" field.
+func (pdc *PollingDefaultCreate) SetName(s string) *PollingDefaultCreate {
+	pdc.mutation.SetName(s)
+	return pdc
+}
+
+// SetPollInterval sets the "poll_interval" field.
+func (pdc *PollingDefaultCreate) SetPollInterval(i int32) *PollingDefaultCreate {
+	pdc.mutation.SetPollInterval(i)
+	return pdc
+}
+
+// SetID sets the "id" field.
+func (pdc *PollingDefaultCreate) SetID(s string) *PollingDefaultCreate {
+	pdc.mutation.SetID(s)
+	return pdc
+}
+
+// Mutation returns the PollingDefaultMutation object of the builder.
+func (pdc *PollingDefaultCreate) Mutation() *PollingDefaultMutation {
+	return pdc.mutation
+}
+
+// Save creates the PollingDefault in the database.
+func (pdc *PollingDefaultCreate) Save(ctx context.Context) (*PollingDefault, error) {
+	return withHooks(ctx, pdc.sqlSave, pdc.mutation, pdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pdc *PollingDefaultCreate) SaveX(ctx context.Context) *PollingDefault {
+	v, err := pdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pdc *PollingDefaultCreate) Exec(ctx context.Context) error {
+	_, err := pdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdc *PollingDefaultCreate) ExecX(ctx context.Context) {
+	if err := pdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pdc *PollingDefaultCreate) check() error {
+	if _, ok := pdc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "PollingDefault.scope"`)}
+	}
+	if v, ok := pdc.mutation.Scope(); ok {
+		if err := pollingdefault.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "PollingDefault.scope": %w`, err)}
+		}
+	}
+	if _, ok := pdc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PollingDefault.name"`)}
+	}
+	if _, ok := pdc.mutation.PollInterval(); !ok {
+		return &ValidationError{Name: "poll_interval", err: errors.New(`ent: missing required field "PollingDefault.poll_interval"`)}
+	}
+	return nil
+}
+
+func (pdc *PollingDefaultCreate) sqlSave(ctx context.Context) (*PollingDefault, error) {
+	if err := pdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PollingDefault.ID type: %T", _spec.ID.Value)
+		}
+	}
+	pdc.mutation.id = &_node.ID
+	pdc.mutation.done = true
+	return _node, nil
+}
+
+func (pdc *PollingDefaultCreate) createSpec() (*PollingDefault, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PollingDefault{config: pdc.config}
+		_spec = sqlgraph.NewCreateSpec(pollingdefault.Table, sqlgraph.NewFieldSpec(pollingdefault.FieldID, field.TypeString))
+	)
+	if id, ok := pdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pdc.mutation.Scope(); ok {
+		_spec.SetField(pollingdefault.FieldScope, field.TypeEnum, value)
+		_node.Scope = value
+	}
+	if value, ok := pdc.mutation.Name(); ok {
+		_spec.SetField(pollingdefault.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pdc.mutation.PollInterval(); ok {
+		_spec.SetField(pollingdefault.FieldPollInterval, field.TypeInt32, value)
+		_node.PollInterval = value
+	}
+	return _node, _spec
+}
+
+// PollingDefaultCreateBulk is the builder for creating many PollingDefault entities in bulk.
+type PollingDefaultCreateBulk struct {
+	config
+	err      error
+	builders []*PollingDefaultCreate
+}
+
+// Save creates the PollingDefault entities in the database.
+func (pdcb *PollingDefaultCreateBulk) Save(ctx context.Context) ([]*PollingDefault, error) {
+	if pdcb.err != nil {
+		return nil, pdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pdcb.builders))
+	nodes := make([]*PollingDefault, len(pdcb.builders))
+	mutators := make([]Mutator, len(pdcb.builders))
+	for i := range pdcb.builders {
+		func(i int, root context.Context) {
+			builder := pdcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PollingDefaultMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pdcb *PollingDefaultCreateBulk) SaveX(ctx context.Context) []*PollingDefault {
+	v, err := pdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pdcb *PollingDefaultCreateBulk) Exec(ctx context.Context) error {
+	_, err := pdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdcb *PollingDefaultCreateBulk) ExecX(ctx context.Context) {
+	if err := pdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
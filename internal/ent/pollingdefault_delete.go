@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// PollingDefaultDelete is the builder for deleting a PollingDefault entity.
+type PollingDefaultDelete struct {
+	config
+	hooks    []Hook
+	mutation *PollingDefaultMutation
+}
+
+// Where appends a list predicates to the PollingDefaultDelete builder.
+func (pdd *PollingDefaultDelete) Where(ps ...predicate.PollingDefault) *PollingDefaultDelete {
+	pdd.mutation.Where(ps...)
+	return pdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pdd *PollingDefaultDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pdd.sqlExec, pdd.mutation, pdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdd *PollingDefaultDelete) ExecX(ctx context.Context) int {
+	n, err := pdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pdd *PollingDefaultDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pollingdefault.Table, sqlgraph.NewFieldSpec(pollingdefault.FieldID, field.TypeString))
+	if ps := pdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pdd.mutation.done = true
+	return affected, err
+}
+
+// PollingDefaultDeleteOne is the builder for deleting a single PollingDefault entity.
+type PollingDefaultDeleteOne struct {
+	pdd *PollingDefaultDelete
+}
+
+// Where appends a list predicates to the PollingDefaultDelete builder.
+func (pddo *PollingDefaultDeleteOne) Where(ps ...predicate.PollingDefault) *PollingDefaultDeleteOne {
+	pddo.pdd.mutation.Where(ps...)
+	return pddo
+}
+
+// Exec executes the deletion query.
+func (pddo *PollingDefaultDeleteOne) Exec(ctx context.Context) error {
+	n, err := pddo.pdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pollingdefault.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pddo *PollingDefaultDeleteOne) ExecX(ctx context.Context) {
+	if err := pddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// PollingDefaultUpdate is the builder for updating PollingDefault entities.
+type PollingDefaultUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PollingDefaultMutation
+}
+
+// Where appends a list predicates to the PollingDefaultUpdate builder.
+func (pdu *PollingDefaultUpdate) Where(ps ...predicate.PollingDefault) *PollingDefaultUpdate {
+	pdu.mutation.Where(ps...)
+	return pdu
+}
+
+// SetScope sets the "scope" field.
+func (pdu *PollingDefaultUpdate) SetScope(po pollingdefault.Scope) *PollingDefaultUpdate {
+	pdu.mutation.SetScope(po)
+	return pdu
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (pdu *PollingDefaultUpdate) SetNillableScope(po *pollingdefault.Scope) *PollingDefaultUpdate {
+	if po != nil {
+		pdu.SetScope(*po)
+	}
+	return pdu
+}
+
+// SetName sets the "name" field.
+func (pdu *PollingDefaultUpdate) SetName(s string) *PollingDefaultUpdate {
+	pdu.mutation.SetName(s)
+	return pdu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pdu *PollingDefaultUpdate) SetNillableName(s *string) *PollingDefaultUpdate {
+	if s != nil {
+		pdu.SetName(*s)
+	}
+	return pdu
+}
+
+// SetPollInterval sets the "poll_interval" field.
+func (pdu *PollingDefaultUpdate) SetPollInterval(i int32) *PollingDefaultUpdate {
+	pdu.mutation.ResetPollInterval()
+	pdu.mutation.SetPollInterval(i)
+	return pdu
+}
+
+// SetNillablePollInterval sets the "poll_interval" field if the given value is not nil.
+func (pdu *PollingDefaultUpdate) SetNillablePollInterval(i *int32) *PollingDefaultUpdate {
+	if i != nil {
+		pdu.SetPollInterval(*i)
+	}
+	return pdu
+}
+
+// AddPollInterval adds i to the "poll_interval" field.
+func (pdu *PollingDefaultUpdate) AddPollInterval(i int32) *PollingDefaultUpdate {
+	pdu.mutation.AddPollInterval(i)
+	return pdu
+}
+
+// Mutation returns the PollingDefaultMutation object of the builder.
+func (pdu *PollingDefaultUpdate) Mutation() *PollingDefaultMutation {
+	return pdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pdu *PollingDefaultUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pdu.sqlSave, pdu.mutation, pdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pdu *PollingDefaultUpdate) SaveX(ctx context.Context) int {
+	affected, err := pdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pdu *PollingDefaultUpdate) Exec(ctx context.Context) error {
+	_, err := pdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdu *PollingDefaultUpdate) ExecX(ctx context.Context) {
+	if err := pdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pdu *PollingDefaultUpdate) check() error {
+	if v, ok := pdu.mutation.Scope(); ok {
+		if err := pollingdefault.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "PollingDefault.scope": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pdu *PollingDefaultUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pollingdefault.Table, pollingdefault.Columns, sqlgraph.NewFieldSpec(pollingdefault.FieldID, field.TypeString))
+	if ps := pdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pdu.mutation.Scope(); ok {
+		_spec.SetField(pollingdefault.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := pdu.mutation.Name(); ok {
+		_spec.SetField(pollingdefault.FieldName, field.TypeString, value)
+	}
+	if value, ok := pdu.mutation.PollInterval(); ok {
+		_spec.SetField(pollingdefault.FieldPollInterval, field.TypeInt32, value)
+	}
+	if value, ok := pdu.mutation.AddedPollInterval(); ok {
+		_spec.AddField(pollingdefault.FieldPollInterval, field.TypeInt32, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pollingdefault.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pdu.mutation.done = true
+	return n, nil
+}
+
+// PollingDefaultUpdateOne is the builder for updating a single PollingDefault entity.
+type PollingDefaultUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PollingDefaultMutation
+}
+
+// SetScope sets the "scope" field.
+func (pduo *PollingDefaultUpdateOne) SetScope(po pollingdefault.Scope) *PollingDefaultUpdateOne {
+	pduo.mutation.SetScope(po)
+	return pduo
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (pduo *PollingDefaultUpdateOne) SetNillableScope(po *pollingdefault.Scope) *PollingDefaultUpdateOne {
+	if po != nil {
+		pduo.SetScope(*po)
+	}
+	return pduo
+}
+
+// SetName sets the "name" field.
+func (pduo *PollingDefaultUpdateOne) SetName(s string) *PollingDefaultUpdateOne {
+	pduo.mutation.SetName(s)
+	return pduo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pduo *PollingDefaultUpdateOne) SetNillableName(s *string) *PollingDefaultUpdateOne {
+	if s != nil {
+		pduo.SetName(*s)
+	}
+	return pduo
+}
+
+// SetPollInterval sets the "poll_interval" field.
+func (pduo *PollingDefaultUpdateOne) SetPollInterval(i int32) *PollingDefaultUpdateOne {
+	pduo.mutation.ResetPollInterval()
+	pduo.mutation.SetPollInterval(i)
+	return pduo
+}
+
+// SetNillablePollInterval sets the "poll_interval" field if the given value is not nil.
+func (pduo *PollingDefaultUpdateOne) SetNillablePollInterval(i *int32) *PollingDefaultUpdateOne {
+	if i != nil {
+		pduo.SetPollInterval(*i)
+	}
+	return pduo
+}
+
+// AddPollInterval adds i to the "poll_interval" field.
+func (pduo *PollingDefaultUpdateOne) AddPollInterval(i int32) *PollingDefaultUpdateOne {
+	pduo.mutation.AddPollInterval(i)
+	return pduo
+}
+
+// Mutation returns the PollingDefaultMutation object of the builder.
+func (pduo *PollingDefaultUpdateOne) Mutation() *PollingDefaultMutation {
+	return pduo.mutation
+}
+
+// Where appends a list predicates to the PollingDefaultUpdate builder.
+func (pduo *PollingDefaultUpdateOne) Where(ps ...predicate.PollingDefault) *PollingDefaultUpdateOne {
+	pduo.mutation.Where(ps...)
+	return pduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pduo *PollingDefaultUpdateOne) Select(field string, fields ...string) *PollingDefaultUpdateOne {
+	pduo.fields = append([]string{field}, fields...)
+	return pduo
+}
+
+// Save executes the query and returns the updated PollingDefault entity.
+func (pduo *PollingDefaultUpdateOne) Save(ctx context.Context) (*PollingDefault, error) {
+	return withHooks(ctx, pduo.sqlSave, pduo.mutation, pduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pduo *PollingDefaultUpdateOne) SaveX(ctx context.Context) *PollingDefault {
+	node, err := pduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pduo *PollingDefaultUpdateOne) Exec(ctx context.Context) error {
+	_, err := pduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pduo *PollingDefaultUpdateOne) ExecX(ctx context.Context) {
+	if err := pduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pduo *PollingDefaultUpdateOne) check() error {
+	if v, ok := pduo.mutation.Scope(); ok {
+		if err := pollingdefault.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "PollingDefault.scope": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pduo *PollingDefaultUpdateOne) sqlSave(ctx context.Context) (_node *PollingDefault, err error) {
+	if err := pduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pollingdefault.Table, pollingdefault.Columns, sqlgraph.NewFieldSpec(pollingdefault.FieldID, field.TypeString))
+	id, ok := pduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PollingDefault.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pollingdefault.FieldID)
+		for _, f := range fields {
+			if !pollingdefault.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pollingdefault.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pduo.mutation.Scope(); ok {
+		_spec.SetField(pollingdefault.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := pduo.mutation.Name(); ok {
+		_spec.SetField(pollingdefault.FieldName, field.TypeString, value)
+	}
+	if value, ok := pduo.mutation.PollInterval(); ok {
+		_spec.SetField(pollingdefault.FieldPollInterval, field.TypeInt32, value)
+	}
+	if value, ok := pduo.mutation.AddedPollInterval(); ok {
+		_spec.AddField(pollingdefault.FieldPollInterval, field.TypeInt32, value)
+	}
+	_node = &PollingDefault{config: pduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pollingdefault.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pduo.mutation.done = true
+	return _node, nil
+}
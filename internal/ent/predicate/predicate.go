@@ -6,14 +6,38 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// CredentialProfile is the predicate function for credentialprofile builders.
+type CredentialProfile func(*sql.Selector)
+
 // DeviceStatus is the predicate function for devicestatus builders.
 type DeviceStatus func(*sql.Selector)
 
 // Endpoint is the predicate function for endpoint builders.
 type Endpoint func(*sql.Selector)
 
+// EndpointHealth is the predicate function for endpointhealth builders.
+type EndpointHealth func(*sql.Selector)
+
+// LatencySample is the predicate function for latencysample builders.
+type LatencySample func(*sql.Selector)
+
+// Lease is the predicate function for lease builders.
+type Lease func(*sql.Selector)
+
+// MaintenanceWindow is the predicate function for maintenancewindow builders.
+type MaintenanceWindow func(*sql.Selector)
+
 // NetworkDevice is the predicate function for networkdevice builders.
 type NetworkDevice func(*sql.Selector)
 
+// PollingDefault is the predicate function for pollingdefault builders.
+type PollingDefault func(*sql.Selector)
+
+// ReplicaHeartbeat is the predicate function for replicaheartbeat builders.
+type ReplicaHeartbeat func(*sql.Selector)
+
+// StatusEvent is the predicate function for statusevent builders.
+type StatusEvent func(*sql.Selector)
+
 // Version is the predicate function for version builders.
 type Version func(*sql.Selector)
@@ -0,0 +1,103 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/replicaheartbeat"
+)
+
+// ReplicaHeartbeat is the model entity for the ReplicaHeartbeat schema.
+type ReplicaHeartbeat struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// LastSeen holds the value of the "last_seen" field.
+	LastSeen     int64 `json:"last_seen,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReplicaHeartbeat) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case replicaheartbeat.FieldLastSeen:
+			values[i] = new(sql.NullInt64)
+		case replicaheartbeat.FieldID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReplicaHeartbeat fields.
+func (rh *ReplicaHeartbeat) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case replicaheartbeat.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				rh.ID = value.String
+			}
+		case replicaheartbeat.FieldLastSeen:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen", values[i])
+			} else if value.Valid {
+				rh.LastSeen = value.Int64
+			}
+		default:
+			rh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReplicaHeartbeat.
+// This includes values selected through modifiers, order, etc.
+func (rh *ReplicaHeartbeat) Value(name string) (ent.Value, error) {
+	return rh.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ReplicaHeartbeat.
+// Note that you need to call ReplicaHeartbeat.Unwrap() before calling this method if this ReplicaHeartbeat
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rh *ReplicaHeartbeat) Update() *ReplicaHeartbeatUpdateOne {
+	return NewReplicaHeartbeatClient(rh.config).UpdateOne(rh)
+}
+
+// Unwrap unwraps the ReplicaHeartbeat entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rh *ReplicaHeartbeat) Unwrap() *ReplicaHeartbeat {
+	_tx, ok := rh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReplicaHeartbeat is not a transactional entity")
+	}
+	rh.config.driver = _tx.drv
+	return rh
+}
+
+// String implements the fmt.Stringer.
+func (rh *ReplicaHeartbeat) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReplicaHeartbeat(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rh.ID))
+	builder.WriteString("last_seen=")
+	builder.WriteString(fmt.Sprintf("%v", rh.LastSeen))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReplicaHeartbeats is a parsable slice of ReplicaHeartbeat.
+type ReplicaHeartbeats []*ReplicaHeartbeat
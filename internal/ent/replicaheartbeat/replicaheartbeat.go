@@ -0,0 +1,47 @@
+// Code generated by ent, DO NOT EDIT.
+
+package replicaheartbeat
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the replicaheartbeat type in the database.
+	Label = "replica_heartbeat"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLastSeen holds the string denoting the last_seen field in the database.
+	FieldLastSeen = "last_seen"
+	// Table holds the table name of the replicaheartbeat in the database.
+	Table = "replica_heartbeats"
+)
+
+// Columns holds all SQL columns for replicaheartbeat fields.
+var Columns = []string{
+	FieldID,
+	FieldLastSeen,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the ReplicaHeartbeat queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLastSeen orders the results by the last_seen field.
+func ByLastSeen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeen, opts...).ToFunc()
+}
@@ -0,0 +1,123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package replicaheartbeat
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldContainsFold(FieldID, id))
+}
+
+// LastSeen applies equality check predicate on the "last_seen" field. It's identical to LastSeenEQ.
+func LastSeen(v int64) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldEQ(FieldLastSeen, v))
+}
+
+// LastSeenEQ applies the EQ predicate on the "last_seen" field.
+func LastSeenEQ(v int64) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldEQ(FieldLastSeen, v))
+}
+
+// LastSeenNEQ applies the NEQ predicate on the "last_seen" field.
+func LastSeenNEQ(v int64) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldNEQ(FieldLastSeen, v))
+}
+
+// LastSeenIn applies the In predicate on the "last_seen" field.
+func LastSeenIn(vs ...int64) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldIn(FieldLastSeen, vs...))
+}
+
+// LastSeenNotIn applies the NotIn predicate on the "last_seen" field.
+func LastSeenNotIn(vs ...int64) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldNotIn(FieldLastSeen, vs...))
+}
+
+// LastSeenGT applies the GT predicate on the "last_seen" field.
+func LastSeenGT(v int64) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldGT(FieldLastSeen, v))
+}
+
+// LastSeenGTE applies the GTE predicate on the "last_seen" field.
+func LastSeenGTE(v int64) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldGTE(FieldLastSeen, v))
+}
+
+// LastSeenLT applies the LT predicate on the "last_seen" field.
+func LastSeenLT(v int64) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldLT(FieldLastSeen, v))
+}
+
+// LastSeenLTE applies the LTE predicate on the "last_seen" field.
+func LastSeenLTE(v int64) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.FieldLTE(FieldLastSeen, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReplicaHeartbeat) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReplicaHeartbeat) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReplicaHeartbeat) predicate.ReplicaHeartbeat {
+	return predicate.ReplicaHeartbeat(sql.NotPredicates(p))
+}
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/replicaheartbeat"
+)
+
+// ReplicaHeartbeatCreate is the builder for creating a ReplicaHeartbeat entity.
+type ReplicaHeartbeatCreate struct {
+	config
+	mutation *ReplicaHeartbeatMutation
+	hooks    []Hook
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (rhc *ReplicaHeartbeatCreate) SetLastSeen(i int64) *ReplicaHeartbeatCreate {
+	rhc.mutation.SetLastSeen(i)
+	return rhc
+}
+
+// SetID sets the "id" field.
+func (rhc *ReplicaHeartbeatCreate) SetID(s string) *ReplicaHeartbeatCreate {
+	rhc.mutation.SetID(s)
+	return rhc
+}
+
+// Mutation returns the ReplicaHeartbeatMutation object of the builder.
+func (rhc *ReplicaHeartbeatCreate) Mutation() *ReplicaHeartbeatMutation {
+	return rhc.mutation
+}
+
+// Save creates the ReplicaHeartbeat in the database.
+func (rhc *ReplicaHeartbeatCreate) Save(ctx context.Context) (*ReplicaHeartbeat, error) {
+	return withHooks(ctx, rhc.sqlSave, rhc.mutation, rhc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rhc *ReplicaHeartbeatCreate) SaveX(ctx context.Context) *ReplicaHeartbeat {
+	v, err := rhc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rhc *ReplicaHeartbeatCreate) Exec(ctx context.Context) error {
+	_, err := rhc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhc *ReplicaHeartbeatCreate) ExecX(ctx context.Context) {
+	if err := rhc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rhc *ReplicaHeartbeatCreate) check() error {
+	if _, ok := rhc.mutation.LastSeen(); !ok {
+		return &ValidationError{Name: "last_seen", err: errors.New(`ent: missing required field "ReplicaHeartbeat.last_seen"`)}
+	}
+	return nil
+}
+
+func (rhc *ReplicaHeartbeatCreate) sqlSave(ctx context.Context) (*ReplicaHeartbeat, error) {
+	if err := rhc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rhc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rhc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ReplicaHeartbeat.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rhc.mutation.id = &_node.ID
+	rhc.mutation.done = true
+	return _node, nil
+}
+
+func (rhc *ReplicaHeartbeatCreate) createSpec() (*ReplicaHeartbeat, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReplicaHeartbeat{config: rhc.config}
+		_spec = sqlgraph.NewCreateSpec(replicaheartbeat.Table, sqlgraph.NewFieldSpec(replicaheartbeat.FieldID, field.TypeString))
+	)
+	if id, ok := rhc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rhc.mutation.LastSeen(); ok {
+		_spec.SetField(replicaheartbeat.FieldLastSeen, field.TypeInt64, value)
+		_node.LastSeen = value
+	}
+	return _node, _spec
+}
+
+// ReplicaHeartbeatCreateBulk is the builder for creating many ReplicaHeartbeat entities in bulk.
+type ReplicaHeartbeatCreateBulk struct {
+	config
+	err      error
+	builders []*ReplicaHeartbeatCreate
+}
+
+// Save creates the ReplicaHeartbeat entities in the database.
+func (rhcb *ReplicaHeartbeatCreateBulk) Save(ctx context.Context) ([]*ReplicaHeartbeat, error) {
+	if rhcb.err != nil {
+		return nil, rhcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rhcb.builders))
+	nodes := make([]*ReplicaHeartbeat, len(rhcb.builders))
+	mutators := make([]Mutator, len(rhcb.builders))
+	for i := range rhcb.builders {
+		func(i int, root context.Context) {
+			builder := rhcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReplicaHeartbeatMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rhcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rhcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rhcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rhcb *ReplicaHeartbeatCreateBulk) SaveX(ctx context.Context) []*ReplicaHeartbeat {
+	v, err := rhcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rhcb *ReplicaHeartbeatCreateBulk) Exec(ctx context.Context) error {
+	_, err := rhcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhcb *ReplicaHeartbeatCreateBulk) ExecX(ctx context.Context) {
+	if err := rhcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+	"github.com/eroshiva/trade-show-poc/internal/ent/replicaheartbeat"
+)
+
+// ReplicaHeartbeatDelete is the builder for deleting a ReplicaHeartbeat entity.
+type ReplicaHeartbeatDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReplicaHeartbeatMutation
+}
+
+// Where appends a list predicates to the ReplicaHeartbeatDelete builder.
+func (rhd *ReplicaHeartbeatDelete) Where(ps ...predicate.ReplicaHeartbeat) *ReplicaHeartbeatDelete {
+	rhd.mutation.Where(ps...)
+	return rhd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rhd *ReplicaHeartbeatDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rhd.sqlExec, rhd.mutation, rhd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhd *ReplicaHeartbeatDelete) ExecX(ctx context.Context) int {
+	n, err := rhd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rhd *ReplicaHeartbeatDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(replicaheartbeat.Table, sqlgraph.NewFieldSpec(replicaheartbeat.FieldID, field.TypeString))
+	if ps := rhd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rhd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rhd.mutation.done = true
+	return affected, err
+}
+
+// ReplicaHeartbeatDeleteOne is the builder for deleting a single ReplicaHeartbeat entity.
+type ReplicaHeartbeatDeleteOne struct {
+	rhd *ReplicaHeartbeatDelete
+}
+
+// Where appends a list predicates to the ReplicaHeartbeatDelete builder.
+func (rhdo *ReplicaHeartbeatDeleteOne) Where(ps ...predicate.ReplicaHeartbeat) *ReplicaHeartbeatDeleteOne {
+	rhdo.rhd.mutation.Where(ps...)
+	return rhdo
+}
+
+// Exec executes the deletion query.
+func (rhdo *ReplicaHeartbeatDeleteOne) Exec(ctx context.Context) error {
+	n, err := rhdo.rhd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{replicaheartbeat.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhdo *ReplicaHeartbeatDeleteOne) ExecX(ctx context.Context) {
+	if err := rhdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
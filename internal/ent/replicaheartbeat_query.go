@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+	"github.com/eroshiva/trade-show-poc/internal/ent/replicaheartbeat"
+)
+
+// ReplicaHeartbeatQuery is the builder for querying ReplicaHeartbeat entities.
+type ReplicaHeartbeatQuery struct {
+	config
+	ctx        *QueryContext
+	order      []replicaheartbeat.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ReplicaHeartbeat
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReplicaHeartbeatQuery builder.
+func (rhq *ReplicaHeartbeatQuery) Where(ps ...predicate.ReplicaHeartbeat) *ReplicaHeartbeatQuery {
+	rhq.predicates = append(rhq.predicates, ps...)
+	return rhq
+}
+
+// Limit the number of records to be returned by this query.
+func (rhq *ReplicaHeartbeatQuery) Limit(limit int) *ReplicaHeartbeatQuery {
+	rhq.ctx.Limit = &limit
+	return rhq
+}
+
+// Offset to start from.
+func (rhq *ReplicaHeartbeatQuery) Offset(offset int) *ReplicaHeartbeatQuery {
+	rhq.ctx.Offset = &offset
+	return rhq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rhq *ReplicaHeartbeatQuery) Unique(unique bool) *ReplicaHeartbeatQuery {
+	rhq.ctx.Unique = &unique
+	return rhq
+}
+
+// Order specifies how the records should be ordered.
+func (rhq *ReplicaHeartbeatQuery) Order(o ...replicaheartbeat.OrderOption) *ReplicaHeartbeatQuery {
+	rhq.order = append(rhq.order, o...)
+	return rhq
+}
+
+// First returns the first ReplicaHeartbeat entity from the query.
+// Returns a *NotFoundError when no ReplicaHeartbeat was found.
+func (rhq *ReplicaHeartbeatQuery) First(ctx context.Context) (*ReplicaHeartbeat, error) {
+	nodes, err := rhq.Limit(1).All(setContextOp(ctx, rhq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{replicaheartbeat.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rhq *ReplicaHeartbeatQuery) FirstX(ctx context.Context) *ReplicaHeartbeat {
+	node, err := rhq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReplicaHeartbeat ID from the query.
+// Returns a *NotFoundError when no ReplicaHeartbeat ID was found.
+func (rhq *ReplicaHeartbeatQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rhq.Limit(1).IDs(setContextOp(ctx, rhq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{replicaheartbeat.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rhq *ReplicaHeartbeatQuery) FirstIDX(ctx context.Context) string {
+	id, err := rhq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReplicaHeartbeat entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReplicaHeartbeat entity is found.
+// Returns a *NotFoundError when no ReplicaHeartbeat entities are found.
+func (rhq *ReplicaHeartbeatQuery) Only(ctx context.Context) (*ReplicaHeartbeat, error) {
+	nodes, err := rhq.Limit(2).All(setContextOp(ctx, rhq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{replicaheartbeat.Label}
+	default:
+		return nil, &NotSingularError{replicaheartbeat.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rhq *ReplicaHeartbeatQuery) OnlyX(ctx context.Context) *ReplicaHeartbeat {
+	node, err := rhq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReplicaHeartbeat ID in the query.
+// Returns a *NotSingularError when more than one ReplicaHeartbeat ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rhq *ReplicaHeartbeatQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rhq.Limit(2).IDs(setContextOp(ctx, rhq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{replicaheartbeat.Label}
+	default:
+		err = &NotSingularError{replicaheartbeat.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rhq *ReplicaHeartbeatQuery) OnlyIDX(ctx context.Context) string {
+	id, err := rhq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReplicaHeartbeats.
+func (rhq *ReplicaHeartbeatQuery) All(ctx context.Context) ([]*ReplicaHeartbeat, error) {
+	ctx = setContextOp(ctx, rhq.ctx, ent.OpQueryAll)
+	if err := rhq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReplicaHeartbeat, *ReplicaHeartbeatQuery]()
+	return withInterceptors[[]*ReplicaHeartbeat](ctx, rhq, qr, rhq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rhq *ReplicaHeartbeatQuery) AllX(ctx context.Context) []*ReplicaHeartbeat {
+	nodes, err := rhq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReplicaHeartbeat IDs.
+func (rhq *ReplicaHeartbeatQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if rhq.ctx.Unique == nil && rhq.path != nil {
+		rhq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rhq.ctx, ent.OpQueryIDs)
+	if err = rhq.Select(replicaheartbeat.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rhq *ReplicaHeartbeatQuery) IDsX(ctx context.Context) []string {
+	ids, err := rhq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rhq *ReplicaHeartbeatQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rhq.ctx, ent.OpQueryCount)
+	if err := rhq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rhq, querierCount[*ReplicaHeartbeatQuery](), rhq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rhq *ReplicaHeartbeatQuery) CountX(ctx context.Context) int {
+	count, err := rhq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rhq *ReplicaHeartbeatQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rhq.ctx, ent.OpQueryExist)
+	switch _, err := rhq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rhq *ReplicaHeartbeatQuery) ExistX(ctx context.Context) bool {
+	exist, err := rhq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReplicaHeartbeatQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rhq *ReplicaHeartbeatQuery) Clone() *ReplicaHeartbeatQuery {
+	if rhq == nil {
+		return nil
+	}
+	return &ReplicaHeartbeatQuery{
+		config:     rhq.config,
+		ctx:        rhq.ctx.Clone(),
+		order:      append([]replicaheartbeat.OrderOption{}, rhq.order...),
+		inters:     append([]Interceptor{}, rhq.inters...),
+		predicates: append([]predicate.ReplicaHeartbeat{}, rhq.predicates...),
+		// clone intermediate query.
+		sql:  rhq.sql.Clone(),
+		path: rhq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LastSeen int64 `json:"last_seen,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReplicaHeartbeat.Query().
+//		GroupBy(replicaheartbeat.FieldLastSeen).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rhq *ReplicaHeartbeatQuery) GroupBy(field string, fields ...string) *ReplicaHeartbeatGroupBy {
+	rhq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReplicaHeartbeatGroupBy{build: rhq}
+	grbuild.flds = &rhq.ctx.Fields
+	grbuild.label = replicaheartbeat.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		LastSeen int64 `json:"last_seen,omitempty"`
+//	}
+//
+//	client.ReplicaHeartbeat.Query().
+//		Select(replicaheartbeat.FieldLastSeen).
+//		Scan(ctx, &v)
+func (rhq *ReplicaHeartbeatQuery) Select(fields ...string) *ReplicaHeartbeatSelect {
+	rhq.ctx.Fields = append(rhq.ctx.Fields, fields...)
+	sbuild := &ReplicaHeartbeatSelect{ReplicaHeartbeatQuery: rhq}
+	sbuild.label = replicaheartbeat.Label
+	sbuild.flds, sbuild.scan = &rhq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReplicaHeartbeatSelect configured with the given aggregations.
+func (rhq *ReplicaHeartbeatQuery) Aggregate(fns ...AggregateFunc) *ReplicaHeartbeatSelect {
+	return rhq.Select().Aggregate(fns...)
+}
+
+func (rhq *ReplicaHeartbeatQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rhq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rhq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rhq.ctx.Fields {
+		if !replicaheartbeat.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rhq.path != nil {
+		prev, err := rhq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rhq.sql = prev
+	}
+	return nil
+}
+
+func (rhq *ReplicaHeartbeatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReplicaHeartbeat, error) {
+	var (
+		nodes = []*ReplicaHeartbeat{}
+		_spec = rhq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReplicaHeartbeat).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReplicaHeartbeat{config: rhq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rhq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rhq *ReplicaHeartbeatQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rhq.querySpec()
+	_spec.Node.Columns = rhq.ctx.Fields
+	if len(rhq.ctx.Fields) > 0 {
+		_spec.Unique = rhq.ctx.Unique != nil && *rhq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rhq.driver, _spec)
+}
+
+func (rhq *ReplicaHeartbeatQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(replicaheartbeat.Table, replicaheartbeat.Columns, sqlgraph.NewFieldSpec(replicaheartbeat.FieldID, field.TypeString))
+	_spec.From = rhq.sql
+	if unique := rhq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rhq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rhq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, replicaheartbeat.FieldID)
+		for i := range fields {
+			if fields[i] != replicaheartbeat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rhq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rhq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rhq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rhq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rhq *ReplicaHeartbeatQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rhq.driver.Dialect())
+	t1 := builder.Table(replicaheartbeat.Table)
+	columns := rhq.ctx.Fields
+	if len(columns) == 0 {
+		columns = replicaheartbeat.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rhq.sql != nil {
+		selector = rhq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rhq.ctx.Unique != nil && *rhq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rhq.predicates {
+		p(selector)
+	}
+	for _, p := range rhq.order {
+		p(selector)
+	}
+	if offset := rhq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rhq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ReplicaHeartbeatGroupBy is the group-by builder for ReplicaHeartbeat entities.
+type ReplicaHeartbeatGroupBy struct {
+	selector
+	build *ReplicaHeartbeatQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rhgb *ReplicaHeartbeatGroupBy) Aggregate(fns ...AggregateFunc) *ReplicaHeartbeatGroupBy {
+	rhgb.fns = append(rhgb.fns, fns...)
+	return rhgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rhgb *ReplicaHeartbeatGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rhgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rhgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReplicaHeartbeatQuery, *ReplicaHeartbeatGroupBy](ctx, rhgb.build, rhgb, rhgb.build.inters, v)
+}
+
+func (rhgb *ReplicaHeartbeatGroupBy) sqlScan(ctx context.Context, root *ReplicaHeartbeatQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rhgb.fns))
+	for _, fn := range rhgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rhgb.flds)+len(rhgb.fns))
+		for _, f := range *rhgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rhgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rhgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReplicaHeartbeatSelect is the builder for selecting fields of ReplicaHeartbeat entities.
+type ReplicaHeartbeatSelect struct {
+	*ReplicaHeartbeatQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rhs *ReplicaHeartbeatSelect) Aggregate(fns ...AggregateFunc) *ReplicaHeartbeatSelect {
+	rhs.fns = append(rhs.fns, fns...)
+	return rhs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rhs *ReplicaHeartbeatSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rhs.ctx, ent.OpQuerySelect)
+	if err := rhs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReplicaHeartbeatQuery, *ReplicaHeartbeatSelect](ctx, rhs.ReplicaHeartbeatQuery, rhs, rhs.inters, v)
+}
+
+func (rhs *ReplicaHeartbeatSelect) sqlScan(ctx context.Context, root *ReplicaHeartbeatQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rhs.fns))
+	for _, fn := range rhs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rhs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rhs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
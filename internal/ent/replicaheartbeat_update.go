@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+	"github.com/eroshiva/trade-show-poc/internal/ent/replicaheartbeat"
+)
+
+// ReplicaHeartbeatUpdate is the builder for updating ReplicaHeartbeat entities.
+type ReplicaHeartbeatUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReplicaHeartbeatMutation
+}
+
+// Where appends a list predicates to the ReplicaHeartbeatUpdate builder.
+func (rhu *ReplicaHeartbeatUpdate) Where(ps ...predicate.ReplicaHeartbeat) *ReplicaHeartbeatUpdate {
+	rhu.mutation.Where(ps...)
+	return rhu
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (rhu *ReplicaHeartbeatUpdate) SetLastSeen(i int64) *ReplicaHeartbeatUpdate {
+	rhu.mutation.ResetLastSeen()
+	rhu.mutation.SetLastSeen(i)
+	return rhu
+}
+
+// SetNillableLastSeen sets the "last_seen" field if the given value is not nil.
+func (rhu *ReplicaHeartbeatUpdate) SetNillableLastSeen(i *int64) *ReplicaHeartbeatUpdate {
+	if i != nil {
+		rhu.SetLastSeen(*i)
+	}
+	return rhu
+}
+
+// AddLastSeen adds i to the "last_seen" field.
+func (rhu *ReplicaHeartbeatUpdate) AddLastSeen(i int64) *ReplicaHeartbeatUpdate {
+	rhu.mutation.AddLastSeen(i)
+	return rhu
+}
+
+// Mutation returns the ReplicaHeartbeatMutation object of the builder.
+func (rhu *ReplicaHeartbeatUpdate) Mutation() *ReplicaHeartbeatMutation {
+	return rhu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rhu *ReplicaHeartbeatUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rhu.sqlSave, rhu.mutation, rhu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rhu *ReplicaHeartbeatUpdate) SaveX(ctx context.Context) int {
+	affected, err := rhu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rhu *ReplicaHeartbeatUpdate) Exec(ctx context.Context) error {
+	_, err := rhu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhu *ReplicaHeartbeatUpdate) ExecX(ctx context.Context) {
+	if err := rhu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rhu *ReplicaHeartbeatUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(replicaheartbeat.Table, replicaheartbeat.Columns, sqlgraph.NewFieldSpec(replicaheartbeat.FieldID, field.TypeString))
+	if ps := rhu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rhu.mutation.LastSeen(); ok {
+		_spec.SetField(replicaheartbeat.FieldLastSeen, field.TypeInt64, value)
+	}
+	if value, ok := rhu.mutation.AddedLastSeen(); ok {
+		_spec.AddField(replicaheartbeat.FieldLastSeen, field.TypeInt64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rhu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{replicaheartbeat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rhu.mutation.done = true
+	return n, nil
+}
+
+// ReplicaHeartbeatUpdateOne is the builder for updating a single ReplicaHeartbeat entity.
+type ReplicaHeartbeatUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReplicaHeartbeatMutation
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (rhuo *ReplicaHeartbeatUpdateOne) SetLastSeen(i int64) *ReplicaHeartbeatUpdateOne {
+	rhuo.mutation.ResetLastSeen()
+	rhuo.mutation.SetLastSeen(i)
+	return rhuo
+}
+
+// SetNillableLastSeen sets the "last_seen" field if the given value is not nil.
+func (rhuo *ReplicaHeartbeatUpdateOne) SetNillableLastSeen(i *int64) *ReplicaHeartbeatUpdateOne {
+	if i != nil {
+		rhuo.SetLastSeen(*i)
+	}
+	return rhuo
+}
+
+// AddLastSeen adds i to the "last_seen" field.
+func (rhuo *ReplicaHeartbeatUpdateOne) AddLastSeen(i int64) *ReplicaHeartbeatUpdateOne {
+	rhuo.mutation.AddLastSeen(i)
+	return rhuo
+}
+
+// Mutation returns the ReplicaHeartbeatMutation object of the builder.
+func (rhuo *ReplicaHeartbeatUpdateOne) Mutation() *ReplicaHeartbeatMutation {
+	return rhuo.mutation
+}
+
+// Where appends a list predicates to the ReplicaHeartbeatUpdate builder.
+func (rhuo *ReplicaHeartbeatUpdateOne) Where(ps ...predicate.ReplicaHeartbeat) *ReplicaHeartbeatUpdateOne {
+	rhuo.mutation.Where(ps...)
+	return rhuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rhuo *ReplicaHeartbeatUpdateOne) Select(field string, fields ...string) *ReplicaHeartbeatUpdateOne {
+	rhuo.fields = append([]string{field}, fields...)
+	return rhuo
+}
+
+// Save executes the query and returns the updated ReplicaHeartbeat entity.
+func (rhuo *ReplicaHeartbeatUpdateOne) Save(ctx context.Context) (*ReplicaHeartbeat, error) {
+	return withHooks(ctx, rhuo.sqlSave, rhuo.mutation, rhuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rhuo *ReplicaHeartbeatUpdateOne) SaveX(ctx context.Context) *ReplicaHeartbeat {
+	node, err := rhuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rhuo *ReplicaHeartbeatUpdateOne) Exec(ctx context.Context) error {
+	_, err := rhuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhuo *ReplicaHeartbeatUpdateOne) ExecX(ctx context.Context) {
+	if err := rhuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rhuo *ReplicaHeartbeatUpdateOne) sqlSave(ctx context.Context) (_node *ReplicaHeartbeat, err error) {
+	_spec := sqlgraph.NewUpdateSpec(replicaheartbeat.Table, replicaheartbeat.Columns, sqlgraph.NewFieldSpec(replicaheartbeat.FieldID, field.TypeString))
+	id, ok := rhuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReplicaHeartbeat.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rhuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, replicaheartbeat.FieldID)
+		for _, f := range fields {
+			if !replicaheartbeat.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != replicaheartbeat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rhuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rhuo.mutation.LastSeen(); ok {
+		_spec.SetField(replicaheartbeat.FieldLastSeen, field.TypeInt64, value)
+	}
+	if value, ok := rhuo.mutation.AddedLastSeen(); ok {
+		_spec.AddField(replicaheartbeat.FieldLastSeen, field.TypeInt64, value)
+	}
+	_node = &ReplicaHeartbeat{config: rhuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rhuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{replicaheartbeat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rhuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,23 @@
+// File updated by protoc-gen-ent.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+type CredentialProfile struct {
+	ent.Schema
+}
+
+func (CredentialProfile) Fields() []ent.Field {
+	return []ent.Field{field.String("id"), field.String("name").Unique(), field.String("username").Optional(), field.String("password").Optional().Sensitive(), field.String("snmp_community").Optional().Sensitive(), field.String("private_key").Optional().Sensitive(), field.String("token").Optional().Sensitive(), field.String("snmp_priv_passphrase").Optional().Sensitive(), field.String("tls_client_key").Optional().Sensitive(), field.String("snmp_auth_protocol").Optional(), field.String("snmp_priv_protocol").Optional(), field.String("tls_client_certificate").Optional(), field.String("ssh_known_hosts").Optional(), field.Bool("ssh_insecure_ignore_host_key").Optional()}
+}
+func (CredentialProfile) Edges() []ent.Edge {
+	return nil
+}
+func (CredentialProfile) Annotations() []schema.Annotation {
+	return nil
+}
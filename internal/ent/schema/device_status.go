@@ -14,7 +14,7 @@ type DeviceStatus struct {
 }
 
 func (DeviceStatus) Fields() []ent.Field {
-	return []ent.Field{field.String("id"), field.Enum("status").Values("STATUS_UNSPECIFIED", "STATUS_DEVICE_DOWN", "STATUS_DEVICE_UNHEALTHY", "STATUS_DEVICE_UP"), field.String("last_seen").Optional(), field.Int32("consequential_failed_connectivity_attempts")}
+	return []ent.Field{field.String("id"), field.Enum("status").Values("STATUS_UNSPECIFIED", "STATUS_DEVICE_DOWN", "STATUS_DEVICE_UNHEALTHY", "STATUS_DEVICE_UP", "STATUS_DEVICE_UNREACHABLE"), field.String("last_seen").Optional(), field.Int32("consequential_failed_connectivity_attempts"), field.Int64("next_poll").Optional(), field.Enum("pending_status").Optional().Values("STATUS_UNSPECIFIED", "STATUS_DEVICE_DOWN", "STATUS_DEVICE_UNHEALTHY", "STATUS_DEVICE_UP", "STATUS_DEVICE_UNREACHABLE"), field.Int32("pending_readings").Optional(), field.Bool("flapping").Optional(), field.Bool("in_maintenance").Optional(), field.String("answered_endpoint_id").Optional(), field.Enum("answered_protocol").Optional().Values("PROTOCOL_UNSPECIFIED", "PROTOCOL_SNMP", "PROTOCOL_NETCONF", "PROTOCOL_RESTCONF", "PROTOCOL_OPEN_V_SWITCH", "PROTOCOL_GNMI", "PROTOCOL_TCP_CONNECT", "PROTOCOL_ICMP", "PROTOCOL_CUSTOM"), field.String("last_error").Optional()}
 }
 func (DeviceStatus) Edges() []ent.Edge {
 	return []ent.Edge{edge.To("network_device", NetworkDevice.Type).Unique()}
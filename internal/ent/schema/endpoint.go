@@ -14,10 +14,10 @@ type Endpoint struct {
 }
 
 func (Endpoint) Fields() []ent.Field {
-	return []ent.Field{field.String("id"), field.String("host"), field.String("port"), field.Enum("protocol").Values("PROTOCOL_UNSPECIFIED", "PROTOCOL_SNMP", "PROTOCOL_NETCONF", "PROTOCOL_RESTCONF", "PROTOCOL_OPEN_V_SWITCH")}
+	return []ent.Field{field.String("id"), field.String("host"), field.String("port"), field.Enum("protocol").Values("PROTOCOL_UNSPECIFIED", "PROTOCOL_SNMP", "PROTOCOL_NETCONF", "PROTOCOL_RESTCONF", "PROTOCOL_OPEN_V_SWITCH", "PROTOCOL_GNMI", "PROTOCOL_TCP_CONNECT", "PROTOCOL_ICMP", "PROTOCOL_CUSTOM"), field.Bool("tls_enabled").Optional(), field.String("tls_ca_bundle").Optional(), field.String("tls_server_name").Optional(), field.Bool("tls_insecure_skip_verify").Optional(), field.Int32("preference").Optional(), field.String("protocol_name").Optional()}
 }
 func (Endpoint) Edges() []ent.Edge {
-	return []ent.Edge{edge.From("network_device", NetworkDevice.Type).Ref("endpoints").Unique()}
+	return []ent.Edge{edge.To("credential_profile", CredentialProfile.Type).Unique(), edge.To("health", EndpointHealth.Type).Unique(), edge.From("network_device", NetworkDevice.Type).Ref("endpoints").Unique()}
 }
 func (Endpoint) Annotations() []schema.Annotation {
 	return nil
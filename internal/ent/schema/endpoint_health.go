@@ -0,0 +1,23 @@
+// File updated by protoc-gen-ent.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+type EndpointHealth struct {
+	ent.Schema
+}
+
+func (EndpointHealth) Fields() []ent.Field {
+	return []ent.Field{field.String("id"), field.Int64("last_success").Optional(), field.Int64("last_failure").Optional(), field.String("last_error").Optional(), field.Int64("latency").Optional(), field.Int32("failure_count").Optional()}
+}
+func (EndpointHealth) Edges() []ent.Edge {
+	return nil
+}
+func (EndpointHealth) Annotations() []schema.Annotation {
+	return nil
+}
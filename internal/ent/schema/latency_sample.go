@@ -0,0 +1,24 @@
+// File updated by protoc-gen-ent.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type LatencySample struct {
+	ent.Schema
+}
+
+func (LatencySample) Fields() []ent.Field {
+	return []ent.Field{field.String("id"), field.Int64("timestamp"), field.Int64("latency")}
+}
+func (LatencySample) Edges() []ent.Edge {
+	return []ent.Edge{edge.To("network_device", NetworkDevice.Type).Unique(), edge.To("endpoint", Endpoint.Type).Unique()}
+}
+func (LatencySample) Annotations() []schema.Annotation {
+	return nil
+}
@@ -0,0 +1,23 @@
+// File updated by protoc-gen-ent.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+type Lease struct {
+	ent.Schema
+}
+
+func (Lease) Fields() []ent.Field {
+	return []ent.Field{field.String("id"), field.String("holder"), field.Int64("expires_at")}
+}
+func (Lease) Edges() []ent.Edge {
+	return nil
+}
+func (Lease) Annotations() []schema.Annotation {
+	return nil
+}
@@ -0,0 +1,24 @@
+// File updated by protoc-gen-ent.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type MaintenanceWindow struct {
+	ent.Schema
+}
+
+func (MaintenanceWindow) Fields() []ent.Field {
+	return []ent.Field{field.String("id"), field.String("name"), field.String("group").Optional(), field.String("site").Optional(), field.Int64("starts_at"), field.Int64("ends_at"), field.String("recurrence").Optional()}
+}
+func (MaintenanceWindow) Edges() []ent.Edge {
+	return []ent.Edge{edge.To("network_devices", NetworkDevice.Type)}
+}
+func (MaintenanceWindow) Annotations() []schema.Annotation {
+	return nil
+}
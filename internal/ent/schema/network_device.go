@@ -14,10 +14,10 @@ type NetworkDevice struct {
 }
 
 func (NetworkDevice) Fields() []ent.Field {
-	return []ent.Field{field.String("id"), field.Enum("vendor").Values("VENDOR_UNSPECIFIED", "VENDOR_UBIQUITI", "VENDOR_CISCO", "VENDOR_JUNIPER"), field.String("model"), field.String("hw_version").Optional()}
+	return []ent.Field{field.String("id"), field.Enum("vendor").Values("VENDOR_UNSPECIFIED", "VENDOR_UBIQUITI", "VENDOR_CISCO", "VENDOR_JUNIPER"), field.String("model"), field.String("hw_version").Optional(), field.Int32("poll_interval").Optional(), field.String("group").Optional(), field.String("site").Optional()}
 }
 func (NetworkDevice) Edges() []ent.Edge {
-	return []ent.Edge{edge.To("endpoints", Endpoint.Type), edge.To("sw_version", Version.Type).Unique(), edge.To("fw_version", Version.Type).Unique()}
+	return []ent.Edge{edge.To("endpoints", Endpoint.Type), edge.To("sw_version", Version.Type).Unique(), edge.To("fw_version", Version.Type).Unique(), edge.From("maintenance_windows", MaintenanceWindow.Type).Ref("network_devices"), edge.To("children", NetworkDevice.Type)}
 }
 func (NetworkDevice) Annotations() []schema.Annotation {
 	return nil
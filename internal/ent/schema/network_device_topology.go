@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TopologyMixin holds the upstream (parent) network device of the network device, i.e., the inverse of its children.
+// Parent is unique, so that the network device has at most one parent. It is not generated out of Protobuf, because
+// the API carries the topology as children of the network device only.
+type TopologyMixin struct {
+	mixin.Schema
+}
+
+// Edges of the TopologyMixin.
+func (TopologyMixin) Edges() []ent.Edge {
+	return []ent.Edge{edge.From("parent", NetworkDevice.Type).Ref("children").Unique()}
+}
+
+// Mixin of the NetworkDevice.
+func (NetworkDevice) Mixin() []ent.Mixin {
+	return []ent.Mixin{TopologyMixin{}}
+}
@@ -0,0 +1,23 @@
+// File updated by protoc-gen-ent.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+type PollingDefault struct {
+	ent.Schema
+}
+
+func (PollingDefault) Fields() []ent.Field {
+	return []ent.Field{field.String("id"), field.Enum("scope").Values("POLLING_SCOPE_UNSPECIFIED", "POLLING_SCOPE_GROUP", "POLLING_SCOPE_SITE"), field.String("name"), field.Int32("poll_interval")}
+}
+func (PollingDefault) Edges() []ent.Edge {
+	return nil
+}
+func (PollingDefault) Annotations() []schema.Annotation {
+	return nil
+}
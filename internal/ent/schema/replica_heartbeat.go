@@ -0,0 +1,23 @@
+// File updated by protoc-gen-ent.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+type ReplicaHeartbeat struct {
+	ent.Schema
+}
+
+func (ReplicaHeartbeat) Fields() []ent.Field {
+	return []ent.Field{field.String("id"), field.Int64("last_seen")}
+}
+func (ReplicaHeartbeat) Edges() []ent.Edge {
+	return nil
+}
+func (ReplicaHeartbeat) Annotations() []schema.Annotation {
+	return nil
+}
@@ -0,0 +1,24 @@
+// File updated by protoc-gen-ent.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type StatusEvent struct {
+	ent.Schema
+}
+
+func (StatusEvent) Fields() []ent.Field {
+	return []ent.Field{field.String("id"), field.Enum("old_status").Values("STATUS_UNSPECIFIED", "STATUS_DEVICE_DOWN", "STATUS_DEVICE_UNHEALTHY", "STATUS_DEVICE_UP", "STATUS_DEVICE_UNREACHABLE"), field.Enum("new_status").Values("STATUS_UNSPECIFIED", "STATUS_DEVICE_DOWN", "STATUS_DEVICE_UNHEALTHY", "STATUS_DEVICE_UP", "STATUS_DEVICE_UNREACHABLE"), field.Int64("timestamp"), field.String("reason")}
+}
+func (StatusEvent) Edges() []ent.Edge {
+	return []ent.Edge{edge.To("network_device", NetworkDevice.Type).Unique()}
+}
+func (StatusEvent) Annotations() []schema.Annotation {
+	return nil
+}
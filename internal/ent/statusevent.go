@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/statusevent"
+)
+
+// StatusEvent is the model entity for the StatusEvent schema.
+type StatusEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// OldStatus holds the value of the "old_status" field.
+	OldStatus statusevent.OldStatus `json:"old_status,omitempty"`
+	// NewStatus holds the value of the "new_status" field.
+	NewStatus statusevent.NewStatus `json:"new_status,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp int64 `json:"timestamp,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StatusEventQuery when eager-loading is set.
+	Edges                       StatusEventEdges `json:"edges"`
+	status_event_network_device *string
+	selectValues                sql.SelectValues
+}
+
+// StatusEventEdges holds the relations/edges for other nodes in the graph.
+type StatusEventEdges struct {
+	// NetworkDevice holds the value of the network_device edge.
+	NetworkDevice *NetworkDevice `json:"network_device,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// NetworkDeviceOrErr returns the NetworkDevice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StatusEventEdges) NetworkDeviceOrErr() (*NetworkDevice, error) {
+	if e.NetworkDevice != nil {
+		return e.NetworkDevice, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: networkdevice.Label}
+	}
+	return nil, &NotLoadedError{edge: "network_device"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StatusEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case statusevent.FieldTimestamp:
+			values[i] = new(sql.NullInt64)
+		case statusevent.FieldID, statusevent.FieldOldStatus, statusevent.FieldNewStatus, statusevent.FieldReason:
+			values[i] = new(sql.NullString)
+		case statusevent.ForeignKeys[0]: // status_event_network_device
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StatusEvent fields.
+func (se *StatusEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case statusevent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				se.ID = value.String
+			}
+		case statusevent.FieldOldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_status", values[i])
+			} else if value.Valid {
+				se.OldStatus = statusevent.OldStatus(value.String)
+			}
+		case statusevent.FieldNewStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_status", values[i])
+			} else if value.Valid {
+				se.NewStatus = statusevent.NewStatus(value.String)
+			}
+		case statusevent.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				se.Timestamp = value.Int64
+			}
+		case statusevent.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				se.Reason = value.String
+			}
+		case statusevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_event_network_device", values[i])
+			} else if value.Valid {
+				se.status_event_network_device = new(string)
+				*se.status_event_network_device = value.String
+			}
+		default:
+			se.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StatusEvent.
+// This includes values selected through modifiers, order, etc.
+func (se *StatusEvent) Value(name string) (ent.Value, error) {
+	return se.selectValues.Get(name)
+}
+
+// QueryNetworkDevice queries the "network_device" edge of the StatusEvent entity.
+func (se *StatusEvent) QueryNetworkDevice() *NetworkDeviceQuery {
+	return NewStatusEventClient(se.config).QueryNetworkDevice(se)
+}
+
+// Update returns a builder for updating this StatusEvent.
+// Note that you need to call StatusEvent.Unwrap() before calling this method if this StatusEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (se *StatusEvent) Update() *StatusEventUpdateOne {
+	return NewStatusEventClient(se.config).UpdateOne(se)
+}
+
+// Unwrap unwraps the StatusEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (se *StatusEvent) Unwrap() *StatusEvent {
+	_tx, ok := se.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StatusEvent is not a transactional entity")
+	}
+	se.config.driver = _tx.drv
+	return se
+}
+
+// String implements the fmt.Stringer.
+func (se *StatusEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("StatusEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", se.ID))
+	builder.WriteString("old_status=")
+	builder.WriteString(fmt.Sprintf("%v", se.OldStatus))
+	builder.WriteString(", ")
+	builder.WriteString("new_status=")
+	builder.WriteString(fmt.Sprintf("%v", se.NewStatus))
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(fmt.Sprintf("%v", se.Timestamp))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(se.Reason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StatusEvents is a parsable slice of StatusEvent.
+type StatusEvents []*StatusEvent
@@ -0,0 +1,160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package statusevent
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the statusevent type in the database.
+	Label = "status_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOldStatus holds the string denoting the old_status field in the database.
+	FieldOldStatus = "old_status"
+	// FieldNewStatus holds the string denoting the new_status field in the database.
+	FieldNewStatus = "new_status"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// EdgeNetworkDevice holds the string denoting the network_device edge name in mutations.
+	EdgeNetworkDevice = "network_device"
+	// Table holds the table name of the statusevent in the database.
+	Table = "status_events"
+	// NetworkDeviceTable is the table that holds the network_device relation/edge.
+	NetworkDeviceTable = "status_events"
+	// NetworkDeviceInverseTable is the table name for the NetworkDevice entity.
+	// It exists in this package in order to avoid circular dependency with the "networkdevice" package.
+	NetworkDeviceInverseTable = "network_devices"
+	// NetworkDeviceColumn is the table column denoting the network_device relation/edge.
+	NetworkDeviceColumn = "status_event_network_device"
+)
+
+// Columns holds all SQL columns for statusevent fields.
+var Columns = []string{
+	FieldID,
+	FieldOldStatus,
+	FieldNewStatus,
+	FieldTimestamp,
+	FieldReason,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "status_events"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"status_event_network_device",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OldStatus defines the type for the "old_status" enum field.
+type OldStatus string
+
+// OldStatus values.
+const (
+	OldStatusSTATUS_UNSPECIFIED        OldStatus = "STATUS_UNSPECIFIED"
+	OldStatusSTATUS_DEVICE_DOWN        OldStatus = "STATUS_DEVICE_DOWN"
+	OldStatusSTATUS_DEVICE_UNHEALTHY   OldStatus = "STATUS_DEVICE_UNHEALTHY"
+	OldStatusSTATUS_DEVICE_UP          OldStatus = "STATUS_DEVICE_UP"
+	OldStatusSTATUS_DEVICE_UNREACHABLE OldStatus = "STATUS_DEVICE_UNREACHABLE"
+)
+
+func (os OldStatus) String() string {
+	return string(os)
+}
+
+// OldStatusValidator is a validator for the "old_status" field enum values. It is called by the builders before save.
+func OldStatusValidator(os OldStatus) error {
+	switch os {
+	case OldStatusSTATUS_UNSPECIFIED, OldStatusSTATUS_DEVICE_DOWN, OldStatusSTATUS_DEVICE_UNHEALTHY, OldStatusSTATUS_DEVICE_UP, OldStatusSTATUS_DEVICE_UNREACHABLE:
+		return nil
+	default:
+		return fmt.Errorf("statusevent: invalid enum value for old_status field: %q", os)
+	}
+}
+
+// NewStatus defines the type for the "new_status" enum field.
+type NewStatus string
+
+// NewStatus values.
+const (
+	NewStatusSTATUS_UNSPECIFIED        NewStatus = "STATUS_UNSPECIFIED"
+	NewStatusSTATUS_DEVICE_DOWN        NewStatus = "STATUS_DEVICE_DOWN"
+	NewStatusSTATUS_DEVICE_UNHEALTHY   NewStatus = "STATUS_DEVICE_UNHEALTHY"
+	NewStatusSTATUS_DEVICE_UP          NewStatus = "STATUS_DEVICE_UP"
+	NewStatusSTATUS_DEVICE_UNREACHABLE NewStatus = "STATUS_DEVICE_UNREACHABLE"
+)
+
+func (ns NewStatus) String() string {
+	return string(ns)
+}
+
+// NewStatusValidator is a validator for the "new_status" field enum values. It is called by the builders before save.
+func NewStatusValidator(ns NewStatus) error {
+	switch ns {
+	case NewStatusSTATUS_UNSPECIFIED, NewStatusSTATUS_DEVICE_DOWN, NewStatusSTATUS_DEVICE_UNHEALTHY, NewStatusSTATUS_DEVICE_UP, NewStatusSTATUS_DEVICE_UNREACHABLE:
+		return nil
+	default:
+		return fmt.Errorf("statusevent: invalid enum value for new_status field: %q", ns)
+	}
+}
+
+// OrderOption defines the ordering options for the StatusEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOldStatus orders the results by the old_status field.
+func ByOldStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldStatus, opts...).ToFunc()
+}
+
+// ByNewStatus orders the results by the new_status field.
+func ByNewStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewStatus, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByNetworkDeviceField orders the results by network_device field.
+func ByNetworkDeviceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNetworkDeviceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newNetworkDeviceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NetworkDeviceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, NetworkDeviceTable, NetworkDeviceColumn),
+	)
+}
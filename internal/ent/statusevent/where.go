@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package statusevent
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldContainsFold(FieldID, id))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v int64) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldEQ(FieldReason, v))
+}
+
+// OldStatusEQ applies the EQ predicate on the "old_status" field.
+func OldStatusEQ(v OldStatus) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldEQ(FieldOldStatus, v))
+}
+
+// OldStatusNEQ applies the NEQ predicate on the "old_status" field.
+func OldStatusNEQ(v OldStatus) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldNEQ(FieldOldStatus, v))
+}
+
+// OldStatusIn applies the In predicate on the "old_status" field.
+func OldStatusIn(vs ...OldStatus) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldIn(FieldOldStatus, vs...))
+}
+
+// OldStatusNotIn applies the NotIn predicate on the "old_status" field.
+func OldStatusNotIn(vs ...OldStatus) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldNotIn(FieldOldStatus, vs...))
+}
+
+// NewStatusEQ applies the EQ predicate on the "new_status" field.
+func NewStatusEQ(v NewStatus) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldEQ(FieldNewStatus, v))
+}
+
+// NewStatusNEQ applies the NEQ predicate on the "new_status" field.
+func NewStatusNEQ(v NewStatus) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldNEQ(FieldNewStatus, v))
+}
+
+// NewStatusIn applies the In predicate on the "new_status" field.
+func NewStatusIn(vs ...NewStatus) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldIn(FieldNewStatus, vs...))
+}
+
+// NewStatusNotIn applies the NotIn predicate on the "new_status" field.
+func NewStatusNotIn(vs ...NewStatus) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldNotIn(FieldNewStatus, vs...))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v int64) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v int64) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...int64) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...int64) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v int64) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v int64) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v int64) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v int64) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.FieldContainsFold(FieldReason, v))
+}
+
+// HasNetworkDevice applies the HasEdge predicate on the "network_device" edge.
+func HasNetworkDevice() predicate.StatusEvent {
+	return predicate.StatusEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, NetworkDeviceTable, NetworkDeviceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNetworkDeviceWith applies the HasEdge predicate on the "network_device" edge with a given conditions (other predicates).
+func HasNetworkDeviceWith(preds ...predicate.NetworkDevice) predicate.StatusEvent {
+	return predicate.StatusEvent(func(s *sql.Selector) {
+		step := newNetworkDeviceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StatusEvent) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StatusEvent) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StatusEvent) predicate.StatusEvent {
+	return predicate.StatusEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/statusevent"
+)
+
+// StatusEventCreate is the builder for creating a StatusEvent entity.
+type StatusEventCreate struct {
+	config
+	mutation *StatusEventMutation
+	hooks    []Hook
+}
+
+// SetOldStatus sets the "old_status" field.
+func (sec *StatusEventCreate) SetOldStatus(ss statusevent.OldStatus) *StatusEventCreate {
+	sec.mutation.SetOldStatus(ss)
+	return sec
+}
+
+// SetNewStatus sets the "new_status" field.
+func (sec *StatusEventCreate) SetNewStatus(ss statusevent.NewStatus) *StatusEventCreate {
+	sec.mutation.SetNewStatus(ss)
+	return sec
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (sec *StatusEventCreate) SetTimestamp(i int64) *StatusEventCreate {
+	sec.mutation.SetTimestamp(i)
+	return sec
+}
+
+// SetReason sets the "reason" field.
+func (sec *StatusEventCreate) SetReason(s string) *StatusEventCreate {
+	sec.mutation.SetReason(s)
+	return sec
+}
+
+// SetID sets the "id" field.
+func (sec *StatusEventCreate) SetID(s string) *StatusEventCreate {
+	sec.mutation.SetID(s)
+	return sec
+}
+
+// SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID.
+func (sec *StatusEventCreate) SetNetworkDeviceID(id string) *StatusEventCreate {
+	sec.mutation.SetNetworkDeviceID(id)
+	return sec
+}
+
+// SetNillableNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID if the given value is not nil.
+func (sec *StatusEventCreate) SetNillableNetworkDeviceID(id *string) *StatusEventCreate {
+	if id != nil {
+		sec = sec.SetNetworkDeviceID(*id)
+	}
+	return sec
+}
+
+// SetNetworkDevice sets the "network_device" edge to the NetworkDevice entity.
+func (sec *StatusEventCreate) SetNetworkDevice(n *NetworkDevice) *StatusEventCreate {
+	return sec.SetNetworkDeviceID(n.ID)
+}
+
+// Mutation returns the StatusEventMutation object of the builder.
+func (sec *StatusEventCreate) Mutation() *StatusEventMutation {
+	return sec.mutation
+}
+
+// Save creates the StatusEvent in the database.
+func (sec *StatusEventCreate) Save(ctx context.Context) (*StatusEvent, error) {
+	return withHooks(ctx, sec.sqlSave, sec.mutation, sec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sec *StatusEventCreate) SaveX(ctx context.Context) *StatusEvent {
+	v, err := sec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sec *StatusEventCreate) Exec(ctx context.Context) error {
+	_, err := sec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sec *StatusEventCreate) ExecX(ctx context.Context) {
+	if err := sec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sec *StatusEventCreate) check() error {
+	if _, ok := sec.mutation.OldStatus(); !ok {
+		return &ValidationError{Name: "old_status", err: errors.New(`ent: missing required field "StatusEvent.old_status"`)}
+	}
+	if v, ok := sec.mutation.OldStatus(); ok {
+		if err := statusevent.OldStatusValidator(v); err != nil {
+			return &ValidationError{Name: "old_status", err: fmt.Errorf(`ent: validator failed for field "StatusEvent.old_status": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.NewStatus(); !ok {
+		return &ValidationError{Name: "new_status", err: errors.New(`ent: missing required field "StatusEvent.new_status"`)}
+	}
+	if v, ok := sec.mutation.NewStatus(); ok {
+		if err := statusevent.NewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "new_status", err: fmt.Errorf(`ent: validator failed for field "StatusEvent.new_status": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "StatusEvent.timestamp"`)}
+	}
+	if _, ok := sec.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "StatusEvent.reason"`)}
+	}
+	return nil
+}
+
+func (sec *StatusEventCreate) sqlSave(ctx context.Context) (*StatusEvent, error) {
+	if err := sec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected StatusEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sec.mutation.id = &_node.ID
+	sec.mutation.done = true
+	return _node, nil
+}
+
+func (sec *StatusEventCreate) createSpec() (*StatusEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StatusEvent{config: sec.config}
+		_spec = sqlgraph.NewCreateSpec(statusevent.Table, sqlgraph.NewFieldSpec(statusevent.FieldID, field.TypeString))
+	)
+	if id, ok := sec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sec.mutation.OldStatus(); ok {
+		_spec.SetField(statusevent.FieldOldStatus, field.TypeEnum, value)
+		_node.OldStatus = value
+	}
+	if value, ok := sec.mutation.NewStatus(); ok {
+		_spec.SetField(statusevent.FieldNewStatus, field.TypeEnum, value)
+		_node.NewStatus = value
+	}
+	if value, ok := sec.mutation.Timestamp(); ok {
+		_spec.SetField(statusevent.FieldTimestamp, field.TypeInt64, value)
+		_node.Timestamp = value
+	}
+	if value, ok := sec.mutation.Reason(); ok {
+		_spec.SetField(statusevent.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if nodes := sec.mutation.NetworkDeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statusevent.NetworkDeviceTable,
+			Columns: []string{statusevent.NetworkDeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.status_event_network_device = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StatusEventCreateBulk is the builder for creating many StatusEvent entities in bulk.
+type StatusEventCreateBulk struct {
+	config
+	err      error
+	builders []*StatusEventCreate
+}
+
+// Save creates the StatusEvent entities in the database.
+func (secb *StatusEventCreateBulk) Save(ctx context.Context) ([]*StatusEvent, error) {
+	if secb.err != nil {
+		return nil, secb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(secb.builders))
+	nodes := make([]*StatusEvent, len(secb.builders))
+	mutators := make([]Mutator, len(secb.builders))
+	for i := range secb.builders {
+		func(i int, root context.Context) {
+			builder := secb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StatusEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, secb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, secb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, secb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (secb *StatusEventCreateBulk) SaveX(ctx context.Context) []*StatusEvent {
+	v, err := secb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (secb *StatusEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := secb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (secb *StatusEventCreateBulk) ExecX(ctx context.Context) {
+	if err := secb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
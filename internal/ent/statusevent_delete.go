@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+	"github.com/eroshiva/trade-show-poc/internal/ent/statusevent"
+)
+
+// StatusEventDelete is the builder for deleting a StatusEvent entity.
+type StatusEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *StatusEventMutation
+}
+
+// Where appends a list predicates to the StatusEventDelete builder.
+func (sed *StatusEventDelete) Where(ps ...predicate.StatusEvent) *StatusEventDelete {
+	sed.mutation.Where(ps...)
+	return sed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sed *StatusEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sed.sqlExec, sed.mutation, sed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sed *StatusEventDelete) ExecX(ctx context.Context) int {
+	n, err := sed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sed *StatusEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(statusevent.Table, sqlgraph.NewFieldSpec(statusevent.FieldID, field.TypeString))
+	if ps := sed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sed.mutation.done = true
+	return affected, err
+}
+
+// StatusEventDeleteOne is the builder for deleting a single StatusEvent entity.
+type StatusEventDeleteOne struct {
+	sed *StatusEventDelete
+}
+
+// Where appends a list predicates to the StatusEventDelete builder.
+func (sedo *StatusEventDeleteOne) Where(ps ...predicate.StatusEvent) *StatusEventDeleteOne {
+	sedo.sed.mutation.Where(ps...)
+	return sedo
+}
+
+// Exec executes the deletion query.
+func (sedo *StatusEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := sedo.sed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{statusevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sedo *StatusEventDeleteOne) ExecX(ctx context.Context) {
+	if err := sedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+	"github.com/eroshiva/trade-show-poc/internal/ent/statusevent"
+)
+
+// StatusEventQuery is the builder for querying StatusEvent entities.
+type StatusEventQuery struct {
+	config
+	ctx               *QueryContext
+	order             []statusevent.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.StatusEvent
+	withNetworkDevice *NetworkDeviceQuery
+	withFKs           bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StatusEventQuery builder.
+func (seq *StatusEventQuery) Where(ps ...predicate.StatusEvent) *StatusEventQuery {
+	seq.predicates = append(seq.predicates, ps...)
+	return seq
+}
+
+// Limit the number of records to be returned by this query.
+func (seq *StatusEventQuery) Limit(limit int) *StatusEventQuery {
+	seq.ctx.Limit = &limit
+	return seq
+}
+
+// Offset to start from.
+func (seq *StatusEventQuery) Offset(offset int) *StatusEventQuery {
+	seq.ctx.Offset = &offset
+	return seq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (seq *StatusEventQuery) Unique(unique bool) *StatusEventQuery {
+	seq.ctx.Unique = &unique
+	return seq
+}
+
+// Order specifies how the records should be ordered.
+func (seq *StatusEventQuery) Order(o ...statusevent.OrderOption) *StatusEventQuery {
+	seq.order = append(seq.order, o...)
+	return seq
+}
+
+// QueryNetworkDevice chains the current query on the "network_device" edge.
+func (seq *StatusEventQuery) QueryNetworkDevice() *NetworkDeviceQuery {
+	query := (&NetworkDeviceClient{config: seq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := seq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := seq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(statusevent.Table, statusevent.FieldID, selector),
+			sqlgraph.To(networkdevice.Table, networkdevice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, statusevent.NetworkDeviceTable, statusevent.NetworkDeviceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(seq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first StatusEvent entity from the query.
+// Returns a *NotFoundError when no StatusEvent was found.
+func (seq *StatusEventQuery) First(ctx context.Context) (*StatusEvent, error) {
+	nodes, err := seq.Limit(1).All(setContextOp(ctx, seq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{statusevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (seq *StatusEventQuery) FirstX(ctx context.Context) *StatusEvent {
+	node, err := seq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StatusEvent ID from the query.
+// Returns a *NotFoundError when no StatusEvent ID was found.
+func (seq *StatusEventQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = seq.Limit(1).IDs(setContextOp(ctx, seq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{statusevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (seq *StatusEventQuery) FirstIDX(ctx context.Context) string {
+	id, err := seq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StatusEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StatusEvent entity is found.
+// Returns a *NotFoundError when no StatusEvent entities are found.
+func (seq *StatusEventQuery) Only(ctx context.Context) (*StatusEvent, error) {
+	nodes, err := seq.Limit(2).All(setContextOp(ctx, seq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{statusevent.Label}
+	default:
+		return nil, &NotSingularError{statusevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (seq *StatusEventQuery) OnlyX(ctx context.Context) *StatusEvent {
+	node, err := seq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StatusEvent ID in the query.
+// Returns a *NotSingularError when more than one StatusEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (seq *StatusEventQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = seq.Limit(2).IDs(setContextOp(ctx, seq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{statusevent.Label}
+	default:
+		err = &NotSingularError{statusevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (seq *StatusEventQuery) OnlyIDX(ctx context.Context) string {
+	id, err := seq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StatusEvents.
+func (seq *StatusEventQuery) All(ctx context.Context) ([]*StatusEvent, error) {
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryAll)
+	if err := seq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*StatusEvent, *StatusEventQuery]()
+	return withInterceptors[[]*StatusEvent](ctx, seq, qr, seq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (seq *StatusEventQuery) AllX(ctx context.Context) []*StatusEvent {
+	nodes, err := seq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StatusEvent IDs.
+func (seq *StatusEventQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if seq.ctx.Unique == nil && seq.path != nil {
+		seq.Unique(true)
+	}
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryIDs)
+	if err = seq.Select(statusevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (seq *StatusEventQuery) IDsX(ctx context.Context) []string {
+	ids, err := seq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (seq *StatusEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryCount)
+	if err := seq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, seq, querierCount[*StatusEventQuery](), seq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (seq *StatusEventQuery) CountX(ctx context.Context) int {
+	count, err := seq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (seq *StatusEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryExist)
+	switch _, err := seq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (seq *StatusEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := seq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StatusEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (seq *StatusEventQuery) Clone() *StatusEventQuery {
+	if seq == nil {
+		return nil
+	}
+	return &StatusEventQuery{
+		config:            seq.config,
+		ctx:               seq.ctx.Clone(),
+		order:             append([]statusevent.OrderOption{}, seq.order...),
+		inters:            append([]Interceptor{}, seq.inters...),
+		predicates:        append([]predicate.StatusEvent{}, seq.predicates...),
+		withNetworkDevice: seq.withNetworkDevice.Clone(),
+		// clone intermediate query.
+		sql:  seq.sql.Clone(),
+		path: seq.path,
+	}
+}
+
+// WithNetworkDevice tells the query-builder to eager-load the nodes that are connected to
+// the "network_device" edge. The optional arguments are used to configure the query builder of the edge.
+func (seq *StatusEventQuery) WithNetworkDevice(opts ...func(*NetworkDeviceQuery)) *StatusEventQuery {
+	query := (&NetworkDeviceClient{config: seq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	seq.withNetworkDevice = query
+	return seq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OldStatus statusevent.OldStatus `json:"old_status,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StatusEvent.Query().
+//		GroupBy(statusevent.FieldOldStatus).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (seq *StatusEventQuery) GroupBy(field string, fields ...string) *StatusEventGroupBy {
+	seq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &StatusEventGroupBy{build: seq}
+	grbuild.flds = &seq.ctx.Fields
+	grbuild.label = statusevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OldStatus statusevent.OldStatus `json:"old_status,omitempty"`
+//	}
+//
+//	client.StatusEvent.Query().
+//		Select(statusevent.FieldOldStatus).
+//		Scan(ctx, &v)
+func (seq *StatusEventQuery) Select(fields ...string) *StatusEventSelect {
+	seq.ctx.Fields = append(seq.ctx.Fields, fields...)
+	sbuild := &StatusEventSelect{StatusEventQuery: seq}
+	sbuild.label = statusevent.Label
+	sbuild.flds, sbuild.scan = &seq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a StatusEventSelect configured with the given aggregations.
+func (seq *StatusEventQuery) Aggregate(fns ...AggregateFunc) *StatusEventSelect {
+	return seq.Select().Aggregate(fns...)
+}
+
+func (seq *StatusEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range seq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, seq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range seq.ctx.Fields {
+		if !statusevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if seq.path != nil {
+		prev, err := seq.path(ctx)
+		if err != nil {
+			return err
+		}
+		seq.sql = prev
+	}
+	return nil
+}
+
+func (seq *StatusEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StatusEvent, error) {
+	var (
+		nodes       = []*StatusEvent{}
+		withFKs     = seq.withFKs
+		_spec       = seq.querySpec()
+		loadedTypes = [1]bool{
+			seq.withNetworkDevice != nil,
+		}
+	)
+	if seq.withNetworkDevice != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, statusevent.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*StatusEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &StatusEvent{config: seq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, seq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := seq.withNetworkDevice; query != nil {
+		if err := seq.loadNetworkDevice(ctx, query, nodes, nil,
+			func(n *StatusEvent, e *NetworkDevice) { n.Edges.NetworkDevice = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (seq *StatusEventQuery) loadNetworkDevice(ctx context.Context, query *NetworkDeviceQuery, nodes []*StatusEvent, init func(*StatusEvent), assign func(*StatusEvent, *NetworkDevice)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*StatusEvent)
+	for i := range nodes {
+		if nodes[i].status_event_network_device == nil {
+			continue
+		}
+		fk := *nodes[i].status_event_network_device
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(networkdevice.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "status_event_network_device" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (seq *StatusEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := seq.querySpec()
+	_spec.Node.Columns = seq.ctx.Fields
+	if len(seq.ctx.Fields) > 0 {
+		_spec.Unique = seq.ctx.Unique != nil && *seq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, seq.driver, _spec)
+}
+
+func (seq *StatusEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(statusevent.Table, statusevent.Columns, sqlgraph.NewFieldSpec(statusevent.FieldID, field.TypeString))
+	_spec.From = seq.sql
+	if unique := seq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if seq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := seq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, statusevent.FieldID)
+		for i := range fields {
+			if fields[i] != statusevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := seq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := seq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := seq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := seq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (seq *StatusEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(seq.driver.Dialect())
+	t1 := builder.Table(statusevent.Table)
+	columns := seq.ctx.Fields
+	if len(columns) == 0 {
+		columns = statusevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if seq.sql != nil {
+		selector = seq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if seq.ctx.Unique != nil && *seq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range seq.predicates {
+		p(selector)
+	}
+	for _, p := range seq.order {
+		p(selector)
+	}
+	if offset := seq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := seq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// StatusEventGroupBy is the group-by builder for StatusEvent entities.
+type StatusEventGroupBy struct {
+	selector
+	build *StatusEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (segb *StatusEventGroupBy) Aggregate(fns ...AggregateFunc) *StatusEventGroupBy {
+	segb.fns = append(segb.fns, fns...)
+	return segb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (segb *StatusEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, segb.build.ctx, ent.OpQueryGroupBy)
+	if err := segb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StatusEventQuery, *StatusEventGroupBy](ctx, segb.build, segb, segb.build.inters, v)
+}
+
+func (segb *StatusEventGroupBy) sqlScan(ctx context.Context, root *StatusEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(segb.fns))
+	for _, fn := range segb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*segb.flds)+len(segb.fns))
+		for _, f := range *segb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*segb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := segb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// StatusEventSelect is the builder for selecting fields of StatusEvent entities.
+type StatusEventSelect struct {
+	*StatusEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ses *StatusEventSelect) Aggregate(fns ...AggregateFunc) *StatusEventSelect {
+	ses.fns = append(ses.fns, fns...)
+	return ses
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ses *StatusEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ses.ctx, ent.OpQuerySelect)
+	if err := ses.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StatusEventQuery, *StatusEventSelect](ctx, ses.StatusEventQuery, ses, ses.inters, v)
+}
+
+func (ses *StatusEventSelect) sqlScan(ctx context.Context, root *StatusEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ses.fns))
+	for _, fn := range ses.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ses.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ses.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
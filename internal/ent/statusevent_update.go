@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/predicate"
+	"github.com/eroshiva/trade-show-poc/internal/ent/statusevent"
+)
+
+// StatusEventUpdate is the builder for updating StatusEvent entities.
+type StatusEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StatusEventMutation
+}
+
+// Where appends a list predicates to the StatusEventUpdate builder.
+func (seu *StatusEventUpdate) Where(ps ...predicate.StatusEvent) *StatusEventUpdate {
+	seu.mutation.Where(ps...)
+	return seu
+}
+
+// SetOldStatus sets the "old_status" field.
+func (seu *StatusEventUpdate) SetOldStatus(ss statusevent.OldStatus) *StatusEventUpdate {
+	seu.mutation.SetOldStatus(ss)
+	return seu
+}
+
+// SetNillableOldStatus sets the "old_status" field if the given value is not nil.
+func (seu *StatusEventUpdate) SetNillableOldStatus(ss *statusevent.OldStatus) *StatusEventUpdate {
+	if ss != nil {
+		seu.SetOldStatus(*ss)
+	}
+	return seu
+}
+
+// SetNewStatus sets the "new_status" field.
+func (seu *StatusEventUpdate) SetNewStatus(ss statusevent.NewStatus) *StatusEventUpdate {
+	seu.mutation.SetNewStatus(ss)
+	return seu
+}
+
+// SetNillableNewStatus sets the "new_status" field if the given value is not nil.
+func (seu *StatusEventUpdate) SetNillableNewStatus(ss *statusevent.NewStatus) *StatusEventUpdate {
+	if ss != nil {
+		seu.SetNewStatus(*ss)
+	}
+	return seu
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (seu *StatusEventUpdate) SetTimestamp(i int64) *StatusEventUpdate {
+	seu.mutation.ResetTimestamp()
+	seu.mutation.SetTimestamp(i)
+	return seu
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (seu *StatusEventUpdate) SetNillableTimestamp(i *int64) *StatusEventUpdate {
+	if i != nil {
+		seu.SetTimestamp(*i)
+	}
+	return seu
+}
+
+// AddTimestamp adds i to the "timestamp" field.
+func (seu *StatusEventUpdate) AddTimestamp(i int64) *StatusEventUpdate {
+	seu.mutation.AddTimestamp(i)
+	return seu
+}
+
+// SetReason sets the "reason" field.
+func (seu *StatusEventUpdate) SetReason(s string) *StatusEventUpdate {
+	seu.mutation.SetReason(s)
+	return seu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (seu *StatusEventUpdate) SetNillableReason(s *string) *StatusEventUpdate {
+	if s != nil {
+		seu.SetReason(*s)
+	}
+	return seu
+}
+
+// SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID.
+func (seu *StatusEventUpdate) SetNetworkDeviceID(id string) *StatusEventUpdate {
+	seu.mutation.SetNetworkDeviceID(id)
+	return seu
+}
+
+// SetNillableNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID if the given value is not nil.
+func (seu *StatusEventUpdate) SetNillableNetworkDeviceID(id *string) *StatusEventUpdate {
+	if id != nil {
+		seu = seu.SetNetworkDeviceID(*id)
+	}
+	return seu
+}
+
+// SetNetworkDevice sets the "network_device" edge to the NetworkDevice entity.
+func (seu *StatusEventUpdate) SetNetworkDevice(n *NetworkDevice) *StatusEventUpdate {
+	return seu.SetNetworkDeviceID(n.ID)
+}
+
+// Mutation returns the StatusEventMutation object of the builder.
+func (seu *StatusEventUpdate) Mutation() *StatusEventMutation {
+	return seu.mutation
+}
+
+// ClearNetworkDevice clears the "network_device" edge to the NetworkDevice entity.
+func (seu *StatusEventUpdate) ClearNetworkDevice() *StatusEventUpdate {
+	seu.mutation.ClearNetworkDevice()
+	return seu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (seu *StatusEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, seu.sqlSave, seu.mutation, seu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seu *StatusEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := seu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (seu *StatusEventUpdate) Exec(ctx context.Context) error {
+	_, err := seu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seu *StatusEventUpdate) ExecX(ctx context.Context) {
+	if err := seu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seu *StatusEventUpdate) check() error {
+	if v, ok := seu.mutation.OldStatus(); ok {
+		if err := statusevent.OldStatusValidator(v); err != nil {
+			return &ValidationError{Name: "old_status", err: fmt.Errorf(`ent: validator failed for field "StatusEvent.old_status": %w`, err)}
+		}
+	}
+	if v, ok := seu.mutation.NewStatus(); ok {
+		if err := statusevent.NewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "new_status", err: fmt.Errorf(`ent: validator failed for field "StatusEvent.new_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (seu *StatusEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := seu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(statusevent.Table, statusevent.Columns, sqlgraph.NewFieldSpec(statusevent.FieldID, field.TypeString))
+	if ps := seu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := seu.mutation.OldStatus(); ok {
+		_spec.SetField(statusevent.FieldOldStatus, field.TypeEnum, value)
+	}
+	if value, ok := seu.mutation.NewStatus(); ok {
+		_spec.SetField(statusevent.FieldNewStatus, field.TypeEnum, value)
+	}
+	if value, ok := seu.mutation.Timestamp(); ok {
+		_spec.SetField(statusevent.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := seu.mutation.AddedTimestamp(); ok {
+		_spec.AddField(statusevent.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := seu.mutation.Reason(); ok {
+		_spec.SetField(statusevent.FieldReason, field.TypeString, value)
+	}
+	if seu.mutation.NetworkDeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statusevent.NetworkDeviceTable,
+			Columns: []string{statusevent.NetworkDeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seu.mutation.NetworkDeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statusevent.NetworkDeviceTable,
+			Columns: []string{statusevent.NetworkDeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, seu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statusevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	seu.mutation.done = true
+	return n, nil
+}
+
+// StatusEventUpdateOne is the builder for updating a single StatusEvent entity.
+type StatusEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StatusEventMutation
+}
+
+// SetOldStatus sets the "old_status" field.
+func (seuo *StatusEventUpdateOne) SetOldStatus(ss statusevent.OldStatus) *StatusEventUpdateOne {
+	seuo.mutation.SetOldStatus(ss)
+	return seuo
+}
+
+// SetNillableOldStatus sets the "old_status" field if the given value is not nil.
+func (seuo *StatusEventUpdateOne) SetNillableOldStatus(ss *statusevent.OldStatus) *StatusEventUpdateOne {
+	if ss != nil {
+		seuo.SetOldStatus(*ss)
+	}
+	return seuo
+}
+
+// SetNewStatus sets the "new_status" field.
+func (seuo *StatusEventUpdateOne) SetNewStatus(ss statusevent.NewStatus) *StatusEventUpdateOne {
+	seuo.mutation.SetNewStatus(ss)
+	return seuo
+}
+
+// SetNillableNewStatus sets the "new_status" field if the given value is not nil.
+func (seuo *StatusEventUpdateOne) SetNillableNewStatus(ss *statusevent.NewStatus) *StatusEventUpdateOne {
+	if ss != nil {
+		seuo.SetNewStatus(*ss)
+	}
+	return seuo
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (seuo *StatusEventUpdateOne) SetTimestamp(i int64) *StatusEventUpdateOne {
+	seuo.mutation.ResetTimestamp()
+	seuo.mutation.SetTimestamp(i)
+	return seuo
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (seuo *StatusEventUpdateOne) SetNillableTimestamp(i *int64) *StatusEventUpdateOne {
+	if i != nil {
+		seuo.SetTimestamp(*i)
+	}
+	return seuo
+}
+
+// AddTimestamp adds i to the "timestamp" field.
+func (seuo *StatusEventUpdateOne) AddTimestamp(i int64) *StatusEventUpdateOne {
+	seuo.mutation.AddTimestamp(i)
+	return seuo
+}
+
+// SetReason sets the "reason" field.
+func (seuo *StatusEventUpdateOne) SetReason(s string) *StatusEventUpdateOne {
+	seuo.mutation.SetReason(s)
+	return seuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (seuo *StatusEventUpdateOne) SetNillableReason(s *string) *StatusEventUpdateOne {
+	if s != nil {
+		seuo.SetReason(*s)
+	}
+	return seuo
+}
+
+// SetNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID.
+func (seuo *StatusEventUpdateOne) SetNetworkDeviceID(id string) *StatusEventUpdateOne {
+	seuo.mutation.SetNetworkDeviceID(id)
+	return seuo
+}
+
+// SetNillableNetworkDeviceID sets the "network_device" edge to the NetworkDevice entity by ID if the given value is not nil.
+func (seuo *StatusEventUpdateOne) SetNillableNetworkDeviceID(id *string) *StatusEventUpdateOne {
+	if id != nil {
+		seuo = seuo.SetNetworkDeviceID(*id)
+	}
+	return seuo
+}
+
+// SetNetworkDevice sets the "network_device" edge to the NetworkDevice entity.
+func (seuo *StatusEventUpdateOne) SetNetworkDevice(n *NetworkDevice) *StatusEventUpdateOne {
+	return seuo.SetNetworkDeviceID(n.ID)
+}
+
+// Mutation returns the StatusEventMutation object of the builder.
+func (seuo *StatusEventUpdateOne) Mutation() *StatusEventMutation {
+	return seuo.mutation
+}
+
+// ClearNetworkDevice clears the "network_device" edge to the NetworkDevice entity.
+func (seuo *StatusEventUpdateOne) ClearNetworkDevice() *StatusEventUpdateOne {
+	seuo.mutation.ClearNetworkDevice()
+	return seuo
+}
+
+// Where appends a list predicates to the StatusEventUpdate builder.
+func (seuo *StatusEventUpdateOne) Where(ps ...predicate.StatusEvent) *StatusEventUpdateOne {
+	seuo.mutation.Where(ps...)
+	return seuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (seuo *StatusEventUpdateOne) Select(field string, fields ...string) *StatusEventUpdateOne {
+	seuo.fields = append([]string{field}, fields...)
+	return seuo
+}
+
+// Save executes the query and returns the updated StatusEvent entity.
+func (seuo *StatusEventUpdateOne) Save(ctx context.Context) (*StatusEvent, error) {
+	return withHooks(ctx, seuo.sqlSave, seuo.mutation, seuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seuo *StatusEventUpdateOne) SaveX(ctx context.Context) *StatusEvent {
+	node, err := seuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (seuo *StatusEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := seuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seuo *StatusEventUpdateOne) ExecX(ctx context.Context) {
+	if err := seuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seuo *StatusEventUpdateOne) check() error {
+	if v, ok := seuo.mutation.OldStatus(); ok {
+		if err := statusevent.OldStatusValidator(v); err != nil {
+			return &ValidationError{Name: "old_status", err: fmt.Errorf(`ent: validator failed for field "StatusEvent.old_status": %w`, err)}
+		}
+	}
+	if v, ok := seuo.mutation.NewStatus(); ok {
+		if err := statusevent.NewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "new_status", err: fmt.Errorf(`ent: validator failed for field "StatusEvent.new_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (seuo *StatusEventUpdateOne) sqlSave(ctx context.Context) (_node *StatusEvent, err error) {
+	if err := seuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(statusevent.Table, statusevent.Columns, sqlgraph.NewFieldSpec(statusevent.FieldID, field.TypeString))
+	id, ok := seuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StatusEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := seuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, statusevent.FieldID)
+		for _, f := range fields {
+			if !statusevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != statusevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := seuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := seuo.mutation.OldStatus(); ok {
+		_spec.SetField(statusevent.FieldOldStatus, field.TypeEnum, value)
+	}
+	if value, ok := seuo.mutation.NewStatus(); ok {
+		_spec.SetField(statusevent.FieldNewStatus, field.TypeEnum, value)
+	}
+	if value, ok := seuo.mutation.Timestamp(); ok {
+		_spec.SetField(statusevent.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := seuo.mutation.AddedTimestamp(); ok {
+		_spec.AddField(statusevent.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := seuo.mutation.Reason(); ok {
+		_spec.SetField(statusevent.FieldReason, field.TypeString, value)
+	}
+	if seuo.mutation.NetworkDeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statusevent.NetworkDeviceTable,
+			Columns: []string{statusevent.NetworkDeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seuo.mutation.NetworkDeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statusevent.NetworkDeviceTable,
+			Columns: []string{statusevent.NetworkDeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(networkdevice.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StatusEvent{config: seuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, seuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statusevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	seuo.mutation.done = true
+	return _node, nil
+}
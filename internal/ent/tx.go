@@ -14,12 +14,28 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// CredentialProfile is the client for interacting with the CredentialProfile builders.
+	CredentialProfile *CredentialProfileClient
 	// DeviceStatus is the client for interacting with the DeviceStatus builders.
 	DeviceStatus *DeviceStatusClient
 	// Endpoint is the client for interacting with the Endpoint builders.
 	Endpoint *EndpointClient
+	// EndpointHealth is the client for interacting with the EndpointHealth builders.
+	EndpointHealth *EndpointHealthClient
+	// LatencySample is the client for interacting with the LatencySample builders.
+	LatencySample *LatencySampleClient
+	// Lease is the client for interacting with the Lease builders.
+	Lease *LeaseClient
+	// MaintenanceWindow is the client for interacting with the MaintenanceWindow builders.
+	MaintenanceWindow *MaintenanceWindowClient
 	// NetworkDevice is the client for interacting with the NetworkDevice builders.
 	NetworkDevice *NetworkDeviceClient
+	// PollingDefault is the client for interacting with the PollingDefault builders.
+	PollingDefault *PollingDefaultClient
+	// ReplicaHeartbeat is the client for interacting with the ReplicaHeartbeat builders.
+	ReplicaHeartbeat *ReplicaHeartbeatClient
+	// StatusEvent is the client for interacting with the StatusEvent builders.
+	StatusEvent *StatusEventClient
 	// Version is the client for interacting with the Version builders.
 	Version *VersionClient
 
@@ -153,9 +169,17 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.CredentialProfile = NewCredentialProfileClient(tx.config)
 	tx.DeviceStatus = NewDeviceStatusClient(tx.config)
 	tx.Endpoint = NewEndpointClient(tx.config)
+	tx.EndpointHealth = NewEndpointHealthClient(tx.config)
+	tx.LatencySample = NewLatencySampleClient(tx.config)
+	tx.Lease = NewLeaseClient(tx.config)
+	tx.MaintenanceWindow = NewMaintenanceWindowClient(tx.config)
 	tx.NetworkDevice = NewNetworkDeviceClient(tx.config)
+	tx.PollingDefault = NewPollingDefaultClient(tx.config)
+	tx.ReplicaHeartbeat = NewReplicaHeartbeatClient(tx.config)
+	tx.StatusEvent = NewStatusEventClient(tx.config)
 	tx.Version = NewVersionClient(tx.config)
 }
 
@@ -166,7 +190,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: DeviceStatus.QueryXXX(), the query will be executed
+// applies a query, for example: CredentialProfile.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
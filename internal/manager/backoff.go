@@ -0,0 +1,48 @@
+// Package manager implements SB control loop, that fetches data from the devices and stores it to the DB.
+package manager
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	defaultBackoffBase = 30 * time.Second
+	// EnvBackoffBase defines a base of the exponential backoff applied to polls of the unreachable device.
+	EnvBackoffBase = "BACKOFF_BASE" // in seconds.
+
+	defaultBackoffCap = 15 * time.Minute
+	// EnvBackoffCap defines a maximum delay between polls of the unreachable device.
+	EnvBackoffCap = "BACKOFF_CAP" // in seconds.
+)
+
+// Backoff computes delays between polls of the unreachable device. It implements exponential backoff with full
+// jitter, i.e., the delay is picked uniformly at random from [0, min(Cap, Base * 2^(attempt-1))].
+type Backoff struct {
+	Base time.Duration
+	Cap  time.Duration
+}
+
+// NewBackoff function creates Backoff structure.
+func NewBackoff(base, maxDelay time.Duration) *Backoff {
+	return &Backoff{
+		Base: base,
+		Cap:  maxDelay,
+	}
+}
+
+// Delay returns a delay before the next poll of the device, which has failed to communicate attempt times in a row.
+func (b *Backoff) Delay(attempt int32) time.Duration {
+	if attempt <= 0 || b.Base <= 0 {
+		return 0
+	}
+	ceiling := b.Cap
+	if ceiling <= 0 {
+		return 0
+	}
+	// comparing against the shifted cap, so that the exponent never overflows
+	if shift := attempt - 1; shift < 63 && b.Base <= ceiling>>shift {
+		ceiling = b.Base << shift
+	}
+	return time.Duration(rand.Int64N(int64(ceiling) + 1)) //nolint:gosec // jitter does not need a secure source
+}
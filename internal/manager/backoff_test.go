@@ -0,0 +1,53 @@
+// Package manager_test implements unit tests to test the control loop behavior.
+package manager_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/manager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	base := 10 * time.Second
+	maxDelay := 5 * time.Minute
+	backoff := manager.NewBackoff(base, maxDelay)
+
+	// no failed attempts, no delay
+	assert.Zero(t, backoff.Delay(0))
+
+	// delay grows exponentially, but never exceeds the cap
+	for attempt := int32(1); attempt <= 10; attempt++ {
+		ceiling := base << (attempt - 1)
+		if ceiling > maxDelay {
+			ceiling = maxDelay
+		}
+		for i := 0; i < 100; i++ {
+			delay := backoff.Delay(attempt)
+			assert.GreaterOrEqual(t, delay, time.Duration(0))
+			assert.LessOrEqual(t, delay, ceiling)
+		}
+	}
+
+	// large number of attempts does not overflow
+	for _, attempt := range []int32{35, 63, 64, 1 << 30} {
+		delay := backoff.Delay(attempt)
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.LessOrEqual(t, delay, maxDelay)
+	}
+
+	// jitter spreads delays over the whole range
+	spread := false
+	first := backoff.Delay(10)
+	for i := 0; i < 100; i++ {
+		if backoff.Delay(10) != first {
+			spread = true
+			break
+		}
+	}
+	assert.True(t, spread)
+
+	// backoff is disabled
+	assert.Zero(t, manager.NewBackoff(0, maxDelay).Delay(5))
+}
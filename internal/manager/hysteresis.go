@@ -0,0 +1,77 @@
+// Package manager implements SB control loop, that fetches data from the devices and stores it to the DB.
+package manager
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+)
+
+const (
+	defaultHysteresisReadings = 2
+	// EnvHysteresisReadings defines a number of consecutive readings of the same status, which are required
+	// to transition the network device to it.
+	EnvHysteresisReadings = "STATUS_HYSTERESIS_READINGS"
+
+	defaultFlapThreshold = 5
+	// EnvFlapThreshold defines a number of transitions within the flapping window, after which the network device
+	// is considered to be flapping. Setting it to 0 disables flap detection.
+	EnvFlapThreshold = "FLAP_THRESHOLD"
+
+	defaultFlapWindow = 10 * time.Minute
+	// EnvFlapWindow defines a window, within which the transitions of the network device are counted.
+	EnvFlapWindow = "FLAP_WINDOW" // in seconds.
+)
+
+// StatusState is a state of the status state machine of the network device.
+type StatusState struct {
+	// Status is a current status of the network device.
+	Status devicestatus.Status
+	// PendingStatus is a status, which the network device is transitioning to, if any.
+	PendingStatus devicestatus.Status
+	// PendingReadings is a number of consecutive readings of the pending status.
+	PendingReadings int32
+}
+
+// Apply applies a reading of the network device status to the state. Transition happens only after the required
+// number of consecutive readings of the same status, the reading of the current status cancels the pending transition.
+// Device, which has no status yet, takes the reading right away. It returns true, when the status has changed.
+func (s StatusState) Apply(reading devicestatus.Status, required int32) (StatusState, bool) {
+	if s.Status == "" || s.Status == devicestatus.StatusSTATUS_UNSPECIFIED {
+		return StatusState{Status: reading}, true
+	}
+	if reading == s.Status {
+		return StatusState{Status: s.Status}, false
+	}
+	readings := int32(1)
+	if reading == s.PendingStatus {
+		readings = s.PendingReadings + 1
+	}
+	if readings >= required {
+		return StatusState{Status: reading}, true
+	}
+	return StatusState{
+		Status:          s.Status,
+		PendingStatus:   reading,
+		PendingReadings: readings,
+	}, false
+}
+
+// readCount reads a non-negative number from the environment variable.
+func readCount(envName string, defaultCount int) int {
+	countStr := os.Getenv(envName)
+	if countStr == "" {
+		zlog.Warn().Msgf("Environment variable \"%s\" is not set, using default value: %d", envName, defaultCount)
+		return defaultCount
+	}
+	count, err := strconv.Atoi(countStr)
+	if err != nil {
+		zlog.Fatal().Err(err).Msgf("Failed to convert \"%s\" variable to number", envName)
+	}
+	if count < 0 {
+		zlog.Fatal().Msgf("Environment variable \"%s\" must not be negative", envName)
+	}
+	return count
+}
@@ -0,0 +1,52 @@
+// Package manager_test implements unit tests to test the control loop behavior.
+package manager_test
+
+import (
+	"testing"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/manager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusStateApply(t *testing.T) {
+	up := devicestatus.StatusSTATUS_DEVICE_UP
+	unhealthy := devicestatus.StatusSTATUS_DEVICE_UNHEALTHY
+	down := devicestatus.StatusSTATUS_DEVICE_DOWN
+
+	// device with no status takes the first reading right away
+	state, transitioned := manager.StatusState{}.Apply(up, 3)
+	assert.True(t, transitioned)
+	assert.Equal(t, manager.StatusState{Status: up}, state)
+
+	// single reading of the other status is not enough
+	state, transitioned = state.Apply(unhealthy, 3)
+	assert.False(t, transitioned)
+	assert.Equal(t, manager.StatusState{Status: up, PendingStatus: unhealthy, PendingReadings: 1}, state)
+
+	// reading of the current status cancels the pending transition
+	state, transitioned = state.Apply(up, 3)
+	assert.False(t, transitioned)
+	assert.Equal(t, manager.StatusState{Status: up}, state)
+
+	// reading of yet another status restarts counting
+	state, _ = state.Apply(unhealthy, 3)
+	state, transitioned = state.Apply(down, 3)
+	assert.False(t, transitioned)
+	assert.Equal(t, manager.StatusState{Status: up, PendingStatus: down, PendingReadings: 1}, state)
+
+	// transition happens after the required number of consecutive readings
+	state, transitioned = state.Apply(down, 3)
+	assert.False(t, transitioned)
+	state, transitioned = state.Apply(down, 3)
+	assert.True(t, transitioned)
+	assert.Equal(t, manager.StatusState{Status: down}, state)
+
+	// hysteresis is disabled
+	state, transitioned = state.Apply(up, 1)
+	assert.True(t, transitioned)
+	assert.Equal(t, manager.StatusState{Status: up}, state)
+	state, transitioned = state.Apply(unhealthy, 0)
+	assert.True(t, transitioned)
+	assert.Equal(t, manager.StatusState{Status: unhealthy}, state)
+}
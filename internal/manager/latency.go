@@ -0,0 +1,42 @@
+// Package manager implements SB control loop, that fetches data from the devices and stores it to the DB.
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/pkg/client/db"
+)
+
+const (
+	defaultLatencyRetention = 7 * 24 * time.Hour
+	// EnvLatencyRetention defines a period, for which latency samples of the network devices are kept. Older samples
+	// are pruned by main control loop. Setting it to 0 keeps the samples forever.
+	EnvLatencyRetention = "LATENCY_SAMPLE_RETENTION" // in seconds.
+)
+
+// readLatencyRetention reads a retention period of latency samples from the environment variable.
+func readLatencyRetention() time.Duration {
+	retention := readPeriod(EnvLatencyRetention, defaultLatencyRetention)
+	if retention < 0 {
+		zlog.Fatal().Msgf("Environment variable \"%s\" must not be negative", EnvLatencyRetention)
+	}
+	return retention
+}
+
+// pruneLatencySamples deletes latency samples of all network devices, which are older than the retention period.
+func (m *Manager) pruneLatencySamples(ctx context.Context) {
+	if m.latencyRetention == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, m.controlLoopTick)
+	defer cancel()
+	deleted, err := db.DeleteLatencySamplesBefore(ctx, m.dbClient, time.Now().Add(-m.latencyRetention))
+	if err != nil {
+		// error is already logged in in the inner function
+		return
+	}
+	if deleted > 0 {
+		zlog.Debug().Msgf("Pruned %d latency sample(s) older than %s", deleted, m.latencyRetention)
+	}
+}
@@ -0,0 +1,97 @@
+// Package manager implements SB control loop, that fetches data from the devices and stores it to the DB.
+package manager
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/pkg/client/db"
+	"github.com/google/uuid"
+)
+
+const (
+	// controlLoopLease is a name of the lease, which holder runs main control loop.
+	controlLoopLease = "control-loop"
+
+	defaultLeaseDuration = 15 * time.Second
+	// EnvLeaseDuration defines a duration of the leadership lease. When the leader dies, another replica takes over
+	// main control loop within this duration.
+	EnvLeaseDuration = "LEADER_LEASE_DURATION" // in seconds.
+)
+
+// readLeaseDuration reads a duration of the leadership lease from the environment variable.
+func readLeaseDuration() time.Duration {
+	duration := readPeriod(EnvLeaseDuration, defaultLeaseDuration)
+	if duration <= 0 {
+		zlog.Fatal().Msgf("Environment variable \"%s\" must be positive", EnvLeaseDuration)
+	}
+	return duration
+}
+
+// newIdentity returns an identity of this replica, which is unique even among the restarts of the same pod.
+func newIdentity() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "monitoring"
+	}
+	return hostname + "-" + uuid.NewString()[:8]
+}
+
+// IsLeader reports whether this replica is currently elected as a leader, i.e., it runs main control loop.
+func (m *Manager) IsLeader() bool {
+	return m.leader.Load()
+}
+
+// runLeaderElection periodically acquires (or renews) the leadership lease. Main control loop runs only while this
+// replica holds the lease, other replicas keep serving API.
+func (m *Manager) runLeaderElection(controlLoopTick time.Duration) {
+	zlog.Info().Msgf("Starting leader election as %s, lease duration is %s", m.identity, m.leaseDuration)
+	// renewing the lease a few times within its duration, so that one failed renewal doesn't cost the leadership
+	ticker := time.NewTicker(m.leaseDuration / 3)
+	defer ticker.Stop()
+
+	var stopControlLoop context.CancelFunc
+	for {
+		leader := m.tryLead()
+		switch {
+		case leader && stopControlLoop == nil:
+			zlog.Info().Msgf("Replica %s is elected as a leader, starting main control loop", m.identity)
+			var ctx context.Context
+			ctx, stopControlLoop = context.WithCancel(context.Background())
+			go m.controlLoop(ctx, controlLoopTick)
+		case !leader && stopControlLoop != nil:
+			zlog.Warn().Msgf("Replica %s has lost the leadership, stopping main control loop", m.identity)
+			stopControlLoop()
+			stopControlLoop = nil
+		}
+		m.leader.Store(leader)
+
+		select {
+		case <-ticker.C:
+		case <-m.closeChan:
+			// shutting down this routine, stepping down right away, so that another replica takes over
+			if stopControlLoop != nil {
+				stopControlLoop()
+			}
+			m.leader.Store(false)
+			ctx, cancel := context.WithTimeout(context.Background(), m.leaseDuration)
+			_ = db.ReleaseLease(ctx, m.dbClient, controlLoopLease, m.identity)
+			// error is already logged in in the inner function
+			cancel()
+			return
+		}
+	}
+}
+
+// tryLead acquires (or renews) the leadership lease. Leadership is considered lost, when the lease can't be renewed.
+func (m *Manager) tryLead() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), m.leaseDuration/3)
+	defer cancel()
+	acquired, err := db.AcquireLease(ctx, m.dbClient, controlLoopLease, m.identity, m.leaseDuration)
+	if err != nil {
+		// error is already logged in in the inner function
+		return false
+	}
+	return acquired
+}
@@ -0,0 +1,77 @@
+// Package manager implements SB control loop, that fetches data from the devices and stores it to the DB.
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/pkg/client/db"
+	"github.com/robfig/cron/v3"
+)
+
+// MaintenanceActive checks if the maintenance window is active at the given time. Recurring window is active for
+// (ends_at - starts_at) after each activation, which is not before starts_at.
+func MaintenanceActive(mw *ent.MaintenanceWindow, now time.Time) bool {
+	startsAt := time.UnixMilli(mw.StartsAt).UTC()
+	endsAt := time.UnixMilli(mw.EndsAt).UTC()
+	now = now.UTC()
+	if mw.Recurrence == "" {
+		return !now.Before(startsAt) && now.Before(endsAt)
+	}
+
+	schedule, err := cron.ParseStandard(mw.Recurrence)
+	if err != nil {
+		// recurrence is validated, when the window is created, this should never happen
+		zlog.Error().Err(err).Msgf("Failed to parse recurrence of maintenance window (%s)", mw.ID)
+		return false
+	}
+	// looking for the activation within (now - duration, now], activation at starts_at counts as well
+	from := now.Add(-endsAt.Sub(startsAt))
+	if from.Before(startsAt) {
+		from = startsAt.Add(-time.Nanosecond)
+	}
+	activation := schedule.Next(from)
+	return !activation.IsZero() && !activation.After(now)
+}
+
+// MaintenanceCovers checks if the maintenance window covers the network device, i.e., the device is listed explicitly
+// or it matches all selectors, which are set.
+func MaintenanceCovers(mw *ent.MaintenanceWindow, nd *ent.NetworkDevice) bool {
+	for _, covered := range mw.Edges.NetworkDevices {
+		if covered.ID == nd.ID {
+			return true
+		}
+	}
+	if mw.Group == "" && mw.Site == "" {
+		return false
+	}
+	return (mw.Group == "" || mw.Group == nd.Group) && (mw.Site == "" || mw.Site == nd.Site)
+}
+
+// InMaintenance checks if the network device is covered by any of the maintenance windows, which are active
+// at the given time.
+func InMaintenance(nd *ent.NetworkDevice, windows []*ent.MaintenanceWindow, now time.Time) bool {
+	for _, mw := range windows {
+		if MaintenanceActive(mw, now) && MaintenanceCovers(mw, nd) {
+			return true
+		}
+	}
+	return false
+}
+
+// maintenance resolves which network devices are in maintenance right now. No device is considered to be in
+// maintenance, when the maintenance windows can't be retrieved.
+func (m *Manager) maintenance(ctx context.Context, ndList []*ent.NetworkDevice) map[string]bool {
+	windows, err := db.ListMaintenanceWindows(ctx, m.dbClient)
+	if err != nil {
+		// error is already logged in in the inner function
+		windows = nil
+	}
+	now := time.Now()
+	inMaintenance := make(map[string]bool, len(ndList))
+	for _, nd := range ndList {
+		inMaintenance[nd.ID] = InMaintenance(nd, windows, now)
+	}
+	return inMaintenance
+}
@@ -0,0 +1,66 @@
+// Package manager_test implements unit tests to test the control loop behavior.
+package manager_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/manager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceActive(t *testing.T) {
+	startsAt := time.Date(2026, time.October, 4, 2, 0, 0, 0, time.UTC) // Sunday
+	mw := &ent.MaintenanceWindow{
+		StartsAt: startsAt.UnixMilli(),
+		EndsAt:   startsAt.Add(2 * time.Hour).UnixMilli(),
+	}
+
+	// one-off window is active only between its start and end
+	assert.False(t, manager.MaintenanceActive(mw, startsAt.Add(-time.Minute)))
+	assert.True(t, manager.MaintenanceActive(mw, startsAt))
+	assert.True(t, manager.MaintenanceActive(mw, startsAt.Add(time.Hour)))
+	assert.False(t, manager.MaintenanceActive(mw, startsAt.Add(2*time.Hour)))
+	assert.False(t, manager.MaintenanceActive(mw, startsAt.Add(7*24*time.Hour)))
+
+	// recurring window is active for two hours every Sunday since its start
+	mw.Recurrence = "0 2 * * SUN"
+	assert.False(t, manager.MaintenanceActive(mw, startsAt.Add(-7*24*time.Hour)))
+	assert.True(t, manager.MaintenanceActive(mw, startsAt))
+	assert.True(t, manager.MaintenanceActive(mw, startsAt.Add(7*24*time.Hour+time.Hour)))
+	assert.False(t, manager.MaintenanceActive(mw, startsAt.Add(7*24*time.Hour+2*time.Hour)))
+	assert.False(t, manager.MaintenanceActive(mw, startsAt.Add(3*24*time.Hour)))
+
+	// malformed recurrence never activates the window
+	mw.Recurrence = "every sunday"
+	assert.False(t, manager.MaintenanceActive(mw, startsAt))
+}
+
+func TestMaintenanceCovers(t *testing.T) {
+	nd := &ent.NetworkDevice{ID: "netdev-1", Group: "core", Site: "remote"}
+
+	// network device is listed explicitly
+	mw := &ent.MaintenanceWindow{}
+	mw.Edges.NetworkDevices = []*ent.NetworkDevice{{ID: "netdev-1"}}
+	assert.True(t, manager.MaintenanceCovers(mw, nd))
+	mw.Edges.NetworkDevices = []*ent.NetworkDevice{{ID: "netdev-2"}}
+	assert.False(t, manager.MaintenanceCovers(mw, nd))
+
+	// network device matches all selectors
+	assert.True(t, manager.MaintenanceCovers(&ent.MaintenanceWindow{Group: "core"}, nd))
+	assert.True(t, manager.MaintenanceCovers(&ent.MaintenanceWindow{Site: "remote"}, nd))
+	assert.True(t, manager.MaintenanceCovers(&ent.MaintenanceWindow{Group: "core", Site: "remote"}, nd))
+	assert.False(t, manager.MaintenanceCovers(&ent.MaintenanceWindow{Group: "core", Site: "hq"}, nd))
+	assert.False(t, manager.MaintenanceCovers(&ent.MaintenanceWindow{Group: "edge"}, nd))
+
+	// window with no devices and no selectors covers nothing
+	assert.False(t, manager.MaintenanceCovers(&ent.MaintenanceWindow{}, &ent.NetworkDevice{ID: "netdev-3"}))
+
+	// network device is in maintenance, only when the covering window is active
+	now := time.Now()
+	active := &ent.MaintenanceWindow{Group: "core", StartsAt: now.Add(-time.Hour).UnixMilli(), EndsAt: now.Add(time.Hour).UnixMilli()}
+	inactive := &ent.MaintenanceWindow{Site: "remote", StartsAt: now.Add(time.Hour).UnixMilli(), EndsAt: now.Add(2 * time.Hour).UnixMilli()}
+	assert.True(t, manager.InMaintenance(nd, []*ent.MaintenanceWindow{inactive, active}, now))
+	assert.False(t, manager.InMaintenance(nd, []*ent.MaintenanceWindow{inactive}, now))
+}
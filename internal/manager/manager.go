@@ -3,19 +3,25 @@ package manager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/eroshiva/trade-show-poc/internal/ent"
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
 	"github.com/eroshiva/trade-show-poc/pkg/checksum"
 	"github.com/eroshiva/trade-show-poc/pkg/client/db"
 	"github.com/eroshiva/trade-show-poc/pkg/connectors"
 	"github.com/rs/zerolog"
+	"golang.org/x/sync/singleflight"
 )
 
 const (
@@ -23,12 +29,39 @@ const (
 	componentName = "control-loop"
 
 	defaultControlLoopPerioud = 30 * time.Second
-	// EnvControlLoopPeriod defines a control loop period in seconds.
+	// EnvControlLoopPeriod defines a default poll interval of the network devices and a period of refreshing the list of
+	// the network devices.
 	EnvControlLoopPeriod = "CONTROL_LOOP_PERIOD" // in seconds.
 
 	defaultConnectivityAbsenceLimit = 3
 	// EnvConnectivityAbsenceLimit specifies threshold for consequential fails in communication with the device.
 	EnvConnectivityAbsenceLimit = "CONNECTIVITY_ABSENCE_LIMIT"
+
+	defaultWorkers = 64
+	// EnvWorkers defines a number of workers, which poll network devices concurrently. It bounds number of connections
+	// established at once.
+	EnvWorkers = "CONTROL_LOOP_WORKERS"
+
+	defaultDeviceTimeout = 30 * time.Second
+	// EnvDeviceTimeout defines a budget for polling a single network device (over all its endpoints).
+	EnvDeviceTimeout = "DEVICE_POLL_TIMEOUT" // in seconds.
+
+	defaultRPCTimeout = 5 * time.Second
+	// EnvRPCTimeout defines a timeout of a single exchange with the network device.
+	EnvRPCTimeout = "DEVICE_RPC_TIMEOUT" // in seconds.
+
+	// EnvConnectionIdleTimeout defines a period, after which an idle connection to the device is closed.
+	EnvConnectionIdleTimeout = "CONNECTION_IDLE_TIMEOUT" // in seconds.
+	// EnvConnectionMaxLifetime defines a period, after which a connection to the device is closed regardless of its usage.
+	EnvConnectionMaxLifetime = "CONNECTION_MAX_LIFETIME" // in seconds.
+	// EnvConnectionHealthCheckPeriod defines a period, after which an idle connection to the device is health checked.
+	EnvConnectionHealthCheckPeriod = "CONNECTION_HEALTH_CHECK_PERIOD" // in seconds.
+	// EnvConnectionMaxActive defines a number of connections to the single endpoint, which can be in use at once.
+	// Setting it to 0 removes the limit.
+	EnvConnectionMaxActive = "CONNECTION_MAX_ACTIVE"
+	// EnvConnectionWaitTimeout defines a period, for which an exchange with the device waits for a connection, when
+	// all connections to the endpoint are in use. Setting it to 0 fails the exchange right away.
+	EnvConnectionWaitTimeout = "CONNECTION_WAIT_TIMEOUT" // in seconds.
 )
 
 var zlog = zerolog.New(zerolog.ConsoleWriter{
@@ -45,6 +78,23 @@ type Manager struct {
 	checksumGenerator            checksum.Generator
 	closeChan                    chan bool
 	connectivityAbsenceThreshold int32
+	hysteresisReadings           int32
+	flapThreshold                int
+	flapWindow                   time.Duration
+	backoff                      *Backoff
+	workers                      int
+	deviceTimeout                time.Duration
+	rpcTimeout                   time.Duration
+	leaseDuration                time.Duration
+	identity                     string
+	leader                       atomic.Bool
+	sharding                     bool
+	heartbeatTimeout             time.Duration
+	ring                         atomic.Pointer[HashRing]
+	controlLoopTick              time.Duration
+	probeStagger                 time.Duration
+	latencyRetention             time.Duration
+	polls                        singleflight.Group
 }
 
 // NewManager function creates Manager structure.
@@ -63,11 +113,35 @@ func NewManager(dbClient *ent.Client, checksumGen checksum.Generator) *Manager {
 		}
 		cal = convertedCal
 	}
+
+	// connections to the devices are shared by all connectors and are kept between control loop iterations
+	poolConfig := connectors.DefaultPoolConfig()
+	poolConfig.IdleTimeout = readPeriod(EnvConnectionIdleTimeout, connectors.DefaultPoolIdleTimeout)
+	poolConfig.MaxLifetime = readPeriod(EnvConnectionMaxLifetime, connectors.DefaultPoolMaxLifetime)
+	poolConfig.HealthCheckInterval = readPeriod(EnvConnectionHealthCheckPeriod, connectors.DefaultPoolHealthCheckInterval)
+	poolConfig.MaxActivePerEndpoint = readCount(EnvConnectionMaxActive, connectors.DefaultPoolMaxActivePerEndpoint)
+	poolConfig.ActiveWaitTimeout = readPeriod(EnvConnectionWaitTimeout, connectors.DefaultPoolActiveWaitTimeout)
+	connectors.DefaultPool().SetConfig(poolConfig)
+
 	return &Manager{
 		dbClient:                     dbClient,
 		checksumGenerator:            checksumGen,
 		closeChan:                    make(chan bool),
 		connectivityAbsenceThreshold: int32(cal),
+		hysteresisReadings:           int32(readCount(EnvHysteresisReadings, defaultHysteresisReadings)),
+		flapThreshold:                readCount(EnvFlapThreshold, defaultFlapThreshold),
+		flapWindow:                   readPeriod(EnvFlapWindow, defaultFlapWindow),
+		backoff:                      NewBackoff(readPeriod(EnvBackoffBase, defaultBackoffBase), readPeriod(EnvBackoffCap, defaultBackoffCap)),
+		workers:                      readWorkers(),
+		deviceTimeout:                readPeriod(EnvDeviceTimeout, defaultDeviceTimeout),
+		rpcTimeout:                   readPeriod(EnvRPCTimeout, defaultRPCTimeout),
+		leaseDuration:                readLeaseDuration(),
+		identity:                     newIdentity(),
+		sharding:                     readSharding(),
+		heartbeatTimeout:             readHeartbeatTimeout(),
+		controlLoopTick:              readPeriod(EnvControlLoopPeriod, defaultControlLoopPerioud),
+		probeStagger:                 readProbeStagger(),
+		latencyRetention:             readLatencyRetention(),
 	}
 }
 
@@ -75,60 +149,261 @@ func NewManager(dbClient *ent.Client, checksumGen checksum.Generator) *Manager {
 func (m *Manager) StopManager() {
 	close(m.closeChan)
 	zlog.Info().Msg("Stopping manager...")
+	// gracefully closing connections to the devices
+	connectors.DefaultPool().CloseIdleConnections()
+}
+
+// readPeriod reads a period in seconds from the environment variable.
+func readPeriod(envName string, defaultPeriod time.Duration) time.Duration {
+	periodStr := os.Getenv(envName)
+	if periodStr == "" {
+		zlog.Warn().Msgf("Environment variable \"%s\" is not set, using default value: %s", envName, defaultPeriod)
+		return defaultPeriod
+	}
+	period, err := strconv.Atoi(periodStr)
+	if err != nil {
+		zlog.Fatal().Err(err).Msgf("Failed to convert \"%s\" variable to number", envName)
+	}
+	return time.Duration(period) * time.Second
+}
+
+// readWorkers reads a number of workers from the environment variable.
+func readWorkers() int {
+	workersStr := os.Getenv(EnvWorkers)
+	if workersStr == "" {
+		zlog.Warn().Msgf("Environment variable \"%s\" is not set, using default value: %d", EnvWorkers, defaultWorkers)
+		return defaultWorkers
+	}
+	workers, err := strconv.Atoi(workersStr)
+	if err != nil {
+		zlog.Fatal().Err(err).Msgf("Failed to convert \"%s\" variable to number", EnvWorkers)
+	}
+	if workers < 1 {
+		zlog.Fatal().Msgf("Environment variable \"%s\" must be positive", EnvWorkers)
+	}
+	return workers
 }
 
 // StartManager function starts main control loop that periodically fetches data from the network devices.
 func (m *Manager) StartManager() {
 	zlog.Info().Msg("Starting manager...")
-	controlLoopTick := defaultControlLoopPerioud
-	// read env variable, where Control Loop Period is specified
-	envControlLoopTick := os.Getenv(EnvControlLoopPeriod)
-	if envControlLoopTick == "" {
-		zlog.Warn().Msgf("Environment variable \"%s\" is not set, using default value: %s",
-			EnvControlLoopPeriod, defaultControlLoopPerioud)
+	protocols := connectors.RegisteredProtocols()
+	if len(protocols) == 0 {
+		zlog.Warn().Msg("No connectors are registered, devices can't be monitored")
 	} else {
-		// control loop tick is specified
-		duration, err := strconv.Atoi(envControlLoopTick)
-		if err != nil {
-			zlog.Fatal().Err(err).Msgf("Failed to convert \"%s\" variable to number", EnvControlLoopPeriod)
-		}
-		controlLoopTick = time.Duration(duration) * time.Second
+		zlog.Info().Msgf("Registered connectors: %v", protocols)
+	}
+	controlLoopTick := m.controlLoopTick
+	if m.sharding {
+		// executing control loop over the shard of this replica
+		go m.runSharding(controlLoopTick)
+		return
 	}
+	// executing control loop, only when this replica is elected as a leader
+	go m.runLeaderElection(controlLoopTick)
+}
+
+// pollJob is a poll of the single network device, which is handed over to the worker.
+type pollJob struct {
+	networkDevice *ent.NetworkDevice
+	pollInterval  time.Duration
+	inMaintenance bool
+}
 
-	// executing control loop
-	go m.controlLoop(controlLoopTick)
+// pollResult carries time of the next poll of the network device, which was polled by the worker.
+type pollResult struct {
+	networkDeviceID string
+	nextPoll        time.Time
 }
 
-func (m *Manager) controlLoop(controlLoopTick time.Duration) {
-	zlog.Info().Msgf("Starting periodical (%s seconds) execution of main control loop", controlLoopTick)
-	// creating ticker
-	ticker := time.NewTicker(controlLoopTick)
+// controlLoop runs main control loop until the context is cancelled. Devices, which are due, are handed over
+// to the long-lived workers, and each device is scheduled again, as soon as its own poll is done, i.e., devices
+// never wait for each other.
+func (m *Manager) controlLoop(ctx context.Context, controlLoopTick time.Duration) {
+	zlog.Info().Msgf("Starting scheduled execution of main control loop, device list is refreshed every %s", controlLoopTick)
+	scheduler := NewScheduler()
+	devices := make(map[string]*ent.NetworkDevice)
+	intervals := make(map[string]time.Duration)
+	var nextRefresh time.Time
+	// devices, which are waiting for a free worker or are being polled, they are not in the schedule
+	inFlight := make(map[string]bool)
+	queue := make([]pollJob, 0)
 
-	// performing control loop routine at the very beginning
-	m.PerformControlLoopRoutine(controlLoopTick)
+	jobs := make(chan pollJob)
+	results := make(chan pollResult)
+	workers := m.startPollWorkers(ctx, jobs, results)
+	defer func() {
+		queueDepth.Sub(float64(len(queue)))
+		// polls in progress are cancelled together with the context
+		workers.Wait()
+	}()
+
+	// firing right away to perform control loop routine at the very beginning
+	timer := time.NewTimer(0)
+	defer timer.Stop()
 
 	// starting infinite control loop
 	for {
+		// handing the first waiting device over to the worker, once any of them is free
+		var dispatch chan<- pollJob
+		var next pollJob
+		if len(queue) > 0 {
+			dispatch, next = jobs, queue[0]
+		}
+
 		select {
-		case <-ticker.C:
-			// performing control loop routine
-			m.PerformControlLoopRoutine(controlLoopTick)
-		case <-m.closeChan:
+		case <-timer.C:
+			// devices may be added or removed via API, refreshing the schedule periodically
+			if !time.Now().Before(nextRefresh) {
+				devices, intervals = m.refreshSchedule(ctx, scheduler, devices, intervals, inFlight, controlLoopTick)
+				m.pruneLatencySamples(ctx)
+				nextRefresh = time.Now().Add(controlLoopTick)
+				// devices, which were removed while waiting for a worker, are not polled anymore
+				queue = slices.DeleteFunc(queue, func(job pollJob) bool {
+					if _, ok := devices[job.networkDevice.ID]; ok {
+						return false
+					}
+					delete(inFlight, job.networkDevice.ID)
+					queueDepth.Dec()
+					return true
+				})
+			}
+			queue = append(queue, m.dueJobs(ctx, scheduler, devices, intervals, inFlight)...)
+		case dispatch <- next:
+			queue = queue[1:]
+			queueDepth.Dec()
+		case result := <-results:
+			delete(inFlight, result.networkDeviceID)
+			if _, ok := devices[result.networkDeviceID]; ok {
+				scheduler.Schedule(result.networkDeviceID, result.nextPoll)
+			}
+		case <-ctx.Done():
 			// shutting down this routine
 			zlog.Debug().Msgf("Stopping main control loop")
 			return
 		}
+
+		// sleeping until the next device is due or until the schedule is refreshed
+		wait := time.Until(nextRefresh)
+		if deadline, ok := scheduler.NextDeadline(); ok && time.Until(deadline) < wait {
+			wait = time.Until(deadline)
+		}
+		timer.Reset(max(wait, 0))
+	}
+}
+
+// refreshSchedule fetches all devices and their poll intervals. New devices are scheduled right away, removed devices
+// are unscheduled. Devices in flight are scheduled, once their poll is done. Previously fetched devices are kept,
+// when the DB is not reachable.
+func (m *Manager) refreshSchedule(ctx context.Context, scheduler *Scheduler, devices map[string]*ent.NetworkDevice,
+	intervals map[string]time.Duration, inFlight map[string]bool, controlLoopTick time.Duration,
+) (map[string]*ent.NetworkDevice, map[string]time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, controlLoopTick)
+	defer cancel()
+
+	ndList, err := m.listNetworkDevices(ctx)
+	if err != nil {
+		// error is already logged in in the inner function
+		return devices, intervals
+	}
+	if len(ndList) == 0 {
+		zlog.Warn().Msgf("No network devices found in the DB")
+	}
+
+	newDevices := make(map[string]*ent.NetworkDevice, len(ndList))
+	for _, nd := range ndList {
+		newDevices[nd.ID] = nd
+		if !scheduler.Contains(nd.ID) && !inFlight[nd.ID] {
+			// persisted schedule of the device is respected when the device is processed
+			scheduler.Schedule(nd.ID, time.Now())
+		}
+	}
+	for id := range devices {
+		if _, ok := newDevices[id]; !ok {
+			scheduler.Remove(id)
+		}
+	}
+	return newDevices, m.pollIntervals(ctx, ndList, controlLoopTick)
+}
+
+// dueJobs takes devices, which are due, out of the schedule and turns them into the jobs for the workers.
+func (m *Manager) dueJobs(ctx context.Context, scheduler *Scheduler, devices map[string]*ent.NetworkDevice,
+	intervals map[string]time.Duration, inFlight map[string]bool,
+) []pollJob {
+	due := scheduler.PopDue(time.Now())
+	ndList := make([]*ent.NetworkDevice, 0, len(due))
+	for _, id := range due {
+		if nd, ok := devices[id]; ok {
+			ndList = append(ndList, nd)
+			inFlight[id] = true
+		}
+	}
+	if len(ndList) == 0 {
+		return nil
 	}
+	zlog.Debug().Msgf("Executing main control loop routine for %d network device(s)", len(ndList))
+
+	inMaintenance := m.maintenance(ctx, ndList)
+	jobs := make([]pollJob, 0, len(ndList))
+	for _, nd := range ndList {
+		jobs = append(jobs, pollJob{networkDevice: nd, pollInterval: intervals[nd.ID], inMaintenance: inMaintenance[nd.ID]})
+	}
+	queueDepth.Add(float64(len(jobs)))
+	return jobs
+}
+
+// startPollWorkers starts a bounded number of workers, which poll network devices handed over to them, until
+// the context is cancelled or the jobs are closed. Each device is given its own budget, so that one slow device
+// doesn't eat the budget of the others.
+func (m *Manager) startPollWorkers(ctx context.Context, jobs <-chan pollJob, results chan<- pollResult) *sync.WaitGroup {
+	wg := &sync.WaitGroup{}
+	for range m.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				var job pollJob
+				var ok bool
+				select {
+				case job, ok = <-jobs:
+					if !ok {
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+				deviceCtx, cancel := context.WithTimeout(ctx, m.deviceTimeout)
+				// device, which is being polled on demand, is not polled again, it is scheduled for the next period
+				nextPoll := time.Now().Add(job.pollInterval)
+				release, err := m.tryLockDevice(deviceCtx, job.networkDevice.ID)
+				if release != nil {
+					nextPoll = m.processNetworkDevice(deviceCtx, job.networkDevice, job.pollInterval, job.inMaintenance, false)
+					release()
+				} else if err == nil {
+					zlog.Debug().Msgf("Network device (%s) is being polled already, skipping it", job.networkDevice.ID)
+				}
+				// error is already logged in in the inner function
+				cancel()
+				select {
+				case results <- pollResult{networkDeviceID: job.networkDevice.ID, nextPoll: nextPoll}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	return wg
 }
 
 // PerformControlLoopRoutine runs main control loop routine, i.e., fetches all devices and updates theirs status.
-func (m *Manager) PerformControlLoopRoutine(controlLoopTick time.Duration) {
+// Devices, which are not due yet, are skipped. Polls are cancelled together with the context.
+func (m *Manager) PerformControlLoopRoutine(ctx context.Context, controlLoopTick time.Duration) {
 	zlog.Debug().Msgf("Executing main control loop routine")
-	ctx, cancel := context.WithTimeout(context.Background(), controlLoopTick)
+	listCtx, cancel := context.WithTimeout(ctx, controlLoopTick)
 	defer cancel()
 
 	// fetching all devices from the DB
-	ndList, err := db.ListNetworkDevices(ctx, m.dbClient)
+	ndList, err := m.listNetworkDevices(listCtx)
 	if err != nil {
 		// error is already logged in in the inner function
 		return
@@ -140,25 +415,96 @@ func (m *Manager) PerformControlLoopRoutine(controlLoopTick time.Duration) {
 		zlog.Warn().Msgf("No network devices found in the DB")
 		return
 	}
+	intervals := m.pollIntervals(listCtx, ndList, controlLoopTick)
+	inMaintenance := m.maintenance(listCtx, ndList)
 
 	// updating network devices concurrently
-	wg := &sync.WaitGroup{}
+	m.processNetworkDevices(ctx, ndList, intervals, inMaintenance)
+}
+
+// processNetworkDevices processes network devices with a bounded number of workers and waits for all of them to be
+// done. Devices, which are still waiting for a worker, when the context is cancelled, are not processed.
+func (m *Manager) processNetworkDevices(ctx context.Context, ndList []*ent.NetworkDevice, intervals map[string]time.Duration,
+	inMaintenance map[string]bool,
+) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	jobs := make(chan pollJob)
+	results := make(chan pollResult)
+	workers := m.startPollWorkers(ctx, jobs, results)
+	defer workers.Wait()
+
+	queueDepth.Add(float64(len(ndList)))
+	go func() {
+		defer close(jobs)
+		for i, nd := range ndList {
+			select {
+			case jobs <- pollJob{networkDevice: nd, pollInterval: intervals[nd.ID], inMaintenance: inMaintenance[nd.ID]}:
+				queueDepth.Dec()
+			case <-ctx.Done():
+				queueDepth.Sub(float64(len(ndList) - i))
+				return
+			}
+		}
+	}()
+	for range ndList {
+		select {
+		case <-results:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// pollIntervals resolves poll intervals of the network devices. Control loop period is used, when neither the device
+// nor its group or site specify the interval.
+func (m *Manager) pollIntervals(ctx context.Context, ndList []*ent.NetworkDevice, controlLoopTick time.Duration) map[string]time.Duration {
+	defaults, err := db.ListPollingDefaults(ctx, m.dbClient)
+	if err != nil {
+		// error is already logged in in the inner function, falling back to the control loop period
+		defaults = nil
+	}
+	intervals := make(map[string]time.Duration, len(ndList))
 	for _, nd := range ndList {
-		wg.Add(1)
-		go func() {
-			m.processNetworkDevice(ctx, nd)
-			wg.Done()
-		}()
+		intervals[nd.ID] = ResolvePollInterval(nd, defaults, controlLoopTick)
+	}
+	return intervals
+}
+
+// ResolvePollInterval returns poll interval of the network device. Interval of the device takes precedence over
+// the default of its group, which takes precedence over the default of its site. Fallback is used otherwise.
+func ResolvePollInterval(nd *ent.NetworkDevice, defaults []*ent.PollingDefault, fallback time.Duration) time.Duration {
+	if nd.PollInterval > 0 {
+		return time.Duration(nd.PollInterval) * time.Second
+	}
+	var siteInterval int32
+	for _, pd := range defaults {
+		switch {
+		case pd.Scope == pollingdefault.ScopePOLLING_SCOPE_GROUP && nd.Group != "" && pd.Name == nd.Group:
+			return time.Duration(pd.PollInterval) * time.Second
+		case pd.Scope == pollingdefault.ScopePOLLING_SCOPE_SITE && nd.Site != "" && pd.Name == nd.Site:
+			siteInterval = pd.PollInterval
+		}
 	}
-	// waiting for all goroutines to finish their iteration (otherwise context gets cancelled before network device processing will be done)
-	wg.Wait()
+	if siteInterval > 0 {
+		return time.Duration(siteInterval) * time.Second
+	}
+	return fallback
 }
 
 // processNetworkDevice runs routine to get network device status, SW, FW, and HW versions from the device and update them in the DB.
-func (m *Manager) processNetworkDevice(ctx context.Context, networkDevice *ent.NetworkDevice) {
+// Devices, which are backed off, are skipped until their next poll is due, unless they are polled on demand. Device
+// in maintenance is polled as well, but it is not reported DOWN. Device, which parent is down, is reported UNREACHABLE
+// instead of DOWN. Device, which rejects the credentials or which is not trusted, is reported UNHEALTHY. It returns
+// time of the next poll of the device.
+func (m *Manager) processNetworkDevice(ctx context.Context, networkDevice *ent.NetworkDevice, pollInterval time.Duration, inMaintenance,
+	onDemand bool,
+) time.Time {
 	zlog.Debug().Msgf("Processing network device (%s)", networkDevice.ID)
 
-	status := devicestatus.StatusSTATUS_DEVICE_DOWN
+	// state of the status state machine of the device
+	var state StatusState
+	flapping := false
 	// get consequential number of failed attempts
 	var cal int32
 	dbDS, err := db.GetDeviceStatusByNetworkDeviceID(ctx, m.dbClient, networkDevice.ID)
@@ -166,77 +512,165 @@ func (m *Manager) processNetworkDevice(ctx context.Context, networkDevice *ent.N
 		zlog.Debug().Err(err).Msgf("Failed to get device status for network device (%s) - looks like it doesn't exist in the system (yet)", networkDevice.ID)
 	} else {
 		cal = dbDS.ConsequentialFailedConnectivityAttempts
-		status = dbDS.Status
+		state = StatusState{
+			Status:          dbDS.Status,
+			PendingStatus:   devicestatus.Status(dbDS.PendingStatus),
+			PendingReadings: dbDS.PendingReadings,
+		}
+		flapping = dbDS.Flapping
+		// device is polled, when its next poll is the closest to now
+		// next poll is not set, when the device has never been polled
+		nextPoll := time.UnixMilli(dbDS.NextPoll)
+		if dbDS.NextPoll != 0 && !onDemand && time.Until(nextPoll) >= pollInterval/2 {
+			zlog.Debug().Msgf("Network device (%s) is not due until %s, skipping it", networkDevice.ID, nextPoll)
+			return nextPoll
+		}
 	}
-	// iterating over endpoints and checking if any of them is alive.
+	// probing endpoints and checking if any of them is alive.
 	// it is enough to find one alive Endpoint and retrieve data from it
 	hwV := ""
 	swV := &ent.Version{}
 	fwV := &ent.Version{}
 
-	aliveConnectionFound := false
-	for _, ep := range networkDevice.Edges.Endpoints {
-		// obtain connection based on the protocol.
-		connector, err := connectors.NewConnector(ep)
-		if err != nil {
-			// we've hit an unsupported protocol case, skipping the rest of the iteration
-			continue
-		}
-		// retrieve device status.
-		s, err := connector.GetStatus(ctx)
-		if err != nil {
-			// failed to retrieve status, proceeding with other the endpoint.
-			// assuming that error is already logged in within the function.
-			continue
-		}
-		// device status was retrieved, break the loop and perform an update.
-		aliveConnectionFound = true
-		status = s // assuming that any live connection is different from down
-		cal = 0    // successful attempt is registered, zeroing counter back
-
-		// checking HW version
-		hwV, _ = connector.GetHWVersion(ctx)
-		// in case of error, assuming that error is already logged in within the function.
-		// even if this call fails, continue to retrieve the other versions.
-		// DB client will do sanity check and skip default values.
-
-		swV, _ = connector.GetSWVersion(ctx)
-		// in case of error, assuming that error is already logged in within the function.
-		// even if this call fails, continue to retrieve the other version.
-		// DB client will do sanity check and skip default values.
+	// unreachable device is read as down
+	reading := devicestatus.StatusSTATUS_DEVICE_DOWN
+	answered, failed := m.probeEndpoints(ctx, networkDevice.Edges.Endpoints)
+	m.recordEndpointHealth(ctx, networkDevice.ID, answered, failed)
+	aliveConnectionFound := answered != nil
+	var answeredEndpoint *ent.Endpoint
+	checksumSupplied := true
+	if aliveConnectionFound {
+		// device status was retrieved, performing an update.
+		answeredEndpoint = answered.endpoint
+		checksumSupplied = answered.checksumSupplied
+		snapshot := answered.snapshot
+		reading = snapshot.Status
+		cal = 0 // successful attempt is registered, zeroing counter back
 
-		fwV, _ = connector.GetFWVersion(ctx)
-		// in case of error, assuming that error is already logged in within the function.
-		// even if this call fails, continue to retrieve the other versions.
+		// even if some of the versions were not retrieved, keeping the rest of them.
 		// DB client will do sanity check and skip default values.
-
-		// no need in further sniffing of other endpoints
-		break
+		hwV = snapshot.HWVersion
+		swV = snapshot.SWVersion
+		fwV = snapshot.FWVersion
+		for part, partErr := range snapshot.Errors {
+			if errors.Is(partErr, connectors.ErrUnsupported) {
+				// protocol doesn't report the version (e.g., reachability probes), device has not failed
+				zlog.Debug().Err(partErr).Msgf("Skipping %s of network device (%s)", part, networkDevice.ID)
+				continue
+			}
+			zlog.Warn().Err(partErr).Msgf("Failed to retrieve %s of network device (%s)", part, networkDevice.ID)
+		}
 	}
 
 	lastSeen := time.Now().String()
+	required := m.hysteresisReadings
+	reason := "device reported " + strings.ToLower(strings.TrimPrefix(string(reading), "STATUS_DEVICE_"))
+	// classified error of the failed poll, empty when the device has answered
+	lastError := ""
+	counted := true
 	if !aliveConnectionFound {
-		// no alive endpoint was found, updating device status to down state and resetting timestamp.
+		// no alive endpoint was found, resetting timestamp.
 		lastSeen = ""
-		cal++ // increasing counter that indicates that number of consequential attempts has increased
+		var pollErr error
+		counted, pollErr = pollFailure(failed)
+		lastError = pollErr.Error()
+		if counted {
+			// unreachable device is reported down, only when it was not communicating more than specified number
+			// of times in a row
+			required = max(required, m.connectivityAbsenceThreshold)
+			cal++ // increasing counter that indicates that number of consequential attempts has increased
+			reason = fmt.Sprintf("%d failed attempts, last error: %s", cal, lastError)
+			// device behind the parent, which is down, can't be reached, it is not down on its own
+			if parentID, down := m.parentDown(ctx, networkDevice.ID); down {
+				reading = devicestatus.StatusSTATUS_DEVICE_UNREACHABLE
+				reason = fmt.Sprintf("parent (%s) is down", parentID)
+			}
+		} else {
+			// e.g., credentials were rejected, device was reached, but it can't be polled, it is read as unhealthy
+			reading = FailureReading(pollErr)
+			zlog.Warn().Msgf("Failed to poll network device (%s), not counting it as a failed attempt: %s", networkDevice.ID, lastError)
+			reason = "attempt not counted, " + lastError
+		}
+	}
+	// transition happens only after the required number of consecutive readings of the same status
+	prevStatus := state.Status
+	transitioned := false
+	if !counted && reading == devicestatus.StatusSTATUS_UNSPECIFIED && state.Status != "" {
+		// failure, which doesn't tell anything about the device, is not a reading of its status, device keeps its status.
+		// new device is recorded with unspecified status
+		zlog.Debug().Msgf("Network device (%s) has failed for a reason, which doesn't tell its status, keeping its status", networkDevice.ID)
+	} else if inMaintenance && (reading == devicestatus.StatusSTATUS_DEVICE_DOWN || reading == devicestatus.StatusSTATUS_DEVICE_UNREACHABLE) &&
+		state.Status != "" {
+		// DOWN (and UNREACHABLE) transitions are suppressed during maintenance, device keeps its status
+		zlog.Debug().Msgf("Network device (%s) is in maintenance, not reporting it down", networkDevice.ID)
+	} else {
+		state, transitioned = state.Apply(reading, required)
+	}
+	if inMaintenance {
+		reason += " (in maintenance)"
 	}
-	// performing check if device was not communicating more than specified number of times in a row
-	if cal >= m.connectivityAbsenceThreshold { // counter starts from 0.
-		// threshold is reached, reporting that network device is down
-		status = devicestatus.StatusSTATUS_DEVICE_DOWN
+
+	// device is flapping, when it has changed its status too many times within the window.
+	// device in maintenance is expected to change its status, it is not flagged.
+	if inMaintenance {
+		flapping = false
+	} else if m.flapThreshold > 0 && (transitioned || flapping) {
+		transitions, err := db.CountStatusTransitions(ctx, m.dbClient, networkDevice.ID, time.Now().Add(-m.flapWindow))
+		if err == nil {
+			// initial status of the device is not a transition
+			if transitioned && prevStatus != "" && prevStatus != devicestatus.StatusSTATUS_UNSPECIFIED {
+				transitions++
+			}
+			flapping = transitions > m.flapThreshold
+		}
+		// error is already logged in in the internal function
 	}
 
-	// alive connection was found and status was fetched (and already fixed, updating device status
-	_, _ = db.UpdateDeviceStatusByNetworkDeviceID(ctx, m.dbClient, networkDevice.ID, status, lastSeen, cal)
+	// scheduling next poll, it is persisted, so that the backoff survives restarts.
+	// recovered device (cal is zeroed) is polled at the regular period again.
+	nextPollDelay := pollInterval
+	if backoffDelay := m.backoff.Delay(cal); backoffDelay > nextPollDelay {
+		nextPollDelay = backoffDelay
+	}
+	nextPoll := time.Now().Add(nextPollDelay)
+
+	// outcome of the poll is stored at once
+	ds := &ent.DeviceStatus{
+		Status:                                  state.Status,
+		LastSeen:                                lastSeen,
+		ConsequentialFailedConnectivityAttempts: cal,
+		NextPoll:                                nextPoll.UnixMilli(),
+		PendingStatus:                           devicestatus.PendingStatus(state.PendingStatus),
+		PendingReadings:                         state.PendingReadings,
+		Flapping:                                flapping,
+		InMaintenance:                           inMaintenance,
+		LastError:                               lastError,
+	}
+	if answeredEndpoint != nil {
+		ds.AnsweredEndpointID = answeredEndpoint.ID
+		ds.AnsweredProtocol = devicestatus.AnsweredProtocol(answeredEndpoint.Protocol)
+	}
+	_, _ = db.UpdateDeviceStatusResource(ctx, m.dbClient, networkDevice.ID, ds, reason)
 	// error is already logged in in the internal function
 
+	// custom connectors may not fill in all versions
+	if swV == nil {
+		swV = &ent.Version{}
+	}
+	if fwV == nil {
+		fwV = &ent.Version{}
+	}
+	if hwV == "" && swV.Version == "" && fwV.Version == "" {
+		// device has not reported any version (e.g., it is probed for reachability only), keeping the stored ones
+		return nextPoll
+	}
 	// conducting checksum verifications
-	err = m.verifyChecksum(swV)
+	err = m.verifyChecksum(swV, checksumSupplied)
 	if err != nil {
 		// resetting SW version, do not updating it in the DB
 		swV = &ent.Version{}
 	}
-	err = m.verifyChecksum(fwV)
+	err = m.verifyChecksum(fwV, checksumSupplied)
 	if err != nil {
 		// resetting FW version, do not updating it in the DB
 		fwV = &ent.Version{}
@@ -245,15 +679,23 @@ func (m *Manager) processNetworkDevice(ctx context.Context, networkDevice *ent.N
 	// updating HW, SW, and FW versions
 	_, _ = db.UpdateNetworkDeviceVersions(ctx, m.dbClient, networkDevice.ID, hwV, swV, fwV)
 	// error is already logged in in the internal function
+	return nextPoll
 }
 
-// verifyChecksum runs checksum verification against checksum generator binary.
-func (m *Manager) verifyChecksum(version *ent.Version) error {
+// verifyChecksum runs checksum verification against checksum generator binary. Checksum of the version, which
+// connector can't supply, is generated instead.
+func (m *Manager) verifyChecksum(version *ent.Version, checksumSupplied bool) error {
 	checksumGen, err := m.checksumGenerator.Generate([]byte(version.Version))
 	if err != nil {
 		// failed generating checksum, assuming that error is logged in internally in function
 		return err
 	}
+	if !checksumSupplied && version.Version != "" && version.Checksum == "" {
+		// connector has declared, that its protocol (e.g., SNMP) does not report checksum,
+		// nothing to verify against, storing the generated one
+		version.Checksum = checksumGen
+		return nil
+	}
 	// comparing checksums, they should be identical
 	if checksumGen != version.Checksum {
 		// checksums are different, reporting error
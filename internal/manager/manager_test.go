@@ -3,13 +3,23 @@ package manager_test
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	apiv1 "github.com/eroshiva/trade-show-poc/api/v1"
 	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
 	"github.com/eroshiva/trade-show-poc/internal/manager"
 	"github.com/eroshiva/trade-show-poc/internal/server"
 	"github.com/eroshiva/trade-show-poc/pkg/checksum"
@@ -17,6 +27,7 @@ import (
 	"github.com/eroshiva/trade-show-poc/pkg/connectors"
 	simulatorv1 "github.com/eroshiva/trade-show-poc/pkg/mocks"
 	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -73,24 +84,23 @@ func TestMainControlLoop(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 4*monitoring_testing.DefaultTestTimeout)
 	t.Cleanup(cancel)
 
-	ds1 := simulatorv1.NewDeviceSimulator()
-	ds2 := simulatorv1.NewDeviceSimulator()
 	ds3 := simulatorv1.NewDeviceSimulator()
-	ds4 := simulatorv1.NewDeviceSimulator()
 
-	// starting first simulator
-	t.Setenv(simulatorv1.EnvServerAddress, connectors.CraftServerAddress(host1, port1))
-	ds1.StartNetworkDeviceSimulator()
+	// first device is a NETCONF device, starting NETCONF server
+	netconfServer := simulatorv1.NewNETCONFServer()
+	t.Setenv(simulatorv1.EnvNETCONFServerAddress, connectors.CraftServerAddress(host1, port1))
+	netconfServer.StartNETCONFServer()
 	t.Cleanup(func() {
-		ds1.StopNetworkDeviceSimulator()
+		netconfServer.StopNETCONFServer()
 	})
 	time.Sleep(100 * time.Millisecond) // giving some time for the server to bootup
 
-	// starting second simulator
-	t.Setenv(simulatorv1.EnvServerAddress, connectors.CraftServerAddress(host2, port2))
-	ds2.StartNetworkDeviceSimulator()
+	// second device is a RESTCONF device, starting RESTCONF server
+	restconfServer := simulatorv1.NewRESTCONFServer()
+	t.Setenv(simulatorv1.EnvRESTCONFServerAddress, connectors.CraftServerAddress(host2, port2))
+	restconfServer.StartRESTCONFServer()
 	t.Cleanup(func() {
-		ds2.StopNetworkDeviceSimulator()
+		restconfServer.StopRESTCONFServer()
 	})
 	time.Sleep(100 * time.Millisecond) // giving some time for the server to bootup
 
@@ -102,21 +112,35 @@ func TestMainControlLoop(t *testing.T) {
 	})
 	time.Sleep(100 * time.Millisecond) // giving some time for the server to bootup
 
-	// starting second simulator
-	t.Setenv(simulatorv1.EnvServerAddress, connectors.CraftServerAddress(host4, port4))
-	ds4.StartNetworkDeviceSimulator()
+	// third device is an SNMP device, starting SNMP agent on the same port
+	snmpAgent := simulatorv1.NewSNMPAgent()
+	t.Setenv(simulatorv1.EnvSNMPAgentAddress, connectors.CraftServerAddress(host3, port3))
+	snmpAgent.StartSNMPAgent()
 	t.Cleanup(func() {
-		ds4.StopNetworkDeviceSimulator()
+		snmpAgent.StopSNMPAgent()
+	})
+	time.Sleep(100 * time.Millisecond) // giving some time for the agent to bootup
+
+	// fourth device is an Open vSwitch device, starting OVSDB server
+	ovsdbServer := simulatorv1.NewOVSDBServer()
+	t.Setenv(simulatorv1.EnvOVSDBServerAddress, connectors.CraftServerAddress(host4, port4))
+	ovsdbServer.StartOVSDBServer()
+	t.Cleanup(func() {
+		ovsdbServer.StopOVSDBServer()
 	})
 	time.Sleep(100 * time.Millisecond) // giving some time for the server to bootup
 
 	// initial setup is complete, now starting the main control loop
 	// first, creating mock checksum generator
 	checksumGen := checksum.NewMockGenerator()
+	// disabling backoff, so that unreachable devices are polled on every round
+	t.Setenv(manager.EnvBackoffBase, "0")
+	// fewer workers than devices, so that devices are queued
+	t.Setenv(manager.EnvWorkers, "2")
 	// creating SB handler
 	sbManager := manager.NewManager(client, checksumGen)
 	// performing one round of SB handler control loop
-	sbManager.PerformControlLoopRoutine(testControlLoopPeriod)
+	sbManager.PerformControlLoopRoutine(ctx, testControlLoopPeriod)
 
 	// waiting until all goroutines would finish
 	time.Sleep(testControlLoopPeriod + delta)
@@ -126,10 +150,44 @@ func TestMainControlLoop(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, ndList.GetDevices(), 0)
 
+	// NETCONF device requires credentials, its host key is generated at random on each start of the server
+	key := make([]byte, 32)
+	_, err = rand.Read(key)
+	require.NoError(t, err)
+	t.Setenv(db.EnvCredentialsKey, base64.StdEncoding.EncodeToString(key))
+	db.ReloadCredentialsKey()
+	t.Cleanup(db.ReloadCredentialsKey)
+	created, err := grpcClient.CreateCredentialProfile(ctx, server.CreateCredentialProfileRequest(&apiv1.CredentialProfile{
+		Name:                     "netconf-" + uuid.NewString(),
+		Username:                 "admin",
+		Password:                 "admin",
+		SshInsecureIgnoreHostKey: true,
+	}))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := grpcClient.DeleteCredentialProfile(context.Background(),
+			server.CreateDeleteCredentialProfileRequest(created.GetProfile().GetId()))
+		assert.NoError(t, err)
+	})
+
+	// SNMP device requires community, there is no default one
+	createdSNMP, err := grpcClient.CreateCredentialProfile(ctx, server.CreateCredentialProfileRequest(&apiv1.CredentialProfile{
+		Name:          "snmp-" + uuid.NewString(),
+		SnmpCommunity: "public",
+	}))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := grpcClient.DeleteCredentialProfile(context.Background(),
+			server.CreateDeleteCredentialProfileRequest(createdSNMP.GetProfile().GetId()))
+		assert.NoError(t, err)
+	})
+
 	// creating endpoints
 	ep1 := server.CreateEndpoint(host1, port1, apiv1.Protocol_PROTOCOL_NETCONF)
+	ep1.CredentialProfile = &apiv1.CredentialProfile{Id: created.GetProfile().GetId()}
 	ep2 := server.CreateEndpoint(host2, port2, apiv1.Protocol_PROTOCOL_RESTCONF)
 	ep3 := server.CreateEndpoint(host3, port3, apiv1.Protocol_PROTOCOL_SNMP)
+	ep3.CredentialProfile = &apiv1.CredentialProfile{Id: createdSNMP.GetProfile().GetId()}
 	ep4 := server.CreateEndpoint(host4, port4, apiv1.Protocol_PROTOCOL_OPEN_V_SWITCH)
 	// creating add network device request
 	req1 := server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_UBIQUITI, "XYZ", []*apiv1.Endpoint{ep1})
@@ -194,7 +252,7 @@ func TestMainControlLoop(t *testing.T) {
 	require.Nil(t, retDS4)
 
 	// running another iteration of control loop
-	sbManager.PerformControlLoopRoutine(testControlLoopPeriod)
+	sbManager.PerformControlLoopRoutine(ctx, testControlLoopPeriod)
 
 	// waiting until all goroutines would finish
 	time.Sleep(testControlLoopPeriod + delta)
@@ -205,6 +263,8 @@ func TestMainControlLoop(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, retDS1)
 	assert.Equal(t, retDS1.GetStatus().GetStatus().String(), apiv1.Status_STATUS_DEVICE_UP.String())
+	// next poll is scheduled
+	assert.NotEmpty(t, retDS1.GetStatus().GetNextPoll())
 
 	// second device should be with up status
 	retDS2, err = grpcClient.GetDeviceStatus(ctx, dsReq2)
@@ -233,6 +293,18 @@ func TestMainControlLoop(t *testing.T) {
 	assert.Equal(t, summary.GetDevicesUnhealthy(), int32(0))
 	assert.Equal(t, summary.GetDownDevices(), int32(0))
 
+	// queue is drained, once the routine is over
+	metricsReq, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+defaultHTTPTestServerAddress+"/metrics", nil)
+	require.NoError(t, err)
+	metricsResp, err := http.DefaultClient.Do(metricsReq)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, metricsResp.Body.Close())
+	})
+	metrics, err := io.ReadAll(metricsResp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(metrics), "monitoring_control_loop_queue_depth 0")
+
 	// now, changing environmental value, that is responsible for promoting Device State value to Network Device Simulator.
 	t.Setenv(simulatorv1.EnvDeviceStatus, simulatorv1.DeviceStatusDOWN)
 	// All device simulators, since they share the same environment, should report devices in down state
@@ -241,12 +313,12 @@ func TestMainControlLoop(t *testing.T) {
 	// they will be reported in the DOWN state.
 
 	// running another iteration of control loop
-	sbManager.PerformControlLoopRoutine(testControlLoopPeriod)
+	sbManager.PerformControlLoopRoutine(ctx, testControlLoopPeriod)
 	// waiting until all goroutines would finish
 	time.Sleep(testControlLoopPeriod + delta)
 
 	// repeating one more time
-	sbManager.PerformControlLoopRoutine(testControlLoopPeriod)
+	sbManager.PerformControlLoopRoutine(ctx, testControlLoopPeriod)
 	time.Sleep(testControlLoopPeriod + delta)
 
 	// Checking that all devices are still in the UP state.
@@ -255,6 +327,10 @@ func TestMainControlLoop(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, retDS1)
 	assert.Equal(t, retDS1.GetStatus().GetStatus().String(), apiv1.Status_STATUS_DEVICE_UP.String())
+	// transition to the DOWN state is pending
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_DOWN, retDS1.GetStatus().GetPendingStatus())
+	assert.Equal(t, int32(2), retDS1.GetStatus().GetPendingReadings())
+	assert.False(t, retDS1.GetStatus().GetFlapping())
 
 	// second device should be with up status
 	retDS2, err = grpcClient.GetDeviceStatus(ctx, dsReq2)
@@ -275,7 +351,7 @@ func TestMainControlLoop(t *testing.T) {
 	assert.Equal(t, retDS4.GetStatus().GetStatus().String(), apiv1.Status_STATUS_DEVICE_UP.String())
 
 	// running another iteration of control loop
-	sbManager.PerformControlLoopRoutine(testControlLoopPeriod)
+	sbManager.PerformControlLoopRoutine(ctx, testControlLoopPeriod)
 	// waiting until all goroutines would finish
 	time.Sleep(testControlLoopPeriod + delta)
 
@@ -335,3 +411,444 @@ func TestMainControlLoop(t *testing.T) {
 	require.NotNil(t, delResp4)
 	assert.True(t, delResp4.GetDeleted())
 }
+
+func TestLeaderElection(t *testing.T) {
+	t.Setenv(manager.EnvLeaseDuration, "1")
+	checksumGen := checksum.NewMockGenerator()
+	replica1 := manager.NewManager(client, checksumGen)
+	replica2 := manager.NewManager(client, checksumGen)
+
+	replica1.StartManager()
+	time.Sleep(100 * time.Millisecond) // giving some time for the first replica to acquire the lease
+	replica2.StartManager()
+	t.Cleanup(replica2.StopManager)
+
+	// only one replica runs main control loop
+	time.Sleep(time.Second)
+	assert.True(t, replica1.IsLeader())
+	assert.False(t, replica2.IsLeader())
+
+	// leader steps down, second replica takes over within the lease duration
+	replica1.StopManager()
+	assert.Eventually(t, func() bool {
+		return !replica1.IsLeader() && replica2.IsLeader()
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
+func TestSharding(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 4*monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+	t.Setenv(manager.EnvShardingEnabled, "true")
+	t.Setenv(manager.EnvHeartbeatTimeout, "1")
+
+	// creating network devices, which are sharded among the replicas
+	ndIDs := make([]string, 0)
+	for range 20 {
+		nd, err := db.CreateNetworkDevice(ctx, client, "XYZ", networkdevice.VendorVENDOR_UBIQUITI, []*ent.Endpoint{})
+		require.NoError(t, err)
+		ndIDs = append(ndIDs, nd.ID)
+	}
+	t.Cleanup(func() {
+		for _, id := range ndIDs {
+			assert.NoError(t, db.DeleteNetworkDeviceByID(context.Background(), client, id))
+		}
+	})
+
+	checksumGen := checksum.NewMockGenerator()
+	replica1 := manager.NewManager(client, checksumGen)
+	replica2 := manager.NewManager(client, checksumGen)
+	replica1.StartManager()
+	replica2.StartManager()
+	t.Cleanup(replica2.StopManager)
+
+	// each network device is owned by exactly one replica
+	owners := func() (int, int) {
+		owned1, owned2 := 0, 0
+		for _, id := range ndIDs {
+			if replica1.InShard(id) {
+				owned1++
+			}
+			if replica2.InShard(id) {
+				owned2++
+			}
+		}
+		return owned1, owned2
+	}
+	assert.Eventually(t, func() bool {
+		owned1, owned2 := owners()
+		return owned1 > 0 && owned2 > 0 && owned1+owned2 == len(ndIDs)
+	}, 2*time.Second, 50*time.Millisecond)
+	assert.False(t, replica1.IsLeader())
+	assert.False(t, replica2.IsLeader())
+
+	// once the replica leaves, the other one takes over all its network devices
+	replica1.StopManager()
+	assert.Eventually(t, func() bool {
+		_, owned2 := owners()
+		return owned2 == len(ndIDs)
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
+func TestMaintenance(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 4*monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+	// unreachable device is reported down right away, unless it is in maintenance
+	t.Setenv(manager.EnvBackoffBase, "0")
+	t.Setenv(manager.EnvConnectivityAbsenceLimit, "1")
+	t.Setenv(manager.EnvHysteresisReadings, "1")
+
+	// nothing listens on the endpoint of the network device
+	ep, err := db.CreateEndpoint(ctx, client, host1, port1, protocol1)
+	require.NoError(t, err)
+	nd, err := db.CreateNetworkDevice(ctx, client, "XYZ", networkdevice.VendorVENDOR_UBIQUITI, []*ent.Endpoint{ep})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(context.Background(), client, nd.ID))
+		assert.NoError(t, db.DeleteEndpointByID(context.Background(), client, ep.ID))
+	})
+	_, err = db.UpdateDeviceStatusByNetworkDeviceID(ctx, client, nd.ID, devicestatus.StatusSTATUS_DEVICE_UP, time.Now().String(), 0, "device reported up")
+	require.NoError(t, err)
+
+	// firmware upgrade is in progress
+	now := time.Now()
+	mwResp, err := grpcClient.CreateMaintenanceWindow(ctx, server.CreateMaintenanceWindowRequest("firmware upgrade",
+		now.Add(-time.Minute), now.Add(time.Hour), nd.ID))
+	require.NoError(t, err)
+
+	sbManager := manager.NewManager(client, checksum.NewMockGenerator())
+	sbManager.PerformControlLoopRoutine(ctx, testControlLoopPeriod)
+
+	// device is polled, but it is not reported down
+	dsReq := server.CreateGetDeviceStatusRequest(nd.ID, server.CreateEndpoint(host1, port1, apiv1.Protocol_PROTOCOL_NETCONF))
+	retDS, err := grpcClient.GetDeviceStatus(ctx, dsReq)
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_UP, retDS.GetStatus().GetStatus())
+	assert.True(t, retDS.GetStatus().GetInMaintenance())
+	assert.Equal(t, int32(1), retDS.GetStatus().GetConsequentialFailedConnectivityAttempts())
+
+	summary, err := grpcClient.GetSummary(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), summary.GetDevicesInMaintenance())
+	assert.Equal(t, int32(1), summary.GetDevicesUp())
+
+	// maintenance is over, device is reported down on the next poll
+	delResp, err := grpcClient.DeleteMaintenanceWindow(ctx, server.CreateDeleteMaintenanceWindowRequest(mwResp.GetWindow().GetId()))
+	require.NoError(t, err)
+	assert.True(t, delResp.GetDeleted())
+	time.Sleep(testControlLoopPeriod + delta)
+	sbManager.PerformControlLoopRoutine(ctx, testControlLoopPeriod)
+
+	retDS, err = grpcClient.GetDeviceStatus(ctx, dsReq)
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_DOWN, retDS.GetStatus().GetStatus())
+	assert.False(t, retDS.GetStatus().GetInMaintenance())
+
+	summary, err = grpcClient.GetSummary(ctx, nil)
+	require.NoError(t, err)
+	assert.Zero(t, summary.GetDevicesInMaintenance())
+	assert.Equal(t, int32(1), summary.GetDownDevices())
+}
+
+func TestTopology(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 4*monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+	// unreachable device is reported down (or unreachable) right away
+	t.Setenv(manager.EnvBackoffBase, "0")
+	t.Setenv(manager.EnvConnectivityAbsenceLimit, "1")
+	t.Setenv(manager.EnvHysteresisReadings, "1")
+	// nothing listens on the endpoints of both network devices
+	const childPort = "50159"
+
+	parentEP, err := db.CreateEndpoint(ctx, client, host1, port1, protocol1)
+	require.NoError(t, err)
+	parent, err := db.CreateNetworkDevice(ctx, client, "Gateway", networkdevice.VendorVENDOR_CISCO, []*ent.Endpoint{parentEP})
+	require.NoError(t, err)
+	childEP, err := db.CreateEndpoint(ctx, client, host1, childPort, protocol1)
+	require.NoError(t, err)
+	child, err := db.CreateNetworkDevice(ctx, client, "XYZ", networkdevice.VendorVENDOR_UBIQUITI, []*ent.Endpoint{childEP})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(context.Background(), client, child.ID))
+		assert.NoError(t, db.DeleteEndpointByID(context.Background(), client, childEP.ID))
+		assert.NoError(t, db.DeleteNetworkDeviceByID(context.Background(), client, parent.ID))
+		assert.NoError(t, db.DeleteEndpointByID(context.Background(), client, parentEP.ID))
+	})
+	_, err = db.UpdateDeviceStatusByNetworkDeviceID(ctx, client, parent.ID, devicestatus.StatusSTATUS_DEVICE_DOWN, "", 1, "1 failed attempts")
+	require.NoError(t, err)
+	_, err = db.UpdateDeviceStatusByNetworkDeviceID(ctx, client, child.ID, devicestatus.StatusSTATUS_DEVICE_UP, time.Now().String(), 0, "device reported up")
+	require.NoError(t, err)
+
+	// child is behind the gateway
+	resp, err := grpcClient.SetDeviceParent(ctx, server.CreateSetDeviceParentRequest(child.ID, parent.ID))
+	require.NoError(t, err)
+	assert.Equal(t, child.ID, resp.GetDevice().GetId())
+
+	sbManager := manager.NewManager(client, checksum.NewMockGenerator())
+	sbManager.PerformControlLoopRoutine(ctx, testControlLoopPeriod)
+
+	// parent remains down, child is unreachable
+	dsReq := server.CreateGetDeviceStatusRequest(parent.ID, server.CreateEndpoint(host1, port1, apiv1.Protocol_PROTOCOL_NETCONF))
+	retDS, err := grpcClient.GetDeviceStatus(ctx, dsReq)
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_DOWN, retDS.GetStatus().GetStatus())
+	dsReq = server.CreateGetDeviceStatusRequest(child.ID, server.CreateEndpoint(host1, childPort, apiv1.Protocol_PROTOCOL_NETCONF))
+	retDS, err = grpcClient.GetDeviceStatus(ctx, dsReq)
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_UNREACHABLE, retDS.GetStatus().GetStatus())
+
+	history, err := grpcClient.ListDeviceStatusHistory(ctx, server.CreateListDeviceStatusHistoryRequest(child.ID, nil, nil))
+	require.NoError(t, err)
+	require.NotEmpty(t, history.GetEvents())
+	assert.Equal(t, fmt.Sprintf("parent (%s) is down", parent.ID), history.GetEvents()[len(history.GetEvents())-1].GetReason())
+
+	summary, err := grpcClient.GetSummary(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), summary.GetDevicesUnreachable())
+
+	// child is detached from the gateway, it is reported down on the next poll
+	_, err = grpcClient.SetDeviceParent(ctx, server.CreateSetDeviceParentRequest(child.ID, ""))
+	require.NoError(t, err)
+	time.Sleep(testControlLoopPeriod + delta)
+	sbManager.PerformControlLoopRoutine(ctx, testControlLoopPeriod)
+
+	retDS, err = grpcClient.GetDeviceStatus(ctx, dsReq)
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_DOWN, retDS.GetStatus().GetStatus())
+}
+
+func TestPollDevice(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 4*monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	restconfServer := simulatorv1.NewRESTCONFServer()
+	t.Setenv(simulatorv1.EnvRESTCONFServerAddress, connectors.CraftServerAddress(host2, port2))
+	restconfServer.StartRESTCONFServer()
+	t.Cleanup(func() {
+		restconfServer.StopRESTCONFServer()
+	})
+	time.Sleep(100 * time.Millisecond) // giving some time for the server to bootup
+
+	ep := server.CreateEndpoint(host2, port2, apiv1.Protocol_PROTOCOL_RESTCONF)
+	res, err := grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_UBIQUITI, "XYZ", []*apiv1.Endpoint{ep}))
+	require.NoError(t, err)
+	ndID := res.GetDevice().GetId()
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(context.Background(), client, ndID))
+	})
+
+	// control loop has not run, device status is not set yet
+	_, err = grpcClient.GetDeviceStatus(ctx, server.CreateGetDeviceStatusRequest(ndID, ep))
+	require.Error(t, err)
+
+	// concurrent requests are collapsed into one poll, all of them get its result
+	const requests = 5
+	responses := make([]*apiv1.PollDeviceResponse, requests)
+	errs := make([]error, requests)
+	wg := &sync.WaitGroup{}
+	for i := range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[i], errs[i] = grpcClient.PollDevice(ctx, server.CreatePollDeviceRequest(ndID))
+		}()
+	}
+	wg.Wait()
+	for i := range requests {
+		require.NoError(t, errs[i])
+		assert.Equal(t, ndID, responses[i].GetDevice().GetId())
+		assert.Equal(t, apiv1.Status_STATUS_DEVICE_UP, responses[i].GetStatus().GetStatus())
+	}
+
+	// result of the poll is stored
+	retDS, err := grpcClient.GetDeviceStatus(ctx, server.CreateGetDeviceStatusRequest(ndID, ep))
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_UP, retDS.GetStatus().GetStatus())
+	assert.NotEmpty(t, retDS.GetStatus().GetLastSeen())
+
+	// device, which is being polled elsewhere (e.g., by the control loop of another replica), is not polled at once
+	const otherPoller = "other-replica"
+	acquired, err := db.AcquireLease(ctx, client, manager.DevicePollLeasePrefix+ndID, otherPoller, time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired)
+	waitCtx, waitCancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer waitCancel()
+	_, err = grpcClient.PollDevice(waitCtx, server.CreatePollDeviceRequest(ndID))
+	require.Error(t, err)
+	// once the other poll is done, device is polled
+	require.NoError(t, db.DeleteLease(ctx, client, manager.DevicePollLeasePrefix+ndID, otherPoller))
+	resp, err := grpcClient.PollDevice(ctx, server.CreatePollDeviceRequest(ndID))
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_UP, resp.GetStatus().GetStatus())
+
+	// fail - network device does not exist
+	_, err = grpcClient.PollDevice(ctx, server.CreatePollDeviceRequest(uuid.NewString()))
+	require.Error(t, err)
+	// fail - ID is not specified
+	_, err = grpcClient.PollDevice(ctx, server.CreatePollDeviceRequest(""))
+	require.Error(t, err)
+}
+
+func TestEndpointProbing(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 4*monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	restconfServer := simulatorv1.NewRESTCONFServer()
+	t.Setenv(simulatorv1.EnvRESTCONFServerAddress, connectors.CraftServerAddress(host2, port2))
+	restconfServer.StartRESTCONFServer()
+	t.Cleanup(func() {
+		restconfServer.StopRESTCONFServer()
+	})
+	time.Sleep(100 * time.Millisecond) // giving some time for the server to bootup
+
+	// nothing listens on the preferred endpoint
+	const deadPort = "50159"
+	preferred := server.CreateEndpoint(host1, deadPort, apiv1.Protocol_PROTOCOL_NETCONF)
+	preferred.Preference = 1
+	fallback := server.CreateEndpoint(host2, port2, apiv1.Protocol_PROTOCOL_RESTCONF)
+	fallback.Preference = 2
+	// endpoints are probed in the order of their preference, not in the order they were listed
+	res, err := grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_UBIQUITI, "XYZ",
+		[]*apiv1.Endpoint{fallback, preferred}))
+	require.NoError(t, err)
+	ndID := res.GetDevice().GetId()
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(context.Background(), client, ndID))
+	})
+	var fallbackID, preferredID string
+	for _, ep := range res.GetDevice().GetEndpoints() {
+		if ep.GetPort() == port2 {
+			fallbackID = ep.GetId()
+			assert.Equal(t, int32(2), ep.GetPreference())
+		} else {
+			preferredID = ep.GetId()
+		}
+	}
+	require.NotEmpty(t, fallbackID)
+	require.NotEmpty(t, preferredID)
+
+	// fallback endpoint answers, once the preferred one has failed
+	pollResp, err := grpcClient.PollDevice(ctx, server.CreatePollDeviceRequest(ndID))
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_UP, pollResp.GetStatus().GetStatus())
+	assert.Equal(t, fallbackID, pollResp.GetStatus().GetAnsweredEndpointId())
+	assert.Equal(t, apiv1.Protocol_PROTOCOL_RESTCONF, pollResp.GetStatus().GetAnsweredProtocol())
+
+	// health of both endpoints is tracked
+	healthResp, err := grpcClient.GetEndpointHealth(ctx, server.CreateGetEndpointHealthRequest(fallbackID))
+	require.NoError(t, err)
+	assert.NotZero(t, healthResp.GetHealth().GetLastSuccess())
+	assert.Zero(t, healthResp.GetHealth().GetFailureCount())
+	healthResp, err = grpcClient.GetEndpointHealth(ctx, server.CreateGetEndpointHealthRequest(preferredID))
+	require.NoError(t, err)
+	assert.Zero(t, healthResp.GetHealth().GetLastSuccess())
+	assert.NotZero(t, healthResp.GetHealth().GetLastFailure())
+	assert.NotEmpty(t, healthResp.GetHealth().GetLastError())
+	assert.Equal(t, int32(1), healthResp.GetHealth().GetFailureCount())
+
+	// latency of the exchange is sampled over the endpoint, which has answered
+	stats, err := grpcClient.GetLatencyStats(ctx, server.CreateGetLatencyStatsRequest(ndID, fallbackID, nil, nil))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), stats.GetSamples())
+	stats, err = grpcClient.GetLatencyStats(ctx, server.CreateGetLatencyStatsRequest(ndID, preferredID, nil, nil))
+	require.NoError(t, err)
+	assert.Zero(t, stats.GetSamples())
+
+	// none of the endpoints answers
+	restconfServer.StopRESTCONFServer()
+	pollResp, err = grpcClient.PollDevice(ctx, server.CreatePollDeviceRequest(ndID))
+	require.NoError(t, err)
+	assert.Empty(t, pollResp.GetStatus().GetAnsweredEndpointId())
+	assert.Equal(t, apiv1.Protocol_PROTOCOL_UNSPECIFIED, pollResp.GetStatus().GetAnsweredProtocol())
+
+	// failures are counted consecutively
+	healthResp, err = grpcClient.GetEndpointHealth(ctx, server.CreateGetEndpointHealthRequest(preferredID))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), healthResp.GetHealth().GetFailureCount())
+	healthResp, err = grpcClient.GetEndpointHealth(ctx, server.CreateGetEndpointHealthRequest(fallbackID))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), healthResp.GetHealth().GetFailureCount())
+	assert.NotZero(t, healthResp.GetHealth().GetLastSuccess())
+}
+
+func TestReachabilityProbe(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	// device offers no management protocol, it only accepts TCP connections
+	const tcpPort = "50161"
+	lis, err := net.Listen("tcp", connectors.CraftServerAddress(host1, tcpPort))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = lis.Close()
+	})
+
+	res, err := grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_CISCO, "XYZ",
+		[]*apiv1.Endpoint{server.CreateEndpoint(host1, tcpPort, apiv1.Protocol_PROTOCOL_TCP_CONNECT)}))
+	require.NoError(t, err)
+	ndID := res.GetDevice().GetId()
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(context.Background(), client, ndID))
+	})
+
+	// device is UP, missing versions are not counted as a failure
+	pollResp, err := grpcClient.PollDevice(ctx, server.CreatePollDeviceRequest(ndID))
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_UP, pollResp.GetStatus().GetStatus())
+	assert.Zero(t, pollResp.GetStatus().GetConsequentialFailedConnectivityAttempts())
+	assert.Equal(t, apiv1.Protocol_PROTOCOL_TCP_CONNECT, pollResp.GetStatus().GetAnsweredProtocol())
+	assert.Empty(t, pollResp.GetDevice().GetHwVersion())
+	assert.Empty(t, pollResp.GetDevice().GetSwVersion().GetVersion())
+
+	// device stops accepting connections
+	require.NoError(t, lis.Close())
+	pollResp, err = grpcClient.PollDevice(ctx, server.CreatePollDeviceRequest(ndID))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), pollResp.GetStatus().GetConsequentialFailedConnectivityAttempts())
+}
+
+func TestFailureClassification(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	const restconfPort = "50162"
+	restconfServer := simulatorv1.NewRESTCONFServer()
+	t.Setenv(simulatorv1.EnvRESTCONFServerAddress, connectors.CraftServerAddress(host1, restconfPort))
+	restconfServer.StartRESTCONFServer()
+	t.Cleanup(func() {
+		restconfServer.StopRESTCONFServer()
+	})
+	time.Sleep(100 * time.Millisecond) // giving some time for the server to bootup
+
+	res, err := grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_JUNIPER, "XYZ",
+		[]*apiv1.Endpoint{server.CreateEndpoint(host1, restconfPort, apiv1.Protocol_PROTOCOL_RESTCONF)}))
+	require.NoError(t, err)
+	ndID := res.GetDevice().GetId()
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(context.Background(), client, ndID))
+	})
+
+	pollResp, err := grpcClient.PollDevice(ctx, server.CreatePollDeviceRequest(ndID))
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_UP, pollResp.GetStatus().GetStatus())
+	assert.Empty(t, pollResp.GetStatus().GetLastError())
+
+	// device starts to require credentials, which the endpoint doesn't carry. It is reachable, failure doesn't count,
+	// but the device can't be monitored, it is reported unhealthy after the required number of readings
+	t.Setenv(simulatorv1.EnvRESTCONFToken, "restconf-token")
+	pollResp, err = grpcClient.PollDevice(ctx, server.CreatePollDeviceRequest(ndID))
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_UP, pollResp.GetStatus().GetStatus())
+	assert.Zero(t, pollResp.GetStatus().GetConsequentialFailedConnectivityAttempts())
+	assert.True(t, strings.HasPrefix(pollResp.GetStatus().GetLastError(), string(connectors.ErrorKindAuthFailed)))
+	pollResp, err = grpcClient.PollDevice(ctx, server.CreatePollDeviceRequest(ndID))
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_UNHEALTHY, pollResp.GetStatus().GetStatus())
+	assert.Zero(t, pollResp.GetStatus().GetConsequentialFailedConnectivityAttempts())
+
+	// device can't be reached anymore, failure counts
+	restconfServer.StopRESTCONFServer()
+	pollResp, err = grpcClient.PollDevice(ctx, server.CreatePollDeviceRequest(ndID))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), pollResp.GetStatus().GetConsequentialFailedConnectivityAttempts())
+	assert.True(t, strings.HasPrefix(pollResp.GetStatus().GetLastError(), string(connectors.ErrorKindUnreachable)))
+}
@@ -0,0 +1,17 @@
+// Package manager implements SB control loop, that fetches data from the devices and stores it to the DB.
+package manager
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const metricsNamespace = "monitoring"
+
+// queueDepth reports number of network devices, which are waiting for a free worker.
+var queueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+	Namespace: metricsNamespace,
+	Subsystem: "control_loop",
+	Name:      "queue_depth",
+	Help:      "Number of network devices waiting for a free worker.",
+})
@@ -0,0 +1,118 @@
+// Package manager implements SB control loop, that fetches data from the devices and stores it to the DB.
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/pkg/client/db"
+	"github.com/google/uuid"
+)
+
+const (
+	// DevicePollLeasePrefix is a prefix of the name of the lease, which holder polls the network device. Name of the
+	// lease is completed with ID of the network device.
+	DevicePollLeasePrefix = "poll/"
+
+	// devicePollRetry is a period, after which the lease of the network device, which is being polled, is tried again.
+	devicePollRetry = 100 * time.Millisecond
+)
+
+// tryLockDevice acquires the lease of the network device, so that the device is polled by a single poll at a time,
+// either on demand or by the control loop, on any of the replicas. Lease expires after the device timeout, when
+// its holder dies. It returns a function, which releases the lease, or nil, when the device is being polled already.
+func (m *Manager) tryLockDevice(ctx context.Context, networkDeviceID string) (func(), error) {
+	name := DevicePollLeasePrefix + networkDeviceID
+	// each poll holds the lease on its own, also within the same replica
+	holder := m.identity + "-" + uuid.NewString()[:8]
+	acquired, err := db.AcquireLease(ctx, m.dbClient, name, holder, m.deviceTimeout)
+	if err != nil {
+		// error is already logged in in the inner function
+		return nil, err
+	}
+	if !acquired {
+		return nil, nil
+	}
+	return func() {
+		// lease is released, even when the poll has run out of time
+		ctx, cancel := context.WithTimeout(context.Background(), m.rpcTimeout)
+		defer cancel()
+		_ = db.DeleteLease(ctx, m.dbClient, name, holder)
+		// error is already logged in in the inner function
+	}, nil
+}
+
+// lockDevice waits, until the network device is not polled by anyone else, and acquires its lease.
+func (m *Manager) lockDevice(ctx context.Context, networkDeviceID string) (func(), error) {
+	ticker := time.NewTicker(devicePollRetry)
+	defer ticker.Stop()
+	for {
+		release, err := m.tryLockDevice(ctx, networkDeviceID)
+		if err != nil || release != nil {
+			return release, err
+		}
+		zlog.Debug().Msgf("Network device (%s) is being polled, waiting for the poll to finish", networkDeviceID)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			err = fmt.Errorf("network device (%s) is being polled: %w", networkDeviceID, ctx.Err())
+			zlog.Error().Err(err).Msgf("Failed to poll network device (%s)", networkDeviceID)
+			return nil, err
+		}
+	}
+}
+
+// PollDevice polls the network device right away, regardless of its schedule, and stores the result in the DB.
+// Concurrent polls of the same network device are collapsed into one. Poll waits for the poll of the control loop
+// (of any replica), which is in progress. It returns the network device with its fresh versions together with its
+// fresh status.
+func (m *Manager) PollDevice(ctx context.Context, id string) (*ent.NetworkDevice, *ent.DeviceStatus, error) {
+	zlog.Debug().Msgf("Polling network device (%s) on demand", id)
+	// callers, which arrive while the poll is in progress, are waiting for its result
+	resChan := m.polls.DoChan(id, func() (any, error) {
+		// poll is not bound to the caller, which has started it, so that it is not cut short for the others.
+		// waiting for the poll in progress is bounded the same way as the poll itself
+		waitCtx, cancelWait := context.WithTimeout(context.Background(), m.deviceTimeout)
+		defer cancelWait()
+		release, err := m.lockDevice(waitCtx, id)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		ctx, cancel := context.WithTimeout(context.Background(), m.deviceTimeout)
+		defer cancel()
+		nd, err := db.GetNetworkDeviceByID(ctx, m.dbClient, id)
+		if err != nil {
+			return nil, err
+		}
+		ndList := []*ent.NetworkDevice{nd}
+		intervals := m.pollIntervals(ctx, ndList, m.controlLoopTick)
+		inMaintenance := m.maintenance(ctx, ndList)
+		m.processNetworkDevice(ctx, nd, intervals[nd.ID], inMaintenance[nd.ID], true)
+		return nil, nil
+	})
+	select {
+	case res := <-resChan:
+		if res.Err != nil {
+			// error is already logged in in the inner function
+			return nil, nil, res.Err
+		}
+	case <-ctx.Done():
+		zlog.Error().Err(ctx.Err()).Msgf("Failed to wait for the poll of network device (%s)", id)
+		return nil, nil, ctx.Err()
+	}
+
+	// reading the result of the poll back
+	nd, err := db.GetNetworkDeviceByID(ctx, m.dbClient, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	ds, err := db.GetDeviceStatusByNetworkDeviceID(ctx, m.dbClient, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nd, ds, nil
+}
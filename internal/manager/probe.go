@@ -0,0 +1,218 @@
+// Package manager implements SB control loop, that fetches data from the devices and stores it to the DB.
+package manager
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/pkg/client/db"
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+)
+
+const (
+	defaultProbeStagger = 250 * time.Millisecond
+	// EnvProbeStagger defines a delay, after which the next endpoint of the network device is probed, while the previous
+	// ones haven't answered yet (happy eyeballs). Setting it to 0 probes all endpoints at once.
+	EnvProbeStagger = "ENDPOINT_PROBE_STAGGER" // in milliseconds.
+)
+
+// probeResult is a result of probing a single endpoint of the network device.
+type probeResult struct {
+	endpoint *ent.Endpoint
+	snapshot *connectors.DeviceSnapshot
+	err      error
+	latency  time.Duration
+	// checksumSupplied is false, when the connector has declared that it can't supply checksum of the versions
+	checksumSupplied bool
+}
+
+// OrderEndpoints orders endpoints in ascending order of their preference. Endpoints without preference are ordered
+// last, endpoints with the same preference keep their order.
+func OrderEndpoints(endpoints []*ent.Endpoint) []*ent.Endpoint {
+	rank := func(ep *ent.Endpoint) int32 {
+		if ep.Preference <= 0 {
+			return math.MaxInt32
+		}
+		return ep.Preference
+	}
+	ordered := slices.Clone(endpoints)
+	slices.SortStableFunc(ordered, func(a, b *ent.Endpoint) int {
+		return int(rank(a)) - int(rank(b))
+	})
+	return ordered
+}
+
+// probeEndpoints probes endpoints of the network device in the order of their preference with staggered starts, i.e.,
+// the next endpoint is probed, when the previous one fails or doesn't answer within the stagger delay. First endpoint,
+// which answers, wins and the rest of the probes are cancelled. It returns the probe, which has won (nil, when none
+// of the endpoints has answered), together with the probes, which have failed before.
+func (m *Manager) probeEndpoints(ctx context.Context, endpoints []*ent.Endpoint) (*probeResult, []*probeResult) {
+	ordered := OrderEndpoints(endpoints)
+	if len(ordered) == 0 {
+		return nil, nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered, so that the probes, which have lost, are not blocked
+	results := make(chan *probeResult, len(ordered))
+	next, pending := 0, 0
+	startProbe := func() {
+		ep := ordered[next]
+		next++
+		pending++
+		go func() {
+			results <- m.probeEndpoint(ctx, ep)
+		}()
+	}
+	startProbe()
+	for m.probeStagger <= 0 && next < len(ordered) {
+		startProbe()
+	}
+
+	stagger := time.NewTimer(m.probeStagger)
+	defer stagger.Stop()
+	failed := make([]*probeResult, 0, len(ordered))
+	for pending > 0 {
+		select {
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				return res, failed
+			}
+			failed = append(failed, res)
+			// endpoint has failed, no need to wait for the stagger delay to probe the next one
+			if next < len(ordered) {
+				startProbe()
+				stagger.Reset(m.probeStagger)
+			}
+		case <-stagger.C:
+			if next < len(ordered) {
+				startProbe()
+				stagger.Reset(m.probeStagger)
+			}
+		}
+	}
+	return nil, failed
+}
+
+// recordEndpointHealth records results of the probes in the health of the endpoints. Latency of the probe, which has
+// won, is recorded as a latency sample of the network device. Probes, which were cancelled, because another endpoint
+// has answered first, are not recorded.
+func (m *Manager) recordEndpointHealth(ctx context.Context, networkDeviceID string, answered *probeResult, failed []*probeResult) {
+	if answered != nil {
+		_, _ = db.RecordEndpointSuccess(ctx, m.dbClient, answered.endpoint.ID, answered.latency)
+		// error is already logged in in the inner function
+		_, _ = db.CreateLatencySample(ctx, m.dbClient, networkDeviceID, answered.endpoint.ID, answered.latency)
+		// error is already logged in in the inner function
+	}
+	for _, res := range failed {
+		_, _ = db.RecordEndpointFailure(ctx, m.dbClient, res.endpoint.ID, res.err.Error())
+		// error is already logged in in the inner function
+	}
+}
+
+// CountsAsFailedAttempt checks if the error of the probe counts as a failed connectivity attempt. Rejected credentials,
+// untrusted device, answer, which could not be processed, and unsupported protocol tell that the device was reached,
+// they don't count.
+func CountsAsFailedAttempt(err error) bool {
+	switch connectors.Classify(err) {
+	case "", connectors.ErrorKindAuthFailed, connectors.ErrorKindUntrusted, connectors.ErrorKindProtocolError,
+		connectors.ErrorKindUnsupported:
+		return false
+	default:
+		return true
+	}
+}
+
+// FailureReading returns the status, which the network device is read as, when none of its endpoints has answered
+// with the given error. Device, which can't be reached, is down. Device, which was reached, but which has rejected
+// the credentials, which was not trusted or which answer could not be processed, can't be monitored, it is unhealthy.
+// Any other failure (e.g., unsupported protocol) doesn't tell anything about the device, its status is unspecified.
+func FailureReading(err error) devicestatus.Status {
+	switch kind := connectors.Classify(err); {
+	case CountsAsFailedAttempt(err):
+		return devicestatus.StatusSTATUS_DEVICE_DOWN
+	case kind == connectors.ErrorKindAuthFailed || kind == connectors.ErrorKindUntrusted ||
+		kind == connectors.ErrorKindProtocolError:
+		return devicestatus.StatusSTATUS_DEVICE_UNHEALTHY
+	default:
+		return devicestatus.StatusSTATUS_UNSPECIFIED
+	}
+}
+
+// pollFailure classifies the poll, which none of the endpoints has answered. Poll counts as a failed connectivity
+// attempt, when any of the probes counts as such, or when the network device has no endpoints at all. Otherwise,
+// failure of the endpoint, which was reached, but which has rejected the poll, takes precedence. It returns the error,
+// which has failed the poll, too.
+func pollFailure(failed []*probeResult) (bool, error) {
+	if len(failed) == 0 {
+		return true, connectors.NewError(connectors.ErrorKindUnreachable, errors.New("network device has no endpoints"))
+	}
+	pollErr := failed[len(failed)-1].err
+	for _, res := range failed {
+		if CountsAsFailedAttempt(res.err) {
+			return true, res.err
+		}
+		if FailureReading(res.err) == devicestatus.StatusSTATUS_DEVICE_UNHEALTHY {
+			pollErr = res.err
+		}
+	}
+	return false, pollErr
+}
+
+// probeEndpoint retrieves device status together with all versions from the endpoint. Result carries duration of the
+// exchange with the device as well.
+func (m *Manager) probeEndpoint(ctx context.Context, ep *ent.Endpoint) *probeResult {
+	// credentials are stored encrypted, decrypting them only for the time of building the connector.
+	opts := []connectors.Option{connectors.WithTimeout(m.rpcTimeout)}
+	if cp := ep.Edges.CredentialProfile; cp != nil {
+		creds, err := db.DecryptCredentialProfile(cp)
+		if err != nil {
+			// credentials can't be used, error is already logged in in the inner function
+			return &probeResult{endpoint: ep, err: connectors.NewError(connectors.ErrorKindAuthFailed, err)}
+		}
+		opts = append(opts, connectors.WithCredentials(creds))
+	}
+	// obtain connection based on the protocol.
+	connector, err := connectors.NewConnector(ep, opts...)
+	if err != nil {
+		// we've hit an unsupported protocol case
+		return &probeResult{endpoint: ep, err: err}
+	}
+	// retrieve device status together with all versions in one go.
+	// assuming that error is already logged in within the function.
+	started := time.Now()
+	snapshot, err := connector.GetSnapshot(ctx)
+	return &probeResult{
+		endpoint:         ep,
+		snapshot:         snapshot,
+		err:              err,
+		latency:          time.Since(started),
+		checksumSupplied: connectors.SuppliesChecksum(connector),
+	}
+}
+
+// readProbeStagger reads the stagger delay of the endpoint probes from the environment variable.
+func readProbeStagger() time.Duration {
+	staggerStr := os.Getenv(EnvProbeStagger)
+	if staggerStr == "" {
+		zlog.Warn().Msgf("Environment variable \"%s\" is not set, using default value: %s", EnvProbeStagger, defaultProbeStagger)
+		return defaultProbeStagger
+	}
+	stagger, err := strconv.Atoi(staggerStr)
+	if err != nil {
+		zlog.Fatal().Err(err).Msgf("Failed to convert \"%s\" variable to number", EnvProbeStagger)
+	}
+	if stagger < 0 {
+		zlog.Fatal().Msgf("Environment variable \"%s\" must not be negative", EnvProbeStagger)
+	}
+	return time.Duration(stagger) * time.Millisecond
+}
@@ -0,0 +1,67 @@
+// Package manager_test implements unit tests to test the control loop behavior.
+package manager_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/manager"
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderEndpoints(t *testing.T) {
+	snmp := &ent.Endpoint{ID: "snmp"}
+	netconf := &ent.Endpoint{ID: "netconf", Preference: 2}
+	gnmi := &ent.Endpoint{ID: "gnmi", Preference: 1}
+	restconf := &ent.Endpoint{ID: "restconf"}
+	ovsdb := &ent.Endpoint{ID: "ovsdb", Preference: 2}
+	endpoints := []*ent.Endpoint{snmp, netconf, gnmi, restconf, ovsdb}
+
+	// preferred endpoints go first, endpoints without preference go last, ties keep their order
+	ordered := manager.OrderEndpoints(endpoints)
+	require.Len(t, ordered, len(endpoints))
+	assert.Equal(t, []*ent.Endpoint{gnmi, netconf, ovsdb, snmp, restconf}, ordered)
+	// original list is kept intact
+	assert.Equal(t, []*ent.Endpoint{snmp, netconf, gnmi, restconf, ovsdb}, endpoints)
+
+	assert.Empty(t, manager.OrderEndpoints(nil))
+}
+
+func TestCountsAsFailedAttempt(t *testing.T) {
+	cause := errors.New("cause")
+	// device can't be reached
+	assert.True(t, manager.CountsAsFailedAttempt(connectors.NewError(connectors.ErrorKindUnreachable, cause)))
+	assert.True(t, manager.CountsAsFailedAttempt(connectors.NewError(connectors.ErrorKindTimeout, cause)))
+
+	// device was reached, but it can't be polled
+	assert.False(t, manager.CountsAsFailedAttempt(connectors.NewError(connectors.ErrorKindProtocolError, cause)))
+	// unclassified error is a protocol error
+	assert.False(t, manager.CountsAsFailedAttempt(cause))
+	assert.False(t, manager.CountsAsFailedAttempt(connectors.NewError(connectors.ErrorKindAuthFailed, cause)))
+	assert.False(t, manager.CountsAsFailedAttempt(connectors.NewError(connectors.ErrorKindUntrusted, cause)))
+	assert.False(t, manager.CountsAsFailedAttempt(fmt.Errorf("wrapped: %w", connectors.NewError(connectors.ErrorKindUnsupported, cause))))
+	assert.False(t, manager.CountsAsFailedAttempt(nil))
+}
+
+func TestFailureReading(t *testing.T) {
+	cause := errors.New("cause")
+	// device can't be reached
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, manager.FailureReading(connectors.NewError(connectors.ErrorKindTimeout, cause)))
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, manager.FailureReading(connectors.NewError(connectors.ErrorKindUnreachable, cause)))
+
+	// device was reached, but it can't be monitored
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, manager.FailureReading(connectors.NewError(connectors.ErrorKindProtocolError, cause)))
+	// unclassified error is a protocol error
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, manager.FailureReading(cause))
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, manager.FailureReading(connectors.NewError(connectors.ErrorKindAuthFailed, cause)))
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY,
+		manager.FailureReading(fmt.Errorf("wrapped: %w", connectors.NewError(connectors.ErrorKindUntrusted, cause))))
+
+	// failure doesn't tell anything about the device
+	assert.Equal(t, devicestatus.StatusSTATUS_UNSPECIFIED, manager.FailureReading(connectors.NewError(connectors.ErrorKindUnsupported, cause)))
+}
@@ -0,0 +1,68 @@
+// Package manager implements SB control loop, that fetches data from the devices and stores it to the DB.
+package manager
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+	"strconv"
+)
+
+// defaultVirtualNodes is a number of points of each replica on the ring. More points spread the devices more evenly.
+const defaultVirtualNodes = 128
+
+// HashRing is a consistent-hash ring of the replicas of the monitoring service. Each network device is owned by exactly
+// one replica, and when the replica leaves the ring, only its devices move to the other replicas.
+type HashRing struct {
+	members []string
+	points  []uint64
+	owners  map[uint64]string
+}
+
+// NewHashRing function creates HashRing structure. The ring is the same for the same members regardless of their order.
+func NewHashRing(members []string, virtualNodes int) *HashRing {
+	r := &HashRing{
+		members: slices.Sorted(slices.Values(members)),
+		points:  make([]uint64, 0, len(members)*virtualNodes),
+		owners:  make(map[uint64]string, len(members)*virtualNodes),
+	}
+	for _, member := range r.members {
+		for i := range virtualNodes {
+			point := hashKey(member + "#" + strconv.Itoa(i))
+			if _, ok := r.owners[point]; ok {
+				// collision is very unlikely, keeping the point of the first member
+				continue
+			}
+			r.owners[point] = member
+			r.points = append(r.points, point)
+		}
+	}
+	slices.Sort(r.points)
+	return r
+}
+
+// Members returns sorted members of the ring.
+func (r *HashRing) Members() []string {
+	return r.members
+}
+
+// Owner returns the member, which owns the key, i.e., the first member clockwise from the hash of the key.
+// It returns empty string, when the ring has no members.
+func (r *HashRing) Owner(key string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+	h := hashKey(key)
+	i, _ := slices.BinarySearch(r.points, h)
+	if i == len(r.points) {
+		// wrapping around the ring
+		i = 0
+	}
+	return r.owners[r.points[i]]
+}
+
+// hashKey maps the key on the ring. Hash must be the same on all replicas, hence a seeded hash can't be used.
+func hashKey(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
@@ -0,0 +1,51 @@
+// Package manager_test implements unit tests to test the control loop behavior.
+package manager_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/eroshiva/trade-show-poc/internal/manager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashRing(t *testing.T) {
+	keys := make([]string, 3000)
+	for i := range keys {
+		keys[i] = "netdev-" + strconv.Itoa(i)
+	}
+
+	// empty ring owns nothing
+	assert.Empty(t, manager.NewHashRing(nil, 128).Owner(keys[0]))
+
+	// single replica owns everything
+	single := manager.NewHashRing([]string{"replica-1"}, 128)
+	for _, key := range keys {
+		assert.Equal(t, "replica-1", single.Owner(key))
+	}
+
+	// devices are spread evenly, the ring doesn't depend on the order of the members
+	ring := manager.NewHashRing([]string{"replica-1", "replica-2", "replica-3"}, 128)
+	sameRing := manager.NewHashRing([]string{"replica-3", "replica-1", "replica-2"}, 128)
+	assert.Equal(t, []string{"replica-1", "replica-2", "replica-3"}, ring.Members())
+	shares := make(map[string]int)
+	for _, key := range keys {
+		owner := ring.Owner(key)
+		assert.Equal(t, owner, sameRing.Owner(key))
+		shares[owner]++
+	}
+	assert.Len(t, shares, 3)
+	for _, share := range shares {
+		assert.Greater(t, share, len(keys)/5)
+	}
+
+	// when the replica dies, only its devices move to the other replicas
+	shrunk := manager.NewHashRing([]string{"replica-1", "replica-3"}, 128)
+	for _, key := range keys {
+		if owner := ring.Owner(key); owner != "replica-2" {
+			assert.Equal(t, owner, shrunk.Owner(key))
+		} else {
+			assert.NotEqual(t, "replica-2", shrunk.Owner(key))
+		}
+	}
+}
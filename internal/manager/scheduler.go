@@ -0,0 +1,111 @@
+// Package manager implements SB control loop, that fetches data from the devices and stores it to the DB.
+package manager
+
+import (
+	"container/heap"
+	"time"
+)
+
+// scheduledDevice is an entry of the scheduler queue.
+type scheduledDevice struct {
+	id       string
+	deadline time.Time
+	index    int // index of the entry in the heap, maintained by the heap.Interface methods
+}
+
+// deviceQueue implements heap.Interface, the device with the earliest deadline is on top.
+type deviceQueue []*scheduledDevice
+
+func (q deviceQueue) Len() int { return len(q) }
+
+func (q deviceQueue) Less(i, j int) bool { return q[i].deadline.Before(q[j].deadline) }
+
+func (q deviceQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *deviceQueue) Push(x any) {
+	entry := x.(*scheduledDevice)
+	entry.index = len(*q)
+	*q = append(*q, entry)
+}
+
+func (q *deviceQueue) Pop() any {
+	old := *q
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	entry.index = -1
+	*q = old[:n-1]
+	return entry
+}
+
+// Scheduler keeps per-device deadlines of the next poll in a priority queue of next-due devices.
+// It is not safe for concurrent use.
+type Scheduler struct {
+	queue   deviceQueue
+	devices map[string]*scheduledDevice
+}
+
+// NewScheduler function creates Scheduler structure.
+func NewScheduler() *Scheduler {
+	return &Scheduler{
+		queue:   make(deviceQueue, 0),
+		devices: make(map[string]*scheduledDevice),
+	}
+}
+
+// Schedule sets deadline of the next poll of the device. Deadline of already scheduled device is overwritten.
+func (s *Scheduler) Schedule(id string, deadline time.Time) {
+	if entry, ok := s.devices[id]; ok {
+		entry.deadline = deadline
+		heap.Fix(&s.queue, entry.index)
+		return
+	}
+	entry := &scheduledDevice{id: id, deadline: deadline}
+	heap.Push(&s.queue, entry)
+	s.devices[id] = entry
+}
+
+// Remove removes the device from the schedule.
+func (s *Scheduler) Remove(id string) {
+	entry, ok := s.devices[id]
+	if !ok {
+		return
+	}
+	heap.Remove(&s.queue, entry.index)
+	delete(s.devices, id)
+}
+
+// Contains checks if the device is scheduled.
+func (s *Scheduler) Contains(id string) bool {
+	_, ok := s.devices[id]
+	return ok
+}
+
+// Len returns number of scheduled devices.
+func (s *Scheduler) Len() int {
+	return len(s.devices)
+}
+
+// NextDeadline returns the earliest deadline. It returns false, when no device is scheduled.
+func (s *Scheduler) NextDeadline() (time.Time, bool) {
+	if len(s.queue) == 0 {
+		return time.Time{}, false
+	}
+	return s.queue[0].deadline, true
+}
+
+// PopDue removes all devices, which deadline is not after now, from the schedule and returns their IDs ordered
+// by deadline. Devices have to be scheduled again once they are polled.
+func (s *Scheduler) PopDue(now time.Time) []string {
+	due := make([]string, 0)
+	for len(s.queue) > 0 && !s.queue[0].deadline.After(now) {
+		entry := heap.Pop(&s.queue).(*scheduledDevice)
+		delete(s.devices, entry.id)
+		due = append(due, entry.id)
+	}
+	return due
+}
@@ -0,0 +1,74 @@
+// Package manager_test implements unit tests to test the control loop behavior.
+package manager_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
+	"github.com/eroshiva/trade-show-poc/internal/manager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler(t *testing.T) {
+	now := time.Now()
+	scheduler := manager.NewScheduler()
+	_, ok := scheduler.NextDeadline()
+	assert.False(t, ok)
+	assert.Empty(t, scheduler.PopDue(now))
+
+	scheduler.Schedule("core", now.Add(5*time.Second))
+	scheduler.Schedule("cpe", now.Add(5*time.Minute))
+	scheduler.Schedule("edge", now.Add(-time.Second))
+	assert.Equal(t, 3, scheduler.Len())
+	assert.True(t, scheduler.Contains("core"))
+
+	// the earliest deadline is on top
+	deadline, ok := scheduler.NextDeadline()
+	require.True(t, ok)
+	assert.Equal(t, now.Add(-time.Second), deadline)
+
+	// only devices, which are due, are popped
+	assert.Equal(t, []string{"edge"}, scheduler.PopDue(now))
+	assert.False(t, scheduler.Contains("edge"))
+	assert.Equal(t, 2, scheduler.Len())
+
+	// rescheduling already scheduled device
+	scheduler.Schedule("cpe", now.Add(time.Second))
+	deadline, ok = scheduler.NextDeadline()
+	require.True(t, ok)
+	assert.Equal(t, now.Add(time.Second), deadline)
+
+	// devices are popped ordered by deadline
+	scheduler.Schedule("edge", now.Add(2*time.Second))
+	assert.Equal(t, []string{"cpe", "edge", "core"}, scheduler.PopDue(now.Add(time.Minute)))
+	assert.Zero(t, scheduler.Len())
+
+	// removing device from the schedule
+	scheduler.Schedule("core", now)
+	scheduler.Schedule("cpe", now)
+	scheduler.Remove("core")
+	scheduler.Remove("not-scheduled")
+	assert.Equal(t, []string{"cpe"}, scheduler.PopDue(now))
+}
+
+func TestResolvePollInterval(t *testing.T) {
+	fallback := 30 * time.Second
+	defaults := []*ent.PollingDefault{
+		{Scope: pollingdefault.ScopePOLLING_SCOPE_SITE, Name: "remote", PollInterval: 300},
+		{Scope: pollingdefault.ScopePOLLING_SCOPE_GROUP, Name: "core", PollInterval: 5},
+		{Scope: pollingdefault.ScopePOLLING_SCOPE_SITE, Name: "core", PollInterval: 60},
+	}
+
+	// interval of the device takes precedence
+	assert.Equal(t, 10*time.Second, manager.ResolvePollInterval(&ent.NetworkDevice{PollInterval: 10, Group: "core"}, defaults, fallback))
+	// then default of the group
+	assert.Equal(t, 5*time.Second, manager.ResolvePollInterval(&ent.NetworkDevice{Group: "core", Site: "remote"}, defaults, fallback))
+	// then default of the site
+	assert.Equal(t, 5*time.Minute, manager.ResolvePollInterval(&ent.NetworkDevice{Group: "cpe", Site: "remote"}, defaults, fallback))
+	// and the fallback otherwise
+	assert.Equal(t, fallback, manager.ResolvePollInterval(&ent.NetworkDevice{Group: "cpe", Site: "hq"}, defaults, fallback))
+	assert.Equal(t, fallback, manager.ResolvePollInterval(&ent.NetworkDevice{}, nil, fallback))
+}
@@ -0,0 +1,118 @@
+// Package manager implements SB control loop, that fetches data from the devices and stores it to the DB.
+package manager
+
+import (
+	"context"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/pkg/client/db"
+)
+
+const (
+	// EnvShardingEnabled enables sharding of the network devices among the replicas of the monitoring service.
+	// Every replica runs main control loop over its own shard then, leader election is not performed.
+	EnvShardingEnabled = "SHARDING_ENABLED"
+
+	defaultHeartbeatTimeout = 15 * time.Second
+	// EnvHeartbeatTimeout defines a period, after which the replica, which doesn't record its heartbeat,
+	// is considered dead and its network devices move to other replicas.
+	EnvHeartbeatTimeout = "REPLICA_HEARTBEAT_TIMEOUT" // in seconds.
+)
+
+// readSharding reads from the environment variable whether sharding is enabled.
+func readSharding() bool {
+	shardingStr := os.Getenv(EnvShardingEnabled)
+	if shardingStr == "" {
+		return false
+	}
+	sharding, err := strconv.ParseBool(shardingStr)
+	if err != nil {
+		zlog.Fatal().Err(err).Msgf("Failed to convert \"%s\" variable to bool", EnvShardingEnabled)
+	}
+	return sharding
+}
+
+// readHeartbeatTimeout reads a heartbeat timeout from the environment variable.
+func readHeartbeatTimeout() time.Duration {
+	timeout := readPeriod(EnvHeartbeatTimeout, defaultHeartbeatTimeout)
+	if timeout <= 0 {
+		zlog.Fatal().Msgf("Environment variable \"%s\" must be positive", EnvHeartbeatTimeout)
+	}
+	return timeout
+}
+
+// InShard reports whether the network device belongs to the shard of this replica. All devices belong to it, when
+// sharding is disabled. No device belongs to it, until the replica has joined the ring.
+func (m *Manager) InShard(networkDeviceID string) bool {
+	if !m.sharding {
+		return true
+	}
+	ring := m.ring.Load()
+	if ring == nil {
+		return false
+	}
+	return ring.Owner(networkDeviceID) == m.identity
+}
+
+// listNetworkDevices lists network devices, which belong to the shard of this replica.
+func (m *Manager) listNetworkDevices(ctx context.Context) ([]*ent.NetworkDevice, error) {
+	if !m.sharding {
+		return db.ListNetworkDevices(ctx, m.dbClient)
+	}
+	return db.ListNetworkDevicesInShard(ctx, m.dbClient, m.InShard)
+}
+
+// runSharding periodically records a heartbeat of this replica and rebuilds the ring out of the live replicas.
+// Main control loop runs over the shard of this replica, which is re-read every control loop period.
+func (m *Manager) runSharding(controlLoopTick time.Duration) {
+	zlog.Info().Msgf("Starting sharded control loop as %s, heartbeat timeout is %s", m.identity, m.heartbeatTimeout)
+	// joining the ring before the control loop starts
+	m.refreshRing()
+	ctx, stopControlLoop := context.WithCancel(context.Background())
+	go m.controlLoop(ctx, controlLoopTick)
+
+	// recording heartbeat a few times within the timeout, so that one failed heartbeat doesn't cost the shard
+	ticker := time.NewTicker(m.heartbeatTimeout / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			m.refreshRing()
+		case <-m.closeChan:
+			// shutting down this routine, leaving the ring right away, so that other replicas take the shard over
+			stopControlLoop()
+			ctx, cancel := context.WithTimeout(context.Background(), m.heartbeatTimeout)
+			_ = db.DeleteReplicaHeartbeat(ctx, m.dbClient, m.identity)
+			// error is already logged in in the inner function
+			cancel()
+			return
+		}
+	}
+}
+
+// refreshRing records a heartbeat of this replica and rebuilds the ring, when the set of the live replicas has changed.
+// Previous ring is kept, when the DB is not reachable.
+func (m *Manager) refreshRing() {
+	ctx, cancel := context.WithTimeout(context.Background(), m.heartbeatTimeout/3)
+	defer cancel()
+	if err := db.RecordReplicaHeartbeat(ctx, m.dbClient, m.identity); err != nil {
+		// error is already logged in in the inner function
+		return
+	}
+	replicas, err := db.ListLiveReplicas(ctx, m.dbClient, m.heartbeatTimeout)
+	if err != nil {
+		// error is already logged in in the inner function
+		return
+	}
+	if ring := m.ring.Load(); ring != nil && slices.Equal(ring.Members(), slices.Sorted(slices.Values(replicas))) {
+		// nothing has changed
+		return
+	}
+	ring := NewHashRing(replicas, defaultVirtualNodes)
+	m.ring.Store(ring)
+	zlog.Info().Msgf("Live replicas have changed, network devices are sharded among %v", ring.Members())
+}
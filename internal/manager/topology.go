@@ -0,0 +1,30 @@
+// Package manager implements SB control loop, that fetches data from the devices and stores it to the DB.
+package manager
+
+import (
+	"context"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/pkg/client/db"
+)
+
+// parentDown checks if the parent of the network device is down (or unreachable itself), i.e., the network device
+// can't be reached because of its upstream device. It returns ID of the parent, when it is down.
+func (m *Manager) parentDown(ctx context.Context, networkDeviceID string) (string, bool) {
+	parent, err := db.GetNetworkDeviceParent(ctx, m.dbClient, networkDeviceID)
+	if err != nil || parent == nil {
+		// error is already logged in in the inner function
+		return "", false
+	}
+	ds, err := db.GetDeviceStatusByNetworkDeviceID(ctx, m.dbClient, parent.ID)
+	if err != nil {
+		zlog.Debug().Err(err).Msgf("Failed to get device status of parent (%s) of network device (%s)", parent.ID, networkDeviceID)
+		return "", false
+	}
+	switch ds.Status {
+	case devicestatus.StatusSTATUS_DEVICE_DOWN, devicestatus.StatusSTATUS_DEVICE_UNREACHABLE:
+		return parent.ID, true
+	default:
+		return "", false
+	}
+}
@@ -2,6 +2,8 @@
 package server
 
 import (
+	"time"
+
 	apiv1 "github.com/eroshiva/trade-show-poc/api/v1"
 )
 
@@ -74,3 +76,117 @@ func CreateGetDeviceStatusRequest(id string, ep *apiv1.Endpoint) *apiv1.GetDevic
 		Endpoint: ep,
 	}
 }
+
+// CreateCredentialProfileRequest is a helper wrapper function that creates CreateCredentialProfileRequest message.
+func CreateCredentialProfileRequest(profile *apiv1.CredentialProfile) *apiv1.CreateCredentialProfileRequest {
+	return &apiv1.CreateCredentialProfileRequest{
+		Profile: profile,
+	}
+}
+
+// CreateDeleteCredentialProfileRequest is a helper wrapper function that creates DeleteCredentialProfileRequest message.
+func CreateDeleteCredentialProfileRequest(id string) *apiv1.DeleteCredentialProfileRequest {
+	return &apiv1.DeleteCredentialProfileRequest{
+		Id: id,
+	}
+}
+
+// CreateSetPollingDefaultRequest is a helper wrapper function that creates SetPollingDefaultRequest message.
+func CreateSetPollingDefaultRequest(scope apiv1.PollingScope, name string, pollInterval int32) *apiv1.SetPollingDefaultRequest {
+	return &apiv1.SetPollingDefaultRequest{
+		Default: &apiv1.PollingDefault{
+			Scope:        scope,
+			Name:         name,
+			PollInterval: pollInterval,
+		},
+	}
+}
+
+// CreateDeletePollingDefaultRequest is a helper wrapper function that creates DeletePollingDefaultRequest message.
+func CreateDeletePollingDefaultRequest(id string) *apiv1.DeletePollingDefaultRequest {
+	return &apiv1.DeletePollingDefaultRequest{
+		Id: id,
+	}
+}
+
+// CreateListDeviceStatusHistoryRequest is a helper wrapper function that creates ListDeviceStatusHistoryRequest message.
+// Time range is not bounded from the side, which is nil.
+func CreateListDeviceStatusHistoryRequest(id string, from, to *time.Time) *apiv1.ListDeviceStatusHistoryRequest {
+	req := &apiv1.ListDeviceStatusHistoryRequest{
+		Id: id,
+	}
+	if from != nil {
+		fromMs := from.UnixMilli()
+		req.From = &fromMs
+	}
+	if to != nil {
+		toMs := to.UnixMilli()
+		req.To = &toMs
+	}
+	return req
+}
+
+// CreateSetDeviceParentRequest is a helper wrapper function that creates SetDeviceParentRequest message.
+func CreateSetDeviceParentRequest(id, parentID string) *apiv1.SetDeviceParentRequest {
+	return &apiv1.SetDeviceParentRequest{
+		Id:       id,
+		ParentId: parentID,
+	}
+}
+
+// CreateMaintenanceWindowRequest is a helper wrapper function that creates CreateMaintenanceWindowRequest message.
+func CreateMaintenanceWindowRequest(name string, startsAt, endsAt time.Time, networkDeviceIDs ...string) *apiv1.CreateMaintenanceWindowRequest {
+	nds := make([]*apiv1.NetworkDevice, 0, len(networkDeviceIDs))
+	for _, id := range networkDeviceIDs {
+		nds = append(nds, &apiv1.NetworkDevice{Id: id})
+	}
+	return &apiv1.CreateMaintenanceWindowRequest{
+		Window: &apiv1.MaintenanceWindow{
+			Name:           name,
+			StartsAt:       startsAt.UnixMilli(),
+			EndsAt:         endsAt.UnixMilli(),
+			NetworkDevices: nds,
+		},
+	}
+}
+
+// CreateDeleteMaintenanceWindowRequest is a helper wrapper function that creates DeleteMaintenanceWindowRequest message.
+func CreateDeleteMaintenanceWindowRequest(id string) *apiv1.DeleteMaintenanceWindowRequest {
+	return &apiv1.DeleteMaintenanceWindowRequest{
+		Id: id,
+	}
+}
+
+// CreatePollDeviceRequest is a helper wrapper function that creates PollDeviceRequest message.
+func CreatePollDeviceRequest(id string) *apiv1.PollDeviceRequest {
+	return &apiv1.PollDeviceRequest{
+		Id: id,
+	}
+}
+
+// CreateGetEndpointHealthRequest is a helper wrapper function that creates GetEndpointHealthRequest message.
+func CreateGetEndpointHealthRequest(id string) *apiv1.GetEndpointHealthRequest {
+	return &apiv1.GetEndpointHealthRequest{
+		Id: id,
+	}
+}
+
+// CreateGetLatencyStatsRequest is a helper wrapper function that creates GetLatencyStatsRequest message. Samples of all
+// endpoints are considered, when endpoint ID is empty. Time range is not bounded from the side, which is nil.
+func CreateGetLatencyStatsRequest(id, endpointID string, from, to *time.Time) *apiv1.GetLatencyStatsRequest {
+	req := &apiv1.GetLatencyStatsRequest{
+		Id: id,
+	}
+	if endpointID != "" {
+		req.EndpointId = &endpointID
+	}
+	if from != nil {
+		fromMs := from.UnixMilli()
+		req.From = &fromMs
+	}
+	if to != nil {
+		toMs := to.UnixMilli()
+		req.To = &toMs
+	}
+	return req
+}
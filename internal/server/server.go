@@ -16,6 +16,7 @@ import (
 	"github.com/eroshiva/trade-show-poc/internal/ent"
 	"github.com/eroshiva/trade-show-poc/pkg/client/db"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -31,6 +32,9 @@ const (
 	envServerAddress         = "GRPC_SERVER_ADDRESS" // must be in form address:port, e.g., localhost:50051.
 	envHTTPServerAddress     = "HTTP_SERVER_ADDRESS" // must be in form address:port, e.g., localhost:80.
 	defaultHTTPServerAddress = "localhost:50052"
+	metricsPath              = "/metrics" // served by HTTP reverse proxy
+	// defaultLatencyStatsWindow is a time range, which latency statistics are computed over, when its beginning is unset.
+	defaultLatencyStatsWindow = 24 * time.Hour
 )
 
 var zlog = zerolog.New(zerolog.ConsoleWriter{
@@ -47,6 +51,13 @@ type server struct {
 
 	// client for interactions with DB
 	dbClient *ent.Client
+	// poller of the network devices on demand
+	poller DevicePoller
+}
+
+// DevicePoller polls the network device on demand, i.e., regardless of the control loop, and stores the result in the DB.
+type DevicePoller interface {
+	PollDevice(ctx context.Context, id string) (*ent.NetworkDevice, *ent.DeviceStatus, error)
 }
 
 // Options structure defines server's features enablement.
@@ -60,7 +71,7 @@ func getServerOptions(_ *Options) ([]grpc.ServerOption, error) {
 	return optionsList, nil
 }
 
-func serve(grpcAddress, httpAddress string, dbClient *ent.Client, wg *sync.WaitGroup, serverOptions []grpc.ServerOption,
+func serve(grpcAddress, httpAddress string, dbClient *ent.Client, poller DevicePoller, wg *sync.WaitGroup, serverOptions []grpc.ServerOption,
 	termChan, readyChan, reverseProxyReadyChan, reverseProxyTermChan chan bool,
 ) {
 	grpcReadyChan := make(chan bool, 1)
@@ -74,6 +85,7 @@ func serve(grpcAddress, httpAddress string, dbClient *ent.Client, wg *sync.WaitG
 
 	gRPCServer := &server{
 		dbClient: dbClient,
+		poller:   poller,
 	}
 
 	// Register our server implementation with the gRPC server.
@@ -132,6 +144,13 @@ func startReverseProxy(grpcServerAddress, httpServerAddress string, wg *sync.Wai
 	if err = apiv1.RegisterDeviceMonitoringServiceHandler(context.Background(), mux, conn); err != nil {
 		zlog.Fatal().Err(err).Msg("Failed to register HTTP gateway")
 	}
+	// exposing metrics of the monitoring service (e.g., depth of the control loop queue) to Prometheus
+	metricsHandler := promhttp.Handler()
+	if err = mux.HandlePath(http.MethodGet, metricsPath, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		metricsHandler.ServeHTTP(w, r)
+	}); err != nil {
+		zlog.Fatal().Err(err).Msg("Failed to register metrics handler")
+	}
 
 	// now, create and start the HTTP server (i.e., our gateway).
 	gwServer := &http.Server{
@@ -186,8 +205,10 @@ func GetHTTPServerAddress() string {
 	return httpServerAddress
 }
 
-// StartServer function configures and brings up gRPC server.
-func StartServer(gRPCServerAddress, httpServerAddress string, dbClient *ent.Client, wg *sync.WaitGroup, termChan, readyChan, reverseProxyReadyChan, reverseProxyTermChan chan bool) {
+// StartServer function configures and brings up gRPC server. Network devices are polled on demand with the poller.
+func StartServer(gRPCServerAddress, httpServerAddress string, dbClient *ent.Client, poller DevicePoller, wg *sync.WaitGroup,
+	termChan, readyChan, reverseProxyReadyChan, reverseProxyTermChan chan bool,
+) {
 	zlog.Info().Msgf("Starting gRPC server...")
 
 	// get server options
@@ -197,7 +218,7 @@ func StartServer(gRPCServerAddress, httpServerAddress string, dbClient *ent.Clie
 	}
 
 	// start server
-	serve(gRPCServerAddress, httpServerAddress, dbClient, wg, serverOptions, termChan, readyChan, reverseProxyReadyChan, reverseProxyTermChan)
+	serve(gRPCServerAddress, httpServerAddress, dbClient, poller, wg, serverOptions, termChan, readyChan, reverseProxyReadyChan, reverseProxyTermChan)
 }
 
 func (srv *server) AddDevice(ctx context.Context, req *apiv1.AddDeviceRequest) (*apiv1.AddDeviceResponse, error) {
@@ -234,25 +255,54 @@ func (srv *server) AddDevice(ctx context.Context, req *apiv1.AddDeviceRequest) (
 	}
 
 	// handling the case, when a network device does not exist.
-	// first, creating endpoints.
-	endpoints := make([]*ent.Endpoint, 0)
+	// first, making sure that endpoints reference existing credential profiles.
 	for _, endpoint := range req.GetDevice().GetEndpoints() {
-		protocol := ConvertProtoProtocolToEntProtocol(endpoint.GetProtocol())
-		ep, err := db.CreateEndpoint(ctx, srv.dbClient, endpoint.GetHost(), endpoint.GetPort(), protocol)
-		if err != nil {
+		profileID := endpoint.GetCredentialProfile().GetId()
+		if profileID == "" {
 			continue
 		}
-		endpoints = append(endpoints, ep)
+		if _, err := db.GetCredentialProfileByID(ctx, srv.dbClient, profileID); err != nil {
+			newErr := fmt.Errorf("credential profile (%s) referenced by endpoint %s:%s doesn't exist: %w",
+				profileID, endpoint.GetHost(), endpoint.GetPort(), err)
+			zlog.Error().Err(newErr).Msg("Failed to add network device")
+			return nil, newErr
+		}
 	}
 
+	// creating endpoints and network device at once, so that nothing is left behind, when any of them fails.
 	entVendor := ConvertProtoVendorToEntVendor(req.GetDevice().GetVendor())
-	// endpoints are created, now creating network device.
-	nd, err := db.CreateNetworkDevice(ctx, srv.dbClient, req.GetDevice().GetModel(), entVendor, endpoints)
+	err := db.WithTx(ctx, srv.dbClient, func(client *ent.Client) error {
+		endpoints := make([]*ent.Endpoint, 0)
+		for _, endpoint := range req.GetDevice().GetEndpoints() {
+			ep, err := db.CreateEndpointResource(ctx, client, ConvertProtoEndpointToEndpoint(endpoint),
+				endpoint.GetCredentialProfile().GetId())
+			if err != nil {
+				return err
+			}
+			endpoints = append(endpoints, ep)
+		}
+
+		// endpoints are created, now creating network device.
+		var err error
+		nd, err = db.CreateNetworkDevice(ctx, client, req.GetDevice().GetModel(), entVendor, endpoints)
+		if err != nil {
+			return err
+		}
+		if hasPollingAttributes(req.GetDevice()) {
+			nd, err = db.SetNetworkDevicePolling(ctx, client, nd.ID, req.GetDevice().GetPollInterval(),
+				req.GetDevice().GetGroup(), req.GetDevice().GetSite())
+			if err != nil {
+				return err
+			}
+		}
+		// DB client doesn't return resource with eager-loaded edges, adding them additionally here
+		nd.Edges.Endpoints = endpoints
+		return nil
+	})
 	if err != nil {
+		// error is already logged in in the inner function
 		return nil, err
 	}
-	// DB client doesn't return resource with eager-loaded edges, adding them additionally here
-	nd.Edges.Endpoints = endpoints
 
 	// converting to Proto bindings
 	protoND := ConvertNetworkDeviceResourceToNetworkDeviceProto(nd)
@@ -334,11 +384,64 @@ func (srv *server) UpdateNetworkDevice(ctx context.Context, nd *apiv1.NetworkDev
 	if err != nil {
 		return nil, err
 	}
+	if hasPollingAttributes(nd) {
+		pollND, err := db.SetNetworkDevicePolling(ctx, srv.dbClient, nd.GetId(), nd.GetPollInterval(), nd.GetGroup(), nd.GetSite())
+		if err != nil {
+			return nil, err
+		}
+		updND.PollInterval = pollND.PollInterval
+		updND.Group = pollND.Group
+		updND.Site = pollND.Site
+	}
 
 	protoND := ConvertNetworkDeviceResourceToNetworkDeviceProto(updND)
 	return protoND, nil
 }
 
+func (srv *server) SetDeviceParent(ctx context.Context, req *apiv1.SetDeviceParentRequest) (*apiv1.SetDeviceParentResponse, error) {
+	zlog.Info().Msgf("Setting parent of network device (%s) to (%s)", req.GetId(), req.GetParentId())
+
+	// sanity check for input parameters
+	if req.GetId() == "" {
+		err := fmt.Errorf("ID is not specified")
+		zlog.Error().Err(err).Msg("Failed to set parent of network device")
+		return nil, err
+	}
+
+	nd, err := db.SetNetworkDeviceParent(ctx, srv.dbClient, req.GetId(), req.GetParentId())
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.SetDeviceParentResponse{
+		Device: ConvertNetworkDeviceResourceToNetworkDeviceProto(nd),
+	}, nil
+}
+
+func (srv *server) PollDevice(ctx context.Context, req *apiv1.PollDeviceRequest) (*apiv1.PollDeviceResponse, error) {
+	zlog.Info().Msgf("Polling network device (%s)", req.GetId())
+
+	// sanity check for input parameters
+	if req.GetId() == "" {
+		err := fmt.Errorf("ID is not specified")
+		zlog.Error().Err(err).Msg("Failed to poll network device")
+		return nil, err
+	}
+	if srv.poller == nil {
+		err := fmt.Errorf("polling on demand is not enabled")
+		zlog.Error().Err(err).Msgf("Failed to poll network device (%s)", req.GetId())
+		return nil, err
+	}
+
+	nd, ds, err := srv.poller.PollDevice(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.PollDeviceResponse{
+		Device: ConvertNetworkDeviceResourceToNetworkDeviceProto(nd),
+		Status: ConvertEntDeviceStatusToProtoDeviceStatus(ds),
+	}, nil
+}
+
 func (srv *server) GetDeviceStatus(ctx context.Context, req *apiv1.GetDeviceStatusRequest) (*apiv1.GetDeviceStatusResponse, error) {
 	zlog.Info().Msgf("Retrieving network device status (%s)", req.GetId())
 
@@ -377,6 +480,106 @@ func (srv *server) GetDeviceStatus(ctx context.Context, req *apiv1.GetDeviceStat
 	}, nil
 }
 
+func (srv *server) ListDeviceStatusHistory(ctx context.Context, req *apiv1.ListDeviceStatusHistoryRequest) (*apiv1.ListDeviceStatusHistoryResponse, error) {
+	zlog.Info().Msgf("Retrieving status history of network device (%s)", req.GetId())
+
+	// sanity check for input parameters
+	if req.GetId() == "" {
+		err := fmt.Errorf("ID is not specified")
+		zlog.Error().Err(err).Msg("Failed to retrieve status history")
+		return nil, err
+	}
+	var from, to time.Time
+	if req.From != nil {
+		from = time.UnixMilli(req.GetFrom())
+	}
+	if req.To != nil {
+		to = time.UnixMilli(req.GetTo())
+	}
+	if req.From != nil && req.To != nil && from.After(to) {
+		err := fmt.Errorf("beginning of the time range is after its end")
+		zlog.Error().Err(err).Msg("Failed to retrieve status history")
+		return nil, err
+	}
+
+	// making sure that the network device exists
+	_, err := db.GetNetworkDeviceByID(ctx, srv.dbClient, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	ses, err := db.ListStatusEvents(ctx, srv.dbClient, req.GetId(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*apiv1.StatusEvent, 0, len(ses))
+	for _, se := range ses {
+		events = append(events, ConvertStatusEventToStatusEventProto(se))
+	}
+	return &apiv1.ListDeviceStatusHistoryResponse{
+		Id:     req.GetId(),
+		Events: events,
+	}, nil
+}
+
+func (srv *server) GetEndpointHealth(ctx context.Context, req *apiv1.GetEndpointHealthRequest) (*apiv1.GetEndpointHealthResponse, error) {
+	zlog.Info().Msgf("Retrieving health of endpoint (%s)", req.GetId())
+
+	// sanity check for input parameters
+	if req.GetId() == "" {
+		err := fmt.Errorf("ID is not specified")
+		zlog.Error().Err(err).Msg("Failed to retrieve health of endpoint")
+		return nil, err
+	}
+
+	eh, err := db.GetEndpointHealthByEndpointID(ctx, srv.dbClient, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.GetEndpointHealthResponse{
+		Id:     req.GetId(),
+		Health: ConvertEndpointHealthToEndpointHealthProto(eh),
+	}, nil
+}
+
+func (srv *server) GetLatencyStats(ctx context.Context, req *apiv1.GetLatencyStatsRequest) (*apiv1.GetLatencyStatsResponse, error) {
+	zlog.Info().Msgf("Retrieving latency statistics of network device (%s)", req.GetId())
+
+	// sanity check for input parameters
+	if req.GetId() == "" {
+		err := fmt.Errorf("ID is not specified")
+		zlog.Error().Err(err).Msg("Failed to retrieve latency statistics")
+		return nil, err
+	}
+	// statistics are computed over a bounded time range
+	to := time.Now()
+	if req.To != nil {
+		to = time.UnixMilli(req.GetTo())
+	}
+	from := to.Add(-defaultLatencyStatsWindow)
+	if req.From != nil {
+		from = time.UnixMilli(req.GetFrom())
+	}
+	if from.After(to) {
+		err := fmt.Errorf("beginning of the time range is after its end")
+		zlog.Error().Err(err).Msg("Failed to retrieve latency statistics")
+		return nil, err
+	}
+
+	// making sure that the network device exists
+	_, err := db.GetNetworkDeviceByID(ctx, srv.dbClient, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	stats, err := db.GetLatencyStats(ctx, srv.dbClient, req.GetId(), req.GetEndpointId(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	resp := ConvertLatencyStatsToLatencyStatsProto(stats)
+	resp.Id = req.GetId()
+	resp.EndpointId = req.GetEndpointId()
+	return resp, nil
+}
+
 func (srv *server) GetSummary(ctx context.Context, _ *emptypb.Empty) (*apiv1.GetSummaryResponse, error) {
 	zlog.Info().Msgf("Retrieving network device summary")
 
@@ -419,6 +622,11 @@ func getStatistics(stats *apiv1.GetSummaryResponse, ds *apiv1.DeviceStatus) *api
 		stats.DownDevices++
 	case apiv1.Status_STATUS_DEVICE_UNHEALTHY:
 		stats.DevicesUnhealthy++
+	case apiv1.Status_STATUS_DEVICE_UNREACHABLE:
+		stats.DevicesUnreachable++
+	}
+	if ds.GetInMaintenance() {
+		stats.DevicesInMaintenance++
 	}
 	return stats
 }
@@ -460,7 +668,7 @@ func (srv *server) SwapDeviceList(ctx context.Context, req *apiv1.SwapDeviceList
 	cumulativeErr = nil
 	addedDevices := make([]*apiv1.NetworkDevice, 0)
 	for _, nd := range req.GetDevices() {
-		resp, err := srv.AddDevice(ctx, CreateAddDeviceRequest(nd.GetVendor(), nd.GetModel(), nd.GetEndpoints()))
+		resp, err := srv.AddDevice(ctx, &apiv1.AddDeviceRequest{Device: nd})
 		if err != nil {
 			cumulativeErr = errors.Join(cumulativeErr, err)
 		}
@@ -502,3 +710,207 @@ func (srv *server) GetAllDeviceStatuses(ctx context.Context, _ *emptypb.Empty) (
 		Statuses: retList,
 	}, nil
 }
+
+func (srv *server) CreateCredentialProfile(ctx context.Context, req *apiv1.CreateCredentialProfileRequest) (*apiv1.CreateCredentialProfileResponse, error) {
+	zlog.Info().Msgf("Creating credential profile %s", req.GetProfile().GetName())
+
+	cp, err := db.CreateCredentialProfile(ctx, srv.dbClient, ConvertProtoCredentialProfileToCredentialProfile(req.GetProfile()))
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.CreateCredentialProfileResponse{
+		Profile: ConvertCredentialProfileToCredentialProfileProto(cp),
+	}, nil
+}
+
+func (srv *server) UpdateCredentialProfile(ctx context.Context, req *apiv1.UpdateCredentialProfileRequest) (*apiv1.UpdateCredentialProfileResponse, error) {
+	zlog.Info().Msgf("Updating credential profile (%s)", req.GetProfile().GetId())
+
+	cp, err := db.UpdateCredentialProfile(ctx, srv.dbClient, ConvertProtoCredentialProfileToCredentialProfile(req.GetProfile()))
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.UpdateCredentialProfileResponse{
+		Profile: ConvertCredentialProfileToCredentialProfileProto(cp),
+	}, nil
+}
+
+func (srv *server) ListCredentialProfiles(ctx context.Context, _ *emptypb.Empty) (*apiv1.ListCredentialProfilesResponse, error) {
+	zlog.Info().Msgf("Retrieving all credential profiles")
+
+	cps, err := db.ListCredentialProfiles(ctx, srv.dbClient)
+	if err != nil {
+		return nil, err
+	}
+	retList := make([]*apiv1.CredentialProfile, 0, len(cps))
+	for _, cp := range cps {
+		retList = append(retList, ConvertCredentialProfileToCredentialProfileProto(cp))
+	}
+	return &apiv1.ListCredentialProfilesResponse{
+		Profiles: retList,
+	}, nil
+}
+
+func (srv *server) DeleteCredentialProfile(ctx context.Context, req *apiv1.DeleteCredentialProfileRequest) (*apiv1.DeleteCredentialProfileResponse, error) {
+	zlog.Info().Msgf("Removing credential profile (%s)", req.GetId())
+
+	// sanity check for input parameters
+	if req.GetId() == "" {
+		err := fmt.Errorf("ID is not specified")
+		zlog.Error().Err(err).Msg("Failed to delete credential profile")
+		return nil, err
+	}
+
+	resp := &apiv1.DeleteCredentialProfileResponse{
+		Id:      req.GetId(),
+		Deleted: false,
+	}
+	err := db.DeleteCredentialProfileByID(ctx, srv.dbClient, req.GetId())
+	if err != nil {
+		// failed to delete credential profile
+		details := err.Error()
+		resp.Details = &details
+		return resp, err
+	}
+	resp.Deleted = true
+	return resp, nil
+}
+
+func (srv *server) SetPollingDefault(ctx context.Context, req *apiv1.SetPollingDefaultRequest) (*apiv1.SetPollingDefaultResponse, error) {
+	zlog.Info().Msgf("Setting poll interval of %s %s", req.GetDefault().GetScope(), req.GetDefault().GetName())
+
+	pd, err := db.SetPollingDefault(ctx, srv.dbClient, ConvertProtoPollingScopeToEntPollingScope(req.GetDefault().GetScope()),
+		req.GetDefault().GetName(), req.GetDefault().GetPollInterval())
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.SetPollingDefaultResponse{
+		Default: ConvertPollingDefaultToPollingDefaultProto(pd),
+	}, nil
+}
+
+func (srv *server) ListPollingDefaults(ctx context.Context, _ *emptypb.Empty) (*apiv1.ListPollingDefaultsResponse, error) {
+	zlog.Info().Msgf("Retrieving all polling defaults")
+
+	pds, err := db.ListPollingDefaults(ctx, srv.dbClient)
+	if err != nil {
+		return nil, err
+	}
+	retList := make([]*apiv1.PollingDefault, 0, len(pds))
+	for _, pd := range pds {
+		retList = append(retList, ConvertPollingDefaultToPollingDefaultProto(pd))
+	}
+	return &apiv1.ListPollingDefaultsResponse{
+		Defaults: retList,
+	}, nil
+}
+
+func (srv *server) DeletePollingDefault(ctx context.Context, req *apiv1.DeletePollingDefaultRequest) (*apiv1.DeletePollingDefaultResponse, error) {
+	zlog.Info().Msgf("Removing polling default (%s)", req.GetId())
+
+	// sanity check for input parameters
+	if req.GetId() == "" {
+		err := fmt.Errorf("ID is not specified")
+		zlog.Error().Err(err).Msg("Failed to delete polling default")
+		return nil, err
+	}
+
+	resp := &apiv1.DeletePollingDefaultResponse{
+		Id:      req.GetId(),
+		Deleted: false,
+	}
+	err := db.DeletePollingDefaultByID(ctx, srv.dbClient, req.GetId())
+	if err != nil {
+		// failed to delete polling default
+		details := err.Error()
+		resp.Details = &details
+		return resp, err
+	}
+	resp.Deleted = true
+	return resp, nil
+}
+
+func (srv *server) CreateMaintenanceWindow(ctx context.Context, req *apiv1.CreateMaintenanceWindowRequest) (*apiv1.CreateMaintenanceWindowResponse, error) {
+	zlog.Info().Msgf("Creating maintenance window %s", req.GetWindow().GetName())
+
+	mw, err := db.CreateMaintenanceWindow(ctx, srv.dbClient, ConvertProtoMaintenanceWindowToMaintenanceWindow(req.GetWindow()),
+		networkDeviceIDs(req.GetWindow().GetNetworkDevices()))
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.CreateMaintenanceWindowResponse{
+		Window: ConvertMaintenanceWindowToMaintenanceWindowProto(mw),
+	}, nil
+}
+
+func (srv *server) UpdateMaintenanceWindow(ctx context.Context, req *apiv1.UpdateMaintenanceWindowRequest) (*apiv1.UpdateMaintenanceWindowResponse, error) {
+	zlog.Info().Msgf("Updating maintenance window (%s)", req.GetWindow().GetId())
+
+	// network devices of the window are kept, unless any of them is set
+	var ndIDs []string
+	if len(req.GetWindow().GetNetworkDevices()) > 0 {
+		ndIDs = networkDeviceIDs(req.GetWindow().GetNetworkDevices())
+	}
+	mw, err := db.UpdateMaintenanceWindow(ctx, srv.dbClient, ConvertProtoMaintenanceWindowToMaintenanceWindow(req.GetWindow()), ndIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.UpdateMaintenanceWindowResponse{
+		Window: ConvertMaintenanceWindowToMaintenanceWindowProto(mw),
+	}, nil
+}
+
+func (srv *server) ListMaintenanceWindows(ctx context.Context, _ *emptypb.Empty) (*apiv1.ListMaintenanceWindowsResponse, error) {
+	zlog.Info().Msgf("Retrieving all maintenance windows")
+
+	mws, err := db.ListMaintenanceWindows(ctx, srv.dbClient)
+	if err != nil {
+		return nil, err
+	}
+	retList := make([]*apiv1.MaintenanceWindow, 0, len(mws))
+	for _, mw := range mws {
+		retList = append(retList, ConvertMaintenanceWindowToMaintenanceWindowProto(mw))
+	}
+	return &apiv1.ListMaintenanceWindowsResponse{
+		Windows: retList,
+	}, nil
+}
+
+func (srv *server) DeleteMaintenanceWindow(ctx context.Context, req *apiv1.DeleteMaintenanceWindowRequest) (*apiv1.DeleteMaintenanceWindowResponse, error) {
+	zlog.Info().Msgf("Removing maintenance window (%s)", req.GetId())
+
+	// sanity check for input parameters
+	if req.GetId() == "" {
+		err := fmt.Errorf("ID is not specified")
+		zlog.Error().Err(err).Msg("Failed to delete maintenance window")
+		return nil, err
+	}
+
+	resp := &apiv1.DeleteMaintenanceWindowResponse{
+		Id:      req.GetId(),
+		Deleted: false,
+	}
+	err := db.DeleteMaintenanceWindowByID(ctx, srv.dbClient, req.GetId())
+	if err != nil {
+		// failed to delete maintenance window
+		details := err.Error()
+		resp.Details = &details
+		return resp, err
+	}
+	resp.Deleted = true
+	return resp, nil
+}
+
+// networkDeviceIDs collects IDs of the network devices.
+func networkDeviceIDs(nds []*apiv1.NetworkDevice) []string {
+	ids := make([]string, 0, len(nds))
+	for _, nd := range nds {
+		ids = append(ids, nd.GetId())
+	}
+	return ids
+}
+
+// hasPollingAttributes checks if any of the polling attributes of the network device is set.
+func hasPollingAttributes(nd *apiv1.NetworkDevice) bool {
+	return nd.GetPollInterval() != 0 || nd.GetGroup() != "" || nd.GetSite() != ""
+}
@@ -3,6 +3,8 @@ package server_test
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"os"
 	"testing"
 	"time"
@@ -13,6 +15,7 @@ import (
 	"github.com/eroshiva/trade-show-poc/internal/server"
 	"github.com/eroshiva/trade-show-poc/pkg/client/db"
 	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -71,6 +74,25 @@ func TestAddDevice(t *testing.T) {
 	assert.Equal(t, res.GetDevice().GetModel(), nd.Model)
 	assert.Len(t, res.GetDevice().GetEndpoints(), 2)
 	assert.Len(t, nd.Edges.Endpoints, 2)
+
+	// endpoint of custom protocol carries the name of the protocol
+	ep3 := server.CreateEndpoint(host3, port3, apiv1.Protocol_PROTOCOL_CUSTOM)
+	ep3.ProtocolName = "in-house"
+	res, err = grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_UBIQUITI, "XYZ",
+		[]*apiv1.Endpoint{ep3}))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err = db.DeleteNetworkDeviceByID(context.Background(), client, res.GetDevice().GetId())
+		assert.NoError(t, err)
+	})
+	require.Len(t, res.GetDevice().GetEndpoints(), 1)
+	assert.Equal(t, apiv1.Protocol_PROTOCOL_CUSTOM, res.GetDevice().GetEndpoints()[0].GetProtocol())
+	assert.Equal(t, "in-house", res.GetDevice().GetEndpoints()[0].GetProtocolName())
+
+	// fail - endpoint of custom protocol without the name of the protocol
+	_, err = grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_UBIQUITI, "XYZ",
+		[]*apiv1.Endpoint{server.CreateEndpoint(host3, port2, apiv1.Protocol_PROTOCOL_CUSTOM)}))
+	require.Error(t, err)
 }
 
 func TestDeleteDevice(t *testing.T) {
@@ -400,3 +422,395 @@ func TestSwapDeviceList(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, nd2)
 }
+
+func TestCredentialProfiles(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	t.Setenv(db.EnvCredentialsKey, base64.StdEncoding.EncodeToString(key))
+	db.ReloadCredentialsKey()
+	t.Cleanup(db.ReloadCredentialsKey)
+
+	// creating credential profile, secrets are not returned back
+	created, err := grpcClient.CreateCredentialProfile(ctx, server.CreateCredentialProfileRequest(&apiv1.CredentialProfile{
+		Name:          "profile-" + time.Now().String(),
+		Username:      "admin",
+		Password:      "secret",
+		SnmpCommunity: "private",
+	}))
+	require.NoError(t, err)
+	profile := created.GetProfile()
+	require.NotEmpty(t, profile.GetId())
+	assert.Equal(t, "admin", profile.GetUsername())
+	assert.Empty(t, profile.GetPassword())
+	assert.Empty(t, profile.GetSnmpCommunity())
+	t.Cleanup(func() {
+		resp, err := grpcClient.DeleteCredentialProfile(context.Background(), server.CreateDeleteCredentialProfileRequest(profile.GetId()))
+		assert.NoError(t, err)
+		assert.True(t, resp.GetDeleted())
+	})
+
+	// adding network device, which endpoint references the credential profile
+	ep := server.CreateEndpoint(host1, port1, apiv1.Protocol_PROTOCOL_SNMP)
+	ep.CredentialProfile = &apiv1.CredentialProfile{Id: profile.GetId()}
+	res, err := grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_UBIQUITI, deviceModel,
+		[]*apiv1.Endpoint{ep}))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err = db.DeleteNetworkDeviceByID(context.Background(), client, res.GetDevice().GetId())
+		assert.NoError(t, err)
+	})
+
+	// device list carries reference to the credential profile, but never secrets
+	ndList, err := grpcClient.GetDeviceList(ctx, nil)
+	require.NoError(t, err)
+	found := false
+	for _, nd := range ndList.GetDevices() {
+		if nd.GetId() != res.GetDevice().GetId() {
+			continue
+		}
+		found = true
+		require.Len(t, nd.GetEndpoints(), 1)
+		retProfile := nd.GetEndpoints()[0].GetCredentialProfile()
+		require.NotNil(t, retProfile)
+		assert.Equal(t, profile.GetId(), retProfile.GetId())
+		assert.Empty(t, retProfile.GetPassword())
+		assert.Empty(t, retProfile.GetSnmpCommunity())
+	}
+	assert.True(t, found)
+
+	// updating credential profile
+	updated, err := grpcClient.UpdateCredentialProfile(ctx, &apiv1.UpdateCredentialProfileRequest{
+		Profile: &apiv1.CredentialProfile{Id: profile.GetId(), Token: "token"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, profile.GetName(), updated.GetProfile().GetName())
+	assert.Empty(t, updated.GetProfile().GetToken())
+
+	// listing credential profiles
+	list, err := grpcClient.ListCredentialProfiles(ctx, nil)
+	require.NoError(t, err)
+	for _, cp := range list.GetProfiles() {
+		assert.Empty(t, cp.GetPassword())
+		assert.Empty(t, cp.GetToken())
+	}
+
+	// fail - network device with endpoint referencing non-existing credential profile is not added at all
+	ep = server.CreateEndpoint(host2, port2, apiv1.Protocol_PROTOCOL_SNMP)
+	ep.CredentialProfile = &apiv1.CredentialProfile{Id: "credprof-fake"}
+	_, err = grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_UBIQUITI, deviceModel,
+		[]*apiv1.Endpoint{server.CreateEndpoint(host3, port3, apiv1.Protocol_PROTOCOL_NETCONF), ep}))
+	require.Error(t, err)
+	// no endpoint is left behind
+	eps, err := db.ListEndpoints(ctx, client)
+	require.NoError(t, err)
+	for _, e := range eps {
+		assert.False(t, e.Host == host3 && e.Port == port3)
+	}
+}
+
+func TestPollingDefaults(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	// setting default poll interval of the site
+	resp, err := grpcClient.SetPollingDefault(ctx, server.CreateSetPollingDefaultRequest(apiv1.PollingScope_POLLING_SCOPE_SITE,
+		"remote", 300))
+	require.NoError(t, err)
+	siteDefault := resp.GetDefault()
+	require.NotEmpty(t, siteDefault.GetId())
+	assert.Equal(t, apiv1.PollingScope_POLLING_SCOPE_SITE, siteDefault.GetScope())
+	assert.Equal(t, int32(300), siteDefault.GetPollInterval())
+	t.Cleanup(func() {
+		delResp, err := grpcClient.DeletePollingDefault(context.Background(), server.CreateDeletePollingDefaultRequest(siteDefault.GetId()))
+		assert.NoError(t, err)
+		assert.True(t, delResp.GetDeleted())
+	})
+
+	// overwriting it
+	resp, err = grpcClient.SetPollingDefault(ctx, server.CreateSetPollingDefaultRequest(apiv1.PollingScope_POLLING_SCOPE_SITE,
+		"remote", 600))
+	require.NoError(t, err)
+	assert.Equal(t, siteDefault.GetId(), resp.GetDefault().GetId())
+	assert.Equal(t, int32(600), resp.GetDefault().GetPollInterval())
+
+	list, err := grpcClient.ListPollingDefaults(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, list.GetDefaults(), 1)
+
+	// adding network device with own poll interval, group and site
+	nd := server.CreateNetworkDevice(apiv1.Vendor_VENDOR_CISCO, deviceModel,
+		[]*apiv1.Endpoint{server.CreateEndpoint(host1, port1, apiv1.Protocol_PROTOCOL_SNMP)})
+	nd.PollInterval = 5
+	nd.Group = "core"
+	nd.Site = "remote"
+	res, err := grpcClient.AddDevice(ctx, &apiv1.AddDeviceRequest{Device: nd})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err = db.DeleteNetworkDeviceByID(context.Background(), client, res.GetDevice().GetId())
+		assert.NoError(t, err)
+	})
+	assert.Equal(t, int32(5), res.GetDevice().GetPollInterval())
+	assert.Equal(t, "core", res.GetDevice().GetGroup())
+	assert.Equal(t, "remote", res.GetDevice().GetSite())
+	assert.Len(t, res.GetDevice().GetEndpoints(), 1)
+
+	// updating poll interval of the network device
+	updNd := &apiv1.NetworkDevice{Id: res.GetDevice().GetId(), PollInterval: 60, Group: "core", Site: "remote"}
+	updResp, err := grpcClient.UpdateDeviceList(ctx, server.CreateUpdateDeviceListRequest([]*apiv1.NetworkDevice{updNd}))
+	require.NoError(t, err)
+	require.Len(t, updResp.GetDevices(), 1)
+	assert.Equal(t, int32(60), updResp.GetDevices()[0].GetPollInterval())
+
+	// fail - scope is unspecified
+	_, err = grpcClient.SetPollingDefault(ctx, server.CreateSetPollingDefaultRequest(apiv1.PollingScope_POLLING_SCOPE_UNSPECIFIED,
+		"remote", 300))
+	require.Error(t, err)
+
+	// fail - ID is not specified
+	_, err = grpcClient.DeletePollingDefault(ctx, server.CreateDeletePollingDefaultRequest(""))
+	require.Error(t, err)
+}
+
+func TestListDeviceStatusHistory(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	res, err := grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_CISCO, deviceModel,
+		[]*apiv1.Endpoint{server.CreateEndpoint(host1, port1, apiv1.Protocol_PROTOCOL_SNMP)}))
+	require.NoError(t, err)
+	ndID := res.GetDevice().GetId()
+	t.Cleanup(func() {
+		err = db.DeleteNetworkDeviceByID(context.Background(), client, ndID)
+		assert.NoError(t, err)
+	})
+
+	// device goes up and then down
+	_, err = db.UpdateDeviceStatusByNetworkDeviceID(ctx, client, ndID, devicestatus.StatusSTATUS_DEVICE_UP, time.Now().String(), 0, "device reported up")
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	middle := time.Now()
+	time.Sleep(5 * time.Millisecond)
+	_, err = db.UpdateDeviceStatusByNetworkDeviceID(ctx, client, ndID, devicestatus.StatusSTATUS_DEVICE_DOWN, "", 3, "3 failed attempts")
+	require.NoError(t, err)
+
+	// retrieving the whole history
+	history, err := grpcClient.ListDeviceStatusHistory(ctx, server.CreateListDeviceStatusHistoryRequest(ndID, nil, nil))
+	require.NoError(t, err)
+	assert.Equal(t, ndID, history.GetId())
+	require.Len(t, history.GetEvents(), 2)
+	assert.Equal(t, apiv1.Status_STATUS_UNSPECIFIED, history.GetEvents()[0].GetOldStatus())
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_UP, history.GetEvents()[0].GetNewStatus())
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_UP, history.GetEvents()[1].GetOldStatus())
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_DOWN, history.GetEvents()[1].GetNewStatus())
+	assert.Equal(t, "3 failed attempts", history.GetEvents()[1].GetReason())
+
+	// retrieving history within the time range
+	history, err = grpcClient.ListDeviceStatusHistory(ctx, server.CreateListDeviceStatusHistoryRequest(ndID, &middle, nil))
+	require.NoError(t, err)
+	require.Len(t, history.GetEvents(), 1)
+	assert.Equal(t, apiv1.Status_STATUS_DEVICE_DOWN, history.GetEvents()[0].GetNewStatus())
+
+	// fail - time range is reversed
+	before := middle.Add(-time.Hour)
+	_, err = grpcClient.ListDeviceStatusHistory(ctx, server.CreateListDeviceStatusHistoryRequest(ndID, &middle, &before))
+	require.Error(t, err)
+
+	// fail - ID is not specified
+	_, err = grpcClient.ListDeviceStatusHistory(ctx, server.CreateListDeviceStatusHistoryRequest("", nil, nil))
+	require.Error(t, err)
+
+	// fail - network device does not exist
+	_, err = grpcClient.ListDeviceStatusHistory(ctx, server.CreateListDeviceStatusHistoryRequest(uuid.NewString(), nil, nil))
+	require.Error(t, err)
+}
+
+func TestGetLatencyStats(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	res, err := grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_CISCO, deviceModel,
+		[]*apiv1.Endpoint{
+			server.CreateEndpoint(host1, port1, apiv1.Protocol_PROTOCOL_SNMP),
+			server.CreateEndpoint(host2, port2, apiv1.Protocol_PROTOCOL_NETCONF),
+		}))
+	require.NoError(t, err)
+	ndID := res.GetDevice().GetId()
+	t.Cleanup(func() {
+		err = db.DeleteNetworkDeviceByID(context.Background(), client, ndID)
+		assert.NoError(t, err)
+	})
+	var ep1ID, ep2ID string
+	for _, ep := range res.GetDevice().GetEndpoints() {
+		if ep.GetPort() == port1 {
+			ep1ID = ep.GetId()
+		} else {
+			ep2ID = ep.GetId()
+		}
+	}
+	require.NotEmpty(t, ep1ID)
+	require.NotEmpty(t, ep2ID)
+
+	// no samples yet
+	stats, err := grpcClient.GetLatencyStats(ctx, server.CreateGetLatencyStatsRequest(ndID, "", nil, nil))
+	require.NoError(t, err)
+	assert.Equal(t, ndID, stats.GetId())
+	assert.Zero(t, stats.GetSamples())
+
+	// recording latency samples over both endpoints
+	for _, latency := range []time.Duration{10, 20, 30} {
+		_, err = db.CreateLatencySample(ctx, client, ndID, ep1ID, latency*time.Millisecond)
+		require.NoError(t, err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	middle := time.Now()
+	time.Sleep(5 * time.Millisecond)
+	_, err = db.CreateLatencySample(ctx, client, ndID, ep2ID, 500*time.Millisecond)
+	require.NoError(t, err)
+
+	// statistics over all samples
+	stats, err = grpcClient.GetLatencyStats(ctx, server.CreateGetLatencyStatsRequest(ndID, "", nil, nil))
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), stats.GetSamples())
+	assert.Equal(t, int64(10), stats.GetMin())
+	assert.InDelta(t, 140, stats.GetAvg(), 0.001)
+	assert.Equal(t, int64(500), stats.GetP95())
+	assert.Equal(t, int64(500), stats.GetMax())
+
+	// statistics of the single endpoint
+	stats, err = grpcClient.GetLatencyStats(ctx, server.CreateGetLatencyStatsRequest(ndID, ep1ID, nil, nil))
+	require.NoError(t, err)
+	assert.Equal(t, ep1ID, stats.GetEndpointId())
+	assert.Equal(t, int32(3), stats.GetSamples())
+	assert.InDelta(t, 20, stats.GetAvg(), 0.001)
+	assert.Equal(t, int64(30), stats.GetMax())
+
+	// statistics within the time range
+	stats, err = grpcClient.GetLatencyStats(ctx, server.CreateGetLatencyStatsRequest(ndID, "", &middle, nil))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), stats.GetSamples())
+	assert.Equal(t, int64(500), stats.GetMin())
+
+	// fail - time range is reversed
+	before := middle.Add(-time.Hour)
+	_, err = grpcClient.GetLatencyStats(ctx, server.CreateGetLatencyStatsRequest(ndID, "", &middle, &before))
+	require.Error(t, err)
+
+	// fail - ID is not specified
+	_, err = grpcClient.GetLatencyStats(ctx, server.CreateGetLatencyStatsRequest("", "", nil, nil))
+	require.Error(t, err)
+
+	// fail - network device does not exist
+	_, err = grpcClient.GetLatencyStats(ctx, server.CreateGetLatencyStatsRequest(uuid.NewString(), "", nil, nil))
+	require.Error(t, err)
+}
+
+func TestMaintenanceWindows(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	res, err := grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_CISCO, deviceModel,
+		[]*apiv1.Endpoint{server.CreateEndpoint(host1, port1, apiv1.Protocol_PROTOCOL_SNMP)}))
+	require.NoError(t, err)
+	ndID := res.GetDevice().GetId()
+	t.Cleanup(func() {
+		err = db.DeleteNetworkDeviceByID(context.Background(), client, ndID)
+		assert.NoError(t, err)
+	})
+
+	// creating maintenance window for the network device
+	now := time.Now()
+	resp, err := grpcClient.CreateMaintenanceWindow(ctx, server.CreateMaintenanceWindowRequest("firmware upgrade",
+		now, now.Add(time.Hour), ndID))
+	require.NoError(t, err)
+	mw := resp.GetWindow()
+	require.NotEmpty(t, mw.GetId())
+	assert.Equal(t, "firmware upgrade", mw.GetName())
+	assert.Equal(t, now.UnixMilli(), mw.GetStartsAt())
+	require.Len(t, mw.GetNetworkDevices(), 1)
+	assert.Equal(t, ndID, mw.GetNetworkDevices()[0].GetId())
+
+	// making it recurring and extending it to the whole site
+	updResp, err := grpcClient.UpdateMaintenanceWindow(ctx, &apiv1.UpdateMaintenanceWindowRequest{
+		Window: &apiv1.MaintenanceWindow{Id: mw.GetId(), Site: "remote", Recurrence: "0 2 * * SUN"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "firmware upgrade", updResp.GetWindow().GetName())
+	assert.Equal(t, "remote", updResp.GetWindow().GetSite())
+	assert.Equal(t, "0 2 * * SUN", updResp.GetWindow().GetRecurrence())
+	assert.Len(t, updResp.GetWindow().GetNetworkDevices(), 1)
+
+	list, err := grpcClient.ListMaintenanceWindows(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, list.GetWindows(), 1)
+
+	// fail - window ends before it starts
+	_, err = grpcClient.CreateMaintenanceWindow(ctx, server.CreateMaintenanceWindowRequest("reboot", now, now.Add(-time.Hour)))
+	require.Error(t, err)
+
+	// fail - recurrence is malformed
+	_, err = grpcClient.UpdateMaintenanceWindow(ctx, &apiv1.UpdateMaintenanceWindowRequest{
+		Window: &apiv1.MaintenanceWindow{Id: mw.GetId(), Recurrence: "every sunday"},
+	})
+	require.Error(t, err)
+
+	// removing maintenance window
+	delResp, err := grpcClient.DeleteMaintenanceWindow(ctx, server.CreateDeleteMaintenanceWindowRequest(mw.GetId()))
+	require.NoError(t, err)
+	assert.True(t, delResp.GetDeleted())
+	list, err = grpcClient.ListMaintenanceWindows(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, list.GetWindows())
+
+	// fail - ID is not specified
+	_, err = grpcClient.DeleteMaintenanceWindow(ctx, server.CreateDeleteMaintenanceWindowRequest(""))
+	require.Error(t, err)
+}
+
+func TestSetDeviceParent(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	gwRes, err := grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_CISCO, deviceModel,
+		[]*apiv1.Endpoint{server.CreateEndpoint(host1, port1, apiv1.Protocol_PROTOCOL_SNMP)}))
+	require.NoError(t, err)
+	gwID := gwRes.GetDevice().GetId()
+	apRes, err := grpcClient.AddDevice(ctx, server.CreateAddDeviceRequest(apiv1.Vendor_VENDOR_UBIQUITI, deviceModel,
+		[]*apiv1.Endpoint{server.CreateEndpoint(host2, port2, apiv1.Protocol_PROTOCOL_SNMP)}))
+	require.NoError(t, err)
+	apID := apRes.GetDevice().GetId()
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(context.Background(), client, apID))
+		assert.NoError(t, db.DeleteNetworkDeviceByID(context.Background(), client, gwID))
+	})
+
+	// access point is behind the gateway
+	resp, err := grpcClient.SetDeviceParent(ctx, server.CreateSetDeviceParentRequest(apID, gwID))
+	require.NoError(t, err)
+	assert.Equal(t, apID, resp.GetDevice().GetId())
+
+	list, err := grpcClient.GetDeviceList(ctx, nil)
+	require.NoError(t, err)
+	for _, nd := range list.GetDevices() {
+		if nd.GetId() == gwID {
+			require.Len(t, nd.GetChildren(), 1)
+			assert.Equal(t, apID, nd.GetChildren()[0].GetId())
+		}
+	}
+
+	// fail - dependency cycle
+	_, err = grpcClient.SetDeviceParent(ctx, server.CreateSetDeviceParentRequest(gwID, apID))
+	require.Error(t, err)
+	// fail - ID is not specified
+	_, err = grpcClient.SetDeviceParent(ctx, server.CreateSetDeviceParentRequest("", gwID))
+	require.Error(t, err)
+
+	// clearing the parent
+	_, err = grpcClient.SetDeviceParent(ctx, server.CreateSetDeviceParentRequest(apID, ""))
+	require.NoError(t, err)
+	gw, err := db.GetNetworkDeviceByID(ctx, client, gwID)
+	require.NoError(t, err)
+	assert.Empty(t, gw.Edges.Children)
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
+	"github.com/eroshiva/trade-show-poc/pkg/client/db"
 )
 
 // ConvertNetworkDeviceResourcesToNetworkDevicesProto converts a list of ENT Network Device to Proto Network Device.
@@ -38,10 +40,18 @@ func ConvertNetworkDeviceResourceToNetworkDeviceProto(nd *ent.NetworkDevice) *ap
 		HwVersion: nd.HwVersion,
 		SwVersion: sw,
 		FwVersion: fw,
+
+		PollInterval: nd.PollInterval,
+		Group:        nd.Group,
+		Site:         nd.Site,
 	}
 
 	endpoints := ConvertEndpointsToEndpointsProto(nd.Edges.Endpoints)
 	ret.Endpoints = append(ret.Endpoints, endpoints...)
+	// children are carried with their IDs only
+	for _, child := range nd.Edges.Children {
+		ret.Children = append(ret.Children, &apiv1.NetworkDevice{Id: child.ID})
+	}
 	return ret
 }
 
@@ -85,13 +95,41 @@ func ConvertEndpointsToEndpointsProto(endpoints []*ent.Endpoint) []*apiv1.Endpoi
 	return retList
 }
 
-// ConvertEndpointToEndpointProto converts ENT endpoint to Proto endpoint.
+// ConvertEndpointToEndpointProto converts ENT endpoint to Proto endpoint. Credential profile is carried without secrets.
 func ConvertEndpointToEndpointProto(endpoint *ent.Endpoint) *apiv1.Endpoint {
-	return &apiv1.Endpoint{
+	protoEndpoint := &apiv1.Endpoint{
 		Id:       endpoint.ID,
 		Host:     endpoint.Host,
 		Port:     endpoint.Port,
 		Protocol: ConvertEntProtocolToProtoProtocol(endpoint.Protocol),
+
+		ProtocolName: endpoint.ProtocolName,
+
+		TlsEnabled:            endpoint.TLSEnabled,
+		TlsCaBundle:           endpoint.TLSCaBundle,
+		TlsServerName:         endpoint.TLSServerName,
+		TlsInsecureSkipVerify: endpoint.TLSInsecureSkipVerify,
+
+		Preference: endpoint.Preference,
+	}
+	if endpoint.Edges.CredentialProfile != nil {
+		protoEndpoint.CredentialProfile = ConvertCredentialProfileToCredentialProfileProto(endpoint.Edges.CredentialProfile)
+	}
+	if endpoint.Edges.Health != nil {
+		protoEndpoint.Health = ConvertEndpointHealthToEndpointHealthProto(endpoint.Edges.Health)
+	}
+	return protoEndpoint
+}
+
+// ConvertEndpointHealthToEndpointHealthProto converts ENT endpoint health to Proto endpoint health.
+func ConvertEndpointHealthToEndpointHealthProto(eh *ent.EndpointHealth) *apiv1.EndpointHealth {
+	return &apiv1.EndpointHealth{
+		Id:           eh.ID,
+		LastSuccess:  eh.LastSuccess,
+		LastFailure:  eh.LastFailure,
+		LastError:    eh.LastError,
+		Latency:      eh.Latency,
+		FailureCount: eh.FailureCount,
 	}
 }
 
@@ -114,6 +152,15 @@ func ConvertProtoEndpointToEndpoint(endpoint *apiv1.Endpoint) *ent.Endpoint {
 		Host:     endpoint.GetHost(),
 		Port:     endpoint.GetPort(),
 		Protocol: ConvertProtoProtocolToEntProtocol(endpoint.GetProtocol()),
+
+		ProtocolName: endpoint.GetProtocolName(),
+
+		TLSEnabled:            endpoint.GetTlsEnabled(),
+		TLSCaBundle:           endpoint.GetTlsCaBundle(),
+		TLSServerName:         endpoint.GetTlsServerName(),
+		TLSInsecureSkipVerify: endpoint.GetTlsInsecureSkipVerify(),
+
+		Preference: endpoint.GetPreference(),
 	}
 }
 
@@ -128,6 +175,14 @@ func ConvertEntProtocolToProtoProtocol(protocol endpoint.Protocol) apiv1.Protoco
 		return apiv1.Protocol_PROTOCOL_RESTCONF
 	case endpoint.ProtocolPROTOCOL_OPEN_V_SWITCH:
 		return apiv1.Protocol_PROTOCOL_OPEN_V_SWITCH
+	case endpoint.ProtocolPROTOCOL_GNMI:
+		return apiv1.Protocol_PROTOCOL_GNMI
+	case endpoint.ProtocolPROTOCOL_TCP_CONNECT:
+		return apiv1.Protocol_PROTOCOL_TCP_CONNECT
+	case endpoint.ProtocolPROTOCOL_ICMP:
+		return apiv1.Protocol_PROTOCOL_ICMP
+	case endpoint.ProtocolPROTOCOL_CUSTOM:
+		return apiv1.Protocol_PROTOCOL_CUSTOM
 	default:
 		return apiv1.Protocol_PROTOCOL_UNSPECIFIED
 	}
@@ -144,6 +199,14 @@ func ConvertProtoProtocolToEntProtocol(protocol apiv1.Protocol) endpoint.Protoco
 		return endpoint.ProtocolPROTOCOL_RESTCONF
 	case apiv1.Protocol_PROTOCOL_OPEN_V_SWITCH:
 		return endpoint.ProtocolPROTOCOL_OPEN_V_SWITCH
+	case apiv1.Protocol_PROTOCOL_GNMI:
+		return endpoint.ProtocolPROTOCOL_GNMI
+	case apiv1.Protocol_PROTOCOL_TCP_CONNECT:
+		return endpoint.ProtocolPROTOCOL_TCP_CONNECT
+	case apiv1.Protocol_PROTOCOL_ICMP:
+		return endpoint.ProtocolPROTOCOL_ICMP
+	case apiv1.Protocol_PROTOCOL_CUSTOM:
+		return endpoint.ProtocolPROTOCOL_CUSTOM
 	default:
 		return endpoint.ProtocolPROTOCOL_UNSPECIFIED
 	}
@@ -195,6 +258,7 @@ func CompareNetworkDeviceResources(nd1, nd2 *ent.NetworkDevice) bool {
 		for _, nd2Endpoint := range nd2.Edges.Endpoints {
 			if nd1Endpoint.ID == nd2Endpoint.ID {
 				if nd1Endpoint.Protocol == nd2Endpoint.Protocol &&
+					nd1Endpoint.ProtocolName == nd2Endpoint.ProtocolName &&
 					nd1Endpoint.Host == nd2Endpoint.Host &&
 					nd1Endpoint.Port == nd2Endpoint.Port {
 					found = true
@@ -214,6 +278,16 @@ func ConvertEntDeviceStatusToProtoDeviceStatus(ds *ent.DeviceStatus) *apiv1.Devi
 		Id:       ds.ID,
 		Status:   ConvertEntStatusToProtoStatus(ds.Status),
 		LastSeen: ds.LastSeen,
+		NextPoll: ds.NextPoll,
+
+		PendingStatus:   ConvertEntStatusToProtoStatus(devicestatus.Status(ds.PendingStatus)),
+		PendingReadings: ds.PendingReadings,
+		Flapping:        ds.Flapping,
+		InMaintenance:   ds.InMaintenance,
+
+		AnsweredEndpointId: ds.AnsweredEndpointID,
+		AnsweredProtocol:   ConvertEntProtocolToProtoProtocol(endpoint.Protocol(ds.AnsweredProtocol)),
+		LastError:          ds.LastError,
 	}
 	if ds.Edges.NetworkDevice != nil {
 		protoDS.NetworkDevice = ConvertNetworkDeviceResourceToNetworkDeviceProto(ds.Edges.NetworkDevice)
@@ -230,6 +304,8 @@ func ConvertEntStatusToProtoStatus(status devicestatus.Status) apiv1.Status {
 		return apiv1.Status_STATUS_DEVICE_UNHEALTHY
 	case devicestatus.StatusSTATUS_DEVICE_DOWN:
 		return apiv1.Status_STATUS_DEVICE_DOWN
+	case devicestatus.StatusSTATUS_DEVICE_UNREACHABLE:
+		return apiv1.Status_STATUS_DEVICE_UNREACHABLE
 	default:
 		return apiv1.Status_STATUS_UNSPECIFIED
 	}
@@ -244,7 +320,139 @@ func ConvertProtoStatusToEntStatus(status apiv1.Status) devicestatus.Status {
 		return devicestatus.StatusSTATUS_DEVICE_DOWN
 	case apiv1.Status_STATUS_DEVICE_UNHEALTHY:
 		return devicestatus.StatusSTATUS_DEVICE_UNHEALTHY
+	case apiv1.Status_STATUS_DEVICE_UNREACHABLE:
+		return devicestatus.StatusSTATUS_DEVICE_UNREACHABLE
 	default:
 		return devicestatus.StatusSTATUS_UNSPECIFIED
 	}
 }
+
+// ConvertCredentialProfileToCredentialProfileProto converts ENT credential profile to Proto credential profile.
+// Secrets are never converted, they must not leave the system.
+func ConvertCredentialProfileToCredentialProfileProto(cp *ent.CredentialProfile) *apiv1.CredentialProfile {
+	return &apiv1.CredentialProfile{
+		Id:               cp.ID,
+		Name:             cp.Name,
+		Username:         cp.Username,
+		SnmpAuthProtocol: cp.SnmpAuthProtocol,
+		SnmpPrivProtocol: cp.SnmpPrivProtocol,
+
+		TlsClientCertificate: cp.TLSClientCertificate,
+
+		SshKnownHosts:            cp.SSHKnownHosts,
+		SshInsecureIgnoreHostKey: cp.SSHInsecureIgnoreHostKey,
+	}
+}
+
+// ConvertProtoCredentialProfileToCredentialProfile converts Proto credential profile (including secrets)
+// to ENT credential profile.
+func ConvertProtoCredentialProfileToCredentialProfile(cp *apiv1.CredentialProfile) *ent.CredentialProfile {
+	return &ent.CredentialProfile{
+		ID:                 cp.GetId(),
+		Name:               cp.GetName(),
+		Username:           cp.GetUsername(),
+		Password:           cp.GetPassword(),
+		SnmpCommunity:      cp.GetSnmpCommunity(),
+		PrivateKey:         cp.GetPrivateKey(),
+		Token:              cp.GetToken(),
+		SnmpPrivPassphrase: cp.GetSnmpPrivPassphrase(),
+		SnmpAuthProtocol:   cp.GetSnmpAuthProtocol(),
+		SnmpPrivProtocol:   cp.GetSnmpPrivProtocol(),
+
+		TLSClientKey:         cp.GetTlsClientKey(),
+		TLSClientCertificate: cp.GetTlsClientCertificate(),
+
+		SSHKnownHosts:            cp.GetSshKnownHosts(),
+		SSHInsecureIgnoreHostKey: cp.GetSshInsecureIgnoreHostKey(),
+	}
+}
+
+// ConvertPollingDefaultToPollingDefaultProto converts ENT polling default to Proto polling default.
+func ConvertPollingDefaultToPollingDefaultProto(pd *ent.PollingDefault) *apiv1.PollingDefault {
+	return &apiv1.PollingDefault{
+		Id:           pd.ID,
+		Scope:        ConvertEntPollingScopeToProtoPollingScope(pd.Scope),
+		Name:         pd.Name,
+		PollInterval: pd.PollInterval,
+	}
+}
+
+// ConvertEntPollingScopeToProtoPollingScope converts ENT polling scope to Proto polling scope.
+func ConvertEntPollingScopeToProtoPollingScope(scope pollingdefault.Scope) apiv1.PollingScope {
+	switch scope {
+	case pollingdefault.ScopePOLLING_SCOPE_GROUP:
+		return apiv1.PollingScope_POLLING_SCOPE_GROUP
+	case pollingdefault.ScopePOLLING_SCOPE_SITE:
+		return apiv1.PollingScope_POLLING_SCOPE_SITE
+	default:
+		return apiv1.PollingScope_POLLING_SCOPE_UNSPECIFIED
+	}
+}
+
+// ConvertProtoPollingScopeToEntPollingScope converts Proto polling scope to ENT polling scope.
+func ConvertProtoPollingScopeToEntPollingScope(scope apiv1.PollingScope) pollingdefault.Scope {
+	switch scope {
+	case apiv1.PollingScope_POLLING_SCOPE_GROUP:
+		return pollingdefault.ScopePOLLING_SCOPE_GROUP
+	case apiv1.PollingScope_POLLING_SCOPE_SITE:
+		return pollingdefault.ScopePOLLING_SCOPE_SITE
+	default:
+		return pollingdefault.ScopePOLLING_SCOPE_UNSPECIFIED
+	}
+}
+
+// ConvertStatusEventToStatusEventProto converts ENT status event to Proto status event.
+func ConvertStatusEventToStatusEventProto(se *ent.StatusEvent) *apiv1.StatusEvent {
+	return &apiv1.StatusEvent{
+		Id:        se.ID,
+		OldStatus: ConvertEntStatusToProtoStatus(devicestatus.Status(se.OldStatus)),
+		NewStatus: ConvertEntStatusToProtoStatus(devicestatus.Status(se.NewStatus)),
+		Timestamp: se.Timestamp,
+		Reason:    se.Reason,
+	}
+}
+
+// ConvertLatencyStatsToLatencyStatsProto converts latency statistics computed by the DB to Proto latency statistics.
+// Statistics are zero, when there are no samples.
+func ConvertLatencyStatsToLatencyStatsProto(stats *db.LatencyStats) *apiv1.GetLatencyStatsResponse {
+	return &apiv1.GetLatencyStatsResponse{
+		Samples: int32(stats.Samples),
+		Min:     stats.Min.Int64,
+		Avg:     stats.Avg.Float64,
+		P95:     stats.P95.Int64,
+		Max:     stats.Max.Int64,
+	}
+}
+
+// ConvertMaintenanceWindowToMaintenanceWindowProto converts ENT maintenance window to Proto maintenance window.
+// Network devices are carried with their IDs only.
+func ConvertMaintenanceWindowToMaintenanceWindowProto(mw *ent.MaintenanceWindow) *apiv1.MaintenanceWindow {
+	nds := make([]*apiv1.NetworkDevice, 0, len(mw.Edges.NetworkDevices))
+	for _, nd := range mw.Edges.NetworkDevices {
+		nds = append(nds, &apiv1.NetworkDevice{Id: nd.ID})
+	}
+	return &apiv1.MaintenanceWindow{
+		Id:             mw.ID,
+		Name:           mw.Name,
+		Group:          mw.Group,
+		Site:           mw.Site,
+		StartsAt:       mw.StartsAt,
+		EndsAt:         mw.EndsAt,
+		Recurrence:     mw.Recurrence,
+		NetworkDevices: nds,
+	}
+}
+
+// ConvertProtoMaintenanceWindowToMaintenanceWindow converts Proto maintenance window (without network devices)
+// to ENT maintenance window.
+func ConvertProtoMaintenanceWindowToMaintenanceWindow(mw *apiv1.MaintenanceWindow) *ent.MaintenanceWindow {
+	return &ent.MaintenanceWindow{
+		ID:         mw.GetId(),
+		Name:       mw.GetName(),
+		Group:      mw.GetGroup(),
+		Site:       mw.GetSite(),
+		StartsAt:   mw.GetStartsAt(),
+		EndsAt:     mw.GetEndsAt(),
+		Recurrence: mw.GetRecurrence(),
+	}
+}
@@ -4,6 +4,7 @@ package server_test
 import (
 	"context"
 	"crypto/sha256"
+	"database/sql"
 	"fmt"
 	"testing"
 
@@ -126,3 +127,24 @@ func TestConvertNetworkDeviceResourceToNetworkDeviceProto(t *testing.T) {
 	assert.Equal(t, updND.Edges.FwVersion.Version, protoND.GetFwVersion().GetVersion())
 	assert.Equal(t, updND.Edges.FwVersion.Checksum, protoND.GetFwVersion().GetChecksum())
 }
+
+func TestConvertLatencyStatsToLatencyStatsProto(t *testing.T) {
+	// no samples
+	stats := server.ConvertLatencyStatsToLatencyStatsProto(&db.LatencyStats{})
+	assert.Zero(t, stats.GetSamples())
+	assert.Zero(t, stats.GetMax())
+
+	// statistics computed by the DB
+	stats = server.ConvertLatencyStatsToLatencyStatsProto(&db.LatencyStats{
+		Samples: 100,
+		Min:     sql.NullInt64{Int64: 1, Valid: true},
+		Avg:     sql.NullFloat64{Float64: 50.5, Valid: true},
+		P95:     sql.NullInt64{Int64: 95, Valid: true},
+		Max:     sql.NullInt64{Int64: 100, Valid: true},
+	})
+	assert.Equal(t, int32(100), stats.GetSamples())
+	assert.Equal(t, int64(1), stats.GetMin())
+	assert.InDelta(t, 50.5, stats.GetAvg(), 0.001)
+	assert.Equal(t, int64(95), stats.GetP95())
+	assert.Equal(t, int64(100), stats.GetMax())
+}
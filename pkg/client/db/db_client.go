@@ -3,18 +3,29 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"time"
 
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/credentialprofile"
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
+	"github.com/eroshiva/trade-show-poc/internal/ent/latencysample"
+	"github.com/eroshiva/trade-show-poc/internal/ent/lease"
+	"github.com/eroshiva/trade-show-poc/internal/ent/maintenancewindow"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
+	"github.com/eroshiva/trade-show-poc/internal/ent/replicaheartbeat"
+	"github.com/eroshiva/trade-show-poc/internal/ent/statusevent"
 	"github.com/eroshiva/trade-show-poc/internal/ent/version"
 	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
 	"github.com/rs/zerolog"
 )
 
@@ -22,10 +33,19 @@ const (
 	component     = "component"
 	componentName = "db-client"
 	// defining prefixes of the resources for the sake of easy post-manipulation
-	networkDevicePrefix = "netdev-"
-	endpointPrefix      = "endpoint-"
-	deviceStatusPrefix  = "devstat-"
-	versionPrefix       = "version-"
+	networkDevicePrefix  = "netdev-"
+	endpointPrefix       = "endpoint-"
+	deviceStatusPrefix   = "devstat-"
+	versionPrefix        = "version-"
+	credentialPrefix     = "credprof-"
+	pollingDefaultPrefix = "polldef-"
+	statusEventPrefix    = "statevt-"
+	maintenancePrefix    = "maintwin-"
+	endpointHealthPrefix = "ephealth-"
+	latencySamplePrefix  = "latsmpl-"
+
+	// staleReplicaTimeouts is a number of heartbeat timeouts, after which the heartbeat of the dead replica is removed.
+	staleReplicaTimeouts = 10
 )
 
 var zlog = zerolog.New(zerolog.ConsoleWriter{
@@ -69,8 +89,22 @@ func CreateNetworkDevice(ctx context.Context, client *ent.Client, model string,
 	return nd, nil
 }
 
-// UpdateNetworkDeviceByUser is used to update Network Device resource by user. Endpoints are overwritten.
+// UpdateNetworkDeviceByUser is used to update Network Device resource by user. Endpoints are overwritten, their
+// preference is set to the provided one. All of it is updated in a single transaction.
 func UpdateNetworkDeviceByUser(ctx context.Context, client *ent.Client, id, model string, vendor networkdevice.Vendor, endpoints []*ent.Endpoint) (*ent.NetworkDevice, error) {
+	var nd *ent.NetworkDevice
+	err := WithTx(ctx, client, func(client *ent.Client) error {
+		var err error
+		nd, err = updateNetworkDeviceByUser(ctx, client, id, model, vendor, endpoints)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nd, nil
+}
+
+func updateNetworkDeviceByUser(ctx context.Context, client *ent.Client, id, model string, vendor networkdevice.Vendor, endpoints []*ent.Endpoint) (*ent.NetworkDevice, error) {
 	zlog.Debug().Msgf("Updating network device (%v)", id)
 	nd, err := GetNetworkDeviceByID(ctx, client, id)
 	if err != nil {
@@ -105,6 +139,15 @@ func UpdateNetworkDeviceByUser(ctx context.Context, client *ent.Client, id, mode
 		return nil, err
 	}
 
+	// preference of the endpoints is carried by the request
+	for _, ep := range endpoints {
+		_, err = SetEndpointPreference(ctx, client, ep.ID, ep.Preference)
+		if err != nil {
+			// error is already logged in in the inner function
+			return nil, err
+		}
+	}
+
 	return nd, nil
 }
 
@@ -253,6 +296,110 @@ func UpdateNetworkDeviceVersions(ctx context.Context, client *ent.Client, id, hw
 	return nd, nil
 }
 
+// SetNetworkDevicePolling replaces poll interval (in seconds), group and site of the network device. Zero poll
+// interval means that the default one is used.
+func SetNetworkDevicePolling(ctx context.Context, client *ent.Client, id string, pollInterval int32, group, site string) (*ent.NetworkDevice, error) {
+	zlog.Debug().Msgf("Setting poll interval (%d), group (%s) and site (%s) of network device (%s)", pollInterval, group, site, id)
+	if pollInterval < 0 {
+		err := fmt.Errorf("poll interval can't be negative")
+		zlog.Error().Err(err).Send()
+		return nil, err
+	}
+	_, err := client.NetworkDevice.UpdateOneID(id).
+		SetPollInterval(pollInterval).
+		SetGroup(group).
+		SetSite(site).
+		Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to set polling attributes of network device (%s)", id)
+		return nil, err
+	}
+
+	return GetNetworkDeviceByID(ctx, client, id)
+}
+
+// SetNetworkDeviceParent sets the parent (i.e., upstream network device, which the network device depends on)
+// of the network device. Previous parent is replaced, empty parentID clears the parent. Parent must not be
+// the network device itself or any of its descendants, so that the topology remains a tree. Check and update of
+// the parent are performed in a single transaction.
+func SetNetworkDeviceParent(ctx context.Context, client *ent.Client, id, parentID string) (*ent.NetworkDevice, error) {
+	zlog.Debug().Msgf("Setting parent of network device (%s) to (%s)", id, parentID)
+	if id == "" {
+		err := fmt.Errorf("ID of the network device is unspecified")
+		zlog.Error().Err(err).Send()
+		return nil, err
+	}
+	var nd *ent.NetworkDevice
+	err := WithTx(ctx, client, func(client *ent.Client) error {
+		var err error
+		nd, err = setNetworkDeviceParent(ctx, client, id, parentID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nd, nil
+}
+
+func setNetworkDeviceParent(ctx context.Context, client *ent.Client, id, parentID string) (*ent.NetworkDevice, error) {
+	if _, err := GetNetworkDeviceByID(ctx, client, id); err != nil {
+		return nil, err
+	}
+
+	upd := client.NetworkDevice.UpdateOneID(id)
+	if parentID == "" {
+		upd = upd.ClearParent()
+	} else {
+		// walking up from the new parent, the network device must not be found among its ancestors
+		ancestorID := parentID
+		for ancestorID != "" {
+			if ancestorID == id {
+				err := fmt.Errorf("network device (%s) can't depend on itself", id)
+				zlog.Error().Err(err).Msgf("Failed to set parent of network device (%s)", id)
+				return nil, err
+			}
+			ancestor, err := GetNetworkDeviceParent(ctx, client, ancestorID)
+			if err != nil {
+				return nil, err
+			}
+			ancestorID = ""
+			if ancestor != nil {
+				ancestorID = ancestor.ID
+			}
+		}
+		// making sure the parent exists
+		if _, err := GetNetworkDeviceByID(ctx, client, parentID); err != nil {
+			return nil, err
+		}
+		upd = upd.SetParentID(parentID)
+	}
+	if _, err := upd.Save(ctx); err != nil {
+		zlog.Error().Err(err).Msgf("Failed to set parent of network device (%s) to (%s)", id, parentID)
+		return nil, err
+	}
+
+	return GetNetworkDeviceByID(ctx, client, id)
+}
+
+// GetNetworkDeviceParent retrieves the parent of the network device with provided ID. It returns nil,
+// when the network device has no parent.
+func GetNetworkDeviceParent(ctx context.Context, client *ent.Client, id string) (*ent.NetworkDevice, error) {
+	zlog.Debug().Msgf("Retrieving parent of network device (%s)", id)
+	parent, err := client.NetworkDevice.Query().
+		Where(networkdevice.ID(id)).
+		QueryParent().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to get parent of network device (%s)", id)
+		return nil, err
+	}
+
+	return parent, nil
+}
+
 // GetNetworkDeviceByID retrieves a Network Device resource by ID from the DB.
 func GetNetworkDeviceByID(ctx context.Context, client *ent.Client, id string) (*ent.NetworkDevice, error) {
 	zlog.Debug().Msgf("Retrieving network device by ID: %s", id)
@@ -260,9 +407,10 @@ func GetNetworkDeviceByID(ctx context.Context, client *ent.Client, id string) (*
 		Query().
 		Where(networkdevice.ID(id)).
 		// Eager-loading all edges
-		WithEndpoints().
+		WithEndpoints(withEndpointEdges).
 		WithFwVersion().
 		WithSwVersion().
+		WithChildren().
 		Only(ctx)
 	if err != nil {
 		zlog.Error().Err(err).Msgf("Failed to get network device (%s)", id)
@@ -282,7 +430,8 @@ func GetNetworkDeviceByEndpoint(ctx context.Context, client *ent.Client, host, p
 		// Eager-loading all edges
 		WithFwVersion().
 		WithSwVersion().
-		WithEndpoints().
+		WithEndpoints(withEndpointEdges).
+		WithChildren().
 		Only(ctx)
 	if err != nil {
 		zlog.Error().Err(err).Msgf("Failed to get network device by host %s:%s", host, port)
@@ -292,14 +441,54 @@ func GetNetworkDeviceByEndpoint(ctx context.Context, client *ent.Client, host, p
 	return nd, nil
 }
 
+// ShardFilter reports whether the network device with provided ID belongs to the shard.
+type ShardFilter func(networkDeviceID string) bool
+
+// ListNetworkDevicesInShard retrieves Network Device resources, which belong to the shard. Only IDs of all network
+// devices are read, the edges are eager-loaded for the network devices in the shard.
+func ListNetworkDevicesInShard(ctx context.Context, client *ent.Client, inShard ShardFilter) ([]*ent.NetworkDevice, error) {
+	zlog.Debug().Msg("Listing network devices in the shard")
+	ids, err := client.NetworkDevice.Query().IDs(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msg("Failed to list IDs of network devices")
+		return nil, err
+	}
+	owned := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if inShard(id) {
+			owned = append(owned, id)
+		}
+	}
+	zlog.Debug().Msgf("%d out of %d network devices belong to the shard", len(owned), len(ids))
+	if len(owned) == 0 {
+		return []*ent.NetworkDevice{}, nil
+	}
+
+	nds, err := client.NetworkDevice.Query().
+		Where(networkdevice.IDIn(owned...)).
+		// Eager-loading edges
+		WithEndpoints(withEndpointEdges).
+		WithSwVersion().
+		WithFwVersion().
+		WithChildren().
+		All(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msg("Failed to list network devices in the shard")
+		return nil, err
+	}
+
+	return nds, nil
+}
+
 // ListNetworkDevices retrieves all Network Device resources present in the system.
 func ListNetworkDevices(ctx context.Context, client *ent.Client) ([]*ent.NetworkDevice, error) {
 	zlog.Debug().Msg("Listing all network devices")
 	nds, err := client.NetworkDevice.Query().
 		// Eager-loading edges
-		WithEndpoints().
+		WithEndpoints(withEndpointEdges).
 		WithSwVersion().
 		WithFwVersion().
+		WithChildren().
 		All(ctx)
 	if err != nil {
 		zlog.Error().Err(err).Msg("Failed to list network devices")
@@ -348,6 +537,53 @@ func CreateEndpoint(ctx context.Context, client *ent.Client, host, port string,
 	return ep, nil
 }
 
+// CreateEndpointResource creates an Endpoint resource with attributes carried by the provided endpoint. Endpoint
+// references credential profile with provided ID, when it is set. All of it is stored in a single insert.
+func CreateEndpointResource(ctx context.Context, client *ent.Client, ep *ent.Endpoint, profileID string) (*ent.Endpoint, error) {
+	// input parameters sanity
+	if ep.Host == "" || ep.Port == "" || ep.Protocol == "" {
+		err := fmt.Errorf("one of the input parameters is missing")
+		zlog.Error().Err(err).Msgf("Failed to create %s endpoint on %s:%s", ep.Protocol, ep.Host, ep.Port)
+		return nil, err
+	}
+	if ep.Protocol == endpoint.ProtocolPROTOCOL_CUSTOM && ep.ProtocolName == "" {
+		err := fmt.Errorf("protocol name is required for custom protocol")
+		zlog.Error().Err(err).Msgf("Failed to create %s endpoint on %s:%s", ep.Protocol, ep.Host, ep.Port)
+		return nil, err
+	}
+	if ep.Preference < 0 {
+		err := fmt.Errorf("preference must not be negative")
+		zlog.Error().Err(err).Msgf("Failed to create %s endpoint on %s:%s", ep.Protocol, ep.Host, ep.Port)
+		return nil, err
+	}
+	zlog.Debug().Msgf("Creating %s endpoint on %s:%s", ep.Protocol, ep.Host, ep.Port)
+
+	// generating random ID for the endpoint
+	id := endpointPrefix + uuid.NewString()
+	create := client.Endpoint.
+		Create().
+		SetID(id).
+		SetHost(ep.Host).
+		SetPort(ep.Port).
+		SetProtocol(ep.Protocol).
+		SetProtocolName(ep.ProtocolName).
+		SetTLSEnabled(ep.TLSEnabled).
+		SetTLSCaBundle(ep.TLSCaBundle).
+		SetTLSServerName(ep.TLSServerName).
+		SetTLSInsecureSkipVerify(ep.TLSInsecureSkipVerify).
+		SetPreference(ep.Preference)
+	if profileID != "" {
+		create.SetCredentialProfileID(profileID)
+	}
+	_, err := create.Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msg("Failed to create endpoint")
+		return nil, err
+	}
+
+	return GetEndpointByID(ctx, client, id)
+}
+
 // UpdateEndpoint updates endpoint associated with provided ID with new details.
 func UpdateEndpoint(ctx context.Context, client *ent.Client, id string, host, port string, protocol endpoint.Protocol) (*ent.Endpoint, error) {
 	zlog.Debug().Msgf("Updating %s endpoint (%s) on %s:%s", protocol, id, host, port)
@@ -394,6 +630,8 @@ func GetEndpointByID(ctx context.Context, client *ent.Client, id string) (*ent.E
 	zlog.Debug().Msgf("Retrieving endpoint (%s)", id)
 	ep, err := client.Endpoint.Query().
 		Where(endpoint.ID(id)).
+		WithCredentialProfile().
+		WithHealth().
 		Only(ctx)
 	if err != nil {
 		zlog.Error().Err(err).Msgf("Failed to get endpoint (%s)", id)
@@ -406,7 +644,7 @@ func GetEndpointByID(ctx context.Context, client *ent.Client, id string) (*ent.E
 // ListEndpoints lists all endpoint resources present in the system.
 func ListEndpoints(ctx context.Context, client *ent.Client) ([]*ent.Endpoint, error) {
 	zlog.Debug().Msgf("Listing endpoints")
-	eps, err := client.Endpoint.Query().All(ctx)
+	eps, err := client.Endpoint.Query().WithCredentialProfile().WithHealth().All(ctx)
 	if err != nil {
 		zlog.Error().Err(err).Msg("Failed to list endpoints")
 		return nil, err
@@ -415,18 +653,172 @@ func ListEndpoints(ctx context.Context, client *ent.Client) ([]*ent.Endpoint, er
 	return eps, nil
 }
 
-// DeleteEndpointByID deletes endpoint resource by provided ID.
+// DeleteEndpointByID deletes endpoint resource (together with its health) by provided ID.
 func DeleteEndpointByID(ctx context.Context, client *ent.Client, id string) error {
 	zlog.Debug().Msgf("Deleting endpoint (%s)", id)
-	_, err := client.Endpoint.Delete().Where(endpoint.ID(id)).Exec(ctx)
+	healthIDs, err := client.Endpoint.Query().Where(endpoint.ID(id)).QueryHealth().IDs(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to get health of endpoint (%s)", id)
+		return err
+	}
+	_, err = client.Endpoint.Delete().Where(endpoint.ID(id)).Exec(ctx)
 	if err != nil {
 		zlog.Error().Err(err).Msgf("Failed to delete endpoint (%s)", id)
 		return err
 	}
+	_, err = client.EndpointHealth.Delete().Where(endpointhealth.IDIn(healthIDs...)).Exec(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to delete health of endpoint (%s)", id)
+		return err
+	}
 
 	return nil
 }
 
+// SetEndpointCredentialProfile references credential profile by the endpoint. Empty profile ID removes the reference.
+func SetEndpointCredentialProfile(ctx context.Context, client *ent.Client, id, profileID string) (*ent.Endpoint, error) {
+	zlog.Debug().Msgf("Setting credential profile (%s) of endpoint (%s)", profileID, id)
+	upd := client.Endpoint.UpdateOneID(id)
+	if profileID == "" {
+		upd.ClearCredentialProfile()
+	} else {
+		upd.SetCredentialProfileID(profileID)
+	}
+	ep, err := upd.Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to set credential profile (%s) of endpoint (%s)", profileID, id)
+		return nil, err
+	}
+
+	return GetEndpointByID(ctx, client, ep.ID)
+}
+
+// SetEndpointTLS replaces TLS settings of the endpoint.
+func SetEndpointTLS(ctx context.Context, client *ent.Client, id string, enabled bool, caBundle, serverName string, insecureSkipVerify bool) (*ent.Endpoint, error) {
+	zlog.Debug().Msgf("Setting TLS settings of endpoint (%s)", id)
+	_, err := client.Endpoint.UpdateOneID(id).
+		SetTLSEnabled(enabled).
+		SetTLSCaBundle(caBundle).
+		SetTLSServerName(serverName).
+		SetTLSInsecureSkipVerify(insecureSkipVerify).
+		Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to set TLS settings of endpoint (%s)", id)
+		return nil, err
+	}
+
+	return GetEndpointByID(ctx, client, id)
+}
+
+// SetEndpointPreference sets preference of the endpoint, i.e., the order, in which endpoints of the network device are
+// probed. Lower preference is probed first, 0 means no preference.
+func SetEndpointPreference(ctx context.Context, client *ent.Client, id string, preference int32) (*ent.Endpoint, error) {
+	zlog.Debug().Msgf("Setting preference of endpoint (%s) to %d", id, preference)
+	if preference < 0 {
+		err := fmt.Errorf("preference must not be negative")
+		zlog.Error().Err(err).Msgf("Failed to set preference of endpoint (%s)", id)
+		return nil, err
+	}
+	_, err := client.Endpoint.UpdateOneID(id).
+		SetPreference(preference).
+		Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to set preference of endpoint (%s)", id)
+		return nil, err
+	}
+
+	return GetEndpointByID(ctx, client, id)
+}
+
+// GetEndpointHealthByEndpointID retrieves health of the endpoint with provided ID.
+func GetEndpointHealthByEndpointID(ctx context.Context, client *ent.Client, endpointID string) (*ent.EndpointHealth, error) {
+	zlog.Debug().Msgf("Retrieving health of endpoint (%s)", endpointID)
+	eh, err := client.Endpoint.Query().
+		Where(endpoint.ID(endpointID)).
+		QueryHealth().
+		Only(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to get health of endpoint (%s)", endpointID)
+		return nil, err
+	}
+
+	return eh, nil
+}
+
+// getOrCreateEndpointHealth retrieves health of the endpoint with provided ID. It creates one, when the endpoint has
+// no health yet.
+func getOrCreateEndpointHealth(ctx context.Context, client *ent.Client, endpointID string) (*ent.EndpointHealth, error) {
+	eh, err := client.Endpoint.Query().
+		Where(endpoint.ID(endpointID)).
+		QueryHealth().
+		Only(ctx)
+	if err == nil {
+		return eh, nil
+	}
+	if !ent.IsNotFound(err) {
+		zlog.Error().Err(err).Msgf("Failed to get health of endpoint (%s)", endpointID)
+		return nil, err
+	}
+
+	zlog.Debug().Msgf("Creating health of endpoint (%s)", endpointID)
+	id := endpointHealthPrefix + uuid.NewString()
+	eh, err = client.EndpointHealth.Create().SetID(id).Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to create health of endpoint (%s)", endpointID)
+		return nil, err
+	}
+	_, err = client.Endpoint.UpdateOneID(endpointID).SetHealthID(id).Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to set health of endpoint (%s)", endpointID)
+		// removing the health, which doesn't belong to any endpoint
+		_, _ = client.EndpointHealth.Delete().Where(endpointhealth.ID(id)).Exec(ctx)
+		return nil, err
+	}
+
+	return eh, nil
+}
+
+// RecordEndpointSuccess records successful probe of the endpoint with provided ID, which took provided latency.
+// Consecutive failures of the endpoint are zeroed.
+func RecordEndpointSuccess(ctx context.Context, client *ent.Client, endpointID string, latency time.Duration) (*ent.EndpointHealth, error) {
+	zlog.Debug().Msgf("Endpoint (%s) has answered in %s", endpointID, latency)
+	eh, err := getOrCreateEndpointHealth(ctx, client, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	eh, err = client.EndpointHealth.UpdateOneID(eh.ID).
+		SetLastSuccess(time.Now().UnixMilli()).
+		SetLatency(latency.Milliseconds()).
+		SetFailureCount(0).
+		Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to record success of endpoint (%s)", endpointID)
+		return nil, err
+	}
+
+	return eh, nil
+}
+
+// RecordEndpointFailure records failed probe of the endpoint with provided ID together with its error message.
+func RecordEndpointFailure(ctx context.Context, client *ent.Client, endpointID, lastError string) (*ent.EndpointHealth, error) {
+	zlog.Debug().Msgf("Endpoint (%s) has failed: %s", endpointID, lastError)
+	eh, err := getOrCreateEndpointHealth(ctx, client, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	eh, err = client.EndpointHealth.UpdateOneID(eh.ID).
+		SetLastFailure(time.Now().UnixMilli()).
+		SetLastError(lastError).
+		AddFailureCount(1).
+		Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to record failure of endpoint (%s)", endpointID)
+		return nil, err
+	}
+
+	return eh, nil
+}
+
 // CreateDeviceStatus creates a device status resource for the specific network device.
 func CreateDeviceStatus(ctx context.Context, client *ent.Client, status devicestatus.Status, lastSeen string, cal int32, nd *ent.NetworkDevice) (*ent.DeviceStatus, error) {
 	// input parameters sanity
@@ -531,8 +923,22 @@ func ListDeviceStatusResources(ctx context.Context, client *ent.Client) ([]*ent.
 }
 
 // UpdateDeviceStatusByNetworkDeviceID updates device status for the network device with provided ID. If device status for this
-// network device does not exist, it creates one.
-func UpdateDeviceStatusByNetworkDeviceID(ctx context.Context, client *ent.Client, networkDeviceID string, status devicestatus.Status, lastSeen string, cal int32) (*ent.DeviceStatus, error) {
+// network device does not exist, it creates one. Change of the status is recorded as a status event with provided reason
+// in the same transaction.
+func UpdateDeviceStatusByNetworkDeviceID(ctx context.Context, client *ent.Client, networkDeviceID string, status devicestatus.Status, lastSeen string, cal int32, reason string) (*ent.DeviceStatus, error) {
+	var ds *ent.DeviceStatus
+	err := WithTx(ctx, client, func(client *ent.Client) error {
+		var err error
+		ds, err = updateDeviceStatusByNetworkDeviceID(ctx, client, networkDeviceID, status, lastSeen, cal, reason)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+func updateDeviceStatusByNetworkDeviceID(ctx context.Context, client *ent.Client, networkDeviceID string, status devicestatus.Status, lastSeen string, cal int32, reason string) (*ent.DeviceStatus, error) {
 	zlog.Debug().Msgf("Updating device status resource by network device (%s)", networkDeviceID)
 	ds, err := GetDeviceStatusByNetworkDeviceID(ctx, client, networkDeviceID)
 	if err != nil {
@@ -550,10 +956,15 @@ func UpdateDeviceStatusByNetworkDeviceID(ctx context.Context, client *ent.Client
 		if err != nil {
 			return nil, err
 		}
+		_, err = CreateStatusEvent(ctx, client, nd.ID, devicestatus.StatusSTATUS_UNSPECIFIED, ds.Status, reason)
+		if err != nil {
+			return nil, err
+		}
 		return ds, nil
 	}
 
 	// device status was found, updating it
+	oldStatus := ds.Status
 	if status != "" {
 		ds.Status = status
 	}
@@ -575,15 +986,133 @@ func UpdateDeviceStatusByNetworkDeviceID(ctx context.Context, client *ent.Client
 		// something bad has happened, returning error
 		newErr := fmt.Errorf("update of device status didn't return error, number of affected nodes is %d", numAfDsNodes)
 		zlog.Error().Err(newErr).Send()
-		return nil, err
+		return nil, newErr
+	}
+	if ds.Status != oldStatus {
+		_, err = CreateStatusEvent(ctx, client, networkDeviceID, oldStatus, ds.Status, reason)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return ds, nil
 }
 
+// UpdateDeviceStatusResource stores the outcome of the poll of the network device with provided ID, i.e., all attributes
+// carried by the provided device status, in a single update. Empty status and last seen timestamp keep the stored ones.
+// If device status for this network device does not exist, it creates one. Change of the status is recorded as
+// a status event with provided reason in the same transaction.
+func UpdateDeviceStatusResource(ctx context.Context, client *ent.Client, networkDeviceID string, ds *ent.DeviceStatus, reason string) (*ent.DeviceStatus, error) {
+	// input parameters sanity
+	if networkDeviceID == "" || ds == nil {
+		err := fmt.Errorf("network device ID or device status is unspecified")
+		zlog.Error().Err(err).Msg("Failed to update device status")
+		return nil, err
+	}
+	var updDs *ent.DeviceStatus
+	err := WithTx(ctx, client, func(client *ent.Client) error {
+		var err error
+		updDs, err = updateDeviceStatusResource(ctx, client, networkDeviceID, ds, reason)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updDs, nil
+}
+
+func updateDeviceStatusResource(ctx context.Context, client *ent.Client, networkDeviceID string, ds *ent.DeviceStatus, reason string) (*ent.DeviceStatus, error) {
+	zlog.Debug().Msgf("Updating device status resource of network device (%s) to %s", networkDeviceID, ds.Status)
+	oldStatus := devicestatus.StatusSTATUS_UNSPECIFIED
+	var mut *ent.DeviceStatusMutation
+	var save func() (*ent.DeviceStatus, error)
+	stored, err := GetDeviceStatusByNetworkDeviceID(ctx, client, networkDeviceID)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+		// no device status for a given network device has been found, creating one
+		if ds.Status == "" {
+			newErr := fmt.Errorf("status must be specified")
+			zlog.Error().Err(newErr).Msgf("Failed to create device status for network device (%s)", networkDeviceID)
+			return nil, newErr
+		}
+		create := client.DeviceStatus.Create().
+			SetID(deviceStatusPrefix + uuid.NewString()).
+			SetNetworkDeviceID(networkDeviceID)
+		mut, save = create.Mutation(), func() (*ent.DeviceStatus, error) { return create.Save(ctx) }
+	} else {
+		oldStatus = stored.Status
+		update := client.DeviceStatus.UpdateOneID(stored.ID)
+		mut, save = update.Mutation(), func() (*ent.DeviceStatus, error) { return update.Save(ctx) }
+		if ds.PendingStatus == "" {
+			mut.ClearPendingStatus()
+		}
+		if ds.AnsweredEndpointID == "" {
+			mut.ClearAnsweredEndpointID()
+			mut.ClearAnsweredProtocol()
+		}
+		if ds.LastError == "" {
+			mut.ClearLastError()
+		}
+	}
+
+	// all attributes are set at once
+	if ds.Status != "" {
+		mut.SetStatus(ds.Status)
+	}
+	if ds.LastSeen != "" {
+		mut.SetLastSeen(ds.LastSeen)
+	}
+	mut.SetConsequentialFailedConnectivityAttempts(ds.ConsequentialFailedConnectivityAttempts)
+	mut.SetPendingReadings(ds.PendingReadings)
+	mut.SetFlapping(ds.Flapping)
+	mut.SetInMaintenance(ds.InMaintenance)
+	if ds.NextPoll != 0 {
+		mut.SetNextPoll(ds.NextPoll)
+	}
+	if ds.PendingStatus != "" {
+		mut.SetPendingStatus(ds.PendingStatus)
+	}
+	if ds.AnsweredEndpointID != "" {
+		mut.SetAnsweredEndpointID(ds.AnsweredEndpointID)
+		mut.SetAnsweredProtocol(ds.AnsweredProtocol)
+	}
+	if ds.LastError != "" {
+		mut.SetLastError(ds.LastError)
+	}
+	updDs, err := save()
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to update device status for network device (%s)", networkDeviceID)
+		return nil, err
+	}
+	if updDs.Status != oldStatus {
+		_, err = CreateStatusEvent(ctx, client, networkDeviceID, oldStatus, updDs.Status, reason)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return updDs, nil
+}
+
 // UpdateDeviceStatusByEndpointID updates device status for the network device with existing endpoint with provided ID. If device status for this
-// endpoint and network device does not exist, it creates one.
-func UpdateDeviceStatusByEndpointID(ctx context.Context, client *ent.Client, endpointID string, status devicestatus.Status, lastSeen string, cal int32) (*ent.DeviceStatus, error) {
+// endpoint and network device does not exist, it creates one. Change of the status is recorded as a status event with
+// provided reason in the same transaction.
+func UpdateDeviceStatusByEndpointID(ctx context.Context, client *ent.Client, endpointID string, status devicestatus.Status, lastSeen string, cal int32, reason string) (*ent.DeviceStatus, error) {
+	var ds *ent.DeviceStatus
+	err := WithTx(ctx, client, func(client *ent.Client) error {
+		var err error
+		ds, err = updateDeviceStatusByEndpointID(ctx, client, endpointID, status, lastSeen, cal, reason)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+func updateDeviceStatusByEndpointID(ctx context.Context, client *ent.Client, endpointID string, status devicestatus.Status, lastSeen string, cal int32, reason string) (*ent.DeviceStatus, error) {
 	zlog.Debug().Msgf("Updating device status resource by endpoint (%s)", endpointID)
 	ds, err := GetDeviceStatusByEndpointID(ctx, client, endpointID)
 	if err != nil {
@@ -606,9 +1135,14 @@ func UpdateDeviceStatusByEndpointID(ctx context.Context, client *ent.Client, end
 		if err != nil {
 			return nil, err
 		}
+		_, err = CreateStatusEvent(ctx, client, nd.ID, devicestatus.StatusSTATUS_UNSPECIFIED, ds.Status, reason)
+		if err != nil {
+			return nil, err
+		}
 		return ds, nil
 	}
 
+	oldStatus := ds.Status
 	if status != "" {
 		ds.Status = status
 	}
@@ -630,26 +1164,226 @@ func UpdateDeviceStatusByEndpointID(ctx context.Context, client *ent.Client, end
 		// something bad has happened, returning error
 		newErr := fmt.Errorf("update of device status didn't return error, number of affected nodes is %d", numAfDsNodes)
 		zlog.Error().Err(newErr).Send()
-		return nil, err
+		return nil, newErr
+	}
+	if ds.Status != oldStatus {
+		networkDeviceID, err := ds.QueryNetworkDevice().OnlyID(ctx)
+		if err != nil {
+			zlog.Error().Err(err).Msgf("Failed to get network device by endpoint (%s)", endpointID)
+			return nil, err
+		}
+		_, err = CreateStatusEvent(ctx, client, networkDeviceID, oldStatus, ds.Status, reason)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return ds, nil
 }
 
-// DeleteDeviceStatusByID deletes device status resource by provided ID.
-func DeleteDeviceStatusByID(ctx context.Context, client *ent.Client, id string) error {
-	zlog.Debug().Msgf("Deleting device status (%s)", id)
-	_, err := client.DeviceStatus.Delete().Where(devicestatus.ID(id)).Exec(ctx)
+// CreateStatusEvent records a transition of the status of the network device with provided ID.
+func CreateStatusEvent(ctx context.Context, client *ent.Client, networkDeviceID string, oldStatus, newStatus devicestatus.Status, reason string) (*ent.StatusEvent, error) {
+	// input parameters sanity
+	if networkDeviceID == "" || newStatus == "" {
+		err := fmt.Errorf("network device ID or new status is unspecified")
+		zlog.Error().Err(err).Msg("Failed to create status event")
+		return nil, err
+	}
+	if oldStatus == "" {
+		oldStatus = devicestatus.StatusSTATUS_UNSPECIFIED
+	}
+	zlog.Debug().Msgf("Recording transition of network device (%s) from %s to %s: %s", networkDeviceID, oldStatus, newStatus, reason)
+
+	id := statusEventPrefix + uuid.NewString()
+	se, err := client.StatusEvent.Create().
+		SetID(id).
+		SetOldStatus(statusevent.OldStatus(oldStatus)).
+		SetNewStatus(statusevent.NewStatus(newStatus)).
+		SetTimestamp(time.Now().UnixMilli()).
+		SetReason(reason).
+		SetNetworkDeviceID(networkDeviceID).
+		Save(ctx)
 	if err != nil {
-		zlog.Error().Err(err).Msgf("Failed to delete device status (%s)", id)
-		return err
+		zlog.Error().Err(err).Msgf("Failed to create status event for network device (%s)", networkDeviceID)
+		return nil, err
 	}
 
-	return nil
+	return se, nil
 }
 
-// CreateVersion creates a version resource in the DB.
-func CreateVersion(ctx context.Context, client *ent.Client, version, checksum string) (*ent.Version, error) {
+// ListStatusEvents lists status transitions of the network device with provided ID, which have happened within
+// [from, to] time range, ordered from the oldest one. Zero time leaves the range unbounded from that side.
+func ListStatusEvents(ctx context.Context, client *ent.Client, networkDeviceID string, from, to time.Time) ([]*ent.StatusEvent, error) {
+	zlog.Debug().Msgf("Retrieving status events of network device (%s)", networkDeviceID)
+
+	q := client.StatusEvent.Query().
+		Where(statusevent.HasNetworkDeviceWith(networkdevice.ID(networkDeviceID)))
+	if !from.IsZero() {
+		q = q.Where(statusevent.TimestampGTE(from.UnixMilli()))
+	}
+	if !to.IsZero() {
+		q = q.Where(statusevent.TimestampLTE(to.UnixMilli()))
+	}
+	ses, err := q.Order(ent.Asc(statusevent.FieldTimestamp)).All(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to list status events of network device (%s)", networkDeviceID)
+		return nil, err
+	}
+
+	return ses, nil
+}
+
+// CountStatusTransitions counts status transitions of the network device with provided ID, which have happened since
+// provided time. Recording of the initial status of the network device is not a transition, it is not counted.
+func CountStatusTransitions(ctx context.Context, client *ent.Client, networkDeviceID string, from time.Time) (int, error) {
+	zlog.Debug().Msgf("Counting status transitions of network device (%s) since %s", networkDeviceID, from)
+
+	count, err := client.StatusEvent.Query().
+		Where(
+			statusevent.HasNetworkDeviceWith(networkdevice.ID(networkDeviceID)),
+			statusevent.TimestampGTE(from.UnixMilli()),
+			statusevent.OldStatusNEQ(statusevent.OldStatusSTATUS_UNSPECIFIED),
+		).
+		Count(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to count status transitions of network device (%s)", networkDeviceID)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// CreateLatencySample records a duration of the successful exchange with the network device with provided ID over its
+// endpoint with provided ID.
+func CreateLatencySample(ctx context.Context, client *ent.Client, networkDeviceID, endpointID string, latency time.Duration) (*ent.LatencySample, error) {
+	// input parameters sanity
+	if networkDeviceID == "" || endpointID == "" {
+		err := fmt.Errorf("network device ID or endpoint ID is unspecified")
+		zlog.Error().Err(err).Msg("Failed to create latency sample")
+		return nil, err
+	}
+	zlog.Debug().Msgf("Recording latency %s of network device (%s) over endpoint (%s)", latency, networkDeviceID, endpointID)
+
+	id := latencySamplePrefix + uuid.NewString()
+	ls, err := client.LatencySample.Create().
+		SetID(id).
+		SetTimestamp(time.Now().UnixMilli()).
+		SetLatency(latency.Milliseconds()).
+		SetNetworkDeviceID(networkDeviceID).
+		SetEndpointID(endpointID).
+		Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to create latency sample for network device (%s)", networkDeviceID)
+		return nil, err
+	}
+
+	return ls, nil
+}
+
+// ListLatencySamples lists latency samples of the network device with provided ID, which were taken within [from, to]
+// time range, ordered from the oldest one. Samples are narrowed down to the endpoint with provided ID, when it is
+// not empty. Zero time leaves the range unbounded from that side.
+func ListLatencySamples(ctx context.Context, client *ent.Client, networkDeviceID, endpointID string, from, to time.Time) ([]*ent.LatencySample, error) {
+	zlog.Debug().Msgf("Retrieving latency samples of network device (%s)", networkDeviceID)
+
+	q := client.LatencySample.Query().
+		Where(latencysample.HasNetworkDeviceWith(networkdevice.ID(networkDeviceID)))
+	if endpointID != "" {
+		q = q.Where(latencysample.HasEndpointWith(endpoint.ID(endpointID)))
+	}
+	if !from.IsZero() {
+		q = q.Where(latencysample.TimestampGTE(from.UnixMilli()))
+	}
+	if !to.IsZero() {
+		q = q.Where(latencysample.TimestampLTE(to.UnixMilli()))
+	}
+	lss, err := q.Order(ent.Asc(latencysample.FieldTimestamp)).All(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to list latency samples of network device (%s)", networkDeviceID)
+		return nil, err
+	}
+
+	return lss, nil
+}
+
+// LatencyStats carries latency statistics (in milliseconds) of the network device. Statistics are zero, when there are
+// no samples.
+type LatencyStats struct {
+	Samples int             `json:"count"`
+	Min     sql.NullInt64   `json:"min"`
+	Avg     sql.NullFloat64 `json:"mean"`
+	P95     sql.NullInt64   `json:"p95"`
+	Max     sql.NullInt64   `json:"max"`
+}
+
+// GetLatencyStats computes latency statistics (min/avg/p95/max) of the network device with provided ID out of the
+// samples, which were taken within [from, to] time range. Samples are narrowed down to the endpoint with provided ID,
+// when it is not empty. Statistics are computed by the DB, 95th percentile is computed with the nearest-rank method.
+func GetLatencyStats(ctx context.Context, client *ent.Client, networkDeviceID, endpointID string, from, to time.Time) (*LatencyStats, error) {
+	zlog.Debug().Msgf("Computing latency statistics of network device (%s) within [%s, %s]", networkDeviceID, from, to)
+
+	q := client.LatencySample.Query().
+		Where(
+			latencysample.HasNetworkDeviceWith(networkdevice.ID(networkDeviceID)),
+			latencysample.TimestampGTE(from.UnixMilli()),
+			latencysample.TimestampLTE(to.UnixMilli()),
+		)
+	if endpointID != "" {
+		q = q.Where(latencysample.HasEndpointWith(endpoint.ID(endpointID)))
+	}
+	var stats []*LatencyStats
+	err := q.Aggregate(
+		ent.Count(),
+		ent.Min(latencysample.FieldLatency),
+		ent.Mean(latencysample.FieldLatency),
+		ent.Max(latencysample.FieldLatency),
+		// nearest rank, i.e., the smallest latency, which is greater or equal to 95% of the samples
+		func(s *entsql.Selector) string {
+			return entsql.As(fmt.Sprintf("PERCENTILE_DISC(0.95) WITHIN GROUP (ORDER BY %s)", s.C(latencysample.FieldLatency)), "p95")
+		},
+	).Scan(ctx, &stats)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to compute latency statistics of network device (%s)", networkDeviceID)
+		return nil, err
+	}
+	if len(stats) != 1 {
+		newErr := fmt.Errorf("computing latency statistics has returned %d rows", len(stats))
+		zlog.Error().Err(newErr).Msgf("Failed to compute latency statistics of network device (%s)", networkDeviceID)
+		return nil, newErr
+	}
+
+	return stats[0], nil
+}
+
+// DeleteLatencySamplesBefore deletes latency samples of all network devices, which were taken before provided time.
+// It returns the number of deleted samples.
+func DeleteLatencySamplesBefore(ctx context.Context, client *ent.Client, before time.Time) (int, error) {
+	zlog.Debug().Msgf("Deleting latency samples taken before %s", before)
+	deleted, err := client.LatencySample.Delete().
+		Where(latencysample.TimestampLT(before.UnixMilli())).
+		Exec(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to delete latency samples taken before %s", before)
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+// DeleteDeviceStatusByID deletes device status resource by provided ID.
+func DeleteDeviceStatusByID(ctx context.Context, client *ent.Client, id string) error {
+	zlog.Debug().Msgf("Deleting device status (%s)", id)
+	_, err := client.DeviceStatus.Delete().Where(devicestatus.ID(id)).Exec(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to delete device status (%s)", id)
+		return err
+	}
+
+	return nil
+}
+
+// CreateVersion creates a version resource in the DB.
+func CreateVersion(ctx context.Context, client *ent.Client, version, checksum string) (*ent.Version, error) {
 	// input parameters sanity
 	if version == "" || checksum == "" {
 		err := fmt.Errorf("version or checksum is unspecified")
@@ -744,3 +1478,520 @@ func DeleteVersionByID(ctx context.Context, client *ent.Client, id string) error
 
 	return nil
 }
+
+// CreateCredentialProfile creates a credential profile resource. Secrets are encrypted before they are stored,
+// returned resource carries them encrypted too.
+func CreateCredentialProfile(ctx context.Context, client *ent.Client, cp *ent.CredentialProfile) (*ent.CredentialProfile, error) {
+	// input parameters sanity
+	if cp == nil || cp.Name == "" {
+		err := fmt.Errorf("name of the credential profile is unspecified")
+		zlog.Error().Err(err).Send()
+		return nil, err
+	}
+	zlog.Debug().Msgf("Creating credential profile %s", cp.Name)
+
+	// generating random ID for the credential profile, it is needed for encryption
+	withID := *cp
+	withID.ID = credentialPrefix + uuid.NewString()
+	encrypted, err := encryptCredentialProfile(&withID)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to encrypt credential profile %s", cp.Name)
+		return nil, err
+	}
+
+	created, err := client.CredentialProfile.
+		Create().
+		SetID(encrypted.ID).
+		SetName(encrypted.Name).
+		SetUsername(encrypted.Username).
+		SetPassword(encrypted.Password).
+		SetSnmpCommunity(encrypted.SnmpCommunity).
+		SetPrivateKey(encrypted.PrivateKey).
+		SetToken(encrypted.Token).
+		SetSnmpPrivPassphrase(encrypted.SnmpPrivPassphrase).
+		SetTLSClientKey(encrypted.TLSClientKey).
+		SetSnmpAuthProtocol(encrypted.SnmpAuthProtocol).
+		SetSnmpPrivProtocol(encrypted.SnmpPrivProtocol).
+		SetTLSClientCertificate(encrypted.TLSClientCertificate).
+		SetSSHKnownHosts(encrypted.SSHKnownHosts).
+		SetSSHInsecureIgnoreHostKey(encrypted.SSHInsecureIgnoreHostKey).
+		Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msg("Failed to create credential profile")
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateCredentialProfile updates credential profile associated with provided ID in a PATCH fashion, i.e.,
+// only non-empty fields are updated. Secrets are encrypted before they are stored.
+func UpdateCredentialProfile(ctx context.Context, client *ent.Client, cp *ent.CredentialProfile) (*ent.CredentialProfile, error) {
+	if cp == nil || cp.ID == "" {
+		err := fmt.Errorf("ID of the credential profile is unspecified")
+		zlog.Error().Err(err).Send()
+		return nil, err
+	}
+	zlog.Debug().Msgf("Updating credential profile (%s)", cp.ID)
+	encrypted, err := encryptCredentialProfile(cp)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to encrypt credential profile (%s)", cp.ID)
+		return nil, err
+	}
+
+	upd := client.CredentialProfile.UpdateOneID(cp.ID)
+	for _, field := range []struct {
+		value string
+		set   func(string) *ent.CredentialProfileUpdateOne
+	}{
+		{encrypted.Name, upd.SetName},
+		{encrypted.Username, upd.SetUsername},
+		{encrypted.Password, upd.SetPassword},
+		{encrypted.SnmpCommunity, upd.SetSnmpCommunity},
+		{encrypted.PrivateKey, upd.SetPrivateKey},
+		{encrypted.Token, upd.SetToken},
+		{encrypted.SnmpPrivPassphrase, upd.SetSnmpPrivPassphrase},
+		{encrypted.TLSClientKey, upd.SetTLSClientKey},
+		{encrypted.SnmpAuthProtocol, upd.SetSnmpAuthProtocol},
+		{encrypted.SnmpPrivProtocol, upd.SetSnmpPrivProtocol},
+		{encrypted.TLSClientCertificate, upd.SetTLSClientCertificate},
+		{encrypted.SSHKnownHosts, upd.SetSSHKnownHosts},
+	} {
+		if field.value != "" {
+			field.set(field.value)
+		}
+	}
+	if encrypted.SSHInsecureIgnoreHostKey {
+		upd.SetSSHInsecureIgnoreHostKey(true)
+	}
+	updated, err := upd.Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to update credential profile (%s)", cp.ID)
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// GetCredentialProfileByID retrieves credential profile by ID. Secrets are returned encrypted.
+func GetCredentialProfileByID(ctx context.Context, client *ent.Client, id string) (*ent.CredentialProfile, error) {
+	zlog.Debug().Msgf("Retrieving credential profile (%s)", id)
+	cp, err := client.CredentialProfile.Query().
+		Where(credentialprofile.ID(id)).
+		Only(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to get credential profile (%s)", id)
+		return nil, err
+	}
+
+	return cp, nil
+}
+
+// ListCredentialProfiles lists all credential profiles present in the system. Secrets are returned encrypted.
+func ListCredentialProfiles(ctx context.Context, client *ent.Client) ([]*ent.CredentialProfile, error) {
+	zlog.Debug().Msg("Listing credential profiles")
+	cps, err := client.CredentialProfile.Query().All(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msg("Failed to list credential profiles")
+		return nil, err
+	}
+
+	return cps, nil
+}
+
+// DeleteCredentialProfileByID deletes credential profile by provided ID. Endpoints, which reference it, are left
+// without credentials.
+func DeleteCredentialProfileByID(ctx context.Context, client *ent.Client, id string) error {
+	zlog.Debug().Msgf("Deleting credential profile (%s)", id)
+	_, err := client.CredentialProfile.Delete().Where(credentialprofile.ID(id)).Exec(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to delete credential profile (%s)", id)
+		return err
+	}
+
+	return nil
+}
+
+// SetPollingDefault sets default poll interval (in seconds) of the group or the site. Default, which already exists
+// for the same scope and name, is overwritten.
+func SetPollingDefault(ctx context.Context, client *ent.Client, scope pollingdefault.Scope, name string, pollInterval int32) (*ent.PollingDefault, error) {
+	// input parameters sanity
+	if name == "" || scope == "" || scope == pollingdefault.ScopePOLLING_SCOPE_UNSPECIFIED {
+		err := fmt.Errorf("scope or name of the polling default are unspecified")
+		zlog.Error().Err(err).Send()
+		return nil, err
+	}
+	if pollInterval <= 0 {
+		err := fmt.Errorf("poll interval must be positive")
+		zlog.Error().Err(err).Send()
+		return nil, err
+	}
+	zlog.Debug().Msgf("Setting poll interval of %s %s to %d seconds", scope, name, pollInterval)
+
+	existing, err := client.PollingDefault.Query().
+		Where(pollingdefault.ScopeEQ(scope), pollingdefault.Name(name)).
+		Only(ctx)
+	if err == nil {
+		pd, err := client.PollingDefault.UpdateOneID(existing.ID).
+			SetPollInterval(pollInterval).
+			Save(ctx)
+		if err != nil {
+			zlog.Error().Err(err).Msgf("Failed to update polling default (%s)", existing.ID)
+			return nil, err
+		}
+		return pd, nil
+	}
+	if !ent.IsNotFound(err) {
+		zlog.Error().Err(err).Msgf("Failed to get polling default of %s %s", scope, name)
+		return nil, err
+	}
+
+	pd, err := client.PollingDefault.Create().
+		SetID(pollingDefaultPrefix + uuid.NewString()).
+		SetScope(scope).
+		SetName(name).
+		SetPollInterval(pollInterval).
+		Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msg("Failed to create polling default")
+		return nil, err
+	}
+
+	return pd, nil
+}
+
+// ListPollingDefaults lists all default poll intervals present in the system.
+func ListPollingDefaults(ctx context.Context, client *ent.Client) ([]*ent.PollingDefault, error) {
+	zlog.Debug().Msg("Listing polling defaults")
+	pds, err := client.PollingDefault.Query().All(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msg("Failed to list polling defaults")
+		return nil, err
+	}
+
+	return pds, nil
+}
+
+// DeletePollingDefaultByID deletes default poll interval by provided ID.
+func DeletePollingDefaultByID(ctx context.Context, client *ent.Client, id string) error {
+	zlog.Debug().Msgf("Deleting polling default (%s)", id)
+	_, err := client.PollingDefault.Delete().Where(pollingdefault.ID(id)).Exec(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to delete polling default (%s)", id)
+		return err
+	}
+
+	return nil
+}
+
+// acquireLeaseQuery creates the lease, or takes it over, when it is held by the holder or when it has expired. Expiry
+// is computed and compared with the clock of the DB, so that replicas with skewed clocks agree on it. Nothing is
+// written, when the lease is held by someone else.
+var acquireLeaseQuery = fmt.Sprintf(`INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s) VALUES ($1, $2, %[5]s + $3)
+ON CONFLICT (%[2]s) DO UPDATE SET %[3]s = EXCLUDED.%[3]s, %[4]s = EXCLUDED.%[4]s
+WHERE %[1]s.%[3]s = EXCLUDED.%[3]s OR %[1]s.%[4]s < %[5]s`,
+	lease.Table, lease.FieldID, lease.FieldHolder, lease.FieldExpiresAt, dbNowMillis)
+
+// dbNowMillis is the current time of the DB in Unix milliseconds.
+const dbNowMillis = "CAST(EXTRACT(EPOCH FROM clock_timestamp()) * 1000 AS BIGINT)"
+
+// AcquireLease acquires (or renews) the lease for the holder for a given duration. It reports false, when the lease is
+// held by someone else and has not expired yet. Expiry of the lease is measured by the clock of the DB.
+func AcquireLease(ctx context.Context, client *ent.Client, name, holder string, duration time.Duration) (bool, error) {
+	// input parameters sanity
+	if name == "" || holder == "" {
+		err := fmt.Errorf("name or holder of the lease are unspecified")
+		zlog.Error().Err(err).Send()
+		return false, err
+	}
+
+	res, err := client.ExecContext(ctx, acquireLeaseQuery, name, holder, duration.Milliseconds())
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to acquire lease (%s)", name)
+		return false, err
+	}
+	numAfLeases, err := res.RowsAffected()
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to acquire lease (%s)", name)
+		return false, err
+	}
+
+	// lease is held by someone else, when it was neither created, nor taken over
+	return numAfLeases == 1, nil
+}
+
+// ReleaseLease releases the lease, if it is held by the holder, so that others can acquire it right away.
+func ReleaseLease(ctx context.Context, client *ent.Client, name, holder string) error {
+	zlog.Debug().Msgf("Releasing lease (%s) held by %s", name, holder)
+	_, err := client.Lease.Update().
+		Where(lease.ID(name), lease.Holder(holder)).
+		SetExpiresAt(0).
+		Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to release lease (%s)", name)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteLease removes the lease, if it is held by the holder. It suits short-lived leases, which would pile up otherwise.
+func DeleteLease(ctx context.Context, client *ent.Client, name, holder string) error {
+	zlog.Debug().Msgf("Deleting lease (%s) held by %s", name, holder)
+	_, err := client.Lease.Delete().
+		Where(lease.ID(name), lease.Holder(holder)).
+		Exec(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to delete lease (%s)", name)
+		return err
+	}
+
+	return nil
+}
+
+// validateMaintenanceWindow checks the time range and the recurrence of the maintenance window.
+func validateMaintenanceWindow(mw *ent.MaintenanceWindow) error {
+	if mw.EndsAt <= mw.StartsAt {
+		return fmt.Errorf("maintenance window must end after it starts")
+	}
+	if mw.Recurrence != "" {
+		if _, err := cron.ParseStandard(mw.Recurrence); err != nil {
+			return fmt.Errorf("failed to parse recurrence of the maintenance window: %w", err)
+		}
+	}
+	return nil
+}
+
+// CreateMaintenanceWindow creates a maintenance window resource, which covers network devices with provided IDs.
+func CreateMaintenanceWindow(ctx context.Context, client *ent.Client, mw *ent.MaintenanceWindow, networkDeviceIDs []string) (*ent.MaintenanceWindow, error) {
+	// input parameters sanity
+	if mw == nil || mw.Name == "" {
+		err := fmt.Errorf("name of the maintenance window is unspecified")
+		zlog.Error().Err(err).Send()
+		return nil, err
+	}
+	if err := validateMaintenanceWindow(mw); err != nil {
+		zlog.Error().Err(err).Msgf("Failed to create maintenance window %s", mw.Name)
+		return nil, err
+	}
+	zlog.Debug().Msgf("Creating maintenance window %s", mw.Name)
+
+	id := maintenancePrefix + uuid.NewString()
+	_, err := client.MaintenanceWindow.Create().
+		SetID(id).
+		SetName(mw.Name).
+		SetGroup(mw.Group).
+		SetSite(mw.Site).
+		SetStartsAt(mw.StartsAt).
+		SetEndsAt(mw.EndsAt).
+		SetRecurrence(mw.Recurrence).
+		AddNetworkDeviceIDs(networkDeviceIDs...).
+		Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to create maintenance window %s", mw.Name)
+		return nil, err
+	}
+
+	return GetMaintenanceWindowByID(ctx, client, id)
+}
+
+// UpdateMaintenanceWindow updates maintenance window associated with provided ID in a PATCH fashion, i.e.,
+// only non-empty fields are updated. Network devices of the window are replaced, unless networkDeviceIDs is nil.
+func UpdateMaintenanceWindow(ctx context.Context, client *ent.Client, mw *ent.MaintenanceWindow, networkDeviceIDs []string) (*ent.MaintenanceWindow, error) {
+	if mw == nil || mw.ID == "" {
+		err := fmt.Errorf("ID of the maintenance window is unspecified")
+		zlog.Error().Err(err).Send()
+		return nil, err
+	}
+	zlog.Debug().Msgf("Updating maintenance window (%s)", mw.ID)
+	current, err := GetMaintenanceWindowByID(ctx, client, mw.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// merging the fields, so that the resulting window is validated as a whole
+	if mw.Name != "" {
+		current.Name = mw.Name
+	}
+	if mw.Group != "" {
+		current.Group = mw.Group
+	}
+	if mw.Site != "" {
+		current.Site = mw.Site
+	}
+	if mw.StartsAt != 0 {
+		current.StartsAt = mw.StartsAt
+	}
+	if mw.EndsAt != 0 {
+		current.EndsAt = mw.EndsAt
+	}
+	if mw.Recurrence != "" {
+		current.Recurrence = mw.Recurrence
+	}
+	if err = validateMaintenanceWindow(current); err != nil {
+		zlog.Error().Err(err).Msgf("Failed to update maintenance window (%s)", mw.ID)
+		return nil, err
+	}
+
+	upd := client.MaintenanceWindow.UpdateOneID(mw.ID).
+		SetName(current.Name).
+		SetGroup(current.Group).
+		SetSite(current.Site).
+		SetStartsAt(current.StartsAt).
+		SetEndsAt(current.EndsAt).
+		SetRecurrence(current.Recurrence)
+	if networkDeviceIDs != nil {
+		upd = upd.ClearNetworkDevices().AddNetworkDeviceIDs(networkDeviceIDs...)
+	}
+	_, err = upd.Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to update maintenance window (%s)", mw.ID)
+		return nil, err
+	}
+
+	return GetMaintenanceWindowByID(ctx, client, mw.ID)
+}
+
+// GetMaintenanceWindowByID retrieves maintenance window (together with its network devices) by provided ID.
+func GetMaintenanceWindowByID(ctx context.Context, client *ent.Client, id string) (*ent.MaintenanceWindow, error) {
+	zlog.Debug().Msgf("Retrieving maintenance window (%s)", id)
+	mw, err := client.MaintenanceWindow.Query().
+		Where(maintenancewindow.ID(id)).
+		WithNetworkDevices().
+		Only(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to get maintenance window (%s)", id)
+		return nil, err
+	}
+
+	return mw, nil
+}
+
+// ListMaintenanceWindows lists all maintenance windows (together with their network devices) present in the system.
+func ListMaintenanceWindows(ctx context.Context, client *ent.Client) ([]*ent.MaintenanceWindow, error) {
+	zlog.Debug().Msg("Listing maintenance windows")
+	mws, err := client.MaintenanceWindow.Query().
+		WithNetworkDevices().
+		All(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msg("Failed to list maintenance windows")
+		return nil, err
+	}
+
+	return mws, nil
+}
+
+// DeleteMaintenanceWindowByID deletes maintenance window by provided ID.
+func DeleteMaintenanceWindowByID(ctx context.Context, client *ent.Client, id string) error {
+	zlog.Debug().Msgf("Deleting maintenance window (%s)", id)
+	_, err := client.MaintenanceWindow.Delete().Where(maintenancewindow.ID(id)).Exec(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to delete maintenance window (%s)", id)
+		return err
+	}
+
+	return nil
+}
+
+// RecordReplicaHeartbeat records a heartbeat of the replica of the monitoring service.
+func RecordReplicaHeartbeat(ctx context.Context, client *ent.Client, replicaID string) error {
+	if replicaID == "" {
+		err := fmt.Errorf("replica ID is unspecified")
+		zlog.Error().Err(err).Send()
+		return err
+	}
+	now := time.Now().UnixMilli()
+	numAfHeartbeats, err := client.ReplicaHeartbeat.Update().
+		Where(replicaheartbeat.ID(replicaID)).
+		SetLastSeen(now).
+		Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to record heartbeat of replica (%s)", replicaID)
+		return err
+	}
+	if numAfHeartbeats == 1 {
+		return nil
+	}
+
+	// replica has just joined
+	_, err = client.ReplicaHeartbeat.Create().
+		SetID(replicaID).
+		SetLastSeen(now).
+		Save(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to record heartbeat of replica (%s)", replicaID)
+		return err
+	}
+
+	return nil
+}
+
+// ListLiveReplicas lists IDs of the replicas, which recorded their heartbeat within the timeout. Records of the replicas,
+// which are dead for a long time, are cleaned up.
+func ListLiveReplicas(ctx context.Context, client *ent.Client, timeout time.Duration) ([]string, error) {
+	threshold := time.Now().Add(-timeout).UnixMilli()
+	ids, err := client.ReplicaHeartbeat.Query().
+		Where(replicaheartbeat.LastSeenGTE(threshold)).
+		IDs(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msg("Failed to list live replicas")
+		return nil, err
+	}
+
+	staleThreshold := time.Now().Add(-staleReplicaTimeouts * timeout).UnixMilli()
+	if _, err = client.ReplicaHeartbeat.Delete().Where(replicaheartbeat.LastSeenLT(staleThreshold)).Exec(ctx); err != nil {
+		// not critical, trying next time
+		zlog.Warn().Err(err).Msg("Failed to clean up heartbeats of dead replicas")
+	}
+
+	return ids, nil
+}
+
+// DeleteReplicaHeartbeat deletes heartbeat of the replica, so that other replicas take its shard over right away.
+func DeleteReplicaHeartbeat(ctx context.Context, client *ent.Client, replicaID string) error {
+	zlog.Debug().Msgf("Deleting heartbeat of replica (%s)", replicaID)
+	_, err := client.ReplicaHeartbeat.Delete().Where(replicaheartbeat.ID(replicaID)).Exec(ctx)
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to delete heartbeat of replica (%s)", replicaID)
+		return err
+	}
+
+	return nil
+}
+
+// WithTx runs provided function within a transaction. Function must use the client, which it is given. Transaction
+// is committed, only when the function succeeds. When the client is transactional already, function joins its
+// transaction, so the functions, which use WithTx, can be composed.
+func WithTx(ctx context.Context, client *ent.Client, fn func(client *ent.Client) error) error {
+	tx, err := client.Tx(ctx)
+	if errors.Is(err, ent.ErrTxStarted) {
+		return fn(client)
+	}
+	if err != nil {
+		zlog.Error().Err(err).Msg("Failed to start transaction")
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx.Client()); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			zlog.Error().Err(rErr).Msg("Failed to roll back transaction")
+			err = errors.Join(err, rErr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		zlog.Error().Err(err).Msg("Failed to commit transaction")
+		return err
+	}
+	return nil
+}
+
+// withEndpointEdges eager-loads credential profile and health of the endpoints.
+func withEndpointEdges(q *ent.EndpointQuery) {
+	q.WithCredentialProfile().WithHealth()
+}
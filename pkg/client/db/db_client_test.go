@@ -3,16 +3,23 @@ package db_test
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/eroshiva/trade-show-poc/internal/ent"
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
 	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpointhealth"
+	"github.com/eroshiva/trade-show-poc/internal/ent/latencysample"
 	"github.com/eroshiva/trade-show-poc/internal/ent/networkdevice"
+	"github.com/eroshiva/trade-show-poc/internal/ent/pollingdefault"
+	"github.com/eroshiva/trade-show-poc/internal/ent/statusevent"
 	"github.com/eroshiva/trade-show-poc/pkg/client/db"
 	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
 	"github.com/google/uuid"
@@ -182,7 +189,7 @@ func TestDeviceStatusResource(t *testing.T) {
 	// updating network device status
 	ds.Status = devicestatus.StatusSTATUS_DEVICE_UNHEALTHY
 	ds.LastSeen = time.Now().String()
-	updDs, err := db.UpdateDeviceStatusByNetworkDeviceID(ctx, client, nd.ID, ds.Status, ds.LastSeen, 0)
+	updDs, err := db.UpdateDeviceStatusByNetworkDeviceID(ctx, client, nd.ID, ds.Status, ds.LastSeen, 0, "device reported unhealthy")
 	require.NoError(t, err)
 	require.NotNil(t, updDs)
 	monitoring_testing.AssertDeviceStatus(t, ds, updDs)
@@ -195,7 +202,7 @@ func TestDeviceStatusResource(t *testing.T) {
 
 	// updating network device status again
 	ds.Status = devicestatus.StatusSTATUS_DEVICE_DOWN
-	updDs2, err := db.UpdateDeviceStatusByEndpointID(ctx, client, ep2.ID, ds.Status, "", 0)
+	updDs2, err := db.UpdateDeviceStatusByEndpointID(ctx, client, ep2.ID, ds.Status, "", 0, "3 failed attempts")
 	require.NoError(t, err)
 	require.NotNil(t, updDs2)
 	monitoring_testing.AssertDeviceStatus(t, ds, updDs2)
@@ -206,11 +213,103 @@ func TestDeviceStatusResource(t *testing.T) {
 	require.NotNil(t, retDs3)
 	monitoring_testing.AssertDeviceStatus(t, ds, retDs3)
 
+	// both transitions are recorded
+	ses, err := db.ListStatusEvents(ctx, client, nd.ID, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, ses, 2)
+	assert.Equal(t, statusevent.OldStatusSTATUS_DEVICE_UP, ses[0].OldStatus)
+	assert.Equal(t, statusevent.NewStatusSTATUS_DEVICE_UNHEALTHY, ses[0].NewStatus)
+	assert.Equal(t, "device reported unhealthy", ses[0].Reason)
+	assert.Equal(t, statusevent.OldStatusSTATUS_DEVICE_UNHEALTHY, ses[1].OldStatus)
+	assert.Equal(t, statusevent.NewStatusSTATUS_DEVICE_DOWN, ses[1].NewStatus)
+	assert.Equal(t, "3 failed attempts", ses[1].Reason)
+
+	// update, which doesn't change the status, is not recorded
+	_, err = db.UpdateDeviceStatusByNetworkDeviceID(ctx, client, nd.ID, ds.Status, "", 4, "4 failed attempts")
+	require.NoError(t, err)
+	ses, err = db.ListStatusEvents(ctx, client, nd.ID, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, ses, 2)
+
 	// removing device status from the DB
 	err = db.DeleteDeviceStatusByID(ctx, client, ds.ID)
 	assert.NoError(t, err)
 }
 
+func TestUpdateDeviceStatusResource(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	ep, err := db.CreateEndpoint(ctx, client, host1, port1, protocol1)
+	require.NoError(t, err)
+	nd, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{ep})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, nd.ID))
+		assert.NoError(t, db.DeleteEndpointByID(ctx, client, ep.ID))
+	})
+	before := time.Now()
+
+	// device status is created with all attributes at once
+	lastSeen := time.Now().String()
+	nextPoll := time.Now().Add(time.Minute).UnixMilli()
+	ds, err := db.UpdateDeviceStatusResource(ctx, client, nd.ID, &ent.DeviceStatus{
+		Status:             devicestatus.StatusSTATUS_DEVICE_UP,
+		LastSeen:           lastSeen,
+		NextPoll:           nextPoll,
+		PendingStatus:      devicestatus.PendingStatusSTATUS_DEVICE_DOWN,
+		PendingReadings:    1,
+		AnsweredEndpointID: ep.ID,
+		AnsweredProtocol:   devicestatus.AnsweredProtocol(ep.Protocol),
+	}, "device reported up")
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, ds.Status)
+	assert.Equal(t, lastSeen, ds.LastSeen)
+	assert.Equal(t, nextPoll, ds.NextPoll)
+	assert.Equal(t, devicestatus.PendingStatusSTATUS_DEVICE_DOWN, ds.PendingStatus)
+	assert.Equal(t, int32(1), ds.PendingReadings)
+	assert.Equal(t, ep.ID, ds.AnsweredEndpointID)
+
+	// failed poll keeps the last seen timestamp, and clears the answered endpoint and the pending transition
+	ds, err = db.UpdateDeviceStatusResource(ctx, client, nd.ID, &ent.DeviceStatus{
+		Status:                                  devicestatus.StatusSTATUS_DEVICE_DOWN,
+		ConsequentialFailedConnectivityAttempts: 3,
+		Flapping:                                true,
+		LastError:                               "timeout: context deadline exceeded",
+	}, "3 failed attempts")
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, ds.Status)
+	assert.Equal(t, lastSeen, ds.LastSeen)
+	assert.Equal(t, nextPoll, ds.NextPoll)
+	assert.Equal(t, int32(3), ds.ConsequentialFailedConnectivityAttempts)
+	assert.Empty(t, ds.PendingStatus)
+	assert.Zero(t, ds.PendingReadings)
+	assert.Empty(t, ds.AnsweredEndpointID)
+	assert.Empty(t, ds.AnsweredProtocol)
+	assert.True(t, ds.Flapping)
+	assert.Equal(t, "timeout: context deadline exceeded", ds.LastError)
+
+	// both statuses are recorded, only the second one is a transition
+	ses, err := db.ListStatusEvents(ctx, client, nd.ID, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, ses, 2)
+	assert.Equal(t, statusevent.OldStatusSTATUS_UNSPECIFIED, ses[0].OldStatus)
+	assert.Equal(t, "3 failed attempts", ses[1].Reason)
+	transitions, err := db.CountStatusTransitions(ctx, client, nd.ID, before)
+	require.NoError(t, err)
+	assert.Equal(t, 1, transitions)
+	transitions, err = db.CountStatusTransitions(ctx, client, nd.ID, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Zero(t, transitions)
+
+	// fail - device status is not specified
+	_, err = db.UpdateDeviceStatusResource(ctx, client, nd.ID, nil, "")
+	require.Error(t, err)
+	// fail - network device does not exist
+	_, err = db.UpdateDeviceStatusResource(ctx, client, uuid.NewString(), &ent.DeviceStatus{Status: devicestatus.StatusSTATUS_DEVICE_UP}, "")
+	require.Error(t, err)
+}
+
 func TestDeviceStatusResourceErrors(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
 	t.Cleanup(cancel)
@@ -269,12 +368,12 @@ func TestDeviceStatusResourceErrors(t *testing.T) {
 	// fail - updating network device status by fake network device ID
 	status := devicestatus.StatusSTATUS_DEVICE_UP
 	lastSeen := time.Now().String()
-	updDs, err := db.UpdateDeviceStatusByNetworkDeviceID(ctx, client, uuid.NewString(), status, lastSeen, 0)
+	updDs, err := db.UpdateDeviceStatusByNetworkDeviceID(ctx, client, uuid.NewString(), status, lastSeen, 0, "")
 	assert.Error(t, err)
 	assert.Nil(t, updDs)
 
 	// success - creating network device status on update
-	updDs, err = db.UpdateDeviceStatusByNetworkDeviceID(ctx, client, nd.ID, status, lastSeen, 0)
+	updDs, err = db.UpdateDeviceStatusByNetworkDeviceID(ctx, client, nd.ID, status, lastSeen, 0, "device reported up")
 	require.NoError(t, err)
 	require.NotNil(t, updDs)
 
@@ -285,12 +384,12 @@ func TestDeviceStatusResourceErrors(t *testing.T) {
 	// fail - updating network device status by fake endpoint ID
 	status = devicestatus.StatusSTATUS_DEVICE_UNHEALTHY
 	lastSeen = time.Now().String()
-	updDs, err = db.UpdateDeviceStatusByEndpointID(ctx, client, uuid.NewString(), status, lastSeen, 0)
+	updDs, err = db.UpdateDeviceStatusByEndpointID(ctx, client, uuid.NewString(), status, lastSeen, 0, "")
 	assert.Error(t, err)
 	assert.Nil(t, updDs)
 
 	// success - creating network device status on update
-	updDs, err = db.UpdateDeviceStatusByEndpointID(ctx, client, ep2.ID, status, lastSeen, 0)
+	updDs, err = db.UpdateDeviceStatusByEndpointID(ctx, client, ep2.ID, status, lastSeen, 0, "device reported unhealthy")
 	require.NoError(t, err)
 	require.NotNil(t, updDs)
 
@@ -322,9 +421,29 @@ func TestEndpointResource(t *testing.T) {
 	require.NotNil(t, updEp)
 	monitoring_testing.AssertEqualEndpoints(t, ep, updEp)
 
+	// setting TLS settings of the endpoint
+	tlsEp, err := db.SetEndpointTLS(ctx, client, ep.ID, true, "ca-bundle", "device.example", false)
+	require.NoError(t, err)
+	assert.True(t, tlsEp.TLSEnabled)
+	assert.Equal(t, "ca-bundle", tlsEp.TLSCaBundle)
+	assert.Equal(t, "device.example", tlsEp.TLSServerName)
+	assert.False(t, tlsEp.TLSInsecureSkipVerify)
+
 	// deleting endpoint
 	err = db.DeleteEndpointByID(ctx, client, updEp.ID)
 	assert.NoError(t, err)
+
+	// creating endpoint with all attributes at once
+	ep, err = db.CreateEndpointResource(ctx, client, &ent.Endpoint{Host: host2, Port: port2, Protocol: protocol1,
+		TLSEnabled: true, TLSServerName: "device.example", Preference: 2}, "")
+	require.NoError(t, err)
+	assert.Equal(t, host2, ep.Host)
+	assert.True(t, ep.TLSEnabled)
+	assert.Equal(t, "device.example", ep.TLSServerName)
+	assert.Equal(t, int32(2), ep.Preference)
+	assert.Nil(t, ep.Edges.CredentialProfile)
+	err = db.DeleteEndpointByID(ctx, client, ep.ID)
+	assert.NoError(t, err)
 }
 
 func TestEndpointResourceErrors(t *testing.T) {
@@ -336,6 +455,18 @@ func TestEndpointResourceErrors(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, ep)
 
+	// fail - creating endpoint, which references non-existing credential profile
+	ep, err = db.CreateEndpointResource(ctx, client, &ent.Endpoint{Host: host1, Port: port1, Protocol: protocol1},
+		"credprof-fake")
+	require.Error(t, err)
+	require.Nil(t, ep)
+
+	// fail - creating endpoint with negative preference
+	ep, err = db.CreateEndpointResource(ctx, client, &ent.Endpoint{Host: host1, Port: port1, Protocol: protocol1,
+		Preference: -1}, "")
+	require.Error(t, err)
+	require.Nil(t, ep)
+
 	// fail - retrieving endpoint with fake ID
 	retEp, err := db.GetEndpointByID(ctx, client, uuid.NewString())
 	require.Error(t, err)
@@ -347,6 +478,189 @@ func TestEndpointResourceErrors(t *testing.T) {
 	require.Nil(t, updEp)
 }
 
+func TestEndpointHealthResource(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	ep, err := db.CreateEndpoint(ctx, client, host1, port1, protocol1)
+	require.NoError(t, err)
+
+	// fail - endpoint has no health before it is probed for the first time
+	eh, err := db.GetEndpointHealthByEndpointID(ctx, client, ep.ID)
+	require.Error(t, err)
+	require.Nil(t, eh)
+
+	// failures are counted consecutively
+	eh, err = db.RecordEndpointFailure(ctx, client, ep.ID, "connection refused")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), eh.FailureCount)
+	eh, err = db.RecordEndpointFailure(ctx, client, ep.ID, "i/o timeout")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), eh.FailureCount)
+	assert.Equal(t, "i/o timeout", eh.LastError)
+	assert.NotZero(t, eh.LastFailure)
+	assert.Zero(t, eh.LastSuccess)
+
+	// success zeroes consecutive failures and keeps the last error
+	eh, err = db.RecordEndpointSuccess(ctx, client, ep.ID, 42*time.Millisecond)
+	require.NoError(t, err)
+	assert.Zero(t, eh.FailureCount)
+	assert.Equal(t, int64(42), eh.Latency)
+	assert.NotZero(t, eh.LastSuccess)
+	assert.Equal(t, "i/o timeout", eh.LastError)
+
+	// health is exposed on the endpoint
+	retEp, err := db.GetEndpointByID(ctx, client, ep.ID)
+	require.NoError(t, err)
+	require.NotNil(t, retEp.Edges.Health)
+	assert.Equal(t, eh.ID, retEp.Edges.Health.ID)
+	retEh, err := db.GetEndpointHealthByEndpointID(ctx, client, ep.ID)
+	require.NoError(t, err)
+	assert.Equal(t, eh.ID, retEh.ID)
+
+	// deleting endpoint deletes its health
+	err = db.DeleteEndpointByID(ctx, client, ep.ID)
+	require.NoError(t, err)
+	exists, err := client.EndpointHealth.Query().Where(endpointhealth.ID(eh.ID)).Exist(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// fail - recording health of a non-existing endpoint
+	eh, err = db.RecordEndpointFailure(ctx, client, uuid.NewString(), "connection refused")
+	require.Error(t, err)
+	require.Nil(t, eh)
+}
+
+func setCredentialsKey(t *testing.T) {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	t.Setenv(db.EnvCredentialsKey, base64.StdEncoding.EncodeToString(key))
+	reloadCredentialsKey(t)
+}
+
+// reloadCredentialsKey makes the key set in the environment to be used, the key of the test is dropped once it ends.
+func reloadCredentialsKey(t *testing.T) {
+	t.Helper()
+	db.ReloadCredentialsKey()
+	t.Cleanup(db.ReloadCredentialsKey)
+}
+
+func TestCredentialProfileResource(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+	setCredentialsKey(t)
+
+	// creating credential profile
+	cp, err := db.CreateCredentialProfile(ctx, client, &ent.CredentialProfile{
+		Name:          "profile-" + uuid.NewString(),
+		Username:      "admin",
+		Password:      "secret",
+		SnmpCommunity: "private",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, cp)
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteCredentialProfileByID(context.Background(), client, cp.ID))
+	})
+	// secrets are stored encrypted
+	retCp, err := db.GetCredentialProfileByID(ctx, client, cp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", retCp.Username)
+	assert.NotEqual(t, "secret", retCp.Password)
+	assert.NotContains(t, retCp.SnmpCommunity, "private")
+	assert.Empty(t, retCp.Token)
+
+	decrypted, err := db.DecryptCredentialProfile(retCp)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", decrypted.Password)
+	assert.Equal(t, "private", decrypted.SnmpCommunity)
+	assert.Empty(t, decrypted.Token)
+
+	// encrypted secret can't be moved to another field
+	swapped := *retCp
+	swapped.Token = retCp.Password
+	_, err = db.DecryptCredentialProfile(&swapped)
+	require.Error(t, err)
+
+	// updating credential profile, other secrets are kept
+	updCp, err := db.UpdateCredentialProfile(ctx, client, &ent.CredentialProfile{ID: cp.ID, Token: "token"})
+	require.NoError(t, err)
+	decrypted, err = db.DecryptCredentialProfile(updCp)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", decrypted.Password)
+	assert.Equal(t, "token", decrypted.Token)
+
+	// referencing credential profile by the endpoint
+	ep, err := db.CreateEndpoint(ctx, client, host1, port1, protocol1)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteEndpointByID(context.Background(), client, ep.ID))
+	})
+	ep, err = db.SetEndpointCredentialProfile(ctx, client, ep.ID, cp.ID)
+	require.NoError(t, err)
+	require.NotNil(t, ep.Edges.CredentialProfile)
+	assert.Equal(t, cp.ID, ep.Edges.CredentialProfile.ID)
+
+	ep, err = db.SetEndpointCredentialProfile(ctx, client, ep.ID, "")
+	require.NoError(t, err)
+	assert.Nil(t, ep.Edges.CredentialProfile)
+
+	// key can be loaded from a file as well
+	keyFile := filepath.Join(t.TempDir(), "credentials.key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(os.Getenv(db.EnvCredentialsKey)), 0o600))
+	t.Setenv(db.EnvCredentialsKey, "")
+	t.Setenv(db.EnvCredentialsKeyFile, keyFile)
+	reloadCredentialsKey(t)
+	_, err = db.DecryptCredentialProfile(retCp)
+	require.NoError(t, err)
+
+	// key is cached, it is not read from the file on each use
+	require.NoError(t, os.Remove(keyFile))
+	_, err = db.DecryptCredentialProfile(retCp)
+	require.NoError(t, err)
+
+	// fail - key is loaded again, once it is reloaded, but the file is gone
+	db.ReloadCredentialsKey()
+	_, err = db.DecryptCredentialProfile(retCp)
+	require.Error(t, err)
+
+	// fail - secrets can't be decrypted with a different key
+	setCredentialsKey(t)
+	_, err = db.DecryptCredentialProfile(retCp)
+	require.Error(t, err)
+}
+
+func TestCredentialProfileResourceErrors(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	// fail - creating credential profile without name
+	setCredentialsKey(t)
+	cp, err := db.CreateCredentialProfile(ctx, client, &ent.CredentialProfile{Password: "secret"})
+	require.Error(t, err)
+	require.Nil(t, cp)
+
+	// fail - creating credential profile without encryption key
+	t.Setenv(db.EnvCredentialsKey, "")
+	t.Setenv(db.EnvCredentialsKeyFile, "")
+	reloadCredentialsKey(t)
+	cp, err = db.CreateCredentialProfile(ctx, client, &ent.CredentialProfile{Name: uuid.NewString(), Password: "secret"})
+	require.Error(t, err)
+	require.Nil(t, cp)
+
+	// fail - retrieving credential profile with fake ID
+	cp, err = db.GetCredentialProfileByID(ctx, client, uuid.NewString())
+	require.Error(t, err)
+	require.Nil(t, cp)
+
+	// fail - referencing credential profile with fake ID
+	ep, err := db.SetEndpointCredentialProfile(ctx, client, uuid.NewString(), uuid.NewString())
+	require.Error(t, err)
+	require.Nil(t, ep)
+}
+
 func TestNetworkDeviceResource(t *testing.T) {
 	// creating Network Device resource
 	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
@@ -390,6 +704,19 @@ func TestNetworkDeviceResource(t *testing.T) {
 	require.NotNil(t, updNd)
 	monitoring_testing.AssertEqualNetworkDevices(t, nd, updNd)
 
+	// setting poll interval, group and site of the network device
+	pollNd, err := db.SetNetworkDevicePolling(ctx, client, nd.ID, 5, "core", "site-a")
+	require.NoError(t, err)
+	require.NotNil(t, pollNd)
+	assert.Equal(t, int32(5), pollNd.PollInterval)
+	assert.Equal(t, "core", pollNd.Group)
+	assert.Equal(t, "site-a", pollNd.Site)
+	monitoring_testing.AssertEqualNetworkDevices(t, nd, pollNd)
+
+	// fail - poll interval can't be negative
+	_, err = db.SetNetworkDevicePolling(ctx, client, nd.ID, -1, "", "")
+	require.Error(t, err)
+
 	err = db.DeleteNetworkDeviceByID(ctx, client, nd.ID)
 	assert.NoError(t, err)
 }
@@ -441,6 +768,22 @@ func TestNetworkDeviceResourceEndpoints(t *testing.T) {
 	require.NotNil(t, updNd)
 	monitoring_testing.AssertEqualNetworkDevicesEndpointsOnly(t, nd, updNd3)
 
+	// preference of the endpoint is updated together with the network device
+	ep1.Preference = 3
+	_, err = db.UpdateNetworkDeviceByUser(ctx, client, nd.ID, deviceModel, deviceVendor, []*ent.Endpoint{ep1})
+	require.NoError(t, err)
+	retEp, err := db.GetEndpointByID(ctx, client, ep1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), retEp.Preference)
+
+	// fail - preference must not be negative, network device is not updated
+	ep1.Preference = -1
+	_, err = db.UpdateNetworkDeviceByUser(ctx, client, nd.ID, "other-model", deviceVendor, []*ent.Endpoint{ep1})
+	require.Error(t, err)
+	retNd, err := db.GetNetworkDeviceByID(ctx, client, nd.ID)
+	require.NoError(t, err)
+	assert.Equal(t, deviceModel, retNd.Model)
+
 	// deleting network device
 	err = db.DeleteNetworkDeviceByID(ctx, client, nd.ID)
 	assert.NoError(t, err)
@@ -494,3 +837,491 @@ func TestNetworkDeviceResourceErrors(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, retNd)
 }
+
+func TestPollingDefaultResource(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	// setting default poll interval of the group
+	groupDefault, err := db.SetPollingDefault(ctx, client, pollingdefault.ScopePOLLING_SCOPE_GROUP, "core", 5)
+	require.NoError(t, err)
+	require.NotNil(t, groupDefault)
+	t.Cleanup(func() {
+		err = db.DeletePollingDefaultByID(ctx, client, groupDefault.ID)
+		assert.NoError(t, err)
+	})
+
+	// site with the same name is a different scope
+	siteDefault, err := db.SetPollingDefault(ctx, client, pollingdefault.ScopePOLLING_SCOPE_SITE, "core", 300)
+	require.NoError(t, err)
+	require.NotNil(t, siteDefault)
+	assert.NotEqual(t, groupDefault.ID, siteDefault.ID)
+	t.Cleanup(func() {
+		err = db.DeletePollingDefaultByID(ctx, client, siteDefault.ID)
+		assert.NoError(t, err)
+	})
+
+	// setting default of the same group again overwrites it
+	updGroupDefault, err := db.SetPollingDefault(ctx, client, pollingdefault.ScopePOLLING_SCOPE_GROUP, "core", 10)
+	require.NoError(t, err)
+	assert.Equal(t, groupDefault.ID, updGroupDefault.ID)
+	assert.Equal(t, int32(10), updGroupDefault.PollInterval)
+
+	// listing defaults
+	pds, err := db.ListPollingDefaults(ctx, client)
+	require.NoError(t, err)
+	assert.Len(t, pds, 2)
+
+	// fail - scope is unspecified
+	_, err = db.SetPollingDefault(ctx, client, pollingdefault.ScopePOLLING_SCOPE_UNSPECIFIED, "core", 5)
+	require.Error(t, err)
+
+	// fail - name is unspecified
+	_, err = db.SetPollingDefault(ctx, client, pollingdefault.ScopePOLLING_SCOPE_SITE, "", 5)
+	require.Error(t, err)
+
+	// fail - poll interval is not positive
+	_, err = db.SetPollingDefault(ctx, client, pollingdefault.ScopePOLLING_SCOPE_SITE, "edge", 0)
+	require.Error(t, err)
+}
+
+func TestLeaseResource(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+	name := "lease-" + uuid.NewString()
+
+	// first holder acquires the lease
+	acquired, err := db.AcquireLease(ctx, client, name, "replica-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// second holder can't acquire it, while it is held
+	acquired, err = db.AcquireLease(ctx, client, name, "replica-2", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+
+	// first holder renews it
+	acquired, err = db.AcquireLease(ctx, client, name, "replica-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// releasing by someone else has no effect
+	require.NoError(t, db.ReleaseLease(ctx, client, name, "replica-2"))
+	acquired, err = db.AcquireLease(ctx, client, name, "replica-2", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+
+	// once released, second holder acquires it right away
+	require.NoError(t, db.ReleaseLease(ctx, client, name, "replica-1"))
+	acquired, err = db.AcquireLease(ctx, client, name, "replica-2", time.Millisecond)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// once expired, first holder acquires it back
+	time.Sleep(10 * time.Millisecond)
+	acquired, err = db.AcquireLease(ctx, client, name, "replica-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// deleting by someone else has no effect
+	require.NoError(t, db.DeleteLease(ctx, client, name, "replica-2"))
+	acquired, err = db.AcquireLease(ctx, client, name, "replica-2", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+
+	// once deleted, lease is created again by the next holder
+	require.NoError(t, db.DeleteLease(ctx, client, name, "replica-1"))
+	acquired, err = db.AcquireLease(ctx, client, name, "replica-2", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	require.NoError(t, db.DeleteLease(ctx, client, name, "replica-2"))
+
+	// fail - holder is unspecified
+	_, err = db.AcquireLease(ctx, client, name, "", time.Minute)
+	require.Error(t, err)
+}
+
+func TestReplicaHeartbeatResource(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+	replica1 := "replica-" + uuid.NewString()
+	replica2 := "replica-" + uuid.NewString()
+
+	// recording heartbeats of two replicas, the second one twice
+	require.NoError(t, db.RecordReplicaHeartbeat(ctx, client, replica1))
+	require.NoError(t, db.RecordReplicaHeartbeat(ctx, client, replica2))
+	require.NoError(t, db.RecordReplicaHeartbeat(ctx, client, replica2))
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteReplicaHeartbeat(ctx, client, replica1))
+	})
+
+	replicas, err := db.ListLiveReplicas(ctx, client, time.Minute)
+	require.NoError(t, err)
+	assert.Contains(t, replicas, replica1)
+	assert.Contains(t, replicas, replica2)
+
+	// replica, which has left, is not live anymore
+	require.NoError(t, db.DeleteReplicaHeartbeat(ctx, client, replica2))
+	replicas, err = db.ListLiveReplicas(ctx, client, time.Minute)
+	require.NoError(t, err)
+	assert.Contains(t, replicas, replica1)
+	assert.NotContains(t, replicas, replica2)
+
+	// replica, which doesn't record its heartbeat, is considered dead after the timeout
+	time.Sleep(10 * time.Millisecond)
+	replicas, err = db.ListLiveReplicas(ctx, client, time.Millisecond)
+	require.NoError(t, err)
+	assert.NotContains(t, replicas, replica1)
+
+	// fail - replica ID is unspecified
+	require.Error(t, db.RecordReplicaHeartbeat(ctx, client, ""))
+}
+
+func TestListNetworkDevicesInShard(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	nd1, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{})
+	require.NoError(t, err)
+	nd2, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, nd1.ID))
+		assert.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, nd2.ID))
+	})
+
+	shard, err := db.ListNetworkDevicesInShard(ctx, client, func(id string) bool {
+		return id == nd2.ID
+	})
+	require.NoError(t, err)
+	require.Len(t, shard, 1)
+	assert.Equal(t, nd2.ID, shard[0].ID)
+	// edges are eager-loaded for the network devices in the shard
+	assert.NotNil(t, shard[0].Edges.Endpoints)
+
+	// shard is empty
+	shard, err = db.ListNetworkDevicesInShard(ctx, client, func(_ string) bool {
+		return false
+	})
+	require.NoError(t, err)
+	assert.Empty(t, shard)
+}
+
+func TestStatusEventResource(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	nd, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, nd.ID))
+	})
+
+	// recording transitions of the network device
+	before := time.Now()
+	se1, err := db.CreateStatusEvent(ctx, client, nd.ID, "", devicestatus.StatusSTATUS_DEVICE_UP, "device reported up")
+	require.NoError(t, err)
+	assert.Equal(t, statusevent.OldStatusSTATUS_UNSPECIFIED, se1.OldStatus)
+	time.Sleep(5 * time.Millisecond)
+	middle := time.Now()
+	time.Sleep(5 * time.Millisecond)
+	se2, err := db.CreateStatusEvent(ctx, client, nd.ID, devicestatus.StatusSTATUS_DEVICE_UP, devicestatus.StatusSTATUS_DEVICE_DOWN, "3 failed attempts")
+	require.NoError(t, err)
+
+	// listing the whole history
+	ses, err := db.ListStatusEvents(ctx, client, nd.ID, before, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, ses, 2)
+	assert.Equal(t, se1.ID, ses[0].ID)
+	assert.Equal(t, se2.ID, ses[1].ID)
+
+	// listing history within the time range
+	ses, err = db.ListStatusEvents(ctx, client, nd.ID, middle, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, ses, 1)
+	assert.Equal(t, se2.ID, ses[0].ID)
+	ses, err = db.ListStatusEvents(ctx, client, nd.ID, time.Time{}, middle)
+	require.NoError(t, err)
+	require.Len(t, ses, 1)
+	assert.Equal(t, se1.ID, ses[0].ID)
+
+	// history of the other network device is empty
+	ses, err = db.ListStatusEvents(ctx, client, uuid.NewString(), time.Time{}, time.Time{})
+	require.NoError(t, err)
+	assert.Empty(t, ses)
+
+	// fail - network device is not specified
+	_, err = db.CreateStatusEvent(ctx, client, "", devicestatus.StatusSTATUS_DEVICE_UP, devicestatus.StatusSTATUS_DEVICE_DOWN, "")
+	require.Error(t, err)
+	// fail - network device does not exist
+	_, err = db.CreateStatusEvent(ctx, client, uuid.NewString(), devicestatus.StatusSTATUS_DEVICE_UP, devicestatus.StatusSTATUS_DEVICE_DOWN, "")
+	require.Error(t, err)
+
+	// history is removed together with the network device
+	nd2, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{})
+	require.NoError(t, err)
+	se3, err := db.CreateStatusEvent(ctx, client, nd2.ID, "", devicestatus.StatusSTATUS_DEVICE_UP, "device reported up")
+	require.NoError(t, err)
+	require.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, nd2.ID))
+	exists, err := client.StatusEvent.Query().Where(statusevent.ID(se3.ID)).Exist(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestLatencySampleResource(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	ep1, err := db.CreateEndpoint(ctx, client, host1, port1, protocol1)
+	require.NoError(t, err)
+	ep2, err := db.CreateEndpoint(ctx, client, host2, port2, protocol2)
+	require.NoError(t, err)
+	nd, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{ep1, ep2})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, nd.ID))
+	})
+
+	// recording latency samples of the network device
+	before := time.Now()
+	ls1, err := db.CreateLatencySample(ctx, client, nd.ID, ep1.ID, 12*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), ls1.Latency)
+	time.Sleep(5 * time.Millisecond)
+	middle := time.Now()
+	time.Sleep(5 * time.Millisecond)
+	ls2, err := db.CreateLatencySample(ctx, client, nd.ID, ep2.ID, 340*time.Millisecond)
+	require.NoError(t, err)
+
+	// listing all samples
+	lss, err := db.ListLatencySamples(ctx, client, nd.ID, "", before, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, lss, 2)
+	assert.Equal(t, ls1.ID, lss[0].ID)
+	assert.Equal(t, ls2.ID, lss[1].ID)
+
+	// listing samples of the single endpoint
+	lss, err = db.ListLatencySamples(ctx, client, nd.ID, ep2.ID, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, lss, 1)
+	assert.Equal(t, ls2.ID, lss[0].ID)
+
+	// listing samples within the time range
+	lss, err = db.ListLatencySamples(ctx, client, nd.ID, "", time.Time{}, middle)
+	require.NoError(t, err)
+	require.Len(t, lss, 1)
+	assert.Equal(t, ls1.ID, lss[0].ID)
+
+	// samples of the other network device are empty
+	lss, err = db.ListLatencySamples(ctx, client, uuid.NewString(), "", time.Time{}, time.Time{})
+	require.NoError(t, err)
+	assert.Empty(t, lss)
+
+	// fail - endpoint is not specified
+	_, err = db.CreateLatencySample(ctx, client, nd.ID, "", time.Millisecond)
+	require.Error(t, err)
+	// fail - network device does not exist
+	_, err = db.CreateLatencySample(ctx, client, uuid.NewString(), ep1.ID, time.Millisecond)
+	require.Error(t, err)
+
+	// samples, which are older than the retention, are pruned
+	deleted, err := db.DeleteLatencySamplesBefore(ctx, client, middle)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, 1)
+	lss, err = db.ListLatencySamples(ctx, client, nd.ID, "", time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, lss, 1)
+	assert.Equal(t, ls2.ID, lss[0].ID)
+
+	// samples are removed together with the network device
+	nd2, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{})
+	require.NoError(t, err)
+	ls3, err := db.CreateLatencySample(ctx, client, nd2.ID, ep1.ID, time.Millisecond)
+	require.NoError(t, err)
+	require.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, nd2.ID))
+	exists, err := client.LatencySample.Query().Where(latencysample.ID(ls3.ID)).Exist(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestGetLatencyStats(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	ep1, err := db.CreateEndpoint(ctx, client, host1, port1, protocol1)
+	require.NoError(t, err)
+	ep2, err := db.CreateEndpoint(ctx, client, host2, port2, protocol2)
+	require.NoError(t, err)
+	nd, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{ep1, ep2})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, nd.ID))
+	})
+	before := time.Now()
+
+	// no samples
+	stats, err := db.GetLatencyStats(ctx, client, nd.ID, "", before, time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, stats.Samples)
+	assert.False(t, stats.Max.Valid)
+
+	// samples from 1 to 100 ms in reverse order
+	for i := 100; i > 0; i-- {
+		_, err = db.CreateLatencySample(ctx, client, nd.ID, ep1.ID, time.Duration(i)*time.Millisecond)
+		require.NoError(t, err)
+	}
+	stats, err = db.GetLatencyStats(ctx, client, nd.ID, ep1.ID, before, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 100, stats.Samples)
+	assert.Equal(t, int64(1), stats.Min.Int64)
+	assert.InDelta(t, 50.5, stats.Avg.Float64, 0.001)
+	assert.Equal(t, int64(95), stats.P95.Int64)
+	assert.Equal(t, int64(100), stats.Max.Int64)
+
+	// a single slow sample of the other endpoint doesn't move the 95th percentile
+	_, err = db.CreateLatencySample(ctx, client, nd.ID, ep2.ID, time.Second)
+	require.NoError(t, err)
+	stats, err = db.GetLatencyStats(ctx, client, nd.ID, "", before, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 101, stats.Samples)
+	assert.Equal(t, int64(96), stats.P95.Int64)
+	assert.Equal(t, int64(1000), stats.Max.Int64)
+
+	// samples outside of the time range are not considered
+	stats, err = db.GetLatencyStats(ctx, client, nd.ID, "", time.Now().Add(time.Minute), time.Now().Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.Zero(t, stats.Samples)
+}
+
+func TestMaintenanceWindowResource(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	nd1, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{})
+	require.NoError(t, err)
+	nd2, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, nd1.ID))
+		assert.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, nd2.ID))
+	})
+
+	// creating maintenance window
+	now := time.Now()
+	mw, err := db.CreateMaintenanceWindow(ctx, client, &ent.MaintenanceWindow{
+		Name:       "firmware upgrade",
+		Site:       "remote",
+		StartsAt:   now.UnixMilli(),
+		EndsAt:     now.Add(time.Hour).UnixMilli(),
+		Recurrence: "0 2 * * SUN",
+	}, []string{nd1.ID})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteMaintenanceWindowByID(ctx, client, mw.ID))
+	})
+	assert.NotEmpty(t, mw.ID)
+	assert.Equal(t, "remote", mw.Site)
+	require.Len(t, mw.Edges.NetworkDevices, 1)
+	assert.Equal(t, nd1.ID, mw.Edges.NetworkDevices[0].ID)
+
+	// updating it, network devices are kept
+	updMw, err := db.UpdateMaintenanceWindow(ctx, client, &ent.MaintenanceWindow{ID: mw.ID, Name: "reboot"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "reboot", updMw.Name)
+	assert.Equal(t, mw.StartsAt, updMw.StartsAt)
+	assert.Len(t, updMw.Edges.NetworkDevices, 1)
+
+	// replacing network devices
+	updMw, err = db.UpdateMaintenanceWindow(ctx, client, &ent.MaintenanceWindow{ID: mw.ID}, []string{nd2.ID})
+	require.NoError(t, err)
+	require.Len(t, updMw.Edges.NetworkDevices, 1)
+	assert.Equal(t, nd2.ID, updMw.Edges.NetworkDevices[0].ID)
+
+	mws, err := db.ListMaintenanceWindows(ctx, client)
+	require.NoError(t, err)
+	assert.NotEmpty(t, mws)
+
+	// fail - name is not specified
+	_, err = db.CreateMaintenanceWindow(ctx, client, &ent.MaintenanceWindow{StartsAt: 1, EndsAt: 2}, nil)
+	require.Error(t, err)
+	// fail - window ends before it starts
+	_, err = db.CreateMaintenanceWindow(ctx, client, &ent.MaintenanceWindow{Name: "upgrade", StartsAt: 2, EndsAt: 1}, nil)
+	require.Error(t, err)
+	_, err = db.UpdateMaintenanceWindow(ctx, client, &ent.MaintenanceWindow{ID: mw.ID, EndsAt: 1}, nil)
+	require.Error(t, err)
+	// fail - recurrence is malformed
+	_, err = db.CreateMaintenanceWindow(ctx, client, &ent.MaintenanceWindow{Name: "upgrade", StartsAt: 1, EndsAt: 2, Recurrence: "every sunday"}, nil)
+	require.Error(t, err)
+	// fail - network device does not exist
+	_, err = db.CreateMaintenanceWindow(ctx, client, &ent.MaintenanceWindow{Name: "upgrade", StartsAt: 1, EndsAt: 2}, []string{uuid.NewString()})
+	require.Error(t, err)
+	// fail - maintenance window does not exist
+	_, err = db.UpdateMaintenanceWindow(ctx, client, &ent.MaintenanceWindow{ID: uuid.NewString(), Name: "upgrade"}, nil)
+	require.Error(t, err)
+}
+
+func TestNetworkDeviceTopology(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+
+	gateway, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{})
+	require.NoError(t, err)
+	sw, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{})
+	require.NoError(t, err)
+	ap, err := db.CreateNetworkDevice(ctx, client, deviceModel, deviceVendor, []*ent.Endpoint{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, ap.ID))
+		assert.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, sw.ID))
+		assert.NoError(t, db.DeleteNetworkDeviceByID(ctx, client, gateway.ID))
+	})
+
+	// gateway -> switch -> access point
+	_, err = db.SetNetworkDeviceParent(ctx, client, sw.ID, gateway.ID)
+	require.NoError(t, err)
+	_, err = db.SetNetworkDeviceParent(ctx, client, ap.ID, sw.ID)
+	require.NoError(t, err)
+
+	parent, err := db.GetNetworkDeviceParent(ctx, client, ap.ID)
+	require.NoError(t, err)
+	require.NotNil(t, parent)
+	assert.Equal(t, sw.ID, parent.ID)
+	parent, err = db.GetNetworkDeviceParent(ctx, client, gateway.ID)
+	require.NoError(t, err)
+	assert.Nil(t, parent)
+	retGateway, err := db.GetNetworkDeviceByID(ctx, client, gateway.ID)
+	require.NoError(t, err)
+	require.Len(t, retGateway.Edges.Children, 1)
+	assert.Equal(t, sw.ID, retGateway.Edges.Children[0].ID)
+
+	// moving access point directly behind the gateway, previous parent is replaced
+	_, err = db.SetNetworkDeviceParent(ctx, client, ap.ID, gateway.ID)
+	require.NoError(t, err)
+	parent, err = db.GetNetworkDeviceParent(ctx, client, ap.ID)
+	require.NoError(t, err)
+	require.NotNil(t, parent)
+	assert.Equal(t, gateway.ID, parent.ID)
+	retSw, err := db.GetNetworkDeviceByID(ctx, client, sw.ID)
+	require.NoError(t, err)
+	assert.Empty(t, retSw.Edges.Children)
+
+	// clearing the parent
+	_, err = db.SetNetworkDeviceParent(ctx, client, ap.ID, "")
+	require.NoError(t, err)
+	parent, err = db.GetNetworkDeviceParent(ctx, client, ap.ID)
+	require.NoError(t, err)
+	assert.Nil(t, parent)
+
+	// fail - network device can't be its own parent
+	_, err = db.SetNetworkDeviceParent(ctx, client, gateway.ID, gateway.ID)
+	require.Error(t, err)
+	// fail - dependency cycle
+	_, err = db.SetNetworkDeviceParent(ctx, client, gateway.ID, sw.ID)
+	require.Error(t, err)
+	// fail - parent does not exist
+	_, err = db.SetNetworkDeviceParent(ctx, client, ap.ID, uuid.NewString())
+	require.Error(t, err)
+	// fail - network device does not exist
+	_, err = db.SetNetworkDeviceParent(ctx, client, uuid.NewString(), gateway.ID)
+	require.Error(t, err)
+}
@@ -0,0 +1,180 @@
+// Package db implements utility functions for managing resources in the PostgreSQL.
+package db
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+)
+
+const (
+	// EnvCredentialsKey is an environment variable, which carries base64 encoded AES-256 key used for encrypting
+	// secrets of the credential profiles.
+	EnvCredentialsKey = "CREDENTIALS_KEY"
+	// EnvCredentialsKeyFile is an environment variable, which carries path to the file with AES-256 key (either raw
+	// 32 bytes, or base64 encoded). It is used, when EnvCredentialsKey is not set.
+	EnvCredentialsKeyFile = "CREDENTIALS_KEY_FILE"
+
+	credentialsKeyLength = 32
+	// encryptedSecretPrefix marks encrypted secret and the version of its format, i.e., AES-256-GCM with a random
+	// nonce prepended to the ciphertext.
+	encryptedSecretPrefix = "aesgcm:v1:"
+)
+
+// credentialsCipher caches the cipher built with the key for encrypting secrets, so that the key is not loaded on each
+// use. It is built on the first use and built again, once the key is reloaded.
+var credentialsCipher struct {
+	sync.Mutex
+	aead cipher.AEAD
+}
+
+// ReloadCredentialsKey drops the cached key for encrypting secrets, so that it is loaded again on the next use,
+// e.g., once the key was rotated by replacing the file.
+func ReloadCredentialsKey() {
+	credentialsCipher.Lock()
+	defer credentialsCipher.Unlock()
+	credentialsCipher.aead = nil
+}
+
+// credentialsKey loads the key for encrypting secrets.
+func credentialsKey() ([]byte, error) {
+	encoded := strings.TrimSpace(os.Getenv(EnvCredentialsKey))
+	if encoded == "" {
+		path := os.Getenv(EnvCredentialsKeyFile)
+		if path == "" {
+			return nil, fmt.Errorf("credentials encryption key is not set, please set %s or %s",
+				EnvCredentialsKey, EnvCredentialsKeyFile)
+		}
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read credentials encryption key: %w", err)
+		}
+		if len(raw) == credentialsKeyLength {
+			return raw, nil
+		}
+		encoded = strings.TrimSpace(string(raw))
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode credentials encryption key: %w", err)
+	}
+	if len(key) != credentialsKeyLength {
+		return nil, fmt.Errorf("credentials encryption key must be %d bytes long, got %d", credentialsKeyLength, len(key))
+	}
+	return key, nil
+}
+
+// newCredentialsCipher returns the cipher for encrypting secrets. Cipher is built with the key on the first use,
+// it is cached afterwards.
+func newCredentialsCipher() (cipher.AEAD, error) {
+	credentialsCipher.Lock()
+	defer credentialsCipher.Unlock()
+	if credentialsCipher.aead != nil {
+		return credentialsCipher.aead, nil
+	}
+	key, err := credentialsKey()
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	credentialsCipher.aead = aead
+	return aead, nil
+}
+
+// encryptSecret encrypts the secret. Secret is bound to the field of the profile it belongs to, so that encrypted
+// value can't be moved to another field or profile. Empty secret is kept empty.
+func encryptSecret(aead cipher.AEAD, secret, profileID, fieldName string) (string, error) {
+	if secret == "" {
+		return "", nil
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(secret), []byte(profileID+"/"+fieldName))
+	return encryptedSecretPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptSecret decrypts the secret encrypted by encryptSecret.
+func decryptSecret(aead cipher.AEAD, encrypted, profileID, fieldName string) (string, error) {
+	if encrypted == "" {
+		return "", nil
+	}
+	encoded, ok := strings.CutPrefix(encrypted, encryptedSecretPrefix)
+	if !ok {
+		return "", fmt.Errorf("%s of credential profile (%s) is not encrypted", fieldName, profileID)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode %s of credential profile (%s): %w", fieldName, profileID, err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("%s of credential profile (%s) is malformed", fieldName, profileID)
+	}
+	secret, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(profileID+"/"+fieldName))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt %s of credential profile (%s): %w", fieldName, profileID, err)
+	}
+	return string(secret), nil
+}
+
+// secretFields returns pointers to all secrets of the credential profile, keyed by field name.
+func secretFields(cp *ent.CredentialProfile) map[string]*string {
+	return map[string]*string{
+		"password":             &cp.Password,
+		"snmp_community":       &cp.SnmpCommunity,
+		"private_key":          &cp.PrivateKey,
+		"token":                &cp.Token,
+		"snmp_priv_passphrase": &cp.SnmpPrivPassphrase,
+		"tls_client_key":       &cp.TLSClientKey,
+	}
+}
+
+// encryptCredentialProfile returns copy of the credential profile with all secrets encrypted.
+func encryptCredentialProfile(cp *ent.CredentialProfile) (*ent.CredentialProfile, error) {
+	aead, err := newCredentialsCipher()
+	if err != nil {
+		return nil, err
+	}
+	encrypted := *cp
+	for name, secret := range secretFields(&encrypted) {
+		*secret, err = encryptSecret(aead, *secret, cp.ID, name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &encrypted, nil
+}
+
+// DecryptCredentialProfile returns copy of the credential profile, as it is stored in the DB, with all secrets
+// decrypted. Decrypted profile must never leave the process, it is meant only for building the connectors.
+func DecryptCredentialProfile(cp *ent.CredentialProfile) (*ent.CredentialProfile, error) {
+	aead, err := newCredentialsCipher()
+	if err != nil {
+		zlog.Error().Err(err).Msgf("Failed to decrypt credential profile (%s)", cp.ID)
+		return nil, err
+	}
+	decrypted := *cp
+	for name, secret := range secretFields(&decrypted) {
+		*secret, err = decryptSecret(aead, *secret, cp.ID, name)
+		if err != nil {
+			zlog.Error().Err(err).Msgf("Failed to decrypt credential profile (%s)", cp.ID)
+			return nil, err
+		}
+	}
+	return &decrypted, nil
+}
@@ -10,11 +10,7 @@ import (
 
 	"github.com/eroshiva/trade-show-poc/internal/ent"
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
-	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
-	simulatorv1 "github.com/eroshiva/trade-show-poc/pkg/mocks"
 	"github.com/rs/zerolog"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 const componentName = "connector"
@@ -34,41 +30,89 @@ type Connector interface {
 	GetHWVersion(ctx context.Context) (string, error)
 	GetSWVersion(ctx context.Context) (*ent.Version, error)
 	GetFWVersion(ctx context.Context) (*ent.Version, error)
+	// GetSnapshot retrieves device status together with all versions, in a single exchange with the device, whenever
+	// protocol allows it. Error is returned only when the device is not reachable, in the same way as GetStatus does.
+	// Versions, which device has failed to report, are listed in DeviceSnapshot.Errors.
+	GetSnapshot(ctx context.Context) (*DeviceSnapshot, error)
 }
 
-// NewConnector function returns the correct connector for a given endpoint protocol.
-func NewConnector(ep *ent.Endpoint) (Connector, error) {
-	switch ep.Protocol {
-	case endpoint.ProtocolPROTOCOL_SNMP:
-		return &SNMPConnector{Endpoint: ep}, nil
-	case endpoint.ProtocolPROTOCOL_RESTCONF:
-		return &RESTCONFConnector{Endpoint: ep}, nil
-	case endpoint.ProtocolPROTOCOL_NETCONF:
-		return &NETCONFConnector{Endpoint: ep}, nil
-	case endpoint.ProtocolPROTOCOL_OPEN_V_SWITCH:
-		return &OVSConnector{Endpoint: ep}, nil
-	default:
-		err := fmt.Errorf("unsupported protocol: %s", ep.Protocol)
-		zlog.Warn().Err(err).Msgf("Protocol %s is not supported", ep.Protocol)
-		return nil, err
+// ChecksumSupplier is implemented by connectors, which protocol can't carry checksum of the versions, to declare it.
+// Versions retrieved by connectors, which don't implement it, must carry checksum, otherwise they fail verification.
+type ChecksumSupplier interface {
+	SuppliesChecksum() bool
+}
+
+// SuppliesChecksum checks if the connector supplies checksum of the versions it retrieves.
+func SuppliesChecksum(c Connector) bool {
+	cs, ok := c.(ChecksumSupplier)
+	return !ok || cs.SuppliesChecksum()
+}
+
+// SnapshotPart identifies a part of the device snapshot, which may fail on its own.
+type SnapshotPart string
+
+const (
+	// SnapshotPartHWVersion identifies HW version of the device.
+	SnapshotPartHWVersion SnapshotPart = "hw-version"
+	// SnapshotPartSWVersion identifies SW version of the device.
+	SnapshotPartSWVersion SnapshotPart = "sw-version"
+	// SnapshotPartFWVersion identifies FW version of the device.
+	SnapshotPartFWVersion SnapshotPart = "fw-version"
+)
+
+// DeviceSnapshot carries device status together with HW, SW, and FW versions.
+type DeviceSnapshot struct {
+	Status    devicestatus.Status
+	HWVersion string
+	// SWVersion and FWVersion are never nil, version, which was not retrieved, is empty.
+	SWVersion *ent.Version
+	FWVersion *ent.Version
+	// Errors carries the parts, which device has failed to report.
+	Errors map[SnapshotPart]error
+}
+
+// newDeviceSnapshot creates snapshot of the device with given status and no versions.
+func newDeviceSnapshot(status devicestatus.Status) *DeviceSnapshot {
+	return &DeviceSnapshot{
+		Status:    status,
+		SWVersion: &ent.Version{},
+		FWVersion: &ent.Version{},
+		Errors:    make(map[SnapshotPart]error),
 	}
 }
 
-// establishGRPCConnection established gRPC connection with provided endpoint. It returns Network Device
-// Simulator client interface for communicating with Device Simulator.
-func establishGRPCConnection(ep *ent.Endpoint) (simulatorv1.MockDeviceServiceClient, *grpc.ClientConn, error) {
-	serverAddress := CraftServerAddressFromEndpoint(ep)
-	// creating the gRPC client
-	conn, err := grpc.NewClient(
-		serverAddress, // The address of the gRPC server
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-	if err != nil {
-		zlog.Error().Err(err).Msgf("Failed to dial to gRPC server %s", serverAddress)
-		return nil, nil, err
+// failVersions marks all versions in the snapshot as failed with the same error.
+func (s *DeviceSnapshot) failVersions(err error) {
+	for _, part := range []SnapshotPart{SnapshotPartHWVersion, SnapshotPartSWVersion, SnapshotPartFWVersion} {
+		s.Errors[part] = err
 	}
+}
 
-	return simulatorv1.NewMockDeviceServiceClient(conn), conn, nil
+// CollectSnapshot assembles device snapshot by calling GetStatus, GetHWVersion, GetSWVersion, and GetFWVersion one
+// after another. It is meant for connectors, which protocol does not allow retrieving everything in a single exchange.
+func CollectSnapshot(ctx context.Context, c Connector) (*DeviceSnapshot, error) {
+	status, err := c.GetStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := newDeviceSnapshot(status)
+	snapshot.HWVersion, err = c.GetHWVersion(ctx)
+	if err != nil {
+		snapshot.Errors[SnapshotPartHWVersion] = err
+	}
+	swV, err := c.GetSWVersion(ctx)
+	if err != nil {
+		snapshot.Errors[SnapshotPartSWVersion] = err
+	} else if swV != nil {
+		snapshot.SWVersion = swV
+	}
+	fwV, err := c.GetFWVersion(ctx)
+	if err != nil {
+		snapshot.Errors[SnapshotPartFWVersion] = err
+	} else if fwV != nil {
+		snapshot.FWVersion = fwV
+	}
+	return snapshot, nil
 }
 
 // CraftServerAddressFromEndpoint returns string containing server address in the form host:port, e.g., localhost:50051,
@@ -81,3 +125,13 @@ func CraftServerAddressFromEndpoint(ep *ent.Endpoint) string {
 func CraftServerAddress(host, port string) string {
 	return fmt.Sprintf("%s:%s", host, port)
 }
+
+// operationDeadline returns the deadline of a single exchange with the device, i.e., timeout from now,
+// unless the context expires earlier.
+func operationDeadline(ctx context.Context, timeout time.Duration) time.Time {
+	deadline := time.Now().Add(timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	return deadline
+}
@@ -0,0 +1,95 @@
+// Package connectors_test implements unit tests for protocol connectors.
+package connectors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// noFWConnector is a connector, which fails to report its FW version.
+type noFWConnector struct {
+	fakeConnector
+}
+
+func (c *noFWConnector) GetFWVersion(_ context.Context) (*ent.Version, error) {
+	return nil, errDeviceFailure
+}
+
+// assertSnapshot verifies that the device is UP and has reported all versions.
+func assertSnapshot(t *testing.T, c connectors.Connector) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	snapshot, err := c.GetSnapshot(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, snapshot.Status)
+	assert.Empty(t, snapshot.Errors)
+	assert.Equal(t, testHWModel, snapshot.HWVersion)
+	assert.Equal(t, testSWVersion, snapshot.SWVersion.Version)
+	assert.Empty(t, snapshot.SWVersion.Checksum)
+	assert.Equal(t, testFWVersion, snapshot.FWVersion.Version)
+	assert.Empty(t, snapshot.FWVersion.Checksum)
+}
+
+// assertSnapshotStatus verifies that the device has answered with the expected status.
+func assertSnapshotStatus(t *testing.T, c connectors.Connector, expected devicestatus.Status) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	snapshot, err := c.GetSnapshot(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, expected, snapshot.Status)
+	require.NotNil(t, snapshot.SWVersion)
+	require.NotNil(t, snapshot.FWVersion)
+}
+
+// assertSnapshotUnreachable verifies that the connector reports an error, when device is unreachable.
+func assertSnapshotUnreachable(t *testing.T, c connectors.Connector) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	snapshot, err := c.GetSnapshot(ctx)
+	require.Error(t, err)
+	assert.Nil(t, snapshot)
+}
+
+func TestCollectSnapshot(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	c := &fakeConnector{}
+	assertSnapshot(t, c)
+
+	// version, which has failed, is reported, the rest of the snapshot is kept
+	snapshot, err := connectors.CollectSnapshot(ctx, &noFWConnector{})
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, snapshot.Status)
+	assert.Equal(t, testHWModel, snapshot.HWVersion)
+	assert.Equal(t, testSWVersion, snapshot.SWVersion.Version)
+	require.NotNil(t, snapshot.FWVersion)
+	assert.Empty(t, snapshot.FWVersion.Version)
+	require.Len(t, snapshot.Errors, 1)
+	require.ErrorIs(t, snapshot.Errors[connectors.SnapshotPartFWVersion], errDeviceFailure)
+}
+
+func TestSuppliesChecksum(t *testing.T) {
+	// connectors are expected to supply checksum, unless they declare otherwise
+	assert.True(t, connectors.SuppliesChecksum(&fakeConnector{}))
+	assert.False(t, connectors.SuppliesChecksum(&connectors.SNMPConnector{}))
+	assert.False(t, connectors.SuppliesChecksum(&connectors.NETCONFConnector{}))
+	assert.False(t, connectors.SuppliesChecksum(&connectors.RESTCONFConnector{}))
+	assert.False(t, connectors.SuppliesChecksum(&connectors.GNMIConnector{}))
+	assert.False(t, connectors.SuppliesChecksum(&connectors.OVSConnector{}))
+}
@@ -0,0 +1,165 @@
+// Package connectors implements common interface for all devices and carries individual implementations of each protocol.
+package connectors
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorKind classifies a failure of the exchange with the device.
+type ErrorKind string
+
+const (
+	// ErrorKindUnreachable means that the device can't be reached, e.g., connection is refused or host is not resolved.
+	ErrorKindUnreachable ErrorKind = "unreachable"
+	// ErrorKindTimeout means that the device has not answered in time.
+	ErrorKindTimeout ErrorKind = "timeout"
+	// ErrorKindAuthFailed means that the device has rejected the credentials.
+	ErrorKindAuthFailed ErrorKind = "auth-failed"
+	// ErrorKindUntrusted means that the device certificate (or SSH host key) was not trusted.
+	ErrorKindUntrusted ErrorKind = "untrusted"
+	// ErrorKindProtocolError means that the device has answered, but its answer could not be processed.
+	ErrorKindProtocolError ErrorKind = "protocol-error"
+	// ErrorKindUnsupported means that the connector (or the device) does not support the requested operation.
+	ErrorKindUnsupported ErrorKind = "unsupported"
+)
+
+var (
+	// ErrUnreachable matches errors of ErrorKindUnreachable kind with errors.Is.
+	ErrUnreachable = &Error{Kind: ErrorKindUnreachable}
+	// ErrTimeout matches errors of ErrorKindTimeout kind with errors.Is.
+	ErrTimeout = &Error{Kind: ErrorKindTimeout}
+	// ErrAuthFailed matches errors of ErrorKindAuthFailed kind with errors.Is.
+	ErrAuthFailed = &Error{Kind: ErrorKindAuthFailed}
+	// ErrUntrusted matches errors of ErrorKindUntrusted kind with errors.Is.
+	ErrUntrusted = &Error{Kind: ErrorKindUntrusted}
+	// ErrProtocolError matches errors of ErrorKindProtocolError kind with errors.Is.
+	ErrProtocolError = &Error{Kind: ErrorKindProtocolError}
+	// ErrUnsupported matches errors of ErrorKindUnsupported kind with errors.Is, e.g., it is returned by reachability
+	// probes, which report status only, from the version getters.
+	ErrUnsupported = &Error{Kind: ErrorKindUnsupported}
+)
+
+// Error is a failure of the exchange with the device classified by its kind. Connectors return it, whenever they fail
+// to reach the device or to retrieve the information from it.
+type Error struct {
+	Kind ErrorKind
+	Err  error
+}
+
+// kindError is implemented by the errors of the connectors, which know their kind, e.g., HTTP errors of RESTCONF.
+type kindError interface {
+	errorKind() ErrorKind
+}
+
+// NewError creates an error of the given kind, which wraps the cause.
+func NewError(kind ErrorKind, err error) *Error {
+	return &Error{Kind: kind, Err: err}
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return string(e.Kind)
+	}
+	return fmt.Sprintf("%s: %v", e.Kind, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is matches the error against the sentinel of the same kind, e.g., ErrTimeout.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Err == nil && t.Kind == e.Kind
+}
+
+// Classify returns the kind of the error. Errors, which were not classified by the connector, are classified
+// by their cause, anything unknown is a protocol error. It returns an empty kind for nil error.
+func Classify(err error) ErrorKind {
+	if err == nil {
+		return ""
+	}
+	var typed *Error
+	if errors.As(err, &typed) {
+		return typed.Kind
+	}
+	return classifyCause(err)
+}
+
+// classifyError wraps the error into Error of the kind derived from its cause, unless it is classified already.
+func classifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var typed *Error
+	if errors.As(err, &typed) {
+		return err
+	}
+	return NewError(classifyCause(err), err)
+}
+
+// classifyCause derives the kind of the error from its cause.
+func classifyCause(err error) ErrorKind {
+	var ke kindError
+	if errors.As(err, &ke) {
+		return ke.errorKind()
+	}
+	// deadline is checked first, network errors are carrying it as well
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) || errors.Is(err, os.ErrDeadlineExceeded) {
+		return ErrorKindTimeout
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrorKindTimeout
+	}
+	if kind, ok := classifyTLSError(err); ok {
+		return kind
+	}
+	if st, ok := status.FromError(err); ok {
+		return classifyGRPCCode(st.Code())
+	}
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	if errors.As(err, &opErr) || errors.As(err, &dnsErr) {
+		return ErrorKindUnreachable
+	}
+	return ErrorKindProtocolError
+}
+
+// classifyTLSError reports failed verification of the device certificate as untrusted device.
+func classifyTLSError(err error) (ErrorKind, bool) {
+	var verificationErr *tls.CertificateVerificationError
+	var authorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidErr x509.CertificateInvalidError
+	if errors.As(err, &verificationErr) || errors.As(err, &authorityErr) || errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidErr) {
+		return ErrorKindUntrusted, true
+	}
+	return "", false
+}
+
+// classifyGRPCCode derives the kind of the error from gRPC status code.
+func classifyGRPCCode(code codes.Code) ErrorKind {
+	switch code {
+	case codes.Unavailable:
+		return ErrorKindUnreachable
+	case codes.DeadlineExceeded, codes.Canceled:
+		return ErrorKindTimeout
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return ErrorKindAuthFailed
+	case codes.Unimplemented:
+		return ErrorKindUnsupported
+	default:
+		return ErrorKindProtocolError
+	}
+}
@@ -0,0 +1,63 @@
+// Package connectors_test implements unit tests for protocol connectors.
+package connectors_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassify(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err  error
+		kind connectors.ErrorKind
+	}{
+		"no error":            {err: nil, kind: ""},
+		"typed error":         {err: connectors.NewError(connectors.ErrorKindAuthFailed, errors.New("denied")), kind: connectors.ErrorKindAuthFailed},
+		"wrapped typed error": {err: fmt.Errorf("failed: %w", connectors.ErrUnsupported), kind: connectors.ErrorKindUnsupported},
+		"deadline":            {err: fmt.Errorf("failed: %w", context.DeadlineExceeded), kind: connectors.ErrorKindTimeout},
+		"connection refused": {
+			err:  &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+			kind: connectors.ErrorKindUnreachable,
+		},
+		"host not found":    {err: &net.DNSError{Err: "no such host", Name: "device.invalid", IsNotFound: true}, kind: connectors.ErrorKindUnreachable},
+		"gRPC unavailable":  {err: status.Error(codes.Unavailable, "connection refused"), kind: connectors.ErrorKindUnreachable},
+		"gRPC unauthorized": {err: fmt.Errorf("failed: %w", status.Error(codes.Unauthenticated, "denied")), kind: connectors.ErrorKindAuthFailed},
+		"unknown error":     {err: errors.New("unexpected message"), kind: connectors.ErrorKindProtocolError},
+		"unknown authority": {
+			err:  &tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}},
+			kind: connectors.ErrorKindUntrusted,
+		},
+		"hostname mismatch": {err: fmt.Errorf("failed: %w", x509.HostnameError{Host: "device.invalid"}), kind: connectors.ErrorKindUntrusted},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.kind, connectors.Classify(tc.err))
+		})
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	cause := errors.New("i/o timeout")
+	err := fmt.Errorf("failed to poll: %w", connectors.NewError(connectors.ErrorKindTimeout, cause))
+	require.ErrorIs(t, err, connectors.ErrTimeout)
+	require.ErrorIs(t, err, cause)
+	assert.NotErrorIs(t, err, connectors.ErrUnreachable)
+	assert.Equal(t, "failed to poll: timeout: i/o timeout", err.Error())
+
+	// protocol without connector is not supported
+	_, err = connectors.NewConnector(&ent.Endpoint{Host: "localhost", Port: "1", Protocol: endpoint.ProtocolPROTOCOL_CUSTOM,
+		ProtocolName: uniqueProtocol()})
+	require.ErrorIs(t, err, connectors.ErrUnsupported)
+}
@@ -0,0 +1,592 @@
+// Package connectors implements common interface for all devices and carries individual implementations of each protocol.
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	componentNameGNMI = "gnmi-connector"
+
+	defaultGNMITimeout = 5 * time.Second
+
+	// OpenConfig paths relevant for monitoring.
+	gnmiSystemStatePath = "/system/state"
+	gnmiComponentsPath  = "/components"
+
+	// identities of openconfig-platform-types, which are relevant for monitoring.
+	openconfigComponentChassis   = "CHASSIS"
+	openconfigOperStatusActive   = "ACTIVE"
+	openconfigOperStatusNotKnown = ""
+)
+
+var zlogGNMI = zerolog.New(zerolog.ConsoleWriter{
+	Out:        os.Stderr,
+	TimeFormat: time.RFC3339,
+	FormatCaller: func(i interface{}) string {
+		return filepath.Dir(fmt.Sprintf("%s/", i))
+	},
+}).Level(zerolog.TraceLevel).With().Caller().Timestamp().Str(component, componentNameGNMI).Logger()
+
+// GNMIConnector handles status checks for gNMI devices. It reads OpenConfig system and platform models.
+type GNMIConnector struct {
+	Endpoint *ent.Endpoint
+	// Username and Password are sent as gRPC metadata with each request, when set.
+	Username string
+	Password string
+	// TLS carries TLS settings, connection is in plain text, when it is not set.
+	TLS     *TLSSettings
+	Timeout time.Duration
+}
+
+// gnmiSession is a gNMI client connection together with the encoding negotiated via Capabilities. It is kept
+// in the connection pool between the calls.
+type gnmiSession struct {
+	conn       *grpc.ClientConn
+	client     gnmi.GNMIClient
+	encoding   gnmi.Encoding
+	negotiated bool
+	// creds records failed TLS handshakes of the connection, nil for plain text connections
+	creds *gnmiTLSCredentials
+}
+
+// gnmiTLSCredentials wraps TLS transport credentials of the gRPC connection and records the last failed verification
+// of the device certificate. gRPC reports failed handshake only as Unavailable status, which carries its text.
+type gnmiTLSCredentials struct {
+	credentials.TransportCredentials
+	// shared by the clones, gRPC clones the credentials for each transport
+	handshake *gnmiHandshake
+}
+
+// gnmiHandshake holds the failed verification of the device certificate, nil when the last handshake has succeeded.
+type gnmiHandshake struct {
+	mu  sync.Mutex
+	err error
+}
+
+// untrustedError is returned, when the device certificate was not trusted during TLS handshake.
+type untrustedError struct {
+	err   error
+	cause error
+}
+
+func (e *untrustedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *untrustedError) Unwrap() []error {
+	return []error{e.err, e.cause}
+}
+
+func (e *untrustedError) errorKind() ErrorKind {
+	return ErrorKindUntrusted
+}
+
+// openconfigSystemState carries subset of the /system/state container (openconfig-system), which is relevant for monitoring.
+type openconfigSystemState struct {
+	Hostname        string `json:"hostname"`
+	SoftwareVersion string `json:"software-version"`
+	CurrentDatetime string `json:"current-datetime"`
+}
+
+// openconfigComponents carries subset of the /components container (openconfig-platform), which is relevant for monitoring.
+type openconfigComponents struct {
+	Component []openconfigComponent `json:"component"`
+}
+
+// openconfigComponent carries subset of the /components/component list entry.
+type openconfigComponent struct {
+	Name  string `json:"name"`
+	State struct {
+		Type            string `json:"type"`
+		OperStatus      string `json:"oper-status"`
+		HardwareVersion string `json:"hardware-version"`
+		FirmwareVersion string `json:"firmware-version"`
+		SoftwareVersion string `json:"software-version"`
+		PartNo          string `json:"part-no"`
+	} `json:"state"`
+}
+
+// chassis returns the chassis component, which describes the device as a whole. If there is no chassis
+// component, first component is returned.
+func (c *openconfigComponents) chassis() *openconfigComponent {
+	if c == nil || len(c.Component) == 0 {
+		return nil
+	}
+	for i := range c.Component {
+		if stripModulePrefix(c.Component[i].State.Type) == openconfigComponentChassis {
+			return &c.Component[i]
+		}
+	}
+	return &c.Component[0]
+}
+
+// hardwareVersion returns hardware version of the component. Part number is used, when hardware version is not reported.
+func (c *openconfigComponent) hardwareVersion() string {
+	if c.State.HardwareVersion != "" {
+		return c.State.HardwareVersion
+	}
+	return c.State.PartNo
+}
+
+func init() {
+	Register(string(endpoint.ProtocolPROTOCOL_GNMI), newGNMIConnector)
+}
+
+// newGNMIConnector is a factory of the gNMI connector. It takes user name and password from the credentials.
+// Connection is secured, when TLS settings are provided.
+func newGNMIConnector(ep *ent.Endpoint, opts Options) (Connector, error) {
+	c := &GNMIConnector{
+		Endpoint: ep,
+		Timeout:  opts.Timeout,
+	}
+	if cp := opts.Credentials; cp != nil {
+		c.Username = cp.Username
+		c.Password = cp.Password
+	}
+	c.TLS = opts.TLS
+	return c, nil
+}
+
+// GetStatus implements the Connector interface, namely GetStatus function, for gNMI protocol.
+// Device answering Capabilities request is alive, its status is derived from the oper-status of the chassis component.
+func (c *GNMIConnector) GetStatus(ctx context.Context) (devicestatus.Status, error) {
+	zlogGNMI.Info().Msgf("Checking status for %s:%s via gNMI...\n", c.Endpoint.Host, c.Endpoint.Port)
+	components := &openconfigComponents{}
+	var getErr error
+	err := c.do(ctx, func(ctx context.Context, s *gnmiSession) error {
+		// capabilities are retrieved each time, they serve as a liveness check
+		err := s.negotiate(ctx)
+		if err != nil {
+			return err
+		}
+		getErr = s.get(ctx, gnmiComponentsPath, components)
+		return nil
+	})
+	if err != nil {
+		zlogGNMI.Error().Err(err).Msgf("Failed to retrieve device status for %s:%s via gNMI", c.Endpoint.Host, c.Endpoint.Port)
+		// failed to retrieve capabilities, returning device status DOWN and an error.
+		return devicestatus.StatusSTATUS_DEVICE_DOWN, err
+	}
+	if getErr != nil {
+		// device has answered, but was not able to process the request
+		zlogGNMI.Warn().Err(getErr).Msgf("Device %s:%s is not healthy", c.Endpoint.Host, c.Endpoint.Port)
+		return devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, nil
+	}
+	chassis := components.chassis()
+	if chassis == nil {
+		// device does not report any component, but it has answered
+		return devicestatus.StatusSTATUS_DEVICE_UP, nil
+	}
+	switch stripModulePrefix(chassis.State.OperStatus) {
+	case openconfigOperStatusActive, openconfigOperStatusNotKnown:
+		return devicestatus.StatusSTATUS_DEVICE_UP, nil
+	default:
+		// "INACTIVE" or "DISABLED"
+		return devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, nil
+	}
+}
+
+// GetHWVersion implements the Connector interface, namely GetHWVersion function, for gNMI protocol.
+func (c *GNMIConnector) GetHWVersion(ctx context.Context) (string, error) {
+	zlogGNMI.Info().Msgf("Checking HW version for %s:%s via gNMI...\n", c.Endpoint.Host, c.Endpoint.Port)
+	chassis, err := c.getChassis(ctx)
+	if err != nil {
+		zlogGNMI.Error().Err(err).Msgf("Failed to retrieve HW version for %s:%s via gNMI", c.Endpoint.Host, c.Endpoint.Port)
+		return "", err
+	}
+	hwV := chassis.hardwareVersion()
+	if hwV == "" {
+		err = fmt.Errorf("device does not report hardware version")
+		zlogGNMI.Error().Err(err).Msgf("Failed to retrieve HW version for %s:%s via gNMI", c.Endpoint.Host, c.Endpoint.Port)
+		return "", err
+	}
+	return hwV, nil
+}
+
+// GetSWVersion implements the Connector interface, namely GetSWVersion function, for gNMI protocol.
+// Software version of the chassis is used, software version from /system/state is used as a fallback.
+func (c *GNMIConnector) GetSWVersion(ctx context.Context) (*ent.Version, error) {
+	zlogGNMI.Info().Msgf("Checking SW version for %s:%s via gNMI...\n", c.Endpoint.Host, c.Endpoint.Port)
+	swV := ""
+	chassis, err := c.getChassis(ctx)
+	if err == nil {
+		swV = chassis.State.SoftwareVersion
+	}
+	if swV == "" {
+		ss := &openconfigSystemState{}
+		err = c.get(ctx, gnmiSystemStatePath, ss)
+		if err != nil {
+			zlogGNMI.Error().Err(err).Msgf("Failed to retrieve SW version for %s:%s via gNMI", c.Endpoint.Host, c.Endpoint.Port)
+			return nil, err
+		}
+		swV = ss.SoftwareVersion
+	}
+	if swV == "" {
+		err = fmt.Errorf("device does not report software version")
+		zlogGNMI.Error().Err(err).Msgf("Failed to retrieve SW version for %s:%s via gNMI", c.Endpoint.Host, c.Endpoint.Port)
+		return nil, err
+	}
+	return &ent.Version{
+		Version: swV,
+	}, nil
+}
+
+// GetFWVersion implements the Connector interface, namely GetFWVersion function, for gNMI protocol.
+func (c *GNMIConnector) GetFWVersion(ctx context.Context) (*ent.Version, error) {
+	zlogGNMI.Info().Msgf("Checking FW version for %s:%s via gNMI...\n", c.Endpoint.Host, c.Endpoint.Port)
+	chassis, err := c.getChassis(ctx)
+	if err != nil {
+		zlogGNMI.Error().Err(err).Msgf("Failed to retrieve FW version for %s:%s via gNMI", c.Endpoint.Host, c.Endpoint.Port)
+		return nil, err
+	}
+	if chassis.State.FirmwareVersion == "" {
+		err = fmt.Errorf("device does not report firmware version")
+		zlogGNMI.Error().Err(err).Msgf("Failed to retrieve FW version for %s:%s via gNMI", c.Endpoint.Host, c.Endpoint.Port)
+		return nil, err
+	}
+	return &ent.Version{
+		Version: chassis.State.FirmwareVersion,
+	}, nil
+}
+
+// SuppliesChecksum implements the ChecksumSupplier interface.
+// OpenConfig components do not carry checksum of the versions, it is generated by the manager.
+func (c *GNMIConnector) SuppliesChecksum() bool {
+	return false
+}
+
+// GetSnapshot implements the Connector interface, namely GetSnapshot function, for gNMI protocol.
+// Status and all versions are retrieved from /components with a single Get request. /system/state is requested
+// only when chassis does not report software version.
+func (c *GNMIConnector) GetSnapshot(ctx context.Context) (*DeviceSnapshot, error) {
+	zlogGNMI.Info().Msgf("Retrieving snapshot for %s:%s via gNMI...\n", c.Endpoint.Host, c.Endpoint.Port)
+	components := &openconfigComponents{}
+	ss := &openconfigSystemState{}
+	var ssErr error
+	err := c.do(ctx, func(ctx context.Context, s *gnmiSession) error {
+		if !s.negotiated {
+			err := s.negotiate(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		err := s.get(ctx, gnmiComponentsPath, components)
+		if err != nil {
+			return err
+		}
+		if chassis := components.chassis(); chassis == nil || chassis.State.SoftwareVersion == "" {
+			ssErr = s.get(ctx, gnmiSystemStatePath, ss)
+		}
+		return nil
+	})
+	if err != nil {
+		if !gnmiUnreachable(err) {
+			// device has answered, but was not able to process the request
+			zlogGNMI.Warn().Err(err).Msgf("Device %s:%s is not healthy", c.Endpoint.Host, c.Endpoint.Port)
+			snapshot := newDeviceSnapshot(devicestatus.StatusSTATUS_DEVICE_UNHEALTHY)
+			snapshot.failVersions(err)
+			return snapshot, nil
+		}
+		zlogGNMI.Error().Err(err).Msgf("Failed to retrieve snapshot for %s:%s via gNMI", c.Endpoint.Host, c.Endpoint.Port)
+		// failed to retrieve device status, returning an error.
+		return nil, err
+	}
+
+	snapshot := newDeviceSnapshot(devicestatus.StatusSTATUS_DEVICE_UP)
+	chassis := components.chassis()
+	if chassis == nil {
+		chassis = &openconfigComponent{}
+	}
+	switch stripModulePrefix(chassis.State.OperStatus) {
+	case openconfigOperStatusActive, openconfigOperStatusNotKnown:
+	default:
+		// "INACTIVE" or "DISABLED"
+		snapshot.Status = devicestatus.StatusSTATUS_DEVICE_UNHEALTHY
+	}
+	snapshot.HWVersion = chassis.hardwareVersion()
+	if snapshot.HWVersion == "" {
+		snapshot.Errors[SnapshotPartHWVersion] = fmt.Errorf("device does not report hardware version")
+	}
+	snapshot.SWVersion.Version = chassis.State.SoftwareVersion
+	if snapshot.SWVersion.Version == "" {
+		snapshot.SWVersion.Version = ss.SoftwareVersion
+	}
+	if snapshot.SWVersion.Version == "" {
+		if ssErr == nil {
+			ssErr = fmt.Errorf("device does not report software version")
+		}
+		snapshot.Errors[SnapshotPartSWVersion] = ssErr
+	}
+	snapshot.FWVersion.Version = chassis.State.FirmwareVersion
+	if snapshot.FWVersion.Version == "" {
+		snapshot.Errors[SnapshotPartFWVersion] = fmt.Errorf("device does not report firmware version")
+	}
+	return snapshot, nil
+}
+
+// getChassis retrieves chassis component from /components.
+func (c *GNMIConnector) getChassis(ctx context.Context) (*openconfigComponent, error) {
+	components := &openconfigComponents{}
+	err := c.get(ctx, gnmiComponentsPath, components)
+	if err != nil {
+		return nil, err
+	}
+	chassis := components.chassis()
+	if chassis == nil {
+		return nil, fmt.Errorf("device does not report any component")
+	}
+	return chassis, nil
+}
+
+// get performs Get on provided path over pooled gNMI session.
+func (c *GNMIConnector) get(ctx context.Context, path string, v interface{}) error {
+	return c.do(ctx, func(ctx context.Context, s *gnmiSession) error {
+		if !s.negotiated {
+			err := s.negotiate(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		return s.get(ctx, path, v)
+	})
+}
+
+// do leases gNMI session from the connection pool and runs fn over it with the request context.
+func (c *GNMIConnector) do(ctx context.Context, fn func(ctx context.Context, s *gnmiSession) error) error {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	key := poolKey(endpoint.ProtocolPROTOCOL_GNMI, CraftServerAddressFromEndpoint(c.Endpoint), c.Username, c.Password,
+		c.TLS.poolKey())
+	err := DefaultPool().Do(ctx, key, func(_ context.Context) (PooledConn, error) {
+		return c.dial()
+	}, func(conn PooledConn) error {
+		s := conn.(*gnmiSession)
+		return s.untrusted(fn(ctx, s))
+	})
+	return classifyError(err)
+}
+
+// requestContext bounds the request with the timeout and attaches credentials, if any.
+func (c *GNMIConnector) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = defaultGNMITimeout
+	}
+	if c.Username != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "username", c.Username, "password", c.Password)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// dial creates gNMI client, TLS is used when configured. Connection is established lazily with the first request.
+func (c *GNMIConnector) dial() (*gnmiSession, error) {
+	serverAddress := CraftServerAddressFromEndpoint(c.Endpoint)
+	creds := insecure.NewCredentials()
+	var tlsCreds *gnmiTLSCredentials
+	if c.TLS != nil {
+		cfg, err := c.TLS.Config(c.Endpoint.Host)
+		if err != nil {
+			return nil, err
+		}
+		tlsCreds = &gnmiTLSCredentials{TransportCredentials: credentials.NewTLS(cfg), handshake: &gnmiHandshake{}}
+		creds = tlsCreds
+	}
+	conn, err := grpc.NewClient(serverAddress, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s: %w", serverAddress, err)
+	}
+	return &gnmiSession{
+		conn:   conn,
+		client: gnmi.NewGNMIClient(conn),
+		creds:  tlsCreds,
+	}, nil
+}
+
+// ClientHandshake performs TLS handshake with the device and records failed verification of the device certificate.
+func (c *gnmiTLSCredentials) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn,
+	credentials.AuthInfo, error,
+) {
+	conn, info, err := c.TransportCredentials.ClientHandshake(ctx, authority, rawConn)
+	var untrusted error
+	if kind, ok := classifyTLSError(err); ok && kind == ErrorKindUntrusted {
+		untrusted = err
+	}
+	c.handshake.mu.Lock()
+	c.handshake.err = untrusted
+	c.handshake.mu.Unlock()
+	return conn, info, err
+}
+
+// Clone keeps recording handshakes of the clone.
+func (c *gnmiTLSCredentials) Clone() credentials.TransportCredentials {
+	return &gnmiTLSCredentials{TransportCredentials: c.TransportCredentials.Clone(), handshake: c.handshake}
+}
+
+// untrusted classifies the failed request as untrusted device, when the device certificate was not trusted during
+// the last TLS handshake.
+func (s *gnmiSession) untrusted(err error) error {
+	if err == nil || s.creds == nil {
+		return err
+	}
+	s.creds.handshake.mu.Lock()
+	cause := s.creds.handshake.err
+	s.creds.handshake.mu.Unlock()
+	if cause == nil {
+		return err
+	}
+	return &untrustedError{err: err, cause: cause}
+}
+
+// negotiate retrieves device capabilities in order to pick the encoding.
+func (s *gnmiSession) negotiate(ctx context.Context) error {
+	caps, err := s.client.Capabilities(ctx, &gnmi.CapabilityRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve capabilities: %w", err)
+	}
+	zlogGNMI.Debug().Msgf("Device %s runs gNMI %s", s.conn.Target(), caps.GetGNMIVersion())
+	// JSON_IETF (RFC 7951) is preferred, since it is mandated by OpenConfig
+	encodings := caps.GetSupportedEncodings()
+	switch {
+	case containsEncoding(encodings, gnmi.Encoding_JSON_IETF):
+		s.encoding = gnmi.Encoding_JSON_IETF
+	case containsEncoding(encodings, gnmi.Encoding_JSON):
+		s.encoding = gnmi.Encoding_JSON
+	default:
+		return fmt.Errorf("device supports neither JSON, nor JSON_IETF encoding: %v", encodings)
+	}
+	s.negotiated = true
+	return nil
+}
+
+// get performs Get of the state data on provided path and decodes the value into v. Module prefixes
+// of the JSON_IETF encoded member names are dropped.
+func (s *gnmiSession) get(ctx context.Context, path string, v interface{}) error {
+	resp, err := s.client.Get(ctx, &gnmi.GetRequest{
+		Path:     []*gnmi.Path{gnmiPath(path)},
+		Type:     gnmi.GetRequest_STATE,
+		Encoding: s.encoding,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", path, err)
+	}
+	for _, notification := range resp.GetNotification() {
+		for _, update := range notification.GetUpdate() {
+			raw := update.GetVal().GetJsonIetfVal()
+			if raw == nil {
+				raw = update.GetVal().GetJsonVal()
+			}
+			if raw == nil {
+				continue
+			}
+			var value interface{}
+			err = json.Unmarshal(raw, &value)
+			if err != nil {
+				return fmt.Errorf("failed to decode %s: %w", path, err)
+			}
+			normalized, err := json.Marshal(stripJSONModulePrefixes(value))
+			if err != nil {
+				return err
+			}
+			return json.Unmarshal(normalized, v)
+		}
+	}
+	return fmt.Errorf("response does not contain %s", path)
+}
+
+// Ping implements the PooledConn interface. gRPC reconnects on its own, thus connection is usable, unless it is
+// shut down or is failing to connect.
+func (s *gnmiSession) Ping(_ context.Context) error {
+	state := s.conn.GetState()
+	if state == connectivity.Shutdown || state == connectivity.TransientFailure {
+		return fmt.Errorf("gNMI connection is in %s state", state)
+	}
+	return nil
+}
+
+// Close implements the PooledConn interface, it closes gNMI connection.
+func (s *gnmiSession) Close() error {
+	return s.conn.Close()
+}
+
+// gnmiPath converts slash separated path without keys to gNMI path.
+func gnmiPath(path string) *gnmi.Path {
+	p := &gnmi.Path{}
+	for _, elem := range strings.Split(strings.Trim(path, "/"), "/") {
+		p.Elem = append(p.Elem, &gnmi.PathElem{Name: elem})
+	}
+	return p
+}
+
+// gnmiUnreachable reports whether the request has failed, because the device could not be reached,
+// as opposed to the device answering with an error.
+func gnmiUnreachable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.Unauthenticated:
+		return true
+	default:
+		return false
+	}
+}
+
+func containsEncoding(encodings []gnmi.Encoding, encoding gnmi.Encoding) bool {
+	for _, e := range encodings {
+		if e == encoding {
+			return true
+		}
+	}
+	return false
+}
+
+// stripJSONModulePrefixes drops module prefixes (RFC 7951, section 4) from all member names, so that the value
+// can be decoded regardless of whether it is encoded in JSON or JSON_IETF.
+func stripJSONModulePrefixes(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		stripped := make(map[string]interface{}, len(v))
+		for key, member := range v {
+			stripped[stripModulePrefix(key)] = stripJSONModulePrefixes(member)
+		}
+		return stripped
+	case []interface{}:
+		for i := range v {
+			v[i] = stripJSONModulePrefixes(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// stripModulePrefix drops module prefix from the member name or identity, e.g., "openconfig-platform-types:CHASSIS".
+func stripModulePrefix(name string) string {
+	if idx := strings.LastIndex(name, ":"); idx >= 0 {
+		return name[idx+1:]
+	}
+	return name
+}
@@ -0,0 +1,131 @@
+// Package connectors_test implements unit tests for protocol connectors.
+package connectors_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+	simulatorv1 "github.com/eroshiva/trade-show-poc/pkg/mocks"
+	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	gnmiHost     = "localhost"
+	gnmiPort     = "50393"
+	gnmiUsername = "gnmi"
+	gnmiPassword = "gnmi-password"
+)
+
+func startGNMIServer(t *testing.T) {
+	t.Helper()
+	t.Setenv(simulatorv1.EnvGNMIServerAddress, connectors.CraftServerAddress(gnmiHost, gnmiPort))
+	srv := simulatorv1.NewGNMIServer()
+	srv.StartGNMIServer()
+	t.Cleanup(srv.StopGNMIServer)
+	time.Sleep(100 * time.Millisecond) // giving some time for the server to bootup
+}
+
+func gnmiEndpoint() *ent.Endpoint {
+	return &ent.Endpoint{
+		Host:     gnmiHost,
+		Port:     gnmiPort,
+		Protocol: endpoint.ProtocolPROTOCOL_GNMI,
+	}
+}
+
+func TestGNMIConnector(t *testing.T) {
+	setDeviceVersions(t)
+	startGNMIServer(t)
+
+	c, err := connectors.NewConnector(gnmiEndpoint())
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, status)
+
+	hwV, err := c.GetHWVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, testHWModel, hwV)
+
+	swV, err := c.GetSWVersion(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, swV)
+	assert.Equal(t, testSWVersion, swV.Version)
+	assert.Empty(t, swV.Checksum)
+
+	fwV, err := c.GetFWVersion(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, fwV)
+	assert.Equal(t, testFWVersion, fwV.Version)
+	assert.Empty(t, fwV.Checksum)
+	assertSnapshot(t, c)
+
+	// chassis is inactive, device should be reported as unhealthy
+	t.Setenv(simulatorv1.EnvDeviceStatus, simulatorv1.DeviceStatusUNHEALTHY)
+	status, err = c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, status)
+	assertSnapshotStatus(t, c, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY)
+
+	// device is unreachable, connector should report an error
+	t.Setenv(simulatorv1.EnvDeviceStatus, simulatorv1.DeviceStatusDOWN)
+	status, err = c.GetStatus(ctx)
+	require.Error(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	_, err = c.GetHWVersion(ctx)
+	require.Error(t, err)
+	assertSnapshotUnreachable(t, c)
+}
+
+func TestGNMIConnectorCredentials(t *testing.T) {
+	setDeviceVersions(t)
+	t.Setenv(simulatorv1.EnvGNMIUsername, gnmiUsername)
+	t.Setenv(simulatorv1.EnvGNMIPassword, gnmiPassword)
+	startGNMIServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	c := &connectors.GNMIConnector{
+		Endpoint: gnmiEndpoint(),
+		Username: gnmiUsername,
+		Password: gnmiPassword,
+	}
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, status)
+	fwV, err := c.GetFWVersion(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, fwV)
+	assert.Equal(t, testFWVersion, fwV.Version)
+
+	c.Password = "wrong-password"
+	status, err = c.GetStatus(ctx)
+	require.ErrorIs(t, err, connectors.ErrAuthFailed)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	_, err = c.GetSWVersion(ctx)
+	require.ErrorIs(t, err, connectors.ErrAuthFailed)
+}
+
+func TestGNMIConnectorServerNotRunning(t *testing.T) {
+	c := &connectors.GNMIConnector{
+		Endpoint: gnmiEndpoint(),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	status, err := c.GetStatus(ctx)
+	require.ErrorIs(t, err, connectors.ErrUnreachable)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	assertSnapshotUnreachable(t, c)
+}
@@ -0,0 +1,175 @@
+// Package connectors implements common interface for all devices and carries individual implementations of each protocol.
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/rs/zerolog"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+const (
+	componentNameICMP  = "icmp-connector"
+	defaultICMPTimeout = 2 * time.Second
+
+	// protocol numbers of ICMP and ICMPv6, they are needed to parse the reply.
+	icmpProtocol   = 1
+	icmpv6Protocol = 58
+	icmpPayload    = "trade-show-poc"
+)
+
+var zlogICMP = zerolog.New(zerolog.ConsoleWriter{
+	Out:        os.Stderr,
+	TimeFormat: time.RFC3339,
+	FormatCaller: func(i interface{}) string {
+		return filepath.Dir(fmt.Sprintf("%s/", i))
+	},
+}).Level(zerolog.TraceLevel).With().Caller().Timestamp().Str(component, componentNameICMP).Logger()
+
+// icmpSequence distinguishes echo requests sent by concurrent probes.
+var icmpSequence atomic.Uint32
+
+// ICMPConnector is a reachability probe for the devices, which don't offer any management protocol. It sends ICMP
+// echo request to the host of the endpoint and waits for the echo reply. It reports status only, port of
+// the endpoint is ignored.
+//
+// Echo is sent over unprivileged (datagram) ICMP socket, thus the service doesn't need CAP_NET_RAW, but its group
+// has to be allowed by "net.ipv4.ping_group_range" sysctl on Linux.
+type ICMPConnector struct {
+	Endpoint *ent.Endpoint
+	Timeout  time.Duration
+}
+
+func init() {
+	Register(string(endpoint.ProtocolPROTOCOL_ICMP), newICMPConnector)
+}
+
+// newICMPConnector is a factory of the ICMP connector. It doesn't accept any parameters.
+func newICMPConnector(ep *ent.Endpoint, opts Options) (Connector, error) {
+	return &ICMPConnector{
+		Endpoint: ep,
+		Timeout:  opts.Timeout,
+	}, nil
+}
+
+// GetStatus implements the Connector interface, namely GetStatus function, for ICMP echo probe.
+// Device answering the echo request is reported UP.
+func (c *ICMPConnector) GetStatus(ctx context.Context) (devicestatus.Status, error) {
+	zlogICMP.Info().Msgf("Checking status for %s via ICMP echo...\n", c.Endpoint.Host)
+	err := classifyError(c.ping(ctx))
+	if err != nil {
+		zlogICMP.Error().Err(err).Msgf("Failed to ping %s", c.Endpoint.Host)
+		// device did not answer, returning device status DOWN and an error.
+		return devicestatus.StatusSTATUS_DEVICE_DOWN, err
+	}
+	return devicestatus.StatusSTATUS_DEVICE_UP, nil
+}
+
+// GetHWVersion implements the Connector interface, namely GetHWVersion function, for ICMP echo probe.
+// ICMP echo probe does not report any version.
+func (c *ICMPConnector) GetHWVersion(_ context.Context) (string, error) {
+	return "", fmt.Errorf("HW version is %w by ICMP echo probe", ErrUnsupported)
+}
+
+// GetSWVersion implements the Connector interface, namely GetSWVersion function, for ICMP echo probe.
+// ICMP echo probe does not report any version.
+func (c *ICMPConnector) GetSWVersion(_ context.Context) (*ent.Version, error) {
+	return nil, fmt.Errorf("SW version is %w by ICMP echo probe", ErrUnsupported)
+}
+
+// GetFWVersion implements the Connector interface, namely GetFWVersion function, for ICMP echo probe.
+// ICMP echo probe does not report any version.
+func (c *ICMPConnector) GetFWVersion(_ context.Context) (*ent.Version, error) {
+	return nil, fmt.Errorf("FW version is %w by ICMP echo probe", ErrUnsupported)
+}
+
+// GetSnapshot implements the Connector interface, namely GetSnapshot function, for ICMP echo probe.
+// Snapshot carries status only, all versions are reported as not supported.
+func (c *ICMPConnector) GetSnapshot(ctx context.Context) (*DeviceSnapshot, error) {
+	return CollectSnapshot(ctx, c)
+}
+
+// ping sends a single ICMP echo request to the host of the endpoint and waits for the matching echo reply.
+func (c *ICMPConnector) ping(ctx context.Context) error {
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, c.Endpoint.Host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", c.Endpoint.Host, err)
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("failed to resolve %s: no address found", c.Endpoint.Host)
+	}
+	ip := addrs[0].IP
+
+	network, protocol := "udp4", icmpProtocol
+	var echoType, replyType icmp.Type = ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+	if ip.To4() == nil {
+		network, protocol = "udp6", icmpv6Protocol
+		echoType, replyType = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+	}
+	conn, err := icmp.ListenPacket(network, "")
+	if err != nil {
+		// unprivileged ICMP sockets are not allowed for the group of the service
+		return NewError(ErrorKindUnsupported, fmt.Errorf("failed to open ICMP socket: %w", err))
+	}
+	defer conn.Close()
+	err = conn.SetDeadline(operationDeadline(ctx, c.timeout()))
+	if err != nil {
+		return err
+	}
+	// cancelled probe (e.g., other endpoint has answered first) is not waiting for the reply
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	// kernel sets the identifier of the echo request to the port of the datagram socket
+	seq := int(icmpSequence.Add(1) & 0xffff)
+	request, err := (&icmp.Message{
+		Type: echoType,
+		Body: &icmp.Echo{Seq: seq, Data: []byte(icmpPayload)},
+	}).Marshal(nil)
+	if err != nil {
+		return err
+	}
+	_, err = conn.WriteTo(request, &net.UDPAddr{IP: ip, Zone: addrs[0].Zone})
+	if err != nil {
+		return fmt.Errorf("failed to send echo request to %s: %w", ip, err)
+	}
+
+	buf := make([]byte, 1500)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("no echo reply from %s: %w", ip, err)
+		}
+		reply, err := icmp.ParseMessage(protocol, buf[:n])
+		if err != nil || reply.Type != replyType {
+			// not an echo reply, waiting for the next message
+			continue
+		}
+		if echo, ok := reply.Body.(*icmp.Echo); ok && echo.Seq == seq {
+			return nil
+		}
+	}
+}
+
+func (c *ICMPConnector) timeout() time.Duration {
+	if c.Timeout == 0 {
+		return defaultICMPTimeout
+	}
+	return c.Timeout
+}
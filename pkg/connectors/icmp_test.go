@@ -0,0 +1,48 @@
+// Package connectors_test implements unit tests for protocol connectors.
+package connectors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/icmp"
+)
+
+func TestICMPConnector(t *testing.T) {
+	// unprivileged ICMP sockets have to be allowed for the group of the test
+	conn, err := icmp.ListenPacket("udp4", "")
+	if err != nil {
+		t.Skipf("Unprivileged ICMP sockets are not allowed: %v", err)
+	}
+	require.NoError(t, conn.Close())
+
+	// port is ignored
+	c, err := connectors.NewConnector(&ent.Endpoint{Host: "127.0.0.1", Port: "0", Protocol: endpoint.ProtocolPROTOCOL_ICMP})
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, status)
+	assertStatusOnlySnapshot(t, c)
+}
+
+func TestICMPConnectorHostNotResolved(t *testing.T) {
+	c := &connectors.ICMPConnector{
+		Endpoint: &ent.Endpoint{Host: "device.invalid", Protocol: endpoint.ProtocolPROTOCOL_ICMP},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	status, err := c.GetStatus(ctx)
+	require.ErrorIs(t, err, connectors.ErrUnreachable)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	assertSnapshotUnreachable(t, c)
+}
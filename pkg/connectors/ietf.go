@@ -0,0 +1,112 @@
+// Package connectors implements common interface for all devices and carries individual implementations of each protocol.
+package connectors
+
+import (
+	"fmt"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+)
+
+const (
+	// ietfSystemNamespace is an XML namespace of the ietf-system YANG module (RFC 7317).
+	ietfSystemNamespace = "urn:ietf:params:xml:ns:yang:ietf-system"
+	// ietfHardwareNamespace is an XML namespace of the ietf-hardware YANG module (RFC 8348).
+	ietfHardwareNamespace = "urn:ietf:params:xml:ns:yang:ietf-hardware"
+
+	hardwareClassChassis  = "chassis"
+	hardwareOperStateUp   = "enabled"
+	hardwareOperStateNone = ""
+)
+
+// ietfSystemState carries subset of the /ietf-system:system-state container, which is relevant for monitoring.
+// It can be decoded from both XML and JSON (RFC 7951) encoding.
+type ietfSystemState struct {
+	Platform struct {
+		OSName    string `xml:"os-name" json:"os-name"`
+		OSRelease string `xml:"os-release" json:"os-release"`
+		OSVersion string `xml:"os-version" json:"os-version"`
+		Machine   string `xml:"machine" json:"machine"`
+	} `xml:"platform" json:"platform"`
+	Clock struct {
+		CurrentDatetime string `xml:"current-datetime" json:"current-datetime"`
+		BootDatetime    string `xml:"boot-datetime" json:"boot-datetime"`
+	} `xml:"clock" json:"clock"`
+}
+
+// ietfHardware carries subset of the /ietf-hardware:hardware container, which is relevant for monitoring.
+type ietfHardware struct {
+	Components []ietfHardwareComponent `xml:"component" json:"component"`
+}
+
+// ietfHardwareComponent carries subset of the /ietf-hardware:hardware/component list entry.
+type ietfHardwareComponent struct {
+	Name        string `xml:"name" json:"name"`
+	Class       string `xml:"class" json:"class"`
+	HardwareRev string `xml:"hardware-rev" json:"hardware-rev"`
+	FirmwareRev string `xml:"firmware-rev" json:"firmware-rev"`
+	SoftwareRev string `xml:"software-rev" json:"software-rev"`
+	ModelName   string `xml:"model-name" json:"model-name"`
+	State       struct {
+		OperState string `xml:"oper-state" json:"oper-state"`
+	} `xml:"state" json:"state"`
+}
+
+// chassis returns the chassis component, which describes the device as a whole. If there is no chassis
+// component, first component is returned.
+func (h *ietfHardware) chassis() *ietfHardwareComponent {
+	if h == nil || len(h.Components) == 0 {
+		return nil
+	}
+	for i := range h.Components {
+		// identity may be prefixed with a module name (or XML prefix), e.g., "iana-hardware:chassis"
+		if stripModulePrefix(h.Components[i].Class) == hardwareClassChassis {
+			return &h.Components[i]
+		}
+	}
+	return &h.Components[0]
+}
+
+// hardwareRevision returns hardware revision of the component. Model name is used, when hardware revision is not reported.
+func (c *ietfHardwareComponent) hardwareRevision() string {
+	if c.HardwareRev != "" {
+		return c.HardwareRev
+	}
+	return c.ModelName
+}
+
+// convertOperStateToStatus converts operational state of the hardware component (RFC 8348) to the device status.
+// Device, which does not report operational state, is considered to be UP, since it has answered the request.
+func convertOperStateToStatus(operState string) devicestatus.Status {
+	switch operState {
+	case hardwareOperStateUp, hardwareOperStateNone:
+		return devicestatus.StatusSTATUS_DEVICE_UP
+	default:
+		// "disabled", "testing" or "unknown"
+		return devicestatus.StatusSTATUS_DEVICE_UNHEALTHY
+	}
+}
+
+// ietfSnapshot fills versions of the snapshot from the chassis component. OS version from ietf-system is used,
+// when chassis does not report software revision.
+func ietfSnapshot(snapshot *DeviceSnapshot, hardware *ietfHardware, systemState *ietfSystemState) *DeviceSnapshot {
+	chassis := hardware.chassis()
+	if chassis == nil {
+		chassis = &ietfHardwareComponent{}
+	}
+	snapshot.HWVersion = chassis.hardwareRevision()
+	if snapshot.HWVersion == "" {
+		snapshot.Errors[SnapshotPartHWVersion] = fmt.Errorf("device does not report hardware revision")
+	}
+	snapshot.SWVersion.Version = chassis.SoftwareRev
+	if snapshot.SWVersion.Version == "" && systemState != nil {
+		snapshot.SWVersion.Version = systemState.Platform.OSVersion
+	}
+	if snapshot.SWVersion.Version == "" {
+		snapshot.Errors[SnapshotPartSWVersion] = fmt.Errorf("device does not report software revision")
+	}
+	snapshot.FWVersion.Version = chassis.FirmwareRev
+	if snapshot.FWVersion.Version == "" {
+		snapshot.Errors[SnapshotPartFWVersion] = fmt.Errorf("device does not report firmware revision")
+	}
+	return snapshot
+}
@@ -2,20 +2,49 @@
 package connectors
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
+	"net"
 	"os"
+	"path"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/eroshiva/trade-show-poc/internal/ent"
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
-	"github.com/eroshiva/trade-show-poc/internal/server"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
 	"github.com/rs/zerolog"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
 )
 
-const componentNameNETCONF = "netconf-connector"
+const (
+	componentNameNETCONF = "netconf-connector"
+
+	defaultNETCONFTimeout = 5 * time.Second
+	// maxNETCONFMessageSize limits size of a single message received from the device, so that a misbehaving device
+	// can't exhaust the memory.
+	maxNETCONFMessageSize = 16 << 20
+
+	netconfSubsystem = "netconf"
+	netconfBase10    = "urn:ietf:params:netconf:base:1.0"
+	netconfBase11    = "urn:ietf:params:netconf:base:1.1"
+	netconfNamespace = "urn:ietf:params:xml:ns:netconf:base:1.0"
+	// end-of-message delimiter used by NETCONF 1.0 framing (RFC 6242, section 4.3).
+	netconfEOM = "]]>]]>"
+
+	// subtree filters (RFC 6241, section 6) for the data relevant for monitoring.
+	netconfSystemStateFilter = `<system-state xmlns="` + ietfSystemNamespace + `"><platform/><clock/></system-state>`
+	netconfHardwareFilter    = `<hardware xmlns="` + ietfHardwareNamespace + `"><component><name/><class/>` +
+		`<hardware-rev/><firmware-rev/><software-rev/><model-name/><state><oper-state/></state></component></hardware>`
+)
 
 var zlogNETCONF = zerolog.New(zerolog.ConsoleWriter{
 	Out:        os.Stderr,
@@ -25,119 +54,669 @@ var zlogNETCONF = zerolog.New(zerolog.ConsoleWriter{
 	},
 }).Level(zerolog.TraceLevel).With().Caller().Timestamp().Str(component, componentNameNETCONF).Logger()
 
-// NETCONFConnector handles status checks for NETCONF devices.
+// NETCONFConnector handles status checks for NETCONF devices. It speaks NETCONF over SSH (RFC 6242).
 type NETCONFConnector struct {
 	Endpoint *ent.Endpoint
+	Username string
+	Password string
+	// PrivateKey is a PEM encoded private key used for SSH public key authentication.
+	PrivateKey []byte
+	// KnownHosts carries trusted host keys in known_hosts format, SSH server host key is verified against them.
+	KnownHosts []byte
+	// InsecureIgnoreHostKey disables verification of SSH server host key, when no known hosts are set. Meant only
+	// for labs.
+	InsecureIgnoreHostKey bool
+	// HostKeyCallback verifies SSH server host key. It takes precedence over KnownHosts.
+	HostKeyCallback ssh.HostKeyCallback
+	Timeout         time.Duration
+}
+
+// sshHostKeyError is returned, when SSH server host key is not trusted.
+type sshHostKeyError struct {
+	err error
+}
+
+func (e *sshHostKeyError) Error() string {
+	return e.err.Error()
+}
+
+func (e *sshHostKeyError) errorKind() ErrorKind {
+	return ErrorKindUntrusted
+}
+
+// sshAuthError is returned, when SSH server has rejected all offered authentication methods.
+type sshAuthError struct {
+	err error
+}
+
+func (e *sshAuthError) Error() string {
+	return e.err.Error()
+}
+
+func (e *sshAuthError) Unwrap() error {
+	return e.err
+}
+
+func (e *sshAuthError) errorKind() ErrorKind {
+	return ErrorKindAuthFailed
+}
+
+// netconfSession is a NETCONF session established over SSH subsystem. It is kept in the connection pool between
+// the calls.
+type netconfSession struct {
+	conn         net.Conn
+	client       *ssh.Client
+	session      *ssh.Session
+	stdin        io.WriteCloser
+	stdout       *bufio.Reader
+	chunked      bool
+	messageID    int
+	capabilities []string
+	// broken is set, when the session has failed to send or receive a message, and can't be used anymore.
+	broken bool
+}
+
+// netconfHello is a NETCONF <hello> message (RFC 6241, section 8.1).
+type netconfHello struct {
+	XMLName      xml.Name `xml:"urn:ietf:params:xml:ns:netconf:base:1.0 hello"`
+	Capabilities []string `xml:"capabilities>capability"`
+	SessionID    string   `xml:"session-id,omitempty"`
+}
+
+// netconfRPCReply is a NETCONF <rpc-reply> message carrying a <get> response.
+type netconfRPCReply struct {
+	XMLName   xml.Name          `xml:"rpc-reply"`
+	MessageID string            `xml:"message-id,attr"`
+	Errors    []netconfRPCError `xml:"rpc-error"`
+	Data      struct {
+		SystemState *ietfSystemState `xml:"urn:ietf:params:xml:ns:yang:ietf-system system-state"`
+		Hardware    *ietfHardware    `xml:"urn:ietf:params:xml:ns:yang:ietf-hardware hardware"`
+	} `xml:"data"`
+}
+
+// netconfRPCError is a NETCONF <rpc-error> (RFC 6241, section 4.3).
+type netconfRPCError struct {
+	Type     string `xml:"error-type"`
+	Tag      string `xml:"error-tag"`
+	Severity string `xml:"error-severity"`
+	Message  string `xml:"error-message"`
+}
+
+func (e netconfRPCError) Error() string {
+	return fmt.Sprintf("%s %s error (%s): %s", e.Type, e.Severity, e.Tag, e.Message)
+}
+
+func (e netconfRPCError) errorKind() ErrorKind {
+	switch e.Tag {
+	case "access-denied":
+		return ErrorKindAuthFailed
+	case "operation-not-supported":
+		return ErrorKindUnsupported
+	default:
+		return ErrorKindProtocolError
+	}
+}
+
+func init() {
+	Register(string(endpoint.ProtocolPROTOCOL_NETCONF), newNETCONFConnector)
+}
+
+// newNETCONFConnector is a factory of the NETCONF connector. It takes user name, password, private key and trusted
+// host keys from the credentials.
+func newNETCONFConnector(ep *ent.Endpoint, opts Options) (Connector, error) {
+	c := &NETCONFConnector{
+		Endpoint: ep,
+		Timeout:  opts.Timeout,
+	}
+	if cp := opts.Credentials; cp != nil {
+		c.Username = cp.Username
+		c.Password = cp.Password
+		if cp.PrivateKey != "" {
+			c.PrivateKey = []byte(cp.PrivateKey)
+		}
+		if cp.SSHKnownHosts != "" {
+			c.KnownHosts = []byte(cp.SSHKnownHosts)
+		}
+		c.InsecureIgnoreHostKey = cp.SSHInsecureIgnoreHostKey
+	}
+	return c, nil
 }
 
 // GetStatus implements the Connector interface, namely GetStatus function, for NETCONF protocol.
+// Status is derived from the operational state of the chassis reported by ietf-hardware.
 func (c *NETCONFConnector) GetStatus(ctx context.Context) (devicestatus.Status, error) {
 	zlogNETCONF.Info().Msgf("Checking status for %s:%s via NETCONF...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, NETCONF connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
-	if err != nil {
-		zlogNETCONF.Error().Err(err).Msgf("Error establishing NETCONF connection, reporting that device is down")
-		// failed to instantiate connection, reporting device status DOWN.
-		return devicestatus.StatusSTATUS_DEVICE_DOWN, nil
-	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetStatus(ctx, &emptypb.Empty{})
+	reply, err := c.get(ctx, netconfSystemStateFilter+netconfHardwareFilter)
 	if err != nil {
+		var rpcErr netconfRPCError
+		if errors.As(err, &rpcErr) {
+			// device has answered, but was not able to process the request
+			zlogNETCONF.Warn().Err(err).Msgf("Device %s:%s is not healthy", c.Endpoint.Host, c.Endpoint.Port)
+			return devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, nil
+		}
 		zlogNETCONF.Error().Err(err).Msgf("Failed to retrieve device status for %s:%s via NETCONF", c.Endpoint.Host, c.Endpoint.Port)
 		// failed to retrieve device status, returning device status DOWN and an error.
 		return devicestatus.StatusSTATUS_DEVICE_DOWN, err
 	}
-	// device status was successfully retrieved, converting it to correct notation
-	status := resp.GetStatus()
-	entStatus := server.ConvertProtoStatusToEntStatus(status)
-	// gracefully closing connection
-	err = conn.Close()
-	if err != nil {
-		zlogNETCONF.Error().Err(err).Msgf("Failed to gracefully close connection")
+	if ss := reply.Data.SystemState; ss != nil {
+		zlogNETCONF.Debug().Msgf("Device %s:%s runs %s %s, booted at %s", c.Endpoint.Host, c.Endpoint.Port,
+			ss.Platform.OSName, ss.Platform.OSRelease, ss.Clock.BootDatetime)
+	}
+	chassis := reply.Data.Hardware.chassis()
+	if chassis == nil {
+		// device does not implement ietf-hardware, but it has answered
+		return devicestatus.StatusSTATUS_DEVICE_UP, nil
 	}
-	return entStatus, nil
+	return convertOperStateToStatus(chassis.State.OperState), nil
 }
 
 // GetHWVersion implements the Connector interface, namely GetHWVersion function, for NETCONF protocol.
 func (c *NETCONFConnector) GetHWVersion(ctx context.Context) (string, error) {
 	zlogNETCONF.Info().Msgf("Checking HW version for %s:%s via NETCONF...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, NETCONF connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
+	chassis, err := c.getChassis(ctx)
 	if err != nil {
-		zlogNETCONF.Error().Err(err).Msgf("Error establishing NETCONF connection")
-		// failed to instantiate connection, returning error
+		zlogNETCONF.Error().Err(err).Msgf("Failed to retrieve HW version for %s:%s via NETCONF", c.Endpoint.Host, c.Endpoint.Port)
 		return "", err
 	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetHWVersion(ctx, &emptypb.Empty{})
-	if err != nil {
+	hwV := chassis.hardwareRevision()
+	if hwV == "" {
+		err = fmt.Errorf("device does not report hardware revision")
 		zlogNETCONF.Error().Err(err).Msgf("Failed to retrieve HW version for %s:%s via NETCONF", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve HW version, returning error
 		return "", err
 	}
-	// HW version was successfully retrieved, returning it
-	// and gracefully closing connection
-	err = conn.Close()
-	if err != nil {
-		zlogNETCONF.Error().Err(err).Msgf("Failed to gracefully close connection")
-	}
-	return resp.GetVersion(), nil
+	return hwV, nil
 }
 
 // GetSWVersion implements the Connector interface, namely GetSWVersion function, for NETCONF protocol.
+// Software revision of the chassis is used, OS version from ietf-system is used as a fallback.
 func (c *NETCONFConnector) GetSWVersion(ctx context.Context) (*ent.Version, error) {
 	zlogNETCONF.Info().Msgf("Checking SW version for %s:%s via NETCONF...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, NETCONF connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
+	reply, err := c.get(ctx, netconfSystemStateFilter+netconfHardwareFilter)
 	if err != nil {
-		zlogNETCONF.Error().Err(err).Msgf("Error establishing NETCONF connection")
-		// failed to instantiate connection, returning error
+		zlogNETCONF.Error().Err(err).Msgf("Failed to retrieve SW version for %s:%s via NETCONF", c.Endpoint.Host, c.Endpoint.Port)
 		return nil, err
 	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetSWVersion(ctx, &emptypb.Empty{})
-	if err != nil {
+	swV := ""
+	if chassis := reply.Data.Hardware.chassis(); chassis != nil {
+		swV = chassis.SoftwareRev
+	}
+	if swV == "" && reply.Data.SystemState != nil {
+		swV = reply.Data.SystemState.Platform.OSVersion
+	}
+	if swV == "" {
+		err = fmt.Errorf("device does not report software revision")
 		zlogNETCONF.Error().Err(err).Msgf("Failed to retrieve SW version for %s:%s via NETCONF", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve SW version, returning error
 		return nil, err
 	}
-	// SW version was successfully retrieved, returning it
-	// and gracefully closing connection
-	err = conn.Close()
-	if err != nil {
-		zlogNETCONF.Error().Err(err).Msgf("Failed to gracefully close connection")
-	}
 	return &ent.Version{
-		Version:  resp.GetVersion(),
-		Checksum: resp.GetChecksum(),
+		Version: swV,
 	}, nil
 }
 
 // GetFWVersion implements the Connector interface, namely GetFWVersion function, for NETCONF protocol.
 func (c *NETCONFConnector) GetFWVersion(ctx context.Context) (*ent.Version, error) {
 	zlogNETCONF.Info().Msgf("Checking FW version for %s:%s via NETCONF...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, NETCONF connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
+	chassis, err := c.getChassis(ctx)
 	if err != nil {
-		zlogNETCONF.Error().Err(err).Msgf("Error establishing NETCONF connection")
-		// failed to instantiate connection, returning error
+		zlogNETCONF.Error().Err(err).Msgf("Failed to retrieve FW version for %s:%s via NETCONF", c.Endpoint.Host, c.Endpoint.Port)
 		return nil, err
 	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetFWVersion(ctx, &emptypb.Empty{})
-	if err != nil {
+	if chassis.FirmwareRev == "" {
+		err = fmt.Errorf("device does not report firmware revision")
 		zlogNETCONF.Error().Err(err).Msgf("Failed to retrieve FW version for %s:%s via NETCONF", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve FW version, returning error
 		return nil, err
 	}
-	// FW version was successfully retrieved, returning it
-	// and gracefully closing connection
-	err = conn.Close()
+	return &ent.Version{
+		Version: chassis.FirmwareRev,
+	}, nil
+}
+
+// SuppliesChecksum implements the ChecksumSupplier interface.
+// ietf-hardware does not model checksum of the revisions, it is generated by the manager.
+func (c *NETCONFConnector) SuppliesChecksum() bool {
+	return false
+}
+
+// GetSnapshot implements the Connector interface, namely GetSnapshot function, for NETCONF protocol.
+// Status and all versions are retrieved with a single <get> operation.
+func (c *NETCONFConnector) GetSnapshot(ctx context.Context) (*DeviceSnapshot, error) {
+	zlogNETCONF.Info().Msgf("Retrieving snapshot for %s:%s via NETCONF...\n", c.Endpoint.Host, c.Endpoint.Port)
+	reply, err := c.get(ctx, netconfSystemStateFilter+netconfHardwareFilter)
 	if err != nil {
-		zlogNETCONF.Error().Err(err).Msgf("Failed to gracefully close connection")
+		var rpcErr netconfRPCError
+		if errors.As(err, &rpcErr) {
+			// device has answered, but was not able to process the request
+			zlogNETCONF.Warn().Err(err).Msgf("Device %s:%s is not healthy", c.Endpoint.Host, c.Endpoint.Port)
+			snapshot := newDeviceSnapshot(devicestatus.StatusSTATUS_DEVICE_UNHEALTHY)
+			snapshot.failVersions(err)
+			return snapshot, nil
+		}
+		zlogNETCONF.Error().Err(err).Msgf("Failed to retrieve snapshot for %s:%s via NETCONF", c.Endpoint.Host, c.Endpoint.Port)
+		// failed to retrieve device status, returning an error.
+		return nil, err
 	}
-	return &ent.Version{
-		Version:  resp.GetVersion(),
-		Checksum: resp.GetChecksum(),
+	status := devicestatus.StatusSTATUS_DEVICE_UP
+	if chassis := reply.Data.Hardware.chassis(); chassis != nil {
+		status = convertOperStateToStatus(chassis.State.OperState)
+	}
+	return ietfSnapshot(newDeviceSnapshot(status), reply.Data.Hardware, reply.Data.SystemState), nil
+}
+
+// getChassis retrieves chassis component from ietf-hardware.
+func (c *NETCONFConnector) getChassis(ctx context.Context) (*ietfHardwareComponent, error) {
+	reply, err := c.get(ctx, netconfHardwareFilter)
+	if err != nil {
+		return nil, err
+	}
+	chassis := reply.Data.Hardware.chassis()
+	if chassis == nil {
+		return nil, fmt.Errorf("device does not report any hardware component")
+	}
+	return chassis, nil
+}
+
+// get performs <get> operation with provided subtree filter over pooled NETCONF session. Whole exchange has to fit
+// in the timeout.
+func (c *NETCONFConnector) get(ctx context.Context, filter string) (*netconfRPCReply, error) {
+	var reply *netconfRPCReply
+	key := poolKey(endpoint.ProtocolPROTOCOL_NETCONF, CraftServerAddressFromEndpoint(c.Endpoint),
+		c.Username, c.Password, string(c.PrivateKey), string(c.KnownHosts), strconv.FormatBool(c.InsecureIgnoreHostKey))
+	err := DefaultPool().Do(ctx, key, func(ctx context.Context) (PooledConn, error) {
+		return c.dial(ctx)
+	}, func(conn PooledConn) error {
+		s := conn.(*netconfSession)
+		err := s.setDeadline(operationDeadline(ctx, c.timeout()))
+		if err != nil {
+			return err
+		}
+		defer func() {
+			// idle session must not expire, SSH connection is read in the background
+			_ = s.setDeadline(time.Time{})
+		}()
+		reply, err = s.get(filter)
+		return err
+	})
+	return reply, classifyError(err)
+}
+
+// dial establishes SSH connection, starts NETCONF subsystem and performs capabilities exchange.
+func (c *NETCONFConnector) dial(ctx context.Context) (*netconfSession, error) {
+	config, err := c.sshClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	serverAddress := CraftServerAddressFromEndpoint(c.Endpoint)
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", serverAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s: %w", serverAddress, err)
+	}
+	// whole handshake has to fit in the deadline
+	err = conn.SetDeadline(operationDeadline(ctx, config.Timeout))
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	// authentication starts, once the host key is verified
+	hostKeyVerified := false
+	verifyHostKey := config.HostKeyCallback
+	config.HostKeyCallback = func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		err := verifyHostKey(hostname, remote, key)
+		hostKeyVerified = err == nil
+		return err
+	}
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, serverAddress, config)
+	if err != nil {
+		_ = conn.Close()
+		// SSH library doesn't export an error for rejected credentials, any failure of the authentication, which
+		// is not a failure of the transport, means that the server has rejected them
+		var netErr net.Error
+		if hostKeyVerified && !errors.Is(err, io.EOF) && !errors.As(err, &netErr) {
+			err = &sshAuthError{err: err}
+		}
+		return nil, fmt.Errorf("failed to establish SSH connection with %s: %w", serverAddress, err)
+	}
+	s := &netconfSession{conn: conn, client: ssh.NewClient(sshConn, chans, reqs)}
+	err = s.start()
+	if err == nil {
+		err = s.setDeadline(time.Time{})
+	}
+	if err != nil {
+		_ = s.client.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *NETCONFConnector) timeout() time.Duration {
+	if c.Timeout == 0 {
+		return defaultNETCONFTimeout
+	}
+	return c.Timeout
+}
+
+// sshClientConfig prepares SSH client configuration. There are no default credentials, server, which requires
+// authentication, rejects the connector, which has none.
+func (c *NETCONFConnector) sshClientConfig() (*ssh.ClientConfig, error) {
+	var auth []ssh.AuthMethod
+	if len(c.PrivateKey) > 0 {
+		signer, err := ssh.ParsePrivateKey(c.PrivateKey)
+		if err != nil {
+			return nil, NewError(ErrorKindAuthFailed, fmt.Errorf("failed to parse SSH private key: %w", err))
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if c.Password != "" {
+		auth = append(auth, ssh.Password(c.Password))
+	}
+	hostKeyCallback, err := c.hostKeyCallback()
+	if err != nil {
+		return nil, err
+	}
+	return &ssh.ClientConfig{
+		User:            c.Username,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         c.timeout(),
+	}, nil
+}
+
+// hostKeyCallback returns the verifier of SSH server host key. Host key is trusted, only when it is listed in known
+// hosts, unless the verification is disabled explicitly.
+func (c *NETCONFConnector) hostKeyCallback() (ssh.HostKeyCallback, error) {
+	switch {
+	case c.HostKeyCallback != nil:
+		return c.HostKeyCallback, nil
+	case len(c.KnownHosts) > 0:
+		return knownHostsCallback(c.KnownHosts)
+	case c.InsecureIgnoreHostKey:
+		return ssh.InsecureIgnoreHostKey(), nil //nolint:gosec // verification is disabled explicitly
+	default:
+		return func(hostname string, _ net.Addr, _ ssh.PublicKey) error {
+			return &sshHostKeyError{err: fmt.Errorf("host key of %s can't be verified, no known hosts are set", hostname)}
+		}, nil
+	}
+}
+
+// knownHost is a single entry of known hosts.
+type knownHost struct {
+	patterns []string
+	key      ssh.PublicKey
+	revoked  bool
+}
+
+// matches checks if the host (normalized, i.e., "host" or "[host]:port") matches any of the patterns of the entry.
+// Patterns may contain '*' and '?' wildcards, brackets are literal. Hashed host names are not supported.
+func (kh knownHost) matches(host string) bool {
+	escaper := strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`)
+	for _, pattern := range kh.patterns {
+		if matched, err := path.Match(escaper.Replace(pattern), host); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// knownHostsCallback parses known hosts and returns the verifier of SSH server host key against them. Revoked keys
+// (@revoked marker) are rejected, certificate authorities (@cert-authority marker) are not supported and skipped.
+func knownHostsCallback(in []byte) (ssh.HostKeyCallback, error) {
+	var hosts []knownHost
+	for {
+		marker, patterns, key, _, rest, err := ssh.ParseKnownHosts(in)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, NewError(ErrorKindUntrusted, fmt.Errorf("failed to parse SSH known hosts: %w", err))
+		}
+		in = rest
+		if marker == "cert-authority" {
+			continue
+		}
+		hosts = append(hosts, knownHost{patterns: patterns, key: key, revoked: marker == "revoked"})
+	}
+
+	return func(hostname string, _ net.Addr, key ssh.PublicKey) error {
+		host := knownhosts.Normalize(hostname)
+		known := false
+		trusted := false
+		for _, kh := range hosts {
+			sameKey := bytes.Equal(kh.key.Marshal(), key.Marshal())
+			if kh.revoked && sameKey {
+				return &sshHostKeyError{err: fmt.Errorf("host key of %s is revoked", hostname)}
+			}
+			if kh.revoked || !kh.matches(host) {
+				continue
+			}
+			known = true
+			trusted = trusted || sameKey
+		}
+		switch {
+		case trusted:
+			return nil
+		case known:
+			return &sshHostKeyError{err: fmt.Errorf("host key of %s does not match known hosts", hostname)}
+		default:
+			return &sshHostKeyError{err: fmt.Errorf("host %s is not listed in known hosts", hostname)}
+		}
 	}, nil
 }
+
+// start opens NETCONF subsystem and exchanges <hello> messages.
+func (s *netconfSession) start() error {
+	var err error
+	s.session, err = s.client.NewSession()
+	if err != nil {
+		return fmt.Errorf("failed to open SSH session: %w", err)
+	}
+	s.stdin, err = s.session.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := s.session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	s.stdout = bufio.NewReader(stdout)
+	err = s.session.RequestSubsystem(netconfSubsystem)
+	if err != nil {
+		return fmt.Errorf("failed to start NETCONF subsystem: %w", err)
+	}
+
+	// both peers send their <hello> at the same time, it is always framed with end-of-message delimiter
+	hello, err := xml.Marshal(&netconfHello{Capabilities: []string{netconfBase10, netconfBase11}})
+	if err != nil {
+		return err
+	}
+	err = s.writeMessage(hello)
+	if err != nil {
+		return fmt.Errorf("failed to send <hello>: %w", err)
+	}
+	msg, err := s.readMessage()
+	if err != nil {
+		return fmt.Errorf("failed to receive <hello>: %w", err)
+	}
+	serverHello := &netconfHello{}
+	err = xml.Unmarshal(msg, serverHello)
+	if err != nil {
+		return fmt.Errorf("failed to parse <hello>: %w", err)
+	}
+	s.capabilities = serverHello.Capabilities
+	for _, capability := range s.capabilities {
+		if capability == netconfBase11 {
+			// both peers support base:1.1, switching to chunked framing
+			s.chunked = true
+		}
+	}
+	zlogNETCONF.Debug().Msgf("NETCONF session %s established, server capabilities: %v", serverHello.SessionID, s.capabilities)
+	return nil
+}
+
+// get performs <get> operation with provided subtree filter.
+func (s *netconfSession) get(filter string) (*netconfRPCReply, error) {
+	s.messageID++
+	messageID := strconv.Itoa(s.messageID)
+	rpc := `<rpc message-id="` + messageID + `" xmlns="` + netconfNamespace + `"><get><filter type="subtree">` +
+		filter + `</filter></get></rpc>`
+	err := s.writeMessage([]byte(rpc))
+	if err != nil {
+		return nil, fmt.Errorf("failed to send <rpc>: %w", err)
+	}
+	msg, err := s.readMessage()
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive <rpc-reply>: %w", err)
+	}
+	reply := &netconfRPCReply{}
+	err = xml.Unmarshal(msg, reply)
+	if err != nil {
+		s.broken = true
+		return nil, fmt.Errorf("failed to parse <rpc-reply>: %w", err)
+	}
+	if reply.MessageID != messageID {
+		s.broken = true
+		return nil, fmt.Errorf("unexpected <rpc-reply> message-id %q, expected %q", reply.MessageID, messageID)
+	}
+	for _, rpcErr := range reply.Errors {
+		if rpcErr.Severity == "error" {
+			return nil, rpcErr
+		}
+	}
+	return reply, nil
+}
+
+// setDeadline sets deadline of the exchange with NETCONF server. Zero value means no deadline.
+func (s *netconfSession) setDeadline(deadline time.Time) error {
+	if err := s.conn.SetDeadline(deadline); err != nil {
+		s.broken = true
+		return err
+	}
+	return nil
+}
+
+// Ping implements the PooledConn interface. Session is alive, when SSH connection answers keepalive request.
+// Server may reject the request, which still proves that the connection is alive.
+func (s *netconfSession) Ping(ctx context.Context) error {
+	if s.broken {
+		return fmt.Errorf("NETCONF session is broken")
+	}
+	err := s.setDeadline(operationDeadline(ctx, poolPingTimeout))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = s.setDeadline(time.Time{})
+	}()
+	_, _, err = s.client.SendRequest("keepalive@openssh.com", true, nil)
+	return err
+}
+
+// Close implements the PooledConn interface, it gracefully closes NETCONF session and underlying SSH connection.
+func (s *netconfSession) Close() error {
+	if !s.broken && s.setDeadline(time.Now().Add(poolPingTimeout)) == nil {
+		s.messageID++
+		rpc := `<rpc message-id="` + strconv.Itoa(s.messageID) + `" xmlns="` + netconfNamespace + `"><close-session/></rpc>`
+		if err := s.writeMessage([]byte(rpc)); err == nil {
+			// reply content is not interesting, server closes the session anyway
+			_, _ = s.readMessage()
+		}
+	}
+	return s.client.Close()
+}
+
+// writeMessage writes single NETCONF message with negotiated framing.
+func (s *netconfSession) writeMessage(msg []byte) error {
+	var buf bytes.Buffer
+	if s.chunked {
+		// whole message fits in a single chunk
+		fmt.Fprintf(&buf, "\n#%d\n", len(msg))
+		buf.Write(msg)
+		buf.WriteString("\n##\n")
+	} else {
+		buf.Write(msg)
+		buf.WriteString(netconfEOM)
+	}
+	_, err := s.stdin.Write(buf.Bytes())
+	if err != nil {
+		s.broken = true
+	}
+	return err
+}
+
+// readMessage reads single NETCONF message with negotiated framing.
+func (s *netconfSession) readMessage() ([]byte, error) {
+	var msg []byte
+	var err error
+	if s.chunked {
+		msg, err = readNETCONFChunkedMessage(s.stdout)
+	} else {
+		msg, err = readNETCONFEOMMessage(s.stdout)
+	}
+	if err != nil {
+		s.broken = true
+	}
+	return msg, err
+}
+
+// readNETCONFEOMMessage reads message delimited with end-of-message delimiter.
+func readNETCONFEOMMessage(r *bufio.Reader) ([]byte, error) {
+	var msg []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if len(msg) >= maxNETCONFMessageSize+len(netconfEOM) {
+			return nil, fmt.Errorf("message exceeds %d octets", maxNETCONFMessageSize)
+		}
+		msg = append(msg, b)
+		if bytes.HasSuffix(msg, []byte(netconfEOM)) {
+			return bytes.TrimSpace(msg[:len(msg)-len(netconfEOM)]), nil
+		}
+	}
+}
+
+// readNETCONFChunkedMessage reads message encoded with chunked framing. Chunks are read only up to the maximum size
+// of the message, whatever size the device announces.
+func readNETCONFChunkedMessage(r *bufio.Reader) ([]byte, error) {
+	var msg []byte
+	for {
+		// each chunk starts with LF HASH chunk-size LF, message ends with LF HASH HASH LF.
+		// header is read from the buffer, so that the line can't grow without limit
+		line, err := r.ReadSlice('\n')
+		if err != nil {
+			return nil, err
+		}
+		header := string(line)
+		if strings.TrimSpace(header) == "" {
+			// leading LF of the chunk header
+			line, err = r.ReadSlice('\n')
+			if err != nil {
+				return nil, err
+			}
+			header = string(line)
+		}
+		header = strings.TrimSuffix(header, "\n")
+		if header == "##" {
+			return msg, nil
+		}
+		if !strings.HasPrefix(header, "#") {
+			return nil, fmt.Errorf("invalid chunk header %q", header)
+		}
+		// chunk size is limited to 4294967295 octets (RFC 6242, section 4.2)
+		size, err := strconv.ParseUint(header[1:], 10, 32)
+		if err != nil || size == 0 {
+			return nil, fmt.Errorf("invalid chunk size %q", header[1:])
+		}
+		if size > uint64(maxNETCONFMessageSize-len(msg)) {
+			return nil, fmt.Errorf("message exceeds %d octets", maxNETCONFMessageSize)
+		}
+		chunk := make([]byte, size)
+		_, err = io.ReadFull(r, chunk)
+		if err != nil {
+			return nil, err
+		}
+		msg = append(msg, chunk...)
+	}
+}
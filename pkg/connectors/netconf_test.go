@@ -0,0 +1,202 @@
+// Package connectors_test implements unit tests for protocol connectors.
+package connectors_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+	simulatorv1 "github.com/eroshiva/trade-show-poc/pkg/mocks"
+	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	netconfHost     = "localhost"
+	netconfPort     = "50371"
+	netconfUsername = "netconf"
+	netconfPassword = "netconf-password"
+)
+
+func startNETCONFServer(t *testing.T) {
+	t.Helper()
+	t.Setenv(simulatorv1.EnvNETCONFServerAddress, connectors.CraftServerAddress(netconfHost, netconfPort))
+	t.Setenv(simulatorv1.EnvNETCONFUsername, netconfUsername)
+	t.Setenv(simulatorv1.EnvNETCONFPassword, netconfPassword)
+	srv := simulatorv1.NewNETCONFServer()
+	srv.StartNETCONFServer()
+	t.Cleanup(srv.StopNETCONFServer)
+	time.Sleep(100 * time.Millisecond) // giving some time for the server to bootup
+}
+
+func netconfEndpoint() *ent.Endpoint {
+	return &ent.Endpoint{
+		Host:     netconfHost,
+		Port:     netconfPort,
+		Protocol: endpoint.ProtocolPROTOCOL_NETCONF,
+	}
+}
+
+func TestNETCONFConnector(t *testing.T) {
+	setDeviceVersions(t)
+	startNETCONFServer(t)
+
+	c := &connectors.NETCONFConnector{
+		Endpoint: netconfEndpoint(),
+		Username: netconfUsername,
+		Password: netconfPassword,
+
+		InsecureIgnoreHostKey: true,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, status)
+
+	hwV, err := c.GetHWVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, testHWModel, hwV)
+
+	swV, err := c.GetSWVersion(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, swV)
+	assert.Equal(t, testSWVersion, swV.Version)
+	assert.Empty(t, swV.Checksum)
+
+	fwV, err := c.GetFWVersion(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, fwV)
+	assert.Equal(t, testFWVersion, fwV.Version)
+	assert.Empty(t, fwV.Checksum)
+	assertSnapshot(t, c)
+
+	// chassis is disabled, device should be reported as unhealthy
+	t.Setenv(simulatorv1.EnvDeviceStatus, simulatorv1.DeviceStatusUNHEALTHY)
+	status, err = c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, status)
+	assertSnapshotStatus(t, c, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY)
+
+	// device is unreachable, connector should report an error
+	t.Setenv(simulatorv1.EnvDeviceStatus, simulatorv1.DeviceStatusDOWN)
+	status, err = c.GetStatus(ctx)
+	require.Error(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	_, err = c.GetHWVersion(ctx)
+	require.Error(t, err)
+	assertSnapshotUnreachable(t, c)
+}
+
+func TestNETCONFConnectorWrongCredentials(t *testing.T) {
+	startNETCONFServer(t)
+
+	c := &connectors.NETCONFConnector{
+		Endpoint: netconfEndpoint(),
+		Username: netconfUsername,
+		Password: "wrong-password",
+
+		InsecureIgnoreHostKey: true,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	status, err := c.GetStatus(ctx)
+	require.ErrorIs(t, err, connectors.ErrAuthFailed)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+
+	// malformed private key
+	c = &connectors.NETCONFConnector{
+		Endpoint:   netconfEndpoint(),
+		Username:   netconfUsername,
+		PrivateKey: []byte("not a key"),
+	}
+	_, err = c.GetFWVersion(ctx)
+	require.ErrorIs(t, err, connectors.ErrAuthFailed)
+
+	// no credentials, there are no defaults to fall back to
+	c = &connectors.NETCONFConnector{
+		Endpoint:              netconfEndpoint(),
+		InsecureIgnoreHostKey: true,
+	}
+	_, err = c.GetStatus(ctx)
+	require.ErrorIs(t, err, connectors.ErrAuthFailed)
+}
+
+func TestNETCONFConnectorHostKey(t *testing.T) {
+	setDeviceVersions(t)
+	startNETCONFServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	// host key is not trusted, when no known hosts are set
+	c := &connectors.NETCONFConnector{
+		Endpoint: netconfEndpoint(),
+		Username: netconfUsername,
+		Password: netconfPassword,
+	}
+	_, err := c.GetStatus(ctx)
+	require.ErrorIs(t, err, connectors.ErrUntrusted)
+
+	// learning host key of the server
+	var hostKey ssh.PublicKey
+	c.HostKeyCallback = func(_ string, _ net.Addr, key ssh.PublicKey) error {
+		hostKey = key
+		return nil
+	}
+	_, err = c.GetStatus(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, hostKey)
+	address := knownhosts.Normalize(connectors.CraftServerAddress(netconfHost, netconfPort))
+
+	// host key is listed in known hosts
+	c = &connectors.NETCONFConnector{
+		Endpoint:   netconfEndpoint(),
+		Username:   netconfUsername,
+		Password:   netconfPassword,
+		KnownHosts: []byte(knownhosts.Line([]string{"otherhost"}, hostKey) + "\n" + knownhosts.Line([]string{address}, hostKey)),
+	}
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, status)
+
+	// another key is listed for the host, known hosts take precedence over disabled verification
+	otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPublicKey, err := ssh.NewPublicKey(otherKey)
+	require.NoError(t, err)
+	c = &connectors.NETCONFConnector{
+		Endpoint:   netconfEndpoint(),
+		Username:   netconfUsername,
+		Password:   netconfPassword,
+		KnownHosts: []byte(knownhosts.Line([]string{address}, otherPublicKey)),
+
+		InsecureIgnoreHostKey: true,
+	}
+	_, err = c.GetStatus(ctx)
+	require.ErrorIs(t, err, connectors.ErrUntrusted)
+}
+
+func TestNETCONFConnectorServerNotRunning(t *testing.T) {
+	c := &connectors.NETCONFConnector{
+		Endpoint: netconfEndpoint(),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	status, err := c.GetStatus(ctx)
+	require.ErrorIs(t, err, connectors.ErrUnreachable)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	assertSnapshotUnreachable(t, c)
+}
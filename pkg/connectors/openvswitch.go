@@ -3,19 +3,38 @@ package connectors
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/eroshiva/trade-show-poc/internal/ent"
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
-	"github.com/eroshiva/trade-show-poc/internal/server"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
 	"github.com/rs/zerolog"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-const componentNameOpenvSwitch = "openvswitch-connector"
+const (
+	componentNameOpenvSwitch = "openvswitch-connector"
+
+	// OVSDBUnixSocketPrefix is a prefix of the endpoint host, which denotes OVSDB server listening on a unix domain
+	// socket, e.g., "unix:/var/run/openvswitch/db.sock". Port of such endpoint is ignored.
+	OVSDBUnixSocketPrefix = "unix:"
+	// DefaultOVSDBDatabase is a name of the Open vSwitch database.
+	DefaultOVSDBDatabase = "Open_vSwitch"
+	// ParamOVSDBDatabase is a factory parameter, which sets the name of the OVSDB database.
+	ParamOVSDBDatabase  = "database"
+	defaultOVSDBTimeout = 5 * time.Second
+
+	ovsdbMethodEcho     = "echo"
+	ovsdbMethodTransact = "transact"
+	ovsdbTable          = "Open_vSwitch"
+)
 
 var zlogOVS = zerolog.New(zerolog.ConsoleWriter{
 	Out:        os.Stderr,
@@ -25,119 +44,479 @@ var zlogOVS = zerolog.New(zerolog.ConsoleWriter{
 	},
 }).Level(zerolog.TraceLevel).With().Caller().Timestamp().Str(component, componentNameOpenvSwitch).Logger()
 
-// OVSConnector handles status checks for Open vSwitch devices.
+// OVSConnector handles status checks for Open vSwitch devices. It speaks OVSDB management protocol (RFC 7047)
+// over TCP, TLS (SSL in Open vSwitch terms) or unix domain socket.
 type OVSConnector struct {
 	Endpoint *ent.Endpoint
+	// Database is a name of the OVSDB database, DefaultOVSDBDatabase is used when not set.
+	Database string
+	// TLS carries TLS settings of TCP connections, connection is in plain text, when it is not set.
+	TLS     *TLSSettings
+	Timeout time.Duration
+}
+
+// ovsdbSession is a JSON-RPC session with OVSDB server. It is kept in the connection pool between the calls.
+type ovsdbSession struct {
+	conn    net.Conn
+	encoder *json.Encoder
+	decoder *json.Decoder
+	id      int
+	// broken is set, when the session has failed to send or receive a message, and can't be used anymore.
+	broken bool
+}
+
+// ovsdbRequest is a JSON-RPC 1.0 request or notification (RFC 7047, section 4).
+type ovsdbRequest struct {
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+	ID     interface{}   `json:"id"`
+}
+
+// ovsdbMessage is any JSON-RPC 1.0 message received from OVSDB server, i.e., response, request or notification.
+type ovsdbMessage struct {
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+	ID     json.RawMessage `json:"id"`
+}
+
+// ovsdbError is an error reported by OVSDB server, either in JSON-RPC response, or in the result of operation.
+type ovsdbError struct {
+	Error   string `json:"error"`
+	Details string `json:"details,omitempty"`
+}
+
+// ovsdbOperationResult is a result of a single operation within transaction (RFC 7047, section 5.2).
+type ovsdbOperationResult struct {
+	Rows []map[string]json.RawMessage `json:"rows,omitempty"`
+	ovsdbError
+}
+
+// ovsSystemInfo carries the columns of the Open_vSwitch table, which are relevant for monitoring.
+type ovsSystemInfo struct {
+	OVSVersion    string
+	DBVersion     string
+	SystemType    string
+	SystemVersion string
+	CurCfg        int64
+	NextCfg       int64
+}
+
+func (e *ovsdbError) toError() error {
+	if e.Details != "" {
+		return fmt.Errorf("OVSDB error %q: %s", e.Error, e.Details)
+	}
+	return fmt.Errorf("OVSDB error %q", e.Error)
+}
+
+func init() {
+	Register(string(endpoint.ProtocolPROTOCOL_OPEN_V_SWITCH), newOVSConnector)
+}
+
+// newOVSConnector is a factory of the Open vSwitch connector. It accepts ParamOVSDBDatabase parameter.
+// TLS is used, when TLS settings are provided.
+func newOVSConnector(ep *ent.Endpoint, opts Options) (Connector, error) {
+	return &OVSConnector{
+		Endpoint: ep,
+		Database: opts.Params[ParamOVSDBDatabase],
+		TLS:      opts.TLS,
+		Timeout:  opts.Timeout,
+	}, nil
 }
 
 // GetStatus implements the Connector interface, namely GetStatus function, for Open vSwitch protocol.
+// Device answering "echo" request is alive. It is UP, when ovs-vswitchd has applied the latest configuration
+// from the database, i.e., cur_cfg has caught up with next_cfg.
 func (c *OVSConnector) GetStatus(ctx context.Context) (devicestatus.Status, error) {
 	zlogOVS.Info().Msgf("Checking status for %s:%s via Open vSwitch...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, Open vSwitch connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
-	if err != nil {
-		zlogOVS.Error().Err(err).Msgf("Error establishing Open vSwitch connection, reporting that device is down")
-		// failed to instantiate connection, reporting device status DOWN.
-		return devicestatus.StatusSTATUS_DEVICE_DOWN, nil
-	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetStatus(ctx, &emptypb.Empty{})
+	var info *ovsSystemInfo
+	var infoErr error
+	err := c.do(ctx, func(s *ovsdbSession) error {
+		err := s.echo()
+		if err != nil {
+			return err
+		}
+		info, infoErr = s.getSystemInfo(c.database())
+		return nil
+	})
 	if err != nil {
 		zlogOVS.Error().Err(err).Msgf("Failed to retrieve device status for %s:%s via Open vSwitch", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve device status, returning device status DOWN and an error.
+		// device does not answer liveness check, returning device status DOWN and an error.
 		return devicestatus.StatusSTATUS_DEVICE_DOWN, err
 	}
-	// device status was successfully retrieved, converting it to correct notation
-	status := resp.GetStatus()
-	entStatus := server.ConvertProtoStatusToEntStatus(status)
-	// gracefully closing connection
-	err = conn.Close()
-	if err != nil {
-		zlogOVS.Error().Err(err).Msgf("Failed to gracefully close connection")
+	if infoErr != nil {
+		// device is alive, but was not able to process the request
+		zlogOVS.Warn().Err(infoErr).Msgf("Device %s:%s is not healthy", c.Endpoint.Host, c.Endpoint.Port)
+		return devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, nil
+	}
+	if info.CurCfg < info.NextCfg {
+		zlogOVS.Warn().Msgf("Device %s:%s has not applied configuration yet (cur_cfg %d, next_cfg %d)",
+			c.Endpoint.Host, c.Endpoint.Port, info.CurCfg, info.NextCfg)
+		return devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, nil
 	}
-	return entStatus, nil
+	return devicestatus.StatusSTATUS_DEVICE_UP, nil
 }
 
 // GetHWVersion implements the Connector interface, namely GetHWVersion function, for Open vSwitch protocol.
+// Open vSwitch does not report hardware, system_type of the platform is used instead.
 func (c *OVSConnector) GetHWVersion(ctx context.Context) (string, error) {
 	zlogOVS.Info().Msgf("Checking HW version for %s:%s via Open vSwitch...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, Open vSwitch connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
+	info, err := c.getSystemInfo(ctx)
 	if err != nil {
-		zlogOVS.Error().Err(err).Msgf("Error establishing Open vSwitch connection")
-		// failed to instantiate connection, returning error
+		zlogOVS.Error().Err(err).Msgf("Failed to retrieve HW version for %s:%s via Open vSwitch", c.Endpoint.Host, c.Endpoint.Port)
 		return "", err
 	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetHWVersion(ctx, &emptypb.Empty{})
-	if err != nil {
+	if info.SystemType == "" {
+		err = fmt.Errorf("device does not report system_type")
 		zlogOVS.Error().Err(err).Msgf("Failed to retrieve HW version for %s:%s via Open vSwitch", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve HW version, returning error
 		return "", err
 	}
-	// HW version was successfully retrieved, returning it
-	// and gracefully closing connection
-	err = conn.Close()
-	if err != nil {
-		zlogOVS.Error().Err(err).Msgf("Failed to gracefully close connection")
-	}
-	return resp.GetVersion(), nil
+	return info.SystemType, nil
 }
 
 // GetSWVersion implements the Connector interface, namely GetSWVersion function, for Open vSwitch protocol.
+// Version of Open vSwitch is reported.
 func (c *OVSConnector) GetSWVersion(ctx context.Context) (*ent.Version, error) {
 	zlogOVS.Info().Msgf("Checking SW version for %s:%s via Open vSwitch...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, Open vSwitch connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
+	info, err := c.getSystemInfo(ctx)
 	if err != nil {
-		zlogOVS.Error().Err(err).Msgf("Error establishing Open vSwitch connection")
-		// failed to instantiate connection, returning error
+		zlogOVS.Error().Err(err).Msgf("Failed to retrieve SW version for %s:%s via Open vSwitch", c.Endpoint.Host, c.Endpoint.Port)
 		return nil, err
 	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetSWVersion(ctx, &emptypb.Empty{})
-	if err != nil {
+	if info.OVSVersion == "" {
+		err = fmt.Errorf("device does not report ovs_version")
 		zlogOVS.Error().Err(err).Msgf("Failed to retrieve SW version for %s:%s via Open vSwitch", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve SW version, returning error
 		return nil, err
 	}
-	// SW version was successfully retrieved, returning it
-	// and gracefully closing connection
-	err = conn.Close()
-	if err != nil {
-		zlogOVS.Error().Err(err).Msgf("Failed to gracefully close connection")
-	}
+	zlogOVS.Debug().Msgf("Device %s:%s runs Open vSwitch %s with database schema %s", c.Endpoint.Host, c.Endpoint.Port,
+		info.OVSVersion, info.DBVersion)
 	return &ent.Version{
-		Version:  resp.GetVersion(),
-		Checksum: resp.GetChecksum(),
+		Version: info.OVSVersion,
 	}, nil
 }
 
 // GetFWVersion implements the Connector interface, namely GetFWVersion function, for Open vSwitch protocol.
+// Open vSwitch does not report firmware, system_version of the platform is used instead.
 func (c *OVSConnector) GetFWVersion(ctx context.Context) (*ent.Version, error) {
 	zlogOVS.Info().Msgf("Checking FW version for %s:%s via Open vSwitch...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, Open vSwitch connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
+	info, err := c.getSystemInfo(ctx)
 	if err != nil {
-		zlogOVS.Error().Err(err).Msgf("Error establishing Open vSwitch connection")
-		// failed to instantiate connection, returning error
+		zlogOVS.Error().Err(err).Msgf("Failed to retrieve FW version for %s:%s via Open vSwitch", c.Endpoint.Host, c.Endpoint.Port)
 		return nil, err
 	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetFWVersion(ctx, &emptypb.Empty{})
-	if err != nil {
+	if info.SystemVersion == "" {
+		err = fmt.Errorf("device does not report system_version")
 		zlogOVS.Error().Err(err).Msgf("Failed to retrieve FW version for %s:%s via Open vSwitch", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve FW version, returning error
 		return nil, err
 	}
-	// FW version was successfully retrieved, returning it
-	// and gracefully closing connection
-	err = conn.Close()
+	return &ent.Version{
+		Version: info.SystemVersion,
+	}, nil
+}
+
+// SuppliesChecksum implements the ChecksumSupplier interface.
+// Open_vSwitch table does not carry checksum of the versions, it is generated by the manager.
+func (c *OVSConnector) SuppliesChecksum() bool {
+	return false
+}
+
+// GetSnapshot implements the Connector interface, namely GetSnapshot function, for Open vSwitch protocol.
+// Status and all versions are retrieved with a single "transact" request, which serves as a liveness check too.
+func (c *OVSConnector) GetSnapshot(ctx context.Context) (*DeviceSnapshot, error) {
+	zlogOVS.Info().Msgf("Retrieving snapshot for %s:%s via Open vSwitch...\n", c.Endpoint.Host, c.Endpoint.Port)
+	var info *ovsSystemInfo
+	var infoErr error
+	err := c.do(ctx, func(s *ovsdbSession) error {
+		info, infoErr = s.getSystemInfo(c.database())
+		if infoErr != nil && s.broken {
+			// device has not answered at all
+			return infoErr
+		}
+		return nil
+	})
 	if err != nil {
-		zlogOVS.Error().Err(err).Msgf("Failed to gracefully close connection")
+		zlogOVS.Error().Err(err).Msgf("Failed to retrieve snapshot for %s:%s via Open vSwitch", c.Endpoint.Host, c.Endpoint.Port)
+		// failed to retrieve device status, returning an error.
+		return nil, err
 	}
-	return &ent.Version{
-		Version:  resp.GetVersion(),
-		Checksum: resp.GetChecksum(),
+	if infoErr != nil {
+		// device is alive, but was not able to process the request
+		zlogOVS.Warn().Err(infoErr).Msgf("Device %s:%s is not healthy", c.Endpoint.Host, c.Endpoint.Port)
+		snapshot := newDeviceSnapshot(devicestatus.StatusSTATUS_DEVICE_UNHEALTHY)
+		snapshot.failVersions(infoErr)
+		return snapshot, nil
+	}
+
+	snapshot := newDeviceSnapshot(devicestatus.StatusSTATUS_DEVICE_UP)
+	if info.CurCfg < info.NextCfg {
+		zlogOVS.Warn().Msgf("Device %s:%s has not applied configuration yet (cur_cfg %d, next_cfg %d)",
+			c.Endpoint.Host, c.Endpoint.Port, info.CurCfg, info.NextCfg)
+		snapshot.Status = devicestatus.StatusSTATUS_DEVICE_UNHEALTHY
+	}
+	snapshot.HWVersion = info.SystemType
+	if info.SystemType == "" {
+		snapshot.Errors[SnapshotPartHWVersion] = fmt.Errorf("device does not report system_type")
+	}
+	snapshot.SWVersion.Version = info.OVSVersion
+	if info.OVSVersion == "" {
+		snapshot.Errors[SnapshotPartSWVersion] = fmt.Errorf("device does not report ovs_version")
+	}
+	snapshot.FWVersion.Version = info.SystemVersion
+	if info.SystemVersion == "" {
+		snapshot.Errors[SnapshotPartFWVersion] = fmt.Errorf("device does not report system_version")
+	}
+	return snapshot, nil
+}
+
+func (c *OVSConnector) database() string {
+	if c.Database == "" {
+		return DefaultOVSDBDatabase
+	}
+	return c.Database
+}
+
+func (c *OVSConnector) timeout() time.Duration {
+	if c.Timeout == 0 {
+		return defaultOVSDBTimeout
+	}
+	return c.Timeout
+}
+
+// getSystemInfo reads the Open_vSwitch table over pooled OVSDB session.
+func (c *OVSConnector) getSystemInfo(ctx context.Context) (*ovsSystemInfo, error) {
+	var info *ovsSystemInfo
+	err := c.do(ctx, func(s *ovsdbSession) error {
+		var err error
+		info, err = s.getSystemInfo(c.database())
+		return err
+	})
+	return info, err
+}
+
+// do leases OVSDB session from the connection pool and runs fn over it. Whole exchange has to fit in the timeout.
+func (c *OVSConnector) do(ctx context.Context, fn func(s *ovsdbSession) error) error {
+	network, address := ovsdbAddress(c.Endpoint)
+	key := poolKey(endpoint.ProtocolPROTOCOL_OPEN_V_SWITCH, network+":"+address, c.TLS.poolKey())
+	err := DefaultPool().Do(ctx, key, func(ctx context.Context) (PooledConn, error) {
+		return c.dial(ctx)
+	}, func(conn PooledConn) error {
+		s := conn.(*ovsdbSession)
+		err := s.setDeadline(operationDeadline(ctx, c.timeout()))
+		if err != nil {
+			return err
+		}
+		defer func() {
+			// idle session must not expire
+			_ = s.setDeadline(time.Time{})
+		}()
+		return fn(s)
+	})
+	return classifyError(err)
+}
+
+// dial establishes connection with OVSDB server over TCP or unix domain socket. TCP connection is secured with TLS,
+// when configured.
+func (c *OVSConnector) dial(ctx context.Context) (*ovsdbSession, error) {
+	network, address := ovsdbAddress(c.Endpoint)
+	dialer := &net.Dialer{Timeout: c.timeout()}
+	var conn net.Conn
+	var err error
+	if c.TLS != nil && network == "tcp" {
+		cfg, cfgErr := c.TLS.Config(c.Endpoint.Host)
+		if cfgErr != nil {
+			return nil, cfgErr
+		}
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: cfg}
+		conn, err = tlsDialer.DialContext(ctx, network, address)
+	} else {
+		conn, err = dialer.DialContext(ctx, network, address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s: %w", address, err)
+	}
+	return &ovsdbSession{
+		conn:    conn,
+		encoder: json.NewEncoder(conn),
+		decoder: json.NewDecoder(conn),
 	}, nil
 }
+
+// ovsdbAddress returns network and address of the OVSDB server.
+func ovsdbAddress(ep *ent.Endpoint) (string, string) {
+	if path, ok := strings.CutPrefix(ep.Host, OVSDBUnixSocketPrefix); ok {
+		return "unix", path
+	}
+	return "tcp", CraftServerAddressFromEndpoint(ep)
+}
+
+// echo performs "echo" request (RFC 7047, section 4.1.11), which serves as a liveness check.
+func (s *ovsdbSession) echo() error {
+	const payload = "monitoring"
+	result := make([]string, 0)
+	err := s.call(ovsdbMethodEcho, []interface{}{payload}, &result)
+	if err != nil {
+		return err
+	}
+	if len(result) != 1 || result[0] != payload {
+		return fmt.Errorf("unexpected echo reply %v", result)
+	}
+	return nil
+}
+
+// getSystemInfo selects the (only) row of the Open_vSwitch table.
+func (s *ovsdbSession) getSystemInfo(database string) (*ovsSystemInfo, error) {
+	op := map[string]interface{}{
+		"op":      "select",
+		"table":   ovsdbTable,
+		"where":   []interface{}{},
+		"columns": []string{"ovs_version", "db_version", "system_type", "system_version", "cur_cfg", "next_cfg"},
+	}
+	results := make([]ovsdbOperationResult, 0)
+	err := s.call(ovsdbMethodTransact, []interface{}{database, op}, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("empty transaction result")
+	}
+	if results[0].Error != "" {
+		return nil, results[0].toError()
+	}
+	if len(results[0].Rows) == 0 {
+		return nil, fmt.Errorf("%s table is empty", ovsdbTable)
+	}
+	row := results[0].Rows[0]
+	info := &ovsSystemInfo{}
+	for column, dst := range map[string]*string{
+		"ovs_version":    &info.OVSVersion,
+		"db_version":     &info.DBVersion,
+		"system_type":    &info.SystemType,
+		"system_version": &info.SystemVersion,
+	} {
+		err = decodeOVSDBOptional(row[column], dst)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode column %s: %w", column, err)
+		}
+	}
+	for column, dst := range map[string]*int64{
+		"cur_cfg":  &info.CurCfg,
+		"next_cfg": &info.NextCfg,
+	} {
+		err = decodeOVSDBOptional(row[column], dst)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode column %s: %w", column, err)
+		}
+	}
+	return info, nil
+}
+
+// call sends JSON-RPC request and waits for the response. Requests received from the server in the meantime
+// ("echo" keepalives) are answered, notifications are ignored.
+func (s *ovsdbSession) call(method string, params []interface{}, result interface{}) error {
+	s.id++
+	id := s.id
+	err := s.encoder.Encode(&ovsdbRequest{Method: method, Params: params, ID: id})
+	if err != nil {
+		s.broken = true
+		return fmt.Errorf("failed to send %s request: %w", method, err)
+	}
+	for {
+		msg := &ovsdbMessage{}
+		err = s.decoder.Decode(msg)
+		if err != nil {
+			s.broken = true
+			return fmt.Errorf("failed to receive %s response: %w", method, err)
+		}
+		if msg.Method != "" {
+			s.handleRequest(msg)
+			continue
+		}
+		if string(msg.ID) != fmt.Sprint(id) {
+			zlogOVS.Warn().Msgf("Ignoring OVSDB response with unexpected id %s, expected %d", msg.ID, id)
+			continue
+		}
+		if len(msg.Error) > 0 && string(msg.Error) != "null" {
+			rpcErr := &ovsdbError{}
+			if err = json.Unmarshal(msg.Error, rpcErr); err != nil {
+				return fmt.Errorf("%s request failed: %s", method, msg.Error)
+			}
+			return rpcErr.toError()
+		}
+		err = json.Unmarshal(msg.Result, result)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s result: %w", method, err)
+		}
+		return nil
+	}
+}
+
+// handleRequest answers requests initiated by the server. Only "echo" is expected.
+func (s *ovsdbSession) handleRequest(msg *ovsdbMessage) {
+	if len(msg.ID) == 0 || string(msg.ID) == "null" {
+		// notification, no response is expected
+		return
+	}
+	response := map[string]interface{}{"id": msg.ID, "result": nil, "error": nil}
+	if msg.Method == ovsdbMethodEcho {
+		response["result"] = msg.Params
+	} else {
+		response["error"] = "unknown method"
+	}
+	if err := s.encoder.Encode(response); err != nil {
+		zlogOVS.Error().Err(err).Msgf("Failed to answer %s request", msg.Method)
+	}
+}
+
+// setDeadline sets deadline of the exchange with OVSDB server. Zero value means no deadline.
+func (s *ovsdbSession) setDeadline(deadline time.Time) error {
+	if err := s.conn.SetDeadline(deadline); err != nil {
+		s.broken = true
+		return err
+	}
+	return nil
+}
+
+// Ping implements the PooledConn interface. Session is alive, when it answers "echo" request.
+func (s *ovsdbSession) Ping(ctx context.Context) error {
+	if s.broken {
+		return fmt.Errorf("OVSDB session is broken")
+	}
+	err := s.setDeadline(operationDeadline(ctx, poolPingTimeout))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = s.setDeadline(time.Time{})
+	}()
+	return s.echo()
+}
+
+// Close implements the PooledConn interface, it closes connection with OVSDB server.
+func (s *ovsdbSession) Close() error {
+	return s.conn.Close()
+}
+
+// decodeOVSDBOptional decodes optional column (RFC 7047, section 5.1), which is encoded either as an atom,
+// or as a set with zero or one element. Empty set leaves destination untouched.
+func decodeOVSDBOptional(raw json.RawMessage, dst interface{}) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	set := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(raw, &set); err == nil {
+		var tag string
+		if len(set) != 2 || json.Unmarshal(set[0], &tag) != nil || tag != "set" {
+			return errors.New("unexpected value " + string(raw))
+		}
+		elements := make([]json.RawMessage, 0)
+		if err = json.Unmarshal(set[1], &elements); err != nil {
+			return err
+		}
+		if len(elements) == 0 {
+			return nil
+		}
+		raw = elements[0]
+	}
+	return json.Unmarshal(raw, dst)
+}
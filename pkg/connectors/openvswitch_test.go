@@ -0,0 +1,140 @@
+// Package connectors_test implements unit tests for protocol connectors.
+package connectors_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+	simulatorv1 "github.com/eroshiva/trade-show-poc/pkg/mocks"
+	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ovsdbHost = "localhost"
+	ovsdbPort = "50391"
+)
+
+// startOVSDBServer starts OVSDB server simulator on provided address, which may also be a unix domain socket.
+func startOVSDBServer(t *testing.T, address string) {
+	t.Helper()
+	t.Setenv(simulatorv1.EnvOVSDBServerAddress, address)
+	srv := simulatorv1.NewOVSDBServer()
+	srv.StartOVSDBServer()
+	t.Cleanup(srv.StopOVSDBServer)
+	time.Sleep(100 * time.Millisecond) // giving some time for the server to bootup
+}
+
+func ovsdbEndpoint(host, port string) *ent.Endpoint {
+	return &ent.Endpoint{
+		Host:     host,
+		Port:     port,
+		Protocol: endpoint.ProtocolPROTOCOL_OPEN_V_SWITCH,
+	}
+}
+
+func assertOVSConnector(t *testing.T, c connectors.Connector) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, status)
+
+	hwV, err := c.GetHWVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, testHWModel, hwV)
+
+	swV, err := c.GetSWVersion(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, swV)
+	assert.Equal(t, testSWVersion, swV.Version)
+	assert.Empty(t, swV.Checksum)
+
+	fwV, err := c.GetFWVersion(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, fwV)
+	assert.Equal(t, testFWVersion, fwV.Version)
+	assert.Empty(t, fwV.Checksum)
+}
+
+func TestOVSConnector(t *testing.T) {
+	setDeviceVersions(t)
+	startOVSDBServer(t, connectors.CraftServerAddress(ovsdbHost, ovsdbPort))
+
+	c, err := connectors.NewConnector(ovsdbEndpoint(ovsdbHost, ovsdbPort))
+	require.NoError(t, err)
+	assertOVSConnector(t, c)
+	assertSnapshot(t, c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	// configuration is not applied, device should be reported as unhealthy
+	t.Setenv(simulatorv1.EnvDeviceStatus, simulatorv1.DeviceStatusUNHEALTHY)
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, status)
+	assertSnapshotStatus(t, c, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY)
+
+	// device does not answer echo, connector should report an error
+	t.Setenv(simulatorv1.EnvDeviceStatus, simulatorv1.DeviceStatusDOWN)
+	status, err = c.GetStatus(ctx)
+	require.Error(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	_, err = c.GetSWVersion(ctx)
+	require.Error(t, err)
+	assertSnapshotUnreachable(t, c)
+}
+
+func TestOVSConnectorUnixSocket(t *testing.T) {
+	setDeviceVersions(t)
+	socket := filepath.Join(t.TempDir(), "db.sock")
+	startOVSDBServer(t, connectors.OVSDBUnixSocketPrefix+socket)
+
+	c := &connectors.OVSConnector{
+		Endpoint: ovsdbEndpoint(connectors.OVSDBUnixSocketPrefix+socket, ""),
+	}
+	assertOVSConnector(t, c)
+	assertSnapshot(t, c)
+}
+
+func TestOVSConnectorUnknownDatabase(t *testing.T) {
+	startOVSDBServer(t, connectors.CraftServerAddress(ovsdbHost, ovsdbPort))
+
+	c := &connectors.OVSConnector{
+		Endpoint: ovsdbEndpoint(ovsdbHost, ovsdbPort),
+		Database: "Unknown",
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	// device is alive, but it is not able to serve the data
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, status)
+	_, err = c.GetHWVersion(ctx)
+	require.Error(t, err)
+	assertSnapshotStatus(t, c, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY)
+}
+
+func TestOVSConnectorServerNotRunning(t *testing.T) {
+	c := &connectors.OVSConnector{
+		Endpoint: ovsdbEndpoint(ovsdbHost, ovsdbPort),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	status, err := c.GetStatus(ctx)
+	require.Error(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	assertSnapshotUnreachable(t, c)
+}
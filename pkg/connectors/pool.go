@@ -0,0 +1,497 @@
+// Package connectors implements common interface for all devices and carries individual implementations of each protocol.
+package connectors
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+)
+
+const (
+	// DefaultPoolIdleTimeout is a period, after which an idle connection is closed. It outlives the default
+	// control loop period, so connections are reused between the polls.
+	DefaultPoolIdleTimeout = 90 * time.Second
+	// DefaultPoolMaxLifetime is a period, after which a connection is closed regardless of its usage.
+	DefaultPoolMaxLifetime = 30 * time.Minute
+	// DefaultPoolHealthCheckInterval is a period, after which an idle connection is checked to be still usable.
+	DefaultPoolHealthCheckInterval = 60 * time.Second
+	// DefaultPoolMaxIdlePerEndpoint is a number of idle connections kept per endpoint.
+	DefaultPoolMaxIdlePerEndpoint = 2
+	// DefaultPoolMaxActivePerEndpoint is a number of connections per endpoint, which can be leased at once.
+	DefaultPoolMaxActivePerEndpoint = 4
+	// DefaultPoolActiveWaitTimeout is a period, for which a call waits for a connection to be released, when all
+	// connections of the endpoint are leased.
+	DefaultPoolActiveWaitTimeout = 5 * time.Second
+
+	poolPingTimeout        = 5 * time.Second
+	poolMinJanitorInterval = 100 * time.Millisecond
+)
+
+// ErrPoolExhausted is returned (wrapped in Error of ErrorKindTimeout kind), when all connections of the endpoint are
+// leased and none of them was released in time.
+var ErrPoolExhausted = errors.New("all connections to the endpoint are in use")
+
+// poolKeySecret makes pool keys opaque, it never leaves the process.
+var poolKeySecret = newPoolKeySecret()
+
+// PooledConn is a connection to the device, which is kept in the Pool between connector calls.
+type PooledConn interface {
+	// Ping verifies that the connection is still usable.
+	Ping(ctx context.Context) error
+	// Close closes the connection.
+	Close() error
+}
+
+// DialFunc establishes a new connection to the device.
+type DialFunc func(ctx context.Context) (PooledConn, error)
+
+// PoolConfig carries connection pool settings. Zero value of a setting disables it.
+type PoolConfig struct {
+	// IdleTimeout is a period, after which an idle connection is closed.
+	IdleTimeout time.Duration
+	// MaxLifetime is a period, after which a connection is closed regardless of its usage.
+	MaxLifetime time.Duration
+	// HealthCheckInterval is a period, after which an idle connection is pinged.
+	HealthCheckInterval time.Duration
+	// MaxIdlePerEndpoint is a number of idle connections kept per endpoint. Connections are not kept, when it is 0.
+	MaxIdlePerEndpoint int
+	// MaxActivePerEndpoint is a number of connections per endpoint, which can be leased at once.
+	MaxActivePerEndpoint int
+	// ActiveWaitTimeout is a period, for which a call waits for a connection to be released, when MaxActivePerEndpoint
+	// connections are leased already. Call fails right away with ErrPoolExhausted, when it is 0.
+	ActiveWaitTimeout time.Duration
+}
+
+// PoolStats carries connection pool statistics.
+type PoolStats struct {
+	// Idle is a number of connections currently kept in the pool.
+	Idle int
+	// Active is a number of connections currently leased.
+	Active int
+	// Dials is a number of established connections.
+	Dials uint64
+	// Reuses is a number of times an idle connection was taken from the pool.
+	Reuses uint64
+	// Evictions is a number of connections closed due to a failure.
+	Evictions uint64
+	// Exhaustions is a number of calls, which have failed, because all connections of the endpoint were leased.
+	Exhaustions uint64
+}
+
+// Pool keeps connections to the devices per endpoint and shares them among connector calls. Connection is leased
+// to a single call at a time, number of leased connections per endpoint is limited. Connection, which fails the call
+// and does not answer Ping afterward, is evicted.
+type Pool struct {
+	lock   sync.Mutex
+	config PoolConfig
+	idle   map[string][]*pooledConn
+	active map[string]int
+	// released is closed (and forgotten), when a connection of the key is released, calls waiting for it are woken up
+	released map[string]chan struct{}
+	stats    PoolStats
+	closed   bool
+	ticker   *time.Ticker
+	closeCh  chan struct{}
+}
+
+// pooledConn is a connection together with its bookkeeping.
+type pooledConn struct {
+	conn        PooledConn
+	key         string
+	createdAt   time.Time
+	lastUsed    time.Time
+	lastChecked time.Time
+}
+
+var defaultPool = NewPool(DefaultPoolConfig())
+
+// DefaultPoolConfig returns default connection pool settings.
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		IdleTimeout:         DefaultPoolIdleTimeout,
+		MaxLifetime:         DefaultPoolMaxLifetime,
+		HealthCheckInterval: DefaultPoolHealthCheckInterval,
+		MaxIdlePerEndpoint:  DefaultPoolMaxIdlePerEndpoint,
+
+		MaxActivePerEndpoint: DefaultPoolMaxActivePerEndpoint,
+		ActiveWaitTimeout:    DefaultPoolActiveWaitTimeout,
+	}
+}
+
+// DefaultPool returns the connection pool shared by all connectors.
+func DefaultPool() *Pool {
+	return defaultPool
+}
+
+// NewPool function creates connection pool and starts a routine, which closes expired idle connections
+// and health checks the rest.
+func NewPool(config PoolConfig) *Pool {
+	p := &Pool{
+		config:   config,
+		idle:     make(map[string][]*pooledConn),
+		active:   make(map[string]int),
+		released: make(map[string]chan struct{}),
+		closeCh:  make(chan struct{}),
+	}
+	p.ticker = time.NewTicker(janitorInterval(config))
+	go p.janitor()
+	return p
+}
+
+// SetConfig replaces pool settings. Idle connections are checked against the new settings on the next janitor run.
+func (p *Pool) SetConfig(config PoolConfig) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.config = config
+	p.ticker.Reset(janitorInterval(config))
+}
+
+// Stats returns connection pool statistics.
+func (p *Pool) Stats() PoolStats {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	stats := p.stats
+	for _, conns := range p.idle {
+		stats.Idle += len(conns)
+	}
+	for _, active := range p.active {
+		stats.Active += active
+	}
+	return stats
+}
+
+// Do leases a connection for the key, dialing a new one when there is no idle connection, and runs fn over it.
+// When MaxActivePerEndpoint connections of the key are leased already, it waits for one of them to be released.
+// When fn fails, the connection is pinged and evicted, if it does not answer. Call, which failed over
+// a stale pooled connection, is retried once over a fresh one.
+func (p *Pool) Do(ctx context.Context, key string, dial DialFunc, fn func(conn PooledConn) error) error {
+	if err := p.reserve(ctx, key); err != nil {
+		return err
+	}
+	defer p.unreserve(key)
+
+	pc := p.acquire(key)
+	reused := pc != nil
+	if !reused {
+		var err error
+		pc, err = p.dial(ctx, key, dial)
+		if err != nil {
+			return err
+		}
+	}
+	err := fn(pc.conn)
+	if err == nil {
+		p.release(pc)
+		return nil
+	}
+	if pingErr := pc.conn.Ping(ctx); pingErr == nil {
+		// connection has survived the failure, i.e., device has answered with an error
+		p.release(pc)
+		return err
+	}
+	p.evict(pc)
+	if !reused || ctx.Err() != nil {
+		return err
+	}
+
+	zlog.Debug().Err(err).Msgf("Pooled connection %s went stale, retrying over a fresh connection", key)
+	pc, err = p.dial(ctx, key, dial)
+	if err != nil {
+		return err
+	}
+	err = fn(pc.conn)
+	if err != nil && pc.conn.Ping(ctx) != nil {
+		p.evict(pc)
+		return err
+	}
+	p.release(pc)
+	return err
+}
+
+// CloseIdleConnections closes all connections, which are currently kept in the pool.
+func (p *Pool) CloseIdleConnections() {
+	p.lock.Lock()
+	idle := p.idle
+	p.idle = make(map[string][]*pooledConn)
+	p.lock.Unlock()
+
+	for _, conns := range idle {
+		for _, pc := range conns {
+			closePooledConn(pc)
+		}
+	}
+}
+
+// Close closes all idle connections and stops the janitor routine. Connections leased afterward are closed
+// once the call is done.
+func (p *Pool) Close() {
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return
+	}
+	p.closed = true
+	p.ticker.Stop()
+	close(p.closeCh)
+	p.lock.Unlock()
+
+	p.CloseIdleConnections()
+}
+
+// reserve makes room for one more leased connection of the key. It waits for a connection to be released
+// for ActiveWaitTimeout (or until the context is done), when MaxActivePerEndpoint connections are leased already.
+func (p *Pool) reserve(ctx context.Context, key string) error {
+	var timeout <-chan time.Time
+	for {
+		p.lock.Lock()
+		limit, wait := p.config.MaxActivePerEndpoint, p.config.ActiveWaitTimeout
+		if limit <= 0 || p.active[key] < limit {
+			p.active[key]++
+			p.lock.Unlock()
+			return nil
+		}
+		if wait <= 0 {
+			p.stats.Exhaustions++
+			p.lock.Unlock()
+			return NewError(ErrorKindTimeout, ErrPoolExhausted)
+		}
+		released, ok := p.released[key]
+		if !ok {
+			released = make(chan struct{})
+			p.released[key] = released
+		}
+		p.lock.Unlock()
+
+		if timeout == nil {
+			timer := time.NewTimer(wait)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+		select {
+		case <-released:
+		case <-timeout:
+			p.exhausted()
+			return NewError(ErrorKindTimeout, ErrPoolExhausted)
+		case <-ctx.Done():
+			p.exhausted()
+			return NewError(ErrorKindTimeout, fmt.Errorf("%w: %w", ErrPoolExhausted, ctx.Err()))
+		}
+	}
+}
+
+// unreserve gives the room of the leased connection back and wakes up the calls waiting for it.
+func (p *Pool) unreserve(key string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.active[key]--
+	if p.active[key] <= 0 {
+		delete(p.active, key)
+	}
+	if released, ok := p.released[key]; ok {
+		close(released)
+		delete(p.released, key)
+	}
+}
+
+// exhausted counts the call, which has given up waiting for a connection.
+func (p *Pool) exhausted() {
+	p.lock.Lock()
+	p.stats.Exhaustions++
+	p.lock.Unlock()
+}
+
+// acquire takes the most recently used idle connection for the key. Expired connections are closed on the way.
+func (p *Pool) acquire(key string) *pooledConn {
+	now := time.Now()
+	expired := make([]*pooledConn, 0)
+	defer func() {
+		for _, pc := range expired {
+			closePooledConn(pc)
+		}
+	}()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	conns := p.idle[key]
+	for len(conns) > 0 {
+		pc := conns[len(conns)-1]
+		conns = conns[:len(conns)-1]
+		if p.expired(pc, now) {
+			expired = append(expired, pc)
+			continue
+		}
+		p.setIdle(key, conns)
+		p.stats.Reuses++
+		return pc
+	}
+	p.setIdle(key, conns)
+	return nil
+}
+
+func (p *Pool) dial(ctx context.Context, key string, dial DialFunc) (*pooledConn, error) {
+	conn, err := dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	p.lock.Lock()
+	p.stats.Dials++
+	p.lock.Unlock()
+	return &pooledConn{
+		conn:        conn,
+		key:         key,
+		createdAt:   now,
+		lastUsed:    now,
+		lastChecked: now,
+	}, nil
+}
+
+// release returns the connection to the pool, or closes it, when it can't be kept.
+func (p *Pool) release(pc *pooledConn) {
+	now := time.Now()
+	pc.lastUsed = now
+	pc.lastChecked = now
+
+	p.lock.Lock()
+	if p.closed || p.expired(pc, now) || len(p.idle[pc.key]) >= p.config.MaxIdlePerEndpoint {
+		p.lock.Unlock()
+		closePooledConn(pc)
+		return
+	}
+	p.idle[pc.key] = append(p.idle[pc.key], pc)
+	p.lock.Unlock()
+}
+
+// evict closes the connection, which is not usable anymore.
+func (p *Pool) evict(pc *pooledConn) {
+	p.lock.Lock()
+	p.stats.Evictions++
+	p.lock.Unlock()
+	zlog.Debug().Msgf("Evicting connection %s", pc.key)
+	closePooledConn(pc)
+}
+
+// expired reports whether connection has exceeded its lifetime or idle timeout. Must be called under the lock.
+func (p *Pool) expired(pc *pooledConn, now time.Time) bool {
+	if p.config.MaxLifetime > 0 && now.Sub(pc.createdAt) >= p.config.MaxLifetime {
+		return true
+	}
+	return p.config.IdleTimeout > 0 && now.Sub(pc.lastUsed) >= p.config.IdleTimeout
+}
+
+func (p *Pool) setIdle(key string, conns []*pooledConn) {
+	if len(conns) == 0 {
+		delete(p.idle, key)
+		return
+	}
+	p.idle[key] = conns
+}
+
+// janitor periodically closes expired idle connections and health checks the rest.
+func (p *Pool) janitor() {
+	for {
+		select {
+		case <-p.ticker.C:
+			p.cleanup()
+		case <-p.closeCh:
+			return
+		}
+	}
+}
+
+// cleanup closes expired idle connections and pings the ones, which were not checked for HealthCheckInterval.
+// Pinged connections are taken out of the pool for the time of the check.
+func (p *Pool) cleanup() {
+	now := time.Now()
+	expired := make([]*pooledConn, 0)
+	unchecked := make([]*pooledConn, 0)
+	p.lock.Lock()
+	for key, conns := range p.idle {
+		kept := make([]*pooledConn, 0, len(conns))
+		for _, pc := range conns {
+			switch {
+			case p.expired(pc, now):
+				expired = append(expired, pc)
+			case p.config.HealthCheckInterval > 0 && now.Sub(pc.lastChecked) >= p.config.HealthCheckInterval:
+				unchecked = append(unchecked, pc)
+			default:
+				kept = append(kept, pc)
+			}
+		}
+		p.setIdle(key, kept)
+	}
+	p.lock.Unlock()
+
+	for _, pc := range expired {
+		closePooledConn(pc)
+	}
+	for _, pc := range unchecked {
+		ctx, cancel := context.WithTimeout(context.Background(), poolPingTimeout)
+		err := pc.conn.Ping(ctx)
+		cancel()
+		if err != nil {
+			zlog.Debug().Err(err).Msgf("Pooled connection %s failed health check", pc.key)
+			p.evict(pc)
+			continue
+		}
+		pc.lastChecked = time.Now()
+		p.lock.Lock()
+		if p.closed || len(p.idle[pc.key]) >= p.config.MaxIdlePerEndpoint {
+			p.lock.Unlock()
+			closePooledConn(pc)
+			continue
+		}
+		// health check does not count as a usage, idle timeout is still counted from the last call
+		p.idle[pc.key] = append(p.idle[pc.key], pc)
+		p.lock.Unlock()
+	}
+}
+
+func closePooledConn(pc *pooledConn) {
+	if err := pc.conn.Close(); err != nil {
+		zlog.Error().Err(err).Msgf("Failed to gracefully close connection %s", pc.key)
+	}
+}
+
+// janitorInterval derives the period of the janitor routine from the pool settings.
+func janitorInterval(config PoolConfig) time.Duration {
+	interval := config.IdleTimeout
+	if config.HealthCheckInterval > 0 && (interval == 0 || config.HealthCheckInterval < interval) {
+		interval = config.HealthCheckInterval
+	}
+	if config.MaxLifetime > 0 && (interval == 0 || config.MaxLifetime < interval) {
+		interval = config.MaxLifetime
+	}
+	interval /= 2
+	if interval < poolMinJanitorInterval {
+		interval = poolMinJanitorInterval
+	}
+	return interval
+}
+
+// poolKey identifies connections to the endpoint in the pool. Credentials are fingerprinted with HMAC keyed by
+// the process-local secret, so connections established with different credentials are never shared, and the key
+// (which is logged) can't be used to guess the secrets.
+func poolKey(protocol endpoint.Protocol, address string, credentials ...string) string {
+	h := hmac.New(sha256.New, poolKeySecret)
+	for _, credential := range credentials {
+		h.Write([]byte(credential))
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("%s/%s/%x", protocol, address, h.Sum(nil)[:16])
+}
+
+func newPoolKeySecret() []byte {
+	secret := make([]byte, sha256.Size)
+	if _, err := rand.Read(secret); err != nil {
+		panic("connectors: failed to generate pool key secret: " + err.Error())
+	}
+	return secret
+}
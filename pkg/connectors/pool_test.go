@@ -0,0 +1,269 @@
+// Package connectors_test implements unit tests for protocol connectors.
+package connectors_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const poolTestKey = "test/localhost:1"
+
+var errDeviceFailure = errors.New("device has failed to process the request")
+
+// fakePooledConn is a connection, which can be broken on demand.
+type fakePooledConn struct {
+	broken atomic.Bool
+	closed atomic.Bool
+}
+
+func (c *fakePooledConn) Ping(_ context.Context) error {
+	if c.broken.Load() {
+		return errors.New("connection is broken")
+	}
+	return nil
+}
+
+func (c *fakePooledConn) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+// fakeDialer records all connections it has dialed.
+type fakeDialer struct {
+	conns []*fakePooledConn
+}
+
+func (d *fakeDialer) dial(_ context.Context) (connectors.PooledConn, error) {
+	conn := &fakePooledConn{}
+	d.conns = append(d.conns, conn)
+	return conn, nil
+}
+
+// use fails, when the connection is broken, as a real connection would.
+func use(conn connectors.PooledConn) error {
+	if conn.(*fakePooledConn).broken.Load() {
+		return errors.New("connection reset by peer")
+	}
+	return nil
+}
+
+func newTestPool(t *testing.T, config connectors.PoolConfig) *connectors.Pool {
+	t.Helper()
+	p := connectors.NewPool(config)
+	t.Cleanup(p.Close)
+	return p
+}
+
+func TestPoolReuse(t *testing.T) {
+	p := newTestPool(t, connectors.DefaultPoolConfig())
+	d := &fakeDialer{}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, p.Do(ctx, poolTestKey, d.dial, use))
+	}
+	require.Len(t, d.conns, 1)
+	assert.Equal(t, connectors.PoolStats{Idle: 1, Dials: 1, Reuses: 3}, p.Stats())
+
+	// connections are kept per key
+	require.NoError(t, p.Do(ctx, "test/localhost:2", d.dial, use))
+	require.Len(t, d.conns, 2)
+
+	p.Close()
+	assert.True(t, d.conns[0].closed.Load())
+	assert.True(t, d.conns[1].closed.Load())
+	assert.Equal(t, 0, p.Stats().Idle)
+}
+
+func TestPoolEviction(t *testing.T) {
+	p := newTestPool(t, connectors.DefaultPoolConfig())
+	d := &fakeDialer{}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	// device has answered with an error, connection is still usable
+	err := p.Do(ctx, poolTestKey, d.dial, func(_ connectors.PooledConn) error {
+		return errDeviceFailure
+	})
+	require.ErrorIs(t, err, errDeviceFailure)
+	require.Len(t, d.conns, 1)
+	assert.False(t, d.conns[0].closed.Load())
+	assert.Equal(t, 1, p.Stats().Idle)
+
+	// connection breaks in the middle of the call, it is evicted and the call is retried over a fresh connection
+	d.conns[0].broken.Store(true)
+	require.NoError(t, p.Do(ctx, poolTestKey, d.dial, use))
+	require.Len(t, d.conns, 2)
+	assert.True(t, d.conns[0].closed.Load())
+	assert.False(t, d.conns[1].closed.Load())
+	assert.Equal(t, connectors.PoolStats{Idle: 1, Dials: 2, Reuses: 1, Evictions: 1}, p.Stats())
+
+	// fresh connection, which fails, is evicted and the call is not retried
+	err = p.Do(ctx, "test/localhost:2", d.dial, func(conn connectors.PooledConn) error {
+		conn.(*fakePooledConn).broken.Store(true)
+		return use(conn)
+	})
+	require.Error(t, err)
+	require.Len(t, d.conns, 3)
+	assert.True(t, d.conns[2].closed.Load())
+	assert.Equal(t, uint64(2), p.Stats().Evictions)
+
+	// dial failure is reported as is
+	dialErr := errors.New("connection refused")
+	err = p.Do(ctx, "test/localhost:3", func(_ context.Context) (connectors.PooledConn, error) {
+		return nil, dialErr
+	}, use)
+	require.ErrorIs(t, err, dialErr)
+}
+
+func TestPoolActiveLimit(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	t.Cleanup(cancel)
+	p := newTestPool(t, connectors.PoolConfig{MaxIdlePerEndpoint: 1, MaxActivePerEndpoint: 1})
+	d := &fakeDialer{}
+
+	// the only connection of the endpoint is leased until the first call is released
+	leased := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- p.Do(ctx, poolTestKey, d.dial, func(_ connectors.PooledConn) error {
+			close(leased)
+			<-release
+			return nil
+		})
+	}()
+	<-leased
+	assert.Equal(t, 1, p.Stats().Active)
+
+	// fail - pool doesn't wait for the connection to be released
+	err := p.Do(ctx, poolTestKey, d.dial, use)
+	require.ErrorIs(t, err, connectors.ErrPoolExhausted)
+	require.ErrorIs(t, err, connectors.ErrTimeout)
+	// other endpoints are not affected
+	require.NoError(t, p.Do(ctx, "test/localhost:2", d.dial, use))
+
+	// pool waits for the connection to be released, call reuses it then
+	p.SetConfig(connectors.PoolConfig{MaxIdlePerEndpoint: 1, MaxActivePerEndpoint: 1, ActiveWaitTimeout: time.Minute})
+	waited := make(chan error, 1)
+	go func() {
+		waited <- p.Do(ctx, poolTestKey, d.dial, use)
+	}()
+	close(release)
+	require.NoError(t, <-done)
+	require.NoError(t, <-waited)
+	assert.Len(t, d.conns, 2)
+	stats := p.Stats()
+	assert.Equal(t, 0, stats.Active)
+	assert.Equal(t, uint64(1), stats.Exhaustions)
+
+	// fail - call gives up waiting, when its context is done
+	leased = make(chan struct{})
+	release = make(chan struct{})
+	go func() {
+		done <- p.Do(ctx, poolTestKey, d.dial, func(_ connectors.PooledConn) error {
+			close(leased)
+			<-release
+			return nil
+		})
+	}()
+	<-leased
+	shortCtx, shortCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	t.Cleanup(shortCancel)
+	err = p.Do(shortCtx, poolTestKey, d.dial, use)
+	require.ErrorIs(t, err, connectors.ErrPoolExhausted)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	close(release)
+	require.NoError(t, <-done)
+}
+
+func TestPoolExpiration(t *testing.T) {
+	p := newTestPool(t, connectors.PoolConfig{
+		IdleTimeout:        time.Hour,
+		MaxLifetime:        time.Hour,
+		MaxIdlePerEndpoint: 1,
+	})
+	d := &fakeDialer{}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	// only one idle connection is kept per key, the one released last is closed
+	err := p.Do(ctx, poolTestKey, d.dial, func(_ connectors.PooledConn) error {
+		return p.Do(ctx, poolTestKey, d.dial, use)
+	})
+	require.NoError(t, err)
+	require.Len(t, d.conns, 2)
+	assert.True(t, d.conns[0].closed.Load())
+	assert.False(t, d.conns[1].closed.Load())
+
+	// idle connection is closed after idle timeout
+	p.SetConfig(connectors.PoolConfig{
+		IdleTimeout:        50 * time.Millisecond,
+		MaxLifetime:        time.Hour,
+		MaxIdlePerEndpoint: 1,
+	})
+	time.Sleep(300 * time.Millisecond)
+	assert.True(t, d.conns[1].closed.Load())
+	assert.Equal(t, 0, p.Stats().Idle)
+
+	// connection is closed after max lifetime, even though it is in use
+	p.SetConfig(connectors.PoolConfig{
+		IdleTimeout:        time.Hour,
+		MaxLifetime:        200 * time.Millisecond,
+		MaxIdlePerEndpoint: 1,
+	})
+	for i := 0; i < 6; i++ {
+		require.NoError(t, p.Do(ctx, poolTestKey, d.dial, use))
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.Len(t, d.conns, 4)
+}
+
+func TestPoolHealthCheck(t *testing.T) {
+	p := newTestPool(t, connectors.PoolConfig{
+		HealthCheckInterval: 50 * time.Millisecond,
+		MaxIdlePerEndpoint:  1,
+	})
+	d := &fakeDialer{}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	require.NoError(t, p.Do(ctx, poolTestKey, d.dial, use))
+	require.NoError(t, p.Do(ctx, "test/localhost:2", d.dial, use))
+	require.Len(t, d.conns, 2)
+
+	// broken idle connection is evicted by the health check, healthy one is kept
+	d.conns[0].broken.Store(true)
+	time.Sleep(300 * time.Millisecond)
+	assert.True(t, d.conns[0].closed.Load())
+	assert.False(t, d.conns[1].closed.Load())
+	assert.Equal(t, 1, p.Stats().Idle)
+	assert.Equal(t, uint64(1), p.Stats().Evictions)
+}
+
+func TestPoolSharedByConnectors(t *testing.T) {
+	setDeviceVersions(t)
+	startOVSDBServer(t, connectors.CraftServerAddress(ovsdbHost, ovsdbPort))
+	connectors.DefaultPool().CloseIdleConnections()
+	before := connectors.DefaultPool().Stats()
+
+	// each poll creates new connector, all of them share the same connection
+	for i := 0; i < 2; i++ {
+		c, err := connectors.NewConnector(ovsdbEndpoint(ovsdbHost, ovsdbPort))
+		require.NoError(t, err)
+		assertOVSConnector(t, c)
+	}
+	after := connectors.DefaultPool().Stats()
+	assert.Equal(t, uint64(1), after.Dials-before.Dials)
+	assert.Equal(t, uint64(7), after.Reuses-before.Reuses)
+	assert.Equal(t, 1, after.Idle)
+}
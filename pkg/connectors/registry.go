@@ -0,0 +1,148 @@
+// Package connectors implements common interface for all devices and carries individual implementations of each protocol.
+package connectors
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+)
+
+// Options carries parameters, which are passed to the connector factory.
+type Options struct {
+	// Timeout bounds a single exchange with the device. Connector's default is used, when not set.
+	Timeout time.Duration
+	// Params carries protocol-specific parameters, e.g., SNMP community. Unknown parameters are ignored by the factory.
+	Params map[string]string
+	// Credentials carries decrypted credential profile of the endpoint. Secrets, which are set, take precedence over
+	// the ones passed in Params. Fields, which are irrelevant for the protocol, are ignored by the factory.
+	Credentials *ent.CredentialProfile
+	// TLS carries TLS settings of the connection. It is derived from the endpoint, when not set. Connectors, which
+	// transport is not TLS based, ignore it.
+	TLS *TLSSettings
+}
+
+// Option sets a parameter passed to the connector factory.
+type Option func(*Options)
+
+// Factory creates a connector for the given endpoint.
+type Factory func(ep *ent.Endpoint, opts Options) (Connector, error)
+
+// registration binds the factory with the options configured for the protocol.
+type registration struct {
+	factory Factory
+	opts    []Option
+}
+
+var (
+	registryLock sync.RWMutex
+	// registry is keyed by protocol name, see ProtocolName.
+	registry = make(map[string]*registration)
+)
+
+// WithTimeout sets a timeout of a single exchange with the device.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.Timeout = timeout
+	}
+}
+
+// WithParam sets a protocol-specific parameter.
+func WithParam(key, value string) Option {
+	return func(o *Options) {
+		if o.Params == nil {
+			o.Params = make(map[string]string)
+		}
+		o.Params[key] = value
+	}
+}
+
+// WithCredentials sets credentials used for accessing the device. Profile must carry decrypted secrets.
+func WithCredentials(cp *ent.CredentialProfile) Option {
+	return func(o *Options) {
+		o.Credentials = cp
+	}
+}
+
+// ProtocolName returns the name of the protocol, which connector of the endpoint is registered under. It is the name
+// carried by the endpoint for PROTOCOL_CUSTOM, and the protocol itself (e.g., PROTOCOL_SNMP) otherwise.
+func ProtocolName(ep *ent.Endpoint) string {
+	if ep.Protocol == endpoint.ProtocolPROTOCOL_CUSTOM {
+		return ep.ProtocolName
+	}
+	return string(ep.Protocol)
+}
+
+// Register makes a connector factory available for the protocol name. Built-in connectors are registered under
+// the protocol itself (e.g., PROTOCOL_SNMP), the other ones are registered under any other name, which is then
+// referenced by PROTOCOL_CUSTOM endpoints. Provided options are applied to every connector created for this protocol.
+// It is meant to be called from the init function of the package implementing the connector. If Register is called
+// twice for the same protocol name, if the name is empty, or if factory is nil, it panics.
+func Register(name string, factory Factory, opts ...Option) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if name == "" {
+		panic("connectors: Register protocol name is empty")
+	}
+	if factory == nil {
+		panic("connectors: Register factory is nil for protocol " + name)
+	}
+	if _, ok := registry[name]; ok {
+		panic("connectors: Register called twice for protocol " + name)
+	}
+	registry[name] = &registration{factory: factory, opts: opts}
+}
+
+// Configure appends options to the ones, which are applied to every connector created for the protocol name.
+func Configure(name string, opts ...Option) error {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	r, ok := registry[name]
+	if !ok {
+		err := fmt.Errorf("protocol %s is not registered", name)
+		zlog.Error().Err(err).Msgf("Failed to configure connector")
+		return err
+	}
+	r.opts = append(r.opts, opts...)
+	return nil
+}
+
+// RegisteredProtocols returns a sorted list of protocol names, for which connector factory is registered.
+func RegisteredProtocols() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	protocols := make([]string, 0, len(registry))
+	for protocol := range registry {
+		protocols = append(protocols, protocol)
+	}
+	sort.Slice(protocols, func(i, j int) bool {
+		return protocols[i] < protocols[j]
+	})
+	return protocols
+}
+
+// NewConnector function returns the correct connector for a given endpoint protocol (see ProtocolName). Options
+// configured for the protocol are applied first, provided options are applied on top of them.
+func NewConnector(ep *ent.Endpoint, opts ...Option) (Connector, error) {
+	name := ProtocolName(ep)
+	registryLock.RLock()
+	r, ok := registry[name]
+	registryLock.RUnlock()
+	if !ok {
+		err := NewError(ErrorKindUnsupported, fmt.Errorf("protocol %s has no connector", name))
+		zlog.Warn().Err(err).Msgf("Protocol %s is not supported", name)
+		return nil, err
+	}
+
+	options := Options{}
+	for _, opt := range append(append([]Option{}, r.opts...), opts...) {
+		opt(&options)
+	}
+	if options.TLS == nil {
+		options.TLS = TLSSettingsFromEndpoint(ep, options.Credentials)
+	}
+	return r.factory(ep, options)
+}
@@ -3,19 +3,51 @@ package connectors
 
 import (
 	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/eroshiva/trade-show-poc/internal/ent"
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
-	"github.com/eroshiva/trade-show-poc/internal/server"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
 	"github.com/rs/zerolog"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-const componentNameRESTCONF = "restconf-connector"
+const (
+	componentNameRESTCONF = "restconf-connector"
+
+	// RESTCONFEncodingJSON requests data encoded in JSON (RFC 7951).
+	RESTCONFEncodingJSON = "json"
+	// RESTCONFEncodingXML requests data encoded in XML.
+	RESTCONFEncodingXML = "xml"
+	// ParamRESTCONFScheme is a factory parameter, which sets the scheme, either "http" or "https".
+	ParamRESTCONFScheme = "scheme"
+	// ParamRESTCONFEncoding is a factory parameter, which sets preferred encoding, either "json" or "xml".
+	ParamRESTCONFEncoding = "encoding"
+
+	defaultRESTCONFScheme  = "http"
+	restconfSchemeHTTPS    = "https"
+	defaultRESTCONFRoot    = "/restconf"
+	defaultRESTCONFTimeout = 5 * time.Second
+
+	restconfHostMetaPath   = "/.well-known/host-meta"
+	restconfLinkRelation   = "restconf"
+	restconfSystemState    = "/data/ietf-system:system-state"
+	restconfHardware       = "/data/ietf-hardware:hardware"
+	mediaTypeXRD           = "application/xrd+xml"
+	mediaTypeYANGDataJSON  = "application/yang-data+json"
+	mediaTypeYANGDataXML   = "application/yang-data+xml"
+	restconfMaxBodyLength  = 1 << 20
+	restconfErrorTagAccess = "access-denied"
+)
 
 var zlogRESTCONF = zerolog.New(zerolog.ConsoleWriter{
 	Out:        os.Stderr,
@@ -25,119 +57,526 @@ var zlogRESTCONF = zerolog.New(zerolog.ConsoleWriter{
 	},
 }).Level(zerolog.TraceLevel).With().Caller().Timestamp().Str(component, componentNameRESTCONF).Logger()
 
-// RESTCONFConnector handles status checks for RESTCONF devices.
+// RESTCONFConnector handles status checks for RESTCONF (RFC 8040) devices.
 type RESTCONFConnector struct {
 	Endpoint *ent.Endpoint
+	// Scheme is either "http" or "https". It defaults to "https", when TLS settings are provided.
+	Scheme string
+	// Username and Password are used for HTTP basic authentication.
+	Username string
+	Password string
+	// Token is used for HTTP bearer token authentication. It takes precedence over basic authentication.
+	Token string
+	// Encoding is a preferred encoding of the data, either RESTCONFEncodingJSON (default) or RESTCONFEncodingXML.
+	Encoding string
+	// TLS carries settings of HTTPS connections.
+	TLS *TLSSettings
+	// HTTPClient is used instead of the pooled one, when set. TLS settings are not applied to it and it is never
+	// put in the pool.
+	HTTPClient *http.Client
+	Timeout    time.Duration
+}
+
+// restconfSession is an HTTP client together with the discovered API root. It is kept in the connection pool
+// between the calls, i.e., keep-alive connections and the root are reused.
+type restconfSession struct {
+	client *http.Client
+	// transport is owned by the session, it is not set, when HTTP client is provided by the user.
+	transport *http.Transport
+	// root of the RESTCONF API, discovered through host-meta.
+	root string
+	// broken is set, when the device was not reachable, discovered root is not trusted anymore.
+	broken bool
+}
+
+// restconfHTTPError is returned when RESTCONF server responds with an error status code.
+type restconfHTTPError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *restconfHTTPError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("RESTCONF server responded with %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+	}
+	return fmt.Sprintf("RESTCONF server responded with %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+func (e *restconfHTTPError) errorKind() ErrorKind {
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrorKindAuthFailed
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return ErrorKindUnsupported
+	default:
+		return ErrorKindProtocolError
+	}
+}
+
+// restconfXRD is a host-meta document (RFC 6415) used for RESTCONF root discovery (RFC 8040, section 3.1).
+type restconfXRD struct {
+	XMLName xml.Name `xml:"XRD"`
+	Links   []struct {
+		Rel  string `xml:"rel,attr"`
+		Href string `xml:"href,attr"`
+	} `xml:"Link"`
+}
+
+// restconfErrors is a JSON encoded ietf-restconf:errors container (RFC 8040, section 7.1).
+type restconfErrors struct {
+	Errors struct {
+		Error []struct {
+			Type    string `json:"error-type"`
+			Tag     string `json:"error-tag"`
+			Message string `json:"error-message"`
+		} `json:"error"`
+	} `json:"ietf-restconf:errors"`
+}
+
+func init() {
+	Register(string(endpoint.ProtocolPROTOCOL_RESTCONF), newRESTCONFConnector)
+}
+
+// newRESTCONFConnector is a factory of the RESTCONF connector. It accepts ParamRESTCONFScheme and
+// ParamRESTCONFEncoding parameters. It takes user name, password and token from the credentials. HTTPS is used,
+// when TLS settings are provided.
+func newRESTCONFConnector(ep *ent.Endpoint, opts Options) (Connector, error) {
+	c := &RESTCONFConnector{
+		Endpoint: ep,
+		Scheme:   opts.Params[ParamRESTCONFScheme],
+		Encoding: opts.Params[ParamRESTCONFEncoding],
+		TLS:      opts.TLS,
+		Timeout:  opts.Timeout,
+	}
+	if cp := opts.Credentials; cp != nil {
+		c.Username = cp.Username
+		c.Password = cp.Password
+		c.Token = cp.Token
+	}
+	return c, nil
 }
 
 // GetStatus implements the Connector interface, namely GetStatus function, for RESTCONF protocol.
+// Status is derived from the operational state of the chassis reported by ietf-hardware.
 func (c *RESTCONFConnector) GetStatus(ctx context.Context) (devicestatus.Status, error) {
 	zlogRESTCONF.Info().Msgf("Checking status for %s:%s via RESTCONF...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, RESTCONF connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
-	if err != nil {
-		zlogRESTCONF.Error().Err(err).Msgf("Error establishing RESTCONF connection, reporting that device is down")
-		// failed to instantiate connection, reporting device status DOWN.
-		return devicestatus.StatusSTATUS_DEVICE_DOWN, nil
-	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetStatus(ctx, &emptypb.Empty{})
+	hw, err := c.getHardware(ctx)
 	if err != nil {
+		var httpErr *restconfHTTPError
+		if errors.As(err, &httpErr) {
+			switch {
+			case httpErr.StatusCode == http.StatusNotFound:
+				// device does not implement ietf-hardware, checking that it is able to report its system state
+				_, err = c.getSystemState(ctx)
+				if err == nil {
+					return devicestatus.StatusSTATUS_DEVICE_UP, nil
+				}
+			case httpErr.StatusCode >= http.StatusInternalServerError:
+				// device has answered, but was not able to process the request
+				zlogRESTCONF.Warn().Err(err).Msgf("Device %s:%s is not healthy", c.Endpoint.Host, c.Endpoint.Port)
+				return devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, nil
+			default:
+			}
+		}
 		zlogRESTCONF.Error().Err(err).Msgf("Failed to retrieve device status for %s:%s via RESTCONF", c.Endpoint.Host, c.Endpoint.Port)
 		// failed to retrieve device status, returning device status DOWN and an error.
 		return devicestatus.StatusSTATUS_DEVICE_DOWN, err
 	}
-	// device status was successfully retrieved, converting it to correct notation
-	status := resp.GetStatus()
-	entStatus := server.ConvertProtoStatusToEntStatus(status)
-	// gracefully closing connection
-	err = conn.Close()
-	if err != nil {
-		zlogRESTCONF.Error().Err(err).Msgf("Failed to gracefully close connection")
+	chassis := hw.chassis()
+	if chassis == nil {
+		// device does not report any component, but it has answered
+		return devicestatus.StatusSTATUS_DEVICE_UP, nil
 	}
-	return entStatus, nil
+	return convertOperStateToStatus(chassis.State.OperState), nil
 }
 
 // GetHWVersion implements the Connector interface, namely GetHWVersion function, for RESTCONF protocol.
 func (c *RESTCONFConnector) GetHWVersion(ctx context.Context) (string, error) {
 	zlogRESTCONF.Info().Msgf("Checking HW version for %s:%s via RESTCONF...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, RESTCONF connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
+	chassis, err := c.getChassis(ctx)
 	if err != nil {
-		zlogRESTCONF.Error().Err(err).Msgf("Error establishing NETRESTCONFCONF connection")
-		// failed to instantiate connection, returning error
+		zlogRESTCONF.Error().Err(err).Msgf("Failed to retrieve HW version for %s:%s via RESTCONF", c.Endpoint.Host, c.Endpoint.Port)
 		return "", err
 	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetHWVersion(ctx, &emptypb.Empty{})
-	if err != nil {
+	hwV := chassis.hardwareRevision()
+	if hwV == "" {
+		err = fmt.Errorf("device does not report hardware revision")
 		zlogRESTCONF.Error().Err(err).Msgf("Failed to retrieve HW version for %s:%s via RESTCONF", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve HW version, returning error
 		return "", err
 	}
-	// HW version was successfully retrieved, returning it
-	// and gracefully closing connection
-	err = conn.Close()
-	if err != nil {
-		zlogRESTCONF.Error().Err(err).Msgf("Failed to gracefully close connection")
-	}
-	return resp.GetVersion(), nil
+	return hwV, nil
 }
 
 // GetSWVersion implements the Connector interface, namely GetSWVersion function, for RESTCONF protocol.
+// Software revision of the chassis is used, OS version from ietf-system is used as a fallback.
 func (c *RESTCONFConnector) GetSWVersion(ctx context.Context) (*ent.Version, error) {
 	zlogRESTCONF.Info().Msgf("Checking SW version for %s:%s via RESTCONF...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, RESTCONF connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
-	if err != nil {
-		zlogRESTCONF.Error().Err(err).Msgf("Error establishing RESTCONF connection")
-		// failed to instantiate connection, returning error
-		return nil, err
+	swV := ""
+	chassis, err := c.getChassis(ctx)
+	if err == nil {
+		swV = chassis.SoftwareRev
 	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetSWVersion(ctx, &emptypb.Empty{})
-	if err != nil {
+	if swV == "" {
+		ss, err := c.getSystemState(ctx)
+		if err != nil {
+			zlogRESTCONF.Error().Err(err).Msgf("Failed to retrieve SW version for %s:%s via RESTCONF", c.Endpoint.Host, c.Endpoint.Port)
+			return nil, err
+		}
+		swV = ss.Platform.OSVersion
+	}
+	if swV == "" {
+		err = fmt.Errorf("device does not report software revision")
 		zlogRESTCONF.Error().Err(err).Msgf("Failed to retrieve SW version for %s:%s via RESTCONF", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve SW version, returning error
 		return nil, err
 	}
-	// SW version was successfully retrieved, returning it
-	// and gracefully closing connection
-	err = conn.Close()
-	if err != nil {
-		zlogRESTCONF.Error().Err(err).Msgf("Failed to gracefully close connection")
-	}
 	return &ent.Version{
-		Version:  resp.GetVersion(),
-		Checksum: resp.GetChecksum(),
+		Version: swV,
 	}, nil
 }
 
 // GetFWVersion implements the Connector interface, namely GetFWVersion function, for RESTCONF protocol.
 func (c *RESTCONFConnector) GetFWVersion(ctx context.Context) (*ent.Version, error) {
 	zlogRESTCONF.Info().Msgf("Checking FW version for %s:%s via RESTCONF...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, NETCONF connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
+	chassis, err := c.getChassis(ctx)
 	if err != nil {
-		zlogRESTCONF.Error().Err(err).Msgf("Error establishing RESTCONF connection")
-		// failed to instantiate connection, returning error
+		zlogRESTCONF.Error().Err(err).Msgf("Failed to retrieve FW version for %s:%s via RESTCONF", c.Endpoint.Host, c.Endpoint.Port)
 		return nil, err
 	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetFWVersion(ctx, &emptypb.Empty{})
-	if err != nil {
+	if chassis.FirmwareRev == "" {
+		err = fmt.Errorf("device does not report firmware revision")
 		zlogRESTCONF.Error().Err(err).Msgf("Failed to retrieve FW version for %s:%s via RESTCONF", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve FW version, returning error
 		return nil, err
 	}
-	// FW version was successfully retrieved, returning it
-	// and gracefully closing connection
-	err = conn.Close()
+	return &ent.Version{
+		Version: chassis.FirmwareRev,
+	}, nil
+}
+
+// SuppliesChecksum implements the ChecksumSupplier interface.
+// ietf-hardware does not model checksum of the revisions, it is generated by the manager.
+func (c *RESTCONFConnector) SuppliesChecksum() bool {
+	return false
+}
+
+// GetSnapshot implements the Connector interface, namely GetSnapshot function, for RESTCONF protocol.
+// Status and all versions are retrieved from ietf-hardware with a single GET request. ietf-system is requested
+// only when chassis does not report software revision.
+func (c *RESTCONFConnector) GetSnapshot(ctx context.Context) (*DeviceSnapshot, error) {
+	zlogRESTCONF.Info().Msgf("Retrieving snapshot for %s:%s via RESTCONF...\n", c.Endpoint.Host, c.Endpoint.Port)
+	hw, err := c.getHardware(ctx)
 	if err != nil {
-		zlogRESTCONF.Error().Err(err).Msgf("Failed to gracefully close connection")
+		var httpErr *restconfHTTPError
+		if errors.As(err, &httpErr) {
+			switch {
+			case httpErr.StatusCode == http.StatusNotFound:
+				// device does not implement ietf-hardware, checking that it is able to report its system state
+				var ss *ietfSystemState
+				ss, err = c.getSystemState(ctx)
+				if err == nil {
+					return ietfSnapshot(newDeviceSnapshot(devicestatus.StatusSTATUS_DEVICE_UP), nil, ss), nil
+				}
+			case httpErr.StatusCode >= http.StatusInternalServerError:
+				// device has answered, but was not able to process the request
+				zlogRESTCONF.Warn().Err(err).Msgf("Device %s:%s is not healthy", c.Endpoint.Host, c.Endpoint.Port)
+				snapshot := newDeviceSnapshot(devicestatus.StatusSTATUS_DEVICE_UNHEALTHY)
+				snapshot.failVersions(err)
+				return snapshot, nil
+			default:
+			}
+		}
+		zlogRESTCONF.Error().Err(err).Msgf("Failed to retrieve snapshot for %s:%s via RESTCONF", c.Endpoint.Host, c.Endpoint.Port)
+		// failed to retrieve device status, returning an error.
+		return nil, err
 	}
-	return &ent.Version{
-		Version:  resp.GetVersion(),
-		Checksum: resp.GetChecksum(),
+	snapshot := newDeviceSnapshot(devicestatus.StatusSTATUS_DEVICE_UP)
+	chassis := hw.chassis()
+	if chassis != nil {
+		snapshot.Status = convertOperStateToStatus(chassis.State.OperState)
+	}
+	var ss *ietfSystemState
+	if chassis == nil || chassis.SoftwareRev == "" {
+		ss, err = c.getSystemState(ctx)
+		if err != nil {
+			zlogRESTCONF.Warn().Err(err).Msgf("Failed to retrieve system state of %s:%s via RESTCONF", c.Endpoint.Host, c.Endpoint.Port)
+		}
+	}
+	ietfSnapshot(snapshot, hw, ss)
+	if _, ok := snapshot.Errors[SnapshotPartSWVersion]; ok && err != nil {
+		snapshot.Errors[SnapshotPartSWVersion] = err
+	}
+	return snapshot, nil
+}
+
+func (c *RESTCONFConnector) getChassis(ctx context.Context) (*ietfHardwareComponent, error) {
+	hw, err := c.getHardware(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chassis := hw.chassis()
+	if chassis == nil {
+		return nil, fmt.Errorf("device does not report any hardware component")
+	}
+	return chassis, nil
+}
+
+// getHardware retrieves /ietf-hardware:hardware container.
+func (c *RESTCONFConnector) getHardware(ctx context.Context) (*ietfHardware, error) {
+	body, xmlEncoded, err := c.getData(ctx, restconfHardware)
+	if err != nil {
+		return nil, err
+	}
+	if xmlEncoded {
+		hw := &struct {
+			XMLName xml.Name `xml:"urn:ietf:params:xml:ns:yang:ietf-hardware hardware"`
+			ietfHardware
+		}{}
+		if err = xml.Unmarshal(body, hw); err != nil {
+			return nil, fmt.Errorf("failed to decode ietf-hardware:hardware: %w", err)
+		}
+		return &hw.ietfHardware, nil
+	}
+	hw := &struct {
+		Hardware *ietfHardware `json:"ietf-hardware:hardware"`
+	}{}
+	if err = json.Unmarshal(body, hw); err != nil {
+		return nil, fmt.Errorf("failed to decode ietf-hardware:hardware: %w", err)
+	}
+	if hw.Hardware == nil {
+		return nil, fmt.Errorf("response does not contain ietf-hardware:hardware container")
+	}
+	return hw.Hardware, nil
+}
+
+// getSystemState retrieves /ietf-system:system-state container.
+func (c *RESTCONFConnector) getSystemState(ctx context.Context) (*ietfSystemState, error) {
+	body, xmlEncoded, err := c.getData(ctx, restconfSystemState)
+	if err != nil {
+		return nil, err
+	}
+	if xmlEncoded {
+		ss := &struct {
+			XMLName xml.Name `xml:"urn:ietf:params:xml:ns:yang:ietf-system system-state"`
+			ietfSystemState
+		}{}
+		if err = xml.Unmarshal(body, ss); err != nil {
+			return nil, fmt.Errorf("failed to decode ietf-system:system-state: %w", err)
+		}
+		return &ss.ietfSystemState, nil
+	}
+	ss := &struct {
+		SystemState *ietfSystemState `json:"ietf-system:system-state"`
+	}{}
+	if err = json.Unmarshal(body, ss); err != nil {
+		return nil, fmt.Errorf("failed to decode ietf-system:system-state: %w", err)
+	}
+	if ss.SystemState == nil {
+		return nil, fmt.Errorf("response does not contain ietf-system:system-state container")
+	}
+	return ss.SystemState, nil
+}
+
+// getData performs GET on a data resource over pooled RESTCONF session. It returns response body and reports
+// whether it is encoded in XML (otherwise, it is encoded in JSON).
+func (c *RESTCONFConnector) getData(ctx context.Context, resource string) ([]byte, bool, error) {
+	var body []byte
+	var xmlEncoded bool
+	exchange := func(conn PooledConn) error {
+		// whole exchange, including root discovery, has to fit in the timeout
+		ctx, cancel := context.WithDeadline(ctx, operationDeadline(ctx, c.timeout()))
+		defer cancel()
+		var err error
+		body, xmlEncoded, err = c.getSessionData(ctx, conn.(*restconfSession), resource)
+		return err
+	}
+	var err error
+	if c.HTTPClient != nil {
+		// client is owned by the caller, it is never pooled
+		err = exchange(&restconfSession{client: c.HTTPClient})
+	} else {
+		err = DefaultPool().Do(ctx, c.poolKey(), func(_ context.Context) (PooledConn, error) {
+			return c.dial()
+		}, exchange)
+	}
+	return body, xmlEncoded, classifyError(err)
+}
+
+// poolKey identifies RESTCONF sessions of the endpoint established with the same credentials.
+func (c *RESTCONFConnector) poolKey() string {
+	return poolKey(endpoint.ProtocolPROTOCOL_RESTCONF, c.scheme()+"://"+CraftServerAddressFromEndpoint(c.Endpoint),
+		c.Username, c.Password, c.Token, c.TLS.poolKey())
+}
+
+// dial creates RESTCONF session. Connections are established lazily with the first request.
+func (c *RESTCONFConnector) dial() (*restconfSession, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if c.TLS != nil {
+		cfg, err := c.TLS.Config(c.Endpoint.Host)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = cfg
+	}
+	return &restconfSession{
+		client:    &http.Client{Transport: transport},
+		transport: transport,
 	}, nil
 }
+
+func (c *RESTCONFConnector) scheme() string {
+	switch {
+	case c.Scheme != "":
+		return c.Scheme
+	case c.TLS != nil:
+		return restconfSchemeHTTPS
+	default:
+		return defaultRESTCONFScheme
+	}
+}
+
+func (c *RESTCONFConnector) timeout() time.Duration {
+	if c.Timeout == 0 {
+		return defaultRESTCONFTimeout
+	}
+	return c.Timeout
+}
+
+// getSessionData performs GET on a data resource, API root is discovered first, unless it is already known.
+func (c *RESTCONFConnector) getSessionData(ctx context.Context, s *restconfSession, resource string) ([]byte, bool, error) {
+	root, err := c.discoverRoot(ctx, s)
+	if err != nil {
+		return nil, false, err
+	}
+	accept := mediaTypeYANGDataJSON + ", " + mediaTypeYANGDataXML + ";q=0.9"
+	if c.Encoding == RESTCONFEncodingXML {
+		accept = mediaTypeYANGDataXML + ", " + mediaTypeYANGDataJSON + ";q=0.9"
+	}
+	body, mediaType, err := c.do(ctx, s, root+resource, accept)
+	if err != nil {
+		return nil, false, err
+	}
+	switch mediaType {
+	case mediaTypeYANGDataJSON, "application/json":
+		return body, false, nil
+	case mediaTypeYANGDataXML, "application/xml", "text/xml":
+		return body, true, nil
+	default:
+		return nil, false, fmt.Errorf("unsupported media type %q of %s", mediaType, resource)
+	}
+}
+
+// discoverRoot discovers RESTCONF API root through host-meta (RFC 8040, section 3.1). When discovery
+// fails, the default root "/restconf" is used.
+func (c *RESTCONFConnector) discoverRoot(ctx context.Context, s *restconfSession) (string, error) {
+	if s.root != "" {
+		return s.root, nil
+	}
+
+	body, _, err := c.do(ctx, s, restconfHostMetaPath, mediaTypeXRD)
+	if err != nil {
+		var httpErr *restconfHTTPError
+		if !errors.As(err, &httpErr) || httpErr.StatusCode == http.StatusUnauthorized || httpErr.StatusCode == http.StatusForbidden {
+			// device is not reachable or access is not granted, there is no point in continuing
+			return "", err
+		}
+		zlogRESTCONF.Warn().Err(err).Msgf("Failed to discover RESTCONF root of %s:%s, using default %s",
+			c.Endpoint.Host, c.Endpoint.Port, defaultRESTCONFRoot)
+		s.root = defaultRESTCONFRoot
+		return s.root, nil
+	}
+	xrd := &restconfXRD{}
+	if err := xml.Unmarshal(body, xrd); err == nil {
+		for _, link := range xrd.Links {
+			if link.Rel == restconfLinkRelation && link.Href != "" {
+				s.root = strings.TrimSuffix(link.Href, "/")
+				return s.root, nil
+			}
+		}
+	}
+	zlogRESTCONF.Warn().Msgf("Host-meta of %s:%s does not carry RESTCONF root, using default %s",
+		c.Endpoint.Host, c.Endpoint.Port, defaultRESTCONFRoot)
+	s.root = defaultRESTCONFRoot
+	return s.root, nil
+}
+
+// do performs HTTP GET request and returns response body with its media type.
+func (c *RESTCONFConnector) do(ctx context.Context, s *restconfSession, path, accept string) ([]byte, string, error) {
+	if !strings.HasPrefix(path, "/") {
+		// root discovered through host-meta may be an absolute URL
+		if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+			return c.doURL(ctx, s, path, accept)
+		}
+		path = "/" + path
+	}
+	return c.doURL(ctx, s, c.scheme()+"://"+CraftServerAddressFromEndpoint(c.Endpoint)+path, accept)
+}
+
+func (c *RESTCONFConnector) doURL(ctx context.Context, s *restconfSession, url, accept string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Accept", accept)
+	switch {
+	case c.Token != "":
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	case c.Username != "":
+		req.SetBasicAuth(c.Username, c.Password)
+	default:
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.broken = true
+		return nil, "", err
+	}
+	defer func() {
+		// gracefully closing response body
+		if err := resp.Body.Close(); err != nil {
+			zlogRESTCONF.Error().Err(err).Msgf("Failed to gracefully close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, restconfMaxBodyLength))
+	if err != nil {
+		s.broken = true
+		return nil, "", fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, "", &restconfHTTPError{StatusCode: resp.StatusCode, Message: restconfErrorMessage(body)}
+	}
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		mediaType = ""
+	}
+	return body, mediaType, nil
+}
+
+// Ping implements the PooledConn interface. HTTP client re-establishes keep-alive connections on its own, thus
+// session is usable, unless the device was not reachable.
+func (s *restconfSession) Ping(_ context.Context) error {
+	if s.broken {
+		return fmt.Errorf("RESTCONF server was not reachable")
+	}
+	return nil
+}
+
+// Close implements the PooledConn interface, it closes idle keep-alive connections.
+func (s *restconfSession) Close() error {
+	if s.transport != nil {
+		s.transport.CloseIdleConnections()
+	}
+	return nil
+}
+
+// restconfErrorMessage extracts error message from JSON encoded ietf-restconf:errors container.
+func restconfErrorMessage(body []byte) string {
+	errs := &restconfErrors{}
+	if err := json.Unmarshal(body, errs); err != nil || len(errs.Errors.Error) == 0 {
+		return ""
+	}
+	e := errs.Errors.Error[0]
+	if e.Tag == restconfErrorTagAccess {
+		return "access denied"
+	}
+	return fmt.Sprintf("%s (%s)", e.Message, e.Tag)
+}
@@ -0,0 +1,164 @@
+// Package connectors_test implements unit tests for protocol connectors.
+package connectors_test
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+	simulatorv1 "github.com/eroshiva/trade-show-poc/pkg/mocks"
+	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	restconfUsername = "restconf"
+	restconfPassword = "restconf-password"
+	restconfToken    = "restconf-token"
+)
+
+// startRESTCONFServer starts RESTCONF server simulator and returns an endpoint, where it is reachable.
+func startRESTCONFServer(t *testing.T) *ent.Endpoint {
+	t.Helper()
+	srv := httptest.NewServer(simulatorv1.NewRESTCONFServer().Handler())
+	t.Cleanup(srv.Close)
+	host, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	require.NoError(t, err)
+	return &ent.Endpoint{
+		Host:     host,
+		Port:     port,
+		Protocol: endpoint.ProtocolPROTOCOL_RESTCONF,
+	}
+}
+
+func assertRESTCONFConnector(t *testing.T, c connectors.Connector) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, status)
+
+	hwV, err := c.GetHWVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, testHWModel, hwV)
+
+	swV, err := c.GetSWVersion(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, swV)
+	assert.Equal(t, testSWVersion, swV.Version)
+	assert.Empty(t, swV.Checksum)
+
+	fwV, err := c.GetFWVersion(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, fwV)
+	assert.Equal(t, testFWVersion, fwV.Version)
+	assert.Empty(t, fwV.Checksum)
+}
+
+func TestRESTCONFConnector(t *testing.T) {
+	setDeviceVersions(t)
+	ep := startRESTCONFServer(t)
+
+	for _, encoding := range []string{connectors.RESTCONFEncodingJSON, connectors.RESTCONFEncodingXML} {
+		t.Run(encoding, func(t *testing.T) {
+			c := &connectors.RESTCONFConnector{
+				Endpoint: ep,
+				Encoding: encoding,
+			}
+			assertRESTCONFConnector(t, c)
+			assertSnapshot(t, c)
+		})
+	}
+
+	c := &connectors.RESTCONFConnector{
+		Endpoint: ep,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	// chassis is disabled, device should be reported as unhealthy
+	t.Setenv(simulatorv1.EnvDeviceStatus, simulatorv1.DeviceStatusUNHEALTHY)
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, status)
+	assertSnapshotStatus(t, c, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY)
+
+	// device is unreachable, connector should report an error
+	t.Setenv(simulatorv1.EnvDeviceStatus, simulatorv1.DeviceStatusDOWN)
+	status, err = c.GetStatus(ctx)
+	require.Error(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	_, err = c.GetHWVersion(ctx)
+	require.Error(t, err)
+	assertSnapshotUnreachable(t, c)
+}
+
+func TestRESTCONFConnectorRootDiscovery(t *testing.T) {
+	setDeviceVersions(t)
+	t.Setenv(simulatorv1.EnvRESTCONFRoot, "/top/restconf")
+	ep := startRESTCONFServer(t)
+
+	assertRESTCONFConnector(t, &connectors.RESTCONFConnector{
+		Endpoint: ep,
+	})
+}
+
+func TestRESTCONFConnectorAuthentication(t *testing.T) {
+	setDeviceVersions(t)
+	t.Setenv(simulatorv1.EnvRESTCONFUsername, restconfUsername)
+	t.Setenv(simulatorv1.EnvRESTCONFPassword, restconfPassword)
+	t.Setenv(simulatorv1.EnvRESTCONFToken, restconfToken)
+	ep := startRESTCONFServer(t)
+
+	// basic auth
+	assertRESTCONFConnector(t, &connectors.RESTCONFConnector{
+		Endpoint: ep,
+		Username: restconfUsername,
+		Password: restconfPassword,
+	})
+	// bearer token
+	assertRESTCONFConnector(t, &connectors.RESTCONFConnector{
+		Endpoint: ep,
+		Token:    restconfToken,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+	for _, c := range []*connectors.RESTCONFConnector{
+		{Endpoint: ep},
+		{Endpoint: ep, Username: restconfUsername, Password: "wrong-password"},
+		{Endpoint: ep, Token: "wrong-token"},
+	} {
+		status, err := c.GetStatus(ctx)
+		require.ErrorIs(t, err, connectors.ErrAuthFailed)
+		assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+		_, err = c.GetSWVersion(ctx)
+		require.ErrorIs(t, err, connectors.ErrAuthFailed)
+		assertSnapshotUnreachable(t, c)
+	}
+}
+
+func TestRESTCONFConnectorServerNotRunning(t *testing.T) {
+	c := &connectors.RESTCONFConnector{
+		Endpoint: &ent.Endpoint{
+			Host:     "localhost",
+			Port:     "50381",
+			Protocol: endpoint.ProtocolPROTOCOL_RESTCONF,
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	status, err := c.GetStatus(ctx)
+	require.ErrorIs(t, err, connectors.ErrUnreachable)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	assertSnapshotUnreachable(t, c)
+}
@@ -3,21 +3,43 @@ package connectors
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/eroshiva/trade-show-poc/internal/ent"
 	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
-	"github.com/eroshiva/trade-show-poc/internal/server"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/gosnmp/gosnmp"
 	"github.com/rs/zerolog"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 const (
 	component         = "component"
 	componentNameSNMP = "snmp-connector"
+
+	// DefaultSNMPEntityIndex is an entPhysicalIndex of the chassis entity, which carries device versions.
+	DefaultSNMPEntityIndex = 1
+	// ParamSNMPCommunity is a factory parameter, which sets SNMPv2c community.
+	ParamSNMPCommunity = "community"
+	// ParamSNMPEntityIndex is a factory parameter, which sets entPhysicalIndex of the chassis entity.
+	ParamSNMPEntityIndex = "entity-index"
+	// ParamSNMPRetries is a factory parameter, which sets number of retries of the SNMP request. 0 disables retries.
+	ParamSNMPRetries   = "retries"
+	defaultSNMPTimeout = 2 * time.Second
+	defaultSNMPRetries = 1
+
+	// SNMPv2-MIB system group.
+	oidSysDescr  = ".1.3.6.1.2.1.1.1.0"
+	oidSysUpTime = ".1.3.6.1.2.1.1.3.0"
+	// ENTITY-MIB entPhysicalTable columns, they have to be suffixed with entPhysicalIndex.
+	oidEntPhysicalHardwareRev = ".1.3.6.1.2.1.47.1.1.1.1.8"
+	oidEntPhysicalFirmwareRev = ".1.3.6.1.2.1.47.1.1.1.1.9"
+	oidEntPhysicalSoftwareRev = ".1.3.6.1.2.1.47.1.1.1.1.10"
 )
 
 var zlogSNMP = zerolog.New(zerolog.ConsoleWriter{
@@ -28,119 +50,439 @@ var zlogSNMP = zerolog.New(zerolog.ConsoleWriter{
 	},
 }).Level(zerolog.TraceLevel).With().Caller().Timestamp().Str(component, componentNameSNMP).Logger()
 
+// SNMPUSMCredentials carries SNMPv3 User-based Security Model (USM) credentials.
+type SNMPUSMCredentials struct {
+	UserName string
+	// AuthProtocol is one of "MD5", "SHA", "SHA224", "SHA256", "SHA384", "SHA512". Empty means no authentication.
+	AuthProtocol   string
+	AuthPassphrase string
+	// PrivProtocol is one of "DES", "AES", "AES192", "AES256", "AES192C", "AES256C". Empty means no privacy.
+	PrivProtocol   string
+	PrivPassphrase string
+}
+
+// snmpSession is an SNMP client with an open UDP socket. It is kept in the connection pool between the calls,
+// which saves SNMPv3 engine discovery.
+type snmpSession struct {
+	client *gosnmp.GoSNMP
+}
+
 // SNMPConnector handles status checks for SNMP devices.
 type SNMPConnector struct {
 	Endpoint *ent.Endpoint
+	// Community is SNMPv2c community. It is used only when USM is not set.
+	Community string
+	// USM carries SNMPv3 credentials. When set, SNMPv3 is used instead of SNMPv2c.
+	USM *SNMPUSMCredentials
+	// EntityIndex is an entPhysicalIndex of the entity, which versions are retrieved.
+	EntityIndex int
+	Timeout     time.Duration
+	// Retries is a number of retries of the SNMP request, default is used, when it is not set.
+	Retries *int
+}
+
+func init() {
+	Register(string(endpoint.ProtocolPROTOCOL_SNMP), newSNMPConnector)
+}
+
+// newSNMPConnector is a factory of the SNMP connector. It accepts ParamSNMPCommunity, ParamSNMPEntityIndex, and
+// ParamSNMPRetries parameters.
+// Credentials carrying user name switch the connector to SNMPv3, password is used as USM authentication passphrase.
+func newSNMPConnector(ep *ent.Endpoint, opts Options) (Connector, error) {
+	c := &SNMPConnector{
+		Endpoint:  ep,
+		Community: opts.Params[ParamSNMPCommunity],
+		Timeout:   opts.Timeout,
+	}
+	if idx, ok := opts.Params[ParamSNMPEntityIndex]; ok {
+		entityIndex, err := strconv.Atoi(idx)
+		if err != nil || entityIndex <= 0 {
+			err = fmt.Errorf("invalid %s parameter %q", ParamSNMPEntityIndex, idx)
+			zlogSNMP.Error().Err(err).Msgf("Failed to create SNMP connector")
+			return nil, err
+		}
+		c.EntityIndex = entityIndex
+	}
+	if r, ok := opts.Params[ParamSNMPRetries]; ok {
+		retries, err := strconv.Atoi(r)
+		if err != nil || retries < 0 {
+			err = fmt.Errorf("invalid %s parameter %q", ParamSNMPRetries, r)
+			zlogSNMP.Error().Err(err).Msgf("Failed to create SNMP connector")
+			return nil, err
+		}
+		c.Retries = &retries
+	}
+	if cp := opts.Credentials; cp != nil {
+		if cp.SnmpCommunity != "" {
+			c.Community = cp.SnmpCommunity
+		}
+		if cp.Username != "" {
+			// user name switches the connector to SNMPv3
+			c.USM = &SNMPUSMCredentials{
+				UserName:       cp.Username,
+				AuthProtocol:   cp.SnmpAuthProtocol,
+				AuthPassphrase: cp.Password,
+				PrivProtocol:   cp.SnmpPrivProtocol,
+				PrivPassphrase: cp.SnmpPrivPassphrase,
+			}
+		}
+	}
+	return c, nil
 }
 
 // GetStatus implements the Connector interface, namely GetStatus function, for SNMP protocol.
+// Device, which answers the request, is reported UP. Device, which answers with an error, is reported UNHEALTHY.
 func (c *SNMPConnector) GetStatus(ctx context.Context) (devicestatus.Status, error) {
 	zlogSNMP.Info().Msgf("Checking status for %s:%s via SNMP...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, NETCONF connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
-	if err != nil {
-		zlogSNMP.Error().Err(err).Msgf("Error establishing SNMP connection, reporting that device is down")
-		// failed to instantiate connection, reporting device status DOWN.
-		return devicestatus.StatusSTATUS_DEVICE_DOWN, nil
-	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetStatus(ctx, &emptypb.Empty{})
+	resp, err := c.get(ctx, oidSysUpTime, oidSysDescr)
 	if err != nil {
 		zlogSNMP.Error().Err(err).Msgf("Failed to retrieve device status for %s:%s via SNMP", c.Endpoint.Host, c.Endpoint.Port)
 		// failed to retrieve device status, returning device status DOWN and an error.
 		return devicestatus.StatusSTATUS_DEVICE_DOWN, err
 	}
-	// device status was successfully retrieved, converting it to correct notation
-	status := resp.GetStatus()
-	entStatus := server.ConvertProtoStatusToEntStatus(status)
-	// gracefully closing connection
-	err = conn.Close()
-	if err != nil {
-		zlogSNMP.Error().Err(err).Msgf("Failed to gracefully close connection")
+	// device has answered, checking whether it was able to process the request
+	if err := checkSNMPResponse(resp, 2); err != nil {
+		zlogSNMP.Warn().Err(err).Msgf("Device %s:%s is not healthy", c.Endpoint.Host, c.Endpoint.Port)
+		return devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, nil
 	}
-	return entStatus, nil
+	zlogSNMP.Debug().Msgf("Device %s:%s is up for %v ticks: %s", c.Endpoint.Host, c.Endpoint.Port,
+		resp.Variables[0].Value, snmpString(resp.Variables[1]))
+	return devicestatus.StatusSTATUS_DEVICE_UP, nil
 }
 
 // GetHWVersion implements the Connector interface, namely GetHWVersion function, for SNMP protocol.
 func (c *SNMPConnector) GetHWVersion(ctx context.Context) (string, error) {
 	zlogSNMP.Info().Msgf("Checking HW version for %s:%s via SNMP...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, NETCONF connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
-	if err != nil {
-		zlogSNMP.Error().Err(err).Msgf("Error establishing SNMP connection")
-		// failed to instantiate connection, returning error
-		return "", err
-	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetHWVersion(ctx, &emptypb.Empty{})
+	hwV, err := c.getEntityRevision(ctx, oidEntPhysicalHardwareRev)
 	if err != nil {
 		zlogSNMP.Error().Err(err).Msgf("Failed to retrieve HW version for %s:%s via SNMP", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve HW version, returning error
 		return "", err
 	}
-	// HW version was successfully retrieved, returning it
-	// and gracefully closing connection
-	err = conn.Close()
-	if err != nil {
-		zlogSNMP.Error().Err(err).Msgf("Failed to gracefully close connection")
-	}
-	return resp.GetVersion(), nil
+	return hwV, nil
 }
 
 // GetSWVersion implements the Connector interface, namely GetSWVersion function, for SNMP protocol.
+// ENTITY-MIB does not carry checksum of the version, thus it is left empty.
 func (c *SNMPConnector) GetSWVersion(ctx context.Context) (*ent.Version, error) {
 	zlogSNMP.Info().Msgf("Checking SW version for %s:%s via SNMP...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, SNMP connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
-	if err != nil {
-		zlogSNMP.Error().Err(err).Msgf("Error establishing SNMP connection")
-		// failed to instantiate connection, returning error
-		return nil, err
-	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetSWVersion(ctx, &emptypb.Empty{})
+	swV, err := c.getEntityRevision(ctx, oidEntPhysicalSoftwareRev)
 	if err != nil {
 		zlogSNMP.Error().Err(err).Msgf("Failed to retrieve SW version for %s:%s via SNMP", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve SW version, returning error
 		return nil, err
 	}
-	// SW version was successfully retrieved, returning it
-	// and gracefully closing connection
-	err = conn.Close()
-	if err != nil {
-		zlogSNMP.Error().Err(err).Msgf("Failed to gracefully close connection")
-	}
 	return &ent.Version{
-		Version:  resp.GetVersion(),
-		Checksum: resp.GetChecksum(),
+		Version: swV,
 	}, nil
 }
 
 // GetFWVersion implements the Connector interface, namely GetFWVersion function, for SNMP protocol.
+// ENTITY-MIB does not carry checksum of the version, thus it is left empty.
 func (c *SNMPConnector) GetFWVersion(ctx context.Context) (*ent.Version, error) {
 	zlogSNMP.Info().Msgf("Checking FW version for %s:%s via SNMP...\n", c.Endpoint.Host, c.Endpoint.Port)
-	// Normally, SNMP connection logic goes here, but for now, we'll stick to communication with device simulator.
-	client, conn, err := establishGRPCConnection(c.Endpoint)
+	fwV, err := c.getEntityRevision(ctx, oidEntPhysicalFirmwareRev)
 	if err != nil {
-		zlogSNMP.Error().Err(err).Msgf("Error establishing SNMP connection")
-		// failed to instantiate connection, returning error
+		zlogSNMP.Error().Err(err).Msgf("Failed to retrieve FW version for %s:%s via SNMP", c.Endpoint.Host, c.Endpoint.Port)
 		return nil, err
 	}
-	// connection was successfully established, retrieving device status
-	resp, err := client.GetFWVersion(ctx, &emptypb.Empty{})
+	return &ent.Version{
+		Version: fwV,
+	}, nil
+}
+
+// SuppliesChecksum implements the ChecksumSupplier interface.
+// ENTITY-MIB does not carry checksum of the version, it is generated by the manager.
+func (c *SNMPConnector) SuppliesChecksum() bool {
+	return false
+}
+
+// GetSnapshot implements the Connector interface, namely GetSnapshot function, for SNMP protocol.
+// Status and all versions are retrieved with a single SNMP GET request.
+func (c *SNMPConnector) GetSnapshot(ctx context.Context) (*DeviceSnapshot, error) {
+	zlogSNMP.Info().Msgf("Retrieving snapshot for %s:%s via SNMP...\n", c.Endpoint.Host, c.Endpoint.Port)
+	resp, err := c.get(ctx, oidSysUpTime, oidSysDescr, c.entityOID(oidEntPhysicalHardwareRev),
+		c.entityOID(oidEntPhysicalSoftwareRev), c.entityOID(oidEntPhysicalFirmwareRev))
 	if err != nil {
-		zlogSNMP.Error().Err(err).Msgf("Failed to retrieve FW version for %s:%s via SNMP", c.Endpoint.Host, c.Endpoint.Port)
-		// failed to retrieve FW version, returning error
+		zlogSNMP.Error().Err(err).Msgf("Failed to retrieve snapshot for %s:%s via SNMP", c.Endpoint.Host, c.Endpoint.Port)
+		// failed to retrieve device status, returning an error.
 		return nil, err
 	}
-	// FW version was successfully retrieved, returning it
-	// and gracefully closing connection
-	err = conn.Close()
+	if resp.Error == gosnmp.NoSuchName {
+		// SNMPv1 agent fails the whole request, when any of the objects is missing, retrieving them one by one
+		zlogSNMP.Debug().Msgf("Device %s:%s is missing some of the objects, falling back to separate requests",
+			c.Endpoint.Host, c.Endpoint.Port)
+		return CollectSnapshot(ctx, c)
+	}
+	if resp.Error != gosnmp.NoError || len(resp.Variables) != 5 {
+		// device has answered, but was not able to process the request
+		err = checkSNMPResponse(resp, 5)
+		zlogSNMP.Warn().Err(err).Msgf("Device %s:%s is not healthy", c.Endpoint.Host, c.Endpoint.Port)
+		snapshot := newDeviceSnapshot(devicestatus.StatusSTATUS_DEVICE_UNHEALTHY)
+		snapshot.failVersions(err)
+		return snapshot, nil
+	}
+
+	snapshot := newDeviceSnapshot(devicestatus.StatusSTATUS_DEVICE_UP)
+	for _, v := range resp.Variables[:2] {
+		if err = checkSNMPVariable(v); err != nil {
+			zlogSNMP.Warn().Err(err).Msgf("Device %s:%s is not healthy", c.Endpoint.Host, c.Endpoint.Port)
+			snapshot.Status = devicestatus.StatusSTATUS_DEVICE_UNHEALTHY
+		}
+	}
+	versions := map[SnapshotPart]gosnmp.SnmpPDU{
+		SnapshotPartHWVersion: resp.Variables[2],
+		SnapshotPartSWVersion: resp.Variables[3],
+		SnapshotPartFWVersion: resp.Variables[4],
+	}
+	for part, v := range versions {
+		if err = checkSNMPVariable(v); err != nil {
+			snapshot.Errors[part] = err
+			continue
+		}
+		switch part {
+		case SnapshotPartHWVersion:
+			snapshot.HWVersion = snmpString(v)
+		case SnapshotPartSWVersion:
+			snapshot.SWVersion.Version = snmpString(v)
+		case SnapshotPartFWVersion:
+			snapshot.FWVersion.Version = snmpString(v)
+		}
+	}
+	return snapshot, nil
+}
+
+// entityOID returns OID of the ENTITY-MIB entPhysicalTable column for the configured entity.
+func (c *SNMPConnector) entityOID(column string) string {
+	idx := c.EntityIndex
+	if idx == 0 {
+		idx = DefaultSNMPEntityIndex
+	}
+	return fmt.Sprintf("%s.%d", column, idx)
+}
+
+// getEntityRevision retrieves a revision column of the ENTITY-MIB entPhysicalTable for the configured entity.
+func (c *SNMPConnector) getEntityRevision(ctx context.Context, column string) (string, error) {
+	resp, err := c.get(ctx, c.entityOID(column))
 	if err != nil {
-		zlogSNMP.Error().Err(err).Msgf("Failed to gracefully close connection")
+		return "", err
+	}
+	if err := checkSNMPResponse(resp, 1); err != nil {
+		return "", NewError(ErrorKindProtocolError, err)
+	}
+	return snmpString(resp.Variables[0]), nil
+}
+
+// get performs a single SNMP GET request over pooled SNMP session.
+func (c *SNMPConnector) get(ctx context.Context, oids ...string) (*gosnmp.SnmpPacket, error) {
+	var resp *gosnmp.SnmpPacket
+	err := DefaultPool().Do(ctx, c.poolKey(), func(ctx context.Context) (PooledConn, error) {
+		return c.dial(ctx)
+	}, func(conn PooledConn) error {
+		s := conn.(*snmpSession)
+		// session may have been created by another connector for the same endpoint
+		s.client.Context = ctx // deadline of the context takes precedence over the timeout
+		s.client.Timeout = c.timeout()
+		s.client.Retries = c.retries()
+		var err error
+		resp, err = s.client.Get(oids)
+		return err
+	})
+	return resp, classifySNMPError(err)
+}
+
+// poolKey identifies SNMP sessions of the endpoint established with the same credentials.
+func (c *SNMPConnector) poolKey() string {
+	address := CraftServerAddressFromEndpoint(c.Endpoint)
+	if c.USM == nil {
+		return poolKey(endpoint.ProtocolPROTOCOL_SNMP, address, gosnmp.Version2c.String(), c.Community)
+	}
+	return poolKey(endpoint.ProtocolPROTOCOL_SNMP, address, gosnmp.Version3.String(), c.USM.UserName,
+		c.USM.AuthProtocol, c.USM.AuthPassphrase, c.USM.PrivProtocol, c.USM.PrivPassphrase)
+}
+
+// dial prepares SNMP client and opens UDP socket towards the endpoint.
+func (c *SNMPConnector) dial(ctx context.Context) (*snmpSession, error) {
+	client, err := c.newSNMPClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = client.Connect()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s:%s: %w", c.Endpoint.Host, c.Endpoint.Port, err)
+	}
+	return &snmpSession{client: client}, nil
+}
+
+func (c *SNMPConnector) timeout() time.Duration {
+	if c.Timeout == 0 {
+		return defaultSNMPTimeout
+	}
+	return c.Timeout
+}
+
+func (c *SNMPConnector) retries() int {
+	if c.Retries == nil {
+		return defaultSNMPRetries
+	}
+	return *c.Retries
+}
+
+// newSNMPClient prepares SNMP client for the endpoint. SNMPv3 is used, when USM credentials are provided.
+func (c *SNMPConnector) newSNMPClient(ctx context.Context) (*gosnmp.GoSNMP, error) {
+	port, err := strconv.ParseUint(c.Endpoint.Port, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SNMP port %q: %w", c.Endpoint.Port, err)
+	}
+
+	client := &gosnmp.GoSNMP{
+		Target:    c.Endpoint.Host,
+		Port:      uint16(port),
+		Transport: "udp",
+		Context:   ctx, // deadline of the context takes precedence over the timeout
+		Timeout:   c.timeout(),
+		Retries:   c.retries(),
+		MaxOids:   gosnmp.MaxOids,
+	}
+	if c.USM == nil {
+		if c.Community == "" {
+			// there is no default community to fall back to
+			return nil, NewError(ErrorKindAuthFailed, fmt.Errorf("SNMPv2c community of %s:%s is not set",
+				c.Endpoint.Host, c.Endpoint.Port))
+		}
+		client.Version = gosnmp.Version2c
+		client.Community = c.Community
+		return client, nil
+	}
+
+	usm, flags, err := c.USM.securityParameters()
+	if err != nil {
+		return nil, err
+	}
+	client.Version = gosnmp.Version3
+	client.SecurityModel = gosnmp.UserSecurityModel
+	client.MsgFlags = flags
+	client.SecurityParameters = usm
+	return client, nil
+}
+
+// Ping implements the PooledConn interface. SNMP runs over UDP, there is no connection state to verify, and
+// the device, which does not answer, is reported by the call itself.
+func (s *snmpSession) Ping(_ context.Context) error {
+	return nil
+}
+
+// Close implements the PooledConn interface, it closes UDP socket.
+func (s *snmpSession) Close() error {
+	return s.client.Conn.Close()
+}
+
+// securityParameters converts USM credentials to the SNMP library notation.
+func (u *SNMPUSMCredentials) securityParameters() (*gosnmp.UsmSecurityParameters, gosnmp.SnmpV3MsgFlags, error) {
+	authProtocol := gosnmp.NoAuth
+	if u.AuthProtocol != "" {
+		p, err := parseSNMPAuthProtocol(u.AuthProtocol)
+		if err != nil {
+			return nil, gosnmp.NoAuthNoPriv, err
+		}
+		authProtocol = p
+	}
+	privProtocol := gosnmp.NoPriv
+	if u.PrivProtocol != "" {
+		p, err := parseSNMPPrivProtocol(u.PrivProtocol)
+		if err != nil {
+			return nil, gosnmp.NoAuthNoPriv, err
+		}
+		privProtocol = p
+	}
+
+	flags := gosnmp.NoAuthNoPriv
+	if authProtocol > gosnmp.NoAuth {
+		flags = gosnmp.AuthNoPriv
+		if privProtocol > gosnmp.NoPriv {
+			flags = gosnmp.AuthPriv
+		}
+	} else if privProtocol > gosnmp.NoPriv {
+		return nil, gosnmp.NoAuthNoPriv, fmt.Errorf("SNMPv3 privacy requires authentication protocol to be set")
+	}
+
+	return &gosnmp.UsmSecurityParameters{
+		UserName:                 u.UserName,
+		AuthenticationProtocol:   authProtocol,
+		AuthenticationPassphrase: u.AuthPassphrase,
+		PrivacyProtocol:          privProtocol,
+		PrivacyPassphrase:        u.PrivPassphrase,
+	}, flags, nil
+}
+
+func parseSNMPAuthProtocol(name string) (gosnmp.SnmpV3AuthProtocol, error) {
+	for p := gosnmp.NoAuth; p <= gosnmp.SHA512; p++ {
+		if p.String() == name {
+			return p, nil
+		}
+	}
+	return gosnmp.NoAuth, fmt.Errorf("unknown SNMPv3 authentication protocol %q", name)
+}
+
+func parseSNMPPrivProtocol(name string) (gosnmp.SnmpV3PrivProtocol, error) {
+	for p := gosnmp.NoPriv; p <= gosnmp.AES256C; p++ {
+		if p.String() == name {
+			return p, nil
+		}
+	}
+	return gosnmp.NoPriv, fmt.Errorf("unknown SNMPv3 privacy protocol %q", name)
+}
+
+// classifySNMPError classifies errors of the SNMP library. SNMP runs over UDP, device, which does not answer (e.g.,
+// because of the wrong community), times out.
+func classifySNMPError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, gosnmp.ErrWrongDigest), errors.Is(err, gosnmp.ErrUnknownUsername),
+		errors.Is(err, gosnmp.ErrDecryption):
+		return NewError(ErrorKindAuthFailed, err)
+	// SNMP library reports exhausted retries as plain errors
+	case strings.Contains(err.Error(), "request timeout"), strings.Contains(err.Error(), "max retries"):
+		return NewError(ErrorKindTimeout, err)
+	default:
+		return classifyError(err)
+	}
+}
+
+// checkSNMPResponse verifies that SNMP agent has processed the request and returned all requested objects, i.e.,
+// the expected number of variables.
+func checkSNMPResponse(resp *gosnmp.SnmpPacket, expected int) error {
+	if resp.Error != gosnmp.NoError {
+		return fmt.Errorf("SNMP agent returned error %s (index %d)", resp.Error, resp.ErrorIndex)
+	}
+	if len(resp.Variables) != expected {
+		return fmt.Errorf("SNMP agent returned %d variables, expected %d", len(resp.Variables), expected)
+	}
+	for _, v := range resp.Variables {
+		if err := checkSNMPVariable(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSNMPVariable verifies that SNMP agent has returned a value of the variable.
+func checkSNMPVariable(v gosnmp.SnmpPDU) error {
+	switch v.Type {
+	case gosnmp.NoSuchObject, gosnmp.NoSuchInstance, gosnmp.EndOfMibView, gosnmp.Null:
+		return fmt.Errorf("SNMP agent has no value for %s", v.Name)
+	default:
+		return nil
+	}
+}
+
+// snmpString converts OCTET STRING variable to string.
+func snmpString(v gosnmp.SnmpPDU) string {
+	switch value := v.Value.(type) {
+	case []byte:
+		return string(value)
+	case string:
+		return value
+	default:
+		return fmt.Sprintf("%v", value)
 	}
-	return &ent.Version{
-		Version:  resp.GetVersion(),
-		Checksum: resp.GetChecksum(),
-	}, nil
 }
@@ -0,0 +1,313 @@
+// Package connectors_test implements unit tests for protocol connectors.
+package connectors_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+	simulatorv1 "github.com/eroshiva/trade-show-poc/pkg/mocks"
+	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
+	"github.com/gosnmp/gosnmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	snmpHost       = "localhost"
+	snmpV2cPort    = "50361"
+	snmpV3Port     = "50362"
+	snmpV3NoPriv   = "50363"
+	snmpShortPort  = "50364"
+	testHWModel    = "HW-TEST"
+	testSWVersion  = "2.3.4"
+	testFWVersion  = "0.5.6"
+	snmpUser       = "monitor"
+	snmpAuthPass   = "auth-passphrase"
+	snmpPrivPass   = "priv-passphrase"
+	snmpCommunity  = "lab-community"
+	snmpBootupTime = 100 * time.Millisecond
+)
+
+func startSNMPAgent(t *testing.T, port string) {
+	t.Helper()
+	t.Setenv(simulatorv1.EnvSNMPAgentAddress, connectors.CraftServerAddress(snmpHost, port))
+	agent := simulatorv1.NewSNMPAgent()
+	agent.StartSNMPAgent()
+	t.Cleanup(agent.StopSNMPAgent)
+	time.Sleep(snmpBootupTime) // giving some time for the agent to bootup
+}
+
+func setDeviceVersions(t *testing.T) {
+	t.Helper()
+	t.Setenv(simulatorv1.EnvHWModel, testHWModel)
+	t.Setenv(simulatorv1.EnvSWVersion, testSWVersion)
+	t.Setenv(simulatorv1.EnvFWVersion, testFWVersion)
+}
+
+func snmpEndpoint(port string) *ent.Endpoint {
+	return &ent.Endpoint{
+		Host:     snmpHost,
+		Port:     port,
+		Protocol: endpoint.ProtocolPROTOCOL_SNMP,
+	}
+}
+
+func assertSNMPConnector(t *testing.T, c connectors.Connector) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, status)
+
+	hwV, err := c.GetHWVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, testHWModel, hwV)
+
+	swV, err := c.GetSWVersion(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, swV)
+	assert.Equal(t, testSWVersion, swV.Version)
+	assert.Empty(t, swV.Checksum)
+
+	fwV, err := c.GetFWVersion(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, fwV)
+	assert.Equal(t, testFWVersion, fwV.Version)
+	assert.Empty(t, fwV.Checksum)
+}
+
+func TestSNMPConnectorV2c(t *testing.T) {
+	setDeviceVersions(t)
+	t.Setenv(simulatorv1.EnvSNMPCommunity, snmpCommunity)
+	startSNMPAgent(t, snmpV2cPort)
+
+	c := &connectors.SNMPConnector{
+		Endpoint:  snmpEndpoint(snmpV2cPort),
+		Community: snmpCommunity,
+	}
+	assertSNMPConnector(t, c)
+	assertSnapshot(t, c)
+
+	// device reports that it is not healthy
+	t.Setenv(simulatorv1.EnvDeviceStatus, simulatorv1.DeviceStatusUNHEALTHY)
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, status)
+	_, err = c.GetHWVersion(ctx)
+	require.Error(t, err)
+	assertSnapshotStatus(t, c, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY)
+
+	// device is unreachable, connector should report an error
+	t.Setenv(simulatorv1.EnvDeviceStatus, simulatorv1.DeviceStatusDOWN)
+	ctx2, cancel2 := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout/2)
+	defer cancel2()
+	status, err = c.GetStatus(ctx2)
+	require.Error(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	assertSnapshotUnreachable(t, c)
+}
+
+func TestSNMPConnectorSnapshotMissingEntity(t *testing.T) {
+	setDeviceVersions(t)
+	t.Setenv(simulatorv1.EnvSNMPCommunity, snmpCommunity)
+	startSNMPAgent(t, snmpV2cPort)
+
+	// agent does not know the entity, device is UP, but versions are not reported
+	c := &connectors.SNMPConnector{
+		Endpoint:    snmpEndpoint(snmpV2cPort),
+		Community:   snmpCommunity,
+		EntityIndex: 7,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+	snapshot, err := c.GetSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, snapshot.Status)
+	assert.Empty(t, snapshot.HWVersion)
+	assert.Empty(t, snapshot.SWVersion.Version)
+	assert.Empty(t, snapshot.FWVersion.Version)
+	for _, part := range []connectors.SnapshotPart{
+		connectors.SnapshotPartHWVersion,
+		connectors.SnapshotPartSWVersion,
+		connectors.SnapshotPartFWVersion,
+	} {
+		assert.Error(t, snapshot.Errors[part])
+	}
+}
+
+func TestSNMPConnectorV2cWrongCommunity(t *testing.T) {
+	t.Setenv(simulatorv1.EnvSNMPCommunity, snmpCommunity)
+	startSNMPAgent(t, snmpV2cPort)
+
+	// agent should silently drop the request with the wrong community
+	c := &connectors.SNMPConnector{
+		Endpoint:  snmpEndpoint(snmpV2cPort),
+		Community: "wrong-community",
+		Timeout:   100 * time.Millisecond,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+	status, err := c.GetStatus(ctx)
+	require.ErrorIs(t, err, connectors.ErrTimeout)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+
+	// fail - community is not set, there is no default to fall back to
+	c = &connectors.SNMPConnector{
+		Endpoint: snmpEndpoint(snmpV2cPort),
+	}
+	status, err = c.GetStatus(ctx)
+	require.ErrorIs(t, err, connectors.ErrAuthFailed)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	_, err = c.GetSnapshot(ctx)
+	require.ErrorIs(t, err, connectors.ErrAuthFailed)
+}
+
+func TestSNMPConnectorV3AuthPriv(t *testing.T) {
+	setDeviceVersions(t)
+	t.Setenv(simulatorv1.EnvSNMPUserName, snmpUser)
+	t.Setenv(simulatorv1.EnvSNMPAuthProtocol, "SHA256")
+	t.Setenv(simulatorv1.EnvSNMPAuthPassphrase, snmpAuthPass)
+	t.Setenv(simulatorv1.EnvSNMPPrivProtocol, "AES")
+	t.Setenv(simulatorv1.EnvSNMPPrivPassphrase, snmpPrivPass)
+	startSNMPAgent(t, snmpV3Port)
+
+	c := &connectors.SNMPConnector{
+		Endpoint: snmpEndpoint(snmpV3Port),
+		USM: &connectors.SNMPUSMCredentials{
+			UserName:       snmpUser,
+			AuthProtocol:   "SHA256",
+			AuthPassphrase: snmpAuthPass,
+			PrivProtocol:   "AES",
+			PrivPassphrase: snmpPrivPass,
+		},
+	}
+	assertSNMPConnector(t, c)
+	assertSnapshot(t, c)
+
+	// wrong passphrase, agent should not accept the request
+	wrong := &connectors.SNMPConnector{
+		Endpoint: snmpEndpoint(snmpV3Port),
+		USM: &connectors.SNMPUSMCredentials{
+			UserName:       snmpUser,
+			AuthProtocol:   "SHA256",
+			AuthPassphrase: "wrong-passphrase",
+			PrivProtocol:   "AES",
+			PrivPassphrase: snmpPrivPass,
+		},
+		Timeout: 100 * time.Millisecond,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+	_, err := wrong.GetStatus(ctx)
+	require.Error(t, err)
+
+	// unknown protocol
+	unknown := &connectors.SNMPConnector{
+		Endpoint: snmpEndpoint(snmpV3Port),
+		USM: &connectors.SNMPUSMCredentials{
+			UserName:     snmpUser,
+			AuthProtocol: "SHA3",
+		},
+	}
+	_, err = unknown.GetHWVersion(ctx)
+	require.Error(t, err)
+}
+
+func TestSNMPConnectorV3AuthNoPriv(t *testing.T) {
+	setDeviceVersions(t)
+	t.Setenv(simulatorv1.EnvSNMPUserName, snmpUser)
+	t.Setenv(simulatorv1.EnvSNMPAuthProtocol, "MD5")
+	t.Setenv(simulatorv1.EnvSNMPAuthPassphrase, snmpAuthPass)
+	startSNMPAgent(t, snmpV3NoPriv)
+
+	c := &connectors.SNMPConnector{
+		Endpoint: snmpEndpoint(snmpV3NoPriv),
+		USM: &connectors.SNMPUSMCredentials{
+			UserName:       snmpUser,
+			AuthProtocol:   "MD5",
+			AuthPassphrase: snmpAuthPass,
+		},
+	}
+	assertSNMPConnector(t, c)
+	assertSnapshot(t, c)
+}
+
+func TestNewConnectorSNMP(t *testing.T) {
+	setDeviceVersions(t)
+	t.Setenv(simulatorv1.EnvSNMPCommunity, snmpCommunity)
+	startSNMPAgent(t, snmpV2cPort)
+
+	// connector created by the factory talks SNMPv2c with the community of the credentials
+	c, err := connectors.NewConnector(snmpEndpoint(snmpV2cPort),
+		connectors.WithCredentials(&ent.CredentialProfile{SnmpCommunity: snmpCommunity}))
+	require.NoError(t, err)
+	assertSNMPConnector(t, c)
+	assertSnapshot(t, c)
+}
+
+// startShortSNMPAgent starts SNMP agent, which answers every request with the first of the requested variables only.
+func startShortSNMPAgent(t *testing.T, port string) {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", connectors.CraftServerAddress(snmpHost, port))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	go func() {
+		buf := make([]byte, 65535)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			req, err := (&gosnmp.GoSNMP{Version: gosnmp.Version2c}).SnmpDecodePacket(buf[:n])
+			if err != nil || len(req.Variables) == 0 {
+				continue
+			}
+			resp := &gosnmp.SnmpPacket{
+				Version:   req.Version,
+				Community: req.Community,
+				PDUType:   gosnmp.GetResponse,
+				RequestID: req.RequestID,
+				Variables: []gosnmp.SnmpPDU{{Name: req.Variables[0].Name, Type: gosnmp.TimeTicks, Value: uint32(42)}},
+			}
+			out, err := resp.MarshalMsg()
+			if err != nil {
+				continue
+			}
+			_, _ = conn.WriteTo(out, addr)
+		}
+	}()
+}
+
+func TestSNMPConnectorShortResponse(t *testing.T) {
+	startShortSNMPAgent(t, snmpShortPort)
+
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+	noRetries := 0
+	c := &connectors.SNMPConnector{
+		Endpoint:  snmpEndpoint(snmpShortPort),
+		Community: snmpCommunity,
+		Retries:   &noRetries,
+	}
+	// agent has answered, but not with all requested variables
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, status)
+
+	snapshot, err := c.GetSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UNHEALTHY, snapshot.Status)
+	assert.Len(t, snapshot.Errors, 3)
+}
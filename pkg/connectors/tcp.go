@@ -0,0 +1,103 @@
+// Package connectors implements common interface for all devices and carries individual implementations of each protocol.
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/rs/zerolog"
+)
+
+const (
+	componentNameTCPConnect  = "tcp-connect-connector"
+	defaultTCPConnectTimeout = 2 * time.Second
+)
+
+var zlogTCP = zerolog.New(zerolog.ConsoleWriter{
+	Out:        os.Stderr,
+	TimeFormat: time.RFC3339,
+	FormatCaller: func(i interface{}) string {
+		return filepath.Dir(fmt.Sprintf("%s/", i))
+	},
+}).Level(zerolog.TraceLevel).With().Caller().Timestamp().Str(component, componentNameTCPConnect).Logger()
+
+// TCPConnectConnector is a reachability probe for the devices, which don't offer any management protocol. It opens
+// TCP connection to the endpoint and closes it right away. It reports status only.
+type TCPConnectConnector struct {
+	Endpoint *ent.Endpoint
+	Timeout  time.Duration
+}
+
+func init() {
+	Register(string(endpoint.ProtocolPROTOCOL_TCP_CONNECT), newTCPConnectConnector)
+}
+
+// newTCPConnectConnector is a factory of the TCP connect connector. It doesn't accept any parameters.
+func newTCPConnectConnector(ep *ent.Endpoint, opts Options) (Connector, error) {
+	return &TCPConnectConnector{
+		Endpoint: ep,
+		Timeout:  opts.Timeout,
+	}, nil
+}
+
+// GetStatus implements the Connector interface, namely GetStatus function, for TCP connect probe.
+// Device accepting the connection is reported UP.
+func (c *TCPConnectConnector) GetStatus(ctx context.Context) (devicestatus.Status, error) {
+	zlogTCP.Info().Msgf("Checking status for %s:%s via TCP connect...\n", c.Endpoint.Host, c.Endpoint.Port)
+	err := c.connect(ctx)
+	if err != nil {
+		zlogTCP.Error().Err(err).Msgf("Failed to connect to %s:%s", c.Endpoint.Host, c.Endpoint.Port)
+		// failed to connect, returning device status DOWN and an error.
+		return devicestatus.StatusSTATUS_DEVICE_DOWN, err
+	}
+	return devicestatus.StatusSTATUS_DEVICE_UP, nil
+}
+
+// GetHWVersion implements the Connector interface, namely GetHWVersion function, for TCP connect probe.
+// TCP connect probe does not report any version.
+func (c *TCPConnectConnector) GetHWVersion(_ context.Context) (string, error) {
+	return "", fmt.Errorf("HW version is %w by TCP connect probe", ErrUnsupported)
+}
+
+// GetSWVersion implements the Connector interface, namely GetSWVersion function, for TCP connect probe.
+// TCP connect probe does not report any version.
+func (c *TCPConnectConnector) GetSWVersion(_ context.Context) (*ent.Version, error) {
+	return nil, fmt.Errorf("SW version is %w by TCP connect probe", ErrUnsupported)
+}
+
+// GetFWVersion implements the Connector interface, namely GetFWVersion function, for TCP connect probe.
+// TCP connect probe does not report any version.
+func (c *TCPConnectConnector) GetFWVersion(_ context.Context) (*ent.Version, error) {
+	return nil, fmt.Errorf("FW version is %w by TCP connect probe", ErrUnsupported)
+}
+
+// GetSnapshot implements the Connector interface, namely GetSnapshot function, for TCP connect probe.
+// Snapshot carries status only, all versions are reported as not supported.
+func (c *TCPConnectConnector) GetSnapshot(ctx context.Context) (*DeviceSnapshot, error) {
+	return CollectSnapshot(ctx, c)
+}
+
+// connect opens TCP connection to the endpoint and closes it right away. Connection is never pooled, establishing it
+// is the probe itself.
+func (c *TCPConnectConnector) connect(ctx context.Context) error {
+	dialer := &net.Dialer{Deadline: operationDeadline(ctx, c.timeout())}
+	conn, err := dialer.DialContext(ctx, "tcp", CraftServerAddressFromEndpoint(c.Endpoint))
+	if err != nil {
+		return classifyError(fmt.Errorf("failed to connect to %s:%s: %w", c.Endpoint.Host, c.Endpoint.Port, err))
+	}
+	return conn.Close()
+}
+
+func (c *TCPConnectConnector) timeout() time.Duration {
+	if c.Timeout == 0 {
+		return defaultTCPConnectTimeout
+	}
+	return c.Timeout
+}
@@ -0,0 +1,67 @@
+// Package connectors_test implements unit tests for protocol connectors.
+package connectors_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/devicestatus"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tcpConnectHost = "localhost"
+
+// assertStatusOnlySnapshot verifies that the connector reports status only and all versions as not supported.
+func assertStatusOnlySnapshot(t *testing.T, c connectors.Connector) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+
+	_, err := c.GetHWVersion(ctx)
+	require.ErrorIs(t, err, connectors.ErrUnsupported)
+	_, err = c.GetSWVersion(ctx)
+	require.ErrorIs(t, err, connectors.ErrUnsupported)
+	_, err = c.GetFWVersion(ctx)
+	require.ErrorIs(t, err, connectors.ErrUnsupported)
+
+	snapshot, err := c.GetSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, snapshot.Status)
+	assert.Empty(t, snapshot.HWVersion)
+	assert.Empty(t, snapshot.SWVersion.Version)
+	assert.Empty(t, snapshot.FWVersion.Version)
+	for _, part := range []connectors.SnapshotPart{connectors.SnapshotPartHWVersion, connectors.SnapshotPartSWVersion,
+		connectors.SnapshotPartFWVersion} {
+		assert.ErrorIs(t, snapshot.Errors[part], connectors.ErrUnsupported)
+	}
+}
+
+func TestTCPConnectConnector(t *testing.T) {
+	lis, err := net.Listen("tcp", connectors.CraftServerAddress(tcpConnectHost, "0"))
+	require.NoError(t, err)
+	_, port, err := net.SplitHostPort(lis.Addr().String())
+	require.NoError(t, err)
+	ep := &ent.Endpoint{Host: tcpConnectHost, Port: port, Protocol: endpoint.ProtocolPROTOCOL_TCP_CONNECT}
+
+	c, err := connectors.NewConnector(ep)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+	defer cancel()
+	status, err := c.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_UP, status)
+	assertStatusOnlySnapshot(t, c)
+
+	// nothing listens on the port anymore
+	require.NoError(t, lis.Close())
+	status, err = c.GetStatus(ctx)
+	require.ErrorIs(t, err, connectors.ErrUnreachable)
+	assert.Equal(t, devicestatus.StatusSTATUS_DEVICE_DOWN, status)
+	assertSnapshotUnreachable(t, c)
+}
@@ -0,0 +1,93 @@
+// Package connectors implements common interface for all devices and carries individual implementations of each protocol.
+package connectors
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+)
+
+// TLSSettings carries TLS settings of the connection to the device. They apply to gNMI (gRPC), RESTCONF (HTTPS)
+// and OVSDB transports.
+type TLSSettings struct {
+	// CABundle is a PEM encoded bundle of CA certificates, which device certificate is verified against.
+	// System roots are used, when it is empty.
+	CABundle []byte
+	// ClientCertificate and ClientKey are PEM encoded certificate and private key presented to the device (mTLS).
+	ClientCertificate []byte
+	ClientKey         []byte
+	// ServerName overrides the name, which device certificate is verified against. Host of the endpoint is used
+	// by default.
+	ServerName string
+	// InsecureSkipVerify disables verification of the device certificate. Meant only for labs.
+	InsecureSkipVerify bool
+}
+
+// WithTLS sets TLS settings of the connection to the device. They take precedence over the ones of the endpoint.
+func WithTLS(settings *TLSSettings) Option {
+	return func(o *Options) {
+		o.TLS = settings
+	}
+}
+
+// TLSSettingsFromEndpoint derives TLS settings from the endpoint, client certificate is taken from the credentials.
+// It returns nil, when TLS is not enabled on the endpoint.
+func TLSSettingsFromEndpoint(ep *ent.Endpoint, cp *ent.CredentialProfile) *TLSSettings {
+	if !ep.TLSEnabled {
+		return nil
+	}
+	settings := &TLSSettings{
+		CABundle:           []byte(ep.TLSCaBundle),
+		ServerName:         ep.TLSServerName,
+		InsecureSkipVerify: ep.TLSInsecureSkipVerify,
+	}
+	if cp != nil {
+		settings.ClientCertificate = []byte(cp.TLSClientCertificate)
+		settings.ClientKey = []byte(cp.TLSClientKey)
+	}
+	return settings
+}
+
+// Config builds TLS client configuration. Device certificate is verified against the host, unless ServerName is set.
+func (s *TLSSettings) Config(host string) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         host,
+		InsecureSkipVerify: s.InsecureSkipVerify, //nolint:gosec // explicitly requested for labs
+	}
+	if s.ServerName != "" {
+		cfg.ServerName = s.ServerName
+	}
+	if len(s.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(s.CABundle) {
+			return nil, fmt.Errorf("CA bundle does not contain any PEM encoded certificate")
+		}
+		cfg.RootCAs = pool
+	}
+	if len(s.ClientCertificate) > 0 || len(s.ClientKey) > 0 {
+		cert, err := tls.X509KeyPair(s.ClientCertificate, s.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// poolKey fingerprints the settings, so that connections established with different TLS settings are never shared.
+// It is empty for plain text connections.
+func (s *TLSSettings) poolKey() string {
+	if s == nil {
+		return ""
+	}
+	h := sha256.New()
+	for _, part := range [][]byte{s.CABundle, s.ClientCertificate, s.ClientKey, []byte(s.ServerName)} {
+		h.Write(part)
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("tls:%t:%x", s.InsecureSkipVerify, h.Sum(nil)[:8])
+}
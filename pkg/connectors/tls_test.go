@@ -0,0 +1,274 @@
+// Package connectors_test implements unit tests for protocol connectors.
+package connectors_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/ent/endpoint"
+	"github.com/eroshiva/trade-show-poc/pkg/connectors"
+	simulatorv1 "github.com/eroshiva/trade-show-poc/pkg/mocks"
+	monitoring_testing "github.com/eroshiva/trade-show-poc/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// tlsServerName is the only name in the server certificate, it differs from the host of the endpoints,
+	// so the name has to be overridden.
+	tlsServerName   = "device.monitoring.test"
+	restconfTLSPort = "50382"
+)
+
+// testPKI carries PEM encoded certificates and keys generated on the fly.
+type testPKI struct {
+	caCert     []byte
+	serverCert []byte
+	serverKey  []byte
+	clientCert []byte
+	clientKey  []byte
+}
+
+// newTestPKI generates CA, server certificate for tlsServerName and client certificate, both signed by the CA.
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "monitoring test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	issue := func(serial int64, template *x509.Certificate) ([]byte, []byte) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template.SerialNumber = big.NewInt(serial)
+		template.NotBefore = ca.NotBefore
+		template.NotAfter = ca.NotAfter
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+			pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	}
+
+	pki := &testPKI{
+		caCert: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+	}
+	pki.serverCert, pki.serverKey = issue(2, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: tlsServerName},
+		DNSNames:    []string{tlsServerName},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	pki.clientCert, pki.clientKey = issue(3, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "monitoring"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return pki
+}
+
+// configureSimulator makes simulators, which are started afterward, serve TLS and require client certificate.
+func (p *testPKI) configureSimulator(t *testing.T) {
+	t.Helper()
+	dir := t.TempDir()
+	for env, content := range map[string][]byte{
+		simulatorv1.EnvTLSCertFile:     p.serverCert,
+		simulatorv1.EnvTLSKeyFile:      p.serverKey,
+		simulatorv1.EnvTLSClientCAFile: p.caCert,
+	} {
+		path := filepath.Join(dir, env)
+		require.NoError(t, os.WriteFile(path, content, 0o600))
+		t.Setenv(env, path)
+	}
+}
+
+// mutualTLS returns settings, which pass verification by both sides.
+func (p *testPKI) mutualTLS() *connectors.TLSSettings {
+	return &connectors.TLSSettings{
+		CABundle:          p.caCert,
+		ClientCertificate: p.clientCert,
+		ClientKey:         p.clientKey,
+		ServerName:        tlsServerName,
+	}
+}
+
+// assertTLSConnector verifies that the connector talks to the device only with valid TLS settings.
+func assertTLSConnector(t *testing.T, pki *testPKI, ep *ent.Endpoint) {
+	t.Helper()
+	otherPKI := newTestPKI(t)
+	for _, tc := range []struct {
+		name     string
+		settings *connectors.TLSSettings
+		valid    bool
+		// untrusted is set, when the device certificate fails the verification
+		untrusted bool
+	}{
+		{name: "mutual TLS", settings: pki.mutualTLS(), valid: true},
+		{name: "skip verify", settings: &connectors.TLSSettings{
+			ClientCertificate:  pki.clientCert,
+			ClientKey:          pki.clientKey,
+			InsecureSkipVerify: true,
+		}, valid: true},
+		{name: "plain text"},
+		{name: "no client certificate", settings: &connectors.TLSSettings{
+			CABundle:   pki.caCert,
+			ServerName: tlsServerName,
+		}},
+		{name: "no server name override", settings: &connectors.TLSSettings{
+			CABundle:          pki.caCert,
+			ClientCertificate: pki.clientCert,
+			ClientKey:         pki.clientKey,
+		}, untrusted: true},
+		{name: "untrusted CA", settings: &connectors.TLSSettings{
+			CABundle:          otherPKI.caCert,
+			ClientCertificate: pki.clientCert,
+			ClientKey:         pki.clientKey,
+			ServerName:        tlsServerName,
+		}, untrusted: true},
+		{name: "untrusted client certificate", settings: &connectors.TLSSettings{
+			CABundle:          pki.caCert,
+			ClientCertificate: otherPKI.clientCert,
+			ClientKey:         otherPKI.clientKey,
+			ServerName:        tlsServerName,
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := connectors.NewConnector(ep, connectors.WithTLS(tc.settings))
+			require.NoError(t, err)
+			if tc.valid {
+				assertSnapshot(t, c)
+				return
+			}
+			if tc.untrusted {
+				ctx, cancel := context.WithTimeout(context.Background(), monitoring_testing.DefaultTestTimeout)
+				defer cancel()
+				_, err = c.GetSnapshot(ctx)
+				require.ErrorIs(t, err, connectors.ErrUntrusted)
+				return
+			}
+			assertSnapshotUnreachable(t, c)
+		})
+	}
+
+	// TLS settings are taken from the endpoint and client certificate from the credentials
+	tlsEp := *ep
+	tlsEp.TLSEnabled = true
+	tlsEp.TLSCaBundle = string(pki.caCert)
+	tlsEp.TLSServerName = tlsServerName
+	c, err := connectors.NewConnector(&tlsEp, connectors.WithCredentials(&ent.CredentialProfile{
+		TLSClientCertificate: string(pki.clientCert),
+		TLSClientKey:         string(pki.clientKey),
+	}))
+	require.NoError(t, err)
+	assertSnapshot(t, c)
+}
+
+func TestTLSSettingsConfig(t *testing.T) {
+	pki := newTestPKI(t)
+
+	cfg, err := pki.mutualTLS().Config("localhost")
+	require.NoError(t, err)
+	assert.Equal(t, tlsServerName, cfg.ServerName)
+	assert.NotNil(t, cfg.RootCAs)
+	assert.Len(t, cfg.Certificates, 1)
+	assert.False(t, cfg.InsecureSkipVerify)
+
+	// host is verified by default, system roots are used
+	cfg, err = (&connectors.TLSSettings{}).Config("localhost")
+	require.NoError(t, err)
+	assert.Equal(t, "localhost", cfg.ServerName)
+	assert.Nil(t, cfg.RootCAs)
+	assert.Empty(t, cfg.Certificates)
+
+	// fail - CA bundle does not carry a certificate
+	_, err = (&connectors.TLSSettings{CABundle: []byte("not a certificate")}).Config("localhost")
+	require.Error(t, err)
+
+	// fail - client certificate without private key
+	_, err = (&connectors.TLSSettings{ClientCertificate: pki.clientCert}).Config("localhost")
+	require.Error(t, err)
+
+	// fail - private key does not match the certificate
+	_, err = (&connectors.TLSSettings{ClientCertificate: pki.clientCert, ClientKey: pki.serverKey}).Config("localhost")
+	require.Error(t, err)
+}
+
+func TestTLSSettingsFromEndpoint(t *testing.T) {
+	ep := &ent.Endpoint{Host: "localhost", Port: "1", Protocol: endpoint.ProtocolPROTOCOL_GNMI}
+	assert.Nil(t, connectors.TLSSettingsFromEndpoint(ep, &ent.CredentialProfile{TLSClientKey: "key"}))
+
+	ep.TLSEnabled = true
+	ep.TLSServerName = tlsServerName
+	ep.TLSInsecureSkipVerify = true
+	assert.Equal(t, &connectors.TLSSettings{
+		CABundle:           []byte{},
+		ServerName:         tlsServerName,
+		InsecureSkipVerify: true,
+	}, connectors.TLSSettingsFromEndpoint(ep, nil))
+
+	settings := connectors.TLSSettingsFromEndpoint(ep, &ent.CredentialProfile{
+		TLSClientCertificate: "certificate",
+		TLSClientKey:         "key",
+	})
+	require.NotNil(t, settings)
+	assert.Equal(t, []byte("certificate"), settings.ClientCertificate)
+	assert.Equal(t, []byte("key"), settings.ClientKey)
+}
+
+func TestGNMIConnectorTLS(t *testing.T) {
+	setDeviceVersions(t)
+	pki := newTestPKI(t)
+	pki.configureSimulator(t)
+	startGNMIServer(t)
+
+	assertTLSConnector(t, pki, gnmiEndpoint())
+}
+
+func TestRESTCONFConnectorTLS(t *testing.T) {
+	setDeviceVersions(t)
+	pki := newTestPKI(t)
+	pki.configureSimulator(t)
+	t.Setenv(simulatorv1.EnvRESTCONFServerAddress, net.JoinHostPort("localhost", restconfTLSPort))
+	srv := simulatorv1.NewRESTCONFServer()
+	srv.StartRESTCONFServer()
+	t.Cleanup(srv.StopRESTCONFServer)
+	time.Sleep(100 * time.Millisecond) // giving some time for the server to bootup
+
+	assertTLSConnector(t, pki, &ent.Endpoint{
+		Host:     "localhost",
+		Port:     restconfTLSPort,
+		Protocol: endpoint.ProtocolPROTOCOL_RESTCONF,
+	})
+}
+
+func TestOVSConnectorTLS(t *testing.T) {
+	setDeviceVersions(t)
+	pki := newTestPKI(t)
+	pki.configureSimulator(t)
+	startOVSDBServer(t, connectors.CraftServerAddress(ovsdbHost, ovsdbPort))
+
+	assertTLSConnector(t, pki, ovsdbEndpoint(ovsdbHost, ovsdbPort))
+}
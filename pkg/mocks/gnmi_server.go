@@ -0,0 +1,216 @@
+// Package simulatorv1 implements network device simulator means.
+package simulatorv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	apiv1 "github.com/eroshiva/trade-show-poc/api/v1"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	componentNameGNMI = "gnmi-server-simulator"
+
+	// EnvGNMIServerAddress constant specifies name of the environmental variable for gNMI server address.
+	EnvGNMIServerAddress     = "DEVICE_SIMULATOR_GNMI_SERVER_ADDRESS" // must be in form address:port, e.g., localhost:50339.
+	defaultGNMIServerAddress = "localhost:50339"
+	// EnvGNMIUsername constant specifies name of the environmental variable for gNMI user name. Authentication is not
+	// required, unless user name is set.
+	EnvGNMIUsername = "DEVICE_SIMULATOR_GNMI_USERNAME"
+	// EnvGNMIPassword constant specifies name of the environmental variable for gNMI password.
+	EnvGNMIPassword = "DEVICE_SIMULATOR_GNMI_PASSWORD"
+
+	gnmiVersion = "0.10.0"
+)
+
+var zlogGNMI = zerolog.New(zerolog.ConsoleWriter{
+	Out:        os.Stderr,
+	TimeFormat: time.RFC3339,
+	FormatCaller: func(i interface{}) string {
+		return filepath.Dir(fmt.Sprintf("%s/", i))
+	},
+}).Level(zerolog.TraceLevel).With().Caller().Timestamp().Str(component, componentNameGNMI).Logger()
+
+// GNMIServer is an in-process gNMI server stand-in. It serves Capabilities and Get requests on OpenConfig
+// /system/state and /components paths with the data reported by the gRPC Network Device Simulator.
+type GNMIServer struct {
+	simulator *grpc.Server
+}
+
+// gnmiServer implements the gnmi.GNMIServer interface.
+type gnmiServer struct {
+	gnmi.UnimplementedGNMIServer
+	startTime time.Time
+}
+
+// NewGNMIServer is a factory function that creates a gNMI server simulator structure. Server serves TLS,
+// when it is configured in the environment.
+func NewGNMIServer() *GNMIServer {
+	opts := []grpc.ServerOption{grpc.UnaryInterceptor(gnmiAuthInterceptor)}
+	tlsConfig, err := readTLSConfig()
+	if err != nil {
+		zlogGNMI.Fatal().Err(err).Msg("failed to read TLS configuration")
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	return &GNMIServer{
+		simulator: grpc.NewServer(opts...),
+	}
+}
+
+// StartGNMIServer function starts gNMI server simulator.
+func (s *GNMIServer) StartGNMIServer() {
+	serverAddress := readServerAddress(EnvGNMIServerAddress, defaultGNMIServerAddress)
+
+	lis, err := net.Listen(tcpNetwork, serverAddress)
+	if err != nil {
+		zlogGNMI.Fatal().Err(err).Msg("failed to listen")
+	}
+	gnmi.RegisterGNMIServer(s.simulator, &gnmiServer{startTime: time.Now()})
+	go func() {
+		zlogGNMI.Info().Msgf("gNMI Server Simulator listening on %s", serverAddress)
+		if err := s.simulator.Serve(lis); err != nil {
+			zlogGNMI.Fatal().Err(err).Msgf("failed to serve")
+		}
+	}()
+}
+
+// StopGNMIServer stops gNMI server simulator.
+func (s *GNMIServer) StopGNMIServer() {
+	zlogGNMI.Info().Msg("Gracefully stopping gNMI Server Simulator")
+	s.simulator.Stop()
+}
+
+// gnmiAuthInterceptor verifies credentials carried in gRPC metadata and rejects all requests of the device,
+// which is down.
+func gnmiAuthInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if readDeviceStatus() == apiv1.Status_STATUS_DEVICE_DOWN {
+		zlogGNMI.Info().Msg("Device status is down, returning an error")
+		return nil, status.Error(codes.Unavailable, "device is unreachable")
+	}
+	username := os.Getenv(EnvGNMIUsername)
+	if username != "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if firstMetadataValue(md.Get("username")) != username || firstMetadataValue(md.Get("password")) != os.Getenv(EnvGNMIPassword) {
+			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+		}
+	}
+	return handler(ctx, req)
+}
+
+func firstMetadataValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Capabilities reports supported OpenConfig models and encodings.
+func (s *gnmiServer) Capabilities(_ context.Context, _ *gnmi.CapabilityRequest) (*gnmi.CapabilityResponse, error) {
+	zlogGNMI.Info().Msg("Received Capabilities request")
+	return &gnmi.CapabilityResponse{
+		SupportedModels: []*gnmi.ModelData{
+			{Name: "openconfig-system", Organization: "OpenConfig working group", Version: "2.3.0"},
+			{Name: "openconfig-platform", Organization: "OpenConfig working group", Version: "0.30.0"},
+		},
+		SupportedEncodings: []gnmi.Encoding{gnmi.Encoding_JSON, gnmi.Encoding_JSON_IETF},
+		GNMIVersion:        gnmiVersion,
+	}, nil
+}
+
+// Get serves /system/state and /components paths. Chassis of the unhealthy device is inactive.
+func (s *gnmiServer) Get(_ context.Context, req *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+	zlogGNMI.Info().Msg("Received Get request")
+	encoding := req.GetEncoding()
+	if encoding != gnmi.Encoding_JSON && encoding != gnmi.Encoding_JSON_IETF {
+		return nil, status.Errorf(codes.Unimplemented, "unsupported encoding %s", encoding)
+	}
+	notification := &gnmi.Notification{
+		Timestamp: time.Now().UnixNano(),
+		Prefix:    req.GetPrefix(),
+	}
+	for _, path := range req.GetPath() {
+		elems := make([]string, 0)
+		for _, elem := range req.GetPrefix().GetElem() {
+			elems = append(elems, elem.GetName())
+		}
+		for _, elem := range path.GetElem() {
+			elems = append(elems, elem.GetName())
+		}
+		var value map[string]interface{}
+		switch strings.Join(elems, "/") {
+		case "system/state":
+			value = s.systemState(encoding == gnmi.Encoding_JSON_IETF)
+		case "components":
+			value = components(readDeviceStatus(), encoding == gnmi.Encoding_JSON_IETF)
+		default:
+			return nil, status.Errorf(codes.NotFound, "path /%s is not supported", strings.Join(elems, "/"))
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		val := &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: raw}}
+		if encoding == gnmi.Encoding_JSON_IETF {
+			val = &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: raw}}
+		}
+		notification.Update = append(notification.Update, &gnmi.Update{Path: path, Val: val})
+	}
+	return &gnmi.GetResponse{Notification: []*gnmi.Notification{notification}}, nil
+}
+
+func (s *gnmiServer) systemState(ietf bool) map[string]interface{} {
+	return map[string]interface{}{
+		qualify(ietf, "openconfig-system", "hostname"):         "simulator",
+		qualify(ietf, "openconfig-system", "software-version"): readSWVersion(),
+		qualify(ietf, "openconfig-system", "current-datetime"): time.Now().Format(time.RFC3339),
+		// uint64 is encoded as a string in JSON_IETF
+		qualify(ietf, "openconfig-system", "boot-time"): fmt.Sprint(s.startTime.UnixNano()),
+	}
+}
+
+// components reports single chassis component, which carries device versions.
+func components(deviceStatus apiv1.Status, ietf bool) map[string]interface{} {
+	operStatus := "ACTIVE"
+	if deviceStatus == apiv1.Status_STATUS_DEVICE_UNHEALTHY {
+		operStatus = "INACTIVE"
+	}
+	return map[string]interface{}{
+		qualify(ietf, "openconfig-platform", "component"): []interface{}{
+			map[string]interface{}{
+				"name": "chassis",
+				"state": map[string]interface{}{
+					"name":             "chassis",
+					"type":             qualify(ietf, "openconfig-platform-types", "CHASSIS"),
+					"oper-status":      qualify(ietf, "openconfig-platform-types", operStatus),
+					"hardware-version": readHWModel(),
+					"firmware-version": readFWVersion(),
+					"software-version": readSWVersion(),
+					"part-no":          readHWModel(),
+				},
+			},
+		},
+	}
+}
+
+// qualify prefixes member name or identity with a module name, when JSON_IETF encoding is used.
+func qualify(ietf bool, module, name string) string {
+	if ietf {
+		return module + ":" + name
+	}
+	return name
+}
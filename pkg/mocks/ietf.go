@@ -0,0 +1,112 @@
+// Package simulatorv1 implements network device simulator means.
+package simulatorv1
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+
+	apiv1 "github.com/eroshiva/trade-show-poc/api/v1"
+)
+
+const (
+	ietfSystemNamespace   = "urn:ietf:params:xml:ns:yang:ietf-system"
+	ietfHardwareNamespace = "urn:ietf:params:xml:ns:yang:ietf-hardware"
+	ianaHardwareNamespace = "urn:ietf:params:xml:ns:yang:iana-hardware"
+)
+
+// ietfSystemStateJSON is a JSON (RFC 7951) encoded /ietf-system:system-state container.
+type ietfSystemStateJSON struct {
+	Platform struct {
+		OSName    string `json:"os-name"`
+		OSRelease string `json:"os-release"`
+		OSVersion string `json:"os-version"`
+		Machine   string `json:"machine"`
+	} `json:"platform"`
+	Clock struct {
+		CurrentDatetime string `json:"current-datetime"`
+		BootDatetime    string `json:"boot-datetime"`
+	} `json:"clock"`
+}
+
+// ietfHardwareComponentJSON is a JSON (RFC 7951) encoded /ietf-hardware:hardware/component list entry.
+type ietfHardwareComponentJSON struct {
+	Name        string `json:"name"`
+	Class       string `json:"class"`
+	HardwareRev string `json:"hardware-rev"`
+	FirmwareRev string `json:"firmware-rev"`
+	SoftwareRev string `json:"software-rev"`
+	ModelName   string `json:"model-name"`
+	State       struct {
+		OperState string `json:"oper-state"`
+	} `json:"state"`
+}
+
+// operState returns operational state of the chassis. Chassis of the unhealthy device is disabled.
+func operState(status apiv1.Status) string {
+	if status == apiv1.Status_STATUS_DEVICE_UNHEALTHY {
+		return "disabled"
+	}
+	return "enabled"
+}
+
+// systemStateXML reports ietf-system state of the device encoded in XML.
+func systemStateXML(startTime time.Time) string {
+	return `<system-state xmlns="` + ietfSystemNamespace + `"><platform>` +
+		`<os-name>simulator</os-name>` +
+		`<os-release>` + xmlEscape(readSWVersion()) + `</os-release>` +
+		`<os-version>` + xmlEscape(readSWVersion()) + `</os-version>` +
+		`<machine>` + xmlEscape(readHWModel()) + `</machine>` +
+		`</platform><clock>` +
+		`<current-datetime>` + time.Now().Format(time.RFC3339) + `</current-datetime>` +
+		`<boot-datetime>` + startTime.Format(time.RFC3339) + `</boot-datetime>` +
+		`</clock></system-state>`
+}
+
+// hardwareXML reports single chassis component, which carries device versions, encoded in XML.
+func hardwareXML(status apiv1.Status) string {
+	return `<hardware xmlns="` + ietfHardwareNamespace + `" xmlns:ianahw="` + ianaHardwareNamespace + `">` +
+		`<component><name>chassis</name><class>ianahw:chassis</class>` +
+		`<hardware-rev>` + xmlEscape(readHWModel()) + `</hardware-rev>` +
+		`<firmware-rev>` + xmlEscape(readFWVersion()) + `</firmware-rev>` +
+		`<software-rev>` + xmlEscape(readSWVersion()) + `</software-rev>` +
+		`<model-name>` + xmlEscape(readHWModel()) + `</model-name>` +
+		`<state><oper-state>` + operState(status) + `</oper-state></state>` +
+		`</component></hardware>`
+}
+
+// systemStateJSON reports ietf-system state of the device encoded in JSON.
+func systemStateJSON(startTime time.Time) map[string]interface{} {
+	ss := ietfSystemStateJSON{}
+	ss.Platform.OSName = "simulator"
+	ss.Platform.OSRelease = readSWVersion()
+	ss.Platform.OSVersion = readSWVersion()
+	ss.Platform.Machine = readHWModel()
+	ss.Clock.CurrentDatetime = time.Now().Format(time.RFC3339)
+	ss.Clock.BootDatetime = startTime.Format(time.RFC3339)
+	return map[string]interface{}{"ietf-system:system-state": ss}
+}
+
+// hardwareJSON reports single chassis component, which carries device versions, encoded in JSON.
+func hardwareJSON(status apiv1.Status) map[string]interface{} {
+	c := ietfHardwareComponentJSON{
+		Name:        "chassis",
+		Class:       "iana-hardware:chassis",
+		HardwareRev: readHWModel(),
+		FirmwareRev: readFWVersion(),
+		SoftwareRev: readSWVersion(),
+		ModelName:   readHWModel(),
+	}
+	c.State.OperState = operState(status)
+	return map[string]interface{}{
+		"ietf-hardware:hardware": map[string]interface{}{
+			"component": []ietfHardwareComponentJSON{c},
+		},
+	}
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
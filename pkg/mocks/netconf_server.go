@@ -0,0 +1,366 @@
+// Package simulatorv1 implements network device simulator means.
+package simulatorv1
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	apiv1 "github.com/eroshiva/trade-show-poc/api/v1"
+	"github.com/rs/zerolog"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	componentNameNETCONF = "netconf-server-simulator"
+
+	// EnvNETCONFServerAddress constant specifies name of the environmental variable for NETCONF server address.
+	EnvNETCONFServerAddress     = "DEVICE_SIMULATOR_NETCONF_SERVER_ADDRESS" // must be in form address:port, e.g., localhost:50830.
+	defaultNETCONFServerAddress = "localhost:50830"
+	// EnvNETCONFUsername constant specifies name of the environmental variable for NETCONF (SSH) user name.
+	EnvNETCONFUsername     = "DEVICE_SIMULATOR_NETCONF_USERNAME"
+	defaultNETCONFUsername = "admin"
+	// EnvNETCONFPassword constant specifies name of the environmental variable for NETCONF (SSH) password.
+	EnvNETCONFPassword     = "DEVICE_SIMULATOR_NETCONF_PASSWORD"
+	defaultNETCONFPassword = "admin"
+
+	netconfSubsystem = "netconf"
+	netconfBase10    = "urn:ietf:params:netconf:base:1.0"
+	netconfBase11    = "urn:ietf:params:netconf:base:1.1"
+	netconfNamespace = "urn:ietf:params:xml:ns:netconf:base:1.0"
+	netconfEOM       = "]]>]]>"
+)
+
+var zlogNETCONF = zerolog.New(zerolog.ConsoleWriter{
+	Out:        os.Stderr,
+	TimeFormat: time.RFC3339,
+	FormatCaller: func(i interface{}) string {
+		return filepath.Dir(fmt.Sprintf("%s/", i))
+	},
+}).Level(zerolog.TraceLevel).With().Caller().Timestamp().Str(component, componentNameNETCONF).Logger()
+
+// NETCONFServer is an in-process NETCONF over SSH (RFC 6242) server stand-in. It answers <get> requests on
+// ietf-system and ietf-hardware YANG modules with the data reported by the gRPC Network Device Simulator.
+type NETCONFServer struct {
+	listener  net.Listener
+	config    *ssh.ServerConfig
+	startTime time.Time
+	sessionID atomic.Uint32
+	wg        sync.WaitGroup
+	conns     connTracker
+}
+
+// netconfHello is a NETCONF <hello> message.
+type netconfHello struct {
+	XMLName      xml.Name `xml:"urn:ietf:params:xml:ns:netconf:base:1.0 hello"`
+	Capabilities []string `xml:"capabilities>capability"`
+	SessionID    string   `xml:"session-id,omitempty"`
+}
+
+// netconfRPC is a NETCONF <rpc> message. Only <get> and <close-session> operations are supported.
+type netconfRPC struct {
+	XMLName   xml.Name `xml:"rpc"`
+	MessageID string   `xml:"message-id,attr"`
+	Get       *struct {
+		Filter *struct {
+			Content string `xml:",innerxml"`
+		} `xml:"filter"`
+	} `xml:"get"`
+	CloseSession *struct{} `xml:"close-session"`
+}
+
+// NewNETCONFServer is a factory function that creates a NETCONF server simulator structure.
+func NewNETCONFServer() *NETCONFServer {
+	return &NETCONFServer{}
+}
+
+// StartNETCONFServer function starts NETCONF server simulator. SSH host key is generated at each start.
+func (s *NETCONFServer) StartNETCONFServer() {
+	serverAddress := readServerAddress(EnvNETCONFServerAddress, defaultNETCONFServerAddress)
+	username := os.Getenv(EnvNETCONFUsername)
+	if username == "" {
+		username = defaultNETCONFUsername
+	}
+	password := os.Getenv(EnvNETCONFPassword)
+	if password == "" {
+		password = defaultNETCONFPassword
+	}
+
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		zlogNETCONF.Fatal().Err(err).Msg("failed to generate host key")
+	}
+	signer, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		zlogNETCONF.Fatal().Err(err).Msg("failed to create host key signer")
+	}
+	s.config = &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if c.User() == username && string(pass) == password {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("password rejected for %q", c.User())
+		},
+	}
+	s.config.AddHostKey(signer)
+
+	lis, err := net.Listen(tcpNetwork, serverAddress)
+	if err != nil {
+		zlogNETCONF.Fatal().Err(err).Msg("failed to listen")
+	}
+	s.listener = lis
+	s.startTime = time.Now()
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		zlogNETCONF.Info().Msgf("NETCONF Server Simulator listening on %s", serverAddress)
+		s.serve()
+	}()
+}
+
+// StopNETCONFServer stops NETCONF server simulator.
+func (s *NETCONFServer) StopNETCONFServer() {
+	zlogNETCONF.Info().Msg("Gracefully stopping NETCONF Server Simulator")
+	if s.listener != nil {
+		_ = s.listener.Close()
+	}
+	s.wg.Wait()
+	s.conns.closeAll()
+}
+
+func (s *NETCONFServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				// server is stopped
+				return
+			}
+			zlogNETCONF.Error().Err(err).Msg("Failed to accept connection")
+			continue
+		}
+		s.conns.track(conn)
+		go s.handleConnection(conn)
+	}
+}
+
+func (s *NETCONFServer) handleConnection(conn net.Conn) {
+	defer func() {
+		s.conns.untrack(conn)
+		_ = conn.Close()
+	}()
+	if readDeviceStatus() == apiv1.Status_STATUS_DEVICE_DOWN {
+		// device is unreachable, dropping the connection
+		zlogNETCONF.Info().Msg("Device status is down, dropping the connection")
+		return
+	}
+
+	sshConn, chans, reqs, err := ssh.NewServerConn(conn, s.config)
+	if err != nil {
+		zlogNETCONF.Error().Err(err).Msg("SSH handshake failed")
+		return
+	}
+	defer sshConn.Close()
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "only session channels are supported")
+			continue
+		}
+		channel, channelReqs, err := newChannel.Accept()
+		if err != nil {
+			zlogNETCONF.Error().Err(err).Msg("Failed to accept channel")
+			continue
+		}
+		go s.handleSession(channel, channelReqs)
+	}
+}
+
+func (s *NETCONFServer) handleSession(channel ssh.Channel, reqs <-chan *ssh.Request) {
+	for req := range reqs {
+		subsystem := struct{ Name string }{}
+		if req.Type != "subsystem" || ssh.Unmarshal(req.Payload, &subsystem) != nil || subsystem.Name != netconfSubsystem {
+			_ = req.Reply(false, nil)
+			continue
+		}
+		_ = req.Reply(true, nil)
+		go func() {
+			s.serveNETCONF(channel)
+			_ = channel.Close()
+		}()
+	}
+}
+
+// serveNETCONF exchanges capabilities and serves <rpc> requests until session is closed.
+func (s *NETCONFServer) serveNETCONF(channel ssh.Channel) {
+	r := bufio.NewReader(channel)
+	sessionID := s.sessionID.Add(1)
+	hello, err := xml.Marshal(&netconfHello{
+		Capabilities: []string{
+			netconfBase10,
+			netconfBase11,
+			ietfSystemNamespace + "?module=ietf-system&revision=2014-08-06",
+			ietfHardwareNamespace + "?module=ietf-hardware&revision=2018-03-13",
+		},
+		SessionID: strconv.FormatUint(uint64(sessionID), 10),
+	})
+	if err != nil {
+		zlogNETCONF.Error().Err(err).Msg("Failed to marshal <hello>")
+		return
+	}
+	err = writeNETCONFMessage(channel, hello, false)
+	if err != nil {
+		zlogNETCONF.Error().Err(err).Msg("Failed to send <hello>")
+		return
+	}
+	msg, err := readNETCONFMessage(r, false)
+	if err != nil {
+		zlogNETCONF.Error().Err(err).Msg("Failed to receive <hello>")
+		return
+	}
+	clientHello := &netconfHello{}
+	err = xml.Unmarshal(msg, clientHello)
+	if err != nil {
+		zlogNETCONF.Error().Err(err).Msg("Failed to parse <hello>")
+		return
+	}
+	chunked := false
+	for _, capability := range clientHello.Capabilities {
+		if capability == netconfBase11 {
+			chunked = true
+		}
+	}
+
+	for {
+		msg, err = readNETCONFMessage(r, chunked)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				zlogNETCONF.Error().Err(err).Msg("Failed to receive <rpc>")
+			}
+			return
+		}
+		if readDeviceStatus() == apiv1.Status_STATUS_DEVICE_DOWN {
+			// device went down in the middle of the session, dropping the session
+			zlogNETCONF.Info().Msg("Device status is down, dropping the session")
+			return
+		}
+		reply, closeSession := s.handleRPC(msg)
+		err = writeNETCONFMessage(channel, []byte(reply), chunked)
+		if err != nil {
+			zlogNETCONF.Error().Err(err).Msg("Failed to send <rpc-reply>")
+			return
+		}
+		if closeSession {
+			return
+		}
+	}
+}
+
+// handleRPC crafts <rpc-reply> for the received <rpc>. It also reports whether session should be closed.
+func (s *NETCONFServer) handleRPC(msg []byte) (string, bool) {
+	rpc := &netconfRPC{}
+	err := xml.Unmarshal(msg, rpc)
+	if err != nil {
+		return netconfRPCErrorReply("", "rpc", "malformed-message", err.Error()), false
+	}
+	switch {
+	case rpc.CloseSession != nil:
+		zlogNETCONF.Info().Msg("Received <close-session> request")
+		return `<rpc-reply message-id="` + xmlEscape(rpc.MessageID) + `" xmlns="` + netconfNamespace + `"><ok/></rpc-reply>`, true
+	case rpc.Get != nil:
+		zlogNETCONF.Info().Msg("Received <get> request")
+		status := readDeviceStatus()
+		filter := ""
+		if rpc.Get.Filter != nil {
+			filter = rpc.Get.Filter.Content
+		}
+		// subtree filter is evaluated only on the top level, i.e., which modules are requested
+		var data strings.Builder
+		if filter == "" || strings.Contains(filter, "system-state") {
+			data.WriteString(systemStateXML(s.startTime))
+		}
+		if filter == "" || strings.Contains(filter, "hardware") {
+			data.WriteString(hardwareXML(status))
+		}
+		return `<rpc-reply message-id="` + xmlEscape(rpc.MessageID) + `" xmlns="` + netconfNamespace + `"><data>` +
+			data.String() + `</data></rpc-reply>`, false
+	default:
+		return netconfRPCErrorReply(rpc.MessageID, "protocol", "operation-not-supported", "operation is not supported"), false
+	}
+}
+
+func netconfRPCErrorReply(messageID, errorType, tag, message string) string {
+	return `<rpc-reply message-id="` + xmlEscape(messageID) + `" xmlns="` + netconfNamespace + `"><rpc-error>` +
+		`<error-type>` + errorType + `</error-type><error-tag>` + tag + `</error-tag>` +
+		`<error-severity>error</error-severity><error-message>` + xmlEscape(message) + `</error-message>` +
+		`</rpc-error></rpc-reply>`
+}
+
+// writeNETCONFMessage writes single NETCONF message with end-of-message or chunked framing.
+func writeNETCONFMessage(w io.Writer, msg []byte, chunked bool) error {
+	var buf bytes.Buffer
+	if chunked {
+		fmt.Fprintf(&buf, "\n#%d\n", len(msg))
+		buf.Write(msg)
+		buf.WriteString("\n##\n")
+	} else {
+		buf.Write(msg)
+		buf.WriteString(netconfEOM)
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// readNETCONFMessage reads single NETCONF message with end-of-message or chunked framing.
+func readNETCONFMessage(r *bufio.Reader, chunked bool) ([]byte, error) {
+	var msg []byte
+	if !chunked {
+		for {
+			b, err := r.ReadByte()
+			if err != nil {
+				return nil, err
+			}
+			msg = append(msg, b)
+			if bytes.HasSuffix(msg, []byte(netconfEOM)) {
+				return bytes.TrimSpace(msg[:len(msg)-len(netconfEOM)]), nil
+			}
+		}
+	}
+	for {
+		header, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		header = strings.TrimSpace(header)
+		if header == "" {
+			continue
+		}
+		if header == "##" {
+			return msg, nil
+		}
+		size, err := strconv.ParseUint(strings.TrimPrefix(header, "#"), 10, 32)
+		if err != nil || !strings.HasPrefix(header, "#") {
+			return nil, fmt.Errorf("invalid chunk header %q", header)
+		}
+		chunk := make([]byte, size)
+		_, err = io.ReadFull(r, chunk)
+		if err != nil {
+			return nil, err
+		}
+		msg = append(msg, chunk...)
+	}
+}
@@ -0,0 +1,219 @@
+// Package simulatorv1 implements network device simulator means.
+package simulatorv1
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	apiv1 "github.com/eroshiva/trade-show-poc/api/v1"
+	"github.com/rs/zerolog"
+)
+
+const (
+	componentNameOVSDB = "ovsdb-server-simulator"
+
+	// EnvOVSDBServerAddress constant specifies name of the environmental variable for OVSDB server address.
+	// Unix domain socket can be specified with "unix:" prefix, e.g., unix:/tmp/db.sock.
+	EnvOVSDBServerAddress     = "DEVICE_SIMULATOR_OVSDB_SERVER_ADDRESS" // must be in form address:port, e.g., localhost:50640.
+	defaultOVSDBServerAddress = "localhost:50640"
+
+	ovsdbUnixSocketPrefix = "unix:"
+	ovsdbDatabase         = "Open_vSwitch"
+	ovsdbSchemaVersion    = "8.3.0"
+)
+
+var zlogOVSDB = zerolog.New(zerolog.ConsoleWriter{
+	Out:        os.Stderr,
+	TimeFormat: time.RFC3339,
+	FormatCaller: func(i interface{}) string {
+		return filepath.Dir(fmt.Sprintf("%s/", i))
+	},
+}).Level(zerolog.TraceLevel).With().Caller().Timestamp().Str(component, componentNameOVSDB).Logger()
+
+// OVSDBServer is an in-process OVSDB (RFC 7047) server stand-in. It answers "echo", "list_dbs" and "transact"
+// (select on the Open_vSwitch table only) requests with the data reported by the gRPC Network Device Simulator.
+type OVSDBServer struct {
+	listener net.Listener
+	wg       sync.WaitGroup
+	conns    connTracker
+}
+
+// ovsdbRequest is a JSON-RPC 1.0 request received from the client.
+type ovsdbRequest struct {
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+	ID     json.RawMessage   `json:"id"`
+}
+
+// ovsdbResponse is a JSON-RPC 1.0 response sent to the client.
+type ovsdbResponse struct {
+	Result interface{}     `json:"result"`
+	Error  interface{}     `json:"error"`
+	ID     json.RawMessage `json:"id"`
+}
+
+// ovsdbSelect is a "select" operation of the "transact" request.
+type ovsdbSelect struct {
+	Op      string   `json:"op"`
+	Table   string   `json:"table"`
+	Columns []string `json:"columns"`
+}
+
+// NewOVSDBServer is a factory function that creates an OVSDB server simulator structure.
+func NewOVSDBServer() *OVSDBServer {
+	return &OVSDBServer{}
+}
+
+// StartOVSDBServer function starts OVSDB server simulator. TCP connections are secured with TLS, when it is
+// configured in the environment.
+func (s *OVSDBServer) StartOVSDBServer() {
+	serverAddress := readServerAddress(EnvOVSDBServerAddress, defaultOVSDBServerAddress)
+	network := tcpNetwork
+	if path, ok := strings.CutPrefix(serverAddress, ovsdbUnixSocketPrefix); ok {
+		network = "unix"
+		serverAddress = path
+		// removing stale socket, if any
+		_ = os.Remove(path)
+	}
+	tlsConfig, err := readTLSConfig()
+	if err != nil {
+		zlogOVSDB.Fatal().Err(err).Msg("failed to read TLS configuration")
+	}
+	lis, err := net.Listen(network, serverAddress)
+	if err != nil {
+		zlogOVSDB.Fatal().Err(err).Msg("failed to listen")
+	}
+	if tlsConfig != nil && network == tcpNetwork {
+		// SSL connection method in Open vSwitch terms
+		lis = tls.NewListener(lis, tlsConfig)
+	}
+	s.listener = lis
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		zlogOVSDB.Info().Msgf("OVSDB Server Simulator listening on %s", serverAddress)
+		s.serve()
+	}()
+}
+
+// StopOVSDBServer stops OVSDB server simulator.
+func (s *OVSDBServer) StopOVSDBServer() {
+	zlogOVSDB.Info().Msg("Gracefully stopping OVSDB Server Simulator")
+	if s.listener != nil {
+		_ = s.listener.Close()
+	}
+	s.wg.Wait()
+	s.conns.closeAll()
+}
+
+func (s *OVSDBServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				// server is stopped
+				return
+			}
+			zlogOVSDB.Error().Err(err).Msg("Failed to accept connection")
+			continue
+		}
+		s.conns.track(conn)
+		go s.handleConnection(conn)
+	}
+}
+
+func (s *OVSDBServer) handleConnection(conn net.Conn) {
+	defer func() {
+		s.conns.untrack(conn)
+		_ = conn.Close()
+	}()
+	decoder := json.NewDecoder(conn)
+	encoder := json.NewEncoder(conn)
+	for {
+		req := &ovsdbRequest{}
+		if err := decoder.Decode(req); err != nil {
+			return
+		}
+		status := readDeviceStatus()
+		if status == apiv1.Status_STATUS_DEVICE_DOWN {
+			// device is unreachable, dropping the connection
+			zlogOVSDB.Info().Msg("Device status is down, dropping the connection")
+			return
+		}
+		if len(req.ID) == 0 || string(req.ID) == "null" {
+			// notification, no response is expected
+			continue
+		}
+		zlogOVSDB.Info().Msgf("Received %s request", req.Method)
+		resp := &ovsdbResponse{ID: req.ID}
+		switch req.Method {
+		case "echo":
+			resp.Result = req.Params
+		case "list_dbs":
+			resp.Result = []string{ovsdbDatabase}
+		case "transact":
+			resp.Result, resp.Error = transact(req.Params, status)
+		default:
+			resp.Error = "unknown method"
+		}
+		if err := encoder.Encode(resp); err != nil {
+			zlogOVSDB.Error().Err(err).Msgf("Failed to send %s response", req.Method)
+			return
+		}
+	}
+}
+
+// transact executes "select" operations on the Open_vSwitch table. Unhealthy device reports that ovs-vswitchd has
+// not applied the latest configuration, i.e., next_cfg is ahead of cur_cfg.
+func transact(params []json.RawMessage, status apiv1.Status) (interface{}, interface{}) {
+	if len(params) == 0 {
+		return nil, "invalid params"
+	}
+	var database string
+	if err := json.Unmarshal(params[0], &database); err != nil || database != ovsdbDatabase {
+		return nil, map[string]string{"error": "unknown database", "details": string(params[0])}
+	}
+	nextCfg := 1
+	if status == apiv1.Status_STATUS_DEVICE_UNHEALTHY {
+		nextCfg = 2
+	}
+	row := map[string]interface{}{
+		"ovs_version":    readSWVersion(),
+		"db_version":     ovsdbSchemaVersion,
+		"system_type":    readHWModel(),
+		"system_version": readFWVersion(),
+		"cur_cfg":        1,
+		"next_cfg":       nextCfg,
+	}
+	results := make([]interface{}, 0, len(params)-1)
+	for _, param := range params[1:] {
+		op := &ovsdbSelect{}
+		if err := json.Unmarshal(param, op); err != nil || op.Op != "select" {
+			results = append(results, map[string]string{"error": "not supported", "details": "only select is supported"})
+			continue
+		}
+		if op.Table != ovsdbDatabase {
+			results = append(results, map[string]string{"error": "unknown table", "details": op.Table})
+			continue
+		}
+		selected := row
+		if len(op.Columns) > 0 {
+			selected = make(map[string]interface{}, len(op.Columns))
+			for _, column := range op.Columns {
+				if value, ok := row[column]; ok {
+					selected[column] = value
+				}
+			}
+		}
+		results = append(results, map[string]interface{}{"rows": []interface{}{selected}})
+	}
+	return results, nil
+}
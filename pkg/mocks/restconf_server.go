@@ -0,0 +1,249 @@
+// Package simulatorv1 implements network device simulator means.
+package simulatorv1
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	apiv1 "github.com/eroshiva/trade-show-poc/api/v1"
+	"github.com/rs/zerolog"
+)
+
+const (
+	componentNameRESTCONF = "restconf-server-simulator"
+
+	// EnvRESTCONFServerAddress constant specifies name of the environmental variable for RESTCONF server address.
+	EnvRESTCONFServerAddress     = "DEVICE_SIMULATOR_RESTCONF_SERVER_ADDRESS" // must be in form address:port, e.g., localhost:50880.
+	defaultRESTCONFServerAddress = "localhost:50880"
+	// EnvRESTCONFRoot constant specifies name of the environmental variable for RESTCONF API root advertised via host-meta.
+	EnvRESTCONFRoot     = "DEVICE_SIMULATOR_RESTCONF_ROOT"
+	defaultRESTCONFRoot = "/restconf"
+	// EnvRESTCONFUsername constant specifies name of the environmental variable for RESTCONF (HTTP basic auth) user name.
+	// Authentication is not required, unless user name or token is set.
+	EnvRESTCONFUsername = "DEVICE_SIMULATOR_RESTCONF_USERNAME"
+	// EnvRESTCONFPassword constant specifies name of the environmental variable for RESTCONF (HTTP basic auth) password.
+	EnvRESTCONFPassword = "DEVICE_SIMULATOR_RESTCONF_PASSWORD"
+	// EnvRESTCONFToken constant specifies name of the environmental variable for RESTCONF bearer token.
+	EnvRESTCONFToken = "DEVICE_SIMULATOR_RESTCONF_TOKEN"
+
+	restconfHostMetaPath    = "/.well-known/host-meta"
+	restconfSystemStatePath = "/data/ietf-system:system-state"
+	restconfHardwarePath    = "/data/ietf-hardware:hardware"
+	mediaTypeXRD            = "application/xrd+xml"
+	mediaTypeYANGDataJSON   = "application/yang-data+json"
+	mediaTypeYANGDataXML    = "application/yang-data+xml"
+)
+
+var zlogRESTCONF = zerolog.New(zerolog.ConsoleWriter{
+	Out:        os.Stderr,
+	TimeFormat: time.RFC3339,
+	FormatCaller: func(i interface{}) string {
+		return filepath.Dir(fmt.Sprintf("%s/", i))
+	},
+}).Level(zerolog.TraceLevel).With().Caller().Timestamp().Str(component, componentNameRESTCONF).Logger()
+
+// RESTCONFServer is an in-process RESTCONF (RFC 8040) server stand-in. It serves ietf-system and ietf-hardware
+// YANG modules, encoded in JSON or XML, with the data reported by the gRPC Network Device Simulator.
+type RESTCONFServer struct {
+	server    *http.Server
+	startTime time.Time
+	wg        sync.WaitGroup
+}
+
+// NewRESTCONFServer is a factory function that creates a RESTCONF server simulator structure.
+func NewRESTCONFServer() *RESTCONFServer {
+	return &RESTCONFServer{
+		startTime: time.Now(),
+	}
+}
+
+// Handler returns HTTP handler of the RESTCONF server simulator. It can be used with any HTTP server, e.g., httptest.
+func (s *RESTCONFServer) Handler() http.Handler {
+	return http.HandlerFunc(s.serveHTTP)
+}
+
+// StartRESTCONFServer function starts RESTCONF server simulator. Server serves HTTPS, when TLS is configured
+// in the environment.
+func (s *RESTCONFServer) StartRESTCONFServer() {
+	serverAddress := readServerAddress(EnvRESTCONFServerAddress, defaultRESTCONFServerAddress)
+	tlsConfig, err := readTLSConfig()
+	if err != nil {
+		zlogRESTCONF.Fatal().Err(err).Msg("failed to read TLS configuration")
+	}
+	lis, err := net.Listen(tcpNetwork, serverAddress)
+	if err != nil {
+		zlogRESTCONF.Fatal().Err(err).Msg("failed to listen")
+	}
+	if tlsConfig != nil {
+		lis = tls.NewListener(lis, tlsConfig)
+	}
+	s.server = &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		zlogRESTCONF.Info().Msgf("RESTCONF Server Simulator listening on %s", serverAddress)
+		if err := s.server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			zlogRESTCONF.Error().Err(err).Msg("failed to serve")
+		}
+	}()
+}
+
+// StopRESTCONFServer stops RESTCONF server simulator.
+func (s *RESTCONFServer) StopRESTCONFServer() {
+	zlogRESTCONF.Info().Msg("Gracefully stopping RESTCONF Server Simulator")
+	if s.server != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = s.server.Shutdown(ctx)
+	}
+	s.wg.Wait()
+}
+
+func (s *RESTCONFServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	status := readDeviceStatus()
+	if status == apiv1.Status_STATUS_DEVICE_DOWN {
+		// device is unreachable, dropping the connection
+		zlogRESTCONF.Info().Msg("Device status is down, dropping the connection")
+		dropConnection(w)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeRESTCONFError(w, http.StatusMethodNotAllowed, "protocol", "operation-not-supported", "only GET is supported")
+		return
+	}
+	if !authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="restconf"`)
+		writeRESTCONFError(w, http.StatusUnauthorized, "protocol", "access-denied", "authentication failed")
+		return
+	}
+
+	root := os.Getenv(EnvRESTCONFRoot)
+	if root == "" {
+		root = defaultRESTCONFRoot
+	}
+	xmlEncoded := prefersXML(r.Header.Get("Accept"))
+	switch r.URL.Path {
+	case restconfHostMetaPath:
+		zlogRESTCONF.Info().Msg("Received host-meta request")
+		w.Header().Set("Content-Type", mediaTypeXRD)
+		_, _ = fmt.Fprintf(w, `<XRD xmlns="http://docs.oasis-open.org/ns/xri/xrd-1.0"><Link rel="restconf" href="%s"/></XRD>`,
+			xmlEscape(root))
+	case root + restconfSystemStatePath:
+		zlogRESTCONF.Info().Msg("Received system-state request")
+		if xmlEncoded {
+			writeRESTCONFData(w, mediaTypeYANGDataXML, []byte(systemStateXML(s.startTime)))
+			return
+		}
+		writeRESTCONFJSON(w, systemStateJSON(s.startTime))
+	case root + restconfHardwarePath:
+		zlogRESTCONF.Info().Msg("Received hardware request")
+		if xmlEncoded {
+			writeRESTCONFData(w, mediaTypeYANGDataXML, []byte(hardwareXML(status)))
+			return
+		}
+		writeRESTCONFJSON(w, hardwareJSON(status))
+	default:
+		writeRESTCONFError(w, http.StatusNotFound, "protocol", "invalid-value", "resource not found")
+	}
+}
+
+// authorized verifies credentials carried by the request. Bearer token and HTTP basic auth are supported.
+func authorized(r *http.Request) bool {
+	token := os.Getenv(EnvRESTCONFToken)
+	username := os.Getenv(EnvRESTCONFUsername)
+	if token == "" && username == "" {
+		// authentication is not required
+		return true
+	}
+	if token != "" {
+		if bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+			return subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) == 1
+		}
+	}
+	if username != "" {
+		if u, p, ok := r.BasicAuth(); ok {
+			return subtle.ConstantTimeCompare([]byte(u), []byte(username)) == 1 &&
+				subtle.ConstantTimeCompare([]byte(p), []byte(os.Getenv(EnvRESTCONFPassword))) == 1
+		}
+	}
+	return false
+}
+
+// prefersXML reports whether the client prefers XML encoding, i.e., whether XML is listed first in the Accept header.
+func prefersXML(accept string) bool {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case mediaTypeYANGDataXML, "application/xml":
+			return true
+		case mediaTypeYANGDataJSON, "application/json":
+			return false
+		default:
+		}
+	}
+	return false
+}
+
+// dropConnection closes underlying connection without sending any response.
+func dropConnection(w http.ResponseWriter) {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		writeRESTCONFError(w, http.StatusServiceUnavailable, "transport", "resource-denied", "device is down")
+		return
+	}
+	conn, _, err := hj.Hijack()
+	if err != nil {
+		zlogRESTCONF.Error().Err(err).Msg("Failed to hijack the connection")
+		return
+	}
+	_ = conn.Close()
+}
+
+func writeRESTCONFJSON(w http.ResponseWriter, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		writeRESTCONFError(w, http.StatusInternalServerError, "application", "operation-failed", err.Error())
+		return
+	}
+	writeRESTCONFData(w, mediaTypeYANGDataJSON, data)
+}
+
+func writeRESTCONFData(w http.ResponseWriter, mediaType string, data []byte) {
+	w.Header().Set("Content-Type", mediaType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// writeRESTCONFError responds with JSON encoded ietf-restconf:errors container (RFC 8040, section 7.1).
+func writeRESTCONFError(w http.ResponseWriter, code int, errorType, tag, message string) {
+	data, _ := json.Marshal(map[string]interface{}{
+		"ietf-restconf:errors": map[string]interface{}{
+			"error": []map[string]string{{
+				"error-type":    errorType,
+				"error-tag":     tag,
+				"error-message": message,
+			}},
+		},
+	})
+	w.Header().Set("Content-Type", mediaTypeYANGDataJSON)
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
@@ -8,6 +8,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	apiv1 "github.com/eroshiva/trade-show-poc/api/v1"
@@ -77,9 +78,8 @@ func convertDeviceStatus(ds string) apiv1.Status {
 	}
 }
 
-// GetStatus returns a status based on the device ID.
-func (s *server) GetStatus(_ context.Context, _ *emptypb.Empty) (*apiv1.DeviceStatus, error) {
-	zlog.Info().Msg("Received GetStatus request")
+// readDeviceStatus reads device status, which should be reported by the simulator, from the environment.
+func readDeviceStatus() apiv1.Status {
 	deviceStatus := os.Getenv(EnvDeviceStatus)
 	if deviceStatus == "" {
 		zlog.Warn().Msgf("Environment variable \"%s\" is not set, returning default value: %v",
@@ -87,56 +87,157 @@ func (s *server) GetStatus(_ context.Context, _ *emptypb.Empty) (*apiv1.DeviceSt
 		deviceStatus = DeviceStatusUP
 	}
 	// value is set, converting and returning it
-	status := convertDeviceStatus(deviceStatus)
-	if status == apiv1.Status_STATUS_DEVICE_DOWN {
-		// returning error
-		err := fmt.Errorf("device is unreachable")
-		zlog.Info().Msgf("Device status is down, returning an error: %v", err)
-		return nil, err
-	}
-
-	return &apiv1.DeviceStatus{Status: status}, nil
+	return convertDeviceStatus(deviceStatus)
 }
 
-// GetHWVersion returns a mock hardware version.
-func (s *server) GetHWVersion(_ context.Context, _ *emptypb.Empty) (*GetVersionResponse, error) {
-	zlog.Info().Msgf("Received GetHWVersion request")
+// readHWModel reads HW model, which should be reported by the simulator, from the environment.
+func readHWModel() string {
 	hwModel := os.Getenv(EnvHWModel)
 	if hwModel == "" {
 		zlog.Warn().Msgf("Environment variable \"%s\" is not set, using default value: %s",
 			EnvHWModel, defaultHWModel)
 		hwModel = defaultHWModel
 	}
-
-	return &GetVersionResponse{Version: hwModel}, nil
+	return hwModel
 }
 
-// GetSWVersion returns a mock software version.
-func (s *server) GetSWVersion(_ context.Context, _ *emptypb.Empty) (*apiv1.Version, error) {
-	zlog.Info().Msgf("Received GetSWVersion request")
+// readSWVersion reads SW version, which should be reported by the simulator, from the environment.
+func readSWVersion() string {
 	swVersion := os.Getenv(EnvSWVersion)
 	if swVersion == "" {
 		zlog.Warn().Msgf("Environment variable \"%s\" is not set, using default value: %s",
 			EnvSWVersion, defaultSWVersion)
 		swVersion = defaultSWVersion
 	}
-	fwChecksum := fmt.Sprintf("%x", sha256.Sum256([]byte(swVersion)))
-
-	return &apiv1.Version{Version: swVersion, Checksum: fwChecksum}, nil
+	return swVersion
 }
 
-// GetFWVersion returns a mock firmware version.
-func (s *server) GetFWVersion(_ context.Context, _ *emptypb.Empty) (*apiv1.Version, error) {
-	zlog.Info().Msgf("Received GetFWVersion request")
+// readFWVersion reads FW version, which should be reported by the simulator, from the environment.
+func readFWVersion() string {
 	fwVersion := os.Getenv(EnvFWVersion)
 	if fwVersion == "" {
 		zlog.Warn().Msgf("Environment variable \"%s\" is not set, using default value: %s",
 			EnvFWVersion, defaultFWVersion)
 		fwVersion = defaultFWVersion
 	}
-	fwChecksum := fmt.Sprintf("%x", sha256.Sum256([]byte(fwVersion)))
+	return fwVersion
+}
+
+// readServerAddress reads the address, on which simulator should listen, from the environment.
+func readServerAddress(envName, defaultAddress string) string {
+	serverAddress := os.Getenv(envName)
+	if serverAddress == "" {
+		zlog.Warn().Msgf("Environment variable \"%s\" is not set, using default address: %s",
+			envName, defaultAddress)
+		serverAddress = defaultAddress
+	}
+	return serverAddress
+}
+
+// connTracker keeps accepted connections, so they can be dropped once the stand-in is stopped. Connectors keep
+// connections open between the calls, stopped stand-in must not keep serving them.
+type connTracker struct {
+	lock  sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+// track registers accepted connection.
+func (t *connTracker) track(conn net.Conn) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.conns == nil {
+		t.conns = make(map[net.Conn]struct{})
+	}
+	t.conns[conn] = struct{}{}
+}
+
+// untrack removes connection, which is closed.
+func (t *connTracker) untrack(conn net.Conn) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.conns, conn)
+}
+
+// closeAll closes all tracked connections.
+func (t *connTracker) closeAll() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for conn := range t.conns {
+		_ = conn.Close()
+	}
+	t.conns = nil
+}
 
-	return &apiv1.Version{Version: fwVersion, Checksum: fwChecksum}, nil
+// computeChecksum returns SHA256 checksum of a version.
+func computeChecksum(version string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(version)))
+}
+
+// GetStatus returns a status based on the device ID.
+func (s *server) GetStatus(_ context.Context, _ *emptypb.Empty) (*apiv1.DeviceStatus, error) {
+	zlog.Info().Msg("Received GetStatus request")
+	status := readDeviceStatus()
+	if status == apiv1.Status_STATUS_DEVICE_DOWN {
+		// returning error
+		err := fmt.Errorf("device is unreachable")
+		zlog.Info().Msgf("Device status is down, returning an error: %v", err)
+		return nil, err
+	}
+
+	return &apiv1.DeviceStatus{Status: status}, nil
+}
+
+// GetHWVersion returns a mock hardware version.
+func (s *server) GetHWVersion(_ context.Context, _ *emptypb.Empty) (*GetVersionResponse, error) {
+	zlog.Info().Msgf("Received GetHWVersion request")
+	return &GetVersionResponse{Version: readHWModel()}, nil
+}
+
+// GetSWVersion returns a mock software version.
+func (s *server) GetSWVersion(_ context.Context, _ *emptypb.Empty) (*apiv1.Version, error) {
+	zlog.Info().Msgf("Received GetSWVersion request")
+	swVersion := readSWVersion()
+	return &apiv1.Version{Version: swVersion, Checksum: computeChecksum(swVersion)}, nil
+}
+
+// GetFWVersion returns a mock firmware version.
+func (s *server) GetFWVersion(_ context.Context, _ *emptypb.Empty) (*apiv1.Version, error) {
+	zlog.Info().Msgf("Received GetFWVersion request")
+	fwVersion := readFWVersion()
+	return &apiv1.Version{Version: fwVersion, Checksum: computeChecksum(fwVersion)}, nil
+}
+
+// GetDeviceInfo returns mock device status together with all versions.
+func (s *server) GetDeviceInfo(_ context.Context, _ *emptypb.Empty) (*GetDeviceInfoResponse, error) {
+	zlog.Info().Msg("Received GetDeviceInfo request")
+	status := readDeviceStatus()
+	if status == apiv1.Status_STATUS_DEVICE_DOWN {
+		// returning error
+		err := fmt.Errorf("device is unreachable")
+		zlog.Info().Msgf("Device status is down, returning an error: %v", err)
+		return nil, err
+	}
+
+	resp := &GetDeviceInfoResponse{
+		Status: &apiv1.DeviceStatus{Status: status},
+	}
+	if status == apiv1.Status_STATUS_DEVICE_UNHEALTHY {
+		// device is not able to retrieve its versions
+		for _, part := range []DeviceInfoPart{
+			DeviceInfoPart_DEVICE_INFO_PART_HW_VERSION,
+			DeviceInfoPart_DEVICE_INFO_PART_SW_VERSION,
+			DeviceInfoPart_DEVICE_INFO_PART_FW_VERSION,
+		} {
+			resp.Errors = append(resp.Errors, &DeviceInfoError{Part: part, Message: "device is not healthy"})
+		}
+		return resp, nil
+	}
+	swVersion := readSWVersion()
+	fwVersion := readFWVersion()
+	resp.HwVersion = readHWModel()
+	resp.SwVersion = &apiv1.Version{Version: swVersion, Checksum: computeChecksum(swVersion)}
+	resp.FwVersion = &apiv1.Version{Version: fwVersion, Checksum: computeChecksum(fwVersion)}
+	return resp, nil
 }
 
 // NewDeviceSimulator is a factory function that creates a network device simulator structure.
@@ -149,12 +250,7 @@ func NewDeviceSimulator() *DeviceSimulator {
 // StartNetworkDeviceSimulator function starts network device simulator. Under the hood, it is a pure gRPC server
 // implemented for the sake of simplicity and showcasing the interaction.
 func (ds *DeviceSimulator) StartNetworkDeviceSimulator() {
-	serverAddress := os.Getenv(EnvServerAddress)
-	if serverAddress == "" {
-		zlog.Warn().Msgf("Environment variable \"%s\" is not set, using default address: %s",
-			EnvServerAddress, defaultServerAddress)
-		serverAddress = defaultServerAddress
-	}
+	serverAddress := readServerAddress(EnvServerAddress, defaultServerAddress)
 
 	lis, err := net.Listen(tcpNetwork, serverAddress)
 	if err != nil {
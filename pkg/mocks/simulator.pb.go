@@ -23,6 +23,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DeviceInfoPart identifies a part of the device information, which may fail on its own.
+type DeviceInfoPart int32
+
+const (
+	DeviceInfoPart_DEVICE_INFO_PART_UNSPECIFIED DeviceInfoPart = 0
+	DeviceInfoPart_DEVICE_INFO_PART_HW_VERSION  DeviceInfoPart = 1
+	DeviceInfoPart_DEVICE_INFO_PART_SW_VERSION  DeviceInfoPart = 2
+	DeviceInfoPart_DEVICE_INFO_PART_FW_VERSION  DeviceInfoPart = 3
+)
+
+// Enum value maps for DeviceInfoPart.
+var (
+	DeviceInfoPart_name = map[int32]string{
+		0: "DEVICE_INFO_PART_UNSPECIFIED",
+		1: "DEVICE_INFO_PART_HW_VERSION",
+		2: "DEVICE_INFO_PART_SW_VERSION",
+		3: "DEVICE_INFO_PART_FW_VERSION",
+	}
+	DeviceInfoPart_value = map[string]int32{
+		"DEVICE_INFO_PART_UNSPECIFIED": 0,
+		"DEVICE_INFO_PART_HW_VERSION":  1,
+		"DEVICE_INFO_PART_SW_VERSION":  2,
+		"DEVICE_INFO_PART_FW_VERSION":  3,
+	}
+)
+
+func (x DeviceInfoPart) Enum() *DeviceInfoPart {
+	p := new(DeviceInfoPart)
+	*p = x
+	return p
+}
+
+func (x DeviceInfoPart) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeviceInfoPart) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_mocks_simulator_proto_enumTypes[0].Descriptor()
+}
+
+func (DeviceInfoPart) Type() protoreflect.EnumType {
+	return &file_pkg_mocks_simulator_proto_enumTypes[0]
+}
+
+func (x DeviceInfoPart) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeviceInfoPart.Descriptor instead.
+func (DeviceInfoPart) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_mocks_simulator_proto_rawDescGZIP(), []int{0}
+}
+
 type GetVersionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -67,18 +120,166 @@ func (x *GetVersionResponse) GetVersion() string {
 	return ""
 }
 
+// DeviceInfoError reports a part of the device information, which device has failed to retrieve.
+type DeviceInfoError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          DeviceInfoPart         `protobuf:"varint,1,opt,name=part,proto3,enum=simulator.v1.DeviceInfoPart" json:"part,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceInfoError) Reset() {
+	*x = DeviceInfoError{}
+	mi := &file_pkg_mocks_simulator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceInfoError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceInfoError) ProtoMessage() {}
+
+func (x *DeviceInfoError) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_mocks_simulator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceInfoError.ProtoReflect.Descriptor instead.
+func (*DeviceInfoError) Descriptor() ([]byte, []int) {
+	return file_pkg_mocks_simulator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeviceInfoError) GetPart() DeviceInfoPart {
+	if x != nil {
+		return x.Part
+	}
+	return DeviceInfoPart_DEVICE_INFO_PART_UNSPECIFIED
+}
+
+func (x *DeviceInfoError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetDeviceInfoResponse struct {
+	state     protoimpl.MessageState   `protogen:"open.v1"`
+	Status    *monitoring.DeviceStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	HwVersion string                   `protobuf:"bytes,2,opt,name=hw_version,json=hwVersion,proto3" json:"hw_version,omitempty"`
+	SwVersion *monitoring.Version      `protobuf:"bytes,3,opt,name=sw_version,json=swVersion,proto3" json:"sw_version,omitempty"`
+	FwVersion *monitoring.Version      `protobuf:"bytes,4,opt,name=fw_version,json=fwVersion,proto3" json:"fw_version,omitempty"`
+	// errors lists the parts, which device has failed to retrieve. Corresponding fields are left empty.
+	Errors        []*DeviceInfoError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceInfoResponse) Reset() {
+	*x = GetDeviceInfoResponse{}
+	mi := &file_pkg_mocks_simulator_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceInfoResponse) ProtoMessage() {}
+
+func (x *GetDeviceInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_mocks_simulator_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetDeviceInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_mocks_simulator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDeviceInfoResponse) GetStatus() *monitoring.DeviceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetDeviceInfoResponse) GetHwVersion() string {
+	if x != nil {
+		return x.HwVersion
+	}
+	return ""
+}
+
+func (x *GetDeviceInfoResponse) GetSwVersion() *monitoring.Version {
+	if x != nil {
+		return x.SwVersion
+	}
+	return nil
+}
+
+func (x *GetDeviceInfoResponse) GetFwVersion() *monitoring.Version {
+	if x != nil {
+		return x.FwVersion
+	}
+	return nil
+}
+
+func (x *GetDeviceInfoResponse) GetErrors() []*DeviceInfoError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
 var File_pkg_mocks_simulator_proto protoreflect.FileDescriptor
 
 const file_pkg_mocks_simulator_proto_rawDesc = "" +
 	"\n" +
 	"\x19pkg/mocks/simulator.proto\x12\fsimulator.v1\x1a\x17api/v1/monitoring.proto\x1a\x1bgoogle/protobuf/empty.proto\".\n" +
 	"\x12GetVersionResponse\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion2\x92\x02\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"]\n" +
+	"\x0fDeviceInfoError\x120\n" +
+	"\x04part\x18\x01 \x01(\x0e2\x1c.simulator.v1.DeviceInfoPartR\x04part\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xfb\x01\n" +
+	"\x15GetDeviceInfoResponse\x12,\n" +
+	"\x06status\x18\x01 \x01(\v2\x14.api.v1.DeviceStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"hw_version\x18\x02 \x01(\tR\thwVersion\x12.\n" +
+	"\n" +
+	"sw_version\x18\x03 \x01(\v2\x0f.api.v1.VersionR\tswVersion\x12.\n" +
+	"\n" +
+	"fw_version\x18\x04 \x01(\v2\x0f.api.v1.VersionR\tfwVersion\x125\n" +
+	"\x06errors\x18\x05 \x03(\v2\x1d.simulator.v1.DeviceInfoErrorR\x06errors*\x95\x01\n" +
+	"\x0eDeviceInfoPart\x12 \n" +
+	"\x1cDEVICE_INFO_PART_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bDEVICE_INFO_PART_HW_VERSION\x10\x01\x12\x1f\n" +
+	"\x1bDEVICE_INFO_PART_SW_VERSION\x10\x02\x12\x1f\n" +
+	"\x1bDEVICE_INFO_PART_FW_VERSION\x10\x032\xe2\x02\n" +
 	"\x11MockDeviceService\x12;\n" +
 	"\tGetStatus\x12\x16.google.protobuf.Empty\x1a\x14.api.v1.DeviceStatus\"\x00\x12J\n" +
 	"\fGetHWVersion\x12\x16.google.protobuf.Empty\x1a .simulator.v1.GetVersionResponse\"\x00\x129\n" +
 	"\fGetSWVersion\x12\x16.google.protobuf.Empty\x1a\x0f.api.v1.Version\"\x00\x129\n" +
-	"\fGetFWVersion\x12\x16.google.protobuf.Empty\x1a\x0f.api.v1.Version\"\x00BAZ?github.com/eroshiva/trade-show-poc/api/v1/simulator;simulatorv1b\x06proto3"
+	"\fGetFWVersion\x12\x16.google.protobuf.Empty\x1a\x0f.api.v1.Version\"\x00\x12N\n" +
+	"\rGetDeviceInfo\x12\x16.google.protobuf.Empty\x1a#.simulator.v1.GetDeviceInfoResponse\"\x00BAZ?github.com/eroshiva/trade-show-poc/api/v1/simulator;simulatorv1b\x06proto3"
 
 var (
 	file_pkg_mocks_simulator_proto_rawDescOnce sync.Once
@@ -92,27 +293,38 @@ func file_pkg_mocks_simulator_proto_rawDescGZIP() []byte {
 	return file_pkg_mocks_simulator_proto_rawDescData
 }
 
-var file_pkg_mocks_simulator_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_mocks_simulator_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_mocks_simulator_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_pkg_mocks_simulator_proto_goTypes = []any{
-	(*GetVersionResponse)(nil),      // 0: simulator.v1.GetVersionResponse
-	(*emptypb.Empty)(nil),           // 1: google.protobuf.Empty
-	(*monitoring.DeviceStatus)(nil), // 2: api.v1.DeviceStatus
-	(*monitoring.Version)(nil),      // 3: api.v1.Version
+	(DeviceInfoPart)(0),             // 0: simulator.v1.DeviceInfoPart
+	(*GetVersionResponse)(nil),      // 1: simulator.v1.GetVersionResponse
+	(*DeviceInfoError)(nil),         // 2: simulator.v1.DeviceInfoError
+	(*GetDeviceInfoResponse)(nil),   // 3: simulator.v1.GetDeviceInfoResponse
+	(*monitoring.DeviceStatus)(nil), // 4: api.v1.DeviceStatus
+	(*monitoring.Version)(nil),      // 5: api.v1.Version
+	(*emptypb.Empty)(nil),           // 6: google.protobuf.Empty
 }
 var file_pkg_mocks_simulator_proto_depIdxs = []int32{
-	1, // 0: simulator.v1.MockDeviceService.GetStatus:input_type -> google.protobuf.Empty
-	1, // 1: simulator.v1.MockDeviceService.GetHWVersion:input_type -> google.protobuf.Empty
-	1, // 2: simulator.v1.MockDeviceService.GetSWVersion:input_type -> google.protobuf.Empty
-	1, // 3: simulator.v1.MockDeviceService.GetFWVersion:input_type -> google.protobuf.Empty
-	2, // 4: simulator.v1.MockDeviceService.GetStatus:output_type -> api.v1.DeviceStatus
-	0, // 5: simulator.v1.MockDeviceService.GetHWVersion:output_type -> simulator.v1.GetVersionResponse
-	3, // 6: simulator.v1.MockDeviceService.GetSWVersion:output_type -> api.v1.Version
-	3, // 7: simulator.v1.MockDeviceService.GetFWVersion:output_type -> api.v1.Version
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: simulator.v1.DeviceInfoError.part:type_name -> simulator.v1.DeviceInfoPart
+	4,  // 1: simulator.v1.GetDeviceInfoResponse.status:type_name -> api.v1.DeviceStatus
+	5,  // 2: simulator.v1.GetDeviceInfoResponse.sw_version:type_name -> api.v1.Version
+	5,  // 3: simulator.v1.GetDeviceInfoResponse.fw_version:type_name -> api.v1.Version
+	2,  // 4: simulator.v1.GetDeviceInfoResponse.errors:type_name -> simulator.v1.DeviceInfoError
+	6,  // 5: simulator.v1.MockDeviceService.GetStatus:input_type -> google.protobuf.Empty
+	6,  // 6: simulator.v1.MockDeviceService.GetHWVersion:input_type -> google.protobuf.Empty
+	6,  // 7: simulator.v1.MockDeviceService.GetSWVersion:input_type -> google.protobuf.Empty
+	6,  // 8: simulator.v1.MockDeviceService.GetFWVersion:input_type -> google.protobuf.Empty
+	6,  // 9: simulator.v1.MockDeviceService.GetDeviceInfo:input_type -> google.protobuf.Empty
+	4,  // 10: simulator.v1.MockDeviceService.GetStatus:output_type -> api.v1.DeviceStatus
+	1,  // 11: simulator.v1.MockDeviceService.GetHWVersion:output_type -> simulator.v1.GetVersionResponse
+	5,  // 12: simulator.v1.MockDeviceService.GetSWVersion:output_type -> api.v1.Version
+	5,  // 13: simulator.v1.MockDeviceService.GetFWVersion:output_type -> api.v1.Version
+	3,  // 14: simulator.v1.MockDeviceService.GetDeviceInfo:output_type -> simulator.v1.GetDeviceInfoResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_pkg_mocks_simulator_proto_init() }
@@ -125,13 +337,14 @@ func file_pkg_mocks_simulator_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_mocks_simulator_proto_rawDesc), len(file_pkg_mocks_simulator_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pkg_mocks_simulator_proto_goTypes,
 		DependencyIndexes: file_pkg_mocks_simulator_proto_depIdxs,
+		EnumInfos:         file_pkg_mocks_simulator_proto_enumTypes,
 		MessageInfos:      file_pkg_mocks_simulator_proto_msgTypes,
 	}.Build()
 	File_pkg_mocks_simulator_proto = out.File
@@ -144,6 +144,33 @@ func local_request_MockDeviceService_GetFWVersion_0(ctx context.Context, marshal
 	return msg, metadata, err
 }
 
+func request_MockDeviceService_GetDeviceInfo_0(ctx context.Context, marshaler runtime.Marshaler, client MockDeviceServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq emptypb.Empty
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.GetDeviceInfo(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_MockDeviceService_GetDeviceInfo_0(ctx context.Context, marshaler runtime.Marshaler, server MockDeviceServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq emptypb.Empty
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.GetDeviceInfo(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 // RegisterMockDeviceServiceHandlerServer registers the http handlers for service MockDeviceService to "mux".
 // UnaryRPC     :call MockDeviceServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -230,6 +257,26 @@ func RegisterMockDeviceServiceHandlerServer(ctx context.Context, mux *runtime.Se
 		}
 		forward_MockDeviceService_GetFWVersion_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_MockDeviceService_GetDeviceInfo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/simulator.v1.MockDeviceService/GetDeviceInfo", runtime.WithHTTPPathPattern("/simulator.v1.MockDeviceService/GetDeviceInfo"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MockDeviceService_GetDeviceInfo_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_MockDeviceService_GetDeviceInfo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 
 	return nil
 }
@@ -338,19 +385,38 @@ func RegisterMockDeviceServiceHandlerClient(ctx context.Context, mux *runtime.Se
 		}
 		forward_MockDeviceService_GetFWVersion_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_MockDeviceService_GetDeviceInfo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/simulator.v1.MockDeviceService/GetDeviceInfo", runtime.WithHTTPPathPattern("/simulator.v1.MockDeviceService/GetDeviceInfo"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_MockDeviceService_GetDeviceInfo_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_MockDeviceService_GetDeviceInfo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	return nil
 }
 
 var (
-	pattern_MockDeviceService_GetStatus_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"simulator.v1.MockDeviceService", "GetStatus"}, ""))
-	pattern_MockDeviceService_GetHWVersion_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"simulator.v1.MockDeviceService", "GetHWVersion"}, ""))
-	pattern_MockDeviceService_GetSWVersion_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"simulator.v1.MockDeviceService", "GetSWVersion"}, ""))
-	pattern_MockDeviceService_GetFWVersion_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"simulator.v1.MockDeviceService", "GetFWVersion"}, ""))
+	pattern_MockDeviceService_GetStatus_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"simulator.v1.MockDeviceService", "GetStatus"}, ""))
+	pattern_MockDeviceService_GetHWVersion_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"simulator.v1.MockDeviceService", "GetHWVersion"}, ""))
+	pattern_MockDeviceService_GetSWVersion_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"simulator.v1.MockDeviceService", "GetSWVersion"}, ""))
+	pattern_MockDeviceService_GetFWVersion_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"simulator.v1.MockDeviceService", "GetFWVersion"}, ""))
+	pattern_MockDeviceService_GetDeviceInfo_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"simulator.v1.MockDeviceService", "GetDeviceInfo"}, ""))
 )
 
 var (
-	forward_MockDeviceService_GetStatus_0    = runtime.ForwardResponseMessage
-	forward_MockDeviceService_GetHWVersion_0 = runtime.ForwardResponseMessage
-	forward_MockDeviceService_GetSWVersion_0 = runtime.ForwardResponseMessage
-	forward_MockDeviceService_GetFWVersion_0 = runtime.ForwardResponseMessage
+	forward_MockDeviceService_GetStatus_0     = runtime.ForwardResponseMessage
+	forward_MockDeviceService_GetHWVersion_0  = runtime.ForwardResponseMessage
+	forward_MockDeviceService_GetSWVersion_0  = runtime.ForwardResponseMessage
+	forward_MockDeviceService_GetFWVersion_0  = runtime.ForwardResponseMessage
+	forward_MockDeviceService_GetDeviceInfo_0 = runtime.ForwardResponseMessage
 )
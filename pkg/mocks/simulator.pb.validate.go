@@ -138,3 +138,332 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetVersionResponseValidationError{}
+
+// Validate checks the field values on DeviceInfoError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeviceInfoError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeviceInfoError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeviceInfoErrorMultiError, or nil if none found.
+func (m *DeviceInfoError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeviceInfoError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Part
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeviceInfoErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeviceInfoErrorMultiError is an error wrapping multiple validation errors
+// returned by DeviceInfoError.ValidateAll() if the designated constraints
+// aren't met.
+type DeviceInfoErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeviceInfoErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeviceInfoErrorMultiError) AllErrors() []error { return m }
+
+// DeviceInfoErrorValidationError is the validation error returned by
+// DeviceInfoError.Validate if the designated constraints aren't met.
+type DeviceInfoErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeviceInfoErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeviceInfoErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeviceInfoErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeviceInfoErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeviceInfoErrorValidationError) ErrorName() string { return "DeviceInfoErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeviceInfoErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeviceInfoError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeviceInfoErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeviceInfoErrorValidationError{}
+
+// Validate checks the field values on GetDeviceInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeviceInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeviceInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeviceInfoResponseMultiError, or nil if none found.
+func (m *GetDeviceInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeviceInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeviceInfoResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDeviceInfoResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeviceInfoResponseValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HwVersion
+
+	if all {
+		switch v := interface{}(m.GetSwVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeviceInfoResponseValidationError{
+					field:  "SwVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDeviceInfoResponseValidationError{
+					field:  "SwVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSwVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeviceInfoResponseValidationError{
+				field:  "SwVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFwVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeviceInfoResponseValidationError{
+					field:  "FwVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDeviceInfoResponseValidationError{
+					field:  "FwVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFwVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeviceInfoResponseValidationError{
+				field:  "FwVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDeviceInfoResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDeviceInfoResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDeviceInfoResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDeviceInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeviceInfoResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDeviceInfoResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDeviceInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeviceInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeviceInfoResponseMultiError) AllErrors() []error { return m }
+
+// GetDeviceInfoResponseValidationError is the validation error returned by
+// GetDeviceInfoResponse.Validate if the designated constraints aren't met.
+type GetDeviceInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeviceInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeviceInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeviceInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeviceInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeviceInfoResponseValidationError) ErrorName() string {
+	return "GetDeviceInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeviceInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeviceInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeviceInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeviceInfoResponseValidationError{}
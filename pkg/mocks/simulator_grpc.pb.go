@@ -21,10 +21,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	MockDeviceService_GetStatus_FullMethodName    = "/simulator.v1.MockDeviceService/GetStatus"
-	MockDeviceService_GetHWVersion_FullMethodName = "/simulator.v1.MockDeviceService/GetHWVersion"
-	MockDeviceService_GetSWVersion_FullMethodName = "/simulator.v1.MockDeviceService/GetSWVersion"
-	MockDeviceService_GetFWVersion_FullMethodName = "/simulator.v1.MockDeviceService/GetFWVersion"
+	MockDeviceService_GetStatus_FullMethodName     = "/simulator.v1.MockDeviceService/GetStatus"
+	MockDeviceService_GetHWVersion_FullMethodName  = "/simulator.v1.MockDeviceService/GetHWVersion"
+	MockDeviceService_GetSWVersion_FullMethodName  = "/simulator.v1.MockDeviceService/GetSWVersion"
+	MockDeviceService_GetFWVersion_FullMethodName  = "/simulator.v1.MockDeviceService/GetFWVersion"
+	MockDeviceService_GetDeviceInfo_FullMethodName = "/simulator.v1.MockDeviceService/GetDeviceInfo"
 )
 
 // MockDeviceServiceClient is the client API for MockDeviceService service.
@@ -35,6 +36,8 @@ type MockDeviceServiceClient interface {
 	GetHWVersion(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetVersionResponse, error)
 	GetSWVersion(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*monitoring.Version, error)
 	GetFWVersion(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*monitoring.Version, error)
+	// GetDeviceInfo returns device status together with all versions in a single exchange.
+	GetDeviceInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDeviceInfoResponse, error)
 }
 
 type mockDeviceServiceClient struct {
@@ -81,6 +84,15 @@ func (c *mockDeviceServiceClient) GetFWVersion(ctx context.Context, in *emptypb.
 	return out, nil
 }
 
+func (c *mockDeviceServiceClient) GetDeviceInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDeviceInfoResponse, error) {
+	out := new(GetDeviceInfoResponse)
+	err := c.cc.Invoke(ctx, MockDeviceService_GetDeviceInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MockDeviceServiceServer is the server API for MockDeviceService service.
 // All implementations should embed UnimplementedMockDeviceServiceServer
 // for forward compatibility
@@ -89,6 +101,8 @@ type MockDeviceServiceServer interface {
 	GetHWVersion(context.Context, *emptypb.Empty) (*GetVersionResponse, error)
 	GetSWVersion(context.Context, *emptypb.Empty) (*monitoring.Version, error)
 	GetFWVersion(context.Context, *emptypb.Empty) (*monitoring.Version, error)
+	// GetDeviceInfo returns device status together with all versions in a single exchange.
+	GetDeviceInfo(context.Context, *emptypb.Empty) (*GetDeviceInfoResponse, error)
 }
 
 // UnimplementedMockDeviceServiceServer should be embedded to have forward compatible implementations.
@@ -107,6 +121,9 @@ func (UnimplementedMockDeviceServiceServer) GetSWVersion(context.Context, *empty
 func (UnimplementedMockDeviceServiceServer) GetFWVersion(context.Context, *emptypb.Empty) (*monitoring.Version, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFWVersion not implemented")
 }
+func (UnimplementedMockDeviceServiceServer) GetDeviceInfo(context.Context, *emptypb.Empty) (*GetDeviceInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceInfo not implemented")
+}
 
 // UnsafeMockDeviceServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to MockDeviceServiceServer will
@@ -191,6 +208,24 @@ func _MockDeviceService_GetFWVersion_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MockDeviceService_GetDeviceInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockDeviceServiceServer).GetDeviceInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MockDeviceService_GetDeviceInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockDeviceServiceServer).GetDeviceInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MockDeviceService_ServiceDesc is the grpc.ServiceDesc for MockDeviceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,6 +249,10 @@ var MockDeviceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFWVersion",
 			Handler:    _MockDeviceService_GetFWVersion_Handler,
 		},
+		{
+			MethodName: "GetDeviceInfo",
+			Handler:    _MockDeviceService_GetDeviceInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/mocks/simulator.proto",
@@ -0,0 +1,349 @@
+// Package simulatorv1 implements network device simulator means.
+package simulatorv1
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	apiv1 "github.com/eroshiva/trade-show-poc/api/v1"
+	"github.com/gosnmp/gosnmp"
+	"github.com/rs/zerolog"
+)
+
+const (
+	componentNameSNMP = "snmp-agent-simulator"
+
+	// server configuration-related constants
+	udpNetwork = "udp"
+	// EnvSNMPAgentAddress constant specifies name of the environmental variable for SNMP agent address.
+	EnvSNMPAgentAddress     = "DEVICE_SIMULATOR_SNMP_AGENT_ADDRESS" // must be in form address:port, e.g., localhost:50161.
+	defaultSNMPAgentAddress = "localhost:50161"
+
+	// EnvSNMPCommunity constant specifies name of the environmental variable for SNMPv2c community.
+	EnvSNMPCommunity     = "DEVICE_SIMULATOR_SNMP_COMMUNITY"
+	defaultSNMPCommunity = "public"
+	// EnvSNMPUserName constant specifies name of the environmental variable for SNMPv3 USM user name.
+	// When set, SNMP agent accepts only SNMPv3 requests of this user.
+	EnvSNMPUserName = "DEVICE_SIMULATOR_SNMP_USER_NAME"
+	// EnvSNMPAuthProtocol constant specifies name of the environmental variable for SNMPv3 authentication protocol,
+	// e.g., "MD5", "SHA", "SHA256".
+	EnvSNMPAuthProtocol = "DEVICE_SIMULATOR_SNMP_AUTH_PROTOCOL"
+	// EnvSNMPAuthPassphrase constant specifies name of the environmental variable for SNMPv3 authentication passphrase.
+	EnvSNMPAuthPassphrase = "DEVICE_SIMULATOR_SNMP_AUTH_PASSPHRASE"
+	// EnvSNMPPrivProtocol constant specifies name of the environmental variable for SNMPv3 privacy protocol,
+	// e.g., "DES", "AES".
+	EnvSNMPPrivProtocol = "DEVICE_SIMULATOR_SNMP_PRIV_PROTOCOL"
+	// EnvSNMPPrivPassphrase constant specifies name of the environmental variable for SNMPv3 privacy passphrase.
+	EnvSNMPPrivPassphrase = "DEVICE_SIMULATOR_SNMP_PRIV_PASSPHRASE"
+
+	// maximum size of SNMP message carried over UDP.
+	maxSNMPMessageSize = 65507
+	// SNMP engine ID of the agent (RFC 3411, text format).
+	snmpEngineID    = "\x80\x00\x00\x00\x04device-simulator"
+	snmpEngineBoots = 1
+
+	// OIDs served by SNMP agent.
+	oidSysDescr                = ".1.3.6.1.2.1.1.1.0"
+	oidSysUpTime               = ".1.3.6.1.2.1.1.3.0"
+	oidEntPhysicalHardwareRev  = ".1.3.6.1.2.1.47.1.1.1.1.8.1"
+	oidEntPhysicalFirmwareRev  = ".1.3.6.1.2.1.47.1.1.1.1.9.1"
+	oidEntPhysicalSoftwareRev  = ".1.3.6.1.2.1.47.1.1.1.1.10.1"
+	oidUsmStatsUnknownEngineID = ".1.3.6.1.6.3.15.1.1.4.0"
+)
+
+var zlogSNMP = zerolog.New(zerolog.ConsoleWriter{
+	Out:        os.Stderr,
+	TimeFormat: time.RFC3339,
+	FormatCaller: func(i interface{}) string {
+		return filepath.Dir(fmt.Sprintf("%s/", i))
+	},
+}).Level(zerolog.TraceLevel).With().Caller().Timestamp().Str(component, componentNameSNMP).Logger()
+
+// SNMPAgent is an in-process SNMP agent stand-in. It answers SNMPv2c and SNMPv3 GET requests
+// with the same data, which is reported by the gRPC Network Device Simulator.
+type SNMPAgent struct {
+	conn      net.PacketConn
+	community string
+	// usm is set only when SNMPv3 is enabled.
+	usm       *gosnmp.UsmSecurityParameters
+	msgFlags  gosnmp.SnmpV3MsgFlags
+	startTime time.Time
+	wg        sync.WaitGroup
+}
+
+// NewSNMPAgent is a factory function that creates an SNMP agent simulator structure.
+func NewSNMPAgent() *SNMPAgent {
+	return &SNMPAgent{}
+}
+
+// StartSNMPAgent function starts SNMP agent simulator, which listens on UDP socket.
+func (a *SNMPAgent) StartSNMPAgent() {
+	serverAddress := readServerAddress(EnvSNMPAgentAddress, defaultSNMPAgentAddress)
+	a.community = os.Getenv(EnvSNMPCommunity)
+	if a.community == "" {
+		a.community = defaultSNMPCommunity
+	}
+	err := a.readUSMParameters()
+	if err != nil {
+		zlogSNMP.Fatal().Err(err).Msg("failed to read SNMPv3 USM parameters")
+	}
+
+	conn, err := net.ListenPacket(udpNetwork, serverAddress)
+	if err != nil {
+		zlogSNMP.Fatal().Err(err).Msg("failed to listen")
+	}
+	a.conn = conn
+	a.startTime = time.Now()
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		zlogSNMP.Info().Msgf("SNMP Agent Simulator listening on %s", serverAddress)
+		a.serve()
+	}()
+}
+
+// StopSNMPAgent stops SNMP agent simulator.
+func (a *SNMPAgent) StopSNMPAgent() {
+	zlogSNMP.Info().Msg("Gracefully stopping SNMP Agent Simulator")
+	if a.conn != nil {
+		_ = a.conn.Close()
+	}
+	a.wg.Wait()
+}
+
+// readUSMParameters reads SNMPv3 USM parameters from the environment. SNMPv3 is enabled only when user name is set.
+func (a *SNMPAgent) readUSMParameters() error {
+	userName := os.Getenv(EnvSNMPUserName)
+	if userName == "" {
+		// SNMPv3 is not enabled, serving only SNMPv2c requests
+		return nil
+	}
+	authProtocol := gosnmp.NoAuth
+	if v := os.Getenv(EnvSNMPAuthProtocol); v != "" {
+		p, err := parseSNMPAuthProtocol(v)
+		if err != nil {
+			return err
+		}
+		authProtocol = p
+	}
+	privProtocol := gosnmp.NoPriv
+	if v := os.Getenv(EnvSNMPPrivProtocol); v != "" {
+		p, err := parseSNMPPrivProtocol(v)
+		if err != nil {
+			return err
+		}
+		privProtocol = p
+	}
+
+	a.msgFlags = gosnmp.NoAuthNoPriv
+	if authProtocol > gosnmp.NoAuth {
+		a.msgFlags = gosnmp.AuthNoPriv
+		if privProtocol > gosnmp.NoPriv {
+			a.msgFlags = gosnmp.AuthPriv
+		}
+	}
+	a.usm = &gosnmp.UsmSecurityParameters{
+		UserName:                 userName,
+		AuthoritativeEngineID:    snmpEngineID,
+		AuthoritativeEngineBoots: snmpEngineBoots,
+		AuthenticationProtocol:   authProtocol,
+		AuthenticationPassphrase: os.Getenv(EnvSNMPAuthPassphrase),
+		PrivacyProtocol:          privProtocol,
+		PrivacyPassphrase:        os.Getenv(EnvSNMPPrivPassphrase),
+	}
+	// localizing keys once, they are valid for the whole lifetime of the agent
+	return a.usm.InitSecurityKeys()
+}
+
+func parseSNMPAuthProtocol(name string) (gosnmp.SnmpV3AuthProtocol, error) {
+	for p := gosnmp.NoAuth; p <= gosnmp.SHA512; p++ {
+		if p.String() == name {
+			return p, nil
+		}
+	}
+	return gosnmp.NoAuth, fmt.Errorf("unknown SNMPv3 authentication protocol %q", name)
+}
+
+func parseSNMPPrivProtocol(name string) (gosnmp.SnmpV3PrivProtocol, error) {
+	for p := gosnmp.NoPriv; p <= gosnmp.AES256C; p++ {
+		if p.String() == name {
+			return p, nil
+		}
+	}
+	return gosnmp.NoPriv, fmt.Errorf("unknown SNMPv3 privacy protocol %q", name)
+}
+
+func (a *SNMPAgent) serve() {
+	buf := make([]byte, maxSNMPMessageSize)
+	for {
+		n, addr, err := a.conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				// agent is stopped
+				return
+			}
+			zlogSNMP.Error().Err(err).Msg("Failed to read SNMP message")
+			continue
+		}
+		packet := make([]byte, n)
+		copy(packet, buf[:n])
+		a.handleMessage(packet, addr)
+	}
+}
+
+// handleMessage decodes SNMP message, crafts response and sends it back to the manager.
+func (a *SNMPAgent) handleMessage(packet []byte, addr net.Addr) {
+	status := readDeviceStatus()
+	if status == apiv1.Status_STATUS_DEVICE_DOWN {
+		// device is unreachable, silently dropping the message
+		zlogSNMP.Info().Msg("Device status is down, dropping SNMP message")
+		return
+	}
+
+	var resp *gosnmp.SnmpPacket
+	var err error
+	if a.usm != nil {
+		resp, err = a.handleV3Message(packet, status)
+	} else {
+		resp, err = a.handleV2cMessage(packet, status)
+	}
+	if err != nil {
+		zlogSNMP.Error().Err(err).Msg("Dropping SNMP message")
+		return
+	}
+
+	out, err := resp.MarshalMsg()
+	if err != nil {
+		zlogSNMP.Error().Err(err).Msg("Failed to marshal SNMP response")
+		return
+	}
+	_, err = a.conn.WriteTo(out, addr)
+	if err != nil {
+		zlogSNMP.Error().Err(err).Msgf("Failed to send SNMP response to %s", addr)
+	}
+}
+
+func (a *SNMPAgent) handleV2cMessage(packet []byte, status apiv1.Status) (*gosnmp.SnmpPacket, error) {
+	x := &gosnmp.GoSNMP{Version: gosnmp.Version2c}
+	req, err := x.SnmpDecodePacket(packet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode SNMP message: %w", err)
+	}
+	if req.Version != gosnmp.Version2c && req.Version != gosnmp.Version1 {
+		return nil, fmt.Errorf("unexpected SNMP version %s", req.Version)
+	}
+	if req.Community != a.community {
+		return nil, fmt.Errorf("unknown community %q", req.Community)
+	}
+	zlogSNMP.Info().Msgf("Received SNMP %s request", req.PDUType)
+
+	resp := &gosnmp.SnmpPacket{
+		Version:   req.Version,
+		Community: req.Community,
+		PDUType:   gosnmp.GetResponse,
+		RequestID: req.RequestID,
+	}
+	a.fillResponse(resp, req, status)
+	return resp, nil
+}
+
+func (a *SNMPAgent) handleV3Message(packet []byte, status apiv1.Status) (*gosnmp.SnmpPacket, error) {
+	x := &gosnmp.GoSNMP{
+		Version:            gosnmp.Version3,
+		SecurityModel:      gosnmp.UserSecurityModel,
+		MsgFlags:           a.msgFlags,
+		SecurityParameters: a.usm,
+	}
+	// decoding (and, if needed, authenticating and decrypting) the message
+	req, err := x.UnmarshalTrap(packet, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode SNMPv3 message: %w", err)
+	}
+	usp, ok := req.SecurityParameters.(*gosnmp.UsmSecurityParameters)
+	if !ok {
+		return nil, fmt.Errorf("unexpected SNMPv3 security parameters")
+	}
+
+	if usp.AuthoritativeEngineID != snmpEngineID {
+		// engine discovery (RFC 3414, section 4), reporting agent's engine ID, boots and time
+		zlogSNMP.Info().Msg("Received SNMPv3 engine discovery request")
+		return &gosnmp.SnmpPacket{
+			Version:       gosnmp.Version3,
+			MsgFlags:      gosnmp.NoAuthNoPriv,
+			SecurityModel: gosnmp.UserSecurityModel,
+			SecurityParameters: &gosnmp.UsmSecurityParameters{
+				AuthoritativeEngineID:    snmpEngineID,
+				AuthoritativeEngineBoots: snmpEngineBoots,
+				AuthoritativeEngineTime:  a.engineTime(),
+			},
+			MsgID:           req.MsgID,
+			ContextEngineID: snmpEngineID,
+			ContextName:     req.ContextName,
+			PDUType:         gosnmp.Report,
+			RequestID:       req.RequestID,
+			Variables: []gosnmp.SnmpPDU{
+				{Name: oidUsmStatsUnknownEngineID, Type: gosnmp.Counter32, Value: uint32(1)},
+			},
+		}, nil
+	}
+	zlogSNMP.Info().Msgf("Received SNMPv3 %s request", req.PDUType)
+
+	// request is authentic, response is secured with the same security level (and privacy salt)
+	usp.AuthoritativeEngineTime = a.engineTime()
+	resp := &gosnmp.SnmpPacket{
+		Version:            gosnmp.Version3,
+		MsgFlags:           req.MsgFlags &^ gosnmp.Reportable,
+		SecurityModel:      gosnmp.UserSecurityModel,
+		SecurityParameters: usp,
+		MsgID:              req.MsgID,
+		ContextEngineID:    req.ContextEngineID,
+		ContextName:        req.ContextName,
+		PDUType:            gosnmp.GetResponse,
+		RequestID:          req.RequestID,
+	}
+	a.fillResponse(resp, req, status)
+	return resp, nil
+}
+
+// fillResponse fills in variable bindings requested by the manager.
+func (a *SNMPAgent) fillResponse(resp, req *gosnmp.SnmpPacket, status apiv1.Status) {
+	if req.PDUType != gosnmp.GetRequest || status == apiv1.Status_STATUS_DEVICE_UNHEALTHY {
+		// agent is not able to process the request, reporting a general error
+		resp.Error = gosnmp.GenErr
+		resp.ErrorIndex = 1
+		resp.Variables = req.Variables
+		return
+	}
+
+	resp.Variables = make([]gosnmp.SnmpPDU, 0, len(req.Variables))
+	for _, v := range req.Variables {
+		resp.Variables = append(resp.Variables, a.lookupVariable(v.Name))
+	}
+}
+
+func (a *SNMPAgent) lookupVariable(oid string) gosnmp.SnmpPDU {
+	switch oid {
+	case oidSysDescr:
+		return gosnmp.SnmpPDU{Name: oid, Type: gosnmp.OctetString, Value: "Network Device Simulator, HW model " + readHWModel()}
+	case oidSysUpTime:
+		// TimeTicks are in hundredths of a second
+		return gosnmp.SnmpPDU{Name: oid, Type: gosnmp.TimeTicks, Value: uint32(time.Since(a.startTime) / (10 * time.Millisecond))} //nolint:gosec
+	case oidEntPhysicalHardwareRev:
+		return gosnmp.SnmpPDU{Name: oid, Type: gosnmp.OctetString, Value: readHWModel()}
+	case oidEntPhysicalSoftwareRev:
+		return gosnmp.SnmpPDU{Name: oid, Type: gosnmp.OctetString, Value: readSWVersion()}
+	case oidEntPhysicalFirmwareRev:
+		return gosnmp.SnmpPDU{Name: oid, Type: gosnmp.OctetString, Value: readFWVersion()}
+	default:
+		return gosnmp.SnmpPDU{Name: oid, Type: gosnmp.NoSuchObject}
+	}
+}
+
+func (a *SNMPAgent) engineTime() uint32 {
+	return uint32(time.Since(a.startTime) / time.Second) //nolint:gosec
+}
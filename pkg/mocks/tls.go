@@ -0,0 +1,51 @@
+// Package simulatorv1 implements network device simulator means.
+package simulatorv1
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+const (
+	// EnvTLSCertFile constant specifies name of the environmental variable for the path to the PEM encoded server
+	// certificate. gNMI, RESTCONF and OVSDB server simulators serve TLS, when it is set.
+	EnvTLSCertFile = "DEVICE_SIMULATOR_TLS_CERT_FILE"
+	// EnvTLSKeyFile constant specifies name of the environmental variable for the path to the PEM encoded private key
+	// of the server certificate.
+	EnvTLSKeyFile = "DEVICE_SIMULATOR_TLS_KEY_FILE"
+	// EnvTLSClientCAFile constant specifies name of the environmental variable for the path to the PEM encoded bundle
+	// of CA certificates. Client certificate signed by one of them is required (mTLS), when it is set.
+	EnvTLSClientCAFile = "DEVICE_SIMULATOR_TLS_CLIENT_CA_FILE"
+)
+
+// readTLSConfig reads TLS server configuration from the environment. It returns nil, when TLS is not configured.
+func readTLSConfig() (*tls.Config, error) {
+	certFile := os.Getenv(EnvTLSCertFile)
+	if certFile == "" {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, os.Getenv(EnvTLSKeyFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+	clientCAFile := os.Getenv(EnvTLSClientCAFile)
+	if clientCAFile != "" {
+		bundle, err := os.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("client CA bundle does not contain any PEM encoded certificate")
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
@@ -8,7 +8,9 @@ import (
 
 	apiv1 "github.com/eroshiva/trade-show-poc/api/v1"
 	"github.com/eroshiva/trade-show-poc/internal/ent"
+	"github.com/eroshiva/trade-show-poc/internal/manager"
 	"github.com/eroshiva/trade-show-poc/internal/server"
+	"github.com/eroshiva/trade-show-poc/pkg/checksum"
 	"github.com/eroshiva/trade-show-poc/pkg/client/db"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,6 +45,9 @@ func SetupFull(grpcServerAddress, httpServerAddress string) (*ent.Client, apiv1.
 		return nil, nil, nil, nil, nil, err
 	}
 
+	// network devices are polled on demand only, control loop is not started
+	poller := manager.NewManager(client, checksum.NewMockGenerator())
+
 	wg := &sync.WaitGroup{}
 	termChan := make(chan bool, 1)
 	readyChan := make(chan bool, 1)
@@ -51,7 +56,7 @@ func SetupFull(grpcServerAddress, httpServerAddress string) (*ent.Client, apiv1.
 	wg.Add(1)
 	go func() {
 		wg.Add(1) //nolint:staticcheck
-		server.StartServer(grpcServerAddress, httpServerAddress, client, wg, termChan, readyChan, reverseProxyReadyChan, reverseProxyTermChan)
+		server.StartServer(grpcServerAddress, httpServerAddress, client, poller, wg, termChan, readyChan, reverseProxyReadyChan, reverseProxyTermChan)
 		wg.Done()
 	}()
 	// Waiting until both servers are up and running
@@ -106,6 +111,7 @@ func AssertEqualEndpoints(t *testing.T, expected, actual *ent.Endpoint) {
 	assert.Equal(t, expected.Host, actual.Host)
 	assert.Equal(t, expected.Port, actual.Port)
 	assert.Equal(t, expected.Protocol, actual.Protocol)
+	assert.Equal(t, expected.ProtocolName, actual.ProtocolName)
 }
 
 // AssertEqualNetworkDevices runs assertions on the fields of the Network Device resources.